@@ -62,18 +62,21 @@ func run(ctx context.Context, sigChan <-chan os.Signal, execute func(context.Con
 			shutdownTimer := time.NewTimer(shutdownTimeout)
 			defer shutdownTimer.Stop()
 
-			// Wait for either: graceful completion, timeout, or second signal
+			// Wait for either: graceful completion, timeout, or second signal.
+			// A forced exit still cleans up so that the state lock is released.
 			select {
 			case <-done:
 				// Graceful shutdown completed
 				return
 			case <-shutdownTimer.C:
 				fmt.Fprintf(stderr, "\nShutdown timeout (%v) exceeded, forcing exit\n", shutdownTimeout)
-				exitFn(1)
 			case sig = <-sigChan:
 				fmt.Fprintf(stderr, "\nReceived second signal %v, forcing exit\n", sig)
-				exitFn(1)
 			}
+			if cleanup != nil {
+				cleanup()
+			}
+			exitFn(1)
 		}()
 	}
 
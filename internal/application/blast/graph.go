@@ -0,0 +1,139 @@
+package blast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GraphFormat is an output format for the dependency graph.
+type GraphFormat string
+
+const (
+	// GraphFormatDOT renders the graph in Graphviz DOT.
+	GraphFormatDOT GraphFormat = "dot"
+	// GraphFormatMermaid renders the graph as a Mermaid flowchart.
+	GraphFormatMermaid GraphFormat = "mermaid"
+	// GraphFormatJSON renders the graph as JSON.
+	GraphFormatJSON GraphFormat = "json"
+)
+
+// ParseGraphFormat parses a graph output format.
+func ParseGraphFormat(s string) (GraphFormat, error) {
+	switch f := GraphFormat(strings.ToLower(strings.TrimSpace(s))); f {
+	case GraphFormatDOT, GraphFormatMermaid, GraphFormatJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid graph format %q (valid: dot, mermaid, json)", s)
+	}
+}
+
+// MarkImpacts flags the graph nodes affected by the given impacts.
+func (g *DependencyGraph) MarkImpacts(impacts []*Impact) {
+	levels := make(map[string]ImpactLevel, len(impacts))
+	for _, impact := range impacts {
+		if impact.Package != nil && impact.Level != ImpactLevelNone {
+			levels[impact.Package.Path] = impact.Level
+		}
+	}
+	for i := range g.Nodes {
+		if level, ok := levels[g.Nodes[i].ID]; ok {
+			g.Nodes[i].Affected = true
+			g.Nodes[i].ImpactLevel = level
+		}
+	}
+}
+
+// ImpactedSubgraph returns the affected nodes and the edges between them.
+func (g *DependencyGraph) ImpactedSubgraph() *DependencyGraph {
+	sub := &DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	affected := make(map[string]bool)
+	for _, node := range g.Nodes {
+		if node.Affected {
+			affected[node.ID] = true
+			sub.Nodes = append(sub.Nodes, node)
+		}
+	}
+	for _, edge := range g.Edges {
+		if affected[edge.Source] && affected[edge.Target] {
+			sub.Edges = append(sub.Edges, edge)
+		}
+	}
+	return sub
+}
+
+// DOT renders the graph in Graphviz DOT. Edges point from a package to the
+// package it depends on; affected packages are filled by impact level.
+func (g *DependencyGraph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph packages {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, node := range g.Nodes {
+		attrs := fmt.Sprintf("label=%q", node.Label)
+		if color := impactColor(node); color != "" {
+			attrs += fmt.Sprintf(", style=filled, fillcolor=%q", color)
+		}
+		fmt.Fprintf(&sb, "  %q [%s];\n", node.ID, attrs)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %q -> %q;\n", edge.Source, edge.Target)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. Edges point from a
+// package to the package it depends on; affected packages are styled by
+// impact level.
+func (g *DependencyGraph) Mermaid() string {
+	ids := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "  %s[\"%s\"]\n", ids[node.ID], strings.ReplaceAll(node.Label, `"`, "#quot;"))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s --> %s\n", ids[edge.Source], ids[edge.Target])
+	}
+
+	var direct, transitive []string
+	for _, node := range g.Nodes {
+		switch {
+		case !node.Affected:
+		case node.ImpactLevel == ImpactLevelTransitive:
+			transitive = append(transitive, ids[node.ID])
+		default:
+			direct = append(direct, ids[node.ID])
+		}
+	}
+	if len(direct) > 0 {
+		fmt.Fprintf(&sb, "  classDef direct fill:%s\n", directColor)
+		fmt.Fprintf(&sb, "  class %s direct\n", strings.Join(direct, ","))
+	}
+	if len(transitive) > 0 {
+		fmt.Fprintf(&sb, "  classDef transitive fill:%s\n", transitiveColor)
+		fmt.Fprintf(&sb, "  class %s transitive\n", strings.Join(transitive, ","))
+	}
+	return sb.String()
+}
+
+const (
+	directColor     = "#f4cccc"
+	transitiveColor = "#fff2cc"
+)
+
+// impactColor returns the fill color for an affected node.
+func impactColor(node GraphNode) string {
+	switch {
+	case !node.Affected:
+		return ""
+	case node.ImpactLevel == ImpactLevelTransitive:
+		return transitiveColor
+	default:
+		return directColor
+	}
+}
@@ -0,0 +1,107 @@
+package blast
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeGraphLayout creates packages/{core,api,web} and shared/utils, where
+// api and web depend on core and core depends on utils.
+func writeGraphLayout(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"packages/core/package.json": `{"name":"core","dependencies":{"utils":"*","left-pad":"1.0.0"}}`,
+		"packages/api/package.json":  `{"name":"api","dependencies":{"core":"*"}}`,
+		"packages/web/package.json":  `{"name":"web","dependencies":{"core":"*","api":"*"}}`,
+		"shared/utils/package.json":  `{"name":"utils"}`,
+		"packages/skip/package.json": `{"name":"skip","dependencies":{"core":"*"}}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestDependencyGraph_KnownLayout(t *testing.T) {
+	root := writeGraphLayout(t)
+	mc := &MonorepoConfig{
+		PackagePaths: []string{"packages/*"},
+		ExcludePaths: []string{"packages/skip"},
+		SharedDirs:   []string{"shared/utils"},
+	}
+	svc := NewService(WithRepoPath(root), WithMonorepoConfig(mc))
+
+	packages, err := svc.DiscoverPackages(context.Background(), &AnalysisOptions{MonorepoConfig: mc})
+	if err != nil {
+		t.Fatalf("DiscoverPackages() error = %v", err)
+	}
+	graph, err := svc.BuildDependencyGraph(context.Background(), packages)
+	if err != nil {
+		t.Fatalf("BuildDependencyGraph() error = %v", err)
+	}
+
+	var edges []string
+	for _, e := range graph.Edges {
+		edges = append(edges, e.Source+"->"+e.Target)
+	}
+	want := []string{
+		"packages/api->packages/core",
+		"packages/core->shared/utils",
+		"packages/web->packages/api",
+		"packages/web->packages/core",
+	}
+	if !reflect.DeepEqual(edges, want) {
+		t.Errorf("edges = %v, want %v", edges, want)
+	}
+
+	graph.MarkImpacts([]*Impact{
+		{Package: &Package{Path: "packages/core"}, Level: ImpactLevelDirect},
+		{Package: &Package{Path: "packages/api"}, Level: ImpactLevelTransitive},
+	})
+
+	dot := graph.DOT()
+	for _, line := range []string{
+		`"packages/api" -> "packages/core";`,
+		`"packages/core" [label="core", style=filled, fillcolor="#f4cccc"];`,
+		`"packages/web" [label="web"];`,
+	} {
+		if !strings.Contains(dot, line) {
+			t.Errorf("DOT output missing %q:\n%s", line, dot)
+		}
+	}
+
+	mermaid := graph.Mermaid()
+	if !strings.HasPrefix(mermaid, "graph LR\n") || strings.Count(mermaid, " --> ") != len(want) {
+		t.Errorf("unexpected Mermaid output:\n%s", mermaid)
+	}
+	if !strings.Contains(mermaid, "direct") || !strings.Contains(mermaid, "transitive") {
+		t.Errorf("Mermaid output missing impact classes:\n%s", mermaid)
+	}
+
+	sub := graph.ImpactedSubgraph()
+	if len(sub.Nodes) != 2 || len(sub.Edges) != 1 || sub.Edges[0].Source != "packages/api" {
+		t.Errorf("ImpactedSubgraph() = %+v, want api -> core", sub)
+	}
+}
+
+func TestParseGraphFormat(t *testing.T) {
+	for _, in := range []string{"dot", "Mermaid", "json"} {
+		if _, err := ParseGraphFormat(in); err != nil {
+			t.Errorf("ParseGraphFormat(%q) error = %v", in, err)
+		}
+	}
+	if _, err := ParseGraphFormat("svg"); err == nil {
+		t.Error("ParseGraphFormat(svg) should fail")
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 
 	"github.com/relicta-tech/relicta/internal/infrastructure/git"
@@ -189,18 +188,7 @@ func (s *serviceImpl) AnalyzeBlastRadius(ctx context.Context, opts *AnalysisOpti
 		graph, _ = s.BuildDependencyGraph(ctx, packages)
 		// Mark affected nodes
 		if graph != nil {
-			affectedPkgs := make(map[string]ImpactLevel)
-			for _, impact := range impacts {
-				if impact.Package != nil {
-					affectedPkgs[impact.Package.Path] = impact.Level
-				}
-			}
-			for i := range graph.Nodes {
-				if level, ok := affectedPkgs[graph.Nodes[i].ID]; ok {
-					graph.Nodes[i].Affected = true
-					graph.Nodes[i].ImpactLevel = level
-				}
-			}
+			graph.MarkImpacts(impacts)
 		}
 	}
 
@@ -414,6 +402,14 @@ func (s *serviceImpl) BuildDependencyGraph(ctx context.Context, packages []*Pack
 		}
 	}
 
+	// Dependencies come from manifest maps; sort edges for stable output
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		return graph.Edges[i].Target < graph.Edges[j].Target
+	})
+
 	return graph, nil
 }
 
@@ -550,162 +546,33 @@ func (s *serviceImpl) detectPackage(ctx context.Context, dir string) (*Package,
 }
 
 func (s *serviceImpl) detectGoModule(dir, relPath string) *Package {
-	goModPath := filepath.Join(dir, "go.mod")
-	data, err := os.ReadFile(goModPath) // #nosec G304 -- path from repo traversal
-	if err != nil {
-		return nil
-	}
-
-	// Parse module name
-	lines := strings.Split(string(data), "\n")
-	var moduleName string
-	var deps []string
-
-	inRequire := false
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "module ") {
-			moduleName = strings.TrimPrefix(line, "module ")
-		}
-		if strings.HasPrefix(line, "require (") {
-			inRequire = true
-			continue
-		}
-		if line == ")" {
-			inRequire = false
-			continue
-		}
-		if inRequire && line != "" && !strings.HasPrefix(line, "//") {
-			parts := strings.Fields(line)
-			if len(parts) >= 1 {
-				deps = append(deps, parts[0])
-			}
-		}
-		if strings.HasPrefix(line, "require ") && !strings.Contains(line, "(") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				deps = append(deps, parts[1])
-			}
-		}
-	}
-
-	return &Package{
-		Name:         moduleName,
-		Path:         relPath,
-		Type:         PackageTypeGoModule,
-		Dependencies: deps,
-	}
+	pkg, err := readGoModule(dir)
+	return detectedAt(pkg, err, relPath)
 }
 
 func (s *serviceImpl) detectNPMPackage(dir, relPath string) *Package {
-	pkgJSONPath := filepath.Join(dir, "package.json")
-	data, err := os.ReadFile(pkgJSONPath) // #nosec G304 -- path from repo traversal
-	if err != nil {
-		return nil
-	}
-
-	var pkgJSON struct {
-		Name            string            `json:"name"`
-		Version         string            `json:"version"`
-		Dependencies    map[string]string `json:"dependencies"`
-		DevDependencies map[string]string `json:"devDependencies"`
-	}
-
-	if err := json.Unmarshal(data, &pkgJSON); err != nil {
-		return nil
-	}
-
-	var deps []string
-	for dep := range pkgJSON.Dependencies {
-		deps = append(deps, dep)
-	}
-
-	var devDeps []string
-	for dep := range pkgJSON.DevDependencies {
-		devDeps = append(devDeps, dep)
-	}
-
-	return &Package{
-		Name:            pkgJSON.Name,
-		Path:            relPath,
-		Type:            PackageTypeNPM,
-		Version:         pkgJSON.Version,
-		Dependencies:    deps,
-		DevDependencies: devDeps,
-	}
+	pkg, err := readNPMPackage(dir)
+	return detectedAt(pkg, err, relPath)
 }
 
 func (s *serviceImpl) detectPythonPackage(dir, relPath string) *Package {
-	// Check for pyproject.toml
-	pyprojectPath := filepath.Join(dir, "pyproject.toml")
-	if data, err := os.ReadFile(pyprojectPath); err == nil { // #nosec G304 -- path from repo traversal
-		var pyproject map[string]any
-		if err := toml.Unmarshal(data, &pyproject); err == nil {
-			name := filepath.Base(dir)
-			version := ""
-
-			if project, ok := pyproject["project"].(map[string]any); ok {
-				if n, ok := project["name"].(string); ok {
-					name = n
-				}
-				if v, ok := project["version"].(string); ok {
-					version = v
-				}
-			}
-
-			return &Package{
-				Name:    name,
-				Path:    relPath,
-				Type:    PackageTypePython,
-				Version: version,
-			}
-		}
-	}
-
-	// Check for setup.py
-	setupPath := filepath.Join(dir, "setup.py")
-	if _, err := os.Stat(setupPath); err == nil {
-		return &Package{
-			Name: filepath.Base(dir),
-			Path: relPath,
-			Type: PackageTypePython,
-		}
-	}
-
-	return nil
+	pkg, err := readPythonPackage(dir)
+	return detectedAt(pkg, err, relPath)
 }
 
 func (s *serviceImpl) detectCargoPackage(dir, relPath string) *Package {
-	cargoPath := filepath.Join(dir, "Cargo.toml")
-	data, err := os.ReadFile(cargoPath) // #nosec G304 -- path from repo traversal
-	if err != nil {
-		return nil
-	}
-
-	var cargo struct {
-		Package struct {
-			Name    string `toml:"name"`
-			Version string `toml:"version"`
-		} `toml:"package"`
-		Dependencies map[string]any `toml:"dependencies"`
-	}
+	pkg, err := readCargoPackage(dir)
+	return detectedAt(pkg, err, relPath)
+}
 
-	if err := toml.Unmarshal(data, &cargo); err != nil {
+// detectedAt places a package read from a manifest at relPath. Unreadable
+// manifests are not detected.
+func detectedAt(pkg *Package, err error, relPath string) *Package {
+	if err != nil || pkg == nil {
 		return nil
 	}
-
-	var deps []string
-	for dep := range cargo.Dependencies {
-		deps = append(deps, dep)
-	}
-
-	return &Package{
-		Name:         cargo.Package.Name,
-		Path:         relPath,
-		Type:         PackageTypeCargo,
-		Version:      cargo.Package.Version,
-		Dependencies: deps,
-	}
+	pkg.Path = relPath
+	return pkg
 }
 
 func (s *serviceImpl) shouldExclude(path string, excludePaths []string) bool {
@@ -0,0 +1,220 @@
+package blast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// manifestReaders read the package described by a manifest in a directory,
+// in detection order. A reader returns nil if its manifest does not exist.
+var manifestReaders = []func(dir string) (*Package, error){
+	readGoModule,
+	readNPMPackage,
+	readPythonPackage,
+	readCargoPackage,
+}
+
+// ReadManifests returns the packages described by the manifests in dir
+// (go.mod, package.json, pyproject.toml or setup.py, Cargo.toml), in
+// detection order. The packages' paths are left empty. It returns an error
+// if a manifest exists but cannot be read or parsed.
+func ReadManifests(dir string) ([]*Package, error) {
+	var found []*Package
+	for _, read := range manifestReaders {
+		pkg, err := read(dir)
+		if err != nil {
+			return nil, err
+		}
+		if pkg != nil {
+			found = append(found, pkg)
+		}
+	}
+	return found, nil
+}
+
+// readManifest reads the named manifest in dir. It returns nil data if the
+// manifest does not exist.
+func readManifest(dir, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name)) // #nosec G304 -- path from repo traversal
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	return data, nil
+}
+
+func readGoModule(dir string) (*Package, error) {
+	data, err := readManifest(dir, "go.mod")
+	if data == nil {
+		return nil, err
+	}
+
+	// Parse module name
+	lines := strings.Split(string(data), "\n")
+	var moduleName string
+	var deps []string
+
+	inRequire := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "module ") {
+			moduleName = strings.TrimPrefix(line, "module ")
+		}
+		if strings.HasPrefix(line, "require (") {
+			inRequire = true
+			continue
+		}
+		if line == ")" {
+			inRequire = false
+			continue
+		}
+		if inRequire && line != "" && !strings.HasPrefix(line, "//") {
+			parts := strings.Fields(line)
+			if len(parts) >= 1 {
+				deps = append(deps, parts[0])
+			}
+		}
+		if strings.HasPrefix(line, "require ") && !strings.Contains(line, "(") {
+			parts := strings.Fields(line)
+			if len(parts) >= 2 {
+				deps = append(deps, parts[1])
+			}
+		}
+	}
+
+	return &Package{
+		Name:         moduleName,
+		Type:         PackageTypeGoModule,
+		Dependencies: deps,
+	}, nil
+}
+
+func readNPMPackage(dir string) (*Package, error) {
+	data, err := readManifest(dir, "package.json")
+	if data == nil {
+		return nil, err
+	}
+
+	var pkgJSON struct {
+		Name                 string            `json:"name"`
+		Version              string            `json:"version"`
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkgJSON); err != nil {
+		return nil, fmt.Errorf("parsing package.json: %w", err)
+	}
+
+	var deps []string
+	for _, m := range []map[string]string{pkgJSON.Dependencies, pkgJSON.PeerDependencies, pkgJSON.OptionalDependencies} {
+		for dep := range m {
+			deps = append(deps, dep)
+		}
+	}
+
+	var devDeps []string
+	for dep := range pkgJSON.DevDependencies {
+		devDeps = append(devDeps, dep)
+	}
+
+	return &Package{
+		Name:            pkgJSON.Name,
+		Type:            PackageTypeNPM,
+		Version:         pkgJSON.Version,
+		Dependencies:    deps,
+		DevDependencies: devDeps,
+	}, nil
+}
+
+func readPythonPackage(dir string) (*Package, error) {
+	// Check for pyproject.toml
+	data, err := readManifest(dir, "pyproject.toml")
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		var pyproject map[string]any
+		if err := toml.Unmarshal(data, &pyproject); err != nil {
+			return nil, fmt.Errorf("parsing pyproject.toml: %w", err)
+		}
+		name := filepath.Base(dir)
+		version := ""
+
+		if project, ok := pyproject["project"].(map[string]any); ok {
+			if n, ok := project["name"].(string); ok {
+				name = n
+			}
+			if v, ok := project["version"].(string); ok {
+				version = v
+			}
+		}
+
+		return &Package{
+			Name:    name,
+			Type:    PackageTypePython,
+			Version: version,
+		}, nil
+	}
+
+	// Check for setup.py
+	if _, err := os.Stat(filepath.Join(dir, "setup.py")); err == nil {
+		return &Package{
+			Name: filepath.Base(dir),
+			Type: PackageTypePython,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func readCargoPackage(dir string) (*Package, error) {
+	data, err := readManifest(dir, "Cargo.toml")
+	if data == nil {
+		return nil, err
+	}
+
+	var cargo struct {
+		Package struct {
+			Name    string `toml:"name"`
+			Version string `toml:"version"`
+		} `toml:"package"`
+		Dependencies      map[string]any `toml:"dependencies"`
+		DevDependencies   map[string]any `toml:"dev-dependencies"`
+		BuildDependencies map[string]any `toml:"build-dependencies"`
+	}
+
+	if err := toml.Unmarshal(data, &cargo); err != nil {
+		return nil, fmt.Errorf("parsing Cargo.toml: %w", err)
+	}
+
+	var deps []string
+	for dep := range cargo.Dependencies {
+		deps = append(deps, dep)
+	}
+
+	var devDeps []string
+	for _, m := range []map[string]any{cargo.DevDependencies, cargo.BuildDependencies} {
+		for dep := range m {
+			devDeps = append(devDeps, dep)
+		}
+	}
+
+	return &Package{
+		Name:            cargo.Package.Name,
+		Type:            PackageTypeCargo,
+		Version:         cargo.Package.Version,
+		Dependencies:    deps,
+		DevDependencies: devDeps,
+	}, nil
+}
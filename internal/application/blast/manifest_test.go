@@ -0,0 +1,48 @@
+package blast
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"package.json": `{"name": "@acme/bindings", "peerDependencies": {"@acme/core": "*"}, "devDependencies": {"@acme/testing": "*"}}`,
+		"Cargo.toml":   "[package]\nname = \"bindings\"\n\n[dependencies.core]\npath = \"../core\"\n\n[dev-dependencies]\nfixtures = \"0.1\"\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pkgs, err := ReadManifests(dir)
+	if err != nil {
+		t.Fatalf("ReadManifests() error = %v", err)
+	}
+	if len(pkgs) != 2 {
+		t.Fatalf("ReadManifests() returned %d packages, want 2", len(pkgs))
+	}
+
+	npm, cargo := pkgs[0], pkgs[1]
+	if npm.Name != "@acme/bindings" || !slices.Contains(npm.Dependencies, "@acme/core") || !slices.Contains(npm.DevDependencies, "@acme/testing") {
+		t.Errorf("npm package = %+v", npm)
+	}
+	if cargo.Name != "bindings" || !slices.Contains(cargo.Dependencies, "core") || !slices.Contains(cargo.DevDependencies, "fixtures") {
+		t.Errorf("cargo package = %+v", cargo)
+	}
+}
+
+func TestReadManifests_InvalidManifest(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{not json`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ReadManifests(dir); err == nil {
+		t.Error("ReadManifests() error = nil, want parse error")
+	}
+}
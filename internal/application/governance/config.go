@@ -5,19 +5,23 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/advisory"
 	"github.com/relicta-tech/relicta/internal/cgp/evaluator"
 	"github.com/relicta-tech/relicta/internal/cgp/memory"
 	"github.com/relicta-tech/relicta/internal/cgp/policy"
 	"github.com/relicta-tech/relicta/internal/cgp/policy/dsl"
+	"github.com/relicta-tech/relicta/internal/cgp/risk"
 	"github.com/relicta-tech/relicta/internal/config"
 )
 
 // NewServiceFromConfig creates a governance service from configuration.
 // It sets up the evaluator, policy engine, and optionally the memory store
 // based on the provided configuration.
-func NewServiceFromConfig(cfg *config.GovernanceConfig, repoPath string, logger *slog.Logger) (*Service, error) {
+// Additional options are applied after those derived from configuration.
+func NewServiceFromConfig(cfg *config.GovernanceConfig, repoPath string, logger *slog.Logger, extra ...ServiceOption) (*Service, error) {
 	if logger == nil {
 		logger = slog.Default()
 	}
@@ -41,10 +45,20 @@ func NewServiceFromConfig(cfg *config.GovernanceConfig, repoPath string, logger
 	// Create policy engine with policies
 	policyEngine := policy.NewEngine(policies, logger)
 
+	calculator := risk.NewCalculatorWithWeights(cfg.RiskWeights)
+	if lookup := newAdvisoryLookup(cfg.Advisories, repoPath, logger); lookup != nil {
+		timeout := cfg.Advisories.Timeout
+		if timeout <= 0 {
+			timeout = DefaultAdvisoryTimeout
+		}
+		calculator.WithAdvisories(lookup, timeout)
+	}
+
 	// Create evaluator with config and policy engine
 	eval := evaluator.New(
 		evaluator.WithConfig(evalCfg),
 		evaluator.WithPolicyEngine(policyEngine),
+		evaluator.WithRiskCalculator(calculator),
 		evaluator.WithLogger(logger),
 	)
 
@@ -53,6 +67,14 @@ func NewServiceFromConfig(cfg *config.GovernanceConfig, repoPath string, logger
 		WithLogger(logger),
 	}
 
+	if cfg.RequireLinkedIssue {
+		linkedIssues, err := NewLinkedIssuePolicy(cfg.StrictMode, cfg.LinkedIssueExemptTypes, cfg.IssuePatterns)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithLinkedIssuePolicy(linkedIssues))
+	}
+
 	// Set up memory store if enabled
 	if cfg.MemoryEnabled {
 		memoryPath := cfg.MemoryPath
@@ -77,7 +99,39 @@ func NewServiceFromConfig(cfg *config.GovernanceConfig, repoPath string, logger
 		}
 	}
 
-	return NewService(eval, opts...), nil
+	return NewService(eval, append(opts, extra...)...), nil
+}
+
+// DefaultAdvisoryTimeout bounds advisory lookups when no timeout is configured.
+const DefaultAdvisoryTimeout = 10 * time.Second
+
+// newAdvisoryLookup creates the configured advisory lookup. It returns nil
+// when advisories are disabled or the local database cannot be loaded.
+func newAdvisoryLookup(cfg config.AdvisoryConfig, repoPath string, logger *slog.Logger) advisory.Lookup {
+	switch cfg.Source {
+	case "osv":
+		var opts []advisory.OSVOption
+		if cfg.URL != "" {
+			opts = append(opts, advisory.WithOSVURL(cfg.URL))
+		}
+		return advisory.NewOSVLookup(opts...)
+	case "database":
+		path := cfg.Database
+		if !filepath.IsAbs(path) && repoPath != "" {
+			path = filepath.Join(repoPath, path)
+		}
+		lookup, err := advisory.LoadDatabase(path)
+		if err != nil {
+			logger.Warn("failed to load advisory database, proceeding without advisory checks",
+				"error", err,
+				"path", path,
+			)
+			return nil
+		}
+		return lookup
+	default:
+		return nil
+	}
 }
 
 // buildPolicies creates policy.Policy objects from config.
@@ -1,12 +1,15 @@
 package governance
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/risk"
 	"github.com/relicta-tech/relicta/internal/config"
 )
 
@@ -351,3 +354,58 @@ func TestEvaluatorConfigFromGovernance(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+// manifestFiles serves dependency manifests keyed by ref.
+type manifestFiles map[string]string
+
+func (f manifestFiles) GetFileAtRef(_ context.Context, ref, path string) ([]byte, error) {
+	if path != "package.json" {
+		return nil, os.ErrNotExist
+	}
+	data, ok := f[ref]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(data), nil
+}
+
+func TestNewServiceFromConfig_AdvisoryDatabase(t *testing.T) {
+	tmpDir := t.TempDir()
+	db := `[{"id": "CVE-2021-23337", "ecosystem": "npm", "package": "lodash", "versions": ["4.17.20"]}]`
+	if err := os.WriteFile(filepath.Join(tmpDir, "advisories.json"), []byte(db), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.GovernanceConfig{
+		AutoApproveThreshold: 0.3,
+		MaxAutoApproveRisk:   0.5,
+		Advisories:           config.AdvisoryConfig{Source: "database", Database: "advisories.json"},
+	}
+	reader := manifestFiles{
+		"v1.0.0": `{"dependencies": {"lodash": "4.17.15"}}`,
+		"HEAD":   `{"dependencies": {"lodash": "4.17.20"}}`,
+	}
+	svc, err := NewServiceFromConfig(cfg, tmpDir, slog.Default(), WithManifestReader(reader))
+	if err != nil {
+		t.Fatalf("NewServiceFromConfig() error = %v", err)
+	}
+
+	output, err := svc.EvaluateRelease(context.Background(), EvaluateReleaseInput{
+		Release:    createTestRelease(t),
+		Actor:      cgp.NewHumanActor("dev", "Dev"),
+		Repository: "owner/repo",
+	})
+	if err != nil {
+		t.Fatalf("EvaluateRelease() error = %v", err)
+	}
+
+	for _, factor := range output.RiskFactors {
+		if factor.Category == risk.FactorVulnerableDependencies {
+			if !strings.Contains(factor.Description, "lodash@4.17.20 (CVE-2021-23337)") {
+				t.Errorf("Description = %q", factor.Description)
+			}
+			return
+		}
+	}
+	t.Errorf("RiskFactors = %+v, want vulnerable dependencies factor", output.RiskFactors)
+}
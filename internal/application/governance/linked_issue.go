@@ -0,0 +1,112 @@
+// Package governance provides CGP (Change Governance Protocol) integration for release workflows.
+package governance
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+)
+
+// DefaultIssuePatterns match GitHub (#123, GH-123) and Jira-style (PROJ-123)
+// issue references.
+var DefaultIssuePatterns = []string{`#\d+`, `(?i)\bGH-\d+\b`, `\b[A-Z][A-Z0-9]+-\d+\b`}
+
+// DefaultLinkedIssueExemptTypes are the commit types that need no linked issue
+// unless configured otherwise.
+var DefaultLinkedIssueExemptTypes = []string{"chore", "docs"}
+
+// LinkedIssuePolicy requires every commit in a release to reference an issue.
+type LinkedIssuePolicy struct {
+	// Strict makes unlinked commits require review instead of only warning.
+	Strict bool
+
+	exemptTypes []string
+	patterns    []*regexp.Regexp
+}
+
+// NewLinkedIssuePolicy creates a linked issue policy. Nil exemptTypes or
+// patterns use the defaults.
+func NewLinkedIssuePolicy(strict bool, exemptTypes, patterns []string) (*LinkedIssuePolicy, error) {
+	if exemptTypes == nil {
+		exemptTypes = DefaultLinkedIssueExemptTypes
+	}
+	if len(patterns) == 0 {
+		patterns = DefaultIssuePatterns
+	}
+
+	p := &LinkedIssuePolicy{Strict: strict, exemptTypes: exemptTypes}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue pattern %q: %w", pattern, err)
+		}
+		p.patterns = append(p.patterns, re)
+	}
+	return p, nil
+}
+
+// UnlinkedCommit is a commit that does not reference an issue.
+type UnlinkedCommit struct {
+	Hash    string `json:"hash"`
+	Type    string `json:"type"`
+	Subject string `json:"subject"`
+}
+
+// Check returns the commits that reference no issue, skipping exempt types.
+func (p *LinkedIssuePolicy) Check(commits []*changes.ConventionalCommit) []UnlinkedCommit {
+	var unlinked []UnlinkedCommit
+	for _, c := range commits {
+		if c == nil || slices.Contains(p.exemptTypes, string(c.Type())) {
+			continue
+		}
+		text := strings.Join([]string{c.Subject(), c.Body(), c.Footer(), c.RawMessage()}, "\n")
+		if p.references(text) {
+			continue
+		}
+		unlinked = append(unlinked, UnlinkedCommit{
+			Hash:    c.ShortHash(),
+			Type:    string(c.Type()),
+			Subject: c.Subject(),
+		})
+	}
+	return unlinked
+}
+
+// references reports whether message references an issue.
+func (p *LinkedIssuePolicy) references(message string) bool {
+	for _, re := range p.patterns {
+		if re.MatchString(message) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply records unlinked commits on the evaluation output. Strict policies
+// require a human review; otherwise the commits are reported as a warning.
+func (p *LinkedIssuePolicy) apply(output *EvaluateReleaseOutput, commits []*changes.ConventionalCommit) {
+	output.UnlinkedCommits = p.Check(commits)
+	if len(output.UnlinkedCommits) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("%d commits do not reference an issue", len(output.UnlinkedCommits))
+	if !p.Strict {
+		output.Warnings = append(output.Warnings, message)
+		return
+	}
+
+	if output.Decision == cgp.DecisionApproved {
+		output.Decision = cgp.DecisionApprovalRequired
+	}
+	output.CanAutoApprove = false
+	output.Rationale = append(output.Rationale, message+" - human review required")
+	output.RequiredActions = append(output.RequiredActions, cgp.RequiredAction{
+		Type:        "human_approval",
+		Description: "Review commits without a linked issue",
+	})
+}
@@ -0,0 +1,124 @@
+package governance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/evaluator"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func linkedIssueTestCommits() []*changes.ConventionalCommit {
+	return []*changes.ConventionalCommit{
+		changes.NewConventionalCommit("a1", changes.CommitTypeFeat, "add export (#42)"),
+		changes.NewConventionalCommit("b2", changes.CommitTypeFix, "handle empty input", changes.WithFooter("Refs: PROJ-7")),
+		changes.NewConventionalCommit("c3", changes.CommitTypeFix, "fix crash", changes.WithBody("Reported in GH-9")),
+		changes.NewConventionalCommit("d4", changes.CommitTypeFeat, "add import"),
+		changes.NewConventionalCommit("e5", changes.CommitTypeChore, "bump deps"),
+		changes.NewConventionalCommit("f6", changes.CommitTypeDocs, "update readme"),
+	}
+}
+
+func TestLinkedIssuePolicy_Check(t *testing.T) {
+	p, err := NewLinkedIssuePolicy(false, nil, nil)
+	if err != nil {
+		t.Fatalf("NewLinkedIssuePolicy() error = %v", err)
+	}
+
+	unlinked := p.Check(linkedIssueTestCommits())
+	if len(unlinked) != 1 {
+		t.Fatalf("Check() returned %d commits, want 1: %+v", len(unlinked), unlinked)
+	}
+	if unlinked[0].Hash != "d4" || unlinked[0].Type != "feat" || unlinked[0].Subject != "add import" {
+		t.Errorf("unlinked commit = %+v, want d4 feat: add import", unlinked[0])
+	}
+
+	// An empty exempt list checks every commit type
+	p, err = NewLinkedIssuePolicy(false, []string{}, nil)
+	if err != nil {
+		t.Fatalf("NewLinkedIssuePolicy() error = %v", err)
+	}
+	if got := len(p.Check(linkedIssueTestCommits())); got != 3 {
+		t.Errorf("Check() without exemptions returned %d commits, want 3", got)
+	}
+
+	// Custom patterns replace the defaults
+	p, err = NewLinkedIssuePolicy(false, nil, []string{`TICKET-\d+`})
+	if err != nil {
+		t.Fatalf("NewLinkedIssuePolicy() error = %v", err)
+	}
+	commits := []*changes.ConventionalCommit{
+		changes.NewConventionalCommit("a1", changes.CommitTypeFeat, "add export TICKET-1"),
+		changes.NewConventionalCommit("b2", changes.CommitTypeFeat, "add import (#42)"),
+	}
+	if unlinked := p.Check(commits); len(unlinked) != 1 || unlinked[0].Hash != "b2" {
+		t.Errorf("Check() with custom pattern = %+v, want only b2", unlinked)
+	}
+}
+
+func TestNewLinkedIssuePolicy_InvalidPattern(t *testing.T) {
+	if _, err := NewLinkedIssuePolicy(false, nil, []string{`(`}); err == nil {
+		t.Error("expected error for invalid issue pattern")
+	}
+}
+
+func TestService_EvaluateRelease_LinkedIssue(t *testing.T) {
+	rel := release.NewReleaseRunForTest("release-linked", "main", "owner/repo")
+	changeSet := changes.NewChangeSet("cs-linked", "v1.0.0", "HEAD")
+	for _, c := range linkedIssueTestCommits() {
+		changeSet.AddCommit(c)
+	}
+	current, _ := version.Parse("1.0.0")
+	next, _ := version.Parse("1.1.0")
+	_ = release.SetPlan(rel, release.NewReleasePlan(current, next, changes.ReleaseTypeMinor, changeSet, false))
+
+	input := EvaluateReleaseInput{
+		Release:    rel,
+		Actor:      cgp.NewHumanActor("dev", "Dev"),
+		Repository: "owner/repo",
+	}
+	newService := func(strict bool) *Service {
+		policy, err := NewLinkedIssuePolicy(strict, nil, nil)
+		if err != nil {
+			t.Fatalf("NewLinkedIssuePolicy() error = %v", err)
+		}
+		eval := evaluator.New(evaluator.WithConfig(evaluator.Config{DefaultDecision: cgp.DecisionApproved, AutoApproveThreshold: 1, MaxAutoApproveRisk: 1}))
+		return NewService(eval, WithLinkedIssuePolicy(policy))
+	}
+
+	t.Run("warns when not strict", func(t *testing.T) {
+		output, err := newService(false).EvaluateRelease(context.Background(), input)
+		if err != nil {
+			t.Fatalf("EvaluateRelease() error = %v", err)
+		}
+		if len(output.UnlinkedCommits) != 1 {
+			t.Errorf("UnlinkedCommits = %+v, want 1 commit", output.UnlinkedCommits)
+		}
+		if len(output.Warnings) != 1 {
+			t.Errorf("Warnings = %v, want 1 warning", output.Warnings)
+		}
+		if output.Decision != cgp.DecisionApproved {
+			t.Errorf("Decision = %s, want approved", output.Decision)
+		}
+	})
+
+	t.Run("requires review when strict", func(t *testing.T) {
+		output, err := newService(true).EvaluateRelease(context.Background(), input)
+		if err != nil {
+			t.Fatalf("EvaluateRelease() error = %v", err)
+		}
+		if output.Decision != cgp.DecisionApprovalRequired || output.CanAutoApprove {
+			t.Errorf("Decision = %s, CanAutoApprove = %v; want approval_required, false", output.Decision, output.CanAutoApprove)
+		}
+		var found bool
+		for _, a := range output.RequiredActions {
+			found = found || a.Description == "Review commits without a linked issue"
+		}
+		if !found {
+			t.Errorf("RequiredActions = %+v, want linked issue review", output.RequiredActions)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/advisory"
 	"github.com/relicta-tech/relicta/internal/cgp/evaluator"
 	"github.com/relicta-tech/relicta/internal/cgp/memory"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
@@ -28,9 +29,11 @@ const (
 
 // Service provides CGP governance evaluation for release workflows.
 type Service struct {
-	evaluator   *evaluator.Evaluator
-	memoryStore memory.Store
-	logger      *slog.Logger
+	evaluator    *evaluator.Evaluator
+	memoryStore  memory.Store
+	linkedIssues *LinkedIssuePolicy
+	manifests    advisory.FileReader
+	logger       *slog.Logger
 }
 
 // ServiceOption configures a governance Service.
@@ -50,6 +53,21 @@ func WithMemoryStore(store memory.Store) ServiceOption {
 	}
 }
 
+// WithManifestReader enables scanning the dependency manifests changed by a
+// release for dependency updates, which are checked for known advisories.
+func WithManifestReader(reader advisory.FileReader) ServiceOption {
+	return func(s *Service) {
+		s.manifests = reader
+	}
+}
+
+// WithLinkedIssuePolicy requires commits to reference an issue.
+func WithLinkedIssuePolicy(policy *LinkedIssuePolicy) ServiceOption {
+	return func(s *Service) {
+		s.linkedIssues = policy
+	}
+}
+
 // NewService creates a new governance service.
 func NewService(eval *evaluator.Evaluator, opts ...ServiceOption) *Service {
 	s := &Service{
@@ -107,6 +125,13 @@ type EvaluateReleaseOutput struct {
 
 	// HistoricalContext provides historical analysis if available.
 	HistoricalContext *HistoricalContext
+
+	// UnlinkedCommits lists commits that reference no issue when
+	// governance.require_linked_issue is enabled.
+	UnlinkedCommits []UnlinkedCommit
+
+	// Warnings are non-blocking findings.
+	Warnings []string
 }
 
 // HistoricalContext provides historical analysis for a release.
@@ -138,6 +163,7 @@ func (s *Service) EvaluateRelease(ctx context.Context, input EvaluateReleaseInpu
 
 	// Build change proposal and analysis from release
 	proposal, analysis := s.buildProposalAndAnalysis(input)
+	s.addDependencyUpdates(ctx, input, analysis)
 
 	// Evaluate the proposal
 	result, err := s.evaluator.Evaluate(ctx, proposal, analysis)
@@ -156,6 +182,12 @@ func (s *Service) EvaluateRelease(ctx context.Context, input EvaluateReleaseInpu
 		CanAutoApprove:  result.Decision.Decision == cgp.DecisionApproved,
 	}
 
+	if s.linkedIssues != nil {
+		if plan := release.GetPlan(input.Release); plan != nil && plan.HasChangeSet() {
+			s.linkedIssues.apply(output, plan.GetChangeSet().Commits())
+		}
+	}
+
 	// Add historical context if requested and available
 	if input.IncludeHistory && s.memoryStore != nil {
 		historicalCtx, err := s.getHistoricalContext(ctx, input)
@@ -238,6 +270,30 @@ func (s *Service) buildProposalAndAnalysis(input EvaluateReleaseInput) (*cgp.Cha
 	return proposal, analysis
 }
 
+// addDependencyUpdates records the dependency updates found in the release's
+// commit range. Scan failures are logged and do not fail the evaluation.
+func (s *Service) addDependencyUpdates(ctx context.Context, input EvaluateReleaseInput, analysis *cgp.ChangeAnalysis) {
+	if s.manifests == nil || analysis == nil {
+		return
+	}
+	plan := release.GetPlan(input.Release)
+	if plan == nil || !plan.HasChangeSet() {
+		return
+	}
+
+	cs := plan.GetChangeSet()
+	toRef := cs.ToRef()
+	if toRef == "" {
+		toRef = "HEAD"
+	}
+	updates, err := advisory.ScanManifests(ctx, s.manifests, cs.FromRef(), toRef)
+	if err != nil {
+		s.logger.Warn("failed to scan dependency manifests", "error", err)
+		return
+	}
+	analysis.DependencyUpdates = updates
+}
+
 // securityScopes are commit scopes that indicate security-related changes.
 var securityScopes = []string{
 	"security", "auth", "authentication", "authorization",
@@ -0,0 +1,251 @@
+package monorepo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/application/blast"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+)
+
+// RootPackagePath is the package path used for the repository root package.
+const RootPackagePath = "."
+
+// AffectedConfig configures affected package detection.
+type AffectedConfig struct {
+	// PackagePaths is a list of glob patterns for package locations (e.g. "packages/*").
+	PackagePaths []string
+	// ExcludePaths is a list of glob patterns for paths that are never packages.
+	ExcludePaths []string
+	// SharedDirs lists directories containing code shared by all packages.
+	// A change in a shared directory marks every package as affected.
+	SharedDirs []string
+	// RootPackage indicates if the repository root is also a package.
+	// Files not owned by any other package are attributed to the root package.
+	RootPackage bool
+}
+
+// AffectedPackage describes a package touched by a set of changes.
+type AffectedPackage struct {
+	// Path is the package path relative to the repository root.
+	Path string `json:"path"`
+	// Files lists the changed files owned by the package.
+	Files []string `json:"files,omitempty"`
+	// SharedChanges lists changed files in shared directories that affect the package.
+	SharedChanges []string `json:"shared_changes,omitempty"`
+}
+
+// DiffStatsProvider provides per-commit file changes.
+type DiffStatsProvider interface {
+	GetCommitDiffStats(ctx context.Context, hash sourcecontrol.CommitHash) (*sourcecontrol.DiffStats, error)
+}
+
+// DiscoverPackages returns the package paths under repoRoot that the blast
+// radius service discovers in the configured package globs, excluding any
+// that match ExcludePaths. Paths are slash-separated, relative to repoRoot
+// and sorted.
+func DiscoverPackages(ctx context.Context, repoRoot string, cfg AffectedConfig) ([]string, error) {
+	for _, pattern := range cfg.PackagePaths {
+		if _, err := filepath.Match(filepath.FromSlash(pattern), ""); err != nil {
+			return nil, fmt.Errorf("invalid package path pattern %q: %w", pattern, err)
+		}
+	}
+
+	svc := blast.NewService(blast.WithRepoPath(repoRoot))
+	found, err := svc.DiscoverPackages(ctx, &blast.AnalysisOptions{
+		MonorepoConfig: &blast.MonorepoConfig{PackagePaths: cfg.PackagePaths},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover packages: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var packages []string
+	for _, pkg := range found {
+		rel := filepath.ToSlash(pkg.Path)
+		if seen[rel] || isExcluded(rel, cfg.ExcludePaths) {
+			continue
+		}
+		seen[rel] = true
+		packages = append(packages, rel)
+	}
+
+	if cfg.RootPackage && !seen[RootPackagePath] {
+		packages = append(packages, RootPackagePath)
+	}
+
+	slices.Sort(packages)
+	return packages, nil
+}
+
+// ChangedFiles returns the unique, sorted list of files changed by the given commits.
+func ChangedFiles(ctx context.Context, provider DiffStatsProvider, commits []*changes.ConventionalCommit) ([]string, error) {
+	seen := make(map[string]bool)
+	var files []string
+
+	add := func(p string) {
+		if p == "" || seen[p] {
+			return
+		}
+		seen[p] = true
+		files = append(files, p)
+	}
+
+	for _, c := range commits {
+		stats, err := provider.GetCommitDiffStats(ctx, sourcecontrol.CommitHash(c.Hash()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get changed files for commit %s: %w", c.ShortHash(), err)
+		}
+		if stats == nil {
+			continue
+		}
+		for _, f := range stats.Files {
+			add(f.Path)
+			add(f.OldPath)
+		}
+	}
+
+	slices.Sort(files)
+	return files, nil
+}
+
+// DetectAffectedPackages maps the changed files to packages with the blast
+// radius service and returns the affected packages sorted by path.
+//
+// A file affects every package whose path contains it. Files in excluded
+// paths are ignored. The root package owns the files no other package
+// contains, except files in a shared directory: those mark every package as
+// affected, since shared code is assumed to be a dependency of all packages.
+func DetectAffectedPackages(ctx context.Context, files []string, packages []string, cfg AffectedConfig) ([]AffectedPackage, error) {
+	var changed []blast.ChangedFile
+	for _, file := range files {
+		file = path.Clean(filepath.ToSlash(file))
+		if isExcluded(file, cfg.ExcludePaths) {
+			continue
+		}
+		changed = append(changed, blast.ChangedFile{Path: file})
+	}
+
+	hasRoot := false
+	candidates := make([]*blast.Package, 0, len(packages))
+	for _, pkg := range packages {
+		if pkg == RootPackagePath {
+			hasRoot = true
+			continue
+		}
+		candidates = append(candidates, &blast.Package{Name: path.Base(pkg), Path: pkg})
+	}
+
+	impacts, err := blast.NewService().GetImpactedPackages(ctx, changed, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect affected packages: %w", err)
+	}
+
+	affected := make(map[string]*AffectedPackage)
+	get := func(pkg string) *AffectedPackage {
+		if a, ok := affected[pkg]; ok {
+			return a
+		}
+		a := &AffectedPackage{Path: pkg}
+		affected[pkg] = a
+		return a
+	}
+
+	owned := make(map[string]bool)
+	for _, impact := range impacts {
+		a := get(impact.Package.Path)
+		for _, f := range impact.DirectChanges {
+			a.Files = append(a.Files, f.Path)
+			owned[f.Path] = true
+		}
+	}
+
+	for _, f := range changed {
+		if owned[f.Path] {
+			continue
+		}
+		if isInSharedDir(f.Path, cfg.SharedDirs) {
+			for _, pkg := range packages {
+				a := get(pkg)
+				a.SharedChanges = append(a.SharedChanges, f.Path)
+			}
+			continue
+		}
+		if hasRoot {
+			a := get(RootPackagePath)
+			a.Files = append(a.Files, f.Path)
+		}
+	}
+
+	result := make([]AffectedPackage, 0, len(affected))
+	for _, a := range affected {
+		result = append(result, *a)
+	}
+	slices.SortFunc(result, func(a, b AffectedPackage) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return result, nil
+}
+
+// PackagesToRelease returns the package paths that should be versioned for
+// the given strategy. In the independent strategy only affected packages are
+// released; lockstep and hybrid strategies release every package when any
+// package is affected.
+func PackagesToRelease(strategy string, packages []string, affected []AffectedPackage) []string {
+	if len(affected) == 0 {
+		return nil
+	}
+	if strategy != "" && strategy != "independent" {
+		return slices.Clone(packages)
+	}
+	result := make([]string, 0, len(affected))
+	for _, a := range affected {
+		result = append(result, a.Path)
+	}
+	return result
+}
+
+// isInSharedDir reports whether file is located in one of the shared directories.
+func isInSharedDir(file string, sharedDirs []string) bool {
+	for _, dir := range sharedDirs {
+		dir = strings.Trim(path.Clean(filepath.ToSlash(dir)), "/")
+		if dir == "" || dir == "." {
+			continue
+		}
+		if strings.HasPrefix(file, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isExcluded reports whether p or any of its parent directories matches an
+// exclude pattern. Patterns without a slash match any single path segment.
+func isExcluded(p string, excludePaths []string) bool {
+	segments := strings.Split(p, "/")
+	for _, pattern := range excludePaths {
+		pattern = strings.Trim(filepath.ToSlash(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+		if !strings.Contains(pattern, "/") {
+			for _, seg := range segments {
+				if ok, _ := path.Match(pattern, seg); ok {
+					return true
+				}
+			}
+			continue
+		}
+		for i := 1; i <= len(segments); i++ {
+			if ok, _ := path.Match(pattern, strings.Join(segments[:i], "/")); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package monorepo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+)
+
+type fakeDiffStatsProvider struct {
+	files map[string][]sourcecontrol.FileStats
+	err   error
+}
+
+func (f *fakeDiffStatsProvider) GetCommitDiffStats(_ context.Context, hash sourcecontrol.CommitHash) (*sourcecontrol.DiffStats, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sourcecontrol.DiffStats{Files: f.files[string(hash)]}, nil
+}
+
+func TestDiscoverPackages(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"packages/core", "packages/ui", "packages/internal-tools", "plugins/slack"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, dir, "main.go"), []byte("package main\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Directories without a manifest or source files are not packages
+	if err := os.MkdirAll(filepath.Join(root, "packages", "empty"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "packages", "README.md"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := AffectedConfig{
+		PackagePaths: []string{"packages/*", "plugins/*"},
+		ExcludePaths: []string{"packages/internal-*"},
+		RootPackage:  true,
+	}
+
+	got, err := DiscoverPackages(context.Background(), root, cfg)
+	if err != nil {
+		t.Fatalf("DiscoverPackages() error = %v", err)
+	}
+	want := []string{".", "packages/core", "packages/ui", "plugins/slack"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiscoverPackages() = %v, want %v", got, want)
+	}
+
+	if _, err := DiscoverPackages(context.Background(), root, AffectedConfig{PackagePaths: []string{"packages/["}}); err == nil {
+		t.Error("expected error for an invalid package path pattern")
+	}
+}
+
+func TestDetectAffectedPackages(t *testing.T) {
+	packages := []string{"packages/core", "packages/ui", "plugins/slack"}
+
+	tests := []struct {
+		name  string
+		files []string
+		cfg   AffectedConfig
+		want  []AffectedPackage
+	}{
+		{
+			name:  "maps files to owning packages",
+			files: []string{"packages/core/main.go", "packages/core/util.go", "plugins/slack/slack.go", "README.md"},
+			want: []AffectedPackage{
+				{Path: "packages/core", Files: []string{"packages/core/main.go", "packages/core/util.go"}},
+				{Path: "plugins/slack", Files: []string{"plugins/slack/slack.go"}},
+			},
+		},
+		{
+			name:  "excluded paths are ignored",
+			files: []string{"packages/ui/node_modules/dep/index.js", "packages/ui/dist/out.js"},
+			cfg:   AffectedConfig{ExcludePaths: []string{"node_modules", "packages/*/dist"}},
+			want:  []AffectedPackage{},
+		},
+		{
+			name:  "shared dir marks all packages affected",
+			files: []string{"shared/log/log.go", "packages/ui/app.tsx"},
+			cfg:   AffectedConfig{SharedDirs: []string{"shared"}},
+			want: []AffectedPackage{
+				{Path: "packages/core", SharedChanges: []string{"shared/log/log.go"}},
+				{Path: "packages/ui", Files: []string{"packages/ui/app.tsx"}, SharedChanges: []string{"shared/log/log.go"}},
+				{Path: "plugins/slack", SharedChanges: []string{"shared/log/log.go"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectAffectedPackages(context.Background(), tt.files, packages, tt.cfg)
+			if err != nil {
+				t.Fatalf("DetectAffectedPackages() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectAffectedPackages() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectAffectedPackages_RootPackage(t *testing.T) {
+	packages := []string{".", "packages/core"}
+	cfg := AffectedConfig{SharedDirs: []string{"shared"}, RootPackage: true}
+
+	got, err := DetectAffectedPackages(context.Background(), []string{"go.mod", "packages/core/a.go"}, packages, cfg)
+	if err != nil {
+		t.Fatalf("DetectAffectedPackages() error = %v", err)
+	}
+	want := []AffectedPackage{
+		{Path: ".", Files: []string{"go.mod"}},
+		{Path: "packages/core", Files: []string{"packages/core/a.go"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectAffectedPackages() = %+v, want %+v", got, want)
+	}
+
+	got, err = DetectAffectedPackages(context.Background(), []string{"shared/x.go"}, packages, cfg)
+	if err != nil {
+		t.Fatalf("DetectAffectedPackages() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("shared change should affect every package including root, got %+v", got)
+	}
+}
+
+func TestPackagesToRelease(t *testing.T) {
+	packages := []string{"a", "b", "c"}
+	affected := []AffectedPackage{{Path: "b"}}
+
+	if got := PackagesToRelease("independent", packages, affected); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("independent = %v, want [b]", got)
+	}
+	if got := PackagesToRelease("lockstep", packages, affected); !reflect.DeepEqual(got, packages) {
+		t.Errorf("lockstep = %v, want %v", got, packages)
+	}
+	if got := PackagesToRelease("lockstep", packages, nil); got != nil {
+		t.Errorf("no affected packages should release nothing, got %v", got)
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	provider := &fakeDiffStatsProvider{files: map[string][]sourcecontrol.FileStats{
+		"aaa1111": {{Path: "packages/core/a.go"}, {Path: "packages/ui/new.ts", OldPath: "packages/core/old.ts"}},
+		"bbb2222": {{Path: "packages/core/a.go"}},
+	}}
+	commits := []*changes.ConventionalCommit{
+		changes.NewConventionalCommit("aaa1111", changes.CommitTypeFeat, "one"),
+		changes.NewConventionalCommit("bbb2222", changes.CommitTypeFix, "two"),
+	}
+
+	got, err := ChangedFiles(context.Background(), provider, commits)
+	if err != nil {
+		t.Fatalf("ChangedFiles() error = %v", err)
+	}
+	want := []string{"packages/core/a.go", "packages/core/old.ts", "packages/ui/new.ts"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles() = %v, want %v", got, want)
+	}
+
+	provider.err = errors.New("boom")
+	if _, err := ChangedFiles(context.Background(), provider, commits); err == nil {
+		t.Error("expected error from provider")
+	}
+}
@@ -0,0 +1,224 @@
+package monorepo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+// DefaultAnalysisCacheDir is the directory, relative to the repository root,
+// where commit attributions are cached.
+const DefaultAnalysisCacheDir = ".relicta/cache/monorepo"
+
+// attributionSchemaVersion is part of every cache key; bump it when the
+// cached format or the attribution rules change.
+const attributionSchemaVersion = "1"
+
+// maxAttributionCacheSize is the maximum size of a cached attribution file.
+const maxAttributionCacheSize = 64 * 1024 * 1024
+
+// AnalysisOptions configures commit attribution.
+type AnalysisOptions struct {
+	// Concurrency bounds the number of commits analyzed in parallel.
+	// Zero or less uses the number of CPUs.
+	Concurrency int
+	// CacheDir caches attributions keyed by the commit range, packages and
+	// affected configuration. Empty disables caching.
+	CacheDir string
+}
+
+// CommitAttribution lists the files a commit changed and the packages they
+// belong to.
+type CommitAttribution struct {
+	// Hash is the full commit hash.
+	Hash string `json:"hash"`
+	// Files lists the changed paths, including the old paths of renames.
+	Files []string `json:"files,omitempty"`
+	// Packages lists the affected packages, sorted.
+	Packages []string `json:"packages,omitempty"`
+}
+
+// Attribution maps the commits of a range to the packages they affect. It
+// is computed once per range and shared by every package analysis, so each
+// commit is diffed only once. Attribution implements DiffStatsProvider for
+// the commits it covers.
+type Attribution struct {
+	// Commits lists the attribution of each commit, in commit order.
+	Commits []CommitAttribution `json:"commits"`
+
+	byHash map[string]*CommitAttribution
+}
+
+// AnalyzeCommits attributes each commit's changed files to packages. Commits
+// are diffed in parallel, bounded by opts.Concurrency; the result does not
+// depend on the concurrency. When opts.CacheDir is set, a cached attribution
+// for the same commits, packages and configuration is reused.
+func AnalyzeCommits(ctx context.Context, provider DiffStatsProvider, commits []*changes.ConventionalCommit, packages []string, cfg AffectedConfig, opts AnalysisOptions) (*Attribution, error) {
+	key := attributionKey(commits, packages, cfg)
+	if opts.CacheDir != "" {
+		if cached, ok := loadAttribution(opts.CacheDir, key); ok {
+			return cached, nil
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	result := make([]CommitAttribution, len(commits))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for i, c := range commits {
+		g.Go(func() error {
+			stats, err := provider.GetCommitDiffStats(gCtx, sourcecontrol.CommitHash(c.Hash()))
+			if err != nil {
+				return fmt.Errorf("failed to get changed files for commit %s: %w", c.ShortHash(), err)
+			}
+			attr := CommitAttribution{Hash: c.Hash()}
+			if stats != nil {
+				for _, f := range stats.Files {
+					attr.Files = append(attr.Files, f.Path)
+					if f.OldPath != "" {
+						attr.Files = append(attr.Files, f.OldPath)
+					}
+				}
+			}
+			affected, err := DetectAffectedPackages(gCtx, attr.Files, packages, cfg)
+			if err != nil {
+				return err
+			}
+			for _, a := range affected {
+				attr.Packages = append(attr.Packages, a.Path)
+			}
+			result[i] = attr
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	attribution := newAttribution(result)
+	if opts.CacheDir != "" {
+		// A cache that cannot be written only costs a re-analysis next time
+		_ = storeAttribution(opts.CacheDir, key, attribution)
+	}
+	return attribution, nil
+}
+
+// newAttribution indexes the commit attributions by hash.
+func newAttribution(commits []CommitAttribution) *Attribution {
+	a := &Attribution{Commits: commits, byHash: make(map[string]*CommitAttribution, len(commits))}
+	for i := range a.Commits {
+		a.byHash[a.Commits[i].Hash] = &a.Commits[i]
+	}
+	return a
+}
+
+// GetCommitDiffStats returns the changed files of an attributed commit. Only
+// paths are available; line counts are not recorded.
+func (a *Attribution) GetCommitDiffStats(_ context.Context, hash sourcecontrol.CommitHash) (*sourcecontrol.DiffStats, error) {
+	attr, ok := a.byHash[string(hash)]
+	if !ok {
+		return nil, fmt.Errorf("commit %s is not part of the analyzed range", hash)
+	}
+	files := make([]sourcecontrol.FileStats, 0, len(attr.Files))
+	for _, f := range attr.Files {
+		files = append(files, sourcecontrol.FileStats{Path: f})
+	}
+	return &sourcecontrol.DiffStats{Files: files, FilesChanged: len(files)}, nil
+}
+
+// ChangedFiles returns the unique, sorted list of files changed in the range.
+func (a *Attribution) ChangedFiles() []string {
+	seen := make(map[string]bool)
+	var files []string
+	for _, c := range a.Commits {
+		for _, f := range c.Files {
+			if f != "" && !seen[f] {
+				seen[f] = true
+				files = append(files, f)
+			}
+		}
+	}
+	slices.Sort(files)
+	return files
+}
+
+// CommitsByPackage maps each package to the given commits that affect it,
+// in commit order. Commits outside the analyzed range are ignored.
+func (a *Attribution) CommitsByPackage(commits []*changes.ConventionalCommit) map[string][]*changes.ConventionalCommit {
+	byPackage := make(map[string][]*changes.ConventionalCommit)
+	for _, c := range commits {
+		attr, ok := a.byHash[c.Hash()]
+		if !ok {
+			continue
+		}
+		for _, pkg := range attr.Packages {
+			byPackage[pkg] = append(byPackage[pkg], c)
+		}
+	}
+	return byPackage
+}
+
+// attributionKey identifies an attribution by the commits, packages and
+// affected configuration it was computed from.
+func attributionKey(commits []*changes.ConventionalCommit, packages []string, cfg AffectedConfig) string {
+	h := sha256.New()
+	write := func(field string, values ...string) {
+		fmt.Fprintf(h, "%s:%d\n", field, len(values))
+		for _, v := range values {
+			fmt.Fprintf(h, "%s\n", v)
+		}
+	}
+	write("schema", attributionSchemaVersion)
+	hashes := make([]string, len(commits))
+	for i, c := range commits {
+		hashes[i] = c.Hash()
+	}
+	write("commits", hashes...)
+	write("packages", packages...)
+	write("exclude", cfg.ExcludePaths...)
+	write("shared", cfg.SharedDirs...)
+	write("root", fmt.Sprint(cfg.RootPackage))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// loadAttribution reads a cached attribution. Missing or unreadable entries
+// are cache misses.
+func loadAttribution(dir, key string) (*Attribution, bool) {
+	data, err := fileutil.ReadFileLimited(filepath.Join(dir, key+".json"), maxAttributionCacheSize)
+	if err != nil {
+		return nil, false
+	}
+	var cached Attribution
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+	return newAttribution(cached.Commits), true
+}
+
+// storeAttribution writes an attribution to the cache.
+func storeAttribution(dir, key string, a *Attribution) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return fileutil.AtomicWriteFile(filepath.Join(dir, key+".json"), data, 0600)
+}
@@ -0,0 +1,210 @@
+package monorepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+)
+
+// slowDiffStatsProvider serves diff stats with a per-commit delay so that
+// parallel requests complete out of order. It is safe for concurrent use.
+type slowDiffStatsProvider struct {
+	files map[string][]sourcecontrol.FileStats
+	delay func(hash string) time.Duration
+	calls atomic.Int32
+}
+
+func (p *slowDiffStatsProvider) GetCommitDiffStats(ctx context.Context, hash sourcecontrol.CommitHash) (*sourcecontrol.DiffStats, error) {
+	p.calls.Add(1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if p.delay != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(p.delay(string(hash))):
+		}
+	}
+	files, ok := p.files[string(hash)]
+	if !ok {
+		return nil, fmt.Errorf("unknown commit %s", hash)
+	}
+	return &sourcecontrol.DiffStats{Files: files}, nil
+}
+
+// multiPackageLayout returns packages and commits touching them, shared
+// directories and the root package.
+func multiPackageLayout(packageCount, commitCount int) ([]string, []*changes.ConventionalCommit, *slowDiffStatsProvider) {
+	packages := []string{RootPackagePath}
+	for i := range packageCount {
+		packages = append(packages, fmt.Sprintf("packages/pkg%02d", i))
+	}
+
+	provider := &slowDiffStatsProvider{files: make(map[string][]sourcecontrol.FileStats)}
+	commits := make([]*changes.ConventionalCommit, 0, commitCount)
+	for i := range commitCount {
+		hash := fmt.Sprintf("%040x", i+1)
+		files := []sourcecontrol.FileStats{
+			{Path: fmt.Sprintf("packages/pkg%02d/file%d.go", i%packageCount, i)},
+			{Path: fmt.Sprintf("packages/pkg%02d/moved.go", (i*7)%packageCount), OldPath: fmt.Sprintf("packages/pkg%02d/old.go", (i*3)%packageCount)},
+		}
+		switch i % 10 {
+		case 3:
+			files = append(files, sourcecontrol.FileStats{Path: "shared/util.go"})
+		case 5:
+			files = append(files, sourcecontrol.FileStats{Path: "README.md"})
+		}
+		provider.files[hash] = files
+		commits = append(commits, changes.NewConventionalCommit(hash, changes.CommitTypeFeat, fmt.Sprintf("change %d", i)))
+	}
+	provider.delay = func(hash string) time.Duration {
+		return time.Duration(hash[len(hash)-1]%7) * 100 * time.Microsecond
+	}
+	return packages, commits, provider
+}
+
+func TestAnalyzeCommits_ConcurrencyIsDeterministic(t *testing.T) {
+	packages, commits, provider := multiPackageLayout(12, 120)
+	cfg := AffectedConfig{SharedDirs: []string{"shared"}, RootPackage: true}
+
+	serial, err := AnalyzeCommits(context.Background(), provider, commits, packages, cfg, AnalysisOptions{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("AnalyzeCommits(concurrency 1) error = %v", err)
+	}
+
+	for _, concurrency := range []int{4, 16, 0} {
+		parallel, err := AnalyzeCommits(context.Background(), provider, commits, packages, cfg, AnalysisOptions{Concurrency: concurrency})
+		if err != nil {
+			t.Fatalf("AnalyzeCommits(concurrency %d) error = %v", concurrency, err)
+		}
+		if !reflect.DeepEqual(parallel.Commits, serial.Commits) {
+			t.Errorf("concurrency %d: commits differ from concurrency 1", concurrency)
+		}
+		if !reflect.DeepEqual(parallel.ChangedFiles(), serial.ChangedFiles()) {
+			t.Errorf("concurrency %d: changed files differ from concurrency 1", concurrency)
+		}
+		if !reflect.DeepEqual(parallel.CommitsByPackage(commits), serial.CommitsByPackage(commits)) {
+			t.Errorf("concurrency %d: package commits differ from concurrency 1", concurrency)
+		}
+	}
+
+	// Attribution agrees with diffing the range directly
+	files, err := ChangedFiles(context.Background(), provider, commits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(serial.ChangedFiles(), files) {
+		t.Errorf("ChangedFiles() = %v, want %v", serial.ChangedFiles(), files)
+	}
+}
+
+func TestAnalyzeCommits_SharesDiffs(t *testing.T) {
+	packages, commits, provider := multiPackageLayout(4, 20)
+	provider.delay = nil
+	cfg := AffectedConfig{}
+
+	attribution, err := AnalyzeCommits(context.Background(), provider, commits, packages, cfg, AnalysisOptions{Concurrency: 4})
+	if err != nil {
+		t.Fatalf("AnalyzeCommits() error = %v", err)
+	}
+	if got := provider.calls.Load(); got != int32(len(commits)) {
+		t.Errorf("diffed %d times, want once per commit (%d)", got, len(commits))
+	}
+
+	// Group planning reads the shared attribution instead of re-diffing
+	groups := []GroupConfig{{Name: "all", Packages: []string{"packages/*"}, Strategy: GroupStrategyLockstep}}
+	fromAttribution, err := PlanGroups(context.Background(), attribution, groups, packages, commits, cfg)
+	if err != nil {
+		t.Fatalf("PlanGroups() error = %v", err)
+	}
+	if got := provider.calls.Load(); got != int32(len(commits)) {
+		t.Errorf("PlanGroups re-diffed commits: %d calls", got)
+	}
+	fromProvider, err := PlanGroups(context.Background(), provider, groups, packages, commits, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromAttribution, fromProvider) {
+		t.Errorf("PlanGroups from attribution = %+v, want %+v", fromAttribution, fromProvider)
+	}
+
+	if _, err := attribution.GetCommitDiffStats(context.Background(), "unknown"); err == nil {
+		t.Error("expected error for commit outside the range")
+	}
+}
+
+func TestAnalyzeCommits_Cache(t *testing.T) {
+	packages, commits, provider := multiPackageLayout(3, 10)
+	provider.delay = nil
+	opts := AnalysisOptions{Concurrency: 2, CacheDir: t.TempDir()}
+
+	first, err := AnalyzeCommits(context.Background(), provider, commits, packages, AffectedConfig{}, opts)
+	if err != nil {
+		t.Fatalf("AnalyzeCommits() error = %v", err)
+	}
+
+	// The same range is served from the cache without diffing
+	failing := &slowDiffStatsProvider{}
+	cached, err := AnalyzeCommits(context.Background(), failing, commits, packages, AffectedConfig{}, opts)
+	if err != nil {
+		t.Fatalf("cached AnalyzeCommits() error = %v", err)
+	}
+	if failing.calls.Load() != 0 {
+		t.Errorf("cache hit diffed %d commits", failing.calls.Load())
+	}
+	if !reflect.DeepEqual(cached.Commits, first.Commits) {
+		t.Error("cached attribution differs")
+	}
+	if !reflect.DeepEqual(cached.CommitsByPackage(commits), first.CommitsByPackage(commits)) {
+		t.Error("cached package commits differ")
+	}
+
+	// A different range or configuration is not served from the cache
+	if _, err := AnalyzeCommits(context.Background(), failing, commits[1:], packages, AffectedConfig{}, opts); err == nil {
+		t.Error("expected cache miss for a different commit range")
+	}
+	if _, err := AnalyzeCommits(context.Background(), failing, commits, packages, AffectedConfig{SharedDirs: []string{"shared"}}, opts); err == nil {
+		t.Error("expected cache miss for a different configuration")
+	}
+}
+
+func TestAnalyzeCommits_Error(t *testing.T) {
+	packages, commits, provider := multiPackageLayout(3, 10)
+	delete(provider.files, commits[4].Hash())
+
+	_, err := AnalyzeCommits(context.Background(), provider, commits, packages, AffectedConfig{}, AnalysisOptions{Concurrency: 3})
+	if err == nil {
+		t.Fatal("expected error from provider")
+	}
+
+	provider.files[commits[4].Hash()] = []sourcecontrol.FileStats{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := AnalyzeCommits(ctx, provider, commits, packages, AffectedConfig{}, AnalysisOptions{Concurrency: 3}); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled context error = %v, want context.Canceled", err)
+	}
+}
+
+func BenchmarkAnalyzeCommits(b *testing.B) {
+	packages, commits, provider := multiPackageLayout(50, 500)
+	provider.delay = func(string) time.Duration { return 50 * time.Microsecond }
+	cfg := AffectedConfig{SharedDirs: []string{"shared"}, RootPackage: true}
+
+	for _, concurrency := range []int{1, 8, 32} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := AnalyzeCommits(context.Background(), provider, commits, packages, cfg, AnalysisOptions{Concurrency: concurrency}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package monorepo
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/application/blast"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+)
+
+// CascadeConfig configures the release of dependents of breaking changes.
+type CascadeConfig struct {
+	// Bump is the minimum release type of a cascaded dependent.
+	Bump changes.ReleaseType
+	// MaxDepth limits how many dependency levels are followed from a
+	// breaking package; zero follows every level.
+	MaxDepth int
+}
+
+// CascadePackage is a package released because a package it depends on,
+// directly or transitively, has a breaking change.
+type CascadePackage struct {
+	// Path is the cascaded package.
+	Path string `json:"path"`
+	// Via is the breaking package the cascade started from.
+	Via string `json:"via"`
+	// Depth is the number of dependency edges from Via (1 for a direct dependent).
+	Depth int `json:"depth"`
+	// Bump is the minimum release type of the package.
+	Bump changes.ReleaseType `json:"bump"`
+}
+
+// Cascade is the set of dependents released by breaking changes.
+type Cascade struct {
+	// Breaking lists the packages with breaking changes.
+	Breaking []string `json:"breaking"`
+	// Packages lists the cascaded dependents, sorted by path.
+	Packages []CascadePackage `json:"packages"`
+	// Truncated lists dependents beyond the maximum depth that were not
+	// included in the release.
+	Truncated []string `json:"truncated,omitempty"`
+}
+
+// Paths returns the paths of the cascaded packages.
+func (c *Cascade) Paths() []string {
+	if c == nil {
+		return nil
+	}
+	paths := make([]string, 0, len(c.Packages))
+	for _, p := range c.Packages {
+		paths = append(paths, p.Path)
+	}
+	return paths
+}
+
+// Include returns the packages to release extended with the cascaded
+// packages, sorted by path.
+func (c *Cascade) Include(release []string) []string {
+	if c == nil || len(c.Packages) == 0 {
+		return release
+	}
+	result := slices.Clone(release)
+	for _, pkg := range c.Paths() {
+		if !slices.Contains(result, pkg) {
+			result = append(result, pkg)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Dependents returns the reverse of the graph: each package mapped to the
+// packages that depend on it, sorted by path.
+func (g DependencyGraph) Dependents() map[string][]string {
+	dependents := make(map[string][]string, len(g))
+	for pkg, deps := range g {
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], pkg)
+		}
+	}
+	for _, pkgs := range dependents {
+		slices.Sort(pkgs)
+	}
+	return dependents
+}
+
+// BreakingPackages returns the packages with at least one breaking commit,
+// sorted by path. byPackage maps each package to its commits.
+func BreakingPackages(byPackage map[string][]*changes.ConventionalCommit) []string {
+	var breaking []string
+	for pkg, commits := range byPackage {
+		if slices.ContainsFunc(commits, (*changes.ConventionalCommit).IsBreaking) {
+			breaking = append(breaking, pkg)
+		}
+	}
+	slices.Sort(breaking)
+	return breaking
+}
+
+// PackageBumps returns the release type of each released package: the
+// highest release type of its commits, raised to at least its cascade bump.
+// byPackage maps each package to its commits.
+func PackageBumps(release []string, byPackage map[string][]*changes.ConventionalCommit, cascade *Cascade) map[string]changes.ReleaseType {
+	bumps := make(map[string]changes.ReleaseType, len(release))
+	for _, pkg := range release {
+		bump := changes.ReleaseTypeNone
+		for _, c := range byPackage[pkg] {
+			bump = changes.MaxReleaseType(bump, c.ReleaseType())
+		}
+		bumps[pkg] = bump
+	}
+	if cascade != nil {
+		for _, p := range cascade.Packages {
+			if bump, ok := bumps[p.Path]; ok {
+				bumps[p.Path] = changes.MaxReleaseType(bump, p.Bump)
+			}
+		}
+	}
+	return bumps
+}
+
+// PlanCascade returns the transitive dependents of the breaking packages.
+// Each dependent is reached by the shortest dependency path from any
+// breaking package; dependents further than cfg.MaxDepth levels away are
+// reported as truncated. Breaking packages are never cascaded themselves.
+func PlanCascade(graph DependencyGraph, breaking []string, cfg CascadeConfig) *Cascade {
+	bump := cfg.Bump
+	if bump == "" || bump == changes.ReleaseTypeNone {
+		bump = changes.ReleaseTypePatch
+	}
+
+	cascade := &Cascade{Breaking: slices.Sorted(slices.Values(breaking)), Packages: []CascadePackage{}}
+	dependents := graph.Dependents()
+
+	type visit struct {
+		pkg, via string
+		depth    int
+	}
+	seen := make(map[string]bool, len(breaking))
+	queue := make([]visit, 0, len(breaking))
+	for _, pkg := range cascade.Breaking {
+		seen[pkg] = true
+		queue = append(queue, visit{pkg: pkg, via: pkg})
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, dependent := range dependents[cur.pkg] {
+			if seen[dependent] {
+				continue
+			}
+			seen[dependent] = true
+			next := visit{pkg: dependent, via: cur.via, depth: cur.depth + 1}
+			if cfg.MaxDepth > 0 && next.depth > cfg.MaxDepth {
+				cascade.Truncated = append(cascade.Truncated, dependent)
+				continue
+			}
+			cascade.Packages = append(cascade.Packages, CascadePackage{
+				Path:  dependent,
+				Via:   next.via,
+				Depth: next.depth,
+				Bump:  bump,
+			})
+			queue = append(queue, next)
+		}
+	}
+
+	slices.SortFunc(cascade.Packages, func(a, b CascadePackage) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	slices.Sort(cascade.Truncated)
+	return cascade
+}
+
+// ApplyCascade includes the group's cascaded members in its release and
+// raises the group's bump to at least their bump. A lockstep group releases
+// every member when any member is cascaded.
+func (g *GroupPlan) ApplyCascade(cascade *Cascade) {
+	if cascade == nil {
+		return
+	}
+	for _, p := range cascade.Packages {
+		if !slices.Contains(g.Packages, p.Path) {
+			continue
+		}
+		g.Bump = changes.MaxReleaseType(g.Bump, p.Bump)
+		switch {
+		case g.IsLockstep():
+			g.Release = slices.Clone(g.Packages)
+		case !slices.Contains(g.Release, p.Path):
+			g.Release = append(g.Release, p.Path)
+			slices.Sort(g.Release)
+		}
+	}
+}
+
+// dependencyConstraintRes returns the expressions locating the version
+// constraint of dependency name in a manifest. The first group ends before
+// the version, keeping any range operator, and the second captures the
+// version itself.
+func dependencyConstraintRes(file, name string) []*regexp.Regexp {
+	quoted := regexp.QuoteMeta(name)
+	switch file {
+	case "package.json":
+		return []*regexp.Regexp{regexp.MustCompile(`("` + quoted + `"\s*:\s*"[~^>=<]*)(\d[^"]*)`)}
+	case "Cargo.toml":
+		return []*regexp.Regexp{
+			regexp.MustCompile(`(?m)(^` + quoted + `\s*=\s*"[~^>=<]*)(\d[^"]*)`),
+			regexp.MustCompile(`(?m)(^` + quoted + `\s*=\s*\{[^}\n]*\bversion\s*=\s*"[~^>=<]*)(\d[^"]*)`),
+		}
+	case "go.mod":
+		return []*regexp.Regexp{regexp.MustCompile(`(?m)(^(?:require\s+)?\s*` + quoted + `\s+v)(\S+)`)}
+	}
+	return nil
+}
+
+// UpdateDependencyConstraints rewrites the constraints pkg declares on its
+// internal dependencies to the given versions, keeping range operators such
+// as "^". versions maps dependency package paths to their new versions.
+// Constraints without a version (workspace or path dependencies) are left
+// unchanged. It returns the updated manifests relative to repoRoot.
+func UpdateDependencyConstraints(repoRoot, pkg string, versions map[string]string) ([]string, error) {
+	names := make(map[string]string)
+	for dep, ver := range versions {
+		found, err := blast.ReadManifests(filepath.Join(repoRoot, filepath.FromSlash(dep)))
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", dep, err)
+		}
+		for _, m := range found {
+			if m.Name != "" {
+				names[m.Name] = ver
+			}
+		}
+	}
+
+	var updated []string
+	for _, file := range []string{"package.json", "Cargo.toml", "go.mod"} {
+		filePath := filepath.Join(repoRoot, filepath.FromSlash(pkg), file)
+		data, err := os.ReadFile(filePath) // #nosec G304 -- path from package discovery
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return updated, fmt.Errorf("reading %s: %w", file, err)
+		}
+
+		content := data
+		for _, name := range slices.Sorted(maps.Keys(names)) {
+			for _, re := range dependencyConstraintRes(file, name) {
+				content = re.ReplaceAll(content, []byte("${1}"+names[name]))
+			}
+		}
+		if slices.Equal(content, data) {
+			continue
+		}
+
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return updated, err
+		}
+		if err := os.WriteFile(filePath, content, info.Mode().Perm()); err != nil {
+			return updated, fmt.Errorf("writing %s: %w", file, err)
+		}
+		updated = append(updated, path.Join(pkg, file))
+	}
+	return updated, nil
+}
@@ -0,0 +1,188 @@
+package monorepo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+)
+
+// chainGraph is a multi-level dependency chain: core <- api <- web <- e2e,
+// with tools also depending on core and docs depending on nothing.
+var chainGraph = DependencyGraph{
+	"core":  {},
+	"api":   {"core"},
+	"web":   {"api"},
+	"e2e":   {"web"},
+	"tools": {"core"},
+	"docs":  {},
+}
+
+func TestPlanCascade_MultiLevelChain(t *testing.T) {
+	cascade := PlanCascade(chainGraph, []string{"core"}, CascadeConfig{Bump: changes.ReleaseTypeMinor})
+
+	want := []CascadePackage{
+		{Path: "api", Via: "core", Depth: 1, Bump: changes.ReleaseTypeMinor},
+		{Path: "e2e", Via: "core", Depth: 3, Bump: changes.ReleaseTypeMinor},
+		{Path: "tools", Via: "core", Depth: 1, Bump: changes.ReleaseTypeMinor},
+		{Path: "web", Via: "core", Depth: 2, Bump: changes.ReleaseTypeMinor},
+	}
+	if !reflect.DeepEqual(cascade.Packages, want) {
+		t.Errorf("Packages = %+v, want %+v", cascade.Packages, want)
+	}
+	if len(cascade.Truncated) != 0 {
+		t.Errorf("Truncated = %v, want none", cascade.Truncated)
+	}
+
+	release := cascade.Include([]string{"core"})
+	if want := []string{"api", "core", "e2e", "tools", "web"}; !reflect.DeepEqual(release, want) {
+		t.Errorf("Include() = %v, want %v", release, want)
+	}
+}
+
+func TestPlanCascade_MaxDepth(t *testing.T) {
+	cascade := PlanCascade(chainGraph, []string{"core"}, CascadeConfig{MaxDepth: 1})
+
+	if got, want := cascade.Paths(), []string{"api", "tools"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Paths() = %v, want %v", got, want)
+	}
+	// Only the first level beyond the limit is reached by the walk.
+	if want := []string{"web"}; !reflect.DeepEqual(cascade.Truncated, want) {
+		t.Errorf("Truncated = %v, want %v", cascade.Truncated, want)
+	}
+	if cascade.Packages[0].Bump != changes.ReleaseTypePatch {
+		t.Errorf("default bump = %s, want patch", cascade.Packages[0].Bump)
+	}
+}
+
+func TestPlanCascade_BreakingDependentNotCascaded(t *testing.T) {
+	cascade := PlanCascade(chainGraph, []string{"web", "core"}, CascadeConfig{})
+
+	if got, want := cascade.Paths(), []string{"api", "e2e", "tools"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Paths() = %v, want %v", got, want)
+	}
+	if want := []string{"core", "web"}; !reflect.DeepEqual(cascade.Breaking, want) {
+		t.Errorf("Breaking = %v, want %v", cascade.Breaking, want)
+	}
+	for _, p := range cascade.Packages {
+		if p.Path == "e2e" && (p.Via != "web" || p.Depth != 1) {
+			t.Errorf("e2e should be reached directly from web, got %+v", p)
+		}
+	}
+}
+
+func TestBreakingPackages(t *testing.T) {
+	byPackage := map[string][]*changes.ConventionalCommit{
+		"core": {
+			changes.NewConventionalCommit("aaa1111", changes.CommitTypeFix, "typo"),
+			changes.NewConventionalCommit("bbb2222", changes.CommitTypeFeat, "new api", changes.WithBreaking("old api removed")),
+		},
+		"web": {changes.NewConventionalCommit("ccc3333", changes.CommitTypeFeat, "dark mode")},
+	}
+
+	if got, want := BreakingPackages(byPackage), []string{"core"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BreakingPackages() = %v, want %v", got, want)
+	}
+}
+
+func TestPackageBumps(t *testing.T) {
+	byPackage := map[string][]*changes.ConventionalCommit{
+		"core": {
+			changes.NewConventionalCommit("aaa1111", changes.CommitTypeFix, "typo"),
+			changes.NewConventionalCommit("bbb2222", changes.CommitTypeFeat, "new api", changes.WithBreaking("old api removed")),
+		},
+		"web":  {changes.NewConventionalCommit("ccc3333", changes.CommitTypeFix, "layout")},
+		"docs": {changes.NewConventionalCommit("ddd4444", changes.CommitTypeFeat, "guide")},
+	}
+	cascade := &Cascade{Packages: []CascadePackage{
+		{Path: "web", Via: "core", Depth: 1, Bump: changes.ReleaseTypeMinor},
+		{Path: "api", Via: "core", Depth: 1, Bump: changes.ReleaseTypeMinor},
+	}}
+
+	got := PackageBumps([]string{"api", "core", "web"}, byPackage, cascade)
+	want := map[string]changes.ReleaseType{
+		"api":  changes.ReleaseTypeMinor,
+		"core": changes.ReleaseTypeMajor,
+		"web":  changes.ReleaseTypeMinor,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PackageBumps() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupPlan_ApplyCascade(t *testing.T) {
+	cascade := &Cascade{Packages: []CascadePackage{{Path: "web", Via: "core", Depth: 1, Bump: changes.ReleaseTypeMinor}}}
+
+	lockstep := GroupPlan{Strategy: GroupStrategyLockstep, Packages: []string{"mobile", "web"}, Bump: changes.ReleaseTypeNone}
+	lockstep.ApplyCascade(cascade)
+	if !reflect.DeepEqual(lockstep.Release, []string{"mobile", "web"}) || lockstep.Bump != changes.ReleaseTypeMinor {
+		t.Errorf("lockstep group = %+v, want every member released with a minor bump", lockstep)
+	}
+
+	independent := GroupPlan{Strategy: GroupStrategyIndependent, Packages: []string{"mobile", "web"}, Bump: changes.ReleaseTypeMajor}
+	independent.ApplyCascade(cascade)
+	if !reflect.DeepEqual(independent.Release, []string{"web"}) || independent.Bump != changes.ReleaseTypeMajor {
+		t.Errorf("independent group = %+v, want only web released keeping the major bump", independent)
+	}
+
+	other := GroupPlan{Strategy: GroupStrategyLockstep, Packages: []string{"cli"}}
+	other.ApplyCascade(cascade)
+	if len(other.Release) != 0 {
+		t.Errorf("unrelated group released %v", other.Release)
+	}
+}
+
+func TestUpdateDependencyConstraints(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "packages/core/package.json"), `{"name": "@acme/core", "version": "2.0.0"}`)
+	writeFile(t, filepath.Join(root, "packages/web/package.json"), `{
+  "name": "@acme/web",
+  "dependencies": {
+    "@acme/core": "^1.4.0",
+    "lodash": "^4.0.0"
+  },
+  "devDependencies": {"@acme/testing": "workspace:*"}
+}`)
+	writeFile(t, filepath.Join(root, "crates/base/Cargo.toml"), "[package]\nname = \"base\"\nversion = \"2.0.0\"\n")
+	writeFile(t, filepath.Join(root, "crates/cli/Cargo.toml"), "[package]\nname = \"cli\"\n\n[dependencies]\nbase = { path = \"../base\", version = \"1.2\" }\nserde = \"1\"\n")
+	writeFile(t, filepath.Join(root, "go/lib/go.mod"), "module example.com/lib\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "go/app/go.mod"), "module example.com/app\n\ngo 1.24\n\nrequire (\n\texample.com/lib v1.0.0\n\tgithub.com/spf13/cobra v1.8.0\n)\n")
+
+	versions := map[string]string{"packages/core": "2.0.0", "crates/base": "2.0.0", "go/lib": "2.0.0"}
+	for _, tc := range []struct {
+		pkg, file string
+		want      []string
+		unchanged []string
+	}{
+		{pkg: "packages/web", file: "package.json", want: []string{`"@acme/core": "^2.0.0"`}, unchanged: []string{`"lodash": "^4.0.0"`, `"workspace:*"`}},
+		{pkg: "crates/cli", file: "Cargo.toml", want: []string{`version = "2.0.0"`}, unchanged: []string{`serde = "1"`}},
+		{pkg: "go/app", file: "go.mod", want: []string{"example.com/lib v2.0.0"}, unchanged: []string{"cobra v1.8.0"}},
+	} {
+		updated, err := UpdateDependencyConstraints(root, tc.pkg, versions)
+		if err != nil {
+			t.Fatalf("UpdateDependencyConstraints(%s) error = %v", tc.pkg, err)
+		}
+		if want := []string{tc.pkg + "/" + tc.file}; !reflect.DeepEqual(updated, want) {
+			t.Errorf("updated = %v, want %v", updated, want)
+		}
+		data, err := os.ReadFile(filepath.Join(root, tc.pkg, tc.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range append(tc.want, tc.unchanged...) {
+			if !strings.Contains(string(data), s) {
+				t.Errorf("%s/%s missing %q:\n%s", tc.pkg, tc.file, s, data)
+			}
+		}
+	}
+
+	// Nothing to update leaves the manifest untouched.
+	updated, err := UpdateDependencyConstraints(root, "packages/core", versions)
+	if err != nil || len(updated) != 0 {
+		t.Errorf("UpdateDependencyConstraints(core) = %v, %v; want no updates", updated, err)
+	}
+}
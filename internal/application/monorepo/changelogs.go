@@ -0,0 +1,177 @@
+package monorepo
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+)
+
+// DefaultChangelogFile is the changelog file written in a package directory
+// when the package has no changelog override.
+const DefaultChangelogFile = "CHANGELOG.md"
+
+// ChangelogConfig configures per-package changelog generation.
+type ChangelogConfig struct {
+	// Overrides maps package paths to a changelog file relative to the
+	// package directory.
+	Overrides map[string]string
+	// RootFile is the root changelog path relative to the repository root.
+	RootFile string
+	// IncludeLinks links each package changelog to the root changelog and to
+	// the changelogs of its dependencies released alongside it.
+	IncludeLinks bool
+}
+
+// ChangelogLink is a link from a package changelog to another changelog.
+type ChangelogLink struct {
+	// Title is the link text.
+	Title string `json:"title"`
+	// Target is the linked file relative to the directory of the changelog
+	// containing the link.
+	Target string `json:"target"`
+}
+
+// PackageChangelog is the changelog entry of a released package.
+type PackageChangelog struct {
+	// Package is the package path relative to the repository root.
+	Package string `json:"package"`
+	// File is the changelog path relative to the repository root.
+	File string `json:"file"`
+	// Version is the version the package is released at.
+	Version string `json:"version"`
+	// Commits lists the commits touching the package.
+	Commits []*changes.ConventionalCommit `json:"-"`
+	// Links lists the changelogs the entry links to.
+	Links []ChangelogLink `json:"links,omitempty"`
+}
+
+// ChangelogPath returns the changelog path of a package relative to the
+// repository root. A non-empty override is resolved against the package
+// directory, so "docs/CHANGES.md" for "packages/core" yields
+// "packages/core/docs/CHANGES.md".
+func ChangelogPath(pkg, override string) string {
+	file := DefaultChangelogFile
+	if override != "" {
+		file = filepath.ToSlash(override)
+	}
+	return path.Join(pkg, file)
+}
+
+// PlanChangelogs returns the changelog entries of the released packages in
+// the order given. versions maps each package to its release version and
+// graph, which may be nil, provides the dependencies linked when
+// cfg.IncludeLinks is set. Only dependencies released alongside a package
+// are linked.
+func PlanChangelogs(release []string, versions map[string]string, commits map[string][]*changes.ConventionalCommit, graph DependencyGraph, cfg ChangelogConfig) []PackageChangelog {
+	files := make(map[string]string, len(release))
+	for _, pkg := range release {
+		files[pkg] = ChangelogPath(pkg, cfg.Overrides[pkg])
+	}
+
+	entries := make([]PackageChangelog, 0, len(release))
+	for _, pkg := range release {
+		entry := PackageChangelog{
+			Package: pkg,
+			File:    files[pkg],
+			Version: versions[pkg],
+			Commits: commits[pkg],
+		}
+		if cfg.IncludeLinks {
+			if cfg.RootFile != "" && path.Clean(filepath.ToSlash(cfg.RootFile)) != entry.File {
+				entry.Links = append(entry.Links, ChangelogLink{
+					Title:  "Root changelog",
+					Target: relativeLink(entry.File, filepath.ToSlash(cfg.RootFile)),
+				})
+			}
+			for _, dep := range graph[pkg] {
+				if depFile, ok := files[dep]; ok {
+					entry.Links = append(entry.Links, ChangelogLink{
+						Title:  fmt.Sprintf("%s %s", dep, versions[dep]),
+						Target: relativeLink(entry.File, depFile),
+					})
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Render renders the changelog entry as a Markdown section dated date.
+// Commits are grouped by changelog category, with breaking changes first.
+func (c PackageChangelog) Render(date time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## [%s] - %s\n", c.Version, date.Format("2006-01-02"))
+
+	var breaking []*changes.ConventionalCommit
+	var categories []string
+	byCategory := make(map[string][]*changes.ConventionalCommit)
+	for _, commit := range c.Commits {
+		if commit.IsBreaking() {
+			breaking = append(breaking, commit)
+		}
+		category := commit.Type().ChangelogCategory()
+		if _, ok := byCategory[category]; !ok {
+			categories = append(categories, category)
+		}
+		byCategory[category] = append(byCategory[category], commit)
+	}
+
+	writeSection := func(title string, commits []*changes.ConventionalCommit) {
+		fmt.Fprintf(&b, "\n### %s\n\n", title)
+		for _, commit := range commits {
+			b.WriteString("- ")
+			if commit.Scope() != "" {
+				fmt.Fprintf(&b, "**%s:** ", commit.Scope())
+			}
+			fmt.Fprintf(&b, "%s (%s)\n", commit.Subject(), commit.ShortHash())
+		}
+	}
+
+	if len(breaking) > 0 {
+		writeSection("Breaking Changes", breaking)
+	}
+	for _, category := range categories {
+		writeSection(category, byCategory[category])
+	}
+
+	if len(c.Links) > 0 {
+		b.WriteString("\n### Related\n\n")
+		for _, link := range c.Links {
+			fmt.Fprintf(&b, "- [%s](%s)\n", link.Title, link.Target)
+		}
+	}
+	return b.String()
+}
+
+// RenderChangelogIndex renders a Markdown section linking the root changelog
+// at rootFile to the changelog of each released package.
+func RenderChangelogIndex(rootFile string, entries []PackageChangelog) string {
+	if len(entries) == 0 {
+		return ""
+	}
+	sorted := slices.Clone(entries)
+	slices.SortFunc(sorted, func(a, b PackageChangelog) int { return strings.Compare(a.Package, b.Package) })
+
+	var b strings.Builder
+	b.WriteString("### Packages\n\n")
+	for _, e := range sorted {
+		fmt.Fprintf(&b, "- [%s](%s) %s\n", e.Package, relativeLink(filepath.ToSlash(rootFile), e.File), e.Version)
+	}
+	return b.String()
+}
+
+// relativeLink returns the path of target relative to the directory of from.
+// Both paths are slash-separated and relative to the repository root.
+func relativeLink(from, target string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(path.Dir(path.Clean(from))), filepath.FromSlash(path.Clean(target)))
+	if err != nil {
+		return path.Clean(target)
+	}
+	return filepath.ToSlash(rel)
+}
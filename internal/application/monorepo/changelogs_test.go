@@ -0,0 +1,121 @@
+package monorepo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+)
+
+func TestChangelogPath(t *testing.T) {
+	tests := []struct {
+		pkg, override, want string
+	}{
+		{"packages/core", "", "packages/core/CHANGELOG.md"},
+		{"packages/core", "docs/CHANGES.md", "packages/core/docs/CHANGES.md"},
+		{"packages/core", "../../changelogs/core.md", "changelogs/core.md"},
+		{RootPackagePath, "", "CHANGELOG.md"},
+	}
+	for _, tt := range tests {
+		if got := ChangelogPath(tt.pkg, tt.override); got != tt.want {
+			t.Errorf("ChangelogPath(%q, %q) = %q, want %q", tt.pkg, tt.override, got, tt.want)
+		}
+	}
+}
+
+func TestPlanChangelogs(t *testing.T) {
+	release := []string{"libs/shared/util", "packages/core"}
+	versions := map[string]string{"libs/shared/util": "1.3.0", "packages/core": "2.0.0"}
+	graph := DependencyGraph{
+		"packages/core":    {"libs/shared/util", "packages/ui"},
+		"libs/shared/util": {},
+	}
+	cfg := ChangelogConfig{
+		Overrides:    map[string]string{"packages/core": "docs/HISTORY.md"},
+		RootFile:     "CHANGELOG.md",
+		IncludeLinks: true,
+	}
+
+	got := PlanChangelogs(release, versions, nil, graph, cfg)
+	if len(got) != 2 {
+		t.Fatalf("PlanChangelogs() returned %d entries, want 2", len(got))
+	}
+
+	util, core := got[0], got[1]
+	if util.File != "libs/shared/util/CHANGELOG.md" {
+		t.Errorf("nested package file = %q", util.File)
+	}
+	wantUtilLinks := []ChangelogLink{{Title: "Root changelog", Target: "../../../CHANGELOG.md"}}
+	if !reflect.DeepEqual(util.Links, wantUtilLinks) {
+		t.Errorf("nested package links = %+v, want %+v", util.Links, wantUtilLinks)
+	}
+
+	if core.File != "packages/core/docs/HISTORY.md" || core.Version != "2.0.0" {
+		t.Errorf("override entry = %+v", core)
+	}
+	// packages/ui is a dependency but is not released, so it is not linked
+	wantCoreLinks := []ChangelogLink{
+		{Title: "Root changelog", Target: "../../../CHANGELOG.md"},
+		{Title: "libs/shared/util 1.3.0", Target: "../../../libs/shared/util/CHANGELOG.md"},
+	}
+	if !reflect.DeepEqual(core.Links, wantCoreLinks) {
+		t.Errorf("override links = %+v, want %+v", core.Links, wantCoreLinks)
+	}
+
+	cfg.IncludeLinks = false
+	for _, entry := range PlanChangelogs(release, versions, nil, graph, cfg) {
+		if len(entry.Links) != 0 {
+			t.Errorf("links for %s without IncludeLinks: %+v", entry.Package, entry.Links)
+		}
+	}
+}
+
+func TestPackageChangelogRender(t *testing.T) {
+	entry := PackageChangelog{
+		Package: "packages/core",
+		File:    "packages/core/CHANGELOG.md",
+		Version: "2.0.0",
+		Commits: []*changes.ConventionalCommit{
+			changes.NewConventionalCommit("aaa1111", changes.CommitTypeFeat, "new api", changes.WithScope("api"), changes.WithBreaking("old api removed")),
+			changes.NewConventionalCommit("bbb2222", changes.CommitTypeFix, "crash on start"),
+		},
+		Links: []ChangelogLink{{Title: "Root changelog", Target: "../../CHANGELOG.md"}},
+	}
+
+	got := entry.Render(time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC))
+	for _, want := range []string{
+		"## [2.0.0] - 2026-10-15\n",
+		"### Breaking Changes\n\n- **api:** new api (aaa1111)\n",
+		"### Features\n\n- **api:** new api (aaa1111)\n",
+		"### Bug Fixes\n\n- crash on start (bbb2222)\n",
+		"### Related\n\n- [Root changelog](../../CHANGELOG.md)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderChangelogIndex(t *testing.T) {
+	entries := []PackageChangelog{
+		{Package: "packages/core", File: "packages/core/docs/HISTORY.md", Version: "2.0.0"},
+		{Package: "libs/shared/util", File: "libs/shared/util/CHANGELOG.md", Version: "1.3.0"},
+	}
+
+	got := RenderChangelogIndex("CHANGELOG.md", entries)
+	want := "### Packages\n\n" +
+		"- [libs/shared/util](libs/shared/util/CHANGELOG.md) 1.3.0\n" +
+		"- [packages/core](packages/core/docs/HISTORY.md) 2.0.0\n"
+	if got != want {
+		t.Errorf("RenderChangelogIndex() = %q, want %q", got, want)
+	}
+
+	if got := RenderChangelogIndex("docs/CHANGELOG.md", entries[:1]); !strings.Contains(got, "(../packages/core/docs/HISTORY.md)") {
+		t.Errorf("index from nested root changelog = %q", got)
+	}
+	if got := RenderChangelogIndex("CHANGELOG.md", nil); got != "" {
+		t.Errorf("empty index = %q", got)
+	}
+}
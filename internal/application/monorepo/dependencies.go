@@ -0,0 +1,146 @@
+package monorepo
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/application/blast"
+)
+
+// DependencyCycleError is returned when internal package dependencies form a cycle.
+type DependencyCycleError struct {
+	// Cycle lists the package paths forming the cycle, starting and ending
+	// with the same package.
+	Cycle []string
+}
+
+// Error implements the error interface.
+func (e *DependencyCycleError) Error() string {
+	return fmt.Sprintf("dependency cycle detected: %s", strings.Join(e.Cycle, " -> "))
+}
+
+// DependencyGraph maps each package path to the package paths it depends on.
+// Only dependencies between packages of the same repository are tracked.
+type DependencyGraph map[string][]string
+
+// BuildDependencyGraph reads the manifests (package.json, Cargo.toml, go.mod,
+// ...) of each package, as blast radius analysis does, and returns the
+// internal dependency graph. Development dependencies count, since they must
+// be released first too. Packages without a recognized manifest have no
+// dependencies.
+func BuildDependencyGraph(repoRoot string, packages []string) (DependencyGraph, error) {
+	manifests := make(map[string][]*blast.Package, len(packages))
+	byName := make(map[string]string)
+
+	for _, pkg := range packages {
+		found, err := blast.ReadManifests(filepath.Join(repoRoot, filepath.FromSlash(pkg)))
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", pkg, err)
+		}
+		manifests[pkg] = found
+		for _, m := range found {
+			if m.Name != "" {
+				byName[m.Name] = pkg
+			}
+		}
+	}
+
+	graph := make(DependencyGraph, len(packages))
+	for _, pkg := range packages {
+		deps := []string{}
+		for _, m := range manifests[pkg] {
+			for _, dep := range slices.Concat(m.Dependencies, m.DevDependencies) {
+				target, ok := byName[dep]
+				if !ok || target == pkg || slices.Contains(deps, target) {
+					continue
+				}
+				deps = append(deps, target)
+			}
+		}
+		slices.Sort(deps)
+		graph[pkg] = deps
+	}
+
+	return graph, nil
+}
+
+// ReleaseOrder returns the given packages sorted so that every package comes
+// after the packages it depends on. Packages with no ordering constraint
+// between them are sorted by path to keep the order deterministic.
+// Dependencies on packages outside the given set are ignored.
+func (g DependencyGraph) ReleaseOrder(packages []string) ([]string, error) {
+	inSet := make(map[string]bool, len(packages))
+	for _, pkg := range packages {
+		inSet[pkg] = true
+	}
+
+	remaining := make(map[string]int, len(packages))
+	dependents := make(map[string][]string)
+	for pkg := range inSet {
+		for _, dep := range g[pkg] {
+			if !inSet[dep] {
+				continue
+			}
+			remaining[pkg]++
+			dependents[dep] = append(dependents[dep], pkg)
+		}
+	}
+
+	var ready []string
+	for pkg := range inSet {
+		if remaining[pkg] == 0 {
+			ready = append(ready, pkg)
+		}
+	}
+
+	order := make([]string, 0, len(inSet))
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		pkg := ready[0]
+		ready = ready[1:]
+		order = append(order, pkg)
+
+		for _, dependent := range dependents[pkg] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(order) < len(inSet) {
+		return nil, &DependencyCycleError{Cycle: g.findCycle(inSet, remaining)}
+	}
+	return order, nil
+}
+
+// findCycle returns a dependency cycle among the packages that could not be
+// ordered. Every such package has at least one unresolved dependency, so
+// following unresolved dependencies from any of them must revisit a package.
+func (g DependencyGraph) findCycle(inSet map[string]bool, remaining map[string]int) []string {
+	var start string
+	for pkg := range inSet {
+		if remaining[pkg] > 0 && (start == "" || pkg < start) {
+			start = pkg
+		}
+	}
+
+	visited := make(map[string]int)
+	var path []string
+	for pkg := start; ; {
+		if i, ok := visited[pkg]; ok {
+			return append(path[i:], pkg)
+		}
+		visited[pkg] = len(path)
+		path = append(path, pkg)
+
+		for _, dep := range g[pkg] {
+			if inSet[dep] && remaining[dep] > 0 {
+				pkg = dep
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package monorepo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildDependencyGraph(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "packages/core/package.json"), `{"name": "@acme/core", "dependencies": {"lodash": "^4.0.0"}}`)
+	writeFile(t, filepath.Join(root, "packages/ui/package.json"), `{"name": "@acme/ui", "dependencies": {"@acme/core": "^1.0.0"}, "devDependencies": {"@acme/testing": "*"}}`)
+	writeFile(t, filepath.Join(root, "packages/testing/package.json"), `{"name": "@acme/testing", "peerDependencies": {"@acme/core": "*"}}`)
+
+	writeFile(t, filepath.Join(root, "crates/base/Cargo.toml"), "[package]\nname = \"base\"\nversion = \"0.1.0\"\n\n[dependencies]\nserde = \"1\"\n")
+	writeFile(t, filepath.Join(root, "crates/cli/Cargo.toml"), "[package]\nname = \"cli\"\nversion = \"0.1.0\"\n\n[dependencies.base]\npath = \"../base\"\n")
+
+	writeFile(t, filepath.Join(root, "go/lib/go.mod"), "module example.com/lib\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "go/app/go.mod"), "module example.com/app\n\ngo 1.24\n\nrequire (\n\texample.com/lib v1.0.0 // indirect\n\tgithub.com/spf13/cobra v1.8.0\n)\n")
+
+	packages := []string{"crates/base", "crates/cli", "go/app", "go/lib", "packages/core", "packages/testing", "packages/ui"}
+	graph, err := BuildDependencyGraph(root, packages)
+	if err != nil {
+		t.Fatalf("BuildDependencyGraph() error = %v", err)
+	}
+
+	want := DependencyGraph{
+		"crates/base":      {},
+		"crates/cli":       {"crates/base"},
+		"go/app":           {"go/lib"},
+		"go/lib":           {},
+		"packages/core":    {},
+		"packages/testing": {"packages/core"},
+		"packages/ui":      {"packages/core", "packages/testing"},
+	}
+	if !reflect.DeepEqual(graph, want) {
+		t.Errorf("BuildDependencyGraph() = %v, want %v", graph, want)
+	}
+
+	order, err := graph.ReleaseOrder([]string{"packages/ui", "packages/testing", "packages/core", "crates/cli"})
+	if err != nil {
+		t.Fatalf("ReleaseOrder() error = %v", err)
+	}
+	wantOrder := []string{"crates/cli", "packages/core", "packages/testing", "packages/ui"}
+	if !reflect.DeepEqual(order, wantOrder) {
+		t.Errorf("ReleaseOrder() = %v, want %v", order, wantOrder)
+	}
+}
+
+func TestBuildDependencyGraph_InvalidManifest(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "pkg/package.json"), `{not json`)
+
+	if _, err := BuildDependencyGraph(root, []string{"pkg"}); err == nil {
+		t.Error("expected error for invalid package.json")
+	}
+}
+
+func TestDependencyGraph_ReleaseOrderCycle(t *testing.T) {
+	graph := DependencyGraph{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+		"d": {},
+	}
+
+	_, err := graph.ReleaseOrder([]string{"a", "b", "c", "d"})
+	var cycleErr *DependencyCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected DependencyCycleError, got %v", err)
+	}
+	if want := []string{"a", "b", "c", "a"}; !reflect.DeepEqual(cycleErr.Cycle, want) {
+		t.Errorf("Cycle = %v, want %v", cycleErr.Cycle, want)
+	}
+	if err.Error() != "dependency cycle detected: a -> b -> c -> a" {
+		t.Errorf("unexpected error message: %q", err.Error())
+	}
+
+	// A cycle outside the released set does not matter
+	order, err := graph.ReleaseOrder([]string{"a", "d"})
+	if err != nil {
+		t.Fatalf("ReleaseOrder() error = %v", err)
+	}
+	if !reflect.DeepEqual(order, []string{"a", "d"}) {
+		t.Errorf("ReleaseOrder() = %v, want [a d]", order)
+	}
+}
@@ -0,0 +1,245 @@
+package monorepo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+const (
+	// GroupStrategyLockstep versions every member of a group together.
+	GroupStrategyLockstep = "lockstep"
+	// GroupStrategyIndependent versions each member of a group separately.
+	GroupStrategyIndependent = "independent"
+)
+
+// GroupConfig configures a release group.
+type GroupConfig struct {
+	// Name is the unique group name.
+	Name string
+	// Packages lists package paths or glob patterns belonging to the group.
+	Packages []string
+	// Strategy is the group's versioning strategy (lockstep or independent).
+	Strategy string
+	// TagPrefix is the tag prefix for the group's shared version.
+	TagPrefix string
+	// SharedChangelog aggregates the group's commits into one changelog.
+	SharedChangelog bool
+}
+
+// GroupPlan describes how a release group is versioned for a set of changes.
+type GroupPlan struct {
+	// Name is the group name.
+	Name string `json:"name"`
+	// Strategy is the group's versioning strategy.
+	Strategy string `json:"strategy"`
+	// TagPrefix is the tag prefix for the group's shared version.
+	TagPrefix string `json:"tag_prefix,omitempty"`
+	// SharedChangelog indicates the group's commits share one changelog.
+	SharedChangelog bool `json:"shared_changelog"`
+	// Packages lists the discovered packages belonging to the group.
+	Packages []string `json:"packages"`
+	// Changed lists the members touched by the changes.
+	Changed []string `json:"changed,omitempty"`
+	// Release lists the members to version. A lockstep group releases every
+	// member when any member changed.
+	Release []string `json:"release,omitempty"`
+	// Bump is the highest release type across the group's commits.
+	Bump changes.ReleaseType `json:"bump"`
+	// CurrentVersion is the group's latest released version (lockstep only).
+	CurrentVersion string `json:"current_version,omitempty"`
+	// NextVersion is the group's shared next version (lockstep only).
+	NextVersion string `json:"next_version,omitempty"`
+	// Commits lists the group's commits for the shared changelog.
+	Commits []GroupCommit `json:"commits,omitempty"`
+}
+
+// GroupCommit is a commit included in a group's shared changelog.
+type GroupCommit struct {
+	Hash    string `json:"hash"`
+	Type    string `json:"type"`
+	Subject string `json:"subject"`
+}
+
+// IsLockstep reports whether the group shares a single version.
+func (g *GroupPlan) IsLockstep() bool {
+	return g.Strategy == GroupStrategyLockstep
+}
+
+// SetVersion records the group's current version and derives its next
+// version from the group's bump. It has no effect for independent groups or
+// groups with nothing to release.
+func (g *GroupPlan) SetVersion(current version.SemanticVersion) {
+	if !g.IsLockstep() || len(g.Release) == 0 {
+		return
+	}
+	g.CurrentVersion = current.String()
+	g.NextVersion = version.NewVersionBump(g.Bump.ToBumpType()).Apply(current).String()
+}
+
+// AssignGroups maps each package to the name of the group it belongs to.
+// Packages not matched by any group are omitted. It returns an error when a
+// package matches more than one group.
+func AssignGroups(groups []GroupConfig, packages []string) (map[string]string, error) {
+	assigned := make(map[string]string)
+	for _, pkg := range packages {
+		for _, group := range groups {
+			if !matchesGroup(pkg, group) {
+				continue
+			}
+			if prev, ok := assigned[pkg]; ok {
+				return nil, fmt.Errorf("package %s belongs to release groups %q and %q; a package can belong to only one group", pkg, prev, group.Name)
+			}
+			assigned[pkg] = group.Name
+		}
+	}
+	return assigned, nil
+}
+
+// PlanGroups computes the release plan of each group from the given commits.
+//
+// Each commit is attributed to the packages its files belong to. A group's
+// bump is the highest release type of any commit touching one of its
+// members, so in a lockstep group a breaking change in one member bumps
+// every member's major version.
+func PlanGroups(ctx context.Context, provider DiffStatsProvider, groups []GroupConfig, packages []string, commits []*changes.ConventionalCommit, cfg AffectedConfig) ([]GroupPlan, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	assigned, err := AssignGroups(groups, packages)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]GroupPlan, len(groups))
+	index := make(map[string]int, len(groups))
+	for i, group := range groups {
+		index[group.Name] = i
+		plans[i] = GroupPlan{
+			Name:            group.Name,
+			Strategy:        group.Strategy,
+			TagPrefix:       group.TagPrefix,
+			SharedChangelog: group.SharedChangelog,
+			Packages:        []string{},
+			Bump:            changes.ReleaseTypeNone,
+		}
+	}
+	for _, pkg := range packages {
+		if name, ok := assigned[pkg]; ok {
+			plans[index[name]].Packages = append(plans[index[name]].Packages, pkg)
+		}
+	}
+
+	for _, c := range commits {
+		stats, err := provider.GetCommitDiffStats(ctx, sourcecontrol.CommitHash(c.Hash()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get changed files for commit %s: %w", c.ShortHash(), err)
+		}
+		if stats == nil {
+			continue
+		}
+
+		var files []string
+		for _, f := range stats.Files {
+			files = append(files, f.Path)
+			if f.OldPath != "" {
+				files = append(files, f.OldPath)
+			}
+		}
+
+		affected, err := DetectAffectedPackages(ctx, files, packages, cfg)
+		if err != nil {
+			return nil, err
+		}
+		touched := make(map[int]bool)
+		for _, a := range affected {
+			name, ok := assigned[a.Path]
+			if !ok {
+				continue
+			}
+			i := index[name]
+			touched[i] = true
+			if !slices.Contains(plans[i].Changed, a.Path) {
+				plans[i].Changed = append(plans[i].Changed, a.Path)
+			}
+		}
+
+		for i := range touched {
+			plans[i].Bump = changes.MaxReleaseType(plans[i].Bump, c.ReleaseType())
+			if plans[i].SharedChangelog {
+				plans[i].Commits = append(plans[i].Commits, GroupCommit{
+					Hash:    c.ShortHash(),
+					Type:    string(c.Type()),
+					Subject: c.Subject(),
+				})
+			}
+		}
+	}
+
+	for i := range plans {
+		slices.Sort(plans[i].Changed)
+		if plans[i].IsLockstep() && len(plans[i].Changed) > 0 {
+			plans[i].Release = slices.Clone(plans[i].Packages)
+		} else {
+			plans[i].Release = slices.Clone(plans[i].Changed)
+		}
+	}
+
+	return plans, nil
+}
+
+// ShareGroupChangelogs returns the commits of each package's changelog with
+// the changelogs of shared changelog groups aggregated: every released
+// member of such a group lists the commits touching any of its members, in
+// the order of commits. Other packages keep their own commits.
+func ShareGroupChangelogs(groups []GroupPlan, commits []*changes.ConventionalCommit, byPackage map[string][]*changes.ConventionalCommit) map[string][]*changes.ConventionalCommit {
+	result := maps.Clone(byPackage)
+	if result == nil {
+		result = make(map[string][]*changes.ConventionalCommit)
+	}
+	for _, g := range groups {
+		if !g.SharedChangelog || len(g.Release) == 0 {
+			continue
+		}
+
+		touching := make(map[string]bool)
+		for _, pkg := range g.Packages {
+			for _, c := range byPackage[pkg] {
+				touching[c.Hash()] = true
+			}
+		}
+		var shared []*changes.ConventionalCommit
+		for _, c := range commits {
+			if touching[c.Hash()] {
+				shared = append(shared, c)
+			}
+		}
+
+		for _, pkg := range g.Release {
+			result[pkg] = shared
+		}
+	}
+	return result
+}
+
+// matchesGroup reports whether pkg matches one of the group's package patterns.
+func matchesGroup(pkg string, group GroupConfig) bool {
+	for _, pattern := range group.Packages {
+		pattern = strings.Trim(path.Clean(pattern), "/")
+		if pattern == pkg {
+			return true
+		}
+		if ok, _ := path.Match(pattern, pkg); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package monorepo
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func TestPlanGroups_LockstepBumpsAllMembers(t *testing.T) {
+	packages := []string{"packages/core", "packages/shared", "packages/ui", "plugins/slack"}
+	groups := []GroupConfig{
+		{Name: "core", Packages: []string{"packages/core", "packages/shared"}, Strategy: GroupStrategyLockstep, TagPrefix: "core-v", SharedChangelog: true},
+		{Name: "plugins", Packages: []string{"plugins/*"}, Strategy: GroupStrategyIndependent},
+	}
+	provider := &fakeDiffStatsProvider{files: map[string][]sourcecontrol.FileStats{
+		"aaa1111": {{Path: "packages/shared/util.go"}},
+		"bbb2222": {{Path: "packages/shared/api.go"}},
+		"ccc3333": {{Path: "packages/ui/app.ts"}},
+	}}
+	commits := []*changes.ConventionalCommit{
+		changes.NewConventionalCommit("aaa1111", changes.CommitTypeFix, "fix util"),
+		changes.NewConventionalCommit("bbb2222", changes.CommitTypeFeat, "add api"),
+		changes.NewConventionalCommit("ccc3333", changes.CommitTypeFeat, "ui", changes.WithBreaking("new layout")),
+	}
+
+	plans, err := PlanGroups(context.Background(), provider, groups, packages, commits, AffectedConfig{})
+	if err != nil {
+		t.Fatalf("PlanGroups() error = %v", err)
+	}
+	if len(plans) != 2 {
+		t.Fatalf("PlanGroups() returned %d plans, want 2", len(plans))
+	}
+
+	core := plans[0]
+	if !reflect.DeepEqual(core.Changed, []string{"packages/shared"}) {
+		t.Errorf("core.Changed = %v, want [packages/shared]", core.Changed)
+	}
+	if want := []string{"packages/core", "packages/shared"}; !reflect.DeepEqual(core.Release, want) {
+		t.Errorf("core.Release = %v, want %v", core.Release, want)
+	}
+	if core.Bump != changes.ReleaseTypeMinor {
+		t.Errorf("core.Bump = %s, want minor (breaking change outside the group must not count)", core.Bump)
+	}
+	if len(core.Commits) != 2 {
+		t.Errorf("core.Commits = %v, want the group's 2 commits", core.Commits)
+	}
+
+	core.SetVersion(version.MustParse("1.4.2"))
+	if core.CurrentVersion != "1.4.2" || core.NextVersion != "1.5.0" {
+		t.Errorf("core versions = %s -> %s, want 1.4.2 -> 1.5.0", core.CurrentVersion, core.NextVersion)
+	}
+
+	pluginsPlan := plans[1]
+	if len(pluginsPlan.Release) != 0 || pluginsPlan.Bump != changes.ReleaseTypeNone {
+		t.Errorf("unchanged independent group should release nothing, got %v (%s)", pluginsPlan.Release, pluginsPlan.Bump)
+	}
+	pluginsPlan.SetVersion(version.MustParse("1.0.0"))
+	if pluginsPlan.NextVersion != "" {
+		t.Errorf("independent group should not get a shared version, got %s", pluginsPlan.NextVersion)
+	}
+}
+
+func TestShareGroupChangelogs(t *testing.T) {
+	fix := changes.NewConventionalCommit("aaa1111", changes.CommitTypeFix, "fix util")
+	feat := changes.NewConventionalCommit("bbb2222", changes.CommitTypeFeat, "add api")
+	ui := changes.NewConventionalCommit("ccc3333", changes.CommitTypeFeat, "ui")
+	commits := []*changes.ConventionalCommit{fix, feat, ui}
+	byPackage := map[string][]*changes.ConventionalCommit{
+		"packages/core":   {feat},
+		"packages/shared": {fix},
+		"packages/ui":     {ui},
+	}
+	groups := []GroupPlan{
+		{Name: "core", SharedChangelog: true, Packages: []string{"packages/core", "packages/shared"}, Release: []string{"packages/core", "packages/shared"}},
+		{Name: "ui", Packages: []string{"packages/ui"}, Release: []string{"packages/ui"}},
+	}
+
+	got := ShareGroupChangelogs(groups, commits, byPackage)
+	want := []*changes.ConventionalCommit{fix, feat}
+	for _, pkg := range []string{"packages/core", "packages/shared"} {
+		if !reflect.DeepEqual(got[pkg], want) {
+			t.Errorf("%s commits = %v, want the group's commits %v", pkg, got[pkg], want)
+		}
+	}
+	if !reflect.DeepEqual(got["packages/ui"], []*changes.ConventionalCommit{ui}) {
+		t.Errorf("packages/ui commits = %v, want its own commits", got["packages/ui"])
+	}
+	if len(byPackage["packages/core"]) != 1 {
+		t.Error("ShareGroupChangelogs() must not modify its input")
+	}
+}
+
+func TestAssignGroups_Overlap(t *testing.T) {
+	groups := []GroupConfig{
+		{Name: "a", Packages: []string{"packages/*"}},
+		{Name: "b", Packages: []string{"packages/core"}},
+	}
+	_, err := AssignGroups(groups, []string{"packages/core", "packages/ui"})
+	if err == nil || !strings.Contains(err.Error(), "packages/core") {
+		t.Fatalf("AssignGroups() error = %v, want overlap error for packages/core", err)
+	}
+
+	got, err := AssignGroups(groups[:1], []string{"packages/core", "plugins/slack"})
+	if err != nil {
+		t.Fatalf("AssignGroups() error = %v", err)
+	}
+	if want := map[string]string{"packages/core": "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AssignGroups() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,344 @@
+package monorepo
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// VersionFileConfig configures how packages of one type store their version.
+type VersionFileConfig struct {
+	// File is the version file name (e.g. "package.json").
+	File string
+	// Files lists alternative version file names or glob patterns, checked
+	// in order after File.
+	Files []string
+	// Field is the version field in structured files (JSON, TOML, XML).
+	Field string
+	// Pattern is a regular expression whose first group captures the
+	// version, used for unstructured files.
+	Pattern string
+	// Update indicates whether the version file is updated on release.
+	Update bool
+	// UpdateFormat is a template for the text replacing a Pattern match,
+	// e.g. `__version__ = "{{.Version}}"`.
+	UpdateFormat string
+}
+
+// PackageOverride overrides version file detection for a package.
+type PackageOverride struct {
+	// VersionFile is the version file, relative to the package directory.
+	VersionFile string
+	// VersionField is the version field in VersionFile.
+	VersionField string
+	// SkipVersioning excludes the package from version file updates.
+	SkipVersioning bool
+}
+
+// PackageVersionFile is the version file detected for a package.
+type PackageVersionFile struct {
+	// Package is the package path relative to the repository root.
+	Package string `json:"package"`
+	// Type is the detected package type (the version_files key).
+	Type string `json:"type,omitempty"`
+	// File is the version file relative to the repository root.
+	File string `json:"file,omitempty"`
+	// Field is the version field in structured files.
+	Field string `json:"field,omitempty"`
+	// Pattern is the regular expression capturing the version.
+	Pattern string `json:"pattern,omitempty"`
+	// Update indicates whether the file is updated on release.
+	Update bool `json:"update"`
+	// UpdateFormat is the template for the text replacing a Pattern match.
+	UpdateFormat string `json:"update_format,omitempty"`
+	// Overridden is set when the file comes from a package override.
+	Overridden bool `json:"overridden,omitempty"`
+	// Candidates lists the matching package types when the package has
+	// manifests of several types and no override picks one.
+	Candidates []string `json:"candidates,omitempty"`
+}
+
+// IsAmbiguous reports whether the package has manifests of several types.
+func (f PackageVersionFile) IsAmbiguous() bool {
+	return len(f.Candidates) > 1
+}
+
+// CheckVersionFiles returns an error for the first package whose version
+// file is ambiguous, so that callers can refuse a release before updating
+// any file.
+func CheckVersionFiles(files []PackageVersionFile) error {
+	for _, f := range files {
+		if f.IsAmbiguous() {
+			return ambiguousVersionFileError(f)
+		}
+	}
+	return nil
+}
+
+// ambiguousVersionFileError returns the error for a package with manifests
+// of several types.
+func ambiguousVersionFileError(f PackageVersionFile) error {
+	return fmt.Errorf("package %s has manifests of several types (%s); set monorepo.package_overrides.%s.version_file",
+		f.Package, strings.Join(f.Candidates, ", "), f.Package)
+}
+
+// DetectVersionFiles detects the version file of each package by the
+// manifests present in its directory. A package override takes precedence
+// over detection. Packages without a known manifest are omitted, as are
+// packages with SkipVersioning set.
+func DetectVersionFiles(repoRoot string, packages []string, types map[string]VersionFileConfig, overrides map[string]PackageOverride) []PackageVersionFile {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var files []PackageVersionFile
+	for _, pkg := range packages {
+		override := overrides[pkg]
+		if override.SkipVersioning {
+			continue
+		}
+
+		if override.VersionFile != "" {
+			f := PackageVersionFile{
+				Package:    pkg,
+				File:       path.Join(pkg, filepath.ToSlash(override.VersionFile)),
+				Update:     true,
+				Overridden: true,
+			}
+			// Reuse the format of the type owning the file, if any.
+			for _, name := range names {
+				if typeOwnsFile(types[name], override.VersionFile) {
+					f = withTypeConfig(f, name, types[name])
+					break
+				}
+			}
+			if override.VersionField != "" {
+				f.Field = override.VersionField
+			}
+			files = append(files, f)
+			continue
+		}
+
+		var matched []PackageVersionFile
+		for _, name := range names {
+			if file := findVersionFile(repoRoot, pkg, types[name]); file != "" {
+				f := withTypeConfig(PackageVersionFile{Package: pkg, File: file}, name, types[name])
+				matched = append(matched, f)
+			}
+		}
+
+		switch len(matched) {
+		case 0:
+			continue
+		case 1:
+			f := matched[0]
+			if override.VersionField != "" {
+				f.Field = override.VersionField
+			}
+			files = append(files, f)
+		default:
+			f := PackageVersionFile{Package: pkg}
+			for _, m := range matched {
+				f.Candidates = append(f.Candidates, m.Type)
+			}
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// withTypeConfig applies a package type's version file configuration.
+func withTypeConfig(f PackageVersionFile, name string, tc VersionFileConfig) PackageVersionFile {
+	f.Type = name
+	f.Field = tc.Field
+	f.Pattern = tc.Pattern
+	f.Update = tc.Update
+	f.UpdateFormat = tc.UpdateFormat
+	return f
+}
+
+// versionFilePatterns returns the file names and patterns of a type in order.
+func versionFilePatterns(tc VersionFileConfig) []string {
+	var patterns []string
+	if tc.File != "" {
+		patterns = append(patterns, tc.File)
+	}
+	return append(patterns, tc.Files...)
+}
+
+// typeOwnsFile reports whether file matches one of the type's file patterns.
+func typeOwnsFile(tc VersionFileConfig, file string) bool {
+	file = filepath.ToSlash(file)
+	for _, pattern := range versionFilePatterns(tc) {
+		if ok, _ := path.Match(pattern, file); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(file)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// findVersionFile returns the first existing version file of the type in the
+// package directory, relative to repoRoot, or "".
+func findVersionFile(repoRoot, pkg string, tc VersionFileConfig) string {
+	for _, pattern := range versionFilePatterns(tc) {
+		matches, err := filepath.Glob(filepath.Join(repoRoot, filepath.FromSlash(pkg), filepath.FromSlash(pattern)))
+		if err != nil {
+			continue
+		}
+		slices.Sort(matches)
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			rel, err := filepath.Rel(repoRoot, match)
+			if err != nil {
+				continue
+			}
+			return filepath.ToSlash(rel)
+		}
+	}
+	return ""
+}
+
+// ReadPackageVersion reads a package's version from its version file.
+func ReadPackageVersion(repoRoot string, f PackageVersionFile) (version.SemanticVersion, error) {
+	data, re, err := loadVersionFile(repoRoot, f)
+	if err != nil {
+		return version.Zero, err
+	}
+
+	m := re.FindSubmatch(data)
+	if m == nil {
+		return version.Zero, noVersionError(f, re)
+	}
+	return version.Parse(strings.TrimSpace(string(m[1])))
+}
+
+// WritePackageVersion replaces the version in a package's version file.
+// The rest of the file is left unchanged.
+func WritePackageVersion(repoRoot string, f PackageVersionFile, ver version.SemanticVersion) error {
+	data, re, err := loadVersionFile(repoRoot, f)
+	if err != nil {
+		return err
+	}
+
+	loc := re.FindSubmatchIndex(data)
+	if loc == nil {
+		return noVersionError(f, re)
+	}
+
+	var replacement []byte
+	start, end := loc[2], loc[3]
+	if !usesVersionField(f) && f.UpdateFormat != "" {
+		tmpl, err := template.New("version").Parse(f.UpdateFormat)
+		if err != nil {
+			return fmt.Errorf("invalid update format for %s: %w", f.File, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, struct{ Version string }{ver.String()}); err != nil {
+			return fmt.Errorf("failed to render update format for %s: %w", f.File, err)
+		}
+		replacement = buf.Bytes()
+		start, end = loc[0], loc[1]
+	} else {
+		replacement = []byte(ver.String())
+	}
+
+	updated := make([]byte, 0, len(data)+len(replacement))
+	updated = append(updated, data[:start]...)
+	updated = append(updated, replacement...)
+	updated = append(updated, data[end:]...)
+
+	filePath := filepath.Join(repoRoot, filepath.FromSlash(f.File))
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, updated, info.Mode().Perm())
+}
+
+// loadVersionFile reads a package's version file and returns the regular
+// expression locating its version.
+func loadVersionFile(repoRoot string, f PackageVersionFile) ([]byte, *regexp.Regexp, error) {
+	if f.IsAmbiguous() {
+		return nil, nil, ambiguousVersionFileError(f)
+	}
+	if f.File == "" {
+		return nil, nil, fmt.Errorf("no version file detected for package %s", f.Package)
+	}
+
+	re, err := versionRegexp(f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(f.File))) // #nosec G304 -- path from package discovery
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, re, nil
+}
+
+// noVersionError reports that re does not match the version file.
+func noVersionError(f PackageVersionFile, re *regexp.Regexp) error {
+	if usesVersionField(f) {
+		return fmt.Errorf("no version found in %s: field %q not found", f.File, f.Field)
+	}
+	return fmt.Errorf("no version found in %s: pattern %q does not match", f.File, re.String())
+}
+
+// usesVersionField reports whether the version is located by its field,
+// which applies to structured (JSON, TOML, XML) files.
+func usesVersionField(f PackageVersionFile) bool {
+	if f.Field == "" {
+		return false
+	}
+	switch strings.ToLower(path.Ext(f.File)) {
+	case ".json", ".toml", ".xml", ".csproj", ".fsproj", ".vbproj", ".props":
+		return true
+	}
+	return false
+}
+
+// versionRegexp returns the regular expression whose first group captures
+// the version in the file. Structured files use the version field; other
+// files use the configured pattern.
+func versionRegexp(f PackageVersionFile) (*regexp.Regexp, error) {
+	if usesVersionField(f) {
+		field := regexp.QuoteMeta(f.Field)
+		switch strings.ToLower(path.Ext(f.File)) {
+		case ".json":
+			return regexp.MustCompile(`"` + field + `"\s*:\s*"([^"]+)"`), nil
+		case ".toml":
+			return regexp.MustCompile(`(?m)^\s*` + field + `\s*=\s*["']([^"']+)["']`), nil
+		default:
+			return regexp.MustCompile(`<` + field + `>\s*([^<\s]+)\s*</` + field + `>`), nil
+		}
+	}
+
+	if f.Pattern == "" {
+		return nil, fmt.Errorf("cannot locate the version in %s: no version field or pattern applies", f.File)
+	}
+	re, err := regexp.Compile(f.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version pattern for %s: %w", f.File, err)
+	}
+	if re.NumSubexp() < 1 {
+		return nil, fmt.Errorf("version pattern for %s must capture the version in a group", f.File)
+	}
+	return re, nil
+}
@@ -0,0 +1,173 @@
+package monorepo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// testVersionFileTypes mirrors the default monorepo.version_files entries.
+var testVersionFileTypes = map[string]VersionFileConfig{
+	"npm":   {File: "package.json", Field: "version", Update: true},
+	"cargo": {File: "Cargo.toml", Field: "version", Update: true},
+	"python": {
+		Files:        []string{"pyproject.toml", "setup.py", "__version__.py"},
+		Field:        "version",
+		Pattern:      `__version__\s*=\s*["']([^"']+)["']`,
+		Update:       true,
+		UpdateFormat: `__version__ = "{{.Version}}"`,
+	},
+	"go_module": {File: "go.mod"},
+}
+
+func TestDetectVersionFiles(t *testing.T) {
+	root := t.TempDir()
+	testFiles := map[string]string{
+		"packages/web/package.json":     `{"name": "web", "version": "1.2.0"}`,
+		"crates/core/Cargo.toml":        "[package]\nname = \"core\"\nversion = \"0.4.1\"\n",
+		"py/sdk/pyproject.toml":         "[project]\nname = \"sdk\"\nversion = \"2.0.0\"\n",
+		"py/legacy/__version__.py":      "__version__ = '0.9.0'\n",
+		"tools/mixed/package.json":      `{"version": "1.0.0"}`,
+		"tools/mixed/pyproject.toml":    "[project]\nversion = \"1.0.0\"\n",
+		"tools/pinned/package.json":     `{"version": "1.0.0"}`,
+		"tools/pinned/pyproject.toml":   "[project]\nversion = \"3.1.0\"\n",
+		"tools/skipped/package.json":    `{"version": "1.0.0"}`,
+		"svc/api/go.mod":                "module example.com/api\n",
+		"docs/README.md":                "no manifest",
+		"tools/renamed/manifest.json":   `{"pkgVersion": "4.0.0"}`,
+		"tools/renamed/other/README.md": "x",
+	}
+	for name, content := range testFiles {
+		writeFile(t, filepath.Join(root, filepath.FromSlash(name)), content)
+	}
+
+	packages := []string{"crates/core", "docs", "packages/web", "py/legacy", "py/sdk", "svc/api",
+		"tools/mixed", "tools/pinned", "tools/renamed", "tools/skipped"}
+	overrides := map[string]PackageOverride{
+		"tools/pinned":  {VersionFile: "pyproject.toml"},
+		"tools/renamed": {VersionFile: "manifest.json", VersionField: "pkgVersion"},
+		"tools/skipped": {SkipVersioning: true},
+	}
+
+	files := DetectVersionFiles(root, packages, testVersionFileTypes, overrides)
+
+	byPkg := make(map[string]PackageVersionFile)
+	for _, f := range files {
+		byPkg[f.Package] = f
+	}
+
+	want := map[string]struct{ typ, file string }{
+		"crates/core":   {"cargo", "crates/core/Cargo.toml"},
+		"packages/web":  {"npm", "packages/web/package.json"},
+		"py/legacy":     {"python", "py/legacy/__version__.py"},
+		"py/sdk":        {"python", "py/sdk/pyproject.toml"},
+		"svc/api":       {"go_module", "svc/api/go.mod"},
+		"tools/pinned":  {"python", "tools/pinned/pyproject.toml"},
+		"tools/renamed": {"", "tools/renamed/manifest.json"},
+	}
+	for pkg, w := range want {
+		f, ok := byPkg[pkg]
+		if !ok {
+			t.Errorf("package %s: no version file detected", pkg)
+			continue
+		}
+		if f.Type != w.typ || f.File != w.file {
+			t.Errorf("package %s: got type %q file %q, want %q %q", pkg, f.Type, f.File, w.typ, w.file)
+		}
+	}
+
+	if _, ok := byPkg["docs"]; ok {
+		t.Error("package without a manifest should be omitted")
+	}
+	if _, ok := byPkg["tools/skipped"]; ok {
+		t.Error("package with skip_versioning should be omitted")
+	}
+	if byPkg["svc/api"].Update {
+		t.Error("go modules are not updated")
+	}
+	if !byPkg["tools/pinned"].Overridden || byPkg["tools/renamed"].Field != "pkgVersion" {
+		t.Errorf("override not applied: %+v %+v", byPkg["tools/pinned"], byPkg["tools/renamed"])
+	}
+
+	mixed := byPkg["tools/mixed"]
+	if !mixed.IsAmbiguous() || !reflect.DeepEqual(mixed.Candidates, []string{"npm", "python"}) {
+		t.Errorf("expected ambiguous npm/python package, got %+v", mixed)
+	}
+	_, err := ReadPackageVersion(root, mixed)
+	if err == nil || !strings.Contains(err.Error(), "package_overrides") {
+		t.Errorf("expected ambiguity error with override guidance, got %v", err)
+	}
+	if err := CheckVersionFiles(files); err == nil || !strings.Contains(err.Error(), "tools/mixed") {
+		t.Errorf("expected CheckVersionFiles to report tools/mixed, got %v", err)
+	}
+	if err := CheckVersionFiles([]PackageVersionFile{byPkg["packages/web"], byPkg["py/sdk"]}); err != nil {
+		t.Errorf("unexpected error for unambiguous files: %v", err)
+	}
+}
+
+func TestReadWritePackageVersion(t *testing.T) {
+	root := t.TempDir()
+	testFiles := map[string]string{
+		"web/package.json":    "{\n  \"name\": \"web\",\n  \"version\": \"1.2.0\",\n  \"dependencies\": {\"core\": \"1.0.0\"}\n}\n",
+		"core/Cargo.toml":     "[package]\nname = \"core\"\nversion = \"0.4.1\"\n\n[dependencies]\nserde = { version = \"1.0\" }\n",
+		"sdk/pyproject.toml":  "[project]\nname = \"sdk\"\nversion = \"2.0.0\"\n",
+		"cli/__version__.py":  "# generated\n__version__ = '0.9.0'\n",
+		"dotnet/App.csproj":   "<Project><PropertyGroup><Version>1.0.0</Version></PropertyGroup></Project>\n",
+		"nofield/VERSION.txt": "1.0.0\n",
+	}
+	for name, content := range testFiles {
+		writeFile(t, filepath.Join(root, filepath.FromSlash(name)), content)
+	}
+
+	types := map[string]VersionFileConfig{
+		"npm":    testVersionFileTypes["npm"],
+		"cargo":  testVersionFileTypes["cargo"],
+		"python": testVersionFileTypes["python"],
+		"nuget":  {Files: []string{"*.csproj"}, Field: "Version", Update: true},
+	}
+	files := DetectVersionFiles(root, []string{"cli", "core", "dotnet", "sdk", "web"}, types, nil)
+	if len(files) != 5 {
+		t.Fatalf("expected 5 version files, got %+v", files)
+	}
+
+	next := version.MustParse("3.0.0")
+	for _, f := range files {
+		if _, err := ReadPackageVersion(root, f); err != nil {
+			t.Errorf("%s: read failed: %v", f.File, err)
+			continue
+		}
+		if err := WritePackageVersion(root, f, next); err != nil {
+			t.Errorf("%s: write failed: %v", f.File, err)
+			continue
+		}
+		got, err := ReadPackageVersion(root, f)
+		if err != nil || !got.Equal(next) {
+			t.Errorf("%s: got %v (%v) after write, want %s", f.File, got, err, next)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "core", "Cargo.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `serde = { version = "1.0" }`) {
+		t.Errorf("dependency versions must be left unchanged:\n%s", data)
+	}
+
+	data, err = os.ReadFile(filepath.Join(root, "cli", "__version__.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "# generated\n__version__ = \"3.0.0\"\n" {
+		t.Errorf("unexpected __version__.py after update:\n%s", data)
+	}
+
+	_, err = ReadPackageVersion(root, PackageVersionFile{Package: "nofield", File: "nofield/VERSION.txt"})
+	if err == nil {
+		t.Error("expected error without a version field or pattern")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/relicta-tech/relicta/internal/domain/changes"
 	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
@@ -18,6 +19,26 @@ type CalculateVersionInput struct {
 	BumpType       version.BumpType
 	Prerelease     version.Prerelease
 	Auto           bool // Auto-detect bump type from commits
+
+	// ContinuePrerelease applies like Prerelease, but only when the current
+	// version is itself a prerelease, so that a configured suffix continues
+	// an existing prerelease series without turning stable releases into
+	// prereleases. Ignored when Prerelease is set.
+	ContinuePrerelease version.Prerelease
+
+	// BaseTag, when set, calculates the version of a hotfix release of an
+	// older release line: the current version is the tag's version and
+	// auto-detection always yields a patch bump.
+	BaseTag string
+
+	// CurrentVersion, when set, is used as the current version instead of
+	// the latest tag's, e.g. when it is read from a version file. Commits
+	// are still collected since the latest tag. Ignored with BaseTag.
+	CurrentVersion *version.SemanticVersion
+
+	// CommitParser parses commits for auto-detection. Nil means
+	// Conventional Commits.
+	CommitParser changes.CommitParser
 }
 
 // CalculateVersionOutput represents output of the CalculateVersion use case.
@@ -60,11 +81,23 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context, input CalculateV
 	if err != nil {
 		currentVersion = version.Initial
 	}
+	if input.CurrentVersion != nil {
+		currentVersion = *input.CurrentVersion
+	}
+	if input.BaseTag != "" {
+		currentVersion, err = version.Parse(strings.TrimPrefix(input.BaseTag, tagPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hotfix base tag %s: %w", input.BaseTag, err)
+		}
+	}
 
 	var bumpType version.BumpType
 	autoDetected := false
 
-	if input.Auto {
+	if input.Auto && input.BaseTag != "" {
+		bumpType = version.BumpPatch
+		autoDetected = true
+	} else if input.Auto {
 		// Auto-detect from commits
 		latestTag, tagErr := uc.gitRepo.GetLatestVersionTag(ctx, tagPrefix)
 		if tagErr != nil {
@@ -90,7 +123,7 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context, input CalculateV
 		hasFix := false
 
 		for _, commit := range commits {
-			cc := changes.ParseConventionalCommit(string(commit.Hash()), commit.Message())
+			cc := changes.ParseCommit(input.CommitParser, string(commit.Hash()), commit.Message())
 			if cc != nil {
 				if cc.IsBreaking() {
 					hasBreaking = true
@@ -120,9 +153,17 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context, input CalculateV
 	// Calculate next version
 	nextVersion := uc.versionCalc.CalculateNextVersion(currentVersion, bumpType)
 
-	// Apply prerelease if specified
-	if input.Prerelease != "" {
-		nextVersion = nextVersion.WithPrerelease(input.Prerelease)
+	// Apply prerelease if specified, continuing an existing prerelease series
+	pre := input.Prerelease
+	if pre == "" && currentVersion.IsPrerelease() {
+		pre = input.ContinuePrerelease
+	}
+	if pre != "" {
+		if bumpType == version.BumpPrerelease {
+			nextVersion = version.NewPrereleaseBump(pre).Apply(currentVersion)
+		} else {
+			nextVersion = version.NextPrerelease(currentVersion, nextVersion, pre)
+		}
 	}
 
 	return &CalculateVersionOutput{
@@ -233,6 +233,55 @@ func TestCalculateVersionUseCase_Execute(t *testing.T) {
 			wantBumpType:   version.BumpPatch,
 			wantAutoDetect: true,
 		},
+		{
+			name: "hotfix of an older tag is always a patch bump",
+			input: CalculateVersionInput{
+				Auto:    true,
+				BaseTag: "v1.1.0",
+			},
+			gitRepo: &mockGitRepository{
+				commits: []*sourcecontrol.Commit{
+					createTestCommit("abc123", "feat: backported feature"),
+				},
+				latestTagErr: errors.New("no tags found"),
+			},
+			versionCalc:    &mockVersionCalculator{},
+			wantErr:        false,
+			wantVersion:    "1.1.1",
+			wantBumpType:   version.BumpPatch,
+			wantAutoDetect: true,
+		},
+		{
+			name: "current version from a version file",
+			input: CalculateVersionInput{
+				Auto:           true,
+				CurrentVersion: ptrVersion(version.MustParse("2.3.0")),
+			},
+			gitRepo: &mockGitRepository{
+				commits: []*sourcecontrol.Commit{
+					createTestCommit("abc123", "fix: minor bug fix"),
+				},
+				latestTagErr: errors.New("no tags found"),
+			},
+			versionCalc:    &mockVersionCalculator{},
+			wantErr:        false,
+			wantVersion:    "2.3.1",
+			wantBumpType:   version.BumpPatch,
+			wantAutoDetect: true,
+		},
+		{
+			name: "hotfix with invalid base tag",
+			input: CalculateVersionInput{
+				Auto:    true,
+				BaseTag: "release-1",
+			},
+			gitRepo: &mockGitRepository{
+				latestTagErr: errors.New("no tags found"),
+			},
+			versionCalc: &mockVersionCalculator{},
+			wantErr:     true,
+			errMsg:      "invalid hotfix base tag",
+		},
 		{
 			name: "explicit major bump",
 			input: CalculateVersionInput{
@@ -336,6 +385,87 @@ func TestCalculateVersionUseCase_Execute(t *testing.T) {
 			wantBumpType:   version.BumpMinor,
 			wantAutoDetect: false,
 		},
+		{
+			name: "seeds prerelease counter",
+			input: CalculateVersionInput{
+				BumpType:   version.BumpMinor,
+				Prerelease: version.PrereleaseBeta,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.1.0", "abc123"),
+			},
+			versionCalc:  &mockVersionCalculator{},
+			wantVersion:  "1.2.0-beta.1",
+			wantBumpType: version.BumpMinor,
+		},
+		{
+			name: "increments existing prerelease",
+			input: CalculateVersionInput{
+				BumpType:   version.BumpPatch,
+				Prerelease: version.PrereleaseBeta,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.2.0-beta.1", "abc123"),
+			},
+			versionCalc:  &mockVersionCalculator{},
+			wantVersion:  "1.2.0-beta.2",
+			wantBumpType: version.BumpPatch,
+		},
+		{
+			name: "prerelease bump switches identifier",
+			input: CalculateVersionInput{
+				BumpType:   version.BumpPrerelease,
+				Prerelease: version.PrereleaseRC,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.2.0-beta.3", "abc123"),
+			},
+			versionCalc:  &mockVersionCalculator{},
+			wantVersion:  "1.2.0-rc.1",
+			wantBumpType: version.BumpPrerelease,
+		},
+		{
+			name: "configured suffix keeps stable release stable",
+			input: CalculateVersionInput{
+				Auto:               true,
+				ContinuePrerelease: version.PrereleaseBeta,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.1.0", "abc123"),
+				commits:          []*sourcecontrol.Commit{createTestCommit("def456", "feat: add option")},
+			},
+			versionCalc:    &mockVersionCalculator{},
+			wantVersion:    "1.2.0",
+			wantBumpType:   version.BumpMinor,
+			wantAutoDetect: true,
+		},
+		{
+			name: "configured suffix continues prerelease series",
+			input: CalculateVersionInput{
+				Auto:               true,
+				ContinuePrerelease: version.PrereleaseBeta,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.2.0-beta.1", "abc123"),
+				commits:          []*sourcecontrol.Commit{createTestCommit("def456", "fix: handle nil")},
+			},
+			versionCalc:    &mockVersionCalculator{},
+			wantVersion:    "1.2.0-beta.2",
+			wantBumpType:   version.BumpPatch,
+			wantAutoDetect: true,
+		},
+		{
+			name: "promotes prerelease to stable",
+			input: CalculateVersionInput{
+				BumpType: version.BumpMinor,
+			},
+			gitRepo: &mockGitRepository{
+				latestVersionTag: sourcecontrol.NewTag("v1.2.0-rc.2", "abc123"),
+			},
+			versionCalc:  &mockVersionCalculator{},
+			wantVersion:  "1.2.0",
+			wantBumpType: version.BumpMinor,
+		},
 	}
 
 	for _, tt := range tests {
@@ -657,3 +787,7 @@ func TestNewSetVersionUseCase(t *testing.T) {
 func containsString(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+func ptrVersion(v version.SemanticVersion) *version.SemanticVersion {
+	return &v
+}
@@ -0,0 +1,107 @@
+package versioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	appmonorepo "github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// Version sources, as configured by versioning.bump_from.
+const (
+	BumpFromTag         = "tag"
+	BumpFromFile        = "file"
+	BumpFromPackageJSON = "package.json"
+)
+
+// ReconcileInput configures the version file/tag reconciliation check.
+type ReconcileInput struct {
+	// RepositoryPath is the repository root.
+	RepositoryPath string
+	// BumpFrom is the authoritative version source (tag, file, package.json).
+	BumpFrom string
+	// VersionFile is the configured version file, relative to RepositoryPath.
+	VersionFile string
+	// TagName is the latest version tag; empty when the repository has none.
+	TagName string
+	// TagVersion is the version of the latest tag.
+	TagVersion version.SemanticVersion
+	// VersionFileTypes are the version file types used to locate the
+	// version in each file, as for the version source (ProjectVersionFile).
+	VersionFileTypes map[string]appmonorepo.VersionFileConfig
+}
+
+// VersionMismatch describes a version file that disagrees with the latest tag.
+type VersionMismatch struct {
+	File          string `json:"file"`
+	FileVersion   string `json:"file_version"`
+	TagName       string `json:"tag_name"`
+	TagVersion    string `json:"tag_version"`
+	Authoritative string `json:"authoritative"`
+	Guidance      string `json:"guidance"`
+}
+
+// String returns a one-line description of the mismatch.
+func (m VersionMismatch) String() string {
+	return fmt.Sprintf("%s has version %s but the latest tag is %s", m.File, m.FileVersion, m.TagName)
+}
+
+// ReconcileVersionFiles compares the configured version files against the
+// latest tag and returns any mismatches. It returns nil when there is no tag
+// or no version file is configured.
+func ReconcileVersionFiles(ctx context.Context, input ReconcileInput) ([]VersionMismatch, error) {
+	if input.TagName == "" {
+		return nil, nil
+	}
+
+	var mismatches []VersionMismatch
+	for _, file := range configuredVersionFiles(input) {
+		fileVersion, err := ReadProjectVersion(input.RepositoryPath, locateVersionFile(file, input.VersionFileTypes))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) && input.BumpFrom == BumpFromTag {
+				continue
+			}
+			return nil, err
+		}
+		if fileVersion.Equal(input.TagVersion) {
+			continue
+		}
+		mismatches = append(mismatches, newVersionMismatch(input, file, fileVersion))
+	}
+	return mismatches, nil
+}
+
+// configuredVersionFiles returns the version files to reconcile.
+func configuredVersionFiles(input ReconcileInput) []string {
+	var files []string
+	if input.VersionFile != "" {
+		files = append(files, input.VersionFile)
+	}
+	if input.BumpFrom == BumpFromPackageJSON && input.VersionFile != "package.json" {
+		files = append(files, "package.json")
+	}
+	return files
+}
+
+// newVersionMismatch builds a mismatch with guidance based on which source
+// is authoritative.
+func newVersionMismatch(input ReconcileInput, file string, fileVersion version.SemanticVersion) VersionMismatch {
+	m := VersionMismatch{
+		File:        file,
+		FileVersion: fileVersion.String(),
+		TagName:     input.TagName,
+		TagVersion:  input.TagVersion.String(),
+	}
+
+	if input.BumpFrom == BumpFromTag || input.BumpFrom == "" {
+		m.Authoritative = BumpFromTag
+		m.Guidance = fmt.Sprintf("tags are authoritative (bump_from: tag); set %s to %s, or set versioning.bump_from to read the version from the file", file, m.TagVersion)
+	} else {
+		m.Authoritative = BumpFromFile
+		m.Guidance = fmt.Sprintf("%s is authoritative (bump_from: %s); tag the release of %s, or correct the file if it was edited by hand", file, input.BumpFrom, m.FileVersion)
+	}
+	return m
+}
@@ -0,0 +1,153 @@
+package versioning
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
+func TestReconcileVersionFiles(t *testing.T) {
+	tests := []struct {
+		name              string
+		files             map[string]string
+		bumpFrom          string
+		versionFile       string
+		tagName           string
+		wantMismatches    int
+		wantAuthoritative string
+		wantErr           bool
+	}{
+		{
+			name:     "matching package.json",
+			files:    map[string]string{"package.json": `{"name":"app","version":"1.1.0"}`},
+			bumpFrom: BumpFromPackageJSON,
+			tagName:  "v1.1.0",
+		},
+		{
+			name:              "package.json ahead of tag",
+			files:             map[string]string{"package.json": `{"name":"app","version":"1.2.0"}`},
+			bumpFrom:          BumpFromPackageJSON,
+			tagName:           "v1.1.0",
+			wantMismatches:    1,
+			wantAuthoritative: BumpFromFile,
+		},
+		{
+			name:              "VERSION file behind tag with tag authoritative",
+			files:             map[string]string{"VERSION": "1.0.0\n"},
+			bumpFrom:          BumpFromTag,
+			versionFile:       "VERSION",
+			tagName:           "v1.1.0",
+			wantMismatches:    1,
+			wantAuthoritative: BumpFromTag,
+		},
+		{
+			name:        "custom file matching",
+			files:       map[string]string{"version.go": "package main\n\nconst Version = \"1.1.0\"\n"},
+			bumpFrom:    BumpFromFile,
+			versionFile: "version.go",
+			tagName:     "v1.1.0",
+		},
+		{
+			name:              "custom file mismatched",
+			files:             map[string]string{"version.go": "package main\n\nconst Version = \"2.0.0\"\n"},
+			bumpFrom:          BumpFromFile,
+			versionFile:       "version.go",
+			tagName:           "v1.1.0",
+			wantMismatches:    1,
+			wantAuthoritative: BumpFromFile,
+		},
+		{
+			name:              "python version file read by its pattern",
+			files:             map[string]string{"__version__.py": "# requires 3.10.0\n__version__ = '1.0.0'\n"},
+			bumpFrom:          BumpFromFile,
+			versionFile:       "__version__.py",
+			tagName:           "v1.1.0",
+			wantMismatches:    1,
+			wantAuthoritative: BumpFromFile,
+		},
+		{
+			name:        "file without version fails",
+			files:       map[string]string{"notes.txt": "no version here"},
+			bumpFrom:    BumpFromFile,
+			versionFile: "notes.txt",
+			tagName:     "v1.1.0",
+			wantErr:     true,
+		},
+		{
+			name:     "no tag skips check",
+			files:    map[string]string{"package.json": `{"version":"1.2.0"}`},
+			bumpFrom: BumpFromPackageJSON,
+			tagName:  "",
+		},
+		{
+			name:        "missing file with tag authoritative is ignored",
+			bumpFrom:    BumpFromTag,
+			versionFile: "VERSION",
+			tagName:     "v1.1.0",
+		},
+		{
+			name:        "missing file with file authoritative fails",
+			bumpFrom:    BumpFromFile,
+			versionFile: "VERSION",
+			tagName:     "v1.1.0",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				writeTestFile(t, dir, name, content)
+			}
+
+			input := ReconcileInput{
+				RepositoryPath: dir,
+				BumpFrom:       tt.bumpFrom,
+				VersionFile:    tt.versionFile,
+				TagName:        tt.tagName,
+
+				VersionFileTypes: testVersionFileTypes,
+			}
+			if tt.tagName != "" {
+				input.TagVersion = version.MustParse(strings.TrimPrefix(tt.tagName, "v"))
+			}
+
+			mismatches, err := ReconcileVersionFiles(context.Background(), input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(mismatches) != tt.wantMismatches {
+				t.Fatalf("got %d mismatches, want %d: %+v", len(mismatches), tt.wantMismatches, mismatches)
+			}
+			for _, m := range mismatches {
+				if m.Authoritative != tt.wantAuthoritative {
+					t.Errorf("Authoritative = %q, want %q", m.Authoritative, tt.wantAuthoritative)
+				}
+				if m.TagName != tt.tagName {
+					t.Errorf("TagName = %q, want %q", m.TagName, tt.tagName)
+				}
+				if m.Guidance == "" {
+					t.Error("expected guidance")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package versioning
+
+import (
+	"fmt"
+	"path/filepath"
+
+	appmonorepo "github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// plainVersionPattern captures the first semantic version in a file that no
+// version file type owns, such as VERSION. A leading "v" is kept in place.
+const plainVersionPattern = `v?(\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?)`
+
+// ProjectVersionFile returns the version file of a single-package project
+// when the current version is read from a file (bump_from: file or
+// package.json). The version is located the same way as in a monorepo
+// package: by the field or pattern of the version file type owning the file
+// (e.g. the python type for __version__.py), or else by the first semantic
+// version in the file. It returns false when the version comes from tags.
+func ProjectVersionFile(bumpFrom, file string, types map[string]appmonorepo.VersionFileConfig) (appmonorepo.PackageVersionFile, bool) {
+	switch bumpFrom {
+	case BumpFromFile:
+		if file == "" {
+			return appmonorepo.PackageVersionFile{}, false
+		}
+	case BumpFromPackageJSON:
+		if file == "" {
+			file = "package.json"
+		}
+	default:
+		return appmonorepo.PackageVersionFile{}, false
+	}
+
+	return locateVersionFile(file, types), true
+}
+
+// locateVersionFile returns how the version in file is located: by the
+// field or pattern of the version file type owning it, or else by the first
+// semantic version in the file.
+func locateVersionFile(file string, types map[string]appmonorepo.VersionFileConfig) appmonorepo.PackageVersionFile {
+	files := appmonorepo.DetectVersionFiles("", []string{appmonorepo.RootPackagePath}, types,
+		map[string]appmonorepo.PackageOverride{appmonorepo.RootPackagePath: {VersionFile: file}})
+	f := files[0]
+	if f.Type == "" || (f.Field == "" && f.Pattern == "") {
+		f.Pattern = plainVersionPattern
+		f.UpdateFormat = ""
+	}
+	return f
+}
+
+// ReadProjectVersion reads the current version from the project version file.
+func ReadProjectVersion(repoRoot string, f appmonorepo.PackageVersionFile) (version.SemanticVersion, error) {
+	ver, err := appmonorepo.ReadPackageVersion(repoRoot, f)
+	if err != nil {
+		return version.Zero, fmt.Errorf("cannot read the current version from %s (versioning.version_file): %w", filepath.FromSlash(f.File), err)
+	}
+	return ver, nil
+}
+
+// WriteProjectVersion writes ver to the project version file, leaving the
+// rest of the file unchanged.
+func WriteProjectVersion(repoRoot string, f appmonorepo.PackageVersionFile, ver version.SemanticVersion) error {
+	if err := appmonorepo.WritePackageVersion(repoRoot, f, ver); err != nil {
+		return fmt.Errorf("cannot update the version in %s (versioning.version_file): %w", filepath.FromSlash(f.File), err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package versioning
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appmonorepo "github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+var testVersionFileTypes = map[string]appmonorepo.VersionFileConfig{
+	"npm": {File: "package.json", Field: "version", Update: true},
+	"python": {
+		Files:        []string{"pyproject.toml", "__version__.py"},
+		Field:        "version",
+		Pattern:      `__version__\s*=\s*["']([^"']+)["']`,
+		Update:       true,
+		UpdateFormat: `__version__ = "{{.Version}}"`,
+	},
+}
+
+func TestProjectVersionFile_ReadWrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		bumpFrom string
+		file     string
+		content  string
+		want     string
+		written  string
+	}{
+		{
+			name:     "python __version__",
+			bumpFrom: BumpFromFile,
+			file:     "src/app/__version__.py",
+			content:  "# app version\n__version__ = '1.2.0'\n",
+			want:     "1.2.0",
+			written:  "# app version\n__version__ = \"1.3.0\"\n",
+		},
+		{
+			name:     "VERSION file",
+			bumpFrom: BumpFromFile,
+			file:     "VERSION",
+			content:  "v1.2.0\n",
+			want:     "1.2.0",
+			written:  "v1.3.0\n",
+		},
+		{
+			name:     "package.json",
+			bumpFrom: BumpFromPackageJSON,
+			content:  `{"name": "app", "version": "1.2.0"}`,
+			want:     "1.2.0",
+			written:  `{"name": "app", "version": "1.3.0"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			f, ok := ProjectVersionFile(tt.bumpFrom, tt.file, testVersionFileTypes)
+			if !ok {
+				t.Fatal("ProjectVersionFile() = false, want true")
+			}
+			path := filepath.Join(dir, filepath.FromSlash(f.File))
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			writeTestFile(t, filepath.Dir(path), filepath.Base(path), tt.content)
+
+			got, err := ReadProjectVersion(dir, f)
+			if err != nil {
+				t.Fatalf("ReadProjectVersion() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ReadProjectVersion() = %s, want %s", got, tt.want)
+			}
+
+			if err := WriteProjectVersion(dir, f, version.MustParse("1.3.0")); err != nil {
+				t.Fatalf("WriteProjectVersion() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.written {
+				t.Errorf("written file = %q, want %q", data, tt.written)
+			}
+		})
+	}
+}
+
+func TestProjectVersionFile_FromTags(t *testing.T) {
+	if _, ok := ProjectVersionFile(BumpFromTag, "VERSION", testVersionFileTypes); ok {
+		t.Error("ProjectVersionFile() = true for bump_from tag")
+	}
+	if _, ok := ProjectVersionFile(BumpFromFile, "", testVersionFileTypes); ok {
+		t.Error("ProjectVersionFile() = true without a version file")
+	}
+}
+
+func TestReadProjectVersion_PatternMismatch(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "__version__.py", "VERSION = '1.2.0'\n")
+
+	f, _ := ProjectVersionFile(BumpFromFile, "__version__.py", testVersionFileTypes)
+	_, err := ReadProjectVersion(dir, f)
+	if err == nil {
+		t.Fatal("ReadProjectVersion() error = nil, want pattern mismatch")
+	}
+	for _, want := range []string{"__version__.py", "versioning.version_file", "does not match"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Package advisory looks up known security advisories for dependency
+// updates found in a release.
+//
+// A Lookup is a pluggable advisory source. The default is offline and
+// reports nothing; OSV and a local advisory database are also available.
+package advisory
+
+import (
+	"context"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+// Advisory is a known vulnerability affecting an updated dependency.
+type Advisory struct {
+	// ID is the advisory identifier (e.g. GHSA-xxxx-xxxx-xxxx or CVE-2024-1234).
+	ID string `json:"id"`
+
+	// Summary is a short description of the vulnerability, if known.
+	Summary string `json:"summary,omitempty"`
+
+	// Dependency is the dependency update the advisory affects.
+	Dependency cgp.DependencyUpdate `json:"dependency"`
+}
+
+// Lookup finds advisories affecting the new versions of updated dependencies.
+type Lookup interface {
+	Lookup(ctx context.Context, updates []cgp.DependencyUpdate) ([]Advisory, error)
+}
+
+// NoopLookup is an offline Lookup that never reports advisories.
+type NoopLookup struct{}
+
+// Lookup implements Lookup.
+func (NoopLookup) Lookup(context.Context, []cgp.DependencyUpdate) ([]Advisory, error) {
+	return nil, nil
+}
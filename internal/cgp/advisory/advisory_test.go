@@ -0,0 +1,88 @@
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+var testUpdates = []cgp.DependencyUpdate{
+	{Ecosystem: EcosystemNPM, Name: "lodash", From: "4.17.20", To: "4.17.21"},
+	{Ecosystem: EcosystemGo, Name: "golang.org/x/net", From: "v0.17.0", To: "v0.23.0"},
+}
+
+func TestNoopLookup(t *testing.T) {
+	advisories, err := NoopLookup{}.Lookup(context.Background(), testUpdates)
+	if err != nil || len(advisories) != 0 {
+		t.Errorf("Lookup() = %v, %v; want no advisories", advisories, err)
+	}
+}
+
+func TestOSVLookup(t *testing.T) {
+	var queries []osvQuery
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Queries []osvQuery `json:"queries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		queries = body.Queries
+		_, _ = w.Write([]byte(`{"results": [{}, {"vulns": [{"id": "GO-2024-2687"}, {"id": "GHSA-4v7x-pqxf-cx7m"}]}]}`))
+	}))
+	defer server.Close()
+
+	advisories, err := NewOSVLookup(WithOSVURL(server.URL)).Lookup(context.Background(), testUpdates)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+
+	if len(queries) != 2 || queries[1].Version != "0.23.0" || queries[1].Package.Ecosystem != EcosystemGo {
+		t.Errorf("queries = %+v", queries)
+	}
+	if len(advisories) != 2 || advisories[0].ID != "GO-2024-2687" || advisories[0].Dependency.Name != "golang.org/x/net" {
+		t.Errorf("advisories = %+v", advisories)
+	}
+}
+
+func TestOSVLookup_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	if _, err := NewOSVLookup(WithOSVURL(server.URL)).Lookup(context.Background(), testUpdates); err == nil {
+		t.Error("Lookup() expected error for failed query")
+	}
+}
+
+func TestDatabaseLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "advisories.json")
+	db := `[{"id": "CVE-2021-23337", "ecosystem": "npm", "package": "lodash", "versions": ["4.17.20", "4.17.21"]},
+	        {"id": "CVE-2020-0001", "ecosystem": "npm", "package": "lodash", "versions": ["4.17.15"]}]`
+	if err := os.WriteFile(path, []byte(db), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	lookup, err := LoadDatabase(path)
+	if err != nil {
+		t.Fatalf("LoadDatabase() error = %v", err)
+	}
+	advisories, err := lookup.Lookup(context.Background(), testUpdates)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if len(advisories) != 1 || advisories[0].ID != "CVE-2021-23337" {
+		t.Errorf("advisories = %+v", advisories)
+	}
+
+	if _, err := LoadDatabase(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadDatabase() expected error for missing file")
+	}
+}
@@ -0,0 +1,65 @@
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+// maxDatabaseSize limits the size of a local advisory database (50MB).
+const maxDatabaseSize = 50 << 20
+
+// DatabaseEntry is an advisory in a local advisory database.
+type DatabaseEntry struct {
+	ID        string   `json:"id"`
+	Summary   string   `json:"summary,omitempty"`
+	Ecosystem string   `json:"ecosystem"`
+	Package   string   `json:"package"`
+	Versions  []string `json:"versions"`
+}
+
+// DatabaseLookup matches dependency updates against a local advisory
+// database listing the affected versions of each package.
+type DatabaseLookup struct {
+	entries []DatabaseEntry
+}
+
+// NewDatabaseLookup creates a lookup over the given advisory entries.
+func NewDatabaseLookup(entries []DatabaseEntry) *DatabaseLookup {
+	return &DatabaseLookup{entries: entries}
+}
+
+// LoadDatabase reads a local advisory database: a JSON array of
+// DatabaseEntry values.
+func LoadDatabase(path string) (*DatabaseLookup, error) {
+	data, err := fileutil.ReadFileLimited(path, maxDatabaseSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read advisory database: %w", err)
+	}
+
+	var entries []DatabaseEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse advisory database %s: %w", path, err)
+	}
+	return NewDatabaseLookup(entries), nil
+}
+
+// Lookup implements Lookup.
+func (l *DatabaseLookup) Lookup(ctx context.Context, updates []cgp.DependencyUpdate) ([]Advisory, error) {
+	var advisories []Advisory
+	for _, u := range updates {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for _, e := range l.entries {
+			if e.Ecosystem == u.Ecosystem && e.Package == u.Name && slices.Contains(e.Versions, u.To) {
+				advisories = append(advisories, Advisory{ID: e.ID, Summary: e.Summary, Dependency: u})
+			}
+		}
+	}
+	return advisories, nil
+}
@@ -0,0 +1,240 @@
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+// Ecosystem names as used by OSV.
+const (
+	EcosystemGo    = "Go"
+	EcosystemNPM   = "npm"
+	EcosystemCrate = "crates.io"
+)
+
+// Manifests are the dependency manifests scanned at the repository root,
+// keyed by path.
+var Manifests = map[string]string{
+	"go.mod":       EcosystemGo,
+	"package.json": EcosystemNPM,
+	"Cargo.lock":   EcosystemCrate,
+}
+
+// FileReader reads file contents at a git ref.
+type FileReader interface {
+	GetFileAtRef(ctx context.Context, ref, path string) ([]byte, error)
+}
+
+// ScanManifests compares the dependency manifests at fromRef and toRef and
+// returns the dependencies whose version changed or that were added.
+// A manifest missing at toRef is skipped; one missing at fromRef is treated
+// as empty. An empty fromRef compares against no previous state.
+func ScanManifests(ctx context.Context, reader FileReader, fromRef, toRef string) ([]cgp.DependencyUpdate, error) {
+	paths := make([]string, 0, len(Manifests))
+	for path := range Manifests {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	var updates []cgp.DependencyUpdate
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		after, err := reader.GetFileAtRef(ctx, toRef, path)
+		if err != nil {
+			continue
+		}
+		var before []byte
+		if fromRef != "" {
+			before, _ = reader.GetFileAtRef(ctx, fromRef, path)
+		}
+
+		found, err := DiffManifest(path, before, after)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, found...)
+	}
+	return updates, nil
+}
+
+// DiffManifest returns the dependency updates between two versions of the
+// manifest at path. Empty content is treated as a manifest without
+// dependencies.
+func DiffManifest(path string, before, after []byte) ([]cgp.DependencyUpdate, error) {
+	ecosystem, ok := Manifests[path]
+	if !ok {
+		return nil, fmt.Errorf("unsupported dependency manifest %q", path)
+	}
+	if string(before) == string(after) {
+		return nil, nil
+	}
+
+	oldDeps, err := parseManifest(path, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s at previous ref: %w", path, err)
+	}
+	newDeps, err := parseManifest(path, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	names := make([]string, 0, len(newDeps))
+	for name := range newDeps {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var updates []cgp.DependencyUpdate
+	for _, name := range names {
+		oldVersions := oldDeps[name]
+		for _, version := range newDeps[name] {
+			if slices.Contains(oldVersions, version) {
+				continue
+			}
+			updates = append(updates, cgp.DependencyUpdate{
+				Ecosystem: ecosystem,
+				Name:      name,
+				From:      replacedVersion(oldVersions, newDeps[name]),
+				To:        version,
+				Manifest:  path,
+			})
+		}
+	}
+	return updates, nil
+}
+
+// replacedVersion returns the first previous version that is no longer
+// present, or "" when the dependency is new.
+func replacedVersion(oldVersions, newVersions []string) string {
+	for _, v := range oldVersions {
+		if !slices.Contains(newVersions, v) {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseManifest returns the dependency versions declared in a manifest,
+// keyed by package name. A package may appear at several versions in a
+// lock file.
+func parseManifest(path string, data []byte) (map[string][]string, error) {
+	deps := make(map[string][]string)
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return deps, nil
+	}
+
+	switch path {
+	case "go.mod":
+		parseGoMod(data, deps)
+	case "package.json":
+		if err := parsePackageJSON(data, deps); err != nil {
+			return nil, err
+		}
+	case "Cargo.lock":
+		if err := parseCargoLock(data, deps); err != nil {
+			return nil, err
+		}
+	}
+	for name := range deps {
+		slices.Sort(deps[name])
+		deps[name] = slices.Compact(deps[name])
+	}
+	return deps, nil
+}
+
+// parseGoMod collects require directives from a go.mod file.
+func parseGoMod(data []byte, deps map[string][]string) {
+	inRequire := false
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case inRequire && fields[0] == ")":
+			inRequire = false
+			continue
+		case fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			inRequire = true
+			continue
+		case fields[0] == "require":
+			fields = fields[1:]
+		case !inRequire:
+			continue
+		}
+
+		if len(fields) >= 2 {
+			deps[fields[0]] = append(deps[fields[0]], fields[1])
+		}
+	}
+}
+
+// parsePackageJSON collects exact or caret/tilde-pinned versions from the
+// dependency sections of a package.json file. Ranges, tags and URLs are
+// skipped since they do not name a single version.
+func parsePackageJSON(data []byte, deps map[string][]string) error {
+	var pkg struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return err
+	}
+
+	for _, section := range []map[string]string{pkg.Dependencies, pkg.DevDependencies, pkg.OptionalDependencies} {
+		for name, spec := range section {
+			if version, ok := npmVersion(spec); ok {
+				deps[name] = append(deps[name], version)
+			}
+		}
+	}
+	return nil
+}
+
+// npmVersion extracts the version from an npm version spec such as
+// "1.2.3", "^1.2.3" or "~1.2.3".
+func npmVersion(spec string) (string, bool) {
+	v := strings.TrimLeft(strings.TrimSpace(spec), "^~=v")
+	if v == "" || strings.ContainsAny(v, " *xX|<>:/") {
+		return "", false
+	}
+	if v[0] < '0' || v[0] > '9' {
+		return "", false
+	}
+	return v, true
+}
+
+// parseCargoLock collects the resolved packages from a Cargo.lock file.
+func parseCargoLock(data []byte, deps map[string][]string) error {
+	var lock struct {
+		Package []struct {
+			Name    string `toml:"name"`
+			Version string `toml:"version"`
+		} `toml:"package"`
+	}
+	if err := toml.Unmarshal(data, &lock); err != nil {
+		return err
+	}
+
+	for _, p := range lock.Package {
+		if p.Name != "" && p.Version != "" {
+			deps[p.Name] = append(deps[p.Name], p.Version)
+		}
+	}
+	return nil
+}
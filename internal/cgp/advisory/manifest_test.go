@@ -0,0 +1,150 @@
+package advisory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+func TestDiffManifest_GoMod(t *testing.T) {
+	before := []byte(`module example.com/app
+
+go 1.22
+
+require github.com/pkg/errors v0.9.0
+
+require (
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/text v0.13.0
+)
+`)
+	after := []byte(`module example.com/app
+
+go 1.22
+
+require github.com/pkg/errors v0.9.0
+
+require (
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/text v0.13.0
+	github.com/google/uuid v1.6.0
+)
+`)
+
+	updates, err := DiffManifest("go.mod", before, after)
+	if err != nil {
+		t.Fatalf("DiffManifest() error = %v", err)
+	}
+	want := []cgp.DependencyUpdate{
+		{Ecosystem: EcosystemGo, Name: "github.com/google/uuid", To: "v1.6.0", Manifest: "go.mod"},
+		{Ecosystem: EcosystemGo, Name: "golang.org/x/net", From: "v0.17.0", To: "v0.23.0", Manifest: "go.mod"},
+	}
+	assertUpdates(t, updates, want)
+}
+
+func TestDiffManifest_PackageJSON(t *testing.T) {
+	before := []byte(`{"dependencies": {"lodash": "^4.17.20", "react": "18.2.0"}, "devDependencies": {"jest": "latest"}}`)
+	after := []byte(`{"dependencies": {"lodash": "^4.17.21", "react": "18.2.0", "left-pad": ">=1.0.0 <2"}, "devDependencies": {"jest": "latest"}}`)
+
+	updates, err := DiffManifest("package.json", before, after)
+	if err != nil {
+		t.Fatalf("DiffManifest() error = %v", err)
+	}
+	assertUpdates(t, updates, []cgp.DependencyUpdate{
+		{Ecosystem: EcosystemNPM, Name: "lodash", From: "4.17.20", To: "4.17.21", Manifest: "package.json"},
+	})
+}
+
+func TestDiffManifest_CargoLock(t *testing.T) {
+	before := []byte(`version = 3
+
+[[package]]
+name = "serde"
+version = "1.0.190"
+
+[[package]]
+name = "syn"
+version = "1.0.109"
+
+[[package]]
+name = "syn"
+version = "2.0.38"
+`)
+	after := []byte(`version = 3
+
+[[package]]
+name = "serde"
+version = "1.0.190"
+
+[[package]]
+name = "syn"
+version = "1.0.109"
+
+[[package]]
+name = "syn"
+version = "2.0.48"
+`)
+
+	updates, err := DiffManifest("Cargo.lock", before, after)
+	if err != nil {
+		t.Fatalf("DiffManifest() error = %v", err)
+	}
+	assertUpdates(t, updates, []cgp.DependencyUpdate{
+		{Ecosystem: EcosystemCrate, Name: "syn", From: "2.0.38", To: "2.0.48", Manifest: "Cargo.lock"},
+	})
+}
+
+func TestDiffManifest_Errors(t *testing.T) {
+	if _, err := DiffManifest("pom.xml", nil, []byte("<project/>")); err == nil {
+		t.Error("DiffManifest() expected error for unsupported manifest")
+	}
+	if _, err := DiffManifest("package.json", nil, []byte("{")); err == nil {
+		t.Error("DiffManifest() expected error for invalid package.json")
+	}
+}
+
+// refFiles serves manifest contents keyed by ref and path.
+type refFiles map[string]map[string]string
+
+func (f refFiles) GetFileAtRef(_ context.Context, ref, path string) ([]byte, error) {
+	data, ok := f[ref][path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []byte(data), nil
+}
+
+func TestScanManifests(t *testing.T) {
+	files := refFiles{
+		"v1.0.0": {
+			"package.json": `{"dependencies": {"lodash": "4.17.20"}}`,
+		},
+		"HEAD": {
+			"package.json": `{"dependencies": {"lodash": "4.17.21"}}`,
+			"go.mod":       "module example.com/app\n\nrequire golang.org/x/net v0.23.0\n",
+		},
+	}
+
+	updates, err := ScanManifests(context.Background(), files, "v1.0.0", "HEAD")
+	if err != nil {
+		t.Fatalf("ScanManifests() error = %v", err)
+	}
+	assertUpdates(t, updates, []cgp.DependencyUpdate{
+		{Ecosystem: EcosystemGo, Name: "golang.org/x/net", To: "v0.23.0", Manifest: "go.mod"},
+		{Ecosystem: EcosystemNPM, Name: "lodash", From: "4.17.20", To: "4.17.21", Manifest: "package.json"},
+	})
+}
+
+func assertUpdates(t *testing.T, got, want []cgp.DependencyUpdate) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("updates = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("updates[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package advisory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+// DefaultOSVURL is the OSV batch query endpoint.
+const DefaultOSVURL = "https://api.osv.dev/v1/querybatch"
+
+// maxOSVResponseSize limits the size of an OSV response body.
+const maxOSVResponseSize = 10 << 20 // 10MB
+
+// OSVLookup queries the OSV vulnerability database (https://osv.dev).
+type OSVLookup struct {
+	url        string
+	httpClient *http.Client
+}
+
+// OSVOption configures an OSVLookup.
+type OSVOption func(*OSVLookup)
+
+// WithOSVURL overrides the OSV batch query endpoint.
+func WithOSVURL(url string) OSVOption {
+	return func(l *OSVLookup) {
+		l.url = url
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for OSV queries.
+func WithHTTPClient(client *http.Client) OSVOption {
+	return func(l *OSVLookup) {
+		l.httpClient = client
+	}
+}
+
+// NewOSVLookup creates an OSV advisory lookup.
+func NewOSVLookup(opts ...OSVOption) *OSVLookup {
+	l := &OSVLookup{
+		url: DefaultOSVURL,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+		},
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+type osvQuery struct {
+	Package osvPackage `json:"package"`
+	Version string     `json:"version"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID      string `json:"id"`
+			Summary string `json:"summary"`
+		} `json:"vulns"`
+	} `json:"results"`
+}
+
+// Lookup implements Lookup with a single OSV batch query.
+func (l *OSVLookup) Lookup(ctx context.Context, updates []cgp.DependencyUpdate) ([]Advisory, error) {
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	queries := make([]osvQuery, len(updates))
+	for i, u := range updates {
+		version := u.To
+		if u.Ecosystem == EcosystemGo {
+			// OSV records Go module versions without the "v" prefix.
+			version = strings.TrimPrefix(version, "v")
+		}
+		queries[i] = osvQuery{
+			Package: osvPackage{Name: u.Name, Ecosystem: u.Ecosystem},
+			Version: version,
+		}
+	}
+	body, err := json.Marshal(map[string]any{"queries": queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OSV query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "relicta-advisory")
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OSV: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV query failed: %s", resp.Status)
+	}
+
+	var result osvBatchResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOSVResponseSize)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode OSV response: %w", err)
+	}
+	if len(result.Results) != len(updates) {
+		return nil, fmt.Errorf("OSV returned %d results for %d queries", len(result.Results), len(updates))
+	}
+
+	var advisories []Advisory
+	for i, r := range result.Results {
+		for _, v := range r.Vulns {
+			advisories = append(advisories, Advisory{ID: v.ID, Summary: v.Summary, Dependency: updates[i]})
+		}
+	}
+	return advisories, nil
+}
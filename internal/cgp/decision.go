@@ -164,6 +164,10 @@ type ChangeAnalysis struct {
 	// BlastRadius quantifies potential impact.
 	BlastRadius *BlastRadius `json:"blastRadius,omitempty"`
 
+	// DependencyUpdates lists dependencies whose version changed in the
+	// repository's dependency manifests.
+	DependencyUpdates []DependencyUpdate `json:"dependencyUpdates,omitempty"`
+
 	// Commit categorization counts.
 	Features     int `json:"features"`
 	Fixes        int `json:"fixes"`
@@ -206,6 +210,25 @@ type DependencyImpact struct {
 	AffectedPackages []string `json:"affectedPackages,omitempty"`
 }
 
+// DependencyUpdate describes a dependency whose version changed in a
+// dependency manifest such as go.mod, package.json or Cargo.lock.
+type DependencyUpdate struct {
+	// Ecosystem is the package ecosystem: "Go", "npm" or "crates.io".
+	Ecosystem string `json:"ecosystem"`
+
+	// Name is the package name.
+	Name string `json:"name"`
+
+	// From is the previous version, empty for added dependencies.
+	From string `json:"from,omitempty"`
+
+	// To is the new version.
+	To string `json:"to"`
+
+	// Manifest is the manifest path the change was found in.
+	Manifest string `json:"manifest,omitempty"`
+}
+
 // BlastRadius quantifies potential impact.
 type BlastRadius struct {
 	// Score is the normalized blast radius (0.0-1.0).
@@ -0,0 +1,90 @@
+// Package memory provides the Release Memory store for CGP.
+package memory
+
+// Metadata keys recorded by the OutcomeTracker and read by SummarizeReleases.
+const (
+	MetadataBumpKind     = "bump_kind"
+	MetadataAutoApproved = "auto_approved"
+)
+
+// DefaultMetricsWindow is the number of recent releases used for rolling
+// metrics when no window is given.
+const DefaultMetricsWindow = 10
+
+// ReleaseMetrics summarizes the release history of a repository.
+type ReleaseMetrics struct {
+	// TotalReleases is the number of recorded releases.
+	TotalReleases int `json:"totalReleases"`
+
+	// ReleasesByBump counts releases by bump kind (major, minor, patch, ...).
+	// Releases recorded without a bump kind are counted as "unknown".
+	ReleasesByBump map[string]int `json:"releasesByBump"`
+
+	// AutoApproved and ManuallyApproved count releases by how they were
+	// approved. Releases recorded without approval info are not counted.
+	AutoApproved     int `json:"autoApproved"`
+	ManuallyApproved int `json:"manuallyApproved"`
+
+	// AutoApproveRatio is AutoApproved over all releases with approval info (0-1).
+	AutoApproveRatio float64 `json:"autoApproveRatio"`
+
+	// Window is the number of most recent releases used for rolling metrics.
+	Window int `json:"window"`
+
+	// AverageRiskScore is the average risk score over the window.
+	AverageRiskScore float64 `json:"averageRiskScore"`
+
+	// FailureRate is the share of failed or rolled back releases over the window (0-1).
+	FailureRate float64 `json:"failureRate"`
+}
+
+// SummarizeReleases computes release metrics from records ordered most
+// recent first, as returned by Store.GetReleaseHistory. Rolling metrics use
+// the first window records; a window of zero or less uses DefaultMetricsWindow.
+func SummarizeReleases(records []*ReleaseRecord, window int) *ReleaseMetrics {
+	if window <= 0 {
+		window = DefaultMetricsWindow
+	}
+
+	metrics := &ReleaseMetrics{
+		TotalReleases:  len(records),
+		ReleasesByBump: make(map[string]int),
+	}
+
+	for _, r := range records {
+		bump := r.Metadata[MetadataBumpKind]
+		if bump == "" {
+			bump = "unknown"
+		}
+		metrics.ReleasesByBump[bump]++
+
+		switch r.Metadata[MetadataAutoApproved] {
+		case "true":
+			metrics.AutoApproved++
+		case "false":
+			metrics.ManuallyApproved++
+		}
+	}
+	if approved := metrics.AutoApproved + metrics.ManuallyApproved; approved > 0 {
+		metrics.AutoApproveRatio = float64(metrics.AutoApproved) / float64(approved)
+	}
+
+	recent := records[:min(window, len(records))]
+	metrics.Window = len(recent)
+	if len(recent) == 0 {
+		return metrics
+	}
+
+	var totalRisk float64
+	var failures int
+	for _, r := range recent {
+		totalRisk += r.RiskScore
+		if r.Outcome == OutcomeFailed || r.Outcome == OutcomeRollback {
+			failures++
+		}
+	}
+	metrics.AverageRiskScore = totalRisk / float64(len(recent))
+	metrics.FailureRate = float64(failures) / float64(len(recent))
+
+	return metrics
+}
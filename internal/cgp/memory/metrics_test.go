@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSummarizeReleases(t *testing.T) {
+	t.Run("empty history", func(t *testing.T) {
+		m := SummarizeReleases(nil, 0)
+		if m.TotalReleases != 0 || m.Window != 0 || m.AverageRiskScore != 0 || m.FailureRate != 0 {
+			t.Errorf("SummarizeReleases(nil) = %+v, want zero metrics", m)
+		}
+	})
+
+	// Most recent first, as returned by GetReleaseHistory.
+	records := []*ReleaseRecord{
+		{RiskScore: 0.8, Outcome: OutcomeRollback, Metadata: map[string]string{MetadataBumpKind: "major", MetadataAutoApproved: "false"}},
+		{RiskScore: 0.2, Outcome: OutcomeSuccess, Metadata: map[string]string{MetadataBumpKind: "minor", MetadataAutoApproved: "true"}},
+		{RiskScore: 0.1, Outcome: OutcomePartial, Metadata: map[string]string{MetadataBumpKind: "patch", MetadataAutoApproved: "true"}},
+		{RiskScore: 0.9, Outcome: OutcomeFailed},
+	}
+
+	m := SummarizeReleases(records, 3)
+	if m.TotalReleases != 4 {
+		t.Errorf("TotalReleases = %d, want 4", m.TotalReleases)
+	}
+	for bump, want := range map[string]int{"major": 1, "minor": 1, "patch": 1, "unknown": 1} {
+		if got := m.ReleasesByBump[bump]; got != want {
+			t.Errorf("ReleasesByBump[%q] = %d, want %d", bump, got, want)
+		}
+	}
+	if m.AutoApproved != 2 || m.ManuallyApproved != 1 {
+		t.Errorf("approvals = %d auto, %d manual; want 2, 1", m.AutoApproved, m.ManuallyApproved)
+	}
+	if math.Abs(m.AutoApproveRatio-2.0/3) > 1e-9 {
+		t.Errorf("AutoApproveRatio = %v, want 2/3", m.AutoApproveRatio)
+	}
+
+	// The window covers the three most recent releases only.
+	if m.Window != 3 {
+		t.Errorf("Window = %d, want 3", m.Window)
+	}
+	if math.Abs(m.AverageRiskScore-(0.8+0.2+0.1)/3) > 1e-9 {
+		t.Errorf("AverageRiskScore = %v", m.AverageRiskScore)
+	}
+	if math.Abs(m.FailureRate-1.0/3) > 1e-9 {
+		t.Errorf("FailureRate = %v, want 1/3 (canceled releases are not failures)", m.FailureRate)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
@@ -113,6 +114,10 @@ func (t *OutcomeTracker) handleInitialized(e *release.RunCreatedEvent) error {
 func (t *OutcomeTracker) handlePlanned(e *release.RunPlannedEvent) error {
 	ctx := t.getOrCreateContext(e.AggregateID())
 	ctx.Version = e.VersionNext.String()
+	if e.RiskScore > 0 {
+		ctx.RiskScore = e.RiskScore
+	}
+	ctx.Metadata[MetadataBumpKind] = string(e.BumpKind)
 	return nil
 }
 
@@ -120,6 +125,7 @@ func (t *OutcomeTracker) handlePlanned(e *release.RunPlannedEvent) error {
 func (t *OutcomeTracker) handleApproved(e *release.RunApprovedEvent) error {
 	ctx := t.getOrCreateContext(e.AggregateID())
 	ctx.Metadata["approved_by"] = e.ApprovedBy
+	ctx.Metadata[MetadataAutoApproved] = strconv.FormatBool(e.AutoApproved)
 	return nil
 }
 
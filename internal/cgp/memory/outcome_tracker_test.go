@@ -308,8 +308,8 @@ func TestOutcomeTracker_EventChaining(t *testing.T) {
 	// Simulate the full event lifecycle
 	events := []release.DomainEvent{
 		&release.RunCreatedEvent{RunID: releaseID, RepoID: "owner/repo", At: time.Now()},
-		&release.RunPlannedEvent{RunID: releaseID, VersionCurrent: version.MustParse("1.0.0"), VersionNext: version.MustParse("1.1.0"), BumpKind: release.BumpMinor, CommitCount: 5, At: time.Now()},
-		&release.RunApprovedEvent{RunID: releaseID, ApprovedBy: "approver", At: time.Now()},
+		&release.RunPlannedEvent{RunID: releaseID, VersionCurrent: version.MustParse("1.0.0"), VersionNext: version.MustParse("1.1.0"), BumpKind: release.BumpMinor, CommitCount: 5, RiskScore: 0.4, At: time.Now()},
+		&release.RunApprovedEvent{RunID: releaseID, ApprovedBy: "approver", AutoApproved: true, At: time.Now()},
 		&release.RunPublishedEvent{RunID: releaseID, Version: version.MustParse("1.1.0"), At: time.Now()},
 	}
 
@@ -334,6 +334,12 @@ func TestOutcomeTracker_EventChaining(t *testing.T) {
 	if record.Metadata["approved_by"] != "approver" {
 		t.Errorf("expected approved_by metadata, got %v", record.Metadata)
 	}
+	if record.Metadata[MetadataBumpKind] != "minor" || record.Metadata[MetadataAutoApproved] != "true" {
+		t.Errorf("expected bump kind and auto-approval metadata, got %v", record.Metadata)
+	}
+	if record.RiskScore != 0.4 {
+		t.Errorf("expected risk score 0.4 from the plan, got %f", record.RiskScore)
+	}
 }
 
 // mockEventPublisher is a test double for EventPublisher.
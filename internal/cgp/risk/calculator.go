@@ -7,14 +7,20 @@ package risk
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/advisory"
 )
 
 // Calculator computes risk scores for changes.
 type Calculator struct {
 	weights WeightConfig
 	history HistoryProvider
+
+	advisories      advisory.Lookup
+	advisoryTimeout time.Duration
 }
 
 // WeightConfig defines the contribution of each factor to overall risk.
@@ -99,12 +105,109 @@ func NewCalculatorWithDefaults() *Calculator {
 	return NewCalculator(DefaultWeights())
 }
 
+// Factor names accepted by NewCalculatorWithWeights. They match the
+// Category reported on each cgp.RiskFactor.
+const (
+	FactorAPIChanges       = "api_change"
+	FactorDependencyImpact = "dependency_impact"
+	FactorBlastRadius      = "blast_radius"
+	FactorCodeComplexity   = "code_complexity"
+	FactorTestCoverage     = "test_coverage"
+	FactorActorTrust       = "actor_trust"
+	FactorHistoricalRisk   = "historical_risk"
+	FactorSecurityImpact   = "security_impact"
+)
+
+// FactorVulnerableDependencies is the category of the factor reported for
+// updated dependencies with known advisories. It is weighted as security impact.
+const FactorVulnerableDependencies = "vulnerable_dependencies"
+
+// FactorNames returns all factor names that can be weighted.
+func FactorNames() []string {
+	return []string{
+		FactorAPIChanges,
+		FactorDependencyImpact,
+		FactorBlastRadius,
+		FactorCodeComplexity,
+		FactorTestCoverage,
+		FactorActorTrust,
+		FactorHistoricalRisk,
+		FactorSecurityImpact,
+	}
+}
+
+// NewCalculatorWithWeights creates a calculator whose default weights are
+// overridden by the given factor weights. Unknown factor names and negative
+// values are ignored. The resulting weights are normalized to sum to 1.0 so
+// that the assessment score stays within 0..1.
+func NewCalculatorWithWeights(weights map[string]float64) *Calculator {
+	cfg := DefaultWeights()
+	for name, w := range weights {
+		if w < 0 {
+			continue
+		}
+		switch name {
+		case FactorAPIChanges:
+			cfg.APIChanges = w
+		case FactorDependencyImpact:
+			cfg.DependencyImpact = w
+		case FactorBlastRadius:
+			cfg.BlastRadius = w
+		case FactorCodeComplexity:
+			cfg.CodeComplexity = w
+		case FactorTestCoverage:
+			cfg.TestCoverage = w
+		case FactorActorTrust:
+			cfg.ActorTrust = w
+		case FactorHistoricalRisk:
+			cfg.HistoricalRisk = w
+		case FactorSecurityImpact:
+			cfg.SecurityImpact = w
+		}
+	}
+	return NewCalculator(cfg.Normalized())
+}
+
+// Normalized returns a copy of the weights scaled so they sum to 1.0.
+// If all weights are zero, the default weights are returned.
+func (w WeightConfig) Normalized() WeightConfig {
+	total := w.APIChanges + w.DependencyImpact + w.BlastRadius + w.CodeComplexity +
+		w.TestCoverage + w.ActorTrust + w.HistoricalRisk + w.SecurityImpact
+	if total <= 0 {
+		return DefaultWeights()
+	}
+	return WeightConfig{
+		APIChanges:       w.APIChanges / total,
+		DependencyImpact: w.DependencyImpact / total,
+		BlastRadius:      w.BlastRadius / total,
+		CodeComplexity:   w.CodeComplexity / total,
+		TestCoverage:     w.TestCoverage / total,
+		ActorTrust:       w.ActorTrust / total,
+		HistoricalRisk:   w.HistoricalRisk / total,
+		SecurityImpact:   w.SecurityImpact / total,
+	}
+}
+
+// Weights returns the weights used by the calculator.
+func (c *Calculator) Weights() WeightConfig {
+	return c.weights
+}
+
 // WithHistory sets the history provider for the calculator.
 func (c *Calculator) WithHistory(history HistoryProvider) *Calculator {
 	c.history = history
 	return c
 }
 
+// WithAdvisories sets the advisory lookup used to check updated
+// dependencies. A positive timeout bounds each lookup; when it expires or
+// the lookup fails, the factor is omitted rather than failing the assessment.
+func (c *Calculator) WithAdvisories(lookup advisory.Lookup, timeout time.Duration) *Calculator {
+	c.advisories = lookup
+	c.advisoryTimeout = timeout
+	return c
+}
+
 // Calculate computes the overall risk score.
 func (c *Calculator) Calculate(ctx context.Context, proposal *cgp.ChangeProposal, analysis *cgp.ChangeAnalysis) (*Assessment, error) {
 	factors := []cgp.RiskFactor{}
@@ -148,6 +251,15 @@ func (c *Calculator) Calculate(ctx context.Context, proposal *cgp.ChangeProposal
 		totalWeight += c.weights.SecurityImpact
 	}
 
+	// Vulnerable Dependencies (if advisory lookup available)
+	if c.advisories != nil {
+		if vulnScore, factor := c.assessVulnerableDependencies(ctx, analysis); factor != nil {
+			factors = append(factors, *factor)
+			totalScore += vulnScore * c.weights.SecurityImpact
+			totalWeight += c.weights.SecurityImpact
+		}
+	}
+
 	// Historical Risk (if history provider available)
 	if c.history != nil && proposal != nil {
 		if histScore, factor := c.assessHistoricalRisk(ctx, proposal); factor != nil {
@@ -390,6 +502,59 @@ func (c *Calculator) assessSecurityImpact(analysis *cgp.ChangeAnalysis) (float64
 	}
 }
 
+// assessVulnerableDependencies looks up advisories for updated dependencies.
+func (c *Calculator) assessVulnerableDependencies(ctx context.Context, analysis *cgp.ChangeAnalysis) (float64, *cgp.RiskFactor) {
+	if analysis == nil || len(analysis.DependencyUpdates) == 0 {
+		return 0, nil
+	}
+
+	if c.advisoryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.advisoryTimeout)
+		defer cancel()
+	}
+	advisories, err := c.advisories.Lookup(ctx, analysis.DependencyUpdates)
+	if err != nil || len(advisories) == 0 {
+		return 0, nil
+	}
+
+	// Group advisory IDs by affected dependency, in lookup order.
+	var affected []string
+	ids := make(map[string][]string)
+	for _, a := range advisories {
+		dep := a.Dependency.Name + "@" + a.Dependency.To
+		if _, ok := ids[dep]; !ok {
+			affected = append(affected, dep)
+		}
+		ids[dep] = append(ids[dep], a.ID)
+	}
+
+	score := 0.7
+	severity := cgp.SeverityHigh
+	if len(affected) > 3 {
+		score = 1.0
+		severity = cgp.SeverityCritical
+	} else if len(affected) > 1 {
+		score = 0.85
+	}
+
+	details := make([]string, len(affected))
+	for i, dep := range affected {
+		details[i] = fmt.Sprintf("%s (%s)", dep, strings.Join(ids[dep], ", "))
+	}
+	noun := "dependencies have"
+	if len(affected) == 1 {
+		noun = "dependency has"
+	}
+
+	return score, &cgp.RiskFactor{
+		Category:    FactorVulnerableDependencies,
+		Description: fmt.Sprintf("%d updated %s advisories: %s", len(affected), noun, strings.Join(details, "; ")),
+		Score:       score,
+		Severity:    severity,
+	}
+}
+
 // assessHistoricalRisk uses release memory to evaluate patterns.
 func (c *Calculator) assessHistoricalRisk(ctx context.Context, proposal *cgp.ChangeProposal) (float64, *cgp.RiskFactor) {
 	if c.history == nil {
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/advisory"
 )
 
 type mockHistoryProvider struct {
@@ -91,3 +93,82 @@ func TestGenerateSummaryHighRisk(t *testing.T) {
 		t.Fatalf("expected high-severity mention, got %q", high)
 	}
 }
+
+type mockAdvisoryLookup struct {
+	advisories []advisory.Advisory
+	err        error
+	block      bool
+}
+
+func (m mockAdvisoryLookup) Lookup(ctx context.Context, _ []cgp.DependencyUpdate) ([]advisory.Advisory, error) {
+	if m.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return m.advisories, m.err
+}
+
+func TestCalculator_VulnerableDependencies(t *testing.T) {
+	lodash := cgp.DependencyUpdate{Ecosystem: "npm", Name: "lodash", From: "4.17.15", To: "4.17.20"}
+	xnet := cgp.DependencyUpdate{Ecosystem: "Go", Name: "golang.org/x/net", From: "v0.17.0", To: "v0.20.0"}
+	analysis := &cgp.ChangeAnalysis{DependencyUpdates: []cgp.DependencyUpdate{lodash, xnet}}
+
+	baseline, err := NewCalculatorWithDefaults().Calculate(context.Background(), nil, analysis)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+
+	calc := NewCalculatorWithDefaults().WithAdvisories(mockAdvisoryLookup{advisories: []advisory.Advisory{
+		{ID: "CVE-2021-23337", Dependency: lodash},
+		{ID: "GHSA-35jh-r3h4-6jhm", Dependency: lodash},
+		{ID: "GO-2024-2687", Dependency: xnet},
+	}}, time.Second)
+	assessment, err := calc.Calculate(context.Background(), nil, analysis)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	if assessment.Score <= baseline.Score {
+		t.Errorf("Score = %v, want above baseline %v", assessment.Score, baseline.Score)
+	}
+
+	var factor *cgp.RiskFactor
+	for i := range assessment.Factors {
+		if assessment.Factors[i].Category == FactorVulnerableDependencies {
+			factor = &assessment.Factors[i]
+		}
+	}
+	if factor == nil {
+		t.Fatal("expected vulnerable dependencies factor")
+	}
+	want := "2 updated dependencies have advisories: lodash@4.17.20 (CVE-2021-23337, GHSA-35jh-r3h4-6jhm); golang.org/x/net@v0.20.0 (GO-2024-2687)"
+	if factor.Description != want {
+		t.Errorf("Description = %q, want %q", factor.Description, want)
+	}
+	if factor.Severity != cgp.SeverityHigh {
+		t.Errorf("Severity = %v, want high", factor.Severity)
+	}
+}
+
+func TestCalculator_VulnerableDependencies_LookupFailures(t *testing.T) {
+	analysis := &cgp.ChangeAnalysis{DependencyUpdates: []cgp.DependencyUpdate{{Ecosystem: "npm", Name: "lodash", To: "4.17.20"}}}
+
+	tests := map[string]mockAdvisoryLookup{
+		"error":   {err: errors.New("offline")},
+		"timeout": {block: true},
+		"none":    {},
+	}
+	for name, lookup := range tests {
+		t.Run(name, func(t *testing.T) {
+			calc := NewCalculatorWithDefaults().WithAdvisories(lookup, 10*time.Millisecond)
+			assessment, err := calc.Calculate(context.Background(), nil, analysis)
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+			for _, f := range assessment.Factors {
+				if f.Category == FactorVulnerableDependencies {
+					t.Errorf("unexpected factor %+v", f)
+				}
+			}
+		})
+	}
+}
@@ -440,3 +440,54 @@ func TestCalculator_LowRiskScenario(t *testing.T) {
 		t.Errorf("Low risk scenario should be low/medium severity, got %v", assessment.Severity)
 	}
 }
+
+func TestNewCalculatorWithWeights(t *testing.T) {
+	calc := NewCalculatorWithWeights(map[string]float64{
+		FactorAPIChanges:  2.0,
+		FactorActorTrust:  -1.0,
+		"unknown_factor":  5.0,
+		FactorBlastRadius: 0,
+	})
+
+	w := calc.Weights()
+	sum := w.APIChanges + w.DependencyImpact + w.BlastRadius + w.CodeComplexity +
+		w.TestCoverage + w.ActorTrust + w.HistoricalRisk + w.SecurityImpact
+	if sum < 0.999 || sum > 1.001 {
+		t.Errorf("weights should sum to 1.0, got %v", sum)
+	}
+	if w.BlastRadius != 0 {
+		t.Errorf("BlastRadius weight = %v, want 0", w.BlastRadius)
+	}
+	if w.APIChanges <= DefaultWeights().APIChanges {
+		t.Errorf("APIChanges weight should increase, got %v", w.APIChanges)
+	}
+	if w.ActorTrust <= 0 {
+		t.Error("negative weight should be ignored and keep the default")
+	}
+
+	proposal := cgp.NewProposal(
+		cgp.NewAgentActor("agent-1", "Agent", "model"),
+		cgp.ProposalScope{Repository: "owner/repo"},
+		cgp.ProposalIntent{Summary: "Change"},
+	)
+	analysis := &cgp.ChangeAnalysis{
+		Security: 5,
+		APIChanges: []cgp.APIChange{
+			{Type: "removed", Symbol: "Foo", Breaking: true},
+		},
+	}
+	assessment, err := calc.Calculate(context.Background(), proposal, analysis)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	if assessment.Score < 0 || assessment.Score > 1 {
+		t.Errorf("Score = %v, want within 0..1", assessment.Score)
+	}
+}
+
+func TestWeightConfig_NormalizedAllZero(t *testing.T) {
+	w := WeightConfig{}.Normalized()
+	if w != DefaultWeights() {
+		t.Errorf("all-zero weights should fall back to defaults, got %+v", w)
+	}
+}
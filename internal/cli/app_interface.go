@@ -36,7 +36,7 @@ type cliApp interface {
 }
 
 var newContainerApp = func(ctx context.Context, cfg *config.Config) (cliApp, error) {
-	app, err := container.NewInitialized(ctx, cfg)
+	app, err := container.NewInitialized(ctx, cfg, container.WithWarningHandler(recordWarning))
 	if err != nil {
 		return nil, err
 	}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,9 @@ const (
 
 	// filePermPrivate is restrictive file permission (owner read/write only).
 	filePermPrivate = 0o600
+
+	// dirPermReadable is directory permission for user-readable directories.
+	dirPermReadable = 0o755
 )
 
 var (
@@ -38,6 +42,8 @@ var (
 	approveEdit        bool
 	approveEditor      string
 	approveInteractive bool
+	approveReject      bool
+	approveReason      string
 )
 
 var runApprovalTUI = ui.RunApprovalTUI
@@ -47,6 +53,10 @@ func init() {
 	approveCmd.Flags().BoolVarP(&approveEdit, "edit", "e", false, "edit release notes before approving")
 	approveCmd.Flags().StringVarP(&approveEditor, "editor", "E", "", "editor to use (default: $EDITOR or vim)")
 	approveCmd.Flags().BoolVarP(&approveInteractive, "interactive", "i", false, "use interactive TUI for approval")
+	approveCmd.Flags().BoolVar(&approveReject, "reject", false, "reject the release notes and request changes instead of approving")
+	approveCmd.Flags().StringVar(&approveReason, "reason", "", "reason for rejecting the release (required with --reject)")
+	approveCmd.MarkFlagsMutuallyExclusive("reject", "yes")
+	approveCmd.MarkFlagsMutuallyExclusive("reject", "edit")
 }
 
 // getLatestRelease retrieves the latest release from the repository.
@@ -92,6 +102,10 @@ func displayLoadedRelease(rel *release.ReleaseRun) {
 
 // isReleaseAlreadyApproved checks if the release is already approved and prints info.
 func isReleaseAlreadyApproved(rel *release.ReleaseRun) bool {
+	if rel.IsApprovalExpired(time.Now()) {
+		printWarning("Release approval has expired; re-approval is required")
+		return false
+	}
 	if rel.State() == release.StateApproved || rel.IsApproved() {
 		printInfo("Release already approved")
 		printInfo("Run 'relicta publish' to execute the release")
@@ -109,6 +123,19 @@ func validateReleaseStateForApproval(rel *release.ReleaseRun) error {
 	case release.StateNotesReady:
 		// Ideal state - ready for approval
 		return nil
+	case release.StateApproved:
+		// Only reached when the previous approval has expired
+		return nil
+	case release.StateChangesRequested:
+		if rejection := rel.LastRejection(); rejection != nil {
+			printWarning(fmt.Sprintf("Changes requested by %s: %s", rejection.RejectedBy, rejection.Reason))
+		}
+		if approveEdit {
+			// Editing the notes resubmits them before approval
+			return nil
+		}
+		printInfo("Revise the notes with 'relicta notes --regenerate' or 'relicta approve --edit'")
+		return fmt.Errorf("release in state '%s' cannot be approved until the notes are revised", state)
 	case release.StatePlanned, release.StateVersioned:
 		// Allow but warn about missing notes
 		printWarning("Release notes have not been generated")
@@ -223,6 +250,11 @@ func executeApprovalWithServices(ctx context.Context, app cliApp, repoPath strin
 		}
 	}
 
+	packageTags, err := monorepoPackageTags(ctx, app.GitAdapter(), repoPath, rel)
+	if err != nil {
+		return fmt.Errorf("failed to determine package release order: %w", err)
+	}
+
 	input := releaseapp.ApproveReleaseInput{
 		RepoRoot: repoPath,
 		RunID:    rel.ID(),
@@ -232,9 +264,14 @@ func executeApprovalWithServices(ctx context.Context, app cliApp, repoPath strin
 		},
 		AutoApprove: approveYes,
 		Force:       true, // Force since we've already validated state
+		PackageTags: packageTags,
+		ApprovalTTL: cfg.Governance.ApprovalTTL,
 	}
 
-	_, err := services.ApproveRelease.Execute(ctx, input)
+	if err := checkStrict(); err != nil {
+		return err
+	}
+	_, err = services.ApproveRelease.Execute(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to approve release: %w", err)
 	}
@@ -272,6 +309,10 @@ func runApprove(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if approveReject {
+		return runReject(ctx, app, rel)
+	}
+
 	// Check if already approved
 	if isReleaseAlreadyApproved(rel) {
 		return nil
@@ -498,6 +539,20 @@ func displayGovernanceResult(result *governance.EvaluateReleaseOutput) {
 		}
 	}
 
+	// Display commits without a linked issue
+	if len(result.UnlinkedCommits) > 0 {
+		fmt.Println()
+		fmt.Println("  Commits Without Linked Issue:")
+		for _, c := range result.UnlinkedCommits {
+			fmt.Printf("    - %s %s: %s\n", c.Hash, c.Type, c.Subject)
+		}
+	}
+
+	for _, w := range result.Warnings {
+		fmt.Println()
+		printWarning(w)
+	}
+
 	// Display historical context if available
 	if result.HistoricalContext != nil && result.HistoricalContext.RecentReleases > 0 {
 		fmt.Println()
@@ -0,0 +1,82 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+)
+
+// runReject rejects the release notes, requesting changes instead of
+// approving. Unlike 'relicta cancel' the release stays open for revision.
+func runReject(ctx context.Context, app cliApp, rel *release.ReleaseRun) error {
+	reason := strings.TrimSpace(approveReason)
+	if reason == "" {
+		return fmt.Errorf("--reject requires --reason describing the requested changes")
+	}
+
+	if rel.State() != release.StateNotesReady {
+		return release.NewStateTransitionError(rel.State(), "reject")
+	}
+
+	if dryRun {
+		printWarning("Dry run - rejection not saved")
+		return nil
+	}
+
+	gitAdapter := app.GitAdapter()
+	repoInfo, err := gitAdapter.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	if err := app.InitReleaseServices(ctx, repoInfo.Path); err != nil {
+		return fmt.Errorf("failed to initialize release services: %w", err)
+	}
+	services := app.ReleaseServices()
+	if services == nil || services.RejectRelease == nil {
+		return fmt.Errorf("RejectRelease use case not available")
+	}
+
+	output, err := services.RejectRelease.Execute(ctx, releaseapp.RejectReleaseInput{
+		RepoRoot: repoInfo.Path,
+		RunID:    rel.ID(),
+		Actor: ports.ActorInfo{
+			Type: "user",
+			ID:   getApproverName(),
+		},
+		Reason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reject release: %w", err)
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{
+			"rejected":     true,
+			"release_id":   string(output.RunID),
+			"next_version": output.VersionNext,
+			"rejected_by":  output.RejectedBy,
+			"reason":       output.Reason,
+			"state":        string(release.StateChangesRequested),
+		})
+	}
+
+	printWarning("Release rejected - changes requested")
+	printInfo(fmt.Sprintf("Reason: %s", output.Reason))
+	fmt.Println()
+
+	printTitle("Next Steps")
+	fmt.Println()
+	fmt.Println("  Revise the notes with 'relicta notes --regenerate' or 'relicta approve --edit'")
+	fmt.Println("  Run 'relicta approve' to approve the revised notes")
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+// Layout of the --output-dir directory. Paths are relative to the directory.
+const (
+	artifactNotesFile     = "release-notes.md"
+	artifactChangelogFile = "CHANGELOG.md"
+	artifactManifestFile  = "manifest.json"
+	artifactPackagesDir   = "changelogs"
+	artifactChecksumsFile = "SHA256SUMS"
+)
+
+// releaseArtifacts holds the documents generated for a release.
+type releaseArtifacts struct {
+	// Notes is the release notes text.
+	Notes string
+	// Changelog is the entry added to the changelog file.
+	Changelog string
+	// Manifest describes the run's publish steps.
+	Manifest *release.PublishManifest
+	// Packages lists the per-package changelog entries.
+	Packages []monorepo.PackageChangelog
+}
+
+// newReleaseArtifacts collects the documents generated for rel. packages are
+// the per-package changelog entries of a monorepo release.
+func newReleaseArtifacts(rel *release.ReleaseRun, packages []monorepo.PackageChangelog) releaseArtifacts {
+	manifest := rel.PublishManifest()
+	artifacts := releaseArtifacts{Manifest: &manifest, Packages: packages}
+	if rel.Notes() != nil {
+		artifacts.Notes = rel.Notes().Text
+		artifacts.Changelog = changelogEntry(artifacts.Notes, packages)
+	}
+	return artifacts
+}
+
+// writeReleaseArtifacts writes the artifacts to dir and returns the written
+// files relative to dir. Every file is written atomically, followed by a
+// SHA256SUMS file listing the checksum of each artifact:
+//
+//	release-notes.md
+//	CHANGELOG.md
+//	manifest.json
+//	changelogs/<package changelog path>
+//	SHA256SUMS
+func writeReleaseArtifacts(dir string, artifacts releaseArtifacts, now time.Time) ([]string, error) {
+	files := make(map[string][]byte)
+	if artifacts.Notes != "" {
+		files[artifactNotesFile] = []byte(strings.TrimRight(artifacts.Notes, "\n") + "\n")
+	}
+	if artifacts.Changelog != "" {
+		files[artifactChangelogFile] = []byte(strings.TrimRight(artifacts.Changelog, "\n") + "\n")
+	}
+	if artifacts.Manifest != nil {
+		data, err := json.MarshalIndent(artifacts.Manifest, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		files[artifactManifestFile] = append(data, '\n')
+	}
+	for _, entry := range artifacts.Packages {
+		files[path.Join(artifactPackagesDir, entry.File)] = []byte(entry.Render(now))
+	}
+
+	names := make([]string, 0, len(files)+1)
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var sums strings.Builder
+	for _, name := range names {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), dirPermReadable); err != nil { // #nosec G301 -- artifacts are uploaded by CI
+			return nil, fmt.Errorf("failed to create artifact directory: %w", err)
+		}
+		if err := fileutil.AtomicWriteFile(target, files[name], filePermReadable); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		sum := sha256.Sum256(files[name])
+		fmt.Fprintf(&sums, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	}
+
+	if err := os.MkdirAll(dir, dirPermReadable); err != nil { // #nosec G301 -- artifacts are uploaded by CI
+		return nil, fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+	if err := fileutil.AtomicWriteFile(filepath.Join(dir, artifactChecksumsFile), []byte(sums.String()), filePermReadable); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", artifactChecksumsFile, err)
+	}
+	return append(names, artifactChecksumsFile), nil
+}
+
+// exportReleaseArtifacts writes the artifacts to dir when dir is set,
+// reporting the outcome. Failing to export does not fail the release.
+func exportReleaseArtifacts(dir string, artifacts releaseArtifacts) {
+	if dir == "" {
+		return
+	}
+	written, err := writeReleaseArtifacts(dir, artifacts, time.Now())
+	if err != nil {
+		printWarning(fmt.Sprintf("Failed to write release artifacts: %v", err))
+		return
+	}
+	printSuccess(fmt.Sprintf("Wrote %d release artifact(s) to %s", len(written), dir))
+}
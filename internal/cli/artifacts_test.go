@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
+)
+
+func TestWriteReleaseArtifacts(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+	cfg.Monorepo.Changelog.RootChangelog = true
+
+	rel := newNotesReadyRelease(t, "artifacts")
+	packages := []monorepo.PackageChangelog{{Package: "packages/core", File: "packages/core/CHANGELOG.md", Version: "1.0.0"}}
+	dir := filepath.Join(t.TempDir(), "artifacts")
+
+	written, err := writeReleaseArtifacts(dir, newReleaseArtifacts(rel, packages), time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("writeReleaseArtifacts() error = %v", err)
+	}
+	want := []string{"CHANGELOG.md", "changelogs/packages/core/CHANGELOG.md", "manifest.json", "release-notes.md", "SHA256SUMS"}
+	if !reflect.DeepEqual(written, want) {
+		t.Fatalf("written = %v, want %v", written, want)
+	}
+
+	read := func(name string) string {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		return string(data)
+	}
+
+	if got := read("release-notes.md"); got != "Test release notes\n" {
+		t.Errorf("release-notes.md = %q", got)
+	}
+	if got := read("CHANGELOG.md"); !strings.Contains(got, "Test release notes") || !strings.Contains(got, "[packages/core](packages/core/CHANGELOG.md)") {
+		t.Errorf("CHANGELOG.md = %q", got)
+	}
+	if got := read("changelogs/packages/core/CHANGELOG.md"); !strings.HasPrefix(got, "## [1.0.0] - 2026-10-15") {
+		t.Errorf("package changelog = %q", got)
+	}
+
+	var manifest release.PublishManifest
+	if err := json.Unmarshal([]byte(read("manifest.json")), &manifest); err != nil {
+		t.Fatalf("manifest.json: %v", err)
+	}
+	if manifest.Version != "1.0.0" || manifest.TagName != "v1.0.0" {
+		t.Errorf("manifest = %+v", manifest)
+	}
+
+	sums := read("SHA256SUMS")
+	for _, name := range want[:len(want)-1] {
+		sum := sha256.Sum256([]byte(read(name)))
+		if line := hex.EncodeToString(sum[:]) + "  " + name + "\n"; !strings.Contains(sums, line) {
+			t.Errorf("SHA256SUMS missing %q:\n%s", line, sums)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			t.Errorf("temporary file left behind: %s", e.Name())
+		}
+	}
+}
+
+func TestPlannedReleaseArtifacts(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+
+	rel := newNotesReadyRelease(t, "planned-artifacts")
+	plan := &servicerelease.AnalyzeOutput{NextVersion: rel.VersionNext()}
+	artifacts := plannedReleaseArtifacts(plan, &releaseBumpOutput{Version: rel.VersionNext(), TagName: "v1.0.0"}, nil)
+
+	if artifacts.Notes != "" || artifacts.Changelog != "" {
+		t.Errorf("expected no notes without generated notes, got %+v", artifacts)
+	}
+	if artifacts.Manifest == nil || artifacts.Manifest.TagName != "v1.0.0" || artifacts.Manifest.SchemaVersion != release.PublishManifestSchemaVersion {
+		t.Errorf("manifest = %+v", artifacts.Manifest)
+	}
+
+	dir := t.TempDir()
+	written, err := writeReleaseArtifacts(dir, artifacts, time.Now())
+	if err != nil {
+		t.Fatalf("writeReleaseArtifacts() error = %v", err)
+	}
+	if want := []string{"manifest.json", "SHA256SUMS"}; !reflect.DeepEqual(written, want) {
+		t.Errorf("written = %v, want %v", written, want)
+	}
+}
@@ -3,13 +3,13 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
 	"github.com/relicta-tech/relicta/internal/application/versioning"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
@@ -22,14 +22,18 @@ var (
 	bumpPrerelease string
 	bumpBuild      string
 	bumpForce      string
+
+	bumpAllowDowngrade bool
 )
 
 func init() {
-	bumpCmd.Flags().StringVarP(&bumpLevel, "level", "l", "", "bump level (major, minor, patch) - overrides auto-detection")
+	bumpCmd.Flags().StringVarP(&bumpLevel, "level", "l", "", "bump level (major, minor, patch, prerelease) - overrides auto-detection")
 	bumpCmd.Flags().StringVarP(&bumpPrerelease, "prerelease", "p", "", "prerelease identifier (e.g., alpha, beta, rc.1)")
+	bumpCmd.Flags().StringVar(&bumpPrerelease, "pre", "", "enter or continue a prerelease line, e.g. --pre beta turns a minor bump of 1.2.0 into 1.3.0-beta.1")
 	bumpCmd.Flags().StringVarP(&bumpBuild, "build", "b", "", "build metadata")
 	bumpCmd.Flags().StringVar(&bumpForce, "force", "", "set a specific version (e.g., 2.0.0), bypasses commit analysis")
 	bumpCmd.Flags().StringVar(&bumpForce, "version", "", "alias for --force: set a specific version")
+	bumpCmd.Flags().BoolVar(&bumpAllowDowngrade, "allow-downgrade", false, "allow --force/--version to set a version that is not greater than the latest release")
 	// Note: --tag and --push flags removed - tags are now created during 'relicta publish'
 }
 
@@ -42,11 +46,25 @@ func parseBumpLevel(level string) (version.BumpType, bool, error) {
 		return version.BumpMinor, false, nil
 	case "patch":
 		return version.BumpPatch, false, nil
+	case "prerelease":
+		return version.BumpPrerelease, false, nil
 	case "":
 		return version.BumpType(""), true, nil // Will auto-detect from commits
 	default:
-		return version.BumpType(""), false, fmt.Errorf("invalid bump level: %s (expected major, minor, or patch)", level)
+		return version.BumpType(""), false, fmt.Errorf("invalid bump level: %s (expected major, minor, patch, or prerelease)", level)
+	}
+}
+
+// validatePrereleaseSuffix checks that a prerelease suffix forms a valid
+// semantic version prerelease.
+func validatePrereleaseSuffix(suffix string) error {
+	if suffix == "" {
+		return nil
+	}
+	if _, err := version.Parse("0.0.0-" + suffix); err != nil {
+		return fmt.Errorf("invalid prerelease suffix %q: %w", suffix, err)
 	}
+	return nil
 }
 
 // handleForcedVersion handles the --force flag to set a specific version.
@@ -56,6 +74,20 @@ func handleForcedVersion(ctx context.Context, app cliApp, forcedVersionStr strin
 	if err != nil {
 		return fmt.Errorf("invalid version format: %w", err)
 	}
+	if err := checkForcedVersionIncreases(ctx, app, forcedVersion); err != nil {
+		return err
+	}
+
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
+	// Refuse ambiguous version files before the release state changes
+	if cfg.Monorepo.Enabled {
+		if err := checkPackageVersionFiles(ctx, app); err != nil {
+			return err
+		}
+	}
 
 	// Update release state if there's an active release
 	// ErrRunNotFound is expected when bump runs standalone without prior plan
@@ -65,6 +97,9 @@ func handleForcedVersion(ctx context.Context, app cliApp, forcedVersionStr strin
 				return fmt.Errorf("failed to update release state: %w", err)
 			}
 		}
+		if err := writeBumpedVersionFile(ctx, app, forcedVersion); err != nil {
+			return err
+		}
 	}
 
 	tagName := cfg.Versioning.TagPrefix + forcedVersion.String()
@@ -74,9 +109,7 @@ func handleForcedVersion(ctx context.Context, app cliApp, forcedVersionStr strin
 			"version":  forcedVersion.String(),
 			"tag_name": tagName,
 		}
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		return encoder.Encode(output)
+		return printStructuredOutput(output)
 	}
 
 	printInfo(fmt.Sprintf("Version set to: %s%s", cfg.Versioning.TagPrefix, forcedVersion.String()))
@@ -84,16 +117,53 @@ func handleForcedVersion(ctx context.Context, app cliApp, forcedVersionStr strin
 	return nil
 }
 
+// checkForcedVersionIncreases refuses a forced version that is not greater
+// than the current version, unless --allow-downgrade is set. The current
+// version is read from the project version file, if there is one, and
+// otherwise from the latest version tag; repositories without version tags
+// accept any version.
+func checkForcedVersionIncreases(ctx context.Context, app cliApp, forcedVersion version.SemanticVersion) error {
+	if bumpAllowDowngrade {
+		return nil
+	}
+	fileVersion, err := bumpCurrentVersion(ctx, app)
+	if err != nil {
+		return err
+	}
+	if fileVersion != nil {
+		return releaseapp.CheckVersionIncreases(*fileVersion, forcedVersion, false)
+	}
+	prefix := cfg.Versioning.TagPrefix
+	latest, err := app.GitAdapter().GetLatestVersionTag(ctx, prefix)
+	if err != nil || latest == nil {
+		return nil
+	}
+	current, err := version.Parse(strings.TrimPrefix(latest.Name(), prefix))
+	if err != nil {
+		return nil
+	}
+	return releaseapp.CheckVersionIncreases(current, forcedVersion, false)
+}
+
 // buildCalculateVersionInput creates the input for the CalculateVersion use case.
 func buildCalculateVersionInput(bumpType version.BumpType, auto bool) versioning.CalculateVersionInput {
 	input := versioning.CalculateVersionInput{
-		TagPrefix: cfg.Versioning.TagPrefix,
-		BumpType:  bumpType,
-		Auto:      auto,
+		TagPrefix:    cfg.Versioning.TagPrefix,
+		BumpType:     bumpType,
+		Auto:         auto,
+		CommitParser: configCommitParser(),
 	}
 
-	if bumpPrerelease != "" {
+	// The configured prerelease suffix applies to prerelease bumps and
+	// continues an existing prerelease series on auto-detected bumps; an
+	// explicit major/minor/patch level promotes to stable.
+	switch {
+	case bumpPrerelease != "":
 		input.Prerelease = version.Prerelease(bumpPrerelease)
+	case bumpType == version.BumpPrerelease:
+		input.Prerelease = version.Prerelease(cfg.Versioning.PrereleaseSuffix)
+	case auto:
+		input.ContinuePrerelease = version.Prerelease(cfg.Versioning.PrereleaseSuffix)
 	}
 
 	return input
@@ -157,12 +227,20 @@ func runVersion(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if err := validatePrereleaseSuffix(bumpPrerelease); err != nil {
+		return err
+	}
 
 	// Handle forced version separately
 	if bumpForce != "" {
 		return handleForcedVersion(ctx, app, bumpForce)
 	}
 
+	currentVersion, err := bumpCurrentVersion(ctx, app)
+	if err != nil {
+		return err
+	}
+
 	// Calculate version
 	var spinner *Spinner
 	if !outputJSON {
@@ -171,6 +249,8 @@ func runVersion(cmd *cobra.Command, args []string) error {
 	}
 
 	calcInput := buildCalculateVersionInput(bumpType, auto)
+	calcInput.BaseTag = hotfixBaseTag(ctx, app)
+	calcInput.CurrentVersion = currentVersion
 	calcOutput, err := app.CalculateVersion().Execute(ctx, calcInput)
 
 	if spinner != nil {
@@ -200,6 +280,10 @@ func runVersion(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
 	// Update release state if there's an active release
 	// ErrRunNotFound is expected when bump runs standalone without prior plan
 	// Note: Tags are created during 'relicta publish', not here
@@ -209,6 +293,14 @@ func runVersion(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if cfg.Monorepo.Enabled {
+		if err := updatePackageVersionFiles(ctx, app); err != nil {
+			return err
+		}
+	} else if err := writeBumpedVersionFile(ctx, app, nextVersion); err != nil {
+		return err
+	}
+
 	// Output JSON after operations complete
 	if outputJSON {
 		return outputBumpJSON(calcOutput.CurrentVersion, nextVersion, calcOutput.BumpType, calcOutput.AutoDetected)
@@ -226,6 +318,13 @@ func runBumpTagPush(ctx context.Context, app cliApp, existingVer version.Semanti
 
 	printInfo(fmt.Sprintf("HEAD is already tagged: %s", existingTag))
 
+	// A prerelease tag was cut deliberately; commits since the previous
+	// tag would otherwise be calculated as a promotion to stable.
+	if existingVer.IsPrerelease() {
+		printInfo("Existing tag is a prerelease - using it")
+		return finishBumpTagPush(ctx, app, existingVer, existingVer, false)
+	}
+
 	// Calculate what version would be needed based on commits
 	calcInput := buildCalculateVersionInput(version.BumpType(""), true)
 	calcOutput, err := app.CalculateVersion().Execute(ctx, calcInput)
@@ -255,6 +354,10 @@ func runBumpTagPush(ctx context.Context, app cliApp, existingVer version.Semanti
 func finishBumpTagPush(ctx context.Context, app cliApp, existingVer, targetVer version.SemanticVersion, needsNewTag bool) error {
 	tagName := cfg.Versioning.TagPrefix + targetVer.String()
 
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
 	// Update release state (unless dry run)
 	if !dryRun {
 		if err := updateReleaseVersion(ctx, app, targetVer); err != nil {
@@ -279,9 +382,7 @@ func finishBumpTagPush(ctx context.Context, app cliApp, existingVer, targetVer v
 			"next_version":    targetVer.String(),
 			"tag_name":        tagName,
 		}
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		return encoder.Encode(output)
+		return printStructuredOutput(output)
 	}
 
 	// Text output - Note about tag creation
@@ -301,6 +402,87 @@ func finishBumpTagPush(ctx context.Context, app cliApp, existingVer, targetVer v
 	return nil
 }
 
+// checkPackageVersionFiles returns an error if a monorepo package being
+// released has an ambiguous version file, which bumping could not update.
+func checkPackageVersionFiles(ctx context.Context, app cliApp) error {
+	gitAdapter := app.GitAdapter()
+	repoInfo, err := gitAdapter.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	if err != nil || !run.State().IsActive() || !run.HasChangeSet() {
+		// Bump can run standalone without a planned release
+		return nil
+	}
+
+	pkgPlan, err := buildMonorepoPackagePlan(ctx, gitAdapter, repoInfo.Path, run.ChangeSet().Commits())
+	if err != nil {
+		return err
+	}
+	return monorepo.CheckVersionFiles(pkgPlan.VersionFiles)
+}
+
+// updatePackageVersionFiles writes the bumped version to the version files
+// of the monorepo packages being released.
+func updatePackageVersionFiles(ctx context.Context, app cliApp) error {
+	gitAdapter := app.GitAdapter()
+	repoInfo, err := gitAdapter.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	if err != nil || !run.State().IsActive() {
+		// Bump can run standalone without a planned release
+		return nil
+	}
+
+	updated, err := writePackageVersionFiles(ctx, gitAdapter, repoInfo.Path, run)
+	if err != nil {
+		return err
+	}
+	if !outputJSON {
+		for _, file := range updated {
+			printInfo(fmt.Sprintf("Updated version in %s", file))
+		}
+	}
+	return nil
+}
+
+// hotfixBaseTag returns the base tag of the active release run if it is a
+// hotfix planned with 'relicta plan --base-tag', that is, based on an older
+// version tag than the latest one. It returns "" otherwise.
+func hotfixBaseTag(ctx context.Context, app cliApp) string {
+	gitAdapter := app.GitAdapter()
+	if gitAdapter == nil {
+		return ""
+	}
+	repoInfo, err := gitAdapter.GetInfo(ctx)
+	if err != nil {
+		return ""
+	}
+	if !app.HasReleaseServices() {
+		if err := app.InitReleaseServices(ctx, repoInfo.Path); err != nil {
+			return ""
+		}
+	}
+	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	if err != nil || run == nil || !run.State().IsActive() {
+		return ""
+	}
+
+	prefix := cfg.Versioning.TagPrefix
+	base, err := version.Parse(strings.TrimPrefix(run.BaseRef(), prefix))
+	if err != nil {
+		return ""
+	}
+	latest, err := gitAdapter.GetLatestVersionTag(ctx, prefix)
+	if err != nil || latest == nil || latest.Version() == nil || !base.LessThan(*latest.Version()) {
+		return ""
+	}
+	return run.BaseRef()
+}
+
 // updateReleaseVersion updates the active release with the bumped version.
 func updateReleaseVersion(ctx context.Context, app cliApp, ver version.SemanticVersion) error {
 	gitAdapter := app.GitAdapter()
@@ -334,6 +516,10 @@ func updateReleaseVersion(ctx context.Context, app cliApp, ver version.SemanticV
 		Force:           true, // Force since git operations already happened
 		OverrideVersion: &ver,
 		OverrideTagName: tagName,
+		// Callers pass either a calculated version or a forced one already
+		// checked by checkForcedVersionIncreases; tag-push mode reuses the
+		// version of the tag at HEAD.
+		AllowDowngrade: true,
 	}
 
 	_, err = services.BumpVersion.Execute(ctx, input)
@@ -367,7 +553,7 @@ func updateReleaseVersionLegacy(ctx context.Context, app cliApp, repoPath string
 	return releaseRepo.Save(ctx, rel)
 }
 
-// outputBumpJSON outputs the version bump as JSON.
+// outputBumpJSON outputs the version bump as JSON or YAML.
 func outputBumpJSON(current, next version.SemanticVersion, bumpType version.BumpType, autoDetected bool) error {
 	output := map[string]any{
 		"current_version": current.String(),
@@ -377,12 +563,10 @@ func outputBumpJSON(current, next version.SemanticVersion, bumpType version.Bump
 		"tag_name":        cfg.Versioning.TagPrefix + next.String(),
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return printStructuredOutput(output)
 }
 
-// outputSetVersionJSON outputs the set version result as JSON.
+// outputSetVersionJSON outputs the set version result as JSON or YAML.
 func outputSetVersionJSON(output *versioning.SetVersionOutput) error {
 	result := map[string]any{
 		"version":     output.Version.String(),
@@ -391,7 +575,5 @@ func outputSetVersionJSON(output *versioning.SetVersionOutput) error {
 		"tag_pushed":  output.TagPushed,
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(result)
+	return printStructuredOutput(result)
 }
@@ -2,10 +2,13 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/release"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
 )
 
 type stubReleaseRepo struct {
@@ -76,3 +79,55 @@ func TestHandleForcedVersion_InvalidVersion(t *testing.T) {
 		t.Fatal("expected error for invalid version")
 	}
 }
+
+// latestTagGitRepo reports a fixed latest version tag.
+type latestTagGitRepo struct {
+	stubGitRepo
+	tag string
+}
+
+func (r latestTagGitRepo) GetLatestVersionTag(ctx context.Context, prefix string) (*sourcecontrol.Tag, error) {
+	return sourcecontrol.NewTag(r.tag, "abc123"), nil
+}
+
+func TestHandleForcedVersion_RefusesDowngrade(t *testing.T) {
+	origCfg, origAllow := cfg, bumpAllowDowngrade
+	defer func() { cfg, bumpAllowDowngrade = origCfg, origAllow }()
+	cfg = &config.Config{Versioning: config.VersioningConfig{TagPrefix: "v"}}
+
+	tests := []struct {
+		name           string
+		latest         string
+		forced         string
+		allowDowngrade bool
+		wantErr        bool
+	}{
+		{"greater version", "v1.2.0", "1.3.0", false, false},
+		{"lower version", "v1.2.0", "1.1.9", false, true},
+		{"same version", "v1.2.0", "1.2.0", false, true},
+		{"release after its prerelease", "v1.0.0-rc.1", "1.0.0", false, false},
+		{"prerelease of latest release", "v1.0.0", "1.0.0-rc.1", false, true},
+		{"lower version allowed", "v1.2.0", "1.1.9", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bumpAllowDowngrade = tt.allowDowngrade
+			app := testCLIApp{
+				gitRepo:     latestTagGitRepo{tag: tt.latest},
+				releaseRepo: stubReleaseRepo{findLatestErr: release.ErrRunNotFound},
+			}
+
+			err := handleForcedVersion(context.Background(), app, tt.forced)
+			if tt.wantErr {
+				if !errors.Is(err, releaseapp.ErrVersionNotIncreasing) {
+					t.Fatalf("handleForcedVersion() error = %v, want ErrVersionNotIncreasing", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("handleForcedVersion() error = %v", err)
+			}
+		})
+	}
+}
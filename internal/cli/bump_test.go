@@ -125,6 +125,7 @@ func TestBumpCommand_FlagsExist(t *testing.T) {
 	}{
 		{"level flag", "level"},
 		{"prerelease flag", "prerelease"},
+		{"pre flag", "pre"},
 		{"build flag", "build"},
 		{"force flag", "force"},
 	}
@@ -139,6 +140,19 @@ func TestBumpCommand_FlagsExist(t *testing.T) {
 	}
 }
 
+func TestValidatePrereleaseSuffix(t *testing.T) {
+	for _, suffix := range []string{"", "beta", "rc.2", "alpha-1"} {
+		if err := validatePrereleaseSuffix(suffix); err != nil {
+			t.Errorf("validatePrereleaseSuffix(%q) error = %v", suffix, err)
+		}
+	}
+	for _, suffix := range []string{"beta!", "rc..1", "beta 1"} {
+		if err := validatePrereleaseSuffix(suffix); err == nil {
+			t.Errorf("validatePrereleaseSuffix(%q) expected error", suffix)
+		}
+	}
+}
+
 func TestBumpCommand_DescriptionContent(t *testing.T) {
 	if bumpCmd.Short == "" {
 		t.Error("bump command should have a short description")
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
 	"github.com/relicta-tech/relicta/internal/config"
 )
 
@@ -68,3 +69,35 @@ func TestHandleChangelogUpdateWritesNotes(t *testing.T) {
 		t.Fatal("expected notes content in changelog")
 	}
 }
+
+func TestUpdateChangelogFileCreatesDirectories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "packages", "core", "docs", "HISTORY.md")
+	if err := updateChangelogFile(path, "## [1.0.0]\n"); err != nil {
+		t.Fatalf("updateChangelogFile error: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected changelog in nested directory: %v", err)
+	}
+}
+
+func TestHandleChangelogUpdateLinksPackageChangelogs(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+	cfg.Monorepo.Changelog.RootChangelog = true
+	cfg.Changelog.File = filepath.Join(t.TempDir(), "CHANGELOG.md")
+
+	rel := newNotesReadyRelease(t, "changelog-packages")
+	packages := []monorepo.PackageChangelog{{Package: "packages/core", File: "packages/core/docs/HISTORY.md", Version: "1.1.0"}}
+	if !handleChangelogUpdate(rel, packages...) {
+		t.Fatal("expected changelog to be updated")
+	}
+
+	data, err := os.ReadFile(cfg.Changelog.File)
+	if err != nil {
+		t.Fatalf("read changelog failed: %v", err)
+	}
+	if !strings.Contains(string(data), "- [packages/core](packages/core/docs/HISTORY.md) 1.1.0") {
+		t.Fatalf("expected package index in root changelog:\n%s", data)
+	}
+}
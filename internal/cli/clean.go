@@ -189,7 +189,7 @@ func determineRunsToDelete(ctx context.Context, releaseRepo release.Repository,
 			continue
 		}
 
-		isActive := !rel.State().IsFinal()
+		isActive := !rel.State().IsFinished()
 		runs = append(runs, runInfo{
 			id:       id,
 			state:    rel.State(),
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/config"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and validate configuration",
+	Long: `Inspect and validate the Relicta configuration file.
+
+Examples:
+  # Validate .relicta.yaml in the current directory
+  relicta config validate
+
+  # Validate a specific file
+  relicta config validate --config path/to/.relicta.yaml
+
+  # Print the JSON Schema for editor autocompletion
+  relicta config validate --print-schema > relicta.schema.json
+
+  # Check that secret:// references resolve
+  relicta config secrets`,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the configuration file",
+	Long: `Load the configuration and report structural problems.
+
+Each error and warning is prefixed with the YAML path of the offending key
+(for example "versioning.strategy" or "plugins[1].name").
+
+Exit codes:
+  0 - Configuration is valid (warnings may be present)
+  1 - Configuration has errors`,
+	RunE: runConfigValidate,
+}
+
+var configPrintSchema bool
+
+// ConfigValidationReport is the JSON output of "config validate".
+type ConfigValidationReport struct {
+	Path     string   `json:"path,omitempty"`
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+	configValidateCmd.Flags().BoolVar(&configPrintSchema, "print-schema", false, "print the configuration JSON Schema and exit")
+
+	rootCmd.AddCommand(configCmd)
+}
+
+// newConfigLoader returns a loader honoring the --config and --profile flags.
+func newConfigLoader() *config.Loader {
+	loader := config.NewLoader()
+	if cfgFile != "" {
+		loader.WithConfigPath(cfgFile)
+	}
+	if profileName != "" {
+		loader.WithProfile(profileName)
+	}
+	return loader
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	if configPrintSchema {
+		return printStructuredOutput(config.JSONSchema())
+	}
+
+	loader := newConfigLoader()
+	loaded, err := loader.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	result := config.Check(loaded)
+	report := ConfigValidationReport{
+		Path:     loader.GetConfigPath(),
+		Valid:    !result.HasErrors(),
+		Errors:   append([]string{}, result.Errors...),
+		Warnings: append([]string{}, result.Warnings...),
+	}
+
+	if outputJSON {
+		if err := printStructuredOutput(report); err != nil {
+			return err
+		}
+	} else {
+		printConfigValidationReport(report)
+	}
+
+	if !report.Valid {
+		return fmt.Errorf("configuration has %d error(s)", len(report.Errors))
+	}
+	return nil
+}
+
+func printConfigValidationReport(report ConfigValidationReport) {
+	if report.Path != "" {
+		printTitle(fmt.Sprintf("Validating %s", report.Path))
+	} else {
+		printTitle("Validating default configuration (no config file found)")
+	}
+	fmt.Println()
+
+	for _, e := range report.Errors {
+		printError(e)
+	}
+	for _, w := range report.Warnings {
+		printWarning(w)
+	}
+
+	switch {
+	case !report.Valid:
+		fmt.Println()
+		printError(fmt.Sprintf("%d error(s), %d warning(s)", len(report.Errors), len(report.Warnings)))
+	case len(report.Warnings) > 0:
+		fmt.Println()
+		printSuccess(fmt.Sprintf("Configuration is valid with %d warning(s)", len(report.Warnings)))
+	default:
+		printSuccess("Configuration is valid")
+	}
+}
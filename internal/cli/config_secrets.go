@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+)
+
+var configSecretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Check that secret references resolve",
+	Long: `List the secret:// references in the configuration and check that each
+one resolves through its secret backend. Secret values are never printed.
+
+References are supported in ai.api_key, git.auth.token, git.auth.password
+and plugin configurations:
+
+  secret://vault/<path>#<field>   HashiCorp Vault (VAULT_ADDR, VAULT_TOKEN)
+  secret://aws-sm/<name>[#field]  AWS Secrets Manager (aws CLI credentials)
+  secret://gcp-sm/<name>[#field]  GCP Secret Manager (gcloud credentials)
+
+Exit codes:
+  0 - All references resolve
+  1 - At least one reference does not resolve`,
+	Args: cobra.NoArgs,
+	RunE: runConfigSecrets,
+}
+
+// ConfigSecret is the resolution status of one secret reference.
+type ConfigSecret struct {
+	Path      string `json:"path"`
+	Reference string `json:"reference"`
+	Resolved  bool   `json:"resolved"`
+	Error     string `json:"error,omitempty"`
+}
+
+func init() {
+	configCmd.AddCommand(configSecretsCmd)
+}
+
+func runConfigSecrets(cmd *cobra.Command, _ []string) error {
+	loaded, err := newConfigLoader().Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	results := checkConfigSecrets(cmd.Context(), loaded, secrets.NewDefaultResolver())
+
+	if outputJSON {
+		if err := printStructuredOutput(results); err != nil {
+			return err
+		}
+	} else {
+		printConfigSecrets(results)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if !r.Resolved {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d secret reference(s) do not resolve", failed)
+	}
+	return nil
+}
+
+// configSecretRefs returns the secret references in the configuration keyed
+// by their YAML path.
+func configSecretRefs(cfg *config.Config) map[string]string {
+	refs := make(map[string]string)
+	add := func(path, value string) {
+		if secrets.IsReference(value) {
+			refs[path] = value
+		}
+	}
+
+	add("ai.api_key", cfg.AI.APIKey)
+	add("git.auth.token", cfg.Git.Auth.Token)
+	add("git.auth.password", cfg.Git.Auth.Password)
+	for i, p := range cfg.Plugins {
+		for path, ref := range secrets.FindReferences(fmt.Sprintf("plugins[%d].config", i), p.Config) {
+			refs[path] = ref
+		}
+	}
+	return refs
+}
+
+// checkConfigSecrets resolves each secret reference in the configuration,
+// discarding the values.
+func checkConfigSecrets(ctx context.Context, cfg *config.Config, resolver *secrets.Resolver) []ConfigSecret {
+	refs := configSecretRefs(cfg)
+	paths := make([]string, 0, len(refs))
+	for path := range refs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	results := make([]ConfigSecret, 0, len(paths))
+	for _, path := range paths {
+		result := ConfigSecret{Path: path, Reference: refs[path]}
+		if _, err := resolver.Resolve(ctx, refs[path]); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Resolved = true
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// printConfigSecrets prints the resolution status of secret references.
+func printConfigSecrets(results []ConfigSecret) {
+	printTitle("Secret References")
+	fmt.Println()
+
+	if len(results) == 0 {
+		printInfo("No secret:// references in the configuration")
+		return
+	}
+
+	for _, r := range results {
+		if r.Resolved {
+			printSuccess(fmt.Sprintf("%s: %s resolves", r.Path, r.Reference))
+		} else {
+			printError(fmt.Sprintf("%s: %s", r.Path, r.Error))
+		}
+	}
+}
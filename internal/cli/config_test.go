@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+)
+
+func TestConfigValidateCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			content: "versioning:\n  strategy: conventional\n",
+		},
+		{
+			name:    "invalid strategy",
+			content: "versioning:\n  strategy: calendar\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid plugin name",
+			content: "plugins:\n  - name: \"bad name\"\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".relicta.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			oldCfgFile, oldJSON, oldSchema := cfgFile, outputJSON, configPrintSchema
+			defer func() {
+				cfgFile, outputJSON, configPrintSchema = oldCfgFile, oldJSON, oldSchema
+			}()
+			cfgFile = path
+			outputJSON = true
+			configPrintSchema = false
+
+			err := runConfigValidate(configValidateCmd, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runConfigValidate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigValidateCmd_PrintSchema(t *testing.T) {
+	oldCfgFile, oldSchema := cfgFile, configPrintSchema
+	defer func() {
+		cfgFile, configPrintSchema = oldCfgFile, oldSchema
+	}()
+	cfgFile = filepath.Join(t.TempDir(), "missing.yaml")
+	configPrintSchema = true
+
+	if err := runConfigValidate(configValidateCmd, nil); err != nil {
+		t.Errorf("--print-schema should not load config, got %v", err)
+	}
+}
+
+// staticSecretBackend resolves the secrets in its map by reference name.
+type staticSecretBackend map[string]string
+
+func (b staticSecretBackend) Resolve(_ context.Context, ref secrets.Reference) (string, error) {
+	if secret, ok := b[ref.Name]; ok {
+		return secret, nil
+	}
+	return "", errors.New("secret not found")
+}
+
+func TestCheckConfigSecrets(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AI.APIKey = "secret://mock/openai"
+	cfg.Git.Auth.Token = "plain-token"
+	cfg.Plugins = []config.PluginConfig{
+		{Name: "github", Config: map[string]any{"token": "secret://mock/missing", "draft": true}},
+	}
+	resolver := secrets.NewResolver(secrets.WithBackend("mock", staticSecretBackend{"openai": "sk-test"}))
+
+	results := checkConfigSecrets(context.Background(), cfg, resolver)
+
+	if len(results) != 2 {
+		t.Fatalf("checkConfigSecrets() returned %d results, want 2: %+v", len(results), results)
+	}
+	if results[0].Path != "ai.api_key" || !results[0].Resolved {
+		t.Errorf("results[0] = %+v, want resolved ai.api_key", results[0])
+	}
+	if results[1].Path != "plugins[0].config.token" || results[1].Resolved || results[1].Error == "" {
+		t.Errorf("results[1] = %+v, want unresolved plugins[0].config.token", results[1])
+	}
+
+	output := captureOutput(t, func() { printConfigSecrets(results) })
+	if strings.Contains(output, "sk-test") {
+		t.Errorf("printConfigSecrets() printed a secret value:\n%s", output)
+	}
+}
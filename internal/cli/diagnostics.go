@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// diagnostics collects the warnings reported while a command runs. Under
+// --strict they fail the command, all reported together: state-mutating
+// commands check before their first write, and every command checks once it
+// completes.
+var diagnostics = &diagnosticCollector{}
+
+// diagnosticCollector records warning messages, ignoring duplicates.
+type diagnosticCollector struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+// warn records a warning.
+func (d *diagnosticCollector) warn(msg string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !slices.Contains(d.warnings, msg) {
+		d.warnings = append(d.warnings, msg)
+	}
+}
+
+// Warnings returns the recorded warnings in the order they were reported.
+func (d *diagnosticCollector) Warnings() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return slices.Clone(d.warnings)
+}
+
+// reset discards the recorded warnings.
+func (d *diagnosticCollector) reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.warnings = nil
+}
+
+// recordWarning records a warning without printing it, for conditions the
+// command already shows in its output.
+func recordWarning(msg string) {
+	diagnostics.warn(msg)
+}
+
+// StrictModeError is returned under --strict when a command reported
+// warnings.
+type StrictModeError struct {
+	Warnings []string
+}
+
+// Error lists the escalated warnings.
+func (e *StrictModeError) Error() string {
+	return fmt.Sprintf("strict mode: %d warning(s) treated as errors:\n  - %s", len(e.Warnings), strings.Join(e.Warnings, "\n  - "))
+}
+
+// strictEnabled reports whether warnings are escalated to errors, by the
+// --strict flag or output.strict.
+func strictEnabled() bool {
+	return strictMode || (cfg != nil && cfg.Output.Strict)
+}
+
+// checkStrict returns a StrictModeError if strict mode is enabled and
+// warnings were reported. Commands that change release state, tags or files
+// call it before doing so, so that --strict stops them while nothing has
+// changed yet.
+func checkStrict() error {
+	if !strictEnabled() {
+		return nil
+	}
+	if warnings := diagnostics.Warnings(); len(warnings) > 0 {
+		return &StrictModeError{Warnings: warnings}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/application/versioning"
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
+)
+
+// withStrict sets the --strict flag and config for a test and clears the
+// recorded diagnostics.
+func withStrict(t *testing.T, flag bool, c *config.Config) {
+	t.Helper()
+	origStrict, origCfg := strictMode, cfg
+	t.Cleanup(func() {
+		strictMode, cfg = origStrict, origCfg
+		diagnostics.reset()
+	})
+	strictMode, cfg = flag, c
+	diagnostics.reset()
+}
+
+func TestStrictMode_WarningBecomesError(t *testing.T) {
+	withStrict(t, true, config.DefaultConfig())
+
+	captureOutput(t, func() {
+		printWarning("could not detect the forge from the origin remote")
+		printWarning("GITHUB_TOKEN is not set")
+		printWarning("GITHUB_TOKEN is not set")
+	})
+
+	err := rootCmd.PersistentPostRunE(rootCmd, nil)
+	var strictErr *StrictModeError
+	if !errors.As(err, &strictErr) {
+		t.Fatalf("PersistentPostRunE() error = %v, want StrictModeError", err)
+	}
+	if len(strictErr.Warnings) != 2 {
+		t.Errorf("Warnings = %v, want both distinct warnings", strictErr.Warnings)
+	}
+	if !strings.Contains(err.Error(), "2 warning(s)") || !strings.Contains(err.Error(), "GITHUB_TOKEN is not set") {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestStrictMode_Disabled(t *testing.T) {
+	withStrict(t, false, config.DefaultConfig())
+
+	captureOutput(t, func() { printWarning("something looks off") })
+
+	if err := checkStrict(); err != nil {
+		t.Errorf("checkStrict() error = %v without --strict", err)
+	}
+}
+
+func TestStrictMode_FromConfig(t *testing.T) {
+	c := config.DefaultConfig()
+	c.Output.Strict = true
+	withStrict(t, false, c)
+
+	if err := checkStrict(); err != nil {
+		t.Errorf("checkStrict() error = %v without warnings", err)
+	}
+	recordWarning("version file mismatch")
+	if err := checkStrict(); err == nil {
+		t.Error("checkStrict() error = nil with output.strict and a warning")
+	}
+}
+
+func TestRecordPlanWarnings(t *testing.T) {
+	withStrict(t, true, config.DefaultConfig())
+
+	output := &servicerelease.AnalyzeOutput{NonConventional: servicerelease.NonConventionalSummary{
+		Policy:  servicerelease.NonConventionalPatch,
+		Commits: []sourcecontrol.CommitHash{"abc123"},
+	}}
+	recordPlanWarnings(output, nil)
+	if got := diagnostics.Warnings(); len(got) != 0 {
+		t.Errorf("Warnings = %v; an explicit policy is not a warning", got)
+	}
+
+	output.NonConventional.Policy = servicerelease.NonConventionalInfer
+	recordPlanWarnings(output, []versioning.VersionMismatch{{File: "VERSION", FileVersion: "1.0.0", TagVersion: "1.1.0"}})
+	if got := diagnostics.Warnings(); len(got) != 2 {
+		t.Errorf("Warnings = %v, want the mismatch and the non-conventional commits", got)
+	}
+}
+
+func TestStrictMode_StopsBeforeStateChange(t *testing.T) {
+	c := &config.Config{Versioning: config.VersioningConfig{TagPrefix: "v"}}
+	withStrict(t, true, c)
+
+	// Updating the release state would fail with this repository; the
+	// strict check must stop the bump before it gets there.
+	app := testCLIApp{
+		gitRepo:     stubGitRepo{},
+		releaseRepo: stubReleaseRepo{findLatestErr: errors.New("state must not be touched")},
+	}
+	recordWarning("plugin system initialization failed, using empty executor: exit status 1")
+
+	err := handleForcedVersion(context.Background(), app, "1.2.3")
+	var strictErr *StrictModeError
+	if !errors.As(err, &strictErr) {
+		t.Fatalf("handleForcedVersion() error = %v, want StrictModeError", err)
+	}
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <run-a> <run-b>",
+	Short: "Compare two release runs",
+	Long: `Compare two release runs and report how they differ.
+
+The comparison covers version, commit set, risk score, policy thresholds,
+approval and step outcomes. It is most useful for comparing a failed run
+with its successful retry.
+
+Run IDs may be abbreviated to any unique prefix (with or without "run-").
+
+Examples:
+  # Compare two runs
+  relicta diff run-3f2a9c1b run-8d41e7a0
+
+  # Output as JSON
+  relicta diff 3f2a 8d41 --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	releaseRepo := app.ReleaseRepository()
+	runIDs, err := releaseRepo.List(ctx, repoInfo.Path)
+	if err != nil {
+		return fmt.Errorf("failed to list release runs: %w", err)
+	}
+
+	runs := make([]*release.ReleaseRun, 0, len(args))
+	for _, ref := range args {
+		id, err := matchRunID(runIDs, ref)
+		if err != nil {
+			return err
+		}
+		run, err := releaseRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to load release run %s: %w", id, err)
+		}
+		runs = append(runs, run)
+	}
+
+	diff := release.CompareRuns(runs[0], runs[1])
+	if outputJSON {
+		return printStructuredOutput(diff)
+	}
+	printRunDiff(diff)
+	return nil
+}
+
+// matchRunID resolves ref to a run ID by exact match or unique prefix.
+func matchRunID(ids []release.RunID, ref string) (release.RunID, error) {
+	var matches []release.RunID
+	for _, id := range ids {
+		s := string(id)
+		if s == ref {
+			return id, nil
+		}
+		if strings.HasPrefix(s, ref) || strings.HasPrefix(strings.TrimPrefix(s, "run-"), ref) {
+			matches = append(matches, id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("release run %q not found", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("release run %q is ambiguous (%d matches); use a longer prefix", ref, len(matches))
+	}
+}
+
+// printRunDiff prints a run comparison as tables of facts, commits and steps.
+func printRunDiff(diff release.RunDiff) {
+	printTitle(fmt.Sprintf("Comparing %s → %s", shortenID(string(diff.RunA)), shortenID(string(diff.RunB))))
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  FIELD\tA\tB\t")
+	for _, f := range diff.Fields {
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t\n", diffMarker(f.Changed), f.Field, orDash(f.A), orDash(f.B))
+	}
+	_ = w.Flush()
+	fmt.Println()
+
+	printTitle("Commits")
+	fmt.Printf("  %d in common, %d only in A, %d only in B\n", diff.CommonCommits, len(diff.OnlyInA), len(diff.OnlyInB))
+	for _, c := range diff.OnlyInA {
+		fmt.Printf("  - %s\n", c.Short())
+	}
+	for _, c := range diff.OnlyInB {
+		fmt.Printf("  + %s\n", c.Short())
+	}
+	fmt.Println()
+
+	if len(diff.Steps) > 0 {
+		printTitle("Steps")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "  STEP\tA\tB\t")
+		for _, s := range diff.Steps {
+			fmt.Fprintf(w, "%s %s\t%s\t%s\t\n", diffMarker(s.Changed), s.Step, orDash(string(s.A)), orDash(string(s.B)))
+		}
+		_ = w.Flush()
+		for _, s := range diff.Steps {
+			if s.ErrorA != "" {
+				printSubtle(fmt.Sprintf("  %s (A): %s", s.Step, s.ErrorA))
+			}
+			if s.ErrorB != "" {
+				printSubtle(fmt.Sprintf("  %s (B): %s", s.Step, s.ErrorB))
+			}
+		}
+		fmt.Println()
+	}
+
+	if !diff.HasChanges() {
+		printSuccess("Runs are identical in all compared fields")
+	}
+}
+
+func diffMarker(changed bool) string {
+	if changed {
+		return "*"
+	}
+	return " "
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+func TestMatchRunID(t *testing.T) {
+	ids := []release.RunID{"run-3f2a9c1b", "run-3f9d0000", "run-8d41e7a0"}
+
+	tests := []struct {
+		ref     string
+		want    release.RunID
+		wantErr bool
+	}{
+		{"run-8d41e7a0", "run-8d41e7a0", false},
+		{"8d41", "run-8d41e7a0", false},
+		{"run-3f2a", "run-3f2a9c1b", false},
+		{"3f", "", true},
+		{"ffff", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := matchRunID(ids, tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchRunID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("matchRunID() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+var evaluateAdvisory bool
+
+var evaluateCmd = &cobra.Command{
+	Use:   "evaluate",
+	Short: "Evaluate release risk using CGP governance",
+	Long: `Evaluate the current release with the Change Governance Protocol (CGP)
+and report the decision, risk score and rationale.
+
+With governance.strict_mode enabled a rejected release makes the command
+fail, so it can gate a pipeline. Use --advisory to ask what governance
+would decide without enforcing it: the result is reported as advisory and
+the command succeeds whatever the decision.
+
+Evaluations are not stored; approve and publish evaluate the release again.`,
+	Example: `  # Evaluate the current release
+  relicta evaluate
+
+  # See the decision without enforcing strict mode
+  relicta evaluate --advisory --json`,
+	Args: cobra.NoArgs,
+	RunE: runEvaluate,
+}
+
+func init() {
+	evaluateCmd.Flags().BoolVar(&evaluateAdvisory, "advisory", false, "report the decision without enforcing governance.strict_mode")
+	rootCmd.AddCommand(evaluateCmd)
+}
+
+// EvaluateResult is the JSON output of the evaluate command.
+type EvaluateResult struct {
+	ReleaseID      string   `json:"release_id"`
+	Decision       string   `json:"decision"`
+	RiskScore      float64  `json:"risk_score"`
+	Severity       string   `json:"severity"`
+	CanAutoApprove bool     `json:"can_auto_approve"`
+	Rationale      []string `json:"rationale,omitempty"`
+	// Advisory is true when the decision is informational and cannot block
+	// the release; AdvisoryReason says why.
+	Advisory       bool   `json:"advisory"`
+	AdvisoryReason string `json:"advisory_reason,omitempty"`
+}
+
+// runEvaluate implements the evaluate command.
+func runEvaluate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	if !app.HasGovernance() {
+		return fmt.Errorf("governance is not enabled: set governance.enabled in the config")
+	}
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	rel, err := app.ReleaseRepository().FindLatest(ctx, repoInfo.Path)
+	if err != nil {
+		return fmt.Errorf("no release in progress: %w", err)
+	}
+
+	govResult, err := evaluateGovernance(ctx, app, rel)
+	if err != nil {
+		return fmt.Errorf("governance evaluation failed: %w", err)
+	}
+	result := newEvaluateResult(string(rel.ID()), govResult, evaluateAdvisory, cfg.Governance.StrictMode)
+
+	if outputJSON {
+		if err := printStructuredOutput(result); err != nil {
+			return err
+		}
+	} else {
+		displayGovernanceResult(govResult)
+		fmt.Println()
+		if result.Advisory {
+			printInfo(fmt.Sprintf("Advisory evaluation (%s): this decision does not block the release", result.AdvisoryReason))
+		} else {
+			printInfo("Enforced evaluation: governance.strict_mode blocks rejected releases")
+		}
+	}
+
+	return enforceEvaluation(result)
+}
+
+// newEvaluateResult builds the evaluate output. The evaluation is advisory
+// when requested or when strict mode is off.
+func newEvaluateResult(releaseID string, out *governance.EvaluateReleaseOutput, advisory, strict bool) *EvaluateResult {
+	result := &EvaluateResult{
+		ReleaseID:      releaseID,
+		Decision:       string(out.Decision),
+		RiskScore:      out.RiskScore,
+		Severity:       string(out.Severity),
+		CanAutoApprove: out.CanAutoApprove,
+		Rationale:      out.Rationale,
+	}
+	switch {
+	case advisory:
+		result.Advisory, result.AdvisoryReason = true, "requested with --advisory"
+	case !strict:
+		result.Advisory, result.AdvisoryReason = true, "governance.strict_mode is disabled"
+	}
+	return result
+}
+
+// enforceEvaluation fails for a rejected release unless the evaluation is
+// advisory.
+func enforceEvaluation(result *EvaluateResult) error {
+	if result.Advisory || result.Decision != string(cgp.DecisionRejected) {
+		return nil
+	}
+	return fmt.Errorf("release denied by governance: %s", strings.Join(result.Rationale, "; "))
+}
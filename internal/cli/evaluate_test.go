@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/cgp"
+)
+
+func TestNewEvaluateResult_Enforcement(t *testing.T) {
+	rejected := &governance.EvaluateReleaseOutput{
+		Decision:  cgp.DecisionRejected,
+		RiskScore: 0.9,
+		Severity:  cgp.SeverityCritical,
+		Rationale: []string{"breaking change without approval"},
+	}
+
+	tests := []struct {
+		name         string
+		advisory     bool
+		strict       bool
+		wantAdvisory bool
+		wantErr      bool
+	}{
+		{"strict mode enforces", false, true, false, true},
+		{"advisory flag overrides strict mode", true, true, true, false},
+		{"advisory without strict mode", false, false, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newEvaluateResult("run-1", rejected, tt.advisory, tt.strict)
+			if result.Advisory != tt.wantAdvisory {
+				t.Errorf("Advisory = %v, want %v", result.Advisory, tt.wantAdvisory)
+			}
+			if result.Advisory && result.AdvisoryReason == "" {
+				t.Error("advisory result has no reason")
+			}
+
+			err := enforceEvaluation(result)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("enforceEvaluation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "breaking change without approval") {
+				t.Errorf("error = %v, want the rationale", err)
+			}
+		})
+	}
+
+	approved := newEvaluateResult("run-1", &governance.EvaluateReleaseOutput{Decision: cgp.DecisionApproved}, false, true)
+	if err := enforceEvaluation(approved); err != nil {
+		t.Errorf("enforceEvaluation(approved) error = %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+var (
+	freezeReason   string
+	overrideFreeze bool
+)
+
+var freezeCmd = &cobra.Command{
+	Use:   "freeze [on|off]",
+	Short: "Freeze or unfreeze releases",
+	Long: `Freeze releases until the freeze is lifted.
+
+While releases are frozen, 'relicta plan', 'relicta publish' and
+'relicta release' refuse to run and report the freeze reason. Pass
+--override-freeze to run them anyway; overrides are recorded in
+.relicta/freeze-audit.jsonl.
+
+The freeze is stored in .relicta/freeze.json and is independent of the
+scheduled freeze periods of governance policies.
+
+Examples:
+  # Freeze releases
+  relicta freeze on --reason "incident INC-1234"
+
+  # Lift the freeze
+  relicta freeze off
+
+  # Show the current freeze
+  relicta freeze`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: []string{"on", "off"},
+	RunE:      runFreeze,
+}
+
+func init() {
+	freezeCmd.Flags().StringVarP(&freezeReason, "reason", "r", "", "reason for freezing (required with 'on')")
+	rootCmd.AddCommand(freezeCmd)
+
+	for _, cmd := range []*cobra.Command{planCmd, publishCmd, releaseCmd} {
+		cmd.Flags().BoolVar(&overrideFreeze, "override-freeze", false, "run even though releases are frozen (audited)")
+	}
+}
+
+// FreezeOutput represents the freeze command output.
+type FreezeOutput struct {
+	Frozen   bool       `json:"frozen"`
+	Reason   string     `json:"reason,omitempty"`
+	FrozenBy string     `json:"frozen_by,omitempty"`
+	FrozenAt *time.Time `json:"frozen_at,omitempty"`
+}
+
+// runFreeze implements the freeze command.
+func runFreeze(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	store := persistence.NewFreezeStore(repoInfo.Path)
+
+	action := ""
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	var freeze *persistence.ReleaseFreeze
+	switch action {
+	case "on":
+		if freezeReason == "" {
+			return fmt.Errorf("--reason is required to freeze releases")
+		}
+		freeze, err = store.Freeze(freezeReason, getCurrentUser())
+		if err != nil {
+			return err
+		}
+	case "off":
+		if _, err := store.Unfreeze(getCurrentUser(), freezeReason); err != nil {
+			return err
+		}
+	default:
+		freeze, err = store.Load()
+		if err != nil {
+			return err
+		}
+	}
+
+	output := freezeOutput(freeze)
+	if outputJSON {
+		return printStructuredOutput(output)
+	}
+
+	switch {
+	case action == "on":
+		printSuccess(fmt.Sprintf("Releases frozen: %s", output.Reason))
+		printInfo("Run 'relicta freeze off' to lift the freeze")
+	case action == "off":
+		printSuccess("Release freeze lifted")
+	case output.Frozen:
+		printWarning(freeze.Summary())
+	default:
+		printInfo("Releases are not frozen")
+	}
+	return nil
+}
+
+// freezeOutput converts a freeze to its command output.
+func freezeOutput(freeze *persistence.ReleaseFreeze) *FreezeOutput {
+	if freeze == nil {
+		return &FreezeOutput{}
+	}
+	frozenAt := freeze.FrozenAt
+	return &FreezeOutput{
+		Frozen:   true,
+		Reason:   freeze.Reason,
+		FrozenBy: freeze.FrozenBy,
+		FrozenAt: &frozenAt,
+	}
+}
+
+// enforceReleaseFreeze checks the release freeze of the current repository.
+func enforceReleaseFreeze(ctx context.Context, app cliApp, command string) error {
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	return checkReleaseFreeze(repoInfo.Path, command)
+}
+
+// checkReleaseFreeze returns an error if releases are frozen. Dry runs only
+// warn, and --override-freeze lets command run after recording the override.
+func checkReleaseFreeze(repoRoot, command string) error {
+	store := persistence.NewFreezeStore(repoRoot)
+	freeze, err := store.Load()
+	if err != nil {
+		return err
+	}
+	if freeze == nil {
+		return nil
+	}
+
+	if dryRun {
+		if !outputJSON {
+			printWarning(freeze.Summary())
+		}
+		return nil
+	}
+
+	if !overrideFreeze {
+		return fmt.Errorf("%s (run 'relicta freeze off' to lift it, or pass --override-freeze)", freeze.Summary())
+	}
+
+	if err := store.RecordOverride(getCurrentUser(), command, freeze); err != nil {
+		return fmt.Errorf("failed to record freeze override: %w", err)
+	}
+	if !outputJSON {
+		printWarning(fmt.Sprintf("Overriding release freeze: %s", freeze.Reason))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+func TestCheckReleaseFreeze(t *testing.T) {
+	origDryRun, origOverride, origJSON := dryRun, overrideFreeze, outputJSON
+	defer func() { dryRun, overrideFreeze, outputJSON = origDryRun, origOverride, origJSON }()
+	outputJSON = true
+
+	repoRoot := t.TempDir()
+	store := persistence.NewFreezeStore(repoRoot)
+
+	dryRun, overrideFreeze = false, false
+	if err := checkReleaseFreeze(repoRoot, "plan"); err != nil {
+		t.Fatalf("checkReleaseFreeze() without freeze error = %v", err)
+	}
+
+	if _, err := store.Freeze("incident INC-1234", "alice"); err != nil {
+		t.Fatalf("Freeze() error = %v", err)
+	}
+
+	err := checkReleaseFreeze(repoRoot, "publish")
+	if err == nil {
+		t.Fatal("checkReleaseFreeze() should block while frozen")
+	}
+	if !strings.Contains(err.Error(), "incident INC-1234") || !strings.Contains(err.Error(), "--override-freeze") {
+		t.Errorf("error = %q, want reason and override hint", err)
+	}
+
+	dryRun = true
+	if err := checkReleaseFreeze(repoRoot, "plan"); err != nil {
+		t.Errorf("checkReleaseFreeze() in dry-run error = %v", err)
+	}
+
+	dryRun, overrideFreeze = false, true
+	if err := checkReleaseFreeze(repoRoot, "publish"); err != nil {
+		t.Fatalf("checkReleaseFreeze() with override error = %v", err)
+	}
+
+	events, err := store.Events()
+	if err != nil {
+		t.Fatalf("Events() error = %v", err)
+	}
+	last := events[len(events)-1]
+	if last.Action != persistence.FreezeActionOverride || last.Command != "publish" {
+		t.Errorf("last audit event = %+v, want publish override", last)
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/application/blast"
+)
+
+var (
+	graphFormat       string
+	graphImpactedBy   string
+	graphImpactedOnly bool
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Visualize the monorepo package dependency graph",
+	Long: `Output the package dependency graph of a monorepo.
+
+Edges point from a package to the package it depends on. With --impacted-by,
+the packages affected by changes since the given ref are highlighted:
+directly changed packages and their transitive dependents. This shows why a
+change to a shared package forces releases of the packages that use it.
+
+Package discovery uses the blast_radius settings (package_paths,
+exclude_paths, shared_dirs) from the configuration.
+
+Examples:
+  # Render the graph with Graphviz
+  relicta graph | dot -Tsvg > packages.svg
+
+  # Mermaid output for a pull request description
+  relicta graph --format mermaid
+
+  # Highlight the packages affected since the last release
+  relicta graph --impacted-by v1.4.0
+
+  # Only the affected subgraph, as JSON
+  relicta graph --impacted-by v1.4.0 --impacted-only --format json`,
+	RunE: runGraph,
+}
+
+func init() {
+	graphCmd.Flags().StringVar(&graphFormat, "format", "dot", "output format (dot, mermaid, json)")
+	graphCmd.Flags().StringVar(&graphImpactedBy, "impacted-by", "", "highlight packages affected by changes since this ref")
+	graphCmd.Flags().BoolVar(&graphImpactedOnly, "impacted-only", false, "only output the affected subgraph (requires --impacted-by)")
+
+	rootCmd.AddCommand(graphCmd)
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	format, err := blast.ParseGraphFormat(graphFormat)
+	if err != nil {
+		return err
+	}
+	if outputJSON {
+		format = blast.GraphFormatJSON
+	}
+	if graphImpactedOnly && graphImpactedBy == "" {
+		return fmt.Errorf("--impacted-only requires --impacted-by")
+	}
+
+	monorepoConfig := blastMonorepoConfig()
+	svc := blast.NewService(
+		blast.WithRepoPath("."),
+		blast.WithMonorepoConfig(monorepoConfig),
+	)
+
+	var graph *blast.DependencyGraph
+	if graphImpactedBy != "" {
+		opts := blast.DefaultAnalysisOptions()
+		opts.FromRef = graphImpactedBy
+		opts.GenerateGraph = true
+		opts.MonorepoConfig = monorepoConfig
+
+		result, err := svc.AnalyzeBlastRadius(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("blast radius analysis failed: %w", err)
+		}
+		graph = result.DependencyGraph
+		if graph == nil {
+			return fmt.Errorf("failed to build dependency graph")
+		}
+		if graphImpactedOnly {
+			graph = graph.ImpactedSubgraph()
+		}
+	} else {
+		packages, err := svc.DiscoverPackages(ctx, &blast.AnalysisOptions{MonorepoConfig: monorepoConfig})
+		if err != nil {
+			return fmt.Errorf("failed to discover packages: %w", err)
+		}
+		graph, err = svc.BuildDependencyGraph(ctx, packages)
+		if err != nil {
+			return fmt.Errorf("failed to build dependency graph: %w", err)
+		}
+	}
+
+	switch format {
+	case blast.GraphFormatJSON:
+		return printStructuredOutput(graph)
+	case blast.GraphFormatMermaid:
+		fmt.Print(graph.Mermaid())
+	default:
+		fmt.Print(graph.DOT())
+	}
+	return nil
+}
+
+// blastMonorepoConfig returns the blast radius package discovery settings,
+// applying the blast_radius configuration over the defaults.
+func blastMonorepoConfig() *blast.MonorepoConfig {
+	mc := blast.DefaultMonorepoConfig()
+	if cfg == nil {
+		return mc
+	}
+
+	br := cfg.BlastRadius
+	if len(br.PackagePaths) > 0 {
+		mc.PackagePaths = br.PackagePaths
+	}
+	mc.ExcludePaths = append(mc.ExcludePaths, br.ExcludePaths...)
+	if len(br.SharedDirs) > 0 {
+		mc.SharedDirs = br.SharedDirs
+	}
+	mc.RootPackage = br.RootPackage
+	mc.MaxTransitiveDepth = br.MaxTransitiveDepth
+	return mc
+}
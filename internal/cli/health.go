@@ -4,6 +4,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -12,6 +13,9 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/forge"
+	"github.com/relicta-tech/relicta/pkg/plugin"
 )
 
 // HealthStatus represents the overall health status.
@@ -54,6 +58,7 @@ This command verifies:
   - Configuration validity
   - Plugin connectivity
   - AI service availability (if enabled)
+  - Remaining GitHub API rate limit (if GITHUB_TOKEN or GH_TOKEN is set)
 
 Exit codes:
   0 - All checks passed (healthy)
@@ -93,6 +98,7 @@ func runHealth(cmd *cobra.Command, args []string) error {
 		{"repository", checkRepository, true},
 		{"config", checkConfig, false},
 		{"plugins_directory", checkPluginsDir, false},
+		{"github_api", checkGitHubAPI, false},
 	}
 
 	for _, c := range checks {
@@ -268,6 +274,60 @@ func checkPluginsDir(ctx context.Context) ComponentHealth {
 	return health
 }
 
+// githubRateLimitChecker queries the GitHub API rate limit.
+type githubRateLimitChecker interface {
+	CheckRateLimit(ctx context.Context) (plugin.RateLimit, error)
+}
+
+// newGitHubRateLimitChecker creates the client used by the github_api check.
+var newGitHubRateLimitChecker = func() (githubRateLimitChecker, error) {
+	return newGitHubClient()
+}
+
+func checkGitHubAPI(ctx context.Context) ComponentHealth {
+	start := time.Now()
+	health := ComponentHealth{
+		Name:    "github_api",
+		Details: make(map[string]string),
+	}
+
+	client, err := newGitHubRateLimitChecker()
+	if errors.Is(err, forge.ErrNoToken) {
+		health.Status = HealthStatusHealthy
+		health.Message = "no GitHub token set (rate limit not checked)"
+		return health
+	}
+	if err != nil {
+		health.Status = HealthStatusDegraded
+		health.Message = fmt.Sprintf("failed to create GitHub client: %v", err)
+		return health
+	}
+
+	rate, err := client.CheckRateLimit(ctx)
+	health.Latency = time.Since(start)
+	if err != nil {
+		health.Status = HealthStatusDegraded
+		health.Message = err.Error()
+		return health
+	}
+
+	health.Details["limit"] = fmt.Sprintf("%d", rate.Limit)
+	health.Details["remaining"] = fmt.Sprintf("%d", rate.Remaining)
+	if !rate.Reset.IsZero() {
+		health.Details["reset"] = rate.Reset.UTC().Format(time.RFC3339)
+	}
+
+	if rate.Known() && rate.Remaining <= rate.Limit/10 {
+		health.Status = HealthStatusDegraded
+		health.Message = fmt.Sprintf("rate limit nearly exhausted: %d of %d requests remaining (resets at %s)",
+			rate.Remaining, rate.Limit, rate.Reset.UTC().Format(time.RFC3339))
+		return health
+	}
+	health.Status = HealthStatusHealthy
+	health.Message = fmt.Sprintf("%d of %d requests remaining", rate.Remaining, rate.Limit)
+	return health
+}
+
 func outputHealthJSON(report *HealthReport) error {
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
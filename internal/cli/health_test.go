@@ -3,10 +3,16 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"os/exec"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/forge"
+	"github.com/relicta-tech/relicta/pkg/plugin"
 )
 
 func TestHealthCommand_Configuration(t *testing.T) {
@@ -167,3 +173,44 @@ func TestHealthStatus_StringRepresentation(t *testing.T) {
 		t.Errorf("string(HealthStatus) = %v, want healthy", statusStr)
 	}
 }
+
+// fakeRateLimitChecker returns a fixed rate limit.
+type fakeRateLimitChecker struct {
+	rate plugin.RateLimit
+	err  error
+}
+
+func (f fakeRateLimitChecker) CheckRateLimit(context.Context) (plugin.RateLimit, error) {
+	return f.rate, f.err
+}
+
+func TestCheckGitHubAPI(t *testing.T) {
+	reset := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		checker     githubRateLimitChecker
+		err         error
+		wantStatus  HealthStatus
+		wantMessage string
+	}{
+		{name: "no token", err: forge.ErrNoToken, wantStatus: HealthStatusHealthy, wantMessage: "no GitHub token"},
+		{name: "plenty remaining", checker: fakeRateLimitChecker{rate: plugin.RateLimit{Limit: 5000, Remaining: 4200, Reset: reset}}, wantStatus: HealthStatusHealthy, wantMessage: "4200 of 5000 requests remaining"},
+		{name: "approaching limit", checker: fakeRateLimitChecker{rate: plugin.RateLimit{Limit: 5000, Remaining: 12, Reset: reset}}, wantStatus: HealthStatusDegraded, wantMessage: "resets at 2026-01-01T12:00:00Z"},
+		{name: "api error", checker: fakeRateLimitChecker{err: errors.New("connection refused")}, wantStatus: HealthStatusDegraded, wantMessage: "connection refused"},
+	}
+
+	original := newGitHubRateLimitChecker
+	t.Cleanup(func() { newGitHubRateLimitChecker = original })
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newGitHubRateLimitChecker = func() (githubRateLimitChecker, error) {
+				return tt.checker, tt.err
+			}
+			got := checkGitHubAPI(context.Background())
+			if got.Status != tt.wantStatus || !strings.Contains(got.Message, tt.wantMessage) {
+				t.Errorf("checkGitHubAPI() = %s %q, want %s containing %q", got.Status, got.Message, tt.wantStatus, tt.wantMessage)
+			}
+		})
+	}
+}
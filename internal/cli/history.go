@@ -2,7 +2,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -135,7 +134,7 @@ func runHistoryReleases(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		return printJSONOutput(history)
+		return printStructuredOutput(history)
 	}
 
 	fmt.Printf("Release History for %s\n", repo)
@@ -189,7 +188,7 @@ func runHistoryActor(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		return printJSONOutput(metrics)
+		return printStructuredOutput(metrics)
 	}
 
 	fmt.Printf("Actor Metrics: %s\n", actorID)
@@ -245,7 +244,7 @@ func runHistoryRisk(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		return printJSONOutput(patterns)
+		return printStructuredOutput(patterns)
 	}
 
 	fmt.Printf("Risk Patterns for %s\n", repo)
@@ -424,9 +423,3 @@ func calculateReleaseStats(releases []*memory.ReleaseRecord) releaseStats {
 
 	return stats
 }
-
-func printJSONOutput(v any) error {
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(v)
-}
@@ -256,9 +256,9 @@ func TestPrintJSONOutput(t *testing.T) {
 	}{Name: "test", Value: 42}
 
 	// The function prints to stdout, but we can verify it doesn't panic
-	err := printJSONOutput(data)
+	err := printStructuredOutput(data)
 	if err != nil {
-		t.Errorf("printJSONOutput() error = %v", err)
+		t.Errorf("printStructuredOutput() error = %v", err)
 	}
 }
 
@@ -268,18 +268,18 @@ func TestPrintJSONOutput_Map(t *testing.T) {
 		"key2": 123,
 	}
 
-	err := printJSONOutput(data)
+	err := printStructuredOutput(data)
 	if err != nil {
-		t.Errorf("printJSONOutput() error = %v", err)
+		t.Errorf("printStructuredOutput() error = %v", err)
 	}
 }
 
 func TestPrintJSONOutput_Slice(t *testing.T) {
 	data := []string{"one", "two", "three"}
 
-	err := printJSONOutput(data)
+	err := printStructuredOutput(data)
 	if err != nil {
-		t.Errorf("printJSONOutput() error = %v", err)
+		t.Errorf("printStructuredOutput() error = %v", err)
 	}
 }
 
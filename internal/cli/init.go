@@ -17,12 +17,18 @@ var (
 	initForce       bool
 	initInteractive bool
 	initFormat      string
+	initDetect      bool
+	initFromExist   bool
+	initYes         bool
 )
 
 func init() {
 	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "overwrite existing config file")
 	initCmd.Flags().BoolVarP(&initInteractive, "interactive", "i", true, "run interactive setup")
 	initCmd.Flags().StringVar(&initFormat, "format", "yaml", "config file format (yaml, json)")
+	initCmd.Flags().BoolVar(&initFromExist, "from-existing", false, "generate a config tailored to the repository (languages, forge, changelog)")
+	initCmd.Flags().BoolVar(&initDetect, "detect", false, "alias for --from-existing")
+	initCmd.Flags().BoolVarP(&initYes, "yes", "y", false, "write the detected config without confirmation")
 }
 
 // runInit implements the init command.
@@ -35,6 +41,11 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Detection mode: infer config from the repository
+	if initFromExist || initDetect {
+		return runInitDetect(cmd, ".relicta.yaml")
+	}
+
 	// Interactive wizard mode
 	if initInteractive {
 		result, err := wizard.RunWizard(".")
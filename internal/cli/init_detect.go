@@ -0,0 +1,376 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/cli/templates"
+)
+
+// languagePlugins maps detected manifest languages to the plugin that publishes them.
+var languagePlugins = map[templates.Language]string{
+	templates.LanguageNode: "npm",
+	templates.LanguageGo:   "gomod",
+	templates.LanguageRust: "crates",
+}
+
+// versionTagRegex splits a version tag into its prefix and semantic version.
+var versionTagRegex = regexp.MustCompile(`^(.*?)\d+\.\d+\.\d+(?:[-+][0-9A-Za-z.-]+)?$`)
+
+// changelogFiles are the changelog file names recognized at the repository root.
+var changelogFiles = []string{"CHANGELOG.md", "CHANGES.md", "HISTORY.md"}
+
+// Changelog heading patterns used to recognize an existing changelog's format.
+var (
+	keepAChangelogRegex  = regexp.MustCompile(`(?mi)^## \[(unreleased|v?\d+\.\d+\.\d+[^\]]*)\](\s+-\s+\d{4}-\d{2}-\d{2})?\s*$`)
+	conventionalLogRegex = regexp.MustCompile(`(?m)^#{1,3} \[?v?\d+\.\d+\.\d+[^\s]*(\]\([^)]*\))? \(\d{4}-\d{2}-\d{2}\)`)
+)
+
+// initDetection holds the settings inferred from a repository by init --detect.
+type initDetection struct {
+	// Projects lists detected project manifests.
+	Projects []detectedProject
+	// Forge is the detected hosting platform (github, gitlab) or empty.
+	Forge string
+	// Owner and Repo identify the repository on the forge.
+	Owner string
+	Repo  string
+	// RepositoryURL is the web URL of the repository.
+	RepositoryURL string
+	// TagPrefix is the tag prefix to use for version tags.
+	TagPrefix string
+	// TagPrefixReason explains how the tag prefix was chosen.
+	TagPrefixReason string
+	// Monorepo indicates a multi-package repository layout.
+	Monorepo bool
+	// PackagePaths lists package glob patterns for monorepo layouts.
+	PackagePaths []string
+	// MonorepoReason explains why the repository is treated as a monorepo.
+	MonorepoReason string
+	// ChangelogFile is the changelog file to maintain.
+	ChangelogFile string
+	// ChangelogFormat is the changelog format (keep-a-changelog, conventional).
+	ChangelogFormat string
+	// ChangelogReason explains how the changelog format was chosen.
+	ChangelogReason string
+}
+
+// detectedPlugin is a plugin enabled by detection and the reason it was chosen.
+type detectedPlugin struct {
+	Name   string
+	Reason string
+}
+
+// detectedProject describes a detected project manifest.
+type detectedProject struct {
+	// Manifest is the manifest path relative to the repository root.
+	Manifest string
+	// Plugin is the publishing plugin for the project type.
+	Plugin string
+	// ModulePath is the Go module path (go.mod only).
+	ModulePath string
+}
+
+// detectInitSettings inspects the repository at repoPath and infers configuration.
+func detectInitSettings(repoPath string) *initDetection {
+	d := &initDetection{}
+	if detection, err := templates.NewDetector(repoPath).Detect(); err == nil {
+		applyProjectDetection(detection, d)
+	}
+	detectTagPrefix(repoPath, d)
+	detectChangelog(repoPath, d)
+	return d
+}
+
+// applyProjectDetection takes the manifests, monorepo layout and forge from
+// the project detection.
+func applyProjectDetection(detection *templates.Detection, d *initDetection) {
+	d.Forge = detection.Forge
+	d.Owner = detection.ForgeOwner
+	d.Repo = detection.ForgeRepo
+	d.RepositoryURL = detection.RepositoryURL
+
+	packageCount := 0
+	for _, m := range detection.Manifests {
+		plugin, ok := languagePlugins[m.Language]
+		if !ok {
+			continue
+		}
+		d.Projects = append(d.Projects, detectedProject{Manifest: m.Path, Plugin: plugin, ModulePath: m.ModulePath})
+
+		// Manifests below the root live in a package directory such as packages/core.
+		if dir, _, nested := strings.Cut(m.Path, "/"); nested {
+			packageCount++
+			if pattern := dir + "/*"; !slices.Contains(d.PackagePaths, pattern) {
+				d.PackagePaths = append(d.PackagePaths, pattern)
+			}
+		}
+	}
+
+	switch {
+	case detection.HasWorkspace:
+		d.Monorepo = true
+		d.MonorepoReason = "workspace configuration found"
+	case packageCount > 1:
+		d.Monorepo = true
+		d.MonorepoReason = fmt.Sprintf("%d packages found under %s", packageCount, strings.Join(d.PackagePaths, ", "))
+	}
+	if d.Monorepo && len(d.PackagePaths) == 0 {
+		d.PackagePaths = []string{"packages/*"}
+	}
+}
+
+// detectTagPrefix infers the tag prefix from existing version tags.
+func detectTagPrefix(repoPath string, d *initDetection) {
+	d.TagPrefix = "v"
+	d.TagPrefixReason = "no version tags found; using the default"
+
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return
+	}
+	tags, err := repo.Tags()
+	if err != nil {
+		return
+	}
+
+	counts := make(map[string]int)
+	total := 0
+	_ = tags.ForEach(func(ref *plumbing.Reference) error {
+		if m := versionTagRegex.FindStringSubmatch(ref.Name().Short()); m != nil {
+			counts[m[1]]++
+			total++
+		}
+		return nil
+	})
+	if total == 0 {
+		return
+	}
+
+	prefixes := make([]string, 0, len(counts))
+	for prefix := range counts {
+		prefixes = append(prefixes, prefix)
+	}
+	// Most common prefix wins; ties are broken alphabetically for stable output.
+	slices.SortFunc(prefixes, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		return strings.Compare(a, b)
+	})
+
+	d.TagPrefix = prefixes[0]
+	d.TagPrefixReason = fmt.Sprintf("%d of %d version tags use this prefix", counts[d.TagPrefix], total)
+}
+
+// detectChangelog finds an existing changelog and infers its format from the
+// release headings.
+func detectChangelog(repoPath string, d *initDetection) {
+	d.ChangelogFile = "CHANGELOG.md"
+	d.ChangelogFormat = "keep-a-changelog"
+	d.ChangelogReason = "no existing changelog found; using the default"
+
+	for _, name := range changelogFiles {
+		data, err := os.ReadFile(filepath.Join(repoPath, name)) // #nosec G304 -- path is built from the repository root
+		if err != nil {
+			continue
+		}
+		d.ChangelogFile = name
+		switch {
+		case conventionalLogRegex.Match(data):
+			d.ChangelogFormat = "conventional"
+			d.ChangelogReason = name + " uses conventional-changelog release headings"
+		case keepAChangelogRegex.Match(data), strings.Contains(string(data), "keepachangelog.com"):
+			d.ChangelogReason = name + " follows Keep a Changelog"
+		default:
+			d.ChangelogReason = name + " has no recognized format; using the default"
+		}
+		return
+	}
+}
+
+// plugins returns the plugins enabled for the detection, in config order,
+// with the reason each was chosen.
+func (d *initDetection) plugins() []detectedPlugin {
+	var plugins []detectedPlugin
+	switch d.Forge {
+	case "github":
+		plugins = append(plugins, detectedPlugin{"github", "origin remote is on GitHub (" + d.Owner + "/" + d.Repo + "); releases are created on GitHub"})
+	case "gitlab":
+		plugins = append(plugins, detectedPlugin{"gitlab", "origin remote is on GitLab (" + d.Owner + "/" + d.Repo + "); releases are created on GitLab"})
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range d.Projects {
+		if seen[p.Plugin] {
+			continue
+		}
+		seen[p.Plugin] = true
+		plugins = append(plugins, detectedPlugin{p.Plugin, p.Manifest + " detected"})
+	}
+	return plugins
+}
+
+// printDetectionSummary prints what was detected and why each setting and
+// plugin was chosen.
+func printDetectionSummary(d *initDetection) {
+	printInfo("Detected:")
+	fmt.Printf("  Tag prefix:  %q (%s)\n", d.TagPrefix, d.TagPrefixReason)
+	fmt.Printf("  Changelog:   %s, %s (%s)\n", d.ChangelogFile, d.ChangelogFormat, d.ChangelogReason)
+	if d.RepositoryURL != "" {
+		fmt.Printf("  Repository:  %s\n", d.RepositoryURL)
+	} else {
+		fmt.Println("  Repository:  no GitHub or GitLab origin remote")
+	}
+	if d.Monorepo {
+		fmt.Printf("  Monorepo:    %s\n", d.MonorepoReason)
+	}
+
+	plugins := d.plugins()
+	if len(plugins) == 0 {
+		fmt.Println("  Plugins:     none (no forge or package manifests detected)")
+		return
+	}
+	fmt.Println("  Plugins:")
+	for _, p := range plugins {
+		fmt.Printf("    %-8s %s\n", p.Name, p.Reason)
+	}
+}
+
+// renderDetectedConfig renders a commented .relicta.yaml for the detection.
+func renderDetectedConfig(d *initDetection) string {
+	var b strings.Builder
+
+	b.WriteString("# Relicta configuration generated by 'relicta init --from-existing'.\n")
+	b.WriteString("# Review the inferred settings below before your first release.\n\n")
+
+	b.WriteString("versioning:\n")
+	b.WriteString("  strategy: conventional\n")
+	fmt.Fprintf(&b, "  # Tag prefix: %s\n", d.TagPrefixReason)
+	fmt.Fprintf(&b, "  tag_prefix: %q\n\n", d.TagPrefix)
+
+	b.WriteString("changelog:\n")
+	fmt.Fprintf(&b, "  file: %s\n", d.changelogFile())
+	fmt.Fprintf(&b, "  # Format: %s\n", d.ChangelogReason)
+	fmt.Fprintf(&b, "  format: %s\n", d.changelogFormat())
+	if d.RepositoryURL != "" {
+		b.WriteString("  # Repository URL detected from the origin remote\n")
+		fmt.Fprintf(&b, "  repository_url: %s\n", d.RepositoryURL)
+	}
+	b.WriteString("\n")
+
+	if d.Monorepo {
+		fmt.Fprintf(&b, "# Monorepo layout detected: %s\n", d.MonorepoReason)
+		b.WriteString("monorepo:\n")
+		b.WriteString("  enabled: true\n")
+		b.WriteString("  strategy: independent\n")
+		b.WriteString("  package_paths:\n")
+		for _, p := range d.PackagePaths {
+			fmt.Fprintf(&b, "    - %q\n", p)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("plugins:\n")
+	pluginCount := 0
+	switch d.Forge {
+	case "github":
+		b.WriteString("  # GitHub remote detected; releases are created on GitHub\n")
+		b.WriteString("  - name: github\n    enabled: true\n    config:\n")
+		fmt.Fprintf(&b, "      owner: %s\n      repo: %s\n", d.Owner, d.Repo)
+		pluginCount++
+	case "gitlab":
+		b.WriteString("  # GitLab remote detected; releases are created on GitLab\n")
+		b.WriteString("  - name: gitlab\n    enabled: true\n    config:\n")
+		fmt.Fprintf(&b, "      project_id: %s/%s\n", d.Owner, d.Repo)
+		pluginCount++
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range d.Projects {
+		if seen[p.Plugin] {
+			continue
+		}
+		seen[p.Plugin] = true
+		pluginCount++
+
+		fmt.Fprintf(&b, "  # %s detected\n", p.Manifest)
+		fmt.Fprintf(&b, "  - name: %s\n    enabled: true\n", p.Plugin)
+		switch p.Plugin {
+		case "npm":
+			b.WriteString("    config:\n      access: public\n")
+		case "gomod":
+			if p.ModulePath != "" {
+				fmt.Fprintf(&b, "    config:\n      module_path: %s\n", p.ModulePath)
+			}
+		case "crates":
+			fmt.Fprintf(&b, "    config:\n      manifest_path: %s\n", p.Manifest)
+		}
+	}
+	if pluginCount == 0 {
+		b.WriteString("  # No forge or package manifests detected; add plugins as needed\n  []\n")
+	}
+
+	return b.String()
+}
+
+// changelogFile returns the detected changelog file, defaulting to CHANGELOG.md.
+func (d *initDetection) changelogFile() string {
+	if d.ChangelogFile == "" {
+		return "CHANGELOG.md"
+	}
+	return d.ChangelogFile
+}
+
+// changelogFormat returns the detected changelog format, defaulting to
+// keep-a-changelog.
+func (d *initDetection) changelogFormat() string {
+	if d.ChangelogFormat == "" {
+		return "keep-a-changelog"
+	}
+	return d.ChangelogFormat
+}
+
+// runInitDetect implements init --from-existing (and its alias --detect).
+func runInitDetect(cmd *cobra.Command, configFile string) error {
+	printTitle("Relicta Setup")
+	fmt.Println()
+
+	d := detectInitSettings(".")
+	content := renderDetectedConfig(d)
+
+	printDetectionSummary(d)
+	fmt.Println()
+	printInfo("Generated configuration:")
+	fmt.Println()
+	fmt.Println(content)
+
+	if !initYes && !ciMode {
+		fmt.Printf("Write %s? [y/N]: ", configFile)
+		response, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			printInfo("Setup canceled")
+			return nil
+		}
+	}
+
+	if err := os.WriteFile(configFile, []byte(content), 0o644); err != nil { // #nosec G306 -- config file is not sensitive
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	printSuccess(fmt.Sprintf("Created %s", configFile))
+	return nil
+}
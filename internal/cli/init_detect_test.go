@@ -0,0 +1,249 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"gopkg.in/yaml.v3"
+
+	"github.com/relicta-tech/relicta/internal/config"
+)
+
+func writeDetectFile(t *testing.T, root, name, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// initDetectRepo creates a git repository with an origin remote, one commit and the given tags.
+func initDetectRepo(t *testing.T, root, remote string, tags ...string) {
+	t.Helper()
+	repo, err := git.PlainInit(root, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remote != "" {
+		if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{remote}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wt.AddGlob("."); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := wt.Commit("chore: initial", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range tags {
+		if _, err := repo.CreateTag(tag, hash, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDetectInitSettings_GoModuleOnGitHub(t *testing.T) {
+	root := t.TempDir()
+	writeDetectFile(t, root, "go.mod", "module github.com/acme/tool\n\ngo 1.24\n")
+	initDetectRepo(t, root, "git@github.com:acme/tool.git", "release-1.0.0", "release-1.1.0", "v0.9.0")
+
+	d := detectInitSettings(root)
+
+	if d.Forge != "github" || d.Owner != "acme" || d.Repo != "tool" {
+		t.Errorf("forge = %q %q/%q, want github acme/tool", d.Forge, d.Owner, d.Repo)
+	}
+	if d.RepositoryURL != "https://github.com/acme/tool" {
+		t.Errorf("RepositoryURL = %q", d.RepositoryURL)
+	}
+	if d.TagPrefix != "release-" {
+		t.Errorf("TagPrefix = %q, want release-", d.TagPrefix)
+	}
+	if len(d.Projects) != 1 || d.Projects[0].Plugin != "gomod" || d.Projects[0].ModulePath != "github.com/acme/tool" {
+		t.Errorf("Projects = %+v, want gomod github.com/acme/tool", d.Projects)
+	}
+	if d.Monorepo {
+		t.Error("single module should not be detected as monorepo")
+	}
+
+	content := renderDetectedConfig(d)
+	for _, want := range []string{"tag_prefix: \"release-\"", "2 of 3 version tags", "name: github", "owner: acme", "module_path: github.com/acme/tool"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("rendered config missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestDetectInitSettings_NodeMonorepoOnGitLab(t *testing.T) {
+	root := t.TempDir()
+	writeDetectFile(t, root, "package.json", `{"name": "root", "private": true, "workspaces": ["packages/*"]}`)
+	writeDetectFile(t, root, "packages/core/package.json", `{"name": "@acme/core"}`)
+	writeDetectFile(t, root, "packages/ui/package.json", `{"name": "@acme/ui"}`)
+	initDetectRepo(t, root, "https://gitlab.example.com/group/sub/web.git")
+
+	d := detectInitSettings(root)
+
+	if d.Forge != "gitlab" || d.Owner != "group/sub" || d.Repo != "web" {
+		t.Errorf("forge = %q %q/%q, want gitlab group/sub/web", d.Forge, d.Owner, d.Repo)
+	}
+	if d.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %q, want default v", d.TagPrefix)
+	}
+	if !d.Monorepo || d.MonorepoReason != "workspace configuration found" {
+		t.Errorf("Monorepo = %v (%s), want workspace monorepo", d.Monorepo, d.MonorepoReason)
+	}
+	if len(d.PackagePaths) != 1 || d.PackagePaths[0] != "packages/*" {
+		t.Errorf("PackagePaths = %v, want [packages/*]", d.PackagePaths)
+	}
+
+	content := renderDetectedConfig(d)
+	if strings.Count(content, "name: npm") != 1 {
+		t.Errorf("npm plugin should be configured once:\n%s", content)
+	}
+	if !strings.Contains(content, "project_id: group/sub/web") {
+		t.Errorf("expected gitlab project_id:\n%s", content)
+	}
+}
+
+func TestDetectInitSettings_RustCratesWithoutGit(t *testing.T) {
+	root := t.TempDir()
+	writeDetectFile(t, root, "crates/core/Cargo.toml", "[package]\nname = \"core\"\n")
+	writeDetectFile(t, root, "crates/cli/Cargo.toml", "[package]\nname = \"cli\"\n")
+
+	d := detectInitSettings(root)
+
+	if d.Forge != "" {
+		t.Errorf("Forge = %q, want none without a git remote", d.Forge)
+	}
+	if !d.Monorepo || len(d.PackagePaths) != 1 || d.PackagePaths[0] != "crates/*" {
+		t.Errorf("Monorepo = %v, PackagePaths = %v, want crates/* monorepo", d.Monorepo, d.PackagePaths)
+	}
+	if len(d.Projects) != 2 || d.Projects[0].Plugin != "crates" {
+		t.Errorf("Projects = %+v, want two crates", d.Projects)
+	}
+}
+
+func TestRenderDetectedConfig_LoadsAsConfig(t *testing.T) {
+	d := &initDetection{
+		Projects:        []detectedProject{{Manifest: "Cargo.toml", Plugin: "crates"}, {Manifest: "package.json", Plugin: "npm"}},
+		Forge:           "github",
+		Owner:           "acme",
+		Repo:            "app",
+		RepositoryURL:   "https://github.com/acme/app",
+		TagPrefix:       "v",
+		TagPrefixReason: "no version tags found; using the default",
+		Monorepo:        true,
+		PackagePaths:    []string{"packages/*"},
+		MonorepoReason:  "workspace configuration found",
+	}
+
+	path := filepath.Join(t.TempDir(), ".relicta.yaml")
+	if err := os.WriteFile(path, []byte(renderDetectedConfig(d)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]any
+	data, _ := os.ReadFile(path)
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("rendered config is not valid YAML: %v", err)
+	}
+
+	loaded, err := config.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+	if !loaded.Monorepo.Enabled || len(loaded.Plugins) != 3 {
+		t.Errorf("loaded config: monorepo=%v plugins=%d, want monorepo and 3 plugins", loaded.Monorepo.Enabled, len(loaded.Plugins))
+	}
+}
+
+func TestDetectChangelog(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		content    string
+		wantFile   string
+		wantFormat string
+	}{
+		{"none", "", "", "CHANGELOG.md", "keep-a-changelog"},
+		{
+			"keep a changelog", "CHANGELOG.md",
+			"# Changelog\n\n## [Unreleased]\n\n## [1.2.0] - 2026-01-10\n### Added\n- Export\n",
+			"CHANGELOG.md", "keep-a-changelog",
+		},
+		{
+			"conventional", "CHANGELOG.md",
+			"# Changelog\n\n## [1.2.0](https://github.com/acme/app/compare/v1.1.0...v1.2.0) (2026-01-10)\n\n### Features\n",
+			"CHANGELOG.md", "conventional",
+		},
+		{"other file name", "HISTORY.md", "# History\n\n1.0.0 first release\n", "HISTORY.md", "keep-a-changelog"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if tt.file != "" {
+				writeDetectFile(t, root, tt.file, tt.content)
+			}
+
+			d := &initDetection{}
+			detectChangelog(root, d)
+
+			if d.ChangelogFile != tt.wantFile || d.ChangelogFormat != tt.wantFormat {
+				t.Errorf("changelog = %s (%s), want %s (%s)", d.ChangelogFile, d.ChangelogFormat, tt.wantFile, tt.wantFormat)
+			}
+			if d.ChangelogReason == "" {
+				t.Error("ChangelogReason is empty")
+			}
+		})
+	}
+}
+
+func TestPrintDetectionSummary(t *testing.T) {
+	d := &initDetection{
+		Projects:        []detectedProject{{Manifest: "go.mod", Plugin: "gomod"}, {Manifest: "cmd/go.mod", Plugin: "gomod"}},
+		Forge:           "github",
+		Owner:           "acme",
+		Repo:            "tool",
+		RepositoryURL:   "https://github.com/acme/tool",
+		TagPrefix:       "v",
+		TagPrefixReason: "no version tags found; using the default",
+		ChangelogFile:   "CHANGELOG.md",
+		ChangelogFormat: "conventional",
+		ChangelogReason: "CHANGELOG.md uses conventional-changelog release headings",
+	}
+
+	out := captureOutput(t, func() { printDetectionSummary(d) })
+
+	for _, want := range []string{
+		"https://github.com/acme/tool",
+		"conventional-changelog release headings",
+		"origin remote is on GitHub (acme/tool)",
+		"go.mod detected",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "gomod") != 1 {
+		t.Errorf("gomod plugin should be listed once:\n%s", out)
+	}
+	if content := renderDetectedConfig(d); !strings.Contains(content, "format: conventional") {
+		t.Errorf("rendered config missing changelog format:\n%s", content)
+	}
+}
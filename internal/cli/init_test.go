@@ -354,6 +354,8 @@ func TestInitCommand_FlagsExist(t *testing.T) {
 		{"force flag", "force"},
 		{"interactive flag", "interactive"},
 		{"format flag", "format"},
+		{"detect flag", "detect"},
+		{"yes flag", "yes"},
 	}
 
 	for _, tt := range tests {
@@ -1,14 +1,20 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/relicta-tech/relicta/internal/cgp/risk"
+	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/container"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/mcp"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
 )
 
 var mcpCmd = &cobra.Command{
@@ -43,24 +49,149 @@ AI Agent Tools:
   - relicta.infer_version:    Lightweight version inference
   - relicta.summarize_diff:   Audience-tailored change summaries
   - relicta.validate_release: Pre-flight release validation
+  - relicta.approve_level:    Grant one level of a multi-level approval
+  - relicta.explain_error:    Recovery guidance for errors
+  - relicta.dryrun_plan:      Preview the publish execution plan
+  - relicta.transcript:       Toggle session transcript recording
 
 Resources available:
   - relicta://state:       Current release state
   - relicta://config:      Configuration settings
   - relicta://commits:     Recent commits
   - relicta://changelog:   Generated changelog
-  - relicta://risk-report: CGP risk assessment`,
+  - relicta://risk-report: CGP risk assessment
+  - relicta://approvals:   Granted and pending approvals
+  - relicta://metrics:     Release Memory analytics
+  - relicta://plan:        Publish execution plan`,
 	RunE: runMCPServe,
 }
 
+var mcpReplayAllowPublish bool
+
+var mcpReplayCmd = &cobra.Command{
+	Use:   "replay <transcript>",
+	Short: "Replay a recorded MCP session",
+	Long: `Replay the client messages of a recorded MCP session transcript against
+a fresh server and compare the responses with the recording.
+
+Transcripts are recorded when mcp.record_transcript is enabled or when an
+agent calls the relicta.transcript tool. Replay runs against the current
+repository, so tools that change release state change it again; replay in a
+scratch clone of the repository the session was recorded in.
+
+relicta.publish calls are skipped unless --allow-publish is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMCPReplay,
+}
+
 func init() {
+	mcpReplayCmd.Flags().BoolVar(&mcpReplayAllowPublish, "allow-publish", false, "replay relicta.publish calls (publishes the release again)")
+
 	mcpCmd.AddCommand(mcpServeCmd)
+	mcpCmd.AddCommand(mcpReplayCmd)
 }
 
 func runMCPServe(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
+	mcpLogger := setupMCP()
+
+	// Record the session transcript if configured; recording can also be
+	// started at runtime through the relicta.transcript tool.
+	var opts []mcp.ServerOption
+	recording := false
+	if cfg != nil {
+		recording = cfg.MCP.RecordTranscript
+		recorder := mcp.NewTranscriptRecorder(cfg.MCP.TranscriptFile, cfg.MCP.TranscriptMaxBytes, recording)
+		opts = append(opts, mcp.WithTranscriptRecorder(recorder))
+	}
+
+	server, hasAdapter, cleanup, err := newMCPServer(ctx, mcpLogger, opts...)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Log startup
+	mcpLogger.Info("starting MCP server",
+		"version", versionInfo.Version,
+		"transport", "stdio",
+		"tools_wired", hasAdapter,
+		"record_transcript", recording,
+	)
+
+	// Serve on stdio
+	return server.ServeStdio()
+}
+
+func runMCPReplay(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open transcript: %w", err)
+	}
+	entries, err := mcp.ReadTranscript(file)
+	_ = file.Close()
+	if err != nil {
+		return err
+	}
+
+	server, _, cleanup, err := newMCPServer(ctx, setupMCP())
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	result, err := server.Replay(ctx, entries, mcp.ReplayOptions{AllowPublish: mcpReplayAllowPublish})
+	if err != nil {
+		return fmt.Errorf("replay failed: %w", err)
+	}
+
+	if outputJSON {
+		if err := printStructuredOutput(result); err != nil {
+			return err
+		}
+	} else {
+		printMCPReplayResult(args[0], result)
+	}
+
+	if len(result.Mismatches) > 0 {
+		return fmt.Errorf("%d of %d replayed messages did not match the transcript", len(result.Mismatches), result.Requests)
+	}
+	return nil
+}
 
-	// Create logger for MCP server
+// printMCPReplayResult prints a replay summary.
+func printMCPReplayResult(path string, result *mcp.ReplayResult) {
+	printTitle("MCP Replay")
+	fmt.Println()
+	printInfo(fmt.Sprintf("Transcript: %s", path))
+	printInfo(fmt.Sprintf("Replayed:   %d", result.Requests))
+	printInfo(fmt.Sprintf("Matched:    %d", result.Matched))
+	if result.Skipped > 0 {
+		printWarning(fmt.Sprintf("Skipped: %d (relicta.transcript calls, and relicta.publish calls unless --allow-publish is set)", result.Skipped))
+	}
+	if len(result.Mismatches) == 0 {
+		printSuccess("All replayed messages matched the transcript")
+		return
+	}
+
+	for _, m := range result.Mismatches {
+		printError(fmt.Sprintf("Entry %d did not match", m.Index+1))
+		fmt.Printf("  Request:  %s\n", m.Request)
+		for _, msg := range m.Expected {
+			fmt.Printf("  Expected: %s\n", msg)
+		}
+		for _, msg := range m.Actual {
+			fmt.Printf("  Actual:   %s\n", msg)
+		}
+	}
+}
+
+// setupMCP loads the config if it is not loaded yet and creates the MCP
+// server logger. The logger writes to stderr, since stdout carries the
+// protocol.
+func setupMCP() *slog.Logger {
 	mcpLogger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}))
@@ -73,6 +204,13 @@ func runMCPServe(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	return mcpLogger
+}
+
+// newMCPServer creates an MCP server wired to the container's services.
+// It reports whether the tools are wired and returns a cleanup function
+// that closes the container.
+func newMCPServer(ctx context.Context, mcpLogger *slog.Logger, extra ...mcp.ServerOption) (*mcp.Server, bool, func(), error) {
 	// Create server options
 	opts := []mcp.ServerOption{
 		mcp.WithLogger(mcpLogger),
@@ -81,61 +219,90 @@ func runMCPServe(cmd *cobra.Command, args []string) error {
 	// Add config if loaded
 	if cfg != nil {
 		opts = append(opts, mcp.WithConfig(cfg))
+		if cfg.Governance.Enabled {
+			opts = append(opts, mcp.WithRiskCalculator(risk.NewCalculatorWithWeights(cfg.Governance.RiskWeights)))
+		}
 	}
 
 	// Initialize container to get use cases
+	cleanup := func() {}
 	var app *container.App
 	if cfg != nil {
 		var err error
 		app, err = container.NewInitialized(ctx, cfg)
 		if err != nil {
 			mcpLogger.Warn("failed to initialize container, tools will return stubs", "error", err)
+			app = nil
 		} else {
-			defer func() {
+			cleanup = func() {
 				if closeErr := app.Close(); closeErr != nil {
 					mcpLogger.Warn("failed to close container", "error", closeErr)
 				}
-			}()
+			}
 
-			// Initialize release services with current working directory
+			// Bind tools to the repository root rather than the process working
+			// directory, so tool calls resolve paths the same way whichever
+			// directory the server was launched from.
 			// This enables the DDD release workflow (plan, bump, notes, approve, publish)
-			repoRoot, err := os.Getwd()
+			repoRoot, err := mcpRepositoryRoot(ctx, app)
 			if err != nil {
-				mcpLogger.Warn("failed to get working directory for release services", "error", err)
+				mcpLogger.Warn("failed to resolve repository root for release services", "error", err)
 			} else {
 				if err := app.InitReleaseServices(ctx, repoRoot); err != nil {
 					mcpLogger.Warn("failed to initialize release services", "error", err)
 				}
+				opts = append(opts, mcp.WithGitService(app.Git()), mcp.WithRepositoryRoot(repoRoot))
+			}
+
+			// Wire Release Memory for the relicta://metrics resource
+			if app.HasMemory() {
+				opts = append(opts, mcp.WithMemoryStore(app.MemoryStore(), memoryRepositoryID(ctx, app, repoRoot)))
 			}
 
 			// Create adapter with use cases from container
-			adapter := createMCPAdapter(app)
+			adapter := createMCPAdapter(app, repoRoot)
 			opts = append(opts, mcp.WithAdapter(adapter))
 		}
 	}
 
-	// Create and start MCP server
-	server, err := mcp.NewServer(versionInfo.Version, opts...)
+	// Create MCP server
+	server, err := mcp.NewServer(versionInfo.Version, append(opts, extra...)...)
 	if err != nil {
-		return fmt.Errorf("failed to create MCP server: %w", err)
+		cleanup()
+		return nil, false, nil, fmt.Errorf("failed to create MCP server: %w", err)
 	}
 
-	// Log startup
-	hasAdapter := app != nil
-	mcpLogger.Info("starting MCP server",
-		"version", versionInfo.Version,
-		"transport", "stdio",
-		"tools_wired", hasAdapter,
-	)
+	return server, app != nil, cleanup, nil
+}
 
-	// Serve on stdio
-	return server.ServeStdio()
+// mcpRepositoryRoot returns the root of the repository the MCP server
+// operates on, falling back to the working directory.
+func mcpRepositoryRoot(ctx context.Context, app *container.App) (string, error) {
+	if gitSvc := app.Git(); gitSvc != nil {
+		if root, err := gitSvc.GetRepositoryRoot(ctx); err == nil && root != "" {
+			return root, nil
+		}
+	}
+	return os.Getwd()
 }
 
-// createMCPAdapter creates an MCP adapter wired to the container's services.
+// memoryRepositoryID returns the repository ID under which Release Memory
+// records releases: the remote URL, falling back to the repository root.
+func memoryRepositoryID(ctx context.Context, app *container.App, repoRoot string) string {
+	if info, err := app.GitAdapter().GetInfo(ctx); err == nil && info.RemoteURL != "" {
+		return info.RemoteURL
+	}
+	return repoRoot
+}
+
+// createMCPAdapter creates an MCP adapter wired to the container's services
+// and bound to repoRoot.
 // ADR-007: All interfaces must use application services layer.
-func createMCPAdapter(app *container.App) *mcp.Adapter {
+func createMCPAdapter(app *container.App, repoRoot string) *mcp.Adapter {
 	opts := []mcp.AdapterOption{}
+	if repoRoot != "" {
+		opts = append(opts, mcp.WithRepoRoot(repoRoot))
+	}
 
 	// Wire release analyzer for planning
 	if analyzer := app.ReleaseAnalyzer(); analyzer != nil {
@@ -163,5 +330,45 @@ func createMCPAdapter(app *container.App) *mcp.Adapter {
 		opts = append(opts, mcp.WithAIService(app.AI()))
 	}
 
+	// Wire approval expiry and plugin failure handling from config
+	if cfg != nil {
+		if cfg.Governance.ApprovalTTL > 0 {
+			opts = append(opts, mcp.WithApprovalTTL(cfg.Governance.ApprovalTTL))
+		}
+		opts = append(opts, mcp.WithOnPluginFailure(releaseapp.PluginFailureMode(cfg.Workflow.OnPluginFailure)))
+		if policy, ok := approvalPolicyFromConfig(cfg.Governance); ok {
+			opts = append(opts, mcp.WithApprovalPolicy(policy))
+		}
+		opts = append(opts, mcp.WithNonConventionalPolicy(servicerelease.NonConventionalPolicy(cfg.Versioning.NonConventionalPolicy)))
+		opts = append(opts, mcp.WithCommitParser(configCommitParser()))
+		if f, ok := projectVersionFile(); ok {
+			opts = append(opts, mcp.WithVersionFile(f))
+		}
+		opts = append(opts, mcp.WithExcludeScopes(cfg.Changelog.ExcludeScopes))
+		opts = append(opts, mcp.WithChangelogPreview(container.NewNotesGeneratorAdapter(nil, nil), changelogPreviewOptions()))
+	}
+
 	return mcp.NewAdapter(opts...)
 }
+
+// approvalPolicyFromConfig builds the multi-level approval policy from
+// governance.approval_levels. It reports false if no levels are configured.
+func approvalPolicyFromConfig(gov config.GovernanceConfig) (releasedomain.ApprovalPolicy, bool) {
+	if len(gov.ApprovalLevels) == 0 {
+		return releasedomain.ApprovalPolicy{}, false
+	}
+
+	policy := releasedomain.ApprovalPolicy{Sequential: gov.SequentialApprovals}
+	for _, name := range gov.ApprovalLevels {
+		level, err := releasedomain.ParseApprovalLevel(name)
+		if err != nil {
+			continue // Rejected by config validation
+		}
+		policy.Requirements = append(policy.Requirements, releasedomain.ApprovalRequirement{
+			Level:       level,
+			Description: fmt.Sprintf("%s approval", level),
+			Required:    true,
+		})
+	}
+	return policy, len(policy.Requirements) > 0
+}
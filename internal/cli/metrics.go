@@ -4,8 +4,10 @@ package cli
 import (
 	"context"
 	"fmt"
+	"html"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
@@ -31,9 +33,14 @@ The metrics server provides visibility into:
   - Plugin executions and errors
   - Command invocations and latency
   - Active release count
+  - Time spent in each release state and runs published/failed per repository
+    (recorded by 'relicta serve' when telemetry.metrics.enabled is set)
+
+The port and path default to telemetry.metrics.port and
+telemetry.metrics.endpoint.
 
 Example:
-  # Start metrics server on default port 9090
+  # Start metrics server on the configured port (default 9090)
   relicta metrics
 
   # Start on custom port
@@ -56,34 +63,13 @@ func runMetrics(cmd *cobra.Command, args []string) error {
 	// Initialize global metrics with version
 	metrics := observability.InitGlobal(versionInfo.Version)
 
-	// Create HTTP server
-	mux := http.NewServeMux()
-	mux.Handle("/metrics", metrics.Handler())
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("OK\n"))
-	})
-	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		_, _ = w.Write([]byte(`<!DOCTYPE html>
-<html>
-<head><title>Relicta Metrics</title></head>
-<body>
-<h1>Relicta Metrics Server</h1>
-<p><a href="/metrics">Metrics</a> - Prometheus-compatible metrics endpoint</p>
-<p><a href="/health">Health</a> - Health check endpoint</p>
-</body>
-</html>`))
-	})
-
-	addr := net.JoinHostPort(metricsHost, fmt.Sprintf("%d", metricsPort))
-	server := &http.Server{
-		Addr:         addr,
-		Handler:      mux,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  60 * time.Second,
+	port := metricsPort
+	if !cmd.Flags().Changed("port") && cfg != nil && cfg.Telemetry.Metrics.Port > 0 {
+		port = cfg.Telemetry.Metrics.Port
 	}
+	addr := net.JoinHostPort(metricsHost, fmt.Sprintf("%d", port))
+	path := metricsEndpointPath()
+	server := newMetricsServer(addr, path, metrics.Handler())
 
 	// Handle graceful shutdown
 	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
@@ -93,7 +79,7 @@ func runMetrics(cmd *cobra.Command, args []string) error {
 	errCh := make(chan error, 1)
 	go func() {
 		fmt.Printf("Starting metrics server on %s\n", addr)
-		fmt.Printf("Metrics available at: http://%s/metrics\n", addr)
+		fmt.Printf("Metrics available at: http://%s%s\n", addr, path)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			errCh <- err
 		}
@@ -110,3 +96,48 @@ func runMetrics(cmd *cobra.Command, args []string) error {
 		return err
 	}
 }
+
+// metricsEndpointPath returns the path metrics are served on:
+// telemetry.metrics.endpoint, or /metrics when it is not set. An endpoint
+// given as a URL contributes its path.
+func metricsEndpointPath() string {
+	if cfg == nil || cfg.Telemetry.Metrics.Endpoint == "" {
+		return "/metrics"
+	}
+	u, err := url.Parse(cfg.Telemetry.Metrics.Endpoint)
+	if err != nil || u.Path == "" || u.Path == "/" {
+		return "/metrics"
+	}
+	return u.Path
+}
+
+// newMetricsServer returns the HTTP server exposing metrics on path, with a
+// health check and an index page.
+func newMetricsServer(addr, path string, metrics http.Handler) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(path, metrics)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("OK\n"))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<!DOCTYPE html>
+<html>
+<head><title>Relicta Metrics</title></head>
+<body>
+<h1>Relicta Metrics Server</h1>
+<p><a href="` + html.EscapeString(path) + `">Metrics</a> - Prometheus-compatible metrics endpoint</p>
+<p><a href="/health">Health</a> - Health check endpoint</p>
+</body>
+</html>`))
+	})
+
+	return &http.Server{
+		Addr:         addr,
+		Handler:      mux,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/config"
 )
 
 func TestMetricsCommand_Configuration(t *testing.T) {
@@ -128,3 +130,25 @@ func TestRunMetrics_ContextCanceled(t *testing.T) {
 		t.Fatalf("runMetrics error: %v", err)
 	}
 }
+
+func TestMetricsEndpointPath(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"", "/metrics"},
+		{"/prometheus", "/prometheus"},
+		{"http://localhost:9090/custom/metrics", "/custom/metrics"},
+		{"http://localhost:9090", "/metrics"},
+	}
+	for _, tt := range tests {
+		cfg = config.DefaultConfig()
+		cfg.Telemetry.Metrics.Endpoint = tt.endpoint
+		if got := metricsEndpointPath(); got != tt.want {
+			t.Errorf("metricsEndpointPath() with endpoint %q = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
@@ -3,14 +3,15 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/pkg/plugin"
 )
 
 var (
@@ -20,33 +21,90 @@ var (
 	notesIncludeEmoji bool
 	notesLanguage     string
 	notesUseAI        bool
+	notesTemplate     string
+	notesRegenerate   bool
+	notesFromFile     string
 )
 
 func init() {
 	notesCmd.Flags().StringVarP(&notesOutput, "output", "o", "", "output file (default: stdout)")
-	notesCmd.Flags().StringVarP(&notesTone, "tone", "t", "", "AI tone (technical, friendly, professional, marketing)")
-	notesCmd.Flags().StringVarP(&notesAudience, "audience", "a", "", "target audience (developers, users, public, stakeholders)")
+	notesCmd.Flags().StringVarP(&notesTone, "tone", "t", "", "tone preset (technical, friendly, professional, excited) - overrides ai.tone")
+	notesCmd.Flags().StringVarP(&notesAudience, "audience", "a", "", "audience preset (developers, users, public, marketing) - overrides ai.audience")
 	notesCmd.Flags().BoolVar(&notesIncludeEmoji, "emoji", false, "include emojis in output")
 	notesCmd.Flags().StringVarP(&notesLanguage, "language", "l", "English", "output language")
 	notesCmd.Flags().BoolVar(&notesUseAI, "ai", false, "use AI to generate notes (requires OPENAI_API_KEY)")
+	notesCmd.Flags().StringVar(&notesTemplate, "template", "", "changelog template file (overrides changelog.template)")
+	notesCmd.Flags().BoolVar(&notesRegenerate, "regenerate", false, "replace notes that were already generated (before approval)")
+	notesCmd.Flags().StringVar(&notesFromFile, "from-file", "", "use the notes in this file instead of generating them")
+	notesCmd.MarkFlagsMutuallyExclusive("from-file", "ai")
+	notesCmd.MarkFlagsMutuallyExclusive("from-file", "regenerate")
+	notesCmd.MarkFlagsMutuallyExclusive("from-file", "template")
 }
 
 // buildNotesInputForServices creates the input for the GenerateNotes use case.
 func buildNotesInputForServices(repoRoot string, hasAI bool) releaseapp.GenerateNotesInput {
+	// Presets not given as flags come from ai.audience and ai.tone, except
+	// when regenerating, which keeps those of the existing notes, and for
+	// imported notes, which were not generated with a preset.
+	audience, tone := notesAudience, notesTone
+	if !notesRegenerate && notesFromFile == "" {
+		if audience == "" {
+			audience = cfg.AI.Audience
+		}
+		if tone == "" {
+			tone = cfg.AI.Tone
+		}
+	}
+
 	return releaseapp.GenerateNotesInput{
 		RepoRoot: repoRoot,
 		Options: ports.NotesOptions{
-			AudiencePreset: notesAudience,
-			TonePreset:     notesTone,
-			UseAI:          notesUseAI && hasAI,
-			RepositoryURL:  cfg.Changelog.RepositoryURL,
+			AudiencePreset:  audience,
+			TonePreset:      tone,
+			UseAI:           notesUseAI && hasAI,
+			RepositoryURL:   cfg.Changelog.RepositoryURL,
+			IssueURL:        cfg.Changelog.IssueURL,
+			Template:        notesTemplatePath(),
+			TagPrefix:       cfg.Versioning.TagPrefix,
+			HighlightsCount: cfg.Changelog.HighlightsCount,
+			ExcludeScopes:   cfg.Changelog.ExcludeScopes,
 		},
 		Actor: ports.ActorInfo{
 			Type: "user",
 			ID:   "cli",
 		},
-		Force: false,
+		Force:      false,
+		Regenerate: notesRegenerate,
+	}
+}
+
+// readNotesFile reads the notes given with --from-file. The path must be a
+// regular file within the working directory and the notes must not be empty.
+func readNotesFile(path string) (string, error) {
+	realPath, err := plugin.ValidateAssetPath(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid notes file: %w", err)
+	}
+
+	data, err := os.ReadFile(realPath) // #nosec G304 -- path validated above
+	if err != nil {
+		return "", fmt.Errorf("failed to read notes file: %w", err)
+	}
+
+	text := strings.TrimSpace(string(data))
+	if text == "" {
+		return "", fmt.Errorf("notes file %s is empty", path)
 	}
+	return text, nil
+}
+
+// notesTemplatePath returns the changelog template to render notes from,
+// preferring the --template flag over changelog.template.
+func notesTemplatePath() string {
+	if notesTemplate != "" {
+		return notesTemplate
+	}
+	return cfg.Changelog.Template
 }
 
 // printNotesNextSteps prints the next steps after generating notes.
@@ -64,6 +122,15 @@ func printNotesNextSteps() {
 func runNotes(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	var importedNotes string
+	if notesFromFile != "" {
+		text, err := readNotesFile(notesFromFile)
+		if err != nil {
+			return err
+		}
+		importedNotes = text
+	}
+
 	printTitle("Release Notes Generation")
 	fmt.Println()
 
@@ -95,22 +162,34 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("GenerateNotes use case not available")
 	}
 
-	return runNotesWithServices(ctx, app, repoInfo.Path)
+	return runNotesWithServices(ctx, app, repoInfo.Path, importedNotes)
 }
 
 // runNotesWithServices generates notes using the GenerateNotesUseCase.
-func runNotesWithServices(ctx context.Context, app cliApp, repoPath string) error {
+// Non-empty importedNotes are used as the notes instead of generating them.
+func runNotesWithServices(ctx context.Context, app cliApp, repoPath, importedNotes string) error {
 	services := app.ReleaseServices()
 
 	// Build input
 	input := buildNotesInputForServices(repoPath, app.HasAI())
+	input.Text = importedNotes
+
+	if err := checkStrict(); err != nil {
+		return err
+	}
 
 	// Show spinner (unless JSON output)
 	var spinner *Spinner
 	if !outputJSON {
 		spinnerMsg := "Generating release notes..."
+		if input.Regenerate {
+			spinnerMsg = "Regenerating release notes..."
+		}
+		if input.Text != "" {
+			spinnerMsg = "Importing release notes..."
+		}
 		if input.Options.UseAI {
-			spinnerMsg = "Generating release notes with AI..."
+			spinnerMsg = strings.TrimSuffix(spinnerMsg, "...") + " with AI..."
 		}
 		spinner = NewSpinner(spinnerMsg)
 		spinner.Start()
@@ -138,6 +217,9 @@ func runNotesWithServices(ctx context.Context, app cliApp, repoPath string) erro
 	if output.Notes != nil {
 		fmt.Println(output.Notes.Text)
 	}
+	if output.Regenerated {
+		printSuccess("Release notes replaced")
+	}
 
 	// Write to file if specified
 	if notesOutput != "" {
@@ -153,12 +235,13 @@ func runNotesWithServices(ctx context.Context, app cliApp, repoPath string) erro
 	return nil
 }
 
-// outputNotesJSONFromServices outputs notes as JSON from domain services.
+// outputNotesJSONFromServices outputs notes as JSON or YAML from domain services.
 func outputNotesJSONFromServices(ctx context.Context, output *releaseapp.GenerateNotesOutput, repoPath string, app cliApp) error {
 	result := map[string]any{
 		"release_id":   string(output.RunID),
 		"inputs_hash":  output.InputsHash,
-		"ai_generated": output.Notes != nil && output.Notes.Provider != "" && output.Notes.Provider != "basic",
+		"regenerated":  output.Regenerated,
+		"ai_generated": output.Notes != nil && output.Notes.Provider != "" && output.Notes.Provider != "basic" && output.Notes.Provider != "template" && output.Notes.Provider != releaseapp.NotesProviderImported,
 	}
 
 	if output.Notes != nil {
@@ -167,6 +250,9 @@ func outputNotesJSONFromServices(ctx context.Context, output *releaseapp.Generat
 		result["audience_preset"] = output.Notes.AudiencePreset
 		result["provider"] = output.Notes.Provider
 		result["model"] = output.Notes.Model
+		if len(output.Notes.Highlights) > 0 {
+			result["highlights"] = output.Notes.Highlights
+		}
 	}
 
 	// Try to get version from the release
@@ -180,7 +266,5 @@ func outputNotesJSONFromServices(ctx context.Context, output *releaseapp.Generat
 		}
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(result)
+	return printStructuredOutput(result)
 }
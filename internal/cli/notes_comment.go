@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/infrastructure/forge"
+)
+
+// defaultCommentMarker identifies the release plan comment on a pull request.
+const defaultCommentMarker = "relicta:release-plan"
+
+var (
+	notesCommentPR     int
+	notesCommentBranch string
+	notesCommentMarker string
+)
+
+var notesCommentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Post the release plan and changelog as a pull request comment",
+	Long: `Post the version plan and changelog of the current release as a comment
+on a release pull request, so reviewers see what the release contains.
+
+The comment is sticky: it carries a hidden marker, and later runs update
+the marked comment in place instead of adding new ones. Use --marker to keep
+separate comments, e.g. one per package.
+
+The pull request is selected with --pr, or found by its head branch with
+--branch (default: the current branch). The changelog is the release notes
+if they have been generated, otherwise the planned commits.
+
+Requires a token in GITHUB_TOKEN or GH_TOKEN; GITHUB_API_URL selects a
+GitHub Enterprise API. With --dry-run the comment is printed, not posted.`,
+	Example: `  # Comment on the pull request for the current branch
+  relicta notes comment
+
+  # Comment on a specific pull request
+  relicta notes comment --pr 42`,
+	Args: cobra.NoArgs,
+	RunE: runNotesComment,
+}
+
+func init() {
+	notesCommentCmd.Flags().IntVar(&notesCommentPR, "pr", 0, "pull request number")
+	notesCommentCmd.Flags().StringVar(&notesCommentBranch, "branch", "", "find the open pull request for this head branch (default: current branch)")
+	notesCommentCmd.Flags().StringVar(&notesCommentMarker, "marker", defaultCommentMarker, "marker identifying the comment to update")
+	notesCommentCmd.MarkFlagsMutuallyExclusive("pr", "branch")
+	notesCmd.AddCommand(notesCommentCmd)
+}
+
+// prCommenter posts sticky comments on forge pull requests.
+type prCommenter interface {
+	FindPullRequest(ctx context.Context, owner, repo, branch string) (*forge.OpenPullRequest, error)
+	UpsertComment(ctx context.Context, owner, repo string, number int, marker, body string) (*forge.IssueComment, bool, error)
+}
+
+// newPRCommenter creates the GitHub client used by notes comment.
+var newPRCommenter = func() (prCommenter, error) {
+	return newGitHubClient()
+}
+
+// PRCommentResult describes the posted comment.
+type PRCommentResult struct {
+	PullRequest int    `json:"pull_request"`
+	CommentID   int64  `json:"comment_id,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Created     bool   `json:"created"`
+	Body        string `json:"body"`
+}
+
+// runNotesComment implements the notes comment command.
+func runNotesComment(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if strings.TrimSpace(notesCommentMarker) == "" {
+		return fmt.Errorf("--marker must not be empty")
+	}
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	if err := app.InitReleaseServices(ctx, repoInfo.Path); err != nil {
+		return fmt.Errorf("failed to initialize release services: %w", err)
+	}
+
+	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	if err != nil {
+		return fmt.Errorf("failed to load release: %w", err)
+	}
+	body := releasePlanComment(run, notesCommentMarker)
+
+	if dryRun {
+		if outputJSON {
+			return printStructuredOutput(PRCommentResult{PullRequest: notesCommentPR, Body: body})
+		}
+		printInfo("Dry run: the following comment would be posted")
+		fmt.Println()
+		fmt.Println(body)
+		return nil
+	}
+
+	commenter, err := newPRCommenter()
+	if err != nil {
+		return err
+	}
+
+	branch := notesCommentBranch
+	if branch == "" {
+		branch = repoInfo.CurrentBranch
+	}
+	result, err := postReleasePlanComment(ctx, commenter, repoInfo, notesCommentPR, branch, notesCommentMarker, body)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		return printStructuredOutput(result)
+	}
+	if result.Created {
+		printSuccess(fmt.Sprintf("Posted release plan on pull request #%d", result.PullRequest))
+	} else {
+		printSuccess(fmt.Sprintf("Updated release plan on pull request #%d", result.PullRequest))
+	}
+	if result.URL != "" {
+		printSubtle("  " + result.URL)
+	}
+	return nil
+}
+
+// postReleasePlanComment posts or updates the sticky comment on pull request
+// number, or on the open pull request for branch if number is zero.
+func postReleasePlanComment(ctx context.Context, commenter prCommenter, repoInfo *sourcecontrol.RepositoryInfo, number int, branch, marker, body string) (*PRCommentResult, error) {
+	if repoInfo.Owner == "" || repoInfo.Name == "" {
+		return nil, fmt.Errorf("cannot determine the GitHub repository from the origin remote")
+	}
+
+	if number == 0 {
+		if branch == "" {
+			return nil, fmt.Errorf("cannot determine the pull request: use --pr or --branch")
+		}
+		pr, err := commenter.FindPullRequest(ctx, repoInfo.Owner, repoInfo.Name, branch)
+		if err != nil {
+			return nil, err
+		}
+		number = pr.Number
+	}
+
+	comment, created, err := commenter.UpsertComment(ctx, repoInfo.Owner, repoInfo.Name, number, commentMarker(marker), body)
+	if err != nil {
+		return nil, err
+	}
+	return &PRCommentResult{
+		PullRequest: number,
+		CommentID:   comment.ID,
+		URL:         comment.HTMLURL,
+		Created:     created,
+		Body:        body,
+	}, nil
+}
+
+// commentMarker returns the hidden HTML marker for a marker name.
+func commentMarker(name string) string {
+	return "<!-- " + name + " -->"
+}
+
+// releasePlanComment renders the version plan and changelog of a release
+// as a pull request comment carrying the marker.
+func releasePlanComment(run *domain.ReleaseRun, marker string) string {
+	var b strings.Builder
+
+	b.WriteString(commentMarker(marker) + "\n")
+	next := run.VersionNext().String()
+	if run.TagName() != "" {
+		next = run.TagName()
+	}
+	fmt.Fprintf(&b, "## Release %s\n\n", next)
+	fmt.Fprintf(&b, "**Version:** %s → %s (%s)  \n", run.VersionCurrent(), run.VersionNext(), run.BumpKind())
+	fmt.Fprintf(&b, "**Commits:** %d  \n", len(run.Commits()))
+	fmt.Fprintf(&b, "**State:** %s\n\n", run.State())
+
+	b.WriteString("### Changelog\n\n")
+	if notes := run.Notes(); notes != nil && strings.TrimSpace(notes.Text) != "" {
+		b.WriteString(strings.TrimSpace(notes.Text) + "\n")
+	} else {
+		doc := buildPlanReviewDocument(run, nil, nil, nil)
+		if len(doc.Commits) == 0 {
+			b.WriteString("No categorized commits.\n")
+		}
+		for _, section := range doc.Commits {
+			fmt.Fprintf(&b, "#### %s\n\n", section.Category)
+			for _, c := range section.Commits {
+				b.WriteString(formatPlanReviewCommit(c))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	fmt.Fprintf(&b, "\n<sub>Updated by relicta for release %s.</sub>\n", run.ID())
+	return b.String()
+}
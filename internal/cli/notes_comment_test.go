@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/infrastructure/forge"
+)
+
+// fakePRCommenter records sticky comments by marker.
+type fakePRCommenter struct {
+	comments map[string]string
+	branch   string
+}
+
+func (f *fakePRCommenter) FindPullRequest(_ context.Context, _, _, branch string) (*forge.OpenPullRequest, error) {
+	f.branch = branch
+	return &forge.OpenPullRequest{Number: 9}, nil
+}
+
+func (f *fakePRCommenter) UpsertComment(_ context.Context, _, _ string, number int, marker, body string) (*forge.IssueComment, bool, error) {
+	_, exists := f.comments[marker]
+	f.comments[marker] = body
+	return &forge.IssueComment{ID: int64(number) * 100}, !exists, nil
+}
+
+func TestReleasePlanComment(t *testing.T) {
+	run, _, _ := newPlanReviewFixture(t)
+
+	body := releasePlanComment(run, "relicta:release-plan")
+	for _, want := range []string{
+		"<!-- relicta:release-plan -->",
+		"## Release 2.0.0",
+		"1.0.0 → 2.0.0 (major)",
+		"#### Features",
+		"- `2222222` handle empty config",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("comment missing %q:\n%s", want, body)
+		}
+	}
+
+	notes := &domain.ReleaseNotes{Text: "## 2.0.0\n\n- Export endpoint\n"}
+	if err := run.SetVersion(run.VersionNext(), "v2.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := run.Bump("test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := run.GenerateNotes(notes, "", "test"); err != nil {
+		t.Fatal(err)
+	}
+	body = releasePlanComment(run, "relicta:release-plan")
+	if !strings.Contains(body, "## Release v2.0.0") || !strings.Contains(body, "- Export endpoint") {
+		t.Errorf("comment should use the tag and release notes:\n%s", body)
+	}
+	if strings.Contains(body, "#### Features") {
+		t.Errorf("comment should not list commits when notes exist:\n%s", body)
+	}
+}
+
+func TestPostReleasePlanComment(t *testing.T) {
+	ctx := context.Background()
+	repoInfo := &sourcecontrol.RepositoryInfo{Owner: "acme", Name: "widget"}
+	commenter := &fakePRCommenter{comments: map[string]string{}}
+
+	result, err := postReleasePlanComment(ctx, commenter, repoInfo, 0, "release/2.0", "relicta:release-plan", "v1")
+	if err != nil {
+		t.Fatalf("postReleasePlanComment() error = %v", err)
+	}
+	if commenter.branch != "release/2.0" || result.PullRequest != 9 || !result.Created {
+		t.Errorf("result = %+v, branch %q; want new comment on #9 found by branch", result, commenter.branch)
+	}
+
+	result, err = postReleasePlanComment(ctx, commenter, repoInfo, 9, "", "relicta:release-plan", "v2")
+	if err != nil {
+		t.Fatalf("postReleasePlanComment() error = %v", err)
+	}
+	if result.Created || commenter.comments["<!-- relicta:release-plan -->"] != "v2" {
+		t.Errorf("result = %+v, comments = %v; want the sticky comment updated", result, commenter.comments)
+	}
+
+	if _, err := postReleasePlanComment(ctx, commenter, &sourcecontrol.RepositoryInfo{}, 9, "", "m", "b"); err == nil {
+		t.Error("postReleasePlanComment() error = nil without a GitHub repository")
+	}
+}
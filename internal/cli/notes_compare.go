@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/infrastructure/forge"
+)
+
+var notesCompareCmd = &cobra.Command{
+	Use:   "compare",
+	Short: "Compare the release notes with GitHub's generated notes",
+	Long: `Compare the release notes of the current release with the notes GitHub
+generates for the same range, and report pull requests listed in only one
+of them. This helps catch commits that were excluded by mistake.
+
+The command is read-only: it asks GitHub to generate notes but does not
+create or modify a release. It requires a token in GITHUB_TOKEN or GH_TOKEN;
+GITHUB_API_URL selects a GitHub Enterprise API.`,
+	Args: cobra.NoArgs,
+	RunE: runNotesCompare,
+}
+
+func init() {
+	notesCmd.AddCommand(notesCompareCmd)
+}
+
+// notesGenerator generates forge release notes for a range.
+type notesGenerator interface {
+	GenerateReleaseNotes(ctx context.Context, owner, repo string, in forge.GenerateNotesRequest) (*forge.GeneratedNotes, error)
+}
+
+// newNotesGenerator creates the GitHub client used by notes compare.
+var newNotesGenerator = func() (notesGenerator, error) {
+	return newGitHubClient()
+}
+
+// newGitHubClient creates a GitHub client from GITHUB_TOKEN or GH_TOKEN and
+// GITHUB_API_URL. The remaining rate limit is logged in verbose mode.
+func newGitHubClient() (*forge.GitHubClient, error) {
+	opts := []forge.Option{forge.WithLogger(slog.New(logger))}
+	if apiURL := os.Getenv("GITHUB_API_URL"); apiURL != "" {
+		opts = append(opts, forge.WithAPIURL(apiURL))
+	}
+	return forge.NewGitHubClient(forge.GitHubTokenFromEnv(), opts...)
+}
+
+// runNotesCompare implements the notes compare command.
+func runNotesCompare(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	if err := app.InitReleaseServices(ctx, repoInfo.Path); err != nil {
+		return fmt.Errorf("failed to initialize release services: %w", err)
+	}
+
+	generator, err := newNotesGenerator()
+	if err != nil {
+		return err
+	}
+
+	comparison, err := compareNotesWithGitHub(ctx, app, repoInfo, generator)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		return printStructuredOutput(comparison)
+	}
+	printNotesComparison(comparison)
+	return nil
+}
+
+// compareNotesWithGitHub compares the notes of the latest release run with
+// GitHub's generated notes for the same range.
+func compareNotesWithGitHub(ctx context.Context, app cliApp, repoInfo *sourcecontrol.RepositoryInfo, generator notesGenerator) (*forge.NotesComparison, error) {
+	if repoInfo.Owner == "" || repoInfo.Name == "" {
+		return nil, fmt.Errorf("cannot determine the GitHub repository from the origin remote")
+	}
+
+	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load release: %w", err)
+	}
+	if run.Notes() == nil {
+		return nil, fmt.Errorf("release notes have not been generated; run 'relicta notes' first")
+	}
+	if run.TagName() == "" {
+		return nil, fmt.Errorf("release version is not set; run 'relicta bump' first")
+	}
+
+	generated, err := generator.GenerateReleaseNotes(ctx, repoInfo.Owner, repoInfo.Name, forge.GenerateNotesRequest{
+		TagName:         run.TagName(),
+		TargetCommitish: releaseTargetCommitish(run),
+		PreviousTagName: run.BaseRef(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gitAdapter := app.GitAdapter()
+	commits := make([]forge.Commit, 0, len(run.Commits()))
+	for _, sha := range run.Commits() {
+		commit, err := gitAdapter.GetCommit(ctx, sourcecontrol.CommitHash(sha))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit %s: %w", sha, err)
+		}
+		commits = append(commits, forge.Commit{SHA: string(sha), Subject: commit.Subject()})
+	}
+
+	return forge.CompareNotes(run.Notes().Text, generated.Body, commits), nil
+}
+
+// printNotesComparison prints the discrepancies between the notes.
+func printNotesComparison(c *forge.NotesComparison) {
+	printTitle("Release Notes Comparison")
+	fmt.Println()
+	printInfo(fmt.Sprintf("Pull requests in both notes: %d", len(c.Matched)))
+
+	if len(c.OnlyInGitHub) > 0 {
+		fmt.Println()
+		printWarning(fmt.Sprintf("Only in GitHub's notes (%d):", len(c.OnlyInGitHub)))
+		printNotesDiscrepancies(c.OnlyInGitHub)
+	}
+	if len(c.OnlyInRelicta) > 0 {
+		fmt.Println()
+		printWarning(fmt.Sprintf("Only in relicta's notes (%d):", len(c.OnlyInRelicta)))
+		printNotesDiscrepancies(c.OnlyInRelicta)
+	}
+	if len(c.UnreferencedCommits) > 0 {
+		fmt.Println()
+		printInfo(fmt.Sprintf("%d commit(s) reference no pull request and were not compared", len(c.UnreferencedCommits)))
+	}
+
+	fmt.Println()
+	if c.HasDiscrepancies() {
+		printWarning("The notes differ; review the pull requests above")
+		return
+	}
+	printSuccess("The notes list the same pull requests")
+}
+
+func printNotesDiscrepancies(ds []forge.NotesDiscrepancy) {
+	for _, d := range ds {
+		line := fmt.Sprintf("  #%d", d.Number)
+		if d.Title != "" {
+			line += " " + d.Title
+		}
+		fmt.Printf("%s\n    %s\n", line, d.Reason)
+		for _, c := range d.Commits {
+			fmt.Printf("    %s %s\n", domain.CommitSHA(c.SHA).Short(), c.Subject)
+		}
+	}
+}
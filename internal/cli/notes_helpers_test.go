@@ -85,4 +85,60 @@ func TestBuildNotesInputForServices(t *testing.T) {
 		input := buildNotesInputForServices("/test/repo", false) // hasAI = false
 		assert.False(t, input.Options.UseAI)
 	})
+
+	t.Run("with regenerate", func(t *testing.T) {
+		oldNotesRegenerate := notesRegenerate
+		defer func() {
+			notesRegenerate = oldNotesRegenerate
+		}()
+
+		notesRegenerate = true
+
+		input := buildNotesInputForServices("/test/repo", false)
+		assert.True(t, input.Regenerate)
+		// Presets are kept from the existing notes rather than the config
+		assert.Empty(t, input.Options.AudiencePreset)
+		assert.Empty(t, input.Options.TonePreset)
+	})
+
+	t.Run("presets default to config", func(t *testing.T) {
+		oldNotesAudience, oldNotesTone := notesAudience, notesTone
+		defer func() { notesAudience, notesTone = oldNotesAudience, oldNotesTone }()
+		cfg.AI.Audience = "users"
+		cfg.AI.Tone = "friendly"
+
+		notesAudience, notesTone = "", "technical"
+
+		input := buildNotesInputForServices("/test/repo", false)
+		assert.Equal(t, "users", input.Options.AudiencePreset)
+		assert.Equal(t, "technical", input.Options.TonePreset)
+	})
+}
+
+func TestReadNotesFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	assert.NoError(t, os.WriteFile("NOTES.md", []byte("\n## Notes\n\n- Fixed a bug\n\n"), 0o600))
+	assert.NoError(t, os.WriteFile("empty.md", []byte(" \n\t\n"), 0o600))
+
+	t.Run("reads and trims the notes", func(t *testing.T) {
+		text, err := readNotesFile("NOTES.md")
+		assert.NoError(t, err)
+		assert.Equal(t, "## Notes\n\n- Fixed a bug", text)
+	})
+
+	t.Run("rejects empty notes", func(t *testing.T) {
+		_, err := readNotesFile("empty.md")
+		assert.ErrorContains(t, err, "is empty")
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		_, err := readNotesFile("../NOTES.md")
+		assert.ErrorContains(t, err, "path traversal")
+	})
+
+	t.Run("rejects missing files", func(t *testing.T) {
+		_, err := readNotesFile("missing.md")
+		assert.ErrorContains(t, err, "does not exist")
+	})
 }
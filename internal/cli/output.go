@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats selected with --output or output.format.
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+	outputFormatYAML = "yaml"
+)
+
+// outputFormat is the structured output format of the command. It is set by
+// resolveOutputFormat and only meaningful while outputJSON is true.
+var outputFormat = outputFormatJSON
+
+// outputFlag holds the --output flag.
+var outputFlag string
+
+// resolveOutputFormat selects the output format from --output, --json (and
+// --ci, which implies it) and output.format, in that order. Structured
+// formats set outputJSON, which commands use to switch to machine-readable
+// output.
+func resolveOutputFormat() error {
+	format := outputFlag
+	switch {
+	case format != "":
+		if outputJSON && format != outputFormatJSON && !ciMode {
+			return fmt.Errorf("--json cannot be combined with --output %s", format)
+		}
+	case outputJSON:
+		format = outputFormatJSON
+	case cfg != nil && cfg.Output.Format != "":
+		format = cfg.Output.Format
+	default:
+		format = outputFormatText
+	}
+
+	switch format {
+	case outputFormatText:
+		outputJSON = false
+	case outputFormatJSON, outputFormatYAML:
+		outputJSON = true
+		outputFormat = format
+	default:
+		return fmt.Errorf("unsupported output format %q (use text, json or yaml)", format)
+	}
+	return nil
+}
+
+// printStructuredOutput writes v to stdout in the structured output format.
+func printStructuredOutput(v any) error {
+	return writeStructuredOutput(os.Stdout, v, outputFormat)
+}
+
+// writeStructuredOutput writes v to w as indented JSON or as YAML. YAML is
+// derived from the JSON encoding, so both formats carry the same fields,
+// names and order.
+func writeStructuredOutput(w io.Writer, v any, format string) error {
+	if format != outputFormatYAML {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("failed to convert output to YAML: %w", err)
+	}
+	blockStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// blockStyle clears the flow and quoting styles that parsing JSON gives a
+// YAML node, so that it is emitted as block YAML. Strings that would read as
+// another type are still quoted by the encoder; strings that YAML 1.1 reads
+// as booleans are quoted here, for consumers that still use it.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" && yaml11Bools[strings.ToLower(node.Value)] {
+		node.Style = yaml.DoubleQuotedStyle
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// yaml11Bools are the plain scalars YAML 1.1 resolves to booleans.
+var yaml11Bools = map[string]bool{
+	"y": true, "yes": true, "n": true, "no": true, "on": true, "off": true,
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/application/versioning"
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func TestResolveOutputFormat(t *testing.T) {
+	origFlag, origJSON, origCI, origFormat, origCfg := outputFlag, outputJSON, ciMode, outputFormat, cfg
+	t.Cleanup(func() {
+		outputFlag, outputJSON, ciMode, outputFormat, cfg = origFlag, origJSON, origCI, origFormat, origCfg
+	})
+
+	tests := []struct {
+		name       string
+		flag       string
+		json       bool
+		ci         bool
+		cfgFormat  string
+		wantJSON   bool
+		wantFormat string
+		wantErr    bool
+	}{
+		{name: "default text", wantJSON: false},
+		{name: "json flag", json: true, wantJSON: true, wantFormat: outputFormatJSON},
+		{name: "output yaml", flag: "yaml", wantJSON: true, wantFormat: outputFormatYAML},
+		{name: "output json", flag: "json", json: true, wantJSON: true, wantFormat: outputFormatJSON},
+		{name: "output text", flag: "text", wantJSON: false},
+		{name: "config yaml", cfgFormat: "yaml", wantJSON: true, wantFormat: outputFormatYAML},
+		{name: "flag overrides config", flag: "text", cfgFormat: "yaml", wantJSON: false},
+		{name: "json overrides config", json: true, cfgFormat: "yaml", wantJSON: true, wantFormat: outputFormatJSON},
+		{name: "ci with output yaml", flag: "yaml", json: true, ci: true, wantJSON: true, wantFormat: outputFormatYAML},
+		{name: "json conflicts with yaml", flag: "yaml", json: true, wantErr: true},
+		{name: "unsupported format", flag: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFlag, outputJSON, ciMode, outputFormat = tt.flag, tt.json, tt.ci, outputFormatJSON
+			cfg = config.DefaultConfig()
+			cfg.Output.Format = tt.cfgFormat
+
+			err := resolveOutputFormat()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveOutputFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if outputJSON != tt.wantJSON {
+				t.Errorf("outputJSON = %v, want %v", outputJSON, tt.wantJSON)
+			}
+			if tt.wantJSON && outputFormat != tt.wantFormat {
+				t.Errorf("outputFormat = %q, want %q", outputFormat, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestWriteStructuredOutput_YAML(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeStructuredOutput(&buf, map[string]any{
+		"version": "1.10",
+		"answer":  "yes",
+		"notes":   "line one\nline two",
+		"count":   3,
+	}, outputFormatYAML)
+	if err != nil {
+		t.Fatalf("writeStructuredOutput() error = %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{`version: "1.10"`, `answer: "yes"`, "count: 3", "notes: |-"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("YAML output missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "{") {
+		t.Errorf("YAML output uses flow style:\n%s", got)
+	}
+}
+
+// TestStructuredOutput_YAMLMatchesJSON checks that every command with
+// structured output writes the same data as YAML as it does as JSON.
+func TestStructuredOutput_YAMLMatchesJSON(t *testing.T) {
+	origFormat, origCfg := outputFormat, cfg
+	t.Cleanup(func() { outputFormat, cfg = origFormat, origCfg })
+	cfg = config.DefaultConfig()
+
+	run := release.NewReleaseRunForTest("run-yaml", "main", ".")
+	plan := simulateTestOutput()
+	notes := &releaseapp.GenerateNotesOutput{
+		RunID:      "run-yaml",
+		InputsHash: "abc123",
+		Notes: &release.ReleaseNotes{
+			Text:       "## 1.3.0\n\n- add export",
+			Highlights: []string{"add export"},
+			Provider:   "basic",
+		},
+	}
+	evaluation := newEvaluateResult("run-yaml", &governance.EvaluateReleaseOutput{
+		Decision:  cgp.DecisionApproved,
+		RiskScore: 0.2,
+		Severity:  cgp.SeverityLow,
+		Rationale: []string{"low risk"},
+	}, true, false)
+
+	commands := []struct {
+		name   string
+		output func() error
+	}{
+		{"plan", func() error { return outputPlanJSON(plan, "run-yaml", nil, nil, nil) }},
+		{"plan --dry", func() error {
+			return outputPlanDryJSON(plan, "## 1.3.0\n\n- add export", []versioning.VersionMismatch{{File: "VERSION", FileVersion: "1.1.0", TagName: "v1.2.0", TagVersion: "1.2.0"}})
+		}},
+		{"bump", func() error {
+			return outputBumpJSON(version.MustParse("1.2.0"), version.MustParse("1.3.0"), version.BumpMinor, true)
+		}},
+		{"notes", func() error {
+			return outputNotesJSONFromServices(context.Background(), notes, ".", commandTestApp{})
+		}},
+		{"evaluate", func() error { return printStructuredOutput(evaluation) }},
+		{"status", func() error {
+			return outputStatusJSON(&StatusOutput{HasActiveRelease: true, ReleaseID: "run-yaml", State: "planned", NextSteps: []string{"relicta bump"}})
+		}},
+		{"publish", func() error { return outputPublishJSONFromServices(run, nil) }},
+	}
+
+	for _, tt := range commands {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFormat = outputFormatJSON
+			jsonOut := captureOutput(t, func() {
+				if err := tt.output(); err != nil {
+					t.Errorf("JSON output error = %v", err)
+				}
+			})
+			outputFormat = outputFormatYAML
+			yamlOut := captureOutput(t, func() {
+				if err := tt.output(); err != nil {
+					t.Errorf("YAML output error = %v", err)
+				}
+			})
+
+			var fromJSON any
+			if err := json.Unmarshal([]byte(jsonOut), &fromJSON); err != nil {
+				t.Fatalf("invalid JSON output: %v\n%s", err, jsonOut)
+			}
+			var fromYAML any
+			if err := yaml.Unmarshal([]byte(yamlOut), &fromYAML); err != nil {
+				t.Fatalf("invalid YAML output: %v\n%s", err, yamlOut)
+			}
+
+			// Round-trip the YAML data through JSON so that numbers compare
+			// with the same types.
+			data, err := json.Marshal(fromYAML)
+			if err != nil {
+				t.Fatalf("YAML data cannot be encoded as JSON: %v", err)
+			}
+			var normalized any
+			if err := json.Unmarshal(data, &normalized); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(normalized, fromJSON) {
+				t.Errorf("YAML data differs from JSON data\nJSON: %s\nYAML: %s", jsonOut, yamlOut)
+			}
+		})
+	}
+}
@@ -4,17 +4,25 @@ package cli
 import (
 	"bufio"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path"
+	"path/filepath"
+	"slices"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/relicta-tech/relicta/internal/analysis"
 	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/application/versioning"
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/container"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
@@ -34,6 +42,13 @@ var (
 	planReview        bool
 	planMinConfidence float64
 	planDisableAI     bool
+	planExclude       []string
+	planBaseTag       string
+	planSince         string
+	planExport        string
+	planExportFormat  string
+	planNonConv       string
+	planDry           bool
 )
 
 func init() {
@@ -45,6 +60,13 @@ func init() {
 	planCmd.Flags().BoolVarP(&planReview, "review", "r", false, "review and adjust commit classifications before planning")
 	planCmd.Flags().Float64Var(&planMinConfidence, "min-confidence", 0, "minimum confidence to accept classifications")
 	planCmd.Flags().BoolVar(&planDisableAI, "no-ai", false, "disable AI classification")
+	planCmd.Flags().StringArrayVar(&planExclude, "exclude-commit", nil, "exclude a commit from the release by SHA (repeatable)")
+	planCmd.Flags().StringVar(&planBaseTag, "base-tag", "", "plan a patch hotfix of an older release tag (e.g. v1.1.0)")
+	planCmd.Flags().StringVar(&planSince, "since", "", "only include commits newer than a duration (e.g. 72h, 14d, 2w) or date (YYYY-MM-DD), reaching past the latest tag unless --from is set")
+	planCmd.Flags().StringVar(&planExport, "export", "", "write a review document for the planned release to a file")
+	planCmd.Flags().StringVar(&planExportFormat, "format", planExportFormatMarkdown, "review document format for --export (markdown, json)")
+	planCmd.Flags().StringVar(&planNonConv, "nonconventional", "", "handling of non-conventional commits: infer, ignore, patch, error (default from versioning.nonconventional_policy)")
+	planCmd.Flags().BoolVar(&planDry, "dry", false, "preview the next version and changelog without creating a release run")
 }
 
 // runPlan implements the plan command.
@@ -59,6 +81,40 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--review is not supported with --json output")
 	}
 
+	if planBaseTag != "" && planFromRef != "" {
+		return fmt.Errorf("use either --from or --base-tag, not both")
+	}
+
+	if planDry && (planAnalyze || planReview || planExport != "") {
+		return fmt.Errorf("--dry cannot be combined with --analyze, --review, or --export")
+	}
+
+	if planExport != "" {
+		if planAnalyze || planReview {
+			return fmt.Errorf("--export cannot be combined with --analyze or --review")
+		}
+		if dryRun {
+			return fmt.Errorf("--export requires a persisted release run and cannot be combined with --dry-run")
+		}
+		if planExportFormat != planExportFormatMarkdown && planExportFormat != planExportFormatJSON {
+			return fmt.Errorf("unsupported export format %q (use markdown or json)", planExportFormat)
+		}
+	}
+
+	since, err := servicerelease.ParseSince(planSince, time.Now())
+	if err != nil {
+		return err
+	}
+
+	nonConvPolicy := cfg.Versioning.NonConventionalPolicy
+	if planNonConv != "" {
+		nonConvPolicy = planNonConv
+	}
+	policy, err := servicerelease.ParseNonConventionalPolicy(nonConvPolicy)
+	if err != nil {
+		return err
+	}
+
 	printTitle("Release Plan")
 	fmt.Println()
 
@@ -73,14 +129,22 @@ func runPlan(cmd *cobra.Command, args []string) error {
 	}
 	defer closeApp(app)
 
-	// Check for tag-push mode (HEAD is already tagged)
-	mode, existingVersion, err := detectReleaseMode(ctx, app, cfg.Versioning.TagPrefix)
-	if err != nil {
-		return fmt.Errorf("failed to detect release mode: %w", err)
-	}
+	// A dry plan only previews the next release, so it neither honors the
+	// freeze nor adopts an existing tag as a release run.
+	if !planDry {
+		if err := enforceReleaseFreeze(ctx, app, "plan"); err != nil {
+			return err
+		}
 
-	if mode == releaseModeTagPush && existingVersion != nil {
-		return runPlanTagPush(ctx, app, *existingVersion)
+		// Check for tag-push mode (HEAD is already tagged)
+		mode, existingVersion, err := detectReleaseMode(ctx, app, cfg.Versioning.TagPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to detect release mode: %w", err)
+		}
+
+		if mode == releaseModeTagPush && existingVersion != nil {
+			return runPlanTagPush(ctx, app, *existingVersion)
+		}
 	}
 
 	// Get repository info for the path
@@ -89,6 +153,10 @@ func runPlan(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get repository info: %w", err)
 	}
+	currentVersion, err := fileCurrentVersion(repoInfo.Path)
+	if err != nil {
+		return err
+	}
 
 	// Prepare input
 	input := servicerelease.AnalyzeInput{
@@ -97,6 +165,14 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		FromRef:        planFromRef,
 		ToRef:          planToRef,
 		TagPrefix:      cfg.Versioning.TagPrefix,
+		ExcludeCommits: planExclude,
+		BaseTag:        planBaseTag,
+		CurrentVersion: currentVersion,
+		Since:          since,
+
+		ContinuePrerelease:    version.Prerelease(cfg.Versioning.PrereleaseSuffix),
+		NonConventionalPolicy: policy,
+		CommitParser:          configCommitParser(),
 	}
 
 	minConfidenceSet := cmd.Flags().Changed("min-confidence")
@@ -130,13 +206,31 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to plan release: %w", err)
 	}
 
-	// Persist release run for subsequent commands (bump, notes, approve, publish)
-	var releaseID string
-	if !dryRun {
-		releaseID, err = persistReleaseRun(ctx, app, output, repoInfo)
+	mismatches, err := checkVersionFiles(ctx, gitAdapter, repoInfo.Path)
+	if err != nil {
+		return err
+	}
+	recordPlanWarnings(output, mismatches)
+
+	if planDry {
+		changelog, err := planChangelogPreview(ctx, output)
 		if err != nil {
-			printWarning(fmt.Sprintf("release run persistence failed: %v", err))
+			return err
 		}
+		if outputJSON {
+			return outputPlanDryJSON(output, changelog, mismatches)
+		}
+		return outputPlanDryText(output, changelog, mismatches)
+	}
+
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
+	// Persist release run for subsequent commands (bump, notes, approve, publish)
+	releaseID, pkgPlan, err := persistPlannedRelease(ctx, app, output, repoInfo)
+	if err != nil {
+		return err
 	}
 
 	// Get governance risk preview if enabled
@@ -145,12 +239,27 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		riskPreview = getGovernanceRiskPreview(ctx, app, output, repoInfo.RemoteURL)
 	}
 
+	if planExport != "" {
+		if releaseID == "" {
+			return fmt.Errorf("cannot export plan: release run was not persisted")
+		}
+		if err := exportPlanReview(ctx, app, repoInfo.Path, output, riskPreview, pkgPlan); err != nil {
+			return err
+		}
+	}
+
 	// Output results
 	if outputJSON {
-		return outputPlanJSON(output, releaseID, riskPreview)
+		return outputPlanJSON(output, releaseID, riskPreview, pkgPlan, mismatches)
 	}
 
-	return outputPlanText(output, releaseID, planShowAll, planMinimal, riskPreview)
+	if err := outputPlanText(output, releaseID, planShowAll, planMinimal, riskPreview, pkgPlan, mismatches); err != nil {
+		return err
+	}
+	if planExport != "" {
+		printSuccess(fmt.Sprintf("Plan review document written to %s", planExport))
+	}
+	return nil
 }
 
 func buildPlanAnalysisConfig(minConfidenceSet bool) (analysis.AnalyzerConfig, bool) {
@@ -191,10 +300,15 @@ func runPlanTagPush(ctx context.Context, app cliApp, ver version.SemanticVersion
 		return fmt.Errorf("failed to get tags: %w", err)
 	}
 
+	// A stable release covers its whole prerelease series, so prerelease
+	// tags are only considered as the previous tag of another prerelease.
 	var prevTagName string
 	var prevVersion *version.SemanticVersion
 	for _, t := range tags.FilterByPrefix(cfg.Versioning.TagPrefix).VersionTags() {
 		tagVer := t.Version()
+		if tagVer != nil && tagVer.IsPrerelease() && !ver.IsPrerelease() {
+			continue
+		}
 		if tagVer != nil && tagVer.LessThan(ver) {
 			if prevVersion == nil || tagVer.GreaterThan(*prevVersion) {
 				prevTagName = t.Name()
@@ -210,6 +324,7 @@ func runPlanTagPush(ctx context.Context, app cliApp, ver version.SemanticVersion
 		FromRef:        prevTagName,
 		ToRef:          tagName,
 		TagPrefix:      cfg.Versioning.TagPrefix,
+		CommitParser:   configCommitParser(),
 	}
 
 	// Execute with spinner (unless JSON output)
@@ -241,7 +356,7 @@ func runPlanTagPush(ctx context.Context, app cliApp, ver version.SemanticVersion
 			TagName:     tagName,
 		})
 		if err != nil {
-			printWarning(fmt.Sprintf("release run persistence failed: %v", err))
+			return fmt.Errorf("failed to save release run: %w", err)
 		}
 	}
 
@@ -259,7 +374,7 @@ func runPlanTagPush(ctx context.Context, app cliApp, ver version.SemanticVersion
 	return outputPlanTagPushText(output, releaseID, riskPreview)
 }
 
-// outputPlanTagPushJSON outputs the tag-push plan as JSON.
+// outputPlanTagPushJSON outputs the tag-push plan as JSON or YAML.
 func outputPlanTagPushJSON(output *servicerelease.AnalyzeOutput, releaseID string, riskPreview *governanceRiskPreview) error {
 	cats := output.ChangeSet.Categories()
 	result := map[string]any{
@@ -288,9 +403,7 @@ func outputPlanTagPushJSON(output *servicerelease.AnalyzeOutput, releaseID strin
 		}
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(result)
+	return printStructuredOutput(result)
 }
 
 // outputPlanTagPushText outputs the tag-push plan as text.
@@ -303,6 +416,9 @@ func outputPlanTagPushText(output *servicerelease.AnalyzeOutput, releaseID strin
 	fmt.Fprintf(w, "  Previous version:\t%s\n", output.CurrentVersion.String())
 	fmt.Fprintf(w, "  Current version:\t%s\n", output.NextVersion.String())
 	fmt.Fprintf(w, "  Total commits:\t%d\n", output.ChangeSet.CommitCount())
+	if len(output.ExcludedCommits) > 0 {
+		fmt.Fprintf(w, "  Excluded commits:\t%s\n", formatExcludedCommits(output.ExcludedCommits))
+	}
 	fmt.Fprintf(w, "  Repository:\t%s\n", output.RepositoryName)
 	fmt.Fprintf(w, "  Branch:\t%s\n", output.Branch)
 	_ = w.Flush()
@@ -395,13 +511,25 @@ func runPlanReview(ctx context.Context, app cliApp, input servicerelease.Analyze
 		return fmt.Errorf("failed to plan release: %w", err)
 	}
 
+	mismatches, err := checkVersionFiles(ctx, app.GitAdapter(), input.RepositoryPath)
+	if err != nil {
+		return err
+	}
+	recordPlanWarnings(output, mismatches)
+
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+
 	// Persist release run for subsequent commands
-	var releaseID string
-	if !dryRun {
-		releaseID, err = persistReleaseRunFromApp(ctx, app, output)
-		if err != nil {
-			printWarning(fmt.Sprintf("release run persistence failed: %v", err))
-		}
+	releaseID, pkgPlan, err := persistPlannedRelease(ctx, app, output, repoInfo)
+	if err != nil {
+		return err
 	}
 
 	var riskPreview *governanceRiskPreview
@@ -410,10 +538,10 @@ func runPlanReview(ctx context.Context, app cliApp, input servicerelease.Analyze
 	}
 
 	if outputJSON {
-		return outputPlanJSON(output, releaseID, riskPreview)
+		return outputPlanJSON(output, releaseID, riskPreview, pkgPlan, mismatches)
 	}
 
-	return outputPlanText(output, releaseID, planShowAll, planMinimal, riskPreview)
+	return outputPlanText(output, releaseID, planShowAll, planMinimal, riskPreview, pkgPlan, mismatches)
 }
 
 func outputAnalysisJSON(result *analysis.AnalysisResult, commitInfos []analysis.CommitInfo) error {
@@ -444,9 +572,7 @@ func outputAnalysisJSON(result *analysis.AnalysisResult, commitInfos []analysis.
 		"total_commits": result.Stats.TotalCommits,
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(payload)
+	return printStructuredOutput(payload)
 }
 
 func outputAnalysisText(result *analysis.AnalysisResult, commitInfos []analysis.CommitInfo) error {
@@ -636,13 +762,456 @@ type governanceRiskPreview struct {
 	RiskFactors    []string
 }
 
-// outputPlanJSON outputs the plan as JSON.
-func outputPlanJSON(output *servicerelease.AnalyzeOutput, releaseID string, riskPreview *governanceRiskPreview) error {
+// monorepoPackagePlan describes which monorepo packages a plan affects.
+type monorepoPackagePlan struct {
+	Strategy string
+	Packages []string
+	Affected []monorepo.AffectedPackage
+	Release  []string
+	Groups   []monorepo.GroupPlan
+	// VersionFiles lists the detected version files of released packages.
+	VersionFiles []monorepo.PackageVersionFile
+	// Attribution maps the plan's commits to the packages they affect.
+	Attribution *monorepo.Attribution
+	// Cascade lists the dependents released because of breaking changes;
+	// nil unless monorepo.cascade_breaking is enabled.
+	Cascade *monorepo.Cascade
+}
+
+// buildMonorepoPackagePlan maps the changeset's changed files to monorepo packages.
+// Packages in a release group are versioned by the group's strategy; all other
+// packages follow the monorepo strategy.
+func buildMonorepoPackagePlan(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, commits []*changes.ConventionalCommit) (*monorepoPackagePlan, error) {
+	affectedCfg := monorepoAffectedConfig()
+
+	packages, err := monorepo.DiscoverPackages(ctx, repoPath, affectedCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Diff each commit once; every package analysis below shares the result
+	attribution, err := monorepo.AnalyzeCommits(ctx, provider, commits, packages, affectedCfg, monorepo.AnalysisOptions{
+		Concurrency: cfg.Monorepo.AnalysisConcurrency,
+		CacheDir:    filepath.Join(repoPath, monorepo.DefaultAnalysisCacheDir),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := string(cfg.Monorepo.Strategy)
+	affected, err := monorepo.DetectAffectedPackages(ctx, attribution.ChangedFiles(), packages, affectedCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var graph monorepo.DependencyGraph
+	if cfg.Monorepo.CascadeBreaking || cfg.Monorepo.DependencyCoordination {
+		graph, err = monorepo.BuildDependencyGraph(repoPath, packages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Release every dependent of a package with a breaking change
+	var cascade *monorepo.Cascade
+	if cfg.Monorepo.CascadeBreaking {
+		breaking := monorepo.BreakingPackages(attribution.CommitsByPackage(commits))
+		cascade = monorepo.PlanCascade(graph, breaking, monorepo.CascadeConfig{
+			Bump:     changes.ReleaseType(cfg.Monorepo.CascadeBump),
+			MaxDepth: cfg.Monorepo.MaxCascadeDepth,
+		})
+	}
+
+	groups, err := planReleaseGroups(ctx, provider, attribution, packages, commits, affectedCfg, cascade)
+	if err != nil {
+		return nil, err
+	}
+
+	toRelease := monorepo.PackagesToRelease(strategy, packages, affected)
+	if len(groups) > 0 {
+		toRelease = releaseWithGroups(strategy, packages, affected, groups)
+	}
+	toRelease = cascade.Include(toRelease)
+
+	// Release internal dependencies before the packages that use them
+	if cfg.Monorepo.DependencyCoordination && len(toRelease) > 1 {
+		toRelease, err = graph.ReleaseOrder(toRelease)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &monorepoPackagePlan{
+		Strategy:     strategy,
+		Packages:     packages,
+		Affected:     affected,
+		Release:      toRelease,
+		Groups:       groups,
+		VersionFiles: detectPackageVersionFiles(repoPath, toRelease),
+		Attribution:  attribution,
+		Cascade:      cascade,
+	}, nil
+}
+
+// monorepoAffectedConfig returns the affected package detection settings
+// of the monorepo configuration.
+func monorepoAffectedConfig() monorepo.AffectedConfig {
+	return monorepo.AffectedConfig{
+		PackagePaths: cfg.Monorepo.PackagePaths,
+		ExcludePaths: cfg.Monorepo.ExcludePaths,
+		SharedDirs:   cfg.BlastRadius.SharedDirs,
+		RootPackage:  cfg.Monorepo.RootPackage,
+	}
+}
+
+// packageVersions maps each package released by a monorepo release to its
+// next version. Members of a lockstep group get the group's version. With
+// the lockstep monorepo strategy, and for the root package, the other
+// packages get the release version; otherwise each package's version is
+// bumped from its own latest tag by its own commits and cascade bump.
+func packageVersions(ctx context.Context, repo sourcecontrol.GitRepository, pkgPlan *monorepoPackagePlan, commits []*changes.ConventionalCommit, releaseVersion version.SemanticVersion) (map[string]string, error) {
+	versions := make(map[string]string, len(pkgPlan.Release))
+	for _, g := range pkgPlan.Groups {
+		if g.IsLockstep() && g.NextVersion != "" {
+			for _, pkg := range g.Release {
+				versions[pkg] = g.NextVersion
+			}
+		}
+	}
+
+	bumps := monorepo.PackageBumps(pkgPlan.Release, pkgPlan.Attribution.CommitsByPackage(commits), pkgPlan.Cascade)
+	for _, pkg := range pkgPlan.Release {
+		if _, ok := versions[pkg]; ok {
+			continue
+		}
+		if pkgPlan.Strategy == string(config.MonorepoStrategyLockstep) || pkg == monorepo.RootPackagePath {
+			versions[pkg] = releaseVersion.String()
+			continue
+		}
+		current, err := latestPrefixedVersion(ctx, repo, packageTagPrefix(pkg))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve version of package %s: %w", pkg, err)
+		}
+		versions[pkg] = version.NewVersionBump(bumps[pkg].ToBumpType()).Apply(current).String()
+	}
+	return versions, nil
+}
+
+// latestPrefixedVersion returns the version of the latest tag with the
+// given prefix, or the initial version if there is none.
+func latestPrefixedVersion(ctx context.Context, repo sourcecontrol.GitRepository, prefix string) (version.SemanticVersion, error) {
+	tag, err := repo.GetLatestVersionTag(ctx, prefix)
+	if errors.Is(err, sourcecontrol.ErrNoTags) || (err == nil && tag == nil) {
+		return version.Initial, nil
+	}
+	if err != nil {
+		return version.Zero, err
+	}
+	return version.Parse(tag.WithoutPrefix(prefix))
+}
+
+// packageTagPrefix returns the tag prefix of a package released on its own:
+// the package override's prefix, or the package name followed by the
+// versioning tag prefix.
+func packageTagPrefix(pkg string) string {
+	if prefix := cfg.Monorepo.PackageOverrides[pkg].TagPrefix; prefix != "" {
+		return prefix
+	}
+	if pkg == monorepo.RootPackagePath {
+		return cfg.Versioning.TagPrefix
+	}
+	return path.Base(pkg) + "-" + cfg.Versioning.TagPrefix
+}
+
+// detectPackageVersionFiles detects the version file of each package from
+// the manifests it contains, using the built-in version file types merged
+// with monorepo.version_files. Package overrides take precedence.
+func detectPackageVersionFiles(repoPath string, packages []string) []monorepo.PackageVersionFile {
+	overrides := make(map[string]monorepo.PackageOverride, len(cfg.Monorepo.PackageOverrides))
+	for pkg, o := range cfg.Monorepo.PackageOverrides {
+		overrides[pkg] = monorepo.PackageOverride{
+			VersionFile:    o.VersionFile,
+			VersionField:   o.VersionField,
+			SkipVersioning: o.SkipVersioning,
+		}
+	}
+
+	return monorepo.DetectVersionFiles(repoPath, packages, versionFileTypes(), overrides)
+}
+
+// versionFileTypes returns the built-in version file types merged with
+// monorepo.version_files.
+func versionFileTypes() map[string]monorepo.VersionFileConfig {
+	types := make(map[string]monorepo.VersionFileConfig)
+	for _, files := range []map[string]config.VersionFileConfig{config.DefaultConfig().Monorepo.VersionFiles, cfg.Monorepo.VersionFiles} {
+		for name, vf := range files {
+			types[name] = monorepo.VersionFileConfig{
+				File:         vf.File,
+				Files:        vf.Files,
+				Field:        vf.Field,
+				Pattern:      vf.Pattern,
+				Update:       vf.Update,
+				UpdateFormat: vf.UpdateFormat,
+			}
+		}
+	}
+	return types
+}
+
+// printPackageVersionFiles prints the detected version file of each package.
+func printPackageVersionFiles(files []monorepo.PackageVersionFile) {
+	if len(files) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println("  Version files:")
+	for _, f := range files {
+		switch {
+		case f.IsAmbiguous():
+			printWarning(fmt.Sprintf("  %s has manifests of several types (%s); set monorepo.package_overrides.%s.version_file",
+				f.Package, strings.Join(f.Candidates, ", "), f.Package))
+		case !f.Update:
+			fmt.Printf("    %s: %s (%s, not updated)\n", f.Package, f.File, f.Type)
+		case f.Overridden:
+			fmt.Printf("    %s: %s (override)\n", f.Package, f.File)
+		default:
+			fmt.Printf("    %s: %s (%s)\n", f.Package, f.File, f.Type)
+		}
+	}
+}
+
+// printCascade prints the dependents released because of breaking changes.
+func printCascade(cascade *monorepo.Cascade) {
+	if cascade == nil || (len(cascade.Packages) == 0 && len(cascade.Truncated) == 0) {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("  Breaking cascade from %s:\n", strings.Join(cascade.Breaking, ", "))
+	for _, p := range cascade.Packages {
+		fmt.Printf("    %s (%s, depth %d via %s)\n", p.Path, p.Bump, p.Depth, p.Via)
+	}
+	if len(cascade.Truncated) > 0 {
+		printWarning(fmt.Sprintf("  Not cascaded beyond max_cascade_depth: %s", strings.Join(cascade.Truncated, ", ")))
+	}
+}
+
+// writePackageVersionFiles updates the version files of the packages
+// released by a monorepo release and returns the updated files, using the
+// versions of packageVersions. Cascaded dependents also get their internal
+// dependency constraints updated.
+func writePackageVersionFiles(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, rel *release.ReleaseRun) ([]string, error) {
+	if !cfg.Monorepo.Enabled || !rel.HasChangeSet() {
+		return nil, nil
+	}
+
+	commits := rel.ChangeSet().Commits()
+	pkgPlan, err := buildMonorepoPackagePlan(ctx, provider, repoPath, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := packageVersions(ctx, provider, pkgPlan, commits, rel.VersionNext())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := monorepo.CheckVersionFiles(pkgPlan.VersionFiles); err != nil {
+		return nil, err
+	}
+
+	var updated []string
+	for _, f := range pkgPlan.VersionFiles {
+		if !f.Update {
+			continue
+		}
+		ver, err := version.Parse(versions[f.Package])
+		if err != nil {
+			return updated, fmt.Errorf("invalid version %s for package %s: %w", versions[f.Package], f.Package, err)
+		}
+		if err := monorepo.WritePackageVersion(repoPath, f, ver); err != nil {
+			return updated, fmt.Errorf("failed to update version of package %s: %w", f.Package, err)
+		}
+		updated = append(updated, f.File)
+	}
+
+	// Cascaded dependents require the new versions of the packages they use
+	for _, pkg := range pkgPlan.Cascade.Paths() {
+		files, err := monorepo.UpdateDependencyConstraints(repoPath, pkg, versions)
+		if err != nil {
+			return updated, fmt.Errorf("failed to update dependency constraints of package %s: %w", pkg, err)
+		}
+		for _, file := range files {
+			if !slices.Contains(updated, file) {
+				updated = append(updated, file)
+			}
+		}
+	}
+	return updated, nil
+}
+
+// planReleaseGroups plans the configured release groups and resolves the
+// shared version of each lockstep group from its tags. Commit changes are
+// read from diffs; cascaded members are released with at least their
+// cascade bump.
+func planReleaseGroups(ctx context.Context, repo sourcecontrol.GitRepository, diffs monorepo.DiffStatsProvider, packages []string, commits []*changes.ConventionalCommit, affectedCfg monorepo.AffectedConfig, cascade *monorepo.Cascade) ([]monorepo.GroupPlan, error) {
+	groupCfgs := make([]monorepo.GroupConfig, 0, len(cfg.Monorepo.ReleaseGroups))
+	for _, g := range cfg.Monorepo.ReleaseGroups {
+		prefix := g.TagPrefix
+		if prefix == "" {
+			prefix = g.Name + "-" + cfg.Versioning.TagPrefix
+		}
+		groupCfgs = append(groupCfgs, monorepo.GroupConfig{
+			Name:            g.Name,
+			Packages:        g.Packages,
+			Strategy:        string(g.Strategy),
+			TagPrefix:       prefix,
+			SharedChangelog: g.SharedChangelog,
+		})
+	}
+
+	groups, err := monorepo.PlanGroups(ctx, diffs, groupCfgs, packages, commits, affectedCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		groups[i].ApplyCascade(cascade)
+		if !groups[i].IsLockstep() || len(groups[i].Release) == 0 {
+			continue
+		}
+		current, err := latestPrefixedVersion(ctx, repo, groups[i].TagPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve version of release group %s: %w", groups[i].Name, err)
+		}
+		groups[i].SetVersion(current)
+	}
+	return groups, nil
+}
+
+// releaseWithGroups returns the packages to release when release groups are
+// configured: grouped packages follow their group, the rest follow strategy.
+func releaseWithGroups(strategy string, packages []string, affected []monorepo.AffectedPackage, groups []monorepo.GroupPlan) []string {
+	grouped := make(map[string]bool)
+	var release []string
+	for _, g := range groups {
+		for _, pkg := range g.Packages {
+			grouped[pkg] = true
+		}
+		release = append(release, g.Release...)
+	}
+
+	var ungrouped []string
+	for _, pkg := range packages {
+		if !grouped[pkg] {
+			ungrouped = append(ungrouped, pkg)
+		}
+	}
+	var ungroupedAffected []monorepo.AffectedPackage
+	for _, a := range affected {
+		if !grouped[a.Path] {
+			ungroupedAffected = append(ungroupedAffected, a)
+		}
+	}
+
+	release = append(release, monorepo.PackagesToRelease(strategy, ungrouped, ungroupedAffected)...)
+	slices.Sort(release)
+	return release
+}
+
+// monorepoPackageTags returns the per-package tags for a coordinated monorepo
+// release in dependency order. It returns nil when monorepo mode is disabled
+// or neither dependency coordination nor release groups are configured.
+func monorepoPackageTags(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, rel *release.ReleaseRun) ([]release.PackageTag, error) {
+	coordinated := cfg.Monorepo.DependencyCoordination || len(cfg.Monorepo.ReleaseGroups) > 0
+	if !cfg.Monorepo.Enabled || !coordinated || !rel.HasChangeSet() {
+		return nil, nil
+	}
+
+	commits := rel.ChangeSet().Commits()
+	pkgPlan, err := buildMonorepoPackagePlan(ctx, provider, repoPath, commits)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := packageVersions(ctx, provider, pkgPlan, commits, rel.VersionNext())
+	if err != nil {
+		return nil, err
+	}
+
+	// Members of a lockstep group share a single group tag
+	groupOf := make(map[string]*monorepo.GroupPlan)
+	for i := range pkgPlan.Groups {
+		if g := &pkgPlan.Groups[i]; g.IsLockstep() && g.NextVersion != "" {
+			for _, pkg := range g.Release {
+				groupOf[pkg] = g
+			}
+		}
+	}
+
+	tags := make([]release.PackageTag, 0, len(pkgPlan.Release))
+	groupTagged := make(map[string]bool)
+	for _, pkg := range pkgPlan.Release {
+		if g, ok := groupOf[pkg]; ok {
+			if !groupTagged[g.Name] {
+				groupTagged[g.Name] = true
+				tags = append(tags, release.PackageTag{Package: g.Name, TagName: g.TagPrefix + g.NextVersion})
+			}
+			continue
+		}
+		tags = append(tags, release.PackageTag{Package: pkg, TagName: packageTagPrefix(pkg) + versions[pkg]})
+	}
+	return tags, nil
+}
+
+// checkVersionFiles compares the configured version files against the latest
+// version tag. Mismatches are returned for display, or as an error when
+// versioning.strict_version_check is enabled.
+func checkVersionFiles(ctx context.Context, gitRepo sourcecontrol.GitRepository, repoPath string) ([]versioning.VersionMismatch, error) {
+	vc := cfg.Versioning
+	if vc.VersionFile == "" && vc.BumpFrom != versioning.BumpFromPackageJSON {
+		return nil, nil
+	}
+
+	input := versioning.ReconcileInput{
+		RepositoryPath: repoPath,
+		BumpFrom:       vc.BumpFrom,
+		VersionFile:    vc.VersionFile,
+
+		VersionFileTypes: versionFileTypes(),
+	}
+	tag, err := gitRepo.GetLatestVersionTag(ctx, vc.TagPrefix)
+	if err != nil && !errors.Is(err, sourcecontrol.ErrNoTags) {
+		printWarning(fmt.Sprintf("version file check skipped: %v", err))
+		return nil, nil
+	}
+	if tag != nil {
+		ver, err := version.Parse(strings.TrimPrefix(tag.Name(), vc.TagPrefix))
+		if err != nil {
+			return nil, nil
+		}
+		input.TagName = tag.Name()
+		input.TagVersion = ver
+	}
+
+	mismatches, err := versioning.ReconcileVersionFiles(ctx, input)
+	if err != nil {
+		printWarning(fmt.Sprintf("version file check skipped: %v", err))
+		return nil, nil
+	}
+	if len(mismatches) > 0 && vc.StrictVersionCheck {
+		m := mismatches[0]
+		return nil, fmt.Errorf("version mismatch: %s (%s)", m.String(), m.Guidance)
+	}
+	return mismatches, nil
+}
+
+// outputPlanJSON outputs the plan as JSON or YAML.
+func outputPlanJSON(output *servicerelease.AnalyzeOutput, releaseID string, riskPreview *governanceRiskPreview, pkgPlan *monorepoPackagePlan, mismatches []versioning.VersionMismatch) error {
 	cats := output.ChangeSet.Categories()
 	result := map[string]any{
 		"release_id":      releaseID,
 		"current_version": output.CurrentVersion.String(),
 		"next_version":    output.NextVersion.String(),
+		"prerelease":      output.NextVersion.IsPrerelease(),
 		"release_type":    output.ReleaseType.String(),
 		"repository_name": output.RepositoryName,
 		"branch":          output.Branch,
@@ -666,28 +1235,238 @@ func outputPlanJSON(output *servicerelease.AnalyzeOutput, releaseID string, risk
 		}
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(result)
+	if len(output.ExcludedCommits) > 0 {
+		result["excluded_commits"] = output.ExcludedCommits
+	}
+
+	if output.BaseTag != "" {
+		result["hotfix_base_tag"] = output.BaseTag
+	}
+
+	result["commit_window"] = output.Window.Fields()
+	result["non_conventional"] = map[string]any{
+		"count":   len(output.NonConventional.Commits),
+		"policy":  output.NonConventional.Policy,
+		"commits": output.NonConventional.Commits,
+	}
+
+	if len(mismatches) > 0 {
+		result["version_mismatches"] = mismatches
+	}
+
+	if pkgPlan != nil {
+		result["packages"] = map[string]any{
+			"strategy": pkgPlan.Strategy,
+			"total":    len(pkgPlan.Packages),
+			"affected": pkgPlan.Affected,
+			"release":  pkgPlan.Release,
+		}
+		if len(pkgPlan.VersionFiles) > 0 {
+			result["version_files"] = pkgPlan.VersionFiles
+		}
+		if len(pkgPlan.Groups) > 0 {
+			result["release_groups"] = pkgPlan.Groups
+		}
+		if pkgPlan.Cascade != nil {
+			result["cascade"] = pkgPlan.Cascade
+		}
+	}
+
+	return printStructuredOutput(result)
+}
+
+// planChangelogPreview renders the notes a release of the analyzed changes
+// would publish, using the configured changelog template without AI.
+func planChangelogPreview(ctx context.Context, output *servicerelease.AnalyzeOutput) (string, error) {
+	return output.ChangelogPreview(ctx, container.NewNotesGeneratorAdapter(nil, nil), changelogPreviewOptions())
+}
+
+// changelogPreviewOptions returns the notes options for a changelog preview
+// from the changelog configuration.
+func changelogPreviewOptions() ports.NotesOptions {
+	return ports.NotesOptions{
+		RepositoryURL:   cfg.Changelog.RepositoryURL,
+		IssueURL:        cfg.Changelog.IssueURL,
+		Template:        cfg.Changelog.Template,
+		TagPrefix:       cfg.Versioning.TagPrefix,
+		HighlightsCount: cfg.Changelog.HighlightsCount,
+		ExcludeScopes:   cfg.Changelog.ExcludeScopes,
+	}
+}
+
+// outputPlanDryJSON outputs a dry plan preview as JSON or YAML.
+func outputPlanDryJSON(output *servicerelease.AnalyzeOutput, changelog string, mismatches []versioning.VersionMismatch) error {
+	summary := output.ChangeSet.Summary()
+	result := map[string]any{
+		"dry":             true,
+		"current_version": output.CurrentVersion.String(),
+		"next_version":    output.NextVersion.String(),
+		"prerelease":      output.NextVersion.IsPrerelease(),
+		"release_type":    output.ReleaseType.String(),
+		"bump_kind":       string(convertReleaseTypeToBumpKind(output.ReleaseType)),
+		"repository_name": output.RepositoryName,
+		"branch":          output.Branch,
+		"summary": map[string]int{
+			"total":            summary.TotalCommits,
+			"features":         summary.Features,
+			"fixes":            summary.Fixes,
+			"breaking_changes": summary.Breaking,
+			"performance":      summary.Performance,
+			"documentation":    summary.Documentation,
+			"refactoring":      summary.Refactoring,
+			"tests":            summary.Tests,
+			"other":            summary.Other,
+		},
+		"changelog": changelog,
+	}
+
+	if len(output.ExcludedCommits) > 0 {
+		result["excluded_commits"] = output.ExcludedCommits
+	}
+	if output.BaseTag != "" {
+		result["hotfix_base_tag"] = output.BaseTag
+	}
+	if len(mismatches) > 0 {
+		result["version_mismatches"] = mismatches
+	}
+
+	return printStructuredOutput(result)
+}
+
+// outputPlanDryText outputs a dry plan preview as text.
+func outputPlanDryText(output *servicerelease.AnalyzeOutput, changelog string, mismatches []versioning.VersionMismatch) error {
+	summary := output.ChangeSet.Summary()
+
+	printTitle("Preview")
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "  Current version:\t%s\n", output.CurrentVersion.String())
+	fmt.Fprintf(w, "  Next version:\t%s\n", output.NextVersion.String())
+	fmt.Fprintf(w, "  Release type:\t%s\n", releaseTypeDisplay(output.ReleaseType))
+	fmt.Fprintf(w, "  Total commits:\t%d\n", summary.TotalCommits)
+	for _, row := range []struct {
+		label string
+		count int
+	}{
+		{"Breaking changes", summary.Breaking},
+		{"Features", summary.Features},
+		{"Bug fixes", summary.Fixes},
+		{"Performance", summary.Performance},
+		{"Documentation", summary.Documentation},
+		{"Refactoring", summary.Refactoring},
+		{"Tests", summary.Tests},
+		{"Other", summary.Other},
+	} {
+		if row.count > 0 {
+			fmt.Fprintf(w, "    %s:\t%d\n", row.label, row.count)
+		}
+	}
+	_ = w.Flush()
+
+	for _, m := range mismatches {
+		printWarning(m.String())
+	}
+
+	if changelog != "" {
+		fmt.Println()
+		printTitle("Changelog Preview")
+		fmt.Println()
+		fmt.Println(changelog)
+	}
+
+	fmt.Println()
+	printInfo("Dry plan: no release run was created. Run 'relicta plan' to start the release.")
+	return nil
+}
+
+// formatCommitWindow renders a commit window for text output.
+func formatCommitWindow(w servicerelease.CommitWindow) string {
+	const layout = "2006-01-02 15:04"
+	window := fmt.Sprintf("%s → %s", w.Oldest.Format(layout), w.Newest.Format(layout))
+	if !w.Since.IsZero() {
+		window += fmt.Sprintf(" (since %s)", w.Since.Format(layout))
+	}
+	return window
+}
+
+// recordPlanWarnings records the plan's warning-level findings, which the
+// output shows as data, so that --strict fails on them in any output mode.
+func recordPlanWarnings(output *servicerelease.AnalyzeOutput, mismatches []versioning.VersionMismatch) {
+	for _, m := range mismatches {
+		recordWarning(m.String())
+	}
+	nc := output.NonConventional
+	if n := len(nc.Commits); n > 0 && nc.Policy == servicerelease.NonConventionalInfer {
+		recordWarning(fmt.Sprintf("%d non-conventional commit(s) classified by commit analysis; set versioning.nonconventional_policy to handle them explicitly", n))
+	}
+}
+
+// configCommitParser returns the parser for versioning.commit_convention.
+// Invalid conventions are rejected when the config is validated, so an error
+// here falls back to Conventional Commits.
+func configCommitParser() changes.CommitParser {
+	if cfg == nil {
+		return nil
+	}
+	parser, err := changes.NewCommitParser(changes.CommitConvention(cfg.Versioning.CommitConvention), cfg.Versioning.TypePatterns)
+	if err != nil {
+		return nil
+	}
+	return parser
+}
+
+// nonConventionalDisplay describes how non-conventional commits were handled.
+func nonConventionalDisplay(policy servicerelease.NonConventionalPolicy) string {
+	switch policy {
+	case servicerelease.NonConventionalIgnore:
+		return "ignored for versioning"
+	case servicerelease.NonConventionalPatch:
+		return "treated as patch changes"
+	default:
+		return "classified by commit analysis"
+	}
 }
 
 // outputPlanText outputs the plan as text.
-func outputPlanText(output *servicerelease.AnalyzeOutput, releaseID string, showAll, minimal bool, riskPreview *governanceRiskPreview) error {
+func outputPlanText(output *servicerelease.AnalyzeOutput, releaseID string, showAll, minimal bool, riskPreview *governanceRiskPreview, pkgPlan *monorepoPackagePlan, mismatches []versioning.VersionMismatch) error {
 	// Summary
 	printTitle("Summary")
 	fmt.Println()
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintf(w, "  Current version:\t%s\n", output.CurrentVersion.String())
-	fmt.Fprintf(w, "  Next version:\t%s\n", output.NextVersion.String())
+	if output.NextVersion.IsPrerelease() {
+		fmt.Fprintf(w, "  Next version:\t%s (prerelease)\n", output.NextVersion.String())
+	} else {
+		fmt.Fprintf(w, "  Next version:\t%s\n", output.NextVersion.String())
+	}
 	fmt.Fprintf(w, "  Release type:\t%s\n", releaseTypeDisplay(output.ReleaseType))
 	fmt.Fprintf(w, "  Total commits:\t%d\n", output.ChangeSet.CommitCount())
 	fmt.Fprintf(w, "  Repository:\t%s\n", output.RepositoryName)
 	fmt.Fprintf(w, "  Branch:\t%s\n", output.Branch)
+	if output.BaseTag != "" {
+		fmt.Fprintf(w, "  Hotfix of:\t%s\n", output.BaseTag)
+	}
+	fmt.Fprintf(w, "  Commit window:\t%s\n", formatCommitWindow(output.Window))
+	if n := len(output.NonConventional.Commits); n > 0 {
+		fmt.Fprintf(w, "  Non-conventional:\t%d (%s)\n", n, nonConventionalDisplay(output.NonConventional.Policy))
+	}
 	_ = w.Flush() // Ignore flush error for stdout display
 
 	fmt.Println()
 
+	// Version file/tag mismatches
+	if len(mismatches) > 0 {
+		printTitle("Version Mismatch")
+		fmt.Println()
+		for _, m := range mismatches {
+			printWarning(m.String())
+			printSubtle("  " + m.Guidance)
+		}
+		fmt.Println()
+	}
+
 	// Governance risk preview (if enabled)
 	if riskPreview != nil {
 		printTitle("Governance Risk Preview")
@@ -710,6 +1489,46 @@ func outputPlanText(output *servicerelease.AnalyzeOutput, releaseID string, show
 		fmt.Println()
 	}
 
+	// Affected packages (monorepo mode)
+	if pkgPlan != nil {
+		printTitle("Affected Packages")
+		fmt.Println()
+		if len(pkgPlan.Affected) == 0 {
+			printSubtle(fmt.Sprintf("  No packages affected (%d packages discovered)", len(pkgPlan.Packages)))
+		}
+		for _, pkg := range pkgPlan.Affected {
+			switch {
+			case len(pkg.Files) > 0 && len(pkg.SharedChanges) > 0:
+				fmt.Printf("  • %s (%d files, %d shared)\n", pkg.Path, len(pkg.Files), len(pkg.SharedChanges))
+			case len(pkg.SharedChanges) > 0:
+				fmt.Printf("  • %s (shared: %d files)\n", pkg.Path, len(pkg.SharedChanges))
+			default:
+				fmt.Printf("  • %s (%d files)\n", pkg.Path, len(pkg.Files))
+			}
+		}
+		if len(pkgPlan.Release) > 0 {
+			fmt.Println()
+			if cfg.Monorepo.DependencyCoordination {
+				fmt.Printf("  Release order (%s): %s\n", pkgPlan.Strategy, strings.Join(pkgPlan.Release, " → "))
+			} else {
+				fmt.Printf("  Packages to release (%s): %s\n", pkgPlan.Strategy, strings.Join(pkgPlan.Release, ", "))
+			}
+		}
+		for _, g := range pkgPlan.Groups {
+			if len(g.Release) == 0 {
+				continue
+			}
+			if g.IsLockstep() {
+				fmt.Printf("  Group %s (lockstep): %s → %s%s (%s)\n", g.Name, g.CurrentVersion, g.TagPrefix, g.NextVersion, strings.Join(g.Release, ", "))
+			} else {
+				fmt.Printf("  Group %s (independent): %s\n", g.Name, strings.Join(g.Release, ", "))
+			}
+		}
+		printCascade(pkgPlan.Cascade)
+		printPackageVersionFiles(pkgPlan.VersionFiles)
+		fmt.Println()
+	}
+
 	if !minimal {
 		cats := output.ChangeSet.Categories()
 
@@ -947,19 +1766,31 @@ func formatAutoApproveDisplay(canAutoApprove bool) string {
 // actorID is the identifier used for CLI-initiated actions.
 const actorID = "cli"
 
-// persistReleaseRunFromApp persists the release run by first obtaining repository info.
-func persistReleaseRunFromApp(ctx context.Context, app cliApp, output *servicerelease.AnalyzeOutput) (string, error) {
-	gitAdapter := app.GitAdapter()
-	if gitAdapter == nil {
-		return "", fmt.Errorf("git adapter not available")
+// persistPlannedRelease detects the affected monorepo packages and then saves
+// the release run, unless --dry-run is set. A dependency cycle between the
+// released packages fails the plan before any run is saved.
+func persistPlannedRelease(ctx context.Context, app cliApp, output *servicerelease.AnalyzeOutput, repoInfo *sourcecontrol.RepositoryInfo) (string, *monorepoPackagePlan, error) {
+	var pkgPlan *monorepoPackagePlan
+	if cfg.Monorepo.Enabled {
+		var err error
+		pkgPlan, err = buildMonorepoPackagePlan(ctx, app.GitAdapter(), repoInfo.Path, output.ChangeSet.Commits())
+		var cycleErr *monorepo.DependencyCycleError
+		if errors.As(err, &cycleErr) {
+			return "", nil, err
+		}
+		if err != nil {
+			printWarning(fmt.Sprintf("affected package detection failed: %v", err))
+		}
 	}
 
-	repoInfo, err := gitAdapter.GetInfo(ctx)
+	if dryRun {
+		return "", pkgPlan, nil
+	}
+	releaseID, err := persistReleaseRun(ctx, app, output, repoInfo)
 	if err != nil {
-		return "", fmt.Errorf("failed to get repository info: %w", err)
+		return "", nil, fmt.Errorf("failed to save release run: %w", err)
 	}
-
-	return persistReleaseRun(ctx, app, output, repoInfo)
+	return releaseID, pkgPlan, nil
 }
 
 // persistReleaseRunOptions contains optional parameters for persistReleaseRun.
@@ -991,22 +1822,29 @@ func persistReleaseRunWithOptions(ctx context.Context, app cliApp, output *servi
 
 	bumpKind := convertReleaseTypeToBumpKind(output.ReleaseType)
 
+	excluded := make([]string, len(output.ExcludedCommits))
+	for i, c := range output.ExcludedCommits {
+		excluded[i] = string(c)
+	}
+
 	input := releaseapp.PlanReleaseInput{
 		RepoRoot: repoInfo.Path,
 		RepoID:   repoInfo.RemoteURL,
-		BaseRef:  "", // Auto-detect from tags
+		BaseRef:  output.ChangeSet.FromRef(),
 		Actor: ports.ActorInfo{
 			Type: "user",
 			ID:   actorID,
 		},
-		Force:          true, // Force to replace any existing run from legacy
-		ChangeSet:      output.ChangeSet,
-		CurrentVersion: &output.CurrentVersion,
-		NextVersion:    &output.NextVersion,
-		BumpKind:       &bumpKind,
-		Confidence:     1.0, // Legacy analysis is authoritative
-		TagPushMode:    opts.TagPushMode,
-		TagName:        opts.TagName,
+		Force:           true, // Force to replace any existing run from legacy
+		ChangeSet:       output.ChangeSet,
+		CurrentVersion:  &output.CurrentVersion,
+		NextVersion:     &output.NextVersion,
+		BumpKind:        &bumpKind,
+		Confidence:      1.0, // Legacy analysis is authoritative
+		TagPushMode:     opts.TagPushMode,
+		TagName:         opts.TagName,
+		ExcludedCommits: excluded,
+		Commits:         output.ReleaseCommits(),
 	}
 
 	planOutput, err := services.PlanRelease.Execute(ctx, input)
@@ -1016,6 +1854,15 @@ func persistReleaseRunWithOptions(ctx context.Context, app cliApp, output *servi
 	return string(planOutput.RunID), nil
 }
 
+// formatExcludedCommits returns a comma-separated list of short commit hashes.
+func formatExcludedCommits(hashes []sourcecontrol.CommitHash) string {
+	short := make([]string, len(hashes))
+	for i, h := range hashes {
+		short[i] = h.Short()
+	}
+	return strings.Join(short, ", ")
+}
+
 // convertReleaseTypeToBumpKind converts ReleaseType to the domain BumpKind.
 func convertReleaseTypeToBumpKind(rt changes.ReleaseType) domain.BumpKind {
 	switch rt {
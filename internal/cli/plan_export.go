@@ -0,0 +1,342 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
+)
+
+// Plan review document formats.
+const (
+	planExportFormatMarkdown = "markdown"
+	planExportFormatJSON     = "json"
+)
+
+// PlanReviewDocument is the human-review artifact for a planned release.
+type PlanReviewDocument struct {
+	ReleaseID       string              `json:"release_id"`
+	Repository      string              `json:"repository,omitempty"`
+	Branch          string              `json:"branch,omitempty"`
+	State           string              `json:"state"`
+	CurrentVersion  string              `json:"current_version"`
+	NextVersion     string              `json:"next_version"`
+	BumpKind        string              `json:"bump_kind"`
+	CommitCount     int                 `json:"commit_count"`
+	Commits         []PlanReviewSection `json:"commits"`
+	BreakingChanges []PlanReviewCommit  `json:"breaking_changes"`
+	Risk            *PlanReviewRisk     `json:"risk,omitempty"`
+	Packages        *PlanReviewPackages `json:"packages,omitempty"`
+	PublishSteps    []PlanReviewStep    `json:"publish_steps"`
+}
+
+// PlanReviewSection groups the commits of one category.
+type PlanReviewSection struct {
+	Category string             `json:"category"`
+	Commits  []PlanReviewCommit `json:"commits"`
+}
+
+// PlanReviewCommit describes a single commit in the review document.
+type PlanReviewCommit struct {
+	Hash     string `json:"hash"`
+	Type     string `json:"type"`
+	Scope    string `json:"scope,omitempty"`
+	Subject  string `json:"subject"`
+	Breaking string `json:"breaking,omitempty"`
+}
+
+// PlanReviewRisk describes the risk assessment of the release.
+type PlanReviewRisk struct {
+	Score          float64  `json:"score"`
+	Severity       string   `json:"severity,omitempty"`
+	Decision       string   `json:"decision,omitempty"`
+	CanAutoApprove bool     `json:"can_auto_approve"`
+	Factors        []string `json:"factors,omitempty"`
+}
+
+// PlanReviewPackages describes the monorepo packages impacted by the release.
+type PlanReviewPackages struct {
+	Strategy string   `json:"strategy"`
+	Affected []string `json:"affected"`
+	Release  []string `json:"release"`
+}
+
+// PlanReviewStep describes a step publish is intended to run.
+type PlanReviewStep struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// exportPlanReview writes the review document for the release run persisted
+// by plan. The analyzed changeset is attached in memory only; the run itself
+// is not modified or saved.
+func exportPlanReview(ctx context.Context, app cliApp, repoPath string, output *servicerelease.AnalyzeOutput, riskPreview *governanceRiskPreview, pkgPlan *monorepoPackagePlan) error {
+	run, err := loadLatestReleaseRun(ctx, app, repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to load release run for export: %w", err)
+	}
+	if !run.HasChangeSet() && output != nil {
+		run.SetChangeSet(output.ChangeSet)
+	}
+
+	steps, err := plannedPublishSteps(ctx, app, repoPath, run)
+	if err != nil {
+		return err
+	}
+
+	content, err := renderPlanReview(buildPlanReviewDocument(run, riskPreview, pkgPlan, steps), planExportFormat)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(planExport, content, filePermReadable); err != nil {
+		return fmt.Errorf("failed to write plan export: %w", err)
+	}
+	return nil
+}
+
+// plannedPublishSteps returns the steps publish is intended to run. Runs are
+// given their execution plan on approval, so the plan approve would give the
+// run is returned without modifying it.
+func plannedPublishSteps(ctx context.Context, app cliApp, repoPath string, run *release.ReleaseRun) ([]release.StepPlan, error) {
+	services := app.ReleaseServices()
+	if services == nil || services.ApproveRelease == nil {
+		return run.Steps(), nil
+	}
+
+	packageTags, err := monorepoPackageTags(ctx, app.GitAdapter(), repoPath, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine package release order: %w", err)
+	}
+	steps, err := services.ApproveRelease.PlannedSteps(ctx, run, packageTags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan publish steps for export: %w", err)
+	}
+	return steps, nil
+}
+
+// buildPlanReviewDocument assembles the review document from a release run.
+// The governance risk preview is preferred over the run's own policy
+// evaluation because it carries the contributing factors.
+func buildPlanReviewDocument(run *release.ReleaseRun, riskPreview *governanceRiskPreview, pkgPlan *monorepoPackagePlan, steps []release.StepPlan) *PlanReviewDocument {
+	doc := &PlanReviewDocument{
+		ReleaseID:       string(run.ID()),
+		Repository:      run.RepoID(),
+		Branch:          run.Branch(),
+		State:           string(run.State()),
+		CurrentVersion:  run.VersionCurrent().String(),
+		NextVersion:     run.VersionNext().String(),
+		BumpKind:        string(run.BumpKind()),
+		CommitCount:     len(run.Commits()),
+		Commits:         []PlanReviewSection{},
+		BreakingChanges: []PlanReviewCommit{},
+	}
+
+	if cs := run.ChangeSet(); cs != nil {
+		doc.CommitCount = cs.CommitCount()
+		cats := cs.Categories()
+		for _, breaking := range cats.Breaking {
+			doc.BreakingChanges = append(doc.BreakingChanges, planReviewCommit(breaking))
+		}
+		for _, section := range []struct {
+			name    string
+			commits []*changes.ConventionalCommit
+		}{
+			{"Features", cats.Features},
+			{"Bug Fixes", cats.Fixes},
+			{"Performance", cats.Perf},
+			{"Refactoring", cats.Refactors},
+			{"Documentation", cats.Docs},
+			{"Tests", cats.Tests},
+			{"Build", cats.Build},
+			{"CI", cats.CI},
+			{"Chores", cats.Chores},
+			{"Reverts", cats.Reverts},
+			{"Other", cats.Other},
+		} {
+			if len(section.commits) == 0 {
+				continue
+			}
+			reviewSection := PlanReviewSection{Category: section.name}
+			for _, c := range section.commits {
+				reviewSection.Commits = append(reviewSection.Commits, planReviewCommit(c))
+			}
+			doc.Commits = append(doc.Commits, reviewSection)
+		}
+	}
+
+	switch {
+	case riskPreview != nil:
+		doc.Risk = &PlanReviewRisk{
+			Score:          riskPreview.RiskScore,
+			Severity:       riskPreview.Severity,
+			Decision:       riskPreview.Decision,
+			CanAutoApprove: riskPreview.CanAutoApprove,
+			Factors:        riskPreview.RiskFactors,
+		}
+	case run.RiskScore() > 0 || len(run.Reasons()) > 0:
+		doc.Risk = &PlanReviewRisk{
+			Score:   run.RiskScore(),
+			Factors: run.Reasons(),
+		}
+	}
+
+	if pkgPlan != nil {
+		packages := &PlanReviewPackages{
+			Strategy: pkgPlan.Strategy,
+			Affected: []string{},
+			Release:  append([]string{}, pkgPlan.Release...),
+		}
+		for _, affected := range pkgPlan.Affected {
+			packages.Affected = append(packages.Affected, affected.Path)
+		}
+		doc.Packages = packages
+	}
+
+	doc.PublishSteps = planReviewSteps(steps)
+	return doc
+}
+
+// planReviewCommit converts a conventional commit for the review document.
+func planReviewCommit(c *changes.ConventionalCommit) PlanReviewCommit {
+	commit := PlanReviewCommit{
+		Hash:    c.ShortHash(),
+		Type:    string(c.Type()),
+		Scope:   c.Scope(),
+		Subject: c.Subject(),
+	}
+	if c.IsBreaking() {
+		commit.Breaking = c.BreakingMessage()
+		if commit.Breaking == "" {
+			commit.Breaking = c.Subject()
+		}
+	}
+	return commit
+}
+
+// planReviewSteps converts the steps publish is intended to run for the
+// review document.
+func planReviewSteps(planned []release.StepPlan) []PlanReviewStep {
+	steps := make([]PlanReviewStep, 0, len(planned))
+	for _, step := range planned {
+		steps = append(steps, PlanReviewStep{Name: step.Name, Type: string(step.Type)})
+	}
+	return steps
+}
+
+// renderPlanReview renders the review document in the requested format.
+func renderPlanReview(doc *PlanReviewDocument, format string) ([]byte, error) {
+	switch format {
+	case planExportFormatJSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode plan export: %w", err)
+		}
+		return append(data, '\n'), nil
+	case planExportFormatMarkdown:
+		return []byte(renderPlanReviewMarkdown(doc)), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q (use markdown or json)", format)
+	}
+}
+
+// renderPlanReviewMarkdown renders the review document as markdown.
+func renderPlanReviewMarkdown(doc *PlanReviewDocument) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Release Plan: %s\n\n", doc.NextVersion)
+	fmt.Fprintf(&b, "- **Release ID:** %s\n", doc.ReleaseID)
+	if doc.Repository != "" {
+		fmt.Fprintf(&b, "- **Repository:** %s\n", doc.Repository)
+	}
+	if doc.Branch != "" {
+		fmt.Fprintf(&b, "- **Branch:** %s\n", doc.Branch)
+	}
+	fmt.Fprintf(&b, "- **State:** %s\n", doc.State)
+
+	b.WriteString("\n## Version Change\n\n")
+	fmt.Fprintf(&b, "%s → %s (%s)\n", doc.CurrentVersion, doc.NextVersion, doc.BumpKind)
+
+	fmt.Fprintf(&b, "\n## Commits (%d)\n\n", doc.CommitCount)
+	if len(doc.Commits) == 0 {
+		b.WriteString("No categorized commits.\n")
+	}
+	for _, section := range doc.Commits {
+		fmt.Fprintf(&b, "### %s\n\n", section.Category)
+		for _, c := range section.Commits {
+			b.WriteString(formatPlanReviewCommit(c))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n## Breaking Changes\n\n")
+	if len(doc.BreakingChanges) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, c := range doc.BreakingChanges {
+		fmt.Fprintf(&b, "- `%s` %s\n", c.Hash, c.Breaking)
+	}
+
+	b.WriteString("\n## Risk Assessment\n\n")
+	if doc.Risk == nil {
+		b.WriteString("Not assessed.\n")
+	} else {
+		fmt.Fprintf(&b, "- **Score:** %.2f\n", doc.Risk.Score)
+		if doc.Risk.Severity != "" {
+			fmt.Fprintf(&b, "- **Severity:** %s\n", doc.Risk.Severity)
+		}
+		if doc.Risk.Decision != "" {
+			fmt.Fprintf(&b, "- **Decision:** %s\n", doc.Risk.Decision)
+			fmt.Fprintf(&b, "- **Auto-approve:** %t\n", doc.Risk.CanAutoApprove)
+		}
+		if len(doc.Risk.Factors) > 0 {
+			b.WriteString("\nFactors:\n\n")
+			for _, factor := range doc.Risk.Factors {
+				fmt.Fprintf(&b, "- %s\n", factor)
+			}
+		}
+	}
+
+	if doc.Packages != nil {
+		b.WriteString("\n## Impacted Packages\n\n")
+		fmt.Fprintf(&b, "- **Strategy:** %s\n", doc.Packages.Strategy)
+		fmt.Fprintf(&b, "- **Affected:** %s\n", joinOrNone(doc.Packages.Affected))
+		fmt.Fprintf(&b, "- **Released:** %s\n", joinOrNone(doc.Packages.Release))
+	}
+
+	b.WriteString("\n## Publish Steps\n\n")
+	if len(doc.PublishSteps) == 0 {
+		b.WriteString("None.\n")
+	}
+	for i, step := range doc.PublishSteps {
+		fmt.Fprintf(&b, "%d. %s (%s)\n", i+1, step.Name, step.Type)
+	}
+
+	return b.String()
+}
+
+// formatPlanReviewCommit renders a commit as a markdown list item.
+func formatPlanReviewCommit(c PlanReviewCommit) string {
+	scope := ""
+	if c.Scope != "" {
+		scope = "**" + c.Scope + ":** "
+	}
+	breaking := ""
+	if c.Breaking != "" {
+		breaking = " **BREAKING**"
+	}
+	return fmt.Sprintf("- `%s` %s%s%s\n", c.Hash, scope, c.Subject, breaking)
+}
+
+// joinOrNone joins items with commas, or returns "none" for an empty list.
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,121 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// newPlanReviewFixture returns a representative planned run with its review inputs.
+func newPlanReviewFixture(t *testing.T) (*domain.ReleaseRun, *governanceRiskPreview, *monorepoPackagePlan) {
+	t.Helper()
+
+	run := domain.NewReleaseRun("github.com/acme/app", t.TempDir(), "v1.0.0", domain.CommitSHA("abc123def456"), nil, "", "")
+	if err := run.SetVersionProposal(version.MustParse("1.0.0"), version.MustParse("2.0.0"), domain.BumpMajor, 1.0); err != nil {
+		t.Fatal(err)
+	}
+	if err := run.Plan("test"); err != nil {
+		t.Fatal(err)
+	}
+
+	cs := changes.NewChangeSet(changes.ChangeSetID("cs-review"), "v1.0.0", "HEAD")
+	cs.AddCommits([]*changes.ConventionalCommit{
+		changes.NewConventionalCommit("1111111aaaaaaa", changes.CommitTypeFeat, "add export endpoint", changes.WithScope("api")),
+		changes.NewConventionalCommit("2222222bbbbbbb", changes.CommitTypeFix, "handle empty config"),
+		changes.NewConventionalCommit("3333333ccccccc", changes.CommitTypeFeat, "drop v1 routes", changes.WithBreaking("the v1 API has been removed")),
+	})
+	run.SetChangeSet(cs)
+
+	riskPreview := &governanceRiskPreview{
+		RiskScore:   0.72,
+		Severity:    "high",
+		Decision:    "require_review",
+		RiskFactors: []string{"API change: breaking change detected"},
+	}
+	pkgPlan := &monorepoPackagePlan{
+		Strategy: "independent",
+		Affected: []monorepo.AffectedPackage{{Path: "packages/api"}, {Path: "packages/web"}},
+		Release:  []string{"packages/api"},
+	}
+	return run, riskPreview, pkgPlan
+}
+
+func TestRenderPlanReviewMarkdown_AllSections(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+
+	run, riskPreview, pkgPlan := newPlanReviewFixture(t)
+	before := run.UpdatedAt()
+	steps := []domain.StepPlan{
+		{Name: "tag:packages/api", Type: domain.StepTypeTag},
+		{Name: "github:release", Type: domain.StepTypePlugin},
+	}
+
+	md := renderPlanReviewMarkdown(buildPlanReviewDocument(run, riskPreview, pkgPlan, steps))
+
+	for _, want := range []string{
+		"# Release Plan: 2.0.0",
+		"## Version Change",
+		"1.0.0 → 2.0.0 (major)",
+		"## Commits (3)",
+		"### Features",
+		"- `1111111` **api:** add export endpoint",
+		"### Bug Fixes",
+		"- `2222222` handle empty config",
+		"## Breaking Changes",
+		"- `3333333` the v1 API has been removed",
+		"## Risk Assessment",
+		"- **Score:** 0.72",
+		"- **Severity:** high",
+		"- API change: breaking change detected",
+		"## Impacted Packages",
+		"- **Affected:** packages/api, packages/web",
+		"- **Released:** packages/api",
+		"## Publish Steps",
+		"1. tag:packages/api (tag)",
+		"2. github:release (plugin)",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+
+	if run.State() != domain.StatePlanned || !run.UpdatedAt().Equal(before) || len(run.Steps()) != 0 {
+		t.Errorf("rendering modified the run: state=%s steps=%d", run.State(), len(run.Steps()))
+	}
+}
+
+func TestRenderPlanReview_JSON(t *testing.T) {
+	run, riskPreview, pkgPlan := newPlanReviewFixture(t)
+
+	data, err := renderPlanReview(buildPlanReviewDocument(run, riskPreview, pkgPlan, nil), planExportFormatJSON)
+	if err != nil {
+		t.Fatalf("renderPlanReview() error = %v", err)
+	}
+
+	var doc PlanReviewDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if doc.NextVersion != "2.0.0" || doc.CommitCount != 3 {
+		t.Errorf("doc = %+v, want next 2.0.0 with 3 commits", doc)
+	}
+	if len(doc.BreakingChanges) != 1 || doc.Risk == nil || doc.Packages == nil {
+		t.Errorf("doc missing sections: %+v", doc)
+	}
+}
+
+func TestRenderPlanReview_UnsupportedFormat(t *testing.T) {
+	run, _, _ := newPlanReviewFixture(t)
+	if _, err := renderPlanReview(buildPlanReviewDocument(run, nil, nil, nil), "html"); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
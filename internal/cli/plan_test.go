@@ -2,10 +2,21 @@
 package cli
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
 )
 
 func TestPlanCommand_FlagsExist(t *testing.T) {
@@ -17,6 +28,10 @@ func TestPlanCommand_FlagsExist(t *testing.T) {
 		{"to flag", "to"},
 		{"all flag", "all"},
 		{"minimal flag", "minimal"},
+		{"since flag", "since"},
+		{"export flag", "export"},
+		{"format flag", "format"},
+		{"dry flag", "dry"},
 	}
 
 	for _, tt := range tests {
@@ -39,6 +54,8 @@ func TestPlanCommand_DefaultValues(t *testing.T) {
 		{"to default HEAD", "to", "HEAD"},
 		{"all default false", "all", "false"},
 		{"minimal default false", "minimal", "false"},
+		{"since default empty", "since", ""},
+		{"dry default false", "dry", "false"},
 	}
 
 	for _, tt := range tests {
@@ -206,3 +223,196 @@ func TestPrintConventionalCommit_BreakingChange(t *testing.T) {
 	// Just verify it doesn't panic
 	printConventionalCommit(commit)
 }
+
+func TestCommitWindowOutput(t *testing.T) {
+	window := servicerelease.CommitWindow{
+		Oldest: time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC),
+		Newest: time.Date(2024, 6, 3, 17, 30, 0, 0, time.UTC),
+	}
+
+	got := window.Fields()
+	if got["oldest"] != "2024-06-01T09:00:00Z" || got["newest"] != "2024-06-03T17:30:00Z" {
+		t.Errorf("unexpected window bounds: %v", got)
+	}
+	if _, ok := got["since"]; ok {
+		t.Error("since should be omitted when the window is not time-bounded")
+	}
+	if text := formatCommitWindow(window); strings.Contains(text, "since") {
+		t.Errorf("unexpected since in %q", text)
+	}
+
+	window.Since = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	if got := window.Fields(); got["since"] != "2024-06-01T00:00:00Z" {
+		t.Errorf("since = %v, want 2024-06-01T00:00:00Z", got["since"])
+	}
+	if text := formatCommitWindow(window); !strings.Contains(text, "(since 2024-06-01 00:00)") {
+		t.Errorf("expected since in %q", text)
+	}
+}
+
+func TestRunPlan_DryRejectsIncompatibleFlags(t *testing.T) {
+	origDry, origAnalyze, origReview, origExport := planDry, planAnalyze, planReview, planExport
+	t.Cleanup(func() {
+		planDry, planAnalyze, planReview, planExport = origDry, origAnalyze, origReview, origExport
+	})
+
+	tests := []struct {
+		name    string
+		analyze bool
+		review  bool
+		export  string
+	}{
+		{name: "analyze", analyze: true},
+		{name: "review", review: true},
+		{name: "export", export: "plan.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planDry, planAnalyze, planReview, planExport = true, tt.analyze, tt.review, tt.export
+
+			err := runPlan(planCmd, nil)
+			if err == nil || !strings.Contains(err.Error(), "--dry cannot be combined") {
+				t.Errorf("runPlan() error = %v, want --dry conflict", err)
+			}
+		})
+	}
+}
+
+// packageGitRepo reports fixed per-prefix latest tags and changed files.
+type packageGitRepo struct {
+	stubGitRepo
+	tags  map[string]string
+	files map[string][]string
+}
+
+func (r packageGitRepo) GetLatestVersionTag(ctx context.Context, prefix string) (*sourcecontrol.Tag, error) {
+	name, ok := r.tags[prefix]
+	if !ok {
+		return nil, sourcecontrol.ErrNoTags
+	}
+	return sourcecontrol.NewTag(name, "abc123"), nil
+}
+
+func (r packageGitRepo) GetCommitDiffStats(ctx context.Context, hash sourcecontrol.CommitHash) (*sourcecontrol.DiffStats, error) {
+	stats := &sourcecontrol.DiffStats{}
+	for _, f := range r.files[string(hash)] {
+		stats.Files = append(stats.Files, sourcecontrol.FileStats{Path: f})
+	}
+	return stats, nil
+}
+
+func TestPackageVersions_Independent(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+	cfg.Monorepo.Enabled = true
+	cfg.Monorepo.Strategy = config.MonorepoStrategyIndependent
+
+	repo := packageGitRepo{
+		tags: map[string]string{"core-v": "core-v1.4.2", "web-v": "web-v0.3.0"},
+		files: map[string][]string{
+			"aaa1111": {"packages/core/api.go"},
+			"bbb2222": {"packages/web/app.ts"},
+			"ccc3333": {"packages/cli/main.go"},
+		},
+	}
+	commits := []*changes.ConventionalCommit{
+		changes.NewConventionalCommit("aaa1111", changes.CommitTypeFix, "nil check"),
+		changes.NewConventionalCommit("bbb2222", changes.CommitTypeFeat, "dark mode"),
+		changes.NewConventionalCommit("ccc3333", changes.CommitTypeFeat, "new flag", changes.WithBreaking("flag renamed")),
+	}
+	packages := []string{"packages/cli", "packages/core", "packages/web"}
+	attribution, err := monorepo.AnalyzeCommits(context.Background(), repo, commits, packages, monorepo.AffectedConfig{}, monorepo.AnalysisOptions{})
+	if err != nil {
+		t.Fatalf("AnalyzeCommits() error = %v", err)
+	}
+	pkgPlan := &monorepoPackagePlan{
+		Strategy:    string(config.MonorepoStrategyIndependent),
+		Packages:    packages,
+		Release:     packages,
+		Attribution: attribution,
+	}
+
+	got, err := packageVersions(context.Background(), repo, pkgPlan, commits, version.MustParse("2.0.0"))
+	if err != nil {
+		t.Fatalf("packageVersions() error = %v", err)
+	}
+	want := map[string]string{
+		"packages/cli":  version.NewVersionBump(version.BumpMajor).Apply(version.Initial).String(),
+		"packages/core": "1.4.3",
+		"packages/web":  "0.4.0",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("packageVersions() = %v, want %v", got, want)
+	}
+
+	pkgPlan.Strategy = string(config.MonorepoStrategyLockstep)
+	got, err = packageVersions(context.Background(), repo, pkgPlan, commits, version.MustParse("2.0.0"))
+	if err != nil {
+		t.Fatalf("packageVersions() error = %v", err)
+	}
+	for pkg, v := range got {
+		if v != "2.0.0" {
+			t.Errorf("lockstep version of %s = %s, want 2.0.0", pkg, v)
+		}
+	}
+}
+
+// recordingPlanApp records whether the release services were initialized to
+// save a run.
+type recordingPlanApp struct {
+	commandTestApp
+	initialized *bool
+}
+
+func (a recordingPlanApp) InitReleaseServices(context.Context, string) error {
+	*a.initialized = true
+	return nil
+}
+
+func TestPersistPlannedRelease_DependencyCycle(t *testing.T) {
+	origCfg, origDryRun := cfg, dryRun
+	t.Cleanup(func() { cfg, dryRun = origCfg, origDryRun })
+	cfg = config.DefaultConfig()
+	cfg.Monorepo.Enabled = true
+	cfg.Monorepo.Strategy = config.MonorepoStrategyIndependent
+	cfg.Monorepo.PackagePaths = []string{"packages/*"}
+	cfg.Monorepo.DependencyCoordination = true
+	dryRun = false
+
+	root := t.TempDir()
+	for name, dep := range map[string]string{"a": "b", "b": "a"} {
+		dir := filepath.Join(root, "packages", name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		manifest := `{"name": "@acme/` + name + `", "version": "1.0.0", "dependencies": {"@acme/` + dep + `": "^1.0.0"}}`
+		if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(manifest), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo := packageGitRepo{files: map[string][]string{
+		"aaa1111": {"packages/a/index.js"},
+		"bbb2222": {"packages/b/index.js"},
+	}}
+	changeSet := changes.NewChangeSet("cs-cycle", "v1.0.0", "HEAD")
+	changeSet.AddCommits([]*changes.ConventionalCommit{
+		changes.NewConventionalCommit("aaa1111", changes.CommitTypeFeat, "a feature"),
+		changes.NewConventionalCommit("bbb2222", changes.CommitTypeFix, "b fix"),
+	})
+	output := &servicerelease.AnalyzeOutput{ChangeSet: changeSet}
+
+	initialized := false
+	app := recordingPlanApp{commandTestApp: commandTestApp{gitRepo: repo}, initialized: &initialized}
+	releaseID, _, err := persistPlannedRelease(context.Background(), app, output, &sourcecontrol.RepositoryInfo{Path: root})
+
+	var cycleErr *monorepo.DependencyCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("persistPlannedRelease() error = %v, want a dependency cycle", err)
+	}
+	if releaseID != "" || initialized {
+		t.Error("persistPlannedRelease() saved a release run for a plan with a dependency cycle")
+	}
+}
@@ -0,0 +1,91 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// remoteTagDeleter deletes tags on a remote. The git adapter implements it.
+type remoteTagDeleter interface {
+	DeleteRemoteTag(ctx context.Context, name string, remote string) error
+}
+
+// cleanupPrereleaseTags deletes the prerelease tags that led to a stable
+// release, e.g. v1.2.0-rc.1 and v1.2.0-rc.2 once v1.2.0 ships. Remote tags
+// are deleted before local ones, so a cleanup that fails part-way is retried
+// on the next run; tags that are already gone are not matched again. Tags
+// are deleted from remote too unless it is empty. In dry-run mode the
+// matching tags are returned without being deleted.
+func cleanupPrereleaseTags(ctx context.Context, repo sourcecontrol.TagManager, prefix string, stable version.SemanticVersion, remote string, dryRun bool) ([]string, error) {
+	if stable.IsPrerelease() {
+		return nil, nil
+	}
+
+	tags, err := repo.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	var deleted []string
+	var errs []error
+	for _, tag := range tags.PrereleasesOf(prefix, stable) {
+		name := tag.Name()
+		if dryRun {
+			deleted = append(deleted, name)
+			continue
+		}
+		if remote != "" {
+			deleter, ok := repo.(remoteTagDeleter)
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: remote tag deletion not supported", name))
+				continue
+			}
+			if err := deleter.DeleteRemoteTag(ctx, name, remote); err != nil {
+				errs = append(errs, fmt.Errorf("%s: failed to delete remote tag: %w", name, err))
+				continue
+			}
+		}
+		if err := repo.DeleteTag(ctx, name); err != nil {
+			errs = append(errs, fmt.Errorf("%s: failed to delete tag: %w", name, err))
+			continue
+		}
+		deleted = append(deleted, name)
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+// runPrereleaseCleanup cleans up the prerelease tags of a stable release
+// when workflow.cleanup_prereleases_on_stable is enabled. Cleanup failures
+// are reported as warnings; they never fail the release.
+func runPrereleaseCleanup(ctx context.Context, repo sourcecontrol.TagManager, released version.SemanticVersion, push bool) {
+	if !cfg.Workflow.CleanupPrereleasesOnStable || released.IsPrerelease() {
+		return
+	}
+
+	remote := ""
+	if push {
+		remote = cfg.Git.DefaultRemote
+		if remote == "" {
+			remote = "origin"
+		}
+	}
+
+	tags, err := cleanupPrereleaseTags(ctx, repo, cfg.Versioning.TagPrefix, released, remote, dryRun)
+	if len(tags) > 0 {
+		if dryRun {
+			printInfo(fmt.Sprintf("Would delete prerelease tags: %s", strings.Join(tags, ", ")))
+		} else {
+			printSuccess(fmt.Sprintf("Deleted prerelease tags: %s", strings.Join(tags, ", ")))
+		}
+	}
+	if err != nil {
+		printWarning(fmt.Sprintf("Prerelease cleanup incomplete: %v", err))
+	}
+}
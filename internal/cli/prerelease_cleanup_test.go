@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// cleanupTagRepo is an in-memory tag store for prerelease cleanup tests.
+type cleanupTagRepo struct {
+	tags          []string
+	remoteDeleted []string
+	remotes       []string
+}
+
+func (r *cleanupTagRepo) GetTags(_ context.Context) (sourcecontrol.TagList, error) {
+	list := make(sourcecontrol.TagList, 0, len(r.tags))
+	for _, name := range r.tags {
+		list = append(list, sourcecontrol.NewTag(name, "abc123"))
+	}
+	return list, nil
+}
+
+func (r *cleanupTagRepo) GetTag(_ context.Context, _ string) (*sourcecontrol.Tag, error) {
+	return nil, sourcecontrol.ErrTagNotFound
+}
+
+func (r *cleanupTagRepo) GetLatestVersionTag(_ context.Context, _ string) (*sourcecontrol.Tag, error) {
+	return nil, nil
+}
+
+func (r *cleanupTagRepo) CreateTag(_ context.Context, _ string, _ sourcecontrol.CommitHash, _ string) (*sourcecontrol.Tag, error) {
+	return nil, nil
+}
+
+func (r *cleanupTagRepo) DeleteTag(_ context.Context, name string) error {
+	for i, tag := range r.tags {
+		if tag == name {
+			r.tags = append(r.tags[:i], r.tags[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *cleanupTagRepo) PushTag(_ context.Context, _ string, _ string) error { return nil }
+
+func (r *cleanupTagRepo) DeleteRemoteTag(_ context.Context, name string, remote string) error {
+	r.remoteDeleted = append(r.remoteDeleted, name)
+	r.remotes = append(r.remotes, remote)
+	return nil
+}
+
+func newCleanupTagRepo() *cleanupTagRepo {
+	return &cleanupTagRepo{tags: []string{"v1.1.0", "v1.2.0-rc.1", "v1.2.0-rc.2", "v1.2.0", "v1.3.0-rc.1"}}
+}
+
+func TestCleanupPrereleaseTags(t *testing.T) {
+	repo := newCleanupTagRepo()
+	stable := version.MustParse("1.2.0")
+
+	deleted, err := cleanupPrereleaseTags(context.Background(), repo, "v", stable, "upstream", false)
+	if err != nil {
+		t.Fatalf("cleanupPrereleaseTags() error = %v", err)
+	}
+
+	want := []string{"v1.2.0-rc.1", "v1.2.0-rc.2"}
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted = %v, want %v", deleted, want)
+	}
+	if !reflect.DeepEqual(repo.remoteDeleted, want) {
+		t.Errorf("remote deleted = %v, want %v", repo.remoteDeleted, want)
+	}
+	if !reflect.DeepEqual(repo.remotes, []string{"upstream", "upstream"}) {
+		t.Errorf("remotes = %v, want the given remote", repo.remotes)
+	}
+	if !reflect.DeepEqual(repo.tags, []string{"v1.1.0", "v1.2.0", "v1.3.0-rc.1"}) {
+		t.Errorf("remaining tags = %v", repo.tags)
+	}
+
+	// Running again is a no-op.
+	deleted, err = cleanupPrereleaseTags(context.Background(), repo, "v", stable, "upstream", false)
+	if err != nil || len(deleted) != 0 {
+		t.Errorf("second cleanup = %v, %v; want nothing deleted", deleted, err)
+	}
+}
+
+func TestCleanupPrereleaseTags_DryRun(t *testing.T) {
+	repo := newCleanupTagRepo()
+
+	deleted, err := cleanupPrereleaseTags(context.Background(), repo, "v", version.MustParse("1.2.0"), "upstream", true)
+	if err != nil {
+		t.Fatalf("cleanupPrereleaseTags() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(deleted, []string{"v1.2.0-rc.1", "v1.2.0-rc.2"}) {
+		t.Errorf("deleted = %v, want rc tags", deleted)
+	}
+	if len(repo.tags) != 5 || len(repo.remoteDeleted) != 0 {
+		t.Errorf("dry run modified tags: local %v, remote %v", repo.tags, repo.remoteDeleted)
+	}
+}
+
+func TestCleanupPrereleaseTags_PrereleaseVersion(t *testing.T) {
+	repo := newCleanupTagRepo()
+
+	deleted, err := cleanupPrereleaseTags(context.Background(), repo, "v", version.MustParse("1.2.0-rc.3"), "", false)
+	if err != nil || deleted != nil {
+		t.Errorf("cleanupPrereleaseTags() = %v, %v; want nothing for a prerelease", deleted, err)
+	}
+}
+
+func TestRunPrereleaseCleanup_Disabled(t *testing.T) {
+	origCfg := cfg
+	defer func() { cfg = origCfg }()
+	cfg = config.DefaultConfig()
+
+	repo := newCleanupTagRepo()
+	runPrereleaseCleanup(context.Background(), repo, version.MustParse("1.2.0"), false)
+
+	if len(repo.tags) != 5 {
+		t.Errorf("cleanup ran while disabled: %v", repo.tags)
+	}
+}
+
+func TestRunPrereleaseCleanup_UsesDefaultRemote(t *testing.T) {
+	origCfg := cfg
+	defer func() { cfg = origCfg }()
+	cfg = config.DefaultConfig()
+	cfg.Workflow.CleanupPrereleasesOnStable = true
+	cfg.Git.DefaultRemote = "upstream"
+
+	repo := newCleanupTagRepo()
+	runPrereleaseCleanup(context.Background(), repo, version.MustParse("1.2.0"), true)
+
+	if !reflect.DeepEqual(repo.remotes, []string{"upstream", "upstream"}) {
+		t.Errorf("remotes = %v, want git.default_remote", repo.remotes)
+	}
+}
@@ -3,20 +3,26 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
 	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
+	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+	"github.com/relicta-tech/relicta/internal/plugin"
 )
 
 var (
@@ -24,6 +30,10 @@ var (
 	publishSkipTag      bool
 	publishSkipPush     bool
 	publishSkipPlugins  bool
+	publishOutputDir    string
+
+	publishValidatePlugins bool
+	publishOnly            []string
 )
 
 func init() {
@@ -31,6 +41,9 @@ func init() {
 	publishCmd.Flags().BoolVarP(&publishSkipTag, "skip-tag", "T", false, "skip git tag creation")
 	publishCmd.Flags().BoolVarP(&publishSkipPush, "skip-push", "P", false, "skip pushing to remote")
 	publishCmd.Flags().BoolVarP(&publishSkipPlugins, "skip-plugins", "G", false, "skip running plugins")
+	publishCmd.Flags().StringVar(&publishOutputDir, "output-dir", "", "also write the release artifacts (notes, changelog, manifest, checksums) to this directory")
+	publishCmd.Flags().BoolVar(&publishValidatePlugins, "validate-plugins", false, "validate plugin configurations before publishing (always done with --dry-run)")
+	publishCmd.Flags().StringArrayVar(&publishOnly, "only", nil, "run only the steps of this plugin, skipping steps already done (repeatable)")
 }
 
 // shouldCreateTag returns whether a tag should be created.
@@ -48,18 +61,117 @@ func shouldRunPlugins() bool {
 	return !publishSkipPlugins && len(cfg.Plugins) > 0
 }
 
+// shouldValidatePlugins returns whether plugin configurations should be
+// validated before publishing.
+func shouldValidatePlugins() bool {
+	return (dryRun || publishValidatePlugins) && shouldRunPlugins()
+}
+
+// pluginConfigValidator validates the configuration of the configured plugins.
+type pluginConfigValidator interface {
+	ValidatePlugins(ctx context.Context) []plugin.PluginValidation
+	Close() error
+}
+
+// newPluginConfigValidator creates the validator used by publish.
+var newPluginConfigValidator = func(c *config.Config) pluginConfigValidator {
+	return plugin.NewManager(c)
+}
+
+// validatePublishPlugins calls Validate on each enabled plugin with its
+// effective configuration. It returns the per-plugin results and an error
+// naming the plugins that failed validation.
+func validatePublishPlugins(ctx context.Context) ([]plugin.PluginValidation, error) {
+	validator := newPluginConfigValidator(cfg)
+	defer func() { _ = validator.Close() }()
+
+	results := validator.ValidatePlugins(ctx)
+
+	var failed []string
+	for _, r := range results {
+		if !r.Valid {
+			failed = append(failed, r.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return results, fmt.Errorf("plugin validation failed: %s", strings.Join(failed, ", "))
+	}
+	return results, nil
+}
+
+// printPluginValidation prints the validation result of each plugin.
+func printPluginValidation(results []plugin.PluginValidation) {
+	printTitle("Plugin Validation")
+	fmt.Println()
+	for _, r := range results {
+		switch {
+		case r.Valid:
+			printSuccess(fmt.Sprintf("  %s: valid", r.Name))
+		case r.Error != "":
+			printError(fmt.Sprintf("  %s: %s", r.Name, r.Error))
+		default:
+			printError(fmt.Sprintf("  %s: invalid configuration", r.Name))
+		}
+		for _, e := range r.Errors {
+			printSubtle(fmt.Sprintf("    %s: %s", e.Field, e.Message))
+		}
+	}
+	fmt.Println()
+}
+
 // displayPublishActions displays what actions will be performed.
-func displayPublishActions(nextVersion string) {
+func displayPublishActions(nextVersion, target string) {
 	fmt.Println()
 	printTitle("Release Actions")
 	fmt.Println()
 	fmt.Printf("  Version:    %s%s\n", cfg.Versioning.TagPrefix, nextVersion)
+	if target != "" {
+		fmt.Printf("  Target:     %s\n", target)
+	}
 	fmt.Printf("  Create tag: %v\n", shouldCreateTag())
+	if shouldCreateTag() && cfg.Versioning.GitSign {
+		fmt.Printf("  Sign tag:   %s\n", signFormat())
+	}
 	fmt.Printf("  Push:       %v\n", shouldPushTag())
 	fmt.Printf("  Plugins:    %v\n", shouldRunPlugins())
+	if len(publishOnly) > 0 {
+		fmt.Printf("  Only:       %s\n", strings.Join(publishOnly, ", "))
+	}
 	fmt.Println()
 }
 
+// releaseTargetCommitish returns the commit a forge release is anchored to
+// when its tag does not exist yet: the github plugin's target_commitish, or
+// else the commit the release was planned against.
+func releaseTargetCommitish(run *releasedomain.ReleaseRun) string {
+	for _, p := range cfg.Plugins {
+		if p.Name != "github" {
+			continue
+		}
+		if target, ok := p.Config["target_commitish"].(string); ok && target != "" {
+			return target
+		}
+	}
+	return string(run.HeadSHA())
+}
+
+// signFormat returns the configured tag signing format.
+func signFormat() string {
+	if cfg.Versioning.SignFormat == "" {
+		return git.SignFormatGPG
+	}
+	return cfg.Versioning.SignFormat
+}
+
+// checkTagSigning verifies that the tag can be signed, so a dry run reports
+// a missing signing key before a real publish fails on it.
+func checkTagSigning() error {
+	if !shouldCreateTag() || !cfg.Versioning.GitSign {
+		return nil
+	}
+	return git.CheckTagSigning(signFormat(), cfg.Git.Auth.SSHKeyPath, cfg.Git.UseCLI())
+}
+
 // outputStepResults outputs the results of step executions.
 func outputStepResults(results []releaseapp.StepResult) {
 	if len(results) == 0 {
@@ -80,18 +192,146 @@ func outputStepResults(results []releaseapp.StepResult) {
 	}
 }
 
+// printSelectedStepSummary prints which selected steps were executed and
+// which were skipped by a publish --only.
+func printSelectedStepSummary(results []releaseapp.StepResult) {
+	var executed, skipped []string
+	for _, result := range results {
+		if result.Skipped {
+			skipped = append(skipped, result.StepName)
+		} else {
+			executed = append(executed, result.StepName)
+		}
+	}
+	fmt.Println()
+	if len(executed) > 0 {
+		printInfo(fmt.Sprintf("Executed: %s", strings.Join(executed, ", ")))
+	}
+	if len(skipped) > 0 {
+		printInfo(fmt.Sprintf("Skipped:  %s", strings.Join(skipped, ", ")))
+	}
+}
+
 // handleChangelogUpdate updates the changelog file if configured.
-func handleChangelogUpdate(rel *release.ReleaseRun) {
+// It reports whether the changelog was updated. When
+// monorepo.changelog.root_changelog is set, the entry links to the given
+// package changelogs.
+func handleChangelogUpdate(rel *release.ReleaseRun, packages ...monorepo.PackageChangelog) bool {
 	if cfg.Changelog.File == "" || rel.Notes() == nil || rel.Notes().Text == "" {
-		return
+		return false
 	}
 
 	printInfo(fmt.Sprintf("Updating %s...", cfg.Changelog.File))
-	if err := updateChangelogFile(cfg.Changelog.File, rel.Notes().Text); err != nil {
+	if err := updateChangelogFile(cfg.Changelog.File, changelogEntry(rel.Notes().Text, packages)); err != nil {
 		printWarning(fmt.Sprintf("Failed to update changelog: %v", err))
-	} else {
-		printSuccess(fmt.Sprintf("Updated %s", cfg.Changelog.File))
+		return false
+	}
+	printSuccess(fmt.Sprintf("Updated %s", cfg.Changelog.File))
+	return true
+}
+
+// changelogEntry returns the changelog entry for the release notes. When
+// monorepo.changelog.root_changelog is set, the entry links to the given
+// package changelogs.
+func changelogEntry(notes string, packages []monorepo.PackageChangelog) string {
+	if !cfg.Monorepo.Changelog.RootChangelog || len(packages) == 0 {
+		return notes
+	}
+	return strings.TrimRight(notes, "\n") + "\n\n" + monorepo.RenderChangelogIndex(cfg.Changelog.File, packages)
+}
+
+// handlePackageChangelogs writes the changelog of each package released by
+// a monorepo release when monorepo.changelog.per_package is enabled. It
+// returns the changelogs that were written.
+func handlePackageChangelogs(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, rel *release.ReleaseRun) []monorepo.PackageChangelog {
+	entries := previewPackageChangelogs(ctx, provider, repoPath, rel)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	written := make([]monorepo.PackageChangelog, 0, len(entries))
+	now := time.Now()
+	for _, entry := range entries {
+		file := filepath.Join(repoPath, filepath.FromSlash(entry.File))
+		if err := updateChangelogFile(file, entry.Render(now)); err != nil {
+			printWarning(fmt.Sprintf("Failed to update %s: %v", entry.File, err))
+			continue
+		}
+		written = append(written, entry)
+	}
+	if len(written) > 0 {
+		printSuccess(fmt.Sprintf("Updated %d package changelog(s)", len(written)))
 	}
+	return written
+}
+
+// previewPackageChangelogs returns the package changelog entries a publish
+// would write, without writing them.
+func previewPackageChangelogs(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, rel *release.ReleaseRun) []monorepo.PackageChangelog {
+	if !cfg.Monorepo.Enabled || !cfg.Monorepo.Changelog.PerPackage || !rel.HasChangeSet() {
+		return nil
+	}
+	entries, err := planPackageChangelogs(ctx, provider, repoPath, rel)
+	if err != nil {
+		printWarning(fmt.Sprintf("Failed to plan package changelogs: %v", err))
+		return nil
+	}
+	return entries
+}
+
+// planPackageChangelogs plans the changelog entries of the packages released
+// by a monorepo release. Members of a lockstep group get the group's
+// version; other packages get the release version. Members of a group with
+// shared_changelog list the commits of the whole group.
+func planPackageChangelogs(ctx context.Context, provider sourcecontrol.GitRepository, repoPath string, rel *release.ReleaseRun) ([]monorepo.PackageChangelog, error) {
+	commits := rel.ChangeSet().Commits()
+	pkgPlan, err := buildMonorepoPackagePlan(ctx, provider, repoPath, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	byPackage := monorepo.ShareGroupChangelogs(pkgPlan.Groups, commits, pkgPlan.Attribution.CommitsByPackage(commits))
+
+	versions, err := packageVersions(ctx, provider, pkgPlan, commits, rel.VersionNext())
+	if err != nil {
+		return nil, err
+	}
+
+	var graph monorepo.DependencyGraph
+	if cfg.Monorepo.Changelog.IncludePackageLinks {
+		if graph, err = monorepo.BuildDependencyGraph(repoPath, pkgPlan.Packages); err != nil {
+			return nil, err
+		}
+	}
+
+	rootFile := ""
+	if cfg.Monorepo.Changelog.RootChangelog && cfg.Changelog.File != "" {
+		rootFile = repoRelativePath(repoPath, cfg.Changelog.File)
+	}
+
+	overrides := make(map[string]string, len(cfg.Monorepo.PackageOverrides))
+	for pkg, o := range cfg.Monorepo.PackageOverrides {
+		overrides[pkg] = o.ChangelogFile
+	}
+
+	return monorepo.PlanChangelogs(pkgPlan.Release, versions, byPackage, graph, monorepo.ChangelogConfig{
+		Overrides:    overrides,
+		RootFile:     rootFile,
+		IncludeLinks: cfg.Monorepo.Changelog.IncludePackageLinks,
+	}), nil
+}
+
+// repoRelativePath returns file relative to repoPath. Relative paths are
+// assumed to already be relative to the repository root.
+func repoRelativePath(repoPath, file string) string {
+	if !filepath.IsAbs(file) {
+		return filepath.ToSlash(filepath.Clean(file))
+	}
+	rel, err := filepath.Rel(repoPath, file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	return filepath.ToSlash(rel)
 }
 
 // printPublishSummary prints the final release summary.
@@ -167,6 +407,10 @@ func runPublish(cmd *cobra.Command, args []string) error {
 func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL string) error {
 	services := app.ReleaseServices()
 
+	if err := checkReleaseFreeze(repoPath, "publish"); err != nil {
+		return err
+	}
+
 	// Load release from repository to get version
 	run, err := services.Repository.LoadLatest(ctx, repoPath)
 	if err != nil {
@@ -175,9 +419,20 @@ func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL
 
 	nextVersion := run.VersionNext().String()
 
+	// Validate plugin configurations so misconfigurations are caught before
+	// anything is published
+	var validations []plugin.PluginValidation
+	var validationErr error
+	if shouldValidatePlugins() {
+		validations, validationErr = validatePublishPlugins(ctx)
+	}
+
 	// Output JSON if requested
 	if outputJSON {
-		return outputPublishJSONFromServices(run)
+		if err := outputPublishJSONFromServices(run, validations); err != nil {
+			return err
+		}
+		return validationErr
 	}
 
 	// Get governance evaluation for outcome tracking (if enabled)
@@ -194,13 +449,33 @@ func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL
 	}
 
 	// Display planned actions
-	displayPublishActions(nextVersion)
+	displayPublishActions(nextVersion, releaseTargetCommitish(run))
+
+	if validations != nil {
+		printPluginValidation(validations)
+		if validationErr != nil {
+			printError("Fix the plugin configuration before publishing")
+			return validationErr
+		}
+	}
 
 	// Dry run - skip actual changes
 	if dryRun {
+		if err := checkTagSigning(); err != nil {
+			printError(fmt.Sprintf("Tag signing: %v", err))
+			return err
+		}
+		if publishOutputDir != "" {
+			exportReleaseArtifacts(publishOutputDir, newReleaseArtifacts(run, previewPackageChangelogs(ctx, app.GitAdapter(), repoPath, run)))
+		}
+		runPrereleaseCleanup(ctx, app.GitAdapter(), run.VersionNext(), shouldPushTag())
 		return nil
 	}
 
+	if err := checkStrict(); err != nil {
+		return err
+	}
+
 	// Track publish start time for duration recording
 	publishStart := time.Now()
 
@@ -215,8 +490,10 @@ func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL
 			Type: "user",
 			ID:   "cli",
 		},
-		Force:  true, // Force since we already validated
-		DryRun: false,
+		Force:           true, // Force since we already validated
+		DryRun:          false,
+		OnPluginFailure: releaseapp.PluginFailureMode(cfg.Workflow.OnPluginFailure),
+		Only:            publishOnly,
 	}
 
 	output, err := services.PublishRelease.Execute(ctx, input)
@@ -225,6 +502,16 @@ func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL
 
 	if err != nil {
 		printError(fmt.Sprintf("Failed to publish release: %v", err))
+		if errors.Is(err, release.ErrStepNotFound) {
+			printInfo("--only takes a plugin name or a step name from the execution plan")
+		}
+		if errors.Is(err, release.ErrPrePublishRejected) {
+			printInfo("A plugin rejected the release before any tag was created")
+			printInfo("Resolve the reported problem and run 'relicta publish' again")
+		}
+		if output != nil && len(output.RolledBackTags) > 0 {
+			printWarning(fmt.Sprintf("Rolled back tags (on_plugin_failure=rollback): %s", strings.Join(output.RolledBackTags, ", ")))
+		}
 		// Record failure outcome to Release Memory
 		if govResult != nil {
 			if rel, relErr := getLatestRelease(ctx, app); relErr == nil {
@@ -243,12 +530,48 @@ func runPublishWithServices(ctx context.Context, app cliApp, repoPath, remoteURL
 
 	// Output step results
 	outputStepResults(output.StepResults)
+	if len(output.PluginFailures) > 0 {
+		printWarning(fmt.Sprintf("Published with %d failed plugin step(s) (on_plugin_failure=%s)", len(output.PluginFailures), output.OnPluginFailure))
+	}
+	if len(publishOnly) > 0 {
+		printSelectedStepSummary(output.StepResults)
+		if !output.Published {
+			fmt.Println()
+			printInfo("Other steps are still pending; run 'relicta publish' to complete the release")
+			return nil
+		}
+	}
 
 	// Handle changelog update
+	var packageChangelogs []monorepo.PackageChangelog
 	if rel, relErr := getLatestRelease(ctx, app); relErr == nil {
-		handleChangelogUpdate(rel)
+		packageChangelogs = handlePackageChangelogs(ctx, app.GitAdapter(), repoPath, rel)
+		if handleChangelogUpdate(rel, packageChangelogs...) && cfg.Workflow.AutoCommitChangelog {
+			var packageFiles []string
+			for _, entry := range packageChangelogs {
+				packageFiles = append(packageFiles, filepath.Join(repoPath, filepath.FromSlash(entry.File)))
+			}
+			if file := projectVersionFilePath(repoPath); file != "" {
+				packageFiles = append(packageFiles, file)
+			}
+			if err := commitReleaseChangelog(ctx, repoPath, rel, packageFiles...); err != nil {
+				printWarning(fmt.Sprintf("Failed to commit changelog: %v", err))
+			} else {
+				printSuccess(fmt.Sprintf("Committed %s", cfg.Changelog.File))
+			}
+		}
+	}
+
+	if publishOutputDir != "" {
+		if rel, relErr := services.Repository.LoadLatest(ctx, repoPath); relErr == nil {
+			exportReleaseArtifacts(publishOutputDir, newReleaseArtifacts(rel, packageChangelogs))
+		} else {
+			printWarning(fmt.Sprintf("Failed to write release artifacts: %v", relErr))
+		}
 	}
 
+	runPrereleaseCleanup(ctx, app.GitAdapter(), run.VersionNext(), shouldPushTag())
+
 	// Determine tag name from version
 	tagName := cfg.Versioning.TagPrefix + nextVersion
 	printPublishSummary(nextVersion, tagName, remoteURL)
@@ -344,6 +667,10 @@ func updateChangelogFile(filename, newContent string) error {
 	// This handles cases where the content was generated with a header
 	newContent = stripChangelogHeader(newContent)
 
+	if err := os.MkdirAll(filepath.Dir(filename), dirPermReadable); err != nil { // #nosec G301 -- changelog directories are committed to the repository
+		return fmt.Errorf("failed to create changelog directory: %w", err)
+	}
+
 	// Read existing content
 	existingContent := ""
 	if data, err := os.ReadFile(filename); err == nil { // #nosec G304 -- user-specified changelog path
@@ -417,8 +744,8 @@ func findVersionEntryPoint(content string) int {
 	return 0
 }
 
-// outputPublishJSONFromServices outputs publish information as JSON from domain services.
-func outputPublishJSONFromServices(run *releasedomain.ReleaseRun) error {
+// outputPublishJSONFromServices outputs publish information as JSON or YAML from domain services.
+func outputPublishJSONFromServices(run *releasedomain.ReleaseRun, validations []plugin.PluginValidation) error {
 	output := map[string]any{
 		"release_id":   string(run.ID()),
 		"version":      run.VersionNext().String(),
@@ -429,6 +756,7 @@ func outputPublishJSONFromServices(run *releasedomain.ReleaseRun) error {
 		"skip_tag":     publishSkipTag,
 		"skip_push":    publishSkipPush,
 		"skip_plugins": publishSkipPlugins,
+		"only":         publishOnly,
 		"actions": map[string]bool{
 			"create_tag":  !publishSkipTag && cfg.Versioning.GitTag,
 			"push_tag":    !publishSkipPush && cfg.Versioning.GitPush,
@@ -436,6 +764,10 @@ func outputPublishJSONFromServices(run *releasedomain.ReleaseRun) error {
 		},
 	}
 
+	if target := releaseTargetCommitish(run); target != "" {
+		output["target_commitish"] = target
+	}
+
 	if run.Notes() != nil && run.Notes().Text != "" {
 		output["release_notes"] = run.Notes().Text
 	}
@@ -450,7 +782,9 @@ func outputPublishJSONFromServices(run *releasedomain.ReleaseRun) error {
 		output["plugins"] = plugins
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	if validations != nil {
+		output["plugin_validation"] = validations
+	}
+
+	return printStructuredOutput(output)
 }
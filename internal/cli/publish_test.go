@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	"github.com/relicta-tech/relicta/internal/plugin"
+	pkgplugin "github.com/relicta-tech/relicta/pkg/plugin"
+)
+
+type fakePluginConfigValidator struct {
+	results []plugin.PluginValidation
+	closed  bool
+}
+
+func (f *fakePluginConfigValidator) ValidatePlugins(ctx context.Context) []plugin.PluginValidation {
+	return f.results
+}
+
+func (f *fakePluginConfigValidator) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestValidatePublishPlugins(t *testing.T) {
+	origCfg := cfg
+	origDryRun := dryRun
+	origValidate := publishValidatePlugins
+	origSkip := publishSkipPlugins
+	origNew := newPluginConfigValidator
+	defer func() {
+		cfg = origCfg
+		dryRun = origDryRun
+		publishValidatePlugins = origValidate
+		publishSkipPlugins = origSkip
+		newPluginConfigValidator = origNew
+	}()
+
+	cfg = config.DefaultConfig()
+	cfg.Plugins = []config.PluginConfig{{Name: "github"}, {Name: "slack"}}
+
+	t.Run("dry-run validates plugins", func(t *testing.T) {
+		dryRun, publishValidatePlugins, publishSkipPlugins = true, false, false
+		if !shouldValidatePlugins() {
+			t.Error("dry-run should validate plugins")
+		}
+		dryRun, publishValidatePlugins = false, true
+		if !shouldValidatePlugins() {
+			t.Error("--validate-plugins should validate plugins")
+		}
+		publishSkipPlugins = true
+		if shouldValidatePlugins() {
+			t.Error("--skip-plugins should skip validation")
+		}
+	})
+
+	t.Run("missing config fails validation", func(t *testing.T) {
+		fake := &fakePluginConfigValidator{results: []plugin.PluginValidation{
+			{
+				Name:   "github",
+				Errors: []pkgplugin.ValidationError{{Field: "token", Message: "GitHub token is required"}},
+			},
+			{Name: "slack", Valid: true},
+		}}
+		newPluginConfigValidator = func(*config.Config) pluginConfigValidator { return fake }
+
+		results, err := validatePublishPlugins(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "github") || strings.Contains(err.Error(), "slack") {
+			t.Errorf("expected validation error naming github only, got %v", err)
+		}
+		if len(results) != 2 {
+			t.Errorf("expected per-plugin results, got %+v", results)
+		}
+		if !fake.closed {
+			t.Error("validator should be closed")
+		}
+	})
+
+	t.Run("valid plugins pass", func(t *testing.T) {
+		newPluginConfigValidator = func(*config.Config) pluginConfigValidator {
+			return &fakePluginConfigValidator{results: []plugin.PluginValidation{{Name: "github", Valid: true}}}
+		}
+		if _, err := validatePublishPlugins(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestReleaseTargetCommitish(t *testing.T) {
+	origCfg, origFormat := cfg, outputFormat
+	t.Cleanup(func() { cfg, outputFormat = origCfg, origFormat })
+
+	run := release.NewReleaseRunForTestWithCommits("run-target", "main", ".")
+	head := string(run.HeadSHA())
+
+	cfg = config.DefaultConfig()
+	cfg.Plugins = []config.PluginConfig{{Name: "github"}}
+	if got := releaseTargetCommitish(run); got != head {
+		t.Errorf("releaseTargetCommitish() = %q, want planned head %q", got, head)
+	}
+
+	cfg.Plugins[0].Config = map[string]any{"target_commitish": "release-branch"}
+	if got := releaseTargetCommitish(run); got != "release-branch" {
+		t.Errorf("releaseTargetCommitish() = %q, want configured target", got)
+	}
+
+	outputFormat = outputFormatJSON
+	out := captureOutput(t, func() {
+		if err := outputPublishJSONFromServices(run, nil); err != nil {
+			t.Errorf("outputPublishJSONFromServices() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, `"target_commitish": "release-branch"`) {
+		t.Errorf("publish output missing target_commitish:\n%s", out)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/relicta-tech/relicta/internal/domain/changes"
 	"github.com/relicta-tech/relicta/internal/domain/communication"
+	"github.com/relicta-tech/relicta/internal/domain/release"
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
 	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
@@ -22,6 +23,7 @@ var (
 	releaseSkipPush    bool
 	releaseForce       string
 	releaseClean       bool
+	releaseOutputDir   string
 )
 
 // releaseMode represents the detected release mode.
@@ -78,6 +80,7 @@ func init() {
 	releaseCmd.Flags().BoolVarP(&releaseAutoApprove, "yes", "y", false, "auto-approve the release without prompting")
 	releaseCmd.Flags().BoolVar(&releaseSkipPush, "skip-push", false, "skip pushing to remote")
 	releaseCmd.Flags().StringVarP(&releaseForce, "force", "f", "", "force a specific version (e.g., v2.0.0)")
+	releaseCmd.Flags().StringVar(&releaseOutputDir, "output-dir", "", "also write the release artifacts (notes, changelog, manifest, checksums) to this directory")
 	releaseCmd.Flags().BoolVarP(&releaseClean, "clean", "x", false, "clear any active release state before starting")
 }
 
@@ -158,6 +161,10 @@ func cleanActiveReleases(ctx context.Context, app cliApp) error {
 // runReleaseWorkflow executes the 5-step release workflow using helper functions.
 // Mode detection happens once at the start and is shared via releaseWorkflowContext.
 func runReleaseWorkflow(ctx context.Context, app cliApp) error {
+	if err := enforceReleaseFreeze(ctx, app, "release"); err != nil {
+		return err
+	}
+
 	// Detect release mode once at the start
 	wfCtx, err := detectWorkflowContext(ctx, app)
 	if err != nil {
@@ -230,6 +237,8 @@ func runReleaseWorkflow(ctx context.Context, app cliApp) error {
 	printStep(5, releaseWorkflowSteps, "Publishing release")
 	if dryRun {
 		printInfo("Dry run - skipping actual publish")
+		exportReleaseArtifacts(releaseOutputDir, plannedReleaseArtifacts(planOutput, bumpOutput, notesOutput))
+		runPrereleaseCleanup(ctx, app.GitAdapter(), bumpOutput.Version, !releaseSkipPush && cfg.Versioning.GitPush)
 		printSuccess("Release workflow completed (dry run)")
 		return nil
 	}
@@ -241,6 +250,16 @@ func runReleaseWorkflow(ctx context.Context, app cliApp) error {
 
 	fmt.Println()
 
+	if releaseOutputDir != "" {
+		if rel, err := loadLatestReleaseRun(ctx, app, publishOutput.RepoRoot); err == nil {
+			exportReleaseArtifacts(releaseOutputDir, newReleaseArtifacts(rel, nil))
+		} else {
+			printWarning(fmt.Sprintf("Failed to write release artifacts: %v", err))
+		}
+	}
+
+	runPrereleaseCleanup(ctx, app.GitAdapter(), bumpOutput.Version, !releaseSkipPush && cfg.Versioning.GitPush)
+
 	// Show appropriate success message based on skip-push
 	if releaseSkipPush {
 		printSuccess(fmt.Sprintf("Created %s locally (push skipped)", bumpOutput.Version.String()))
@@ -292,6 +311,10 @@ func runReleasePlan(ctx context.Context, c cliApp, wfCtx *releaseWorkflowContext
 	if analyzer == nil {
 		return nil, fmt.Errorf("release analyzer not available")
 	}
+	currentVersion, err := fileCurrentVersion(repoInfo.Path)
+	if err != nil {
+		return nil, err
+	}
 
 	input := servicerelease.AnalyzeInput{
 		RepositoryPath: repoInfo.Path,
@@ -299,6 +322,11 @@ func runReleasePlan(ctx context.Context, c cliApp, wfCtx *releaseWorkflowContext
 		FromRef:        fromRef,
 		ToRef:          toRef,
 		TagPrefix:      cfg.Versioning.TagPrefix,
+		CurrentVersion: currentVersion,
+
+		ContinuePrerelease:    version.Prerelease(cfg.Versioning.PrereleaseSuffix),
+		NonConventionalPolicy: servicerelease.NonConventionalPolicy(cfg.Versioning.NonConventionalPolicy),
+		CommitParser:          configCommitParser(),
 	}
 
 	output, err := analyzer.Analyze(ctx, input)
@@ -311,6 +339,10 @@ func runReleasePlan(ctx context.Context, c cliApp, wfCtx *releaseWorkflowContext
 		output.NextVersion = *wfCtx.existingVersion
 	}
 
+	if err := checkStrict(); err != nil {
+		return nil, err
+	}
+
 	// Persist the release run using DDD services
 	if !dryRun {
 		if err := persistReleasePlan(ctx, c, output, repoInfo.Path, repoInfo.Name, wfCtx); err != nil {
@@ -404,6 +436,11 @@ func runReleaseBump(ctx context.Context, c cliApp, wfCtx *releaseWorkflowContext
 	if err := updateReleaseVersion(ctx, c, ver); err != nil {
 		return nil, fmt.Errorf("failed to update release state: %w", err)
 	}
+	if !dryRun {
+		if err := writeBumpedVersionFile(ctx, c, ver); err != nil {
+			return nil, err
+		}
+	}
 
 	return &releaseBumpOutput{
 		Version: ver,
@@ -418,6 +455,7 @@ type releaseNotesResult struct {
 
 // releasePublishResult holds the result of publishing for the workflow.
 type releasePublishResult struct {
+	RepoRoot      string
 	TagName       string
 	ReleaseURL    string
 	PluginResults []releasePluginResult
@@ -452,10 +490,15 @@ func runReleaseNotes(ctx context.Context, c cliApp, plan *servicerelease.Analyze
 	input := releaseapp.GenerateNotesInput{
 		RepoRoot: repoInfo.Path,
 		Options: ports.NotesOptions{
-			AudiencePreset: cfg.AI.Audience,
-			TonePreset:     cfg.AI.Tone,
-			UseAI:          cfg.AI.Enabled,
-			RepositoryURL:  cfg.Changelog.RepositoryURL,
+			AudiencePreset:  cfg.AI.Audience,
+			TonePreset:      cfg.AI.Tone,
+			UseAI:           cfg.AI.Enabled,
+			RepositoryURL:   cfg.Changelog.RepositoryURL,
+			IssueURL:        cfg.Changelog.IssueURL,
+			Template:        cfg.Changelog.Template,
+			TagPrefix:       cfg.Versioning.TagPrefix,
+			HighlightsCount: cfg.Changelog.HighlightsCount,
+			ExcludeScopes:   cfg.Changelog.ExcludeScopes,
 		},
 		Actor: ports.ActorInfo{
 			Type: "user",
@@ -550,6 +593,16 @@ func runReleaseApproveExecute(ctx context.Context, c cliApp, approvedBy string)
 		return false, fmt.Errorf("ApproveRelease use case not available")
 	}
 
+	var packageTags []releasedomain.PackageTag
+	if services.Repository != nil {
+		if run, err := services.Repository.LoadLatest(ctx, repoInfo.Path); err == nil {
+			packageTags, err = monorepoPackageTags(ctx, gitAdapter, repoInfo.Path, run)
+			if err != nil {
+				return false, fmt.Errorf("failed to determine package release order: %w", err)
+			}
+		}
+	}
+
 	input := releaseapp.ApproveReleaseInput{
 		RepoRoot: repoInfo.Path,
 		Actor: ports.ActorInfo{
@@ -558,6 +611,8 @@ func runReleaseApproveExecute(ctx context.Context, c cliApp, approvedBy string)
 		},
 		AutoApprove: true,
 		Force:       true,
+		PackageTags: packageTags,
+		ApprovalTTL: cfg.Governance.ApprovalTTL,
 	}
 	_, err = services.ApproveRelease.Execute(ctx, input)
 	if err != nil {
@@ -592,10 +647,14 @@ func runReleasePublish(ctx context.Context, c cliApp, plan *servicerelease.Analy
 			Type: "user",
 			ID:   "cli",
 		},
-		Force:  true,
-		DryRun: dryRun,
+		Force:           true,
+		DryRun:          dryRun,
+		OnPluginFailure: releaseapp.PluginFailureMode(cfg.Workflow.OnPluginFailure),
 	}
 
+	if err := checkStrict(); err != nil {
+		return nil, err
+	}
 	output, err := services.PublishRelease.Execute(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to publish release: %w", err)
@@ -603,7 +662,8 @@ func runReleasePublish(ctx context.Context, c cliApp, plan *servicerelease.Analy
 
 	// Build result
 	result := &releasePublishResult{
-		TagName: cfg.Versioning.TagPrefix + plan.NextVersion.String(),
+		RepoRoot: repoInfo.Path,
+		TagName:  cfg.Versioning.TagPrefix + plan.NextVersion.String(),
 	}
 
 	// Convert step results to plugin results format
@@ -620,6 +680,24 @@ func runReleasePublish(ctx context.Context, c cliApp, plan *servicerelease.Analy
 	return result, nil
 }
 
+// plannedReleaseArtifacts returns the artifacts a dry-run release would
+// produce. No run is persisted in a dry run, so the manifest lists no steps.
+func plannedReleaseArtifacts(plan *servicerelease.AnalyzeOutput, bump *releaseBumpOutput, notes *releaseNotesResult) releaseArtifacts {
+	artifacts := releaseArtifacts{
+		Manifest: &release.PublishManifest{
+			SchemaVersion: release.PublishManifestSchemaVersion,
+			Version:       plan.NextVersion.String(),
+			TagName:       bump.TagName,
+			Targets:       []release.PublishTarget{},
+		},
+	}
+	if notes != nil && notes.ReleaseNotes != nil {
+		artifacts.Notes = notes.ReleaseNotes.Summary()
+		artifacts.Changelog = changelogEntry(artifacts.Notes, nil)
+	}
+	return artifacts
+}
+
 // Helper functions
 
 func printStep(current, total int, message string) {
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+// releaseCommitVars returns the placeholder values available to the release
+// commit message and trailers, e.g. ${version} or ${run_id}.
+func releaseCommitVars(rel *release.ReleaseRun) map[string]string {
+	tagName := rel.TagName()
+	if tagName == "" {
+		tagName = cfg.Versioning.TagPrefix + rel.VersionNext().String()
+	}
+	return map[string]string{
+		"version": rel.VersionNext().String(),
+		"tag":     tagName,
+		"run_id":  string(rel.ID()),
+		"risk":    strconv.FormatFloat(rel.RiskScore(), 'f', 2, 64),
+		"commit":  string(rel.HeadSHA()),
+	}
+}
+
+// buildReleaseCommitMessage renders the release commit message and appends
+// the rendered trailers as a trailer block separated by a blank line.
+func buildReleaseCommitMessage(message string, trailers []string, vars map[string]string) (string, error) {
+	expand := func(s string) string {
+		return os.Expand(s, func(key string) string { return vars[key] })
+	}
+
+	msg := strings.TrimRight(expand(message), "\n")
+	if len(trailers) == 0 {
+		return msg + "\n", nil
+	}
+
+	lines := make([]string, 0, len(trailers))
+	for _, t := range trailers {
+		line := strings.TrimSpace(expand(t))
+		key, value, ok := config.ParseReleaseTrailer(line)
+		if !ok {
+			return "", fmt.Errorf("invalid release commit trailer %q: must be \"Key: value\"", line)
+		}
+		lines = append(lines, key+": "+value)
+	}
+	return msg + "\n\n" + strings.Join(lines, "\n") + "\n", nil
+}
+
+// commitReleaseChangelog commits the updated changelog file with the
+// configured release commit message and trailers.
+func commitReleaseChangelog(ctx context.Context, repoPath string, rel *release.ReleaseRun, extraFiles ...string) error {
+	message, err := buildReleaseCommitMessage(cfg.Workflow.ChangelogCommitMessage, cfg.Workflow.ReleaseCommitTrailers, releaseCommitVars(rel))
+	if err != nil {
+		return err
+	}
+
+	file, err := filepath.Abs(cfg.Changelog.File)
+	if err != nil {
+		return fmt.Errorf("failed to resolve changelog path: %w", err)
+	}
+
+	files := append([]string{file}, extraFiles...)
+	if out, err := runGit(ctx, repoPath, "", append([]string{"add", "--"}, files...)...); err != nil {
+		return fmt.Errorf("git add failed: %w\noutput: %s", err, out)
+	}
+	if out, err := runGit(ctx, repoPath, message, append([]string{"commit", "--file=-", "--"}, files...)...); err != nil {
+		return fmt.Errorf("git commit failed: %w\noutput: %s", err, out)
+	}
+	return nil
+}
+
+// runGit runs a git command in dir, passing stdin when non-empty.
+func runGit(ctx context.Context, dir, stdin string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...) // #nosec G204 -- git command with fixed subcommands
+	cmd.Dir = dir
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+)
+
+func TestBuildReleaseCommitMessage(t *testing.T) {
+	vars := map[string]string{"version": "1.2.0", "run_id": "run-abc", "risk": "0.12"}
+
+	msg, err := buildReleaseCommitMessage("chore(release): update changelog for ${version}", []string{
+		"Relicta-Version: ${version}",
+		"Relicta-Run-Id:   ${run_id}",
+		"Relicta-Risk: ${risk}",
+	}, vars)
+	if err != nil {
+		t.Fatalf("buildReleaseCommitMessage() error = %v", err)
+	}
+	want := "chore(release): update changelog for 1.2.0\n\nRelicta-Version: 1.2.0\nRelicta-Run-Id: run-abc\nRelicta-Risk: 0.12\n"
+	if msg != want {
+		t.Errorf("message = %q, want %q", msg, want)
+	}
+
+	msg, err = buildReleaseCommitMessage("chore: release ${version}", nil, vars)
+	if err != nil {
+		t.Fatalf("buildReleaseCommitMessage() error = %v", err)
+	}
+	if msg != "chore: release 1.2.0\n" {
+		t.Errorf("message without trailers = %q", msg)
+	}
+
+	// A trailer whose value renders empty is not well-formed
+	if _, err := buildReleaseCommitMessage("chore: release", []string{"Relicta-Tag: ${tag}"}, vars); err == nil {
+		t.Error("expected error for empty trailer value")
+	}
+}
+
+func TestCommitReleaseChangelog(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	for _, args := range [][]string{{"init", "-q"}, {"commit", "-q", "--allow-empty", "-m", "initial"}} {
+		if out, err := runGit(context.Background(), dir, "", args...); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+	cfg.Changelog.File = filepath.Join(dir, "CHANGELOG.md")
+	cfg.Workflow.ReleaseCommitTrailers = []string{"Relicta-Version: ${version}", "Relicta-Run-Id: ${run_id}", "Relicta-Risk: ${risk}"}
+
+	rel := newNotesReadyRelease(t, "run-trailers")
+	if !handleChangelogUpdate(rel) {
+		t.Fatal("expected changelog to be updated")
+	}
+	if err := commitReleaseChangelog(context.Background(), dir, rel); err != nil {
+		t.Fatalf("commitReleaseChangelog() error = %v", err)
+	}
+
+	out, err := runGit(context.Background(), dir, "", "log", "-1", "--format=%B")
+	if err != nil {
+		t.Fatalf("git log failed: %v\n%s", err, out)
+	}
+	if !strings.HasPrefix(out, "chore(release): update changelog for 1.0.0\n\n") {
+		t.Errorf("unexpected commit subject: %q", out)
+	}
+	for _, want := range []string{"Relicta-Version: 1.0.0", "Relicta-Run-Id: run-trailers", "Relicta-Risk: 0.00"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("commit message missing trailer %q:\n%s", want, out)
+		}
+	}
+
+	trailers, err := runGit(context.Background(), dir, "", "log", "-1", "--format=%(trailers:only,unfold)")
+	if err != nil {
+		t.Fatalf("git log failed: %v\n%s", err, trailers)
+	}
+	if !strings.Contains(trailers, "Relicta-Version: 1.0.0") {
+		t.Errorf("git did not parse trailers: %q", trailers)
+	}
+
+	if _, err := os.Stat(cfg.Changelog.File); err != nil {
+		t.Fatalf("changelog missing: %v", err)
+	}
+	status, _ := runGit(context.Background(), dir, "", "status", "--porcelain")
+	if strings.TrimSpace(status) != "" {
+		t.Errorf("expected clean tree after commit, got %q", status)
+	}
+}
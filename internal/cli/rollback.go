@@ -0,0 +1,179 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+)
+
+var (
+	rollbackReason string
+	rollbackForce  bool
+)
+
+func init() {
+	rollbackCmd.Flags().StringVarP(&rollbackReason, "reason", "r", "", "reason for rolling back the release")
+	rollbackCmd.Flags().BoolVarP(&rollbackForce, "force", "f", false, "roll back a release that is already published")
+}
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback [version]",
+	Short: "Roll back a published release",
+	Long: `Roll back a release by deleting the tags it created.
+
+The tag is deleted locally and, when versioning.git_push is enabled, on the
+remote. Plugins are notified through the on-error hook and the release run
+is marked failed, so it can be prepared for another publish attempt with
+'relicta reset'.
+
+Without a version, the latest release is rolled back.
+
+Rolling back a published release requires --force: anyone who already
+fetched the tag, or a package built from it, keeps the old release.
+
+Each reverted step is recorded as it completes. If a rollback is
+interrupted, run it again to finish the remaining steps.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRollback,
+}
+
+// runRollback implements the rollback command.
+func runRollback(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if !outputJSON {
+		printTitle("Rollback Release")
+		fmt.Println()
+		if dryRun {
+			printDryRunBanner()
+		}
+	}
+
+	// Initialize container
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	if err := app.InitReleaseServices(ctx, repoInfo.Path); err != nil {
+		return fmt.Errorf("failed to initialize release services: %w", err)
+	}
+	services := app.ReleaseServices()
+	if services == nil || services.Rollback == nil {
+		return fmt.Errorf("rollback use case not available")
+	}
+
+	input := releaseapp.RollbackReleaseInput{
+		RepoRoot: repoInfo.Path,
+		Actor: ports.ActorInfo{
+			Type: "user",
+			ID:   getCurrentUser(),
+		},
+		Reason: rollbackReason,
+		Force:  rollbackForce,
+		DryRun: dryRun,
+	}
+	if len(args) > 0 {
+		input.Version = args[0]
+	}
+
+	output, err := services.Rollback.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, releaseapp.ErrRollbackRequiresForce) {
+			printError("Release has already been published")
+			printInfo("Consumers may already have fetched the tag or artifacts built from it")
+			printInfo("Use --force to roll back anyway")
+		}
+		if output != nil && !outputJSON {
+			outputStepResults(output.RevertedSteps)
+			fmt.Println()
+			printInfo("Run 'relicta rollback' again to resume the rollback")
+		}
+		return fmt.Errorf("failed to roll back release: %w", err)
+	}
+
+	if outputJSON {
+		return outputRollbackJSON(output, dryRun)
+	}
+
+	printRollbackSummary(output, dryRun)
+	return nil
+}
+
+// printRollbackSummary prints the reverted steps and the resulting run state.
+func printRollbackSummary(output *releaseapp.RollbackReleaseOutput, wasDryRun bool) {
+	if output.PreviousState == releasedomain.StatePublished {
+		printWarning(fmt.Sprintf("Rolling back published release %s - consumers may still reference it", output.VersionNext))
+	}
+
+	outputStepResults(output.RevertedSteps)
+	fmt.Println()
+
+	if len(output.RevertedSteps) == 0 {
+		printInfo("No tag steps to revert")
+	}
+
+	if wasDryRun {
+		printInfo(fmt.Sprintf("Would roll back release %s (%s)", output.VersionNext, output.RunID))
+		return
+	}
+
+	if output.NotifyError != "" {
+		printWarning(fmt.Sprintf("Rollback hooks failed: %s", output.NotifyError))
+	}
+
+	printSuccess(fmt.Sprintf("Release %s rolled back (%d step(s) reverted)", output.VersionNext, len(output.RevertedSteps)))
+	printInfo(fmt.Sprintf("State: %s -> %s", output.PreviousState, output.State))
+	fmt.Println()
+	printTitle("Next Steps")
+	fmt.Println()
+	fmt.Println("  • Run 'relicta reset' to prepare another publish attempt")
+	fmt.Println("  • Or run 'relicta plan' to start a new release")
+	fmt.Println()
+}
+
+// outputRollbackJSON outputs the rollback result as JSON.
+func outputRollbackJSON(output *releaseapp.RollbackReleaseOutput, wasDryRun bool) error {
+	steps := make([]map[string]any, 0, len(output.RevertedSteps))
+	for _, step := range output.RevertedSteps {
+		steps = append(steps, map[string]any{
+			"name":    step.StepName,
+			"success": step.Success,
+			"skipped": step.Skipped,
+			"output":  step.Output,
+			"error":   step.Error,
+		})
+	}
+
+	result := map[string]any{
+		"action":         "rollback",
+		"release_id":     string(output.RunID),
+		"version":        output.VersionNext,
+		"previous_state": string(output.PreviousState),
+		"new_state":      string(output.State),
+		"reverted_steps": steps,
+		"removed_tags":   output.RemovedTags,
+		"dry_run":        wasDryRun,
+	}
+	if output.NotifyError != "" {
+		result["notify_error"] = output.NotifyError
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
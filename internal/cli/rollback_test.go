@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
+)
+
+func TestRollbackCommand_Configuration(t *testing.T) {
+	if rollbackCmd.Use != "rollback [version]" {
+		t.Errorf("rollbackCmd.Use = %v, want rollback [version]", rollbackCmd.Use)
+	}
+	if rollbackCmd.RunE == nil {
+		t.Error("rollbackCmd.RunE is nil")
+	}
+	for _, name := range []string{"force", "reason"} {
+		if rollbackCmd.Flags().Lookup(name) == nil {
+			t.Errorf("rollback command missing %s flag", name)
+		}
+	}
+}
+
+func testRollbackOutput() *releaseapp.RollbackReleaseOutput {
+	return &releaseapp.RollbackReleaseOutput{
+		RunID:         "run-1",
+		VersionNext:   "1.2.0",
+		PreviousState: releasedomain.StatePublished,
+		State:         releasedomain.StateFailed,
+		RevertedSteps: []releaseapp.StepResult{
+			{StepName: "tag", Success: true, Output: "Deleted tag v1.2.0"},
+		},
+		RemovedTags: []string{"v1.2.0"},
+	}
+}
+
+func TestOutputRollbackJSON(t *testing.T) {
+	out := captureOutput(t, func() {
+		if err := outputRollbackJSON(testRollbackOutput(), false); err != nil {
+			t.Errorf("outputRollbackJSON() error = %v", err)
+		}
+	})
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if result["action"] != "rollback" || result["new_state"] != "failed" {
+		t.Errorf("unexpected result: %v", result)
+	}
+	steps, ok := result["reverted_steps"].([]any)
+	if !ok || len(steps) != 1 {
+		t.Errorf("reverted_steps = %v, want one step", result["reverted_steps"])
+	}
+}
+
+func TestPrintRollbackSummary(t *testing.T) {
+	out := captureOutput(t, func() {
+		printRollbackSummary(testRollbackOutput(), false)
+	})
+
+	for _, want := range []string{"consumers may still reference it", "Deleted tag v1.2.0", "published -> failed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/observability"
 	"github.com/relicta-tech/relicta/internal/security"
 )
 
@@ -28,14 +29,16 @@ var (
 
 	// Global flags
 	cfgFile       string
+	profileName   string // --profile flag selecting a config profile
 	verbose       bool
 	dryRun        bool
-	outputJSON    bool
+	outputJSON    bool // structured output; outputFormat selects JSON or YAML
 	noColor       bool
 	logLevel      string
 	modelFlag     string // --model flag for AI provider/model selection
 	ciMode        bool   // --ci flag for CI/CD pipeline mode (auto-approve, JSON output)
 	redactSecrets bool   // --redact flag to mask sensitive data in output
+	strictMode    bool   // --strict flag escalating warnings to errors
 
 	// Global config
 	cfg *config.Config
@@ -95,12 +98,18 @@ releases in an AI-driven world.
 
 Get started with 'relicta init' to set up your project.`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		// Skip config loading for commands that don't need it
-		if cmd.Name() == "init" || cmd.Name() == "version" || cmd.Name() == "help" || cmd.Name() == "plugin" || cmd.Name() == "mcp" || cmd.Name() == "policy" || cmd.Parent() != nil && (cmd.Parent().Name() == "plugin" || cmd.Parent().Name() == "mcp" || cmd.Parent().Name() == "policy") {
-			return nil
+		diagnostics.reset()
+
+		// Skip config loading for commands that don't need it; their
+		// structured output still follows --output and --json
+		if cmd.Name() == "init" || cmd.Name() == "version" || cmd.Name() == "self-update" || cmd.Name() == "help" || cmd.Name() == "plugin" || cmd.Name() == "mcp" || cmd.Name() == "policy" || cmd.Name() == "config" || cmd.Parent() != nil && (cmd.Parent().Name() == "plugin" || cmd.Parent().Name() == "mcp" || cmd.Parent().Name() == "policy" || cmd.Parent().Name() == "config") {
+			return resolveOutputFormat()
 		}
 		return initConfig()
 	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		return checkStrict()
+	},
 	SilenceUsage:  true,
 	SilenceErrors: true,
 }
@@ -125,14 +134,17 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default: .relicta.yaml)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "config profile to apply over the base config (env: RELICTA_PROFILE)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "simulate actions without making changes")
 	rootCmd.PersistentFlags().BoolVar(&outputJSON, "json", false, "output results as JSON")
+	rootCmd.PersistentFlags().StringVar(&outputFlag, "output", "", "output format: text, json, yaml (default from output.format)")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "log level (debug, info, warn, error)")
 	rootCmd.PersistentFlags().StringVar(&modelFlag, "model", "", "AI model to use (format: provider/model, e.g., ollama/llama3.2, openai/gpt-4, anthropic/claude-sonnet-4, local/mistral)")
 	rootCmd.PersistentFlags().BoolVar(&ciMode, "ci", false, "CI/CD mode: auto-approve, JSON output, non-interactive")
 	rootCmd.PersistentFlags().BoolVar(&redactSecrets, "redact", false, "redact secrets and API keys from output (auto-enabled in CI mode)")
+	rootCmd.PersistentFlags().BoolVar(&strictMode, "strict", false, "treat warnings as errors and exit non-zero if any were reported")
 
 	// Bind flags to viper (errors are non-fatal for flag binding)
 	_ = viper.BindPFlag("output.verbose", rootCmd.PersistentFlags().Lookup("verbose"))
@@ -150,6 +162,7 @@ func init() {
 	rootCmd.AddCommand(releaseCmd)
 	rootCmd.AddCommand(cancelCmd)
 	rootCmd.AddCommand(resetCmd)
+	rootCmd.AddCommand(rollbackCmd)
 	rootCmd.AddCommand(mcpCmd)
 	rootCmd.AddCommand(policyCmd)
 }
@@ -161,6 +174,9 @@ func loadAndValidateConfig() error {
 	if cfgFile != "" {
 		loader.WithConfigPath(cfgFile)
 	}
+	if profileName != "" {
+		loader.WithProfile(profileName)
+	}
 
 	var err error
 	cfg, err = loader.Load()
@@ -171,6 +187,9 @@ func loadAndValidateConfig() error {
 	if err := config.Validate(cfg); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
+	for _, warning := range config.Check(cfg).Warnings {
+		recordWarning("config: " + warning)
+	}
 
 	return nil
 }
@@ -285,36 +304,54 @@ func initConfig() error {
 	applyModelFlag()
 	applyCIModeFlag()
 	applyRedactSecretsFlag()
+	if err := resolveOutputFormat(); err != nil {
+		return err
+	}
 
 	// Configure logger
 	configureLoggerFormat()
 	configureLogLevel()
 
+	// Configure tracing
+	if err := configureTracing(); err != nil {
+		return err
+	}
+
 	// Configure log file
 	return configureLogFile()
 }
 
+// configureTracing initializes the global tracer from the telemetry configuration.
+func configureTracing() error {
+	tc := cfg.Telemetry.Tracing
+	if !tc.Enabled {
+		return nil
+	}
+
+	tracerCfg := observability.DefaultTracerConfig()
+	tracerCfg.Enabled = true
+	tracerCfg.ServiceVersion = versionInfo.Version
+	tracerCfg.Endpoint = tc.Endpoint
+	tracerCfg.Insecure = tc.Insecure
+	tracerCfg.SampleRate = tc.SampleRate
+	tracerCfg.Headers = tc.Headers
+
+	if _, err := observability.InitTracer(tracerCfg); err != nil {
+		return fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+	return nil
+}
+
 // Cleanup closes any open resources. Should be called before program exit.
 func Cleanup() {
+	releaseStateLock()
+	_ = observability.ShutdownTracer(context.Background()) // Best effort flush on exit
 	if logFile != nil {
 		_ = logFile.Close() // Error on cleanup is logged but not propagated
 		logFile = nil
 	}
 }
 
-// versionCmd prints version information.
-var versionCmd = &cobra.Command{
-	Use:   "version",
-	Short: "Print version information",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("relicta %s\n", versionInfo.Version)
-		if verbose {
-			fmt.Printf("  commit: %s\n", versionInfo.Commit)
-			fmt.Printf("  built:  %s\n", versionInfo.Date)
-		}
-	},
-}
-
 // Placeholder commands (to be implemented in separate files)
 
 var initCmd = &cobra.Command{
@@ -323,7 +360,14 @@ var initCmd = &cobra.Command{
 	Long: `Initialize a new relicta configuration in the current directory.
 
 This command creates a .relicta.yaml file with sensible defaults
-and guides you through the initial setup.`,
+and guides you through the initial setup.
+
+With --from-existing the config is tailored to the repository instead:
+project manifests (go.mod, package.json, Cargo.toml) enable the matching
+publishing plugins, the origin remote selects the GitHub or GitLab plugin
+and prefills changelog.repository_url, and an existing CHANGELOG keeps its
+format. A summary explains what was detected and why each plugin was
+chosen. An existing config is only overwritten with --force.`,
 	RunE: runInit,
 }
 
@@ -333,7 +377,12 @@ var planCmd = &cobra.Command{
 	Long: `Analyze commits since the last release and suggest a version bump.
 
 This command examines your commit history using conventional commits
-to determine what type of release is needed (major, minor, or patch).`,
+to determine what type of release is needed (major, minor, or patch).
+
+To hotfix an older release line, check out a branch created from its tag
+and run 'relicta plan --base-tag v1.1.0'. The release covers only the
+commits since that tag and is always a patch release (v1.1.1). Planning
+fails if a newer release tag is reachable from the branch.`,
 	RunE: runPlan,
 }
 
@@ -342,7 +391,11 @@ var bumpCmd = &cobra.Command{
 	Short: "Calculate and apply a version bump",
 	Long: `Calculate the next version based on commits and apply the bump.
 
-This command updates version tags and optionally version files.`,
+This command updates version tags and optionally version files.
+
+Use --pre <suffix> to cut a prerelease from the computed bump: a minor bump
+of 1.2.0 with --pre beta gives 1.3.0-beta.1, and once that is tagged the
+same command continues the series with 1.3.0-beta.2.`,
 	Aliases: []string{"version-bump"},
 	RunE:    runVersion,
 }
@@ -353,7 +406,22 @@ var notesCmd = &cobra.Command{
 	Long: `Generate changelog entries and release notes for the current release.
 
 This command creates human-readable release documentation from your
-commit history, optionally using AI to enhance the content.`,
+commit history, optionally using AI to enhance the content.
+
+When changelog.template (or --template) is set, the notes are rendered from
+that Go text/template file instead. Templates receive the changelog entry:
+.Version, .Date, .Tag, .Sections, .Items (with .Type, .Scope, .Description,
+.CommitHash, .Author and .Breaking), .Highlights, .Contributors and
+.CompareURL, and can use functions such as upper, groupByType, groupByScope
+and issueLink.
+
+Use --regenerate to replace notes that were already generated, before the
+release is approved. The audience and tone of the existing notes are kept
+unless --audience or --tone is given.
+
+Use --from-file to supply hand-written notes instead of generating them.
+The file must be within the working directory. Notes that were already
+generated are replaced, as long as the release is not yet approved.`,
 	RunE: runNotes,
 }
 
@@ -363,7 +431,11 @@ var approveCmd = &cobra.Command{
 	Long: `Review the prepared release and approve it for publishing.
 
 This command presents the release summary and allows you to
-review and edit the release notes before publishing.`,
+review and edit the release notes before publishing.
+
+Use --reject with --reason to send the notes back for changes instead.
+The release stays open: revise the notes with 'relicta notes --regenerate'
+or 'relicta approve --edit' to resubmit them for approval.`,
 	RunE: runApprove,
 }
 
@@ -376,7 +448,11 @@ running configured plugins.
 This command performs all the release actions including:
 - Creating and pushing git tags
 - Updating the changelog file
-- Running plugins (GitHub release, npm publish, Slack notification)`,
+- Running plugins (GitHub release, npm publish, Slack notification)
+
+With --dry-run, each plugin's configuration is validated (missing tokens,
+bad owner/repo, ...) and reported per plugin. Use --validate-plugins to run
+the same check before a real publish.`,
 	RunE: runPublish,
 }
 
@@ -392,7 +468,9 @@ func printError(msg string) {
 }
 
 func printWarning(msg string) {
-	fmt.Println(styles.Warning.Render("⚠ " + security.Mask(msg)))
+	msg = security.Mask(msg)
+	diagnostics.warn(msg)
+	fmt.Println(styles.Warning.Render("⚠ " + msg))
 }
 
 func printInfo(msg string) {
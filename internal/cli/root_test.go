@@ -174,6 +174,27 @@ func TestRootCommand_PersistentPreRunE(t *testing.T) {
 	}
 }
 
+func TestRootCommand_PersistentPreRunE_ResolvesOutputWithoutConfig(t *testing.T) {
+	origFlag, origJSON, origFormat, origCfg := outputFlag, outputJSON, outputFormat, cfg
+	t.Cleanup(func() {
+		outputFlag, outputJSON, outputFormat, cfg = origFlag, origJSON, origFormat, origCfg
+	})
+	cfg = nil
+
+	outputFlag, outputJSON = "yaml", false
+	if err := rootCmd.PersistentPreRunE(versionCmd, nil); err != nil {
+		t.Fatalf("PersistentPreRunE(version --output yaml) error = %v", err)
+	}
+	if !outputJSON || outputFormat != outputFormatYAML {
+		t.Errorf("version --output yaml: outputJSON = %v, outputFormat = %q", outputJSON, outputFormat)
+	}
+
+	outputFlag, outputJSON = "bogus", false
+	if err := rootCmd.PersistentPreRunE(versionCmd, nil); err == nil {
+		t.Error("PersistentPreRunE(version --output bogus) error = nil, want unsupported format")
+	}
+}
+
 func TestExecute_FunctionExists(t *testing.T) {
 	// Just verify the function exists - we can't actually test execution
 	// without running the whole CLI
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/selfupdate"
+)
+
+var selfUpdateCmd = &cobra.Command{
+	Use:   "self-update",
+	Short: "Update relicta to the latest release",
+	Long: `Check GitHub releases for a newer relicta version and install it.
+
+The release archive for this OS/architecture is verified against the
+published checksums.txt before the current executable is replaced. When
+cosign is installed, the checksum file's signature is verified as well.
+
+Proxy settings are taken from HTTP_PROXY, HTTPS_PROXY and NO_PROXY.
+
+Examples:
+  # Report whether an update is available
+  relicta self-update --check
+
+  # Update to the latest stable release (asks for confirmation)
+  relicta self-update
+
+  # Include prereleases
+  relicta self-update --channel prerelease
+
+  # Update without prompting
+  relicta self-update --yes`,
+	RunE: runSelfUpdate,
+}
+
+var (
+	selfUpdateCheck   bool
+	selfUpdateChannel string
+	selfUpdateYes     bool
+
+	// newSelfUpdater is replaceable in tests.
+	newSelfUpdater = func() *selfupdate.Updater { return selfupdate.NewUpdater() }
+)
+
+func init() {
+	selfUpdateCmd.Flags().BoolVar(&selfUpdateCheck, "check", false, "only report whether an update is available")
+	selfUpdateCmd.Flags().StringVar(&selfUpdateChannel, "channel", "stable", "release channel (stable, prerelease)")
+	selfUpdateCmd.Flags().BoolVarP(&selfUpdateYes, "yes", "y", false, "install the update without prompting for confirmation")
+
+	rootCmd.AddCommand(selfUpdateCmd)
+}
+
+func runSelfUpdate(cmd *cobra.Command, args []string) error {
+	channel, err := selfupdate.ParseChannel(selfUpdateChannel)
+	if err != nil {
+		return err
+	}
+
+	updater := newSelfUpdater()
+	result, err := updater.Check(cmd.Context(), versionInfo.Version, channel)
+	if err != nil {
+		return fmt.Errorf("failed to check for updates: %w", err)
+	}
+
+	if selfUpdateCheck || !result.UpdateAvailable {
+		return printSelfUpdateCheck(result)
+	}
+
+	if outputJSON && !selfUpdateYes {
+		return fmt.Errorf("update to %s available; re-run with --yes to install it non-interactively", result.Latest)
+	}
+
+	printInfo(fmt.Sprintf("Update available: %s → %s", result.Current, result.Latest))
+	if !selfUpdateYes && !confirmSelfUpdate(result.Latest) {
+		printInfo("Update canceled")
+		return nil
+	}
+
+	exePath, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+
+	applied, err := updater.Apply(cmd.Context(), result.Release, exePath)
+	if err != nil {
+		if errors.Is(err, selfupdate.ErrPermission) {
+			return fmt.Errorf("%w\n\nThe relicta binary at %s is not writable by the current user. Try:\n  sudo relicta self-update", err, exePath)
+		}
+		return fmt.Errorf("update failed: %w", err)
+	}
+
+	if !applied.SignatureVerified {
+		printWarning("Signature not verified (install cosign to verify release signatures); checksum verified")
+	}
+	printSuccess(fmt.Sprintf("Updated relicta %s → %s", result.Current, result.Latest))
+	return nil
+}
+
+// printSelfUpdateCheck reports the result of an update check.
+func printSelfUpdateCheck(result *selfupdate.CheckResult) error {
+	if outputJSON {
+		return printStructuredOutput(result)
+	}
+
+	if !result.UpdateAvailable {
+		printSuccess(fmt.Sprintf("relicta %s is up to date (latest %s release: %s)", result.Current, result.Channel, result.Latest))
+		return nil
+	}
+
+	printInfo(fmt.Sprintf("Update available: %s → %s", result.Current, result.Latest))
+	if result.ReleaseURL != "" {
+		printSubtle("  " + result.ReleaseURL)
+	}
+	fmt.Println("  Run 'relicta self-update' to install it.")
+	return nil
+}
+
+// confirmSelfUpdate asks the user to confirm installing the update.
+func confirmSelfUpdate(latest string) bool {
+	fmt.Printf("Install relicta %s? [y/N]: ", latest)
+	var response string
+	if _, err := fmt.Scanln(&response); err != nil {
+		return false // Treat as "no"
+	}
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
+
+// currentExecutable returns the resolved path of the running binary.
+func currentExecutable() (string, error) {
+	exePath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the relicta executable: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exePath); err == nil {
+		exePath = resolved
+	}
+	return exePath, nil
+}
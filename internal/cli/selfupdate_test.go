@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/selfupdate"
+)
+
+func TestRunSelfUpdate_CheckOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"tag_name": "v9.9.9"}})
+	}))
+	defer srv.Close()
+
+	oldUpdater, oldVersion := newSelfUpdater, versionInfo.Version
+	oldCheck, oldChannel, oldJSON := selfUpdateCheck, selfUpdateChannel, outputJSON
+	defer func() {
+		newSelfUpdater, versionInfo.Version = oldUpdater, oldVersion
+		selfUpdateCheck, selfUpdateChannel, outputJSON = oldCheck, oldChannel, oldJSON
+	}()
+
+	newSelfUpdater = func() *selfupdate.Updater {
+		return selfupdate.NewUpdater(selfupdate.WithAPIURL(srv.URL))
+	}
+	versionInfo.Version = "v1.0.0"
+	selfUpdateCheck = true
+	selfUpdateChannel = "stable"
+	outputJSON = true
+
+	selfUpdateCmd.SetContext(context.Background())
+	if err := runSelfUpdate(selfUpdateCmd, nil); err != nil {
+		t.Fatalf("runSelfUpdate(--check) error = %v", err)
+	}
+
+	selfUpdateChannel = "nightly"
+	if err := runSelfUpdate(selfUpdateCmd, nil); err == nil {
+		t.Error("expected error for invalid channel")
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/relicta-tech/relicta/internal/container"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	"github.com/relicta-tech/relicta/internal/httpserver"
+	"github.com/relicta-tech/relicta/internal/observability"
 )
 
 var (
@@ -122,6 +125,29 @@ func runServe(cmd *cobra.Command, args []string) error {
 			"hint", "Use --api-key flag or configure api_keys in release.config.yaml")
 	}
 
+	// Record release transitions made through the dashboard as metrics,
+	// exported on the configured metrics port and endpoint
+	var metricsHandler http.Handler
+	if cfg.Telemetry.Metrics.Enabled {
+		metrics := observability.InitGlobal(versionInfo.Version)
+		release.SetTransitionObserver(metrics)
+		defer release.SetTransitionObserver(nil)
+		metricsHandler = metrics.Handler()
+
+		metricsAddr := fmt.Sprintf(":%d", cfg.Telemetry.Metrics.Port)
+		metricsServer := newMetricsServer(metricsAddr, metricsEndpointPath(), metricsHandler)
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Warn("Metrics server stopped", "address", metricsAddr, "error", err)
+			}
+		}()
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = metricsServer.Shutdown(shutdownCtx)
+		}()
+	}
+
 	// Initialize application container
 	var releaseServices *release.Services
 	app, err := initializeAppContainer(ctx, cfg)
@@ -140,6 +166,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 		Config:          dashboardCfg,
 		Frontend:        frontend,
 		ReleaseServices: releaseServices,
+		Metrics:         metricsHandler,
 	})
 
 	// Wire up WebSocket event broadcasting
@@ -172,6 +199,9 @@ func runServe(cmd *cobra.Command, args []string) error {
 	if frontend != nil {
 		fmt.Printf("  Dashboard:  http://%s/\n", resolveDisplayAddress(address))
 	}
+	if metricsHandler != nil {
+		fmt.Printf("  Metrics:    http://%s%s\n", resolveDisplayAddress(fmt.Sprintf(":%d", cfg.Telemetry.Metrics.Port)), metricsEndpointPath())
+	}
 	fmt.Println()
 
 	// Start server (blocks until context is canceled)
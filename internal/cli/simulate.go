@@ -0,0 +1,313 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
+)
+
+// simulateActor is recorded as the actor of simulated transitions.
+const simulateActor = "simulate"
+
+// Approval paths reported by a simulation.
+const (
+	approvalPathAuto    = "auto"
+	approvalPathManual  = "manual"
+	approvalPathBlocked = "blocked"
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate the release state machine for the current commits",
+	Long: `Walk a hypothetical release run through plan, bump, notes, evaluate,
+approve and publish in memory and print each state transition.
+
+The simulation uses the current commits to compute the next version and,
+when governance is enabled, the risk decision that determines whether the
+release would be auto-approved or need manual approval. Nothing is
+persisted: no release run is saved, no tag is created and no plugin runs.
+Unlike publish --dry-run, simulate does not require an existing release run.
+
+Examples:
+  # Show the transitions a release would go through
+  relicta simulate
+
+  # Output as JSON
+  relicta simulate --json`,
+	Args: cobra.NoArgs,
+	RunE: runSimulate,
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+}
+
+// SimulationTransition is one step of a simulated release run.
+type SimulationTransition struct {
+	Command string `json:"command"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Event   string `json:"event"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// SimulationResult is the outcome of a simulated release run.
+type SimulationResult struct {
+	CurrentVersion string                 `json:"current_version"`
+	NextVersion    string                 `json:"next_version"`
+	ReleaseType    string                 `json:"release_type"`
+	Commits        int                    `json:"commits"`
+	RiskScore      *float64               `json:"risk_score,omitempty"`
+	Decision       string                 `json:"decision,omitempty"`
+	ApprovalPath   string                 `json:"approval_path"`
+	ApprovalReason string                 `json:"approval_reason"`
+	Transitions    []SimulationTransition `json:"transitions"`
+	FinalState     string                 `json:"final_state"`
+}
+
+// releaseEvaluator evaluates a release against governance rules.
+type releaseEvaluator interface {
+	EvaluateRelease(ctx context.Context, input governance.EvaluateReleaseInput) (*governance.EvaluateReleaseOutput, error)
+}
+
+// simulationOptions configures simulateRelease.
+type simulationOptions struct {
+	TagPrefix       string
+	RequireApproval bool
+	StrictMode      bool
+	Plugins         []string
+	Repository      string
+	Evaluator       releaseEvaluator
+}
+
+func runSimulate(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	currentVersion, err := fileCurrentVersion(repoInfo.Path)
+	if err != nil {
+		return err
+	}
+
+	output, err := app.ReleaseAnalyzer().Analyze(ctx, servicerelease.AnalyzeInput{
+		RepositoryPath: repoInfo.Path,
+		Branch:         repoInfo.CurrentBranch,
+		TagPrefix:      cfg.Versioning.TagPrefix,
+		CurrentVersion: currentVersion,
+
+		NonConventionalPolicy: servicerelease.NonConventionalPolicy(cfg.Versioning.NonConventionalPolicy),
+		CommitParser:          configCommitParser(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to analyze commits: %w", err)
+	}
+
+	opts := simulationOptions{
+		TagPrefix:       cfg.Versioning.TagPrefix,
+		RequireApproval: cfg.Workflow.RequireApproval,
+		StrictMode:      cfg.Governance.StrictMode,
+		Repository:      repoInfo.RemoteURL,
+	}
+	for _, p := range cfg.Plugins {
+		if p.IsEnabled() {
+			opts.Plugins = append(opts.Plugins, p.Name)
+		}
+	}
+	if app.HasGovernance() {
+		if svc := app.GovernanceService(); svc != nil {
+			opts.Evaluator = svc
+		}
+	}
+
+	result, err := simulateRelease(ctx, output, opts)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		return printStructuredOutput(result)
+	}
+	printSimulation(result)
+	return nil
+}
+
+// simulateRelease walks an in-memory release run through the workflow
+// states and records each transition. It has no side effects.
+func simulateRelease(ctx context.Context, output *servicerelease.AnalyzeOutput, opts simulationOptions) (*SimulationResult, error) {
+	rel := release.NewReleaseRun("", "", output.Branch, "", nil, "", "")
+	result := &SimulationResult{
+		CurrentVersion: output.CurrentVersion.String(),
+		NextVersion:    output.NextVersion.String(),
+		ReleaseType:    string(output.ReleaseType),
+	}
+	if output.ChangeSet != nil {
+		result.Commits = output.ChangeSet.CommitCount()
+	}
+
+	// record appends the transition made by the last successful state change.
+	record := func(command, detail string) {
+		history := rel.History()
+		last := history[len(history)-1]
+		result.Transitions = append(result.Transitions, SimulationTransition{
+			Command: command,
+			From:    string(last.From),
+			To:      string(last.To),
+			Event:   last.Event,
+			Detail:  detail,
+		})
+	}
+
+	plan := release.NewReleasePlan(output.CurrentVersion, output.NextVersion, output.ReleaseType, output.ChangeSet, true)
+	if err := release.SetPlan(rel, plan); err != nil {
+		return nil, fmt.Errorf("simulated plan failed: %w", err)
+	}
+	record("plan", fmt.Sprintf("%d commits, %s release", result.Commits, output.ReleaseType))
+
+	tagName := opts.TagPrefix + output.NextVersion.String()
+	if err := rel.SetVersion(output.NextVersion, tagName); err != nil {
+		return nil, fmt.Errorf("simulated bump failed: %w", err)
+	}
+	if err := rel.Bump(simulateActor); err != nil {
+		return nil, fmt.Errorf("simulated bump failed: %w", err)
+	}
+	record("bump", fmt.Sprintf("%s -> %s (tag %s)", result.CurrentVersion, result.NextVersion, tagName))
+
+	if err := rel.GenerateNotes(&release.ReleaseNotes{Text: "(simulated)"}, "", simulateActor); err != nil {
+		return nil, fmt.Errorf("simulated notes failed: %w", err)
+	}
+	record("notes", "release notes generated")
+
+	// Evaluation does not change the run state; it decides the approval path.
+	result.ApprovalPath, result.ApprovalReason = approvalPathWithoutGovernance(opts.RequireApproval)
+	evalDetail := "governance disabled"
+	if opts.Evaluator != nil {
+		eval, err := opts.Evaluator.EvaluateRelease(ctx, governance.EvaluateReleaseInput{
+			Release:    rel,
+			Actor:      createCGPActorForPlan(),
+			Repository: opts.Repository,
+		})
+		if err != nil {
+			evalDetail = fmt.Sprintf("governance evaluation failed: %v", err)
+		} else {
+			score := eval.RiskScore
+			result.RiskScore = &score
+			result.Decision = string(eval.Decision)
+			rel.SetPolicyEvaluation(eval.RiskScore, eval.Rationale, release.PolicyThresholds{})
+			result.ApprovalPath, result.ApprovalReason = approvalPathFromGovernance(eval, opts.StrictMode)
+			evalDetail = fmt.Sprintf("risk %.0f%% (%s), decision %s", eval.RiskScore*100, eval.Severity, eval.Decision)
+		}
+	}
+	state := string(rel.State())
+	result.Transitions = append(result.Transitions, SimulationTransition{
+		Command: "evaluate",
+		From:    state,
+		To:      state,
+		Event:   "EVALUATE",
+		Detail:  evalDetail,
+	})
+
+	if result.ApprovalPath == approvalPathBlocked {
+		if err := rel.Reject(result.ApprovalReason, simulateActor); err != nil {
+			return nil, fmt.Errorf("simulated rejection failed: %w", err)
+		}
+		record("approve", "blocked by governance")
+		result.FinalState = string(rel.State())
+		return result, nil
+	}
+
+	if err := rel.Approve(simulateActor, result.ApprovalPath == approvalPathAuto); err != nil {
+		return nil, fmt.Errorf("simulated approval failed: %w", err)
+	}
+	record("approve", result.ApprovalPath+" approval")
+
+	if err := rel.StartPublishing(simulateActor); err != nil {
+		return nil, fmt.Errorf("simulated publish failed: %w", err)
+	}
+	actions := []string{"create tag " + tagName}
+	if len(opts.Plugins) > 0 {
+		actions = append(actions, "run plugins "+strings.Join(opts.Plugins, ", "))
+	}
+	record("publish", "would "+strings.Join(actions, " and "))
+
+	if err := rel.MarkPublished(simulateActor); err != nil {
+		return nil, fmt.Errorf("simulated publish failed: %w", err)
+	}
+	record("publish", "release published")
+
+	result.FinalState = string(rel.State())
+	return result, nil
+}
+
+// approvalPathWithoutGovernance returns the approval path when governance
+// does not evaluate the release.
+func approvalPathWithoutGovernance(requireApproval bool) (string, string) {
+	if requireApproval {
+		return approvalPathManual, "workflow.require_approval is enabled"
+	}
+	return approvalPathAuto, "workflow.require_approval is disabled"
+}
+
+// approvalPathFromGovernance returns the approval path for a governance
+// decision, mirroring approve: low-risk releases can be auto-approved and
+// rejected releases are blocked in strict mode.
+func approvalPathFromGovernance(eval *governance.EvaluateReleaseOutput, strict bool) (string, string) {
+	switch {
+	case strict && eval.Decision == cgp.DecisionRejected:
+		reason := "rejected by governance"
+		if len(eval.Rationale) > 0 {
+			reason += ": " + strings.Join(eval.Rationale, "; ")
+		}
+		return approvalPathBlocked, reason
+	case eval.CanAutoApprove:
+		return approvalPathAuto, "low risk, governance allows auto-approval"
+	default:
+		reason := fmt.Sprintf("governance decision %s requires human review", eval.Decision)
+		if len(eval.RequiredActions) > 0 {
+			reason += ": " + eval.RequiredActions[0].Description
+		}
+		return approvalPathManual, reason
+	}
+}
+
+// printSimulation prints the simulated transitions.
+func printSimulation(result *SimulationResult) {
+	printTitle("Release Simulation")
+	fmt.Println()
+	printSubtle("Nothing is persisted and no side effects are executed.")
+	fmt.Println()
+
+	fmt.Printf("  Version:   %s -> %s (%s)\n", result.CurrentVersion, result.NextVersion, result.ReleaseType)
+	if result.RiskScore != nil {
+		fmt.Printf("  Risk:      %.0f%%, decision %s\n", *result.RiskScore*100, result.Decision)
+	}
+	fmt.Printf("  Approval:  %s (%s)\n", result.ApprovalPath, result.ApprovalReason)
+	fmt.Println()
+
+	fmt.Println("  Transitions:")
+	for i, t := range result.Transitions {
+		fmt.Printf("    %d. %-9s %s -> %s [%s] %s\n", i+1, t.Command, t.From, t.To, t.Event, t.Detail)
+	}
+	fmt.Println()
+
+	if result.ApprovalPath == approvalPathBlocked {
+		printWarning(fmt.Sprintf("Simulation stopped in %s: release would be blocked", result.FinalState))
+		return
+	}
+	printSuccess(fmt.Sprintf("Simulation reached %s", result.FinalState))
+}
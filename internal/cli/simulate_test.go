@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/application/governance"
+	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
+)
+
+type stubReleaseEvaluator struct {
+	output *governance.EvaluateReleaseOutput
+}
+
+func (s stubReleaseEvaluator) EvaluateRelease(context.Context, governance.EvaluateReleaseInput) (*governance.EvaluateReleaseOutput, error) {
+	return s.output, nil
+}
+
+func simulateTestOutput() *servicerelease.AnalyzeOutput {
+	cs := changes.NewChangeSet("cs-sim", "v1.2.0", "HEAD")
+	cs.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "add export"))
+	return &servicerelease.AnalyzeOutput{
+		CurrentVersion: version.MustParse("1.2.0"),
+		NextVersion:    version.MustParse("1.3.0"),
+		ReleaseType:    changes.ReleaseTypeMinor,
+		ChangeSet:      cs,
+		Branch:         "main",
+	}
+}
+
+func transitionSequence(result *SimulationResult) string {
+	steps := make([]string, len(result.Transitions))
+	for i, tr := range result.Transitions {
+		steps[i] = tr.From + ">" + tr.To
+	}
+	return strings.Join(steps, " ")
+}
+
+func TestSimulateRelease_LowRisk(t *testing.T) {
+	opts := simulationOptions{
+		TagPrefix:       "v",
+		RequireApproval: true,
+		Plugins:         []string{"github"},
+		Evaluator: stubReleaseEvaluator{output: &governance.EvaluateReleaseOutput{
+			Decision:       cgp.DecisionApproved,
+			RiskScore:      0.1,
+			Severity:       cgp.SeverityLow,
+			CanAutoApprove: true,
+		}},
+	}
+
+	result, err := simulateRelease(context.Background(), simulateTestOutput(), opts)
+	if err != nil {
+		t.Fatalf("simulateRelease() error = %v", err)
+	}
+
+	want := "draft>planned planned>versioned versioned>notes_ready notes_ready>notes_ready notes_ready>approved approved>publishing publishing>published"
+	if got := transitionSequence(result); got != want {
+		t.Errorf("transitions = %s, want %s", got, want)
+	}
+	if result.NextVersion != "1.3.0" || result.FinalState != "published" {
+		t.Errorf("NextVersion = %s, FinalState = %s", result.NextVersion, result.FinalState)
+	}
+	if result.ApprovalPath != approvalPathAuto || result.Decision != "approved" {
+		t.Errorf("ApprovalPath = %s, Decision = %s, want auto/approved", result.ApprovalPath, result.Decision)
+	}
+	if detail := result.Transitions[5].Detail; !strings.Contains(detail, "create tag v1.3.0") || !strings.Contains(detail, "github") {
+		t.Errorf("publish detail = %q", detail)
+	}
+}
+
+func TestSimulateRelease_HighRisk(t *testing.T) {
+	highRisk := &governance.EvaluateReleaseOutput{
+		Decision:        cgp.DecisionApprovalRequired,
+		RiskScore:       0.75,
+		Severity:        cgp.SeverityHigh,
+		RequiredActions: []cgp.RequiredAction{{Description: "security review"}},
+	}
+
+	result, err := simulateRelease(context.Background(), simulateTestOutput(), simulationOptions{Evaluator: stubReleaseEvaluator{output: highRisk}})
+	if err != nil {
+		t.Fatalf("simulateRelease() error = %v", err)
+	}
+	want := "draft>planned planned>versioned versioned>notes_ready notes_ready>notes_ready notes_ready>approved approved>publishing publishing>published"
+	if got := transitionSequence(result); got != want {
+		t.Errorf("transitions = %s, want %s", got, want)
+	}
+	if result.ApprovalPath != approvalPathManual || !strings.Contains(result.ApprovalReason, "security review") {
+		t.Errorf("ApprovalPath = %s (%s), want manual", result.ApprovalPath, result.ApprovalReason)
+	}
+	if result.RiskScore == nil || *result.RiskScore != 0.75 {
+		t.Errorf("RiskScore = %v, want 0.75", result.RiskScore)
+	}
+
+	highRisk.Decision = cgp.DecisionRejected
+	highRisk.Rationale = []string{"risk exceeds threshold"}
+	result, err = simulateRelease(context.Background(), simulateTestOutput(), simulationOptions{StrictMode: true, Evaluator: stubReleaseEvaluator{output: highRisk}})
+	if err != nil {
+		t.Fatalf("simulateRelease() error = %v", err)
+	}
+	want = "draft>planned planned>versioned versioned>notes_ready notes_ready>notes_ready notes_ready>changes_requested"
+	if got := transitionSequence(result); got != want {
+		t.Errorf("strict transitions = %s, want %s", got, want)
+	}
+	if result.ApprovalPath != approvalPathBlocked || result.FinalState != "changes_requested" {
+		t.Errorf("ApprovalPath = %s, FinalState = %s, want blocked/changes_requested", result.ApprovalPath, result.FinalState)
+	}
+}
+
+func TestSimulateRelease_WithoutGovernance(t *testing.T) {
+	result, err := simulateRelease(context.Background(), simulateTestOutput(), simulationOptions{RequireApproval: false})
+	if err != nil {
+		t.Fatalf("simulateRelease() error = %v", err)
+	}
+	if result.ApprovalPath != approvalPathAuto || result.RiskScore != nil {
+		t.Errorf("ApprovalPath = %s, RiskScore = %v", result.ApprovalPath, result.RiskScore)
+	}
+
+	out := captureOutput(t, func() { printSimulation(result) })
+	if !strings.Contains(out, "notes_ready -> approved") || !strings.Contains(out, "Simulation reached published") {
+		t.Errorf("printSimulation() output = %s", out)
+	}
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+var (
+	// heldStateLockMu guards heldStateLock.
+	heldStateLockMu sync.Mutex
+	// heldStateLock releases the state lock held by the running command, if any.
+	heldStateLock func()
+)
+
+func init() {
+	// Commands that mutate the release state under .relicta/ hold the state
+	// lock while they run, so that concurrent invocations (e.g. CI and a
+	// developer) cannot interleave their writes.
+	for _, cmd := range []*cobra.Command{
+		planCmd, bumpCmd, notesCmd, approveCmd, publishCmd, releaseCmd,
+		cancelCmd, resetCmd, rollbackCmd, freezeCmd, cleanCmd,
+	} {
+		cmd.RunE = withStateLock(cmd.RunE)
+	}
+}
+
+// withStateLock wraps run so that it holds the state lock of the repository
+// containing the working directory. Invocations that only read state run
+// unlocked.
+func withStateLock(run func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if readsStateOnly(cmd, args) {
+			return run(cmd, args)
+		}
+
+		release, err := persistence.NewStateLock(stateLockRoot(cmd.Context()), 0).Acquire(cmd.CommandPath())
+		if err != nil {
+			return err
+		}
+		heldStateLockMu.Lock()
+		heldStateLock = release
+		heldStateLockMu.Unlock()
+		defer releaseStateLock()
+
+		return run(cmd, args)
+	}
+}
+
+// stateLockRoot returns the top-level directory of the repository containing
+// the working directory, so that commands run from a subdirectory take the
+// same lock as the MCP server. Outside a repository it is the working
+// directory.
+func stateLockRoot(ctx context.Context) string {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	out, err := runGit(ctx, ".", "", "rev-parse", "--show-toplevel")
+	if root := strings.TrimSpace(out); err == nil && root != "" {
+		return root
+	}
+	return "."
+}
+
+// readsStateOnly reports whether an invocation of a state-mutating command
+// leaves the state untouched.
+func readsStateOnly(cmd *cobra.Command, args []string) bool {
+	switch {
+	case dryRun:
+		return true
+	case cmd == planCmd:
+		return planDry
+	case cmd == freezeCmd:
+		return len(args) == 0
+	case cmd == cleanCmd:
+		return cleanDryRunFlag
+	}
+	return false
+}
+
+// releaseStateLock releases the state lock held by the running command. It
+// is called when the command returns and from Cleanup, so that a forced
+// shutdown does not leave the lock behind.
+func releaseStateLock() {
+	heldStateLockMu.Lock()
+	release := heldStateLock
+	heldStateLock = nil
+	heldStateLockMu.Unlock()
+
+	if release != nil {
+		release()
+	}
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+func TestWithStateLock(t *testing.T) {
+	t.Chdir(t.TempDir())
+	origDryRun := dryRun
+	t.Cleanup(func() { dryRun = origDryRun })
+	dryRun = false
+
+	var locked bool
+	run := withStateLock(func(cmd *cobra.Command, args []string) error {
+		_, err := persistence.NewStateLock(".", 0).Acquire("relicta test")
+		locked = errors.Is(err, persistence.ErrStateLocked)
+		return nil
+	})
+
+	if err := run(bumpCmd, nil); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if !locked {
+		t.Error("state lock was not held while the command ran")
+	}
+
+	// The lock is released when the command returns.
+	release, err := persistence.NewStateLock(".", 0).Acquire("relicta test")
+	if err != nil {
+		t.Fatalf("Acquire() after command error = %v", err)
+	}
+
+	if err := run(bumpCmd, nil); !errors.Is(err, persistence.ErrStateLocked) {
+		t.Errorf("run() while locked error = %v, want ErrStateLocked", err)
+	}
+
+	// Read-only invocations do not need the lock.
+	if err := run(freezeCmd, nil); err != nil {
+		t.Errorf("run() of freeze without args while locked error = %v", err)
+	}
+	dryRun = true
+	if err := run(bumpCmd, nil); err != nil {
+		t.Errorf("run() with --dry-run while locked error = %v", err)
+	}
+	release()
+}
+
+func TestStateLockRoot(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	if got := stateLockRoot(context.Background()); got != "." {
+		t.Errorf("stateLockRoot() outside a repository = %q, want .", got)
+	}
+
+	if out, err := runGit(context.Background(), dir, "", "init", "-q"); err != nil {
+		t.Skipf("git unavailable: %v: %s", err, out)
+	}
+	sub := filepath.Join(dir, "pkg", "api")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(sub)
+
+	want, _ := filepath.EvalSymlinks(dir)
+	got, _ := filepath.EvalSymlinks(stateLockRoot(context.Background()))
+	if got != want {
+		t.Errorf("stateLockRoot() in subdirectory = %q, want %q", got, want)
+	}
+}
@@ -5,12 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
 )
 
 var statusCmd = &cobra.Command{
@@ -23,34 +23,65 @@ This command shows:
   - Current state in the release workflow
   - Version being released
   - Risk assessment (if available)
+  - Active release freeze (see 'relicta freeze')
 
 Examples:
   # Check current release status
   relicta status
 
   # Output as JSON
-  relicta status --json`,
+  relicta status --json
+
+  # Follow a long publish until the release finishes
+  relicta status --watch --interval 5s`,
 	RunE: runStatus,
 }
 
+var (
+	statusWatch    bool
+	statusInterval time.Duration
+)
+
 func init() {
+	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "poll and redraw the status until the release finishes")
+	statusCmd.Flags().DurationVar(&statusInterval, "interval", 2*time.Second, "polling interval for --watch")
 	rootCmd.AddCommand(statusCmd)
 }
 
 // StatusOutput represents the status command output.
 type StatusOutput struct {
-	HasActiveRelease bool       `json:"has_active_release"`
-	ReleaseID        string     `json:"release_id,omitempty"`
-	State            string     `json:"state,omitempty"`
-	CurrentVersion   string     `json:"current_version,omitempty"`
-	NextVersion      string     `json:"next_version,omitempty"`
-	BumpKind         string     `json:"bump_kind,omitempty"`
-	RiskScore        float64    `json:"risk_score,omitempty"`
-	CreatedAt        *time.Time `json:"created_at,omitempty"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
-	CommitCount      int        `json:"commit_count,omitempty"`
-	Message          string     `json:"message,omitempty"`
-	NextSteps        []string   `json:"next_steps,omitempty"`
+	HasActiveRelease bool             `json:"has_active_release"`
+	Profile          string           `json:"profile,omitempty"`
+	ReleaseID        string           `json:"release_id,omitempty"`
+	State            string           `json:"state,omitempty"`
+	CurrentVersion   string           `json:"current_version,omitempty"`
+	NextVersion      string           `json:"next_version,omitempty"`
+	BumpKind         string           `json:"bump_kind,omitempty"`
+	RiskScore        float64          `json:"risk_score,omitempty"`
+	CreatedAt        *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty"`
+	CommitCount      int              `json:"commit_count,omitempty"`
+	Message          string           `json:"message,omitempty"`
+	NextAction       string           `json:"next_action,omitempty"`
+	NextSteps        []string         `json:"next_steps,omitempty"`
+	Freeze           *FreezeOutput    `json:"freeze,omitempty"`
+	Rejection        *RejectionOutput `json:"rejection,omitempty"`
+	Steps            []StepOutput     `json:"steps,omitempty"`
+}
+
+// StepOutput describes the progress of a publish step.
+type StepOutput struct {
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Attempts int    `json:"attempts,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// RejectionOutput describes the most recent rejection of a release.
+type RejectionOutput struct {
+	RejectedBy string    `json:"rejected_by"`
+	RejectedAt time.Time `json:"rejected_at"`
+	Reason     string    `json:"reason"`
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
@@ -75,45 +106,189 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize release services: %w", err)
 	}
 
+	if statusWatch {
+		return watchStatus(ctx, app, repoInfo.Path, statusInterval)
+	}
+
+	output := buildStatusOutput(ctx, app, repoInfo.Path)
+	if outputJSON {
+		return outputStatusJSON(output)
+	}
+	return outputStatusText(output)
+}
+
+// buildStatusOutput reads the release freeze and the latest release run of
+// the repository. It only reads state.
+func buildStatusOutput(ctx context.Context, app cliApp, repoPath string) *StatusOutput {
 	output := &StatusOutput{}
+	if cfg != nil {
+		output.Profile = cfg.Profile
+	}
+
+	freeze, err := persistence.NewFreezeStore(repoPath).Load()
+	if err != nil {
+		printWarning(fmt.Sprintf("Failed to read release freeze: %v", err))
+	} else if freeze != nil {
+		output.Freeze = freezeOutput(freeze)
+	}
 
 	// Try to load the latest release run
-	run, err := loadLatestReleaseRun(ctx, app, repoInfo.Path)
+	run, err := loadLatestReleaseRun(ctx, app, repoPath)
 	if err != nil {
 		// No active release
 		output.HasActiveRelease = false
 		output.Message = "No active release found. Run 'relicta plan' to start a new release."
+		output.NextAction = "plan release"
 		output.NextSteps = []string{"relicta plan"}
-	} else {
-		output.HasActiveRelease = true
-		output.ReleaseID = string(run.ID())
-		output.State = string(run.State())
-
-		vCurrent := run.VersionCurrent()
-		vNext := run.VersionNext()
-		if !vCurrent.IsZero() {
-			output.CurrentVersion = vCurrent.String()
+		return output
+	}
+
+	output.HasActiveRelease = true
+	output.ReleaseID = string(run.ID())
+	output.State = string(run.State())
+
+	vCurrent := run.VersionCurrent()
+	vNext := run.VersionNext()
+	if !vCurrent.IsZero() {
+		output.CurrentVersion = vCurrent.String()
+	}
+	if !vNext.IsZero() {
+		output.NextVersion = vNext.String()
+	}
+	output.BumpKind = string(run.BumpKind())
+	output.RiskScore = run.RiskScore()
+	output.CommitCount = len(run.Commits())
+
+	createdAt := run.CreatedAt()
+	updatedAt := run.UpdatedAt()
+	output.CreatedAt = &createdAt
+	output.UpdatedAt = &updatedAt
+
+	action, command := run.NextAction()
+	output.NextAction = action
+	if command != "" {
+		output.NextSteps = []string{command}
+	}
+	output.Message = getStateMessage(run.State())
+
+	if rejection := run.LastRejection(); rejection != nil {
+		output.Rejection = &RejectionOutput{
+			RejectedBy: rejection.RejectedBy,
+			RejectedAt: rejection.RejectedAt,
+			Reason:     rejection.Reason,
 		}
-		if !vNext.IsZero() {
-			output.NextVersion = vNext.String()
+	}
+
+	output.Steps = stepOutputs(run)
+	return output
+}
+
+// stepOutputs returns the progress of the run's publish steps in plan order.
+func stepOutputs(run *domain.ReleaseRun) []StepOutput {
+	statuses := run.AllStepStatuses()
+	steps := make([]StepOutput, 0, len(run.Steps()))
+	for _, step := range run.Steps() {
+		out := StepOutput{Name: step.Name, State: string(domain.StepPending)}
+		if status := statuses[step.Name]; status != nil {
+			out.State = string(status.State)
+			out.Attempts = status.Attempts
+			out.Error = status.LastError
 		}
-		output.BumpKind = string(run.BumpKind())
-		output.RiskScore = run.RiskScore()
-		output.CommitCount = len(run.Commits())
+		steps = append(steps, out)
+	}
+	return steps
+}
+
+// watchStatus polls the release state every interval and redraws the status
+// whenever it changes, until the release finishes or ctx is
+// canceled. It never modifies the release.
+func watchStatus(ctx context.Context, app cliApp, repoPath string, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", interval)
+	}
 
-		createdAt := run.CreatedAt()
-		updatedAt := run.UpdatedAt()
-		output.CreatedAt = &createdAt
-		output.UpdatedAt = &updatedAt
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-		output.NextSteps = getNextSteps(run.State())
-		output.Message = getStateMessage(run.State())
+	var last string
+	for {
+		output := buildStatusOutput(ctx, app, repoPath)
+		if err := renderWatchedStatus(output, &last, interval); err != nil {
+			return err
+		}
+		if !output.HasActiveRelease || domain.RunState(output.State).IsFinished() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
 	}
+}
 
-	if outputJSON {
-		return outputStatusJSON(output)
+// renderWatchedStatus prints output if it differs from the last rendered
+// status, recorded in last. JSON output is written as one object per line
+// and YAML output as one document per change; quiet output prints a single summary line; otherwise the full status is
+// redrawn, clearing the screen when colors are enabled.
+func renderWatchedStatus(output *StatusOutput, last *string, interval time.Duration) error {
+	key, err := json.Marshal(output)
+	if err != nil {
+		return err
 	}
-	return outputStatusText(output)
+	if string(key) == *last {
+		return nil
+	}
+	first := *last == ""
+	*last = string(key)
+
+	switch {
+	case outputJSON && outputFormat == outputFormatYAML:
+		fmt.Println("---")
+		return printStructuredOutput(output)
+	case outputJSON:
+		_, err := fmt.Println(string(key))
+		return err
+	case cfg != nil && cfg.Output.Quiet:
+		fmt.Println(formatStatusLine(output))
+		return nil
+	}
+
+	if cfg != nil && cfg.Output.Color && !noColor {
+		fmt.Print("\033[H\033[2J")
+	} else if !first {
+		fmt.Println()
+	}
+	if err := outputStatusText(output); err != nil {
+		return err
+	}
+	if output.HasActiveRelease && !domain.RunState(output.State).IsFinished() {
+		fmt.Println()
+		printSubtle(fmt.Sprintf("Refreshing every %s, press Ctrl+C to stop", interval))
+	}
+	return nil
+}
+
+// formatStatusLine summarizes the status on one line for quiet watch output.
+func formatStatusLine(output *StatusOutput) string {
+	if !output.HasActiveRelease {
+		return "no active release"
+	}
+	line := fmt.Sprintf("%s %s", time.Now().Format(time.TimeOnly), output.State)
+	if output.NextVersion != "" {
+		line += " " + output.NextVersion
+	}
+	if len(output.Steps) > 0 {
+		done := 0
+		for _, step := range output.Steps {
+			if step.State == string(domain.StepDone) || step.State == string(domain.StepSkipped) {
+				done++
+			}
+		}
+		line += fmt.Sprintf(" steps %d/%d", done, len(output.Steps))
+	}
+	return line
 }
 
 func loadLatestReleaseRun(ctx context.Context, app cliApp, repoRoot string) (*domain.ReleaseRun, error) {
@@ -128,31 +303,6 @@ func loadLatestReleaseRun(ctx context.Context, app cliApp, repoRoot string) (*do
 	return services.Repository.LoadLatest(ctx, repoRoot)
 }
 
-func getNextSteps(state domain.RunState) []string {
-	switch state {
-	case domain.StateDraft:
-		return []string{"relicta plan"}
-	case domain.StatePlanned:
-		return []string{"relicta bump"}
-	case domain.StateVersioned:
-		return []string{"relicta notes"}
-	case domain.StateNotesReady:
-		return []string{"relicta approve"}
-	case domain.StateApproved:
-		return []string{"relicta publish"}
-	case domain.StatePublishing:
-		return []string{"Wait for publish to complete or run 'relicta publish' to retry"}
-	case domain.StatePublished:
-		return []string{"Release complete! Run 'relicta plan' for next release"}
-	case domain.StateFailed:
-		return []string{"relicta reset", "Then run 'relicta plan' to start over"}
-	case domain.StateCanceled:
-		return []string{"relicta reset", "Then run 'relicta plan' to start over"}
-	default:
-		return []string{"relicta status"}
-	}
-}
-
 func getStateMessage(state domain.RunState) string {
 	switch state {
 	case domain.StateDraft:
@@ -163,6 +313,8 @@ func getStateMessage(state domain.RunState) string {
 		return "Version bumped, ready for release notes"
 	case domain.StateNotesReady:
 		return "Release notes generated, ready for approval"
+	case domain.StateChangesRequested:
+		return "Changes requested, revise the release notes to resubmit them"
 	case domain.StateApproved:
 		return "Release approved, ready to publish"
 	case domain.StatePublishing:
@@ -178,20 +330,31 @@ func getStateMessage(state domain.RunState) string {
 	}
 }
 
+// outputStatusJSON outputs the status as JSON or YAML.
 func outputStatusJSON(output *StatusOutput) error {
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return printStructuredOutput(output)
 }
 
 func outputStatusText(output *StatusOutput) error {
 	printTitle("Release Status")
 	fmt.Println()
 
+	if output.Profile != "" {
+		printInfo(fmt.Sprintf("Config profile: %s", output.Profile))
+		fmt.Println()
+	}
+
+	if output.Freeze != nil {
+		printWarning(fmt.Sprintf("Releases are frozen by %s since %s: %s",
+			output.Freeze.FrozenBy, output.Freeze.FrozenAt.Format(time.RFC3339), output.Freeze.Reason))
+		printSubtle("  Run 'relicta freeze off' to lift the freeze")
+		fmt.Println()
+	}
+
 	if !output.HasActiveRelease {
 		printInfo(output.Message)
 		fmt.Println()
-		fmt.Println("Next step:")
+		fmt.Printf("Next step: %s\n", output.NextAction)
 		for _, step := range output.NextSteps {
 			fmt.Printf("  $ %s\n", step)
 		}
@@ -214,11 +377,36 @@ func outputStatusText(output *StatusOutput) error {
 		fmt.Println()
 	}
 
+	if output.Rejection != nil {
+		label := "Changes requested"
+		if output.State != "changes_requested" {
+			label = "Last rejection"
+		}
+		fmt.Printf("%s by %s at %s:\n", label, output.Rejection.RejectedBy, output.Rejection.RejectedAt.Format(time.RFC3339))
+		fmt.Printf("  %s\n", output.Rejection.Reason)
+		fmt.Println()
+	}
+
 	if output.CommitCount > 0 {
 		fmt.Printf("Changes: %d commit(s)\n", output.CommitCount)
 		fmt.Println()
 	}
 
+	if len(output.Steps) > 0 {
+		fmt.Println("Steps:")
+		for _, step := range output.Steps {
+			line := fmt.Sprintf("  %-9s %s", step.State, step.Name)
+			if step.Attempts > 1 {
+				line += fmt.Sprintf(" (attempt %d)", step.Attempts)
+			}
+			if step.Error != "" {
+				line += ": " + step.Error
+			}
+			fmt.Println(line)
+		}
+		fmt.Println()
+	}
+
 	if output.RiskScore > 0 {
 		fmt.Printf("Risk Score: %.2f\n", output.RiskScore)
 		fmt.Println()
@@ -233,7 +421,7 @@ func outputStatusText(output *StatusOutput) error {
 	fmt.Println()
 
 	// Show next steps
-	fmt.Println("Next step:")
+	fmt.Printf("Next step: %s\n", output.NextAction)
 	for _, step := range output.NextSteps {
 		fmt.Printf("  $ %s\n", step)
 	}
@@ -249,6 +437,8 @@ func formatState(state string) string {
 		return styles.Info.Render("versioned")
 	case "notes_ready":
 		return styles.Info.Render("notes ready")
+	case "changes_requested":
+		return styles.Warning.Render("changes requested")
 	case "approved":
 		return styles.Success.Render("approved")
 	case "publishing":
@@ -2,82 +2,23 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	"github.com/relicta-tech/relicta/internal/domain/release/adapters"
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/version"
 )
 
-func TestGetNextSteps(t *testing.T) {
-	tests := []struct {
-		name     string
-		state    domain.RunState
-		expected []string
-	}{
-		{
-			name:     "draft state",
-			state:    domain.StateDraft,
-			expected: []string{"relicta plan"},
-		},
-		{
-			name:     "planned state",
-			state:    domain.StatePlanned,
-			expected: []string{"relicta bump"},
-		},
-		{
-			name:     "versioned state",
-			state:    domain.StateVersioned,
-			expected: []string{"relicta notes"},
-		},
-		{
-			name:     "notes ready state",
-			state:    domain.StateNotesReady,
-			expected: []string{"relicta approve"},
-		},
-		{
-			name:     "approved state",
-			state:    domain.StateApproved,
-			expected: []string{"relicta publish"},
-		},
-		{
-			name:     "publishing state",
-			state:    domain.StatePublishing,
-			expected: []string{"Wait for publish to complete or run 'relicta publish' to retry"},
-		},
-		{
-			name:     "published state",
-			state:    domain.StatePublished,
-			expected: []string{"Release complete! Run 'relicta plan' for next release"},
-		},
-		{
-			name:     "failed state",
-			state:    domain.StateFailed,
-			expected: []string{"relicta reset", "Then run 'relicta plan' to start over"},
-		},
-		{
-			name:     "canceled state",
-			state:    domain.StateCanceled,
-			expected: []string{"relicta reset", "Then run 'relicta plan' to start over"},
-		},
-		{
-			name:     "unknown state",
-			state:    domain.RunState("unknown"),
-			expected: []string{"relicta status"},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := getNextSteps(tt.state)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestGetStateMessage(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -295,3 +236,125 @@ func TestStatusOutputFields(t *testing.T) {
 	assert.Equal(t, "Ready to publish", output.Message)
 	assert.Equal(t, []string{"relicta publish"}, output.NextSteps)
 }
+
+// statusWatchApp serves release runs from a file repository.
+type statusWatchApp struct {
+	commandTestApp
+	services *release.Services
+}
+
+func (a statusWatchApp) ReleaseServices() *release.Services { return a.services }
+func (a statusWatchApp) HasReleaseServices() bool           { return true }
+
+// newStatusWatchApp saves a run with a publish plan in repoRoot, moved to
+// state, and returns an app reading from the same repository.
+func newStatusWatchApp(t *testing.T, repoRoot string, state domain.RunState) (statusWatchApp, *domain.ReleaseRun) {
+	t.Helper()
+
+	run := domain.NewReleaseRun("repo", repoRoot, "v1.0.0", domain.CommitSHA("abc123def456"), nil, "", "")
+	require.NoError(t, run.SetVersionProposal(version.MustParse("1.0.0"), version.MustParse("1.1.0"), domain.BumpMinor, 0.9))
+	require.NoError(t, run.Plan("test"))
+	require.NoError(t, run.SetVersion(version.MustParse("1.1.0"), "v1.1.0"))
+	require.NoError(t, run.Bump("test"))
+	require.NoError(t, run.GenerateNotes(&domain.ReleaseNotes{Text: "notes"}, "hash", "test"))
+	run.SetExecutionPlan([]domain.StepPlan{
+		{Name: "create-tag", Type: domain.StepTypeTag},
+		{Name: "github", Type: domain.StepTypePlugin},
+	})
+	require.NoError(t, run.Approve("test", false))
+	require.NoError(t, run.StartPublishing("test"))
+	require.NoError(t, run.MarkStepStarted("create-tag"))
+	require.NoError(t, run.MarkStepDone("create-tag", "v1.1.0"))
+	if state == domain.StatePublished {
+		require.NoError(t, run.MarkStepStarted("github"))
+		require.NoError(t, run.MarkStepDone("github", "released"))
+		require.NoError(t, run.MarkPublished("test"))
+	}
+
+	repo := adapters.NewFileReleaseRunRepository()
+	require.NoError(t, repo.Save(context.Background(), run))
+	require.NoError(t, repo.SetLatest(context.Background(), repoRoot, run.ID()))
+
+	return statusWatchApp{services: &release.Services{Repository: repo}}, run
+}
+
+func TestWatchStatus_ExitsOnTerminalState(t *testing.T) {
+	repoRoot := t.TempDir()
+	app, run := newStatusWatchApp(t, repoRoot, domain.StatePublished)
+
+	origCfg, origJSON := cfg, outputJSON
+	t.Cleanup(func() { cfg, outputJSON = origCfg, origJSON })
+	cfg = config.DefaultConfig()
+	outputJSON = true
+
+	var err error
+	out := captureOutput(t, func() {
+		err = watchStatus(context.Background(), app, repoRoot, time.Hour)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 1, "a terminal release is rendered once: %s", out)
+
+	var decoded StatusOutput
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &decoded))
+	assert.Equal(t, string(run.ID()), decoded.ReleaseID)
+	assert.Equal(t, "published", decoded.State)
+	assert.Equal(t, []StepOutput{
+		{Name: "create-tag", State: "done", Attempts: 1},
+		{Name: "github", State: "done", Attempts: 1},
+	}, decoded.Steps)
+}
+
+func TestWatchStatus_PollsWithoutModifyingRun(t *testing.T) {
+	repoRoot := t.TempDir()
+	app, run := newStatusWatchApp(t, repoRoot, domain.StatePublishing)
+
+	origCfg, origJSON := cfg, outputJSON
+	t.Cleanup(func() { cfg, outputJSON = origCfg, origJSON })
+	cfg = config.DefaultConfig()
+	cfg.Output.Quiet = true
+	outputJSON = false
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var err error
+	out := captureOutput(t, func() {
+		err = watchStatus(ctx, app, repoRoot, 5*time.Millisecond)
+	})
+	require.NoError(t, err)
+
+	// Unchanged status is printed once, as a single quiet line
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 1, out)
+	assert.Contains(t, lines[0], "publishing 1.1.0 steps 1/2")
+
+	reloaded, err := app.services.Repository.LoadLatest(context.Background(), repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatePublishing, reloaded.State())
+	assert.Equal(t, run.UpdatedAt().UnixNano(), reloaded.UpdatedAt().UnixNano())
+}
+
+func TestWatchStatus_RejectsNonPositiveInterval(t *testing.T) {
+	err := watchStatus(context.Background(), statusWatchApp{}, t.TempDir(), 0)
+	assert.ErrorContains(t, err, "--interval must be positive")
+}
+
+func TestBuildStatusOutput_ReportsProfile(t *testing.T) {
+	repoRoot := t.TempDir()
+	app, _ := newStatusWatchApp(t, repoRoot, domain.StatePlanned)
+
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+	cfg = config.DefaultConfig()
+	cfg.Profile = "staging"
+
+	output := buildStatusOutput(context.Background(), app, repoRoot)
+	assert.Equal(t, "staging", output.Profile)
+
+	out := captureOutput(t, func() {
+		require.NoError(t, outputStatusText(output))
+	})
+	assert.Contains(t, out, "Config profile: staging")
+}
@@ -2,8 +2,12 @@
 package templates
 
 import (
+	"bufio"
+	"encoding/json"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/go-git/go-git/v5"
@@ -65,14 +69,29 @@ type Detection struct {
 	// TypeConfidence is the confidence score (0-100) for the type detection.
 	TypeConfidence int
 
-	// GitRepository is the detected git repository URL (if available).
+	// GitRepository is the detected git repository URL (if available),
+	// preferring the origin remote.
 	GitRepository string
+	// Forge is the hosting platform of GitRepository (github, gitlab) or empty.
+	Forge string
+	// ForgeOwner and ForgeRepo identify the repository on the forge.
+	ForgeOwner string
+	ForgeRepo  string
+	// RepositoryURL is the web URL of the repository on the forge.
+	RepositoryURL string
 	// GitBranch is the current git branch.
 	GitBranch string
 	// IsMonorepo indicates if this is a monorepo structure.
 	IsMonorepo bool
 	// MonorepoRoot is the root directory if in a monorepo.
 	MonorepoRoot string
+	// HasWorkspace indicates a workspace declaration at the root (npm/yarn
+	// workspaces, pnpm, lerna, Cargo workspace or go.work).
+	HasWorkspace bool
+
+	// Manifests lists the project manifests at the root and in conventional
+	// monorepo package directories, in that order.
+	Manifests []Manifest
 
 	// HasDockerfile indicates presence of Dockerfile.
 	HasDockerfile bool
@@ -92,6 +111,32 @@ type Detection struct {
 	SuggestedTemplate string
 }
 
+// Manifest describes a detected project manifest.
+type Manifest struct {
+	// Path is the manifest path relative to the detection root, with forward slashes.
+	Path string
+	// Language is the language the manifest belongs to.
+	Language Language
+	// ModulePath is the Go module path (go.mod only).
+	ModulePath string
+}
+
+// manifestFiles maps project manifest files to their language.
+var manifestFiles = []struct {
+	File     string
+	Language Language
+}{
+	{"package.json", LanguageNode},
+	{"go.mod", LanguageGo},
+	{"Cargo.toml", LanguageRust},
+}
+
+// monorepoPackageDirs are the conventional parent directories of monorepo packages.
+var monorepoPackageDirs = []string{"packages", "apps", "crates", "libs", "modules", "services"}
+
+// cargoWorkspaceRegex matches the workspace table of a Cargo.toml.
+var cargoWorkspaceRegex = regexp.MustCompile(`(?m)^\[workspace\]`)
+
 // ignoredDirs is a list of directories that should be excluded from scanning.
 // These are typically large vendor directories, build outputs, or VCS metadata.
 var ignoredDirs = map[string]bool{
@@ -205,6 +250,9 @@ func (d *Detector) Detect() (*Detection, error) {
 		return nil, err
 	}
 
+	// Detect manifests and workspaces
+	d.detectManifests(detection)
+
 	// Detect project type
 	if err := d.detectProjectType(detection); err != nil {
 		return nil, err
@@ -394,7 +442,7 @@ func (d *Detector) detectProjectType(detection *Detection) error {
 		scores[ProjectTypeMonorepo] += 50
 		detection.IsMonorepo = true
 	}
-	if d.fileExists("lerna.json") || d.fileExists("pnpm-workspace.yaml") {
+	if detection.HasWorkspace {
 		scores[ProjectTypeMonorepo] += 30
 		detection.IsMonorepo = true
 	}
@@ -438,9 +486,92 @@ func (d *Detector) detectProjectType(detection *Detection) error {
 	return nil
 }
 
+// detectManifests finds project manifests at the root and in conventional
+// monorepo package directories, and whether the root declares a workspace.
+func (d *Detector) detectManifests(detection *Detection) {
+	for _, m := range manifestFiles {
+		if d.fileExists(m.File) {
+			detection.Manifests = append(detection.Manifests, d.newManifest(m.File, m.Language))
+		}
+	}
+
+	for _, dir := range monorepoPackageDirs {
+		entries, err := os.ReadDir(filepath.Join(d.basePath, dir))
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			for _, m := range manifestFiles {
+				manifest := path.Join(dir, entry.Name(), m.File)
+				if d.fileExists(manifest) {
+					detection.Manifests = append(detection.Manifests, d.newManifest(manifest, m.Language))
+				}
+			}
+		}
+	}
+
+	detection.HasWorkspace = d.hasWorkspace()
+}
+
+// newManifest creates a Manifest, reading the module path for go.mod.
+func (d *Detector) newManifest(manifest string, lang Language) Manifest {
+	m := Manifest{Path: manifest, Language: lang}
+	if lang == LanguageGo {
+		m.ModulePath = d.goModulePath(manifest)
+	}
+	return m
+}
+
+// hasWorkspace reports whether the root declares a workspace.
+func (d *Detector) hasWorkspace() bool {
+	for _, f := range []string{"pnpm-workspace.yaml", "lerna.json", "go.work"} {
+		if d.fileExists(f) {
+			return true
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(d.basePath, "package.json")); err == nil { // #nosec G304 -- path from validated basePath
+		var pkg struct {
+			Workspaces json.RawMessage `json:"workspaces"`
+		}
+		if json.Unmarshal(data, &pkg) == nil && len(pkg.Workspaces) > 0 {
+			return true
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(d.basePath, "Cargo.toml")); err == nil { // #nosec G304 -- path from validated basePath
+		if cargoWorkspaceRegex.Match(data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// goModulePath returns the module path declared in a go.mod file.
+func (d *Detector) goModulePath(goMod string) string {
+	f, err := os.Open(filepath.Join(d.basePath, filepath.FromSlash(goMod))) // #nosec G304 -- path from validated basePath
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
 // detectGit extracts git repository information.
 func (d *Detector) detectGit(detection *Detection) error {
-	repo, err := git.PlainOpen(d.basePath)
+	repo, err := git.PlainOpenWithOptions(d.basePath, &git.PlainOpenOptions{DetectDotGit: true})
 	if err != nil {
 		return err
 	}
@@ -451,18 +582,63 @@ func (d *Detector) detectGit(detection *Detection) error {
 		detection.GitBranch = head.Name().Short()
 	}
 
-	// Get remote URL
-	remotes, err := repo.Remotes()
-	if err == nil && len(remotes) > 0 {
-		config := remotes[0].Config()
-		if len(config.URLs) > 0 {
-			detection.GitRepository = config.URLs[0]
+	// Get remote URL, preferring origin
+	remote, err := repo.Remote("origin")
+	if err != nil {
+		remotes, _ := repo.Remotes()
+		if len(remotes) == 0 {
+			return nil
 		}
+		remote = remotes[0]
+	}
+	if config := remote.Config(); len(config.URLs) > 0 {
+		detection.GitRepository = config.URLs[0]
+		detection.Forge, detection.ForgeOwner, detection.ForgeRepo, detection.RepositoryURL = ParseForgeRemote(config.URLs[0])
 	}
 
 	return nil
 }
 
+// ParseForgeRemote parses a git remote URL into forge, owner, repo and web URL.
+// It returns empty values for remotes that are not on GitHub or GitLab.
+func ParseForgeRemote(remoteURL string) (forge, owner, repo, webURL string) {
+	host, repoPath := splitRemoteURL(remoteURL)
+	switch {
+	case host == "github.com":
+		forge = "github"
+	case strings.Contains(host, "gitlab"):
+		forge = "gitlab"
+	default:
+		return "", "", "", ""
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	idx := strings.LastIndex(repoPath, "/")
+	if idx <= 0 {
+		return "", "", "", ""
+	}
+	return forge, repoPath[:idx], repoPath[idx+1:], "https://" + host + "/" + repoPath
+}
+
+// splitRemoteURL splits SSH (git@host:path), ssh:// and http(s):// remotes into host and path.
+func splitRemoteURL(remoteURL string) (host, repoPath string) {
+	if scheme := strings.Index(remoteURL, "://"); scheme >= 0 {
+		rest := remoteURL[scheme+3:]
+		if at := strings.Index(rest, "@"); at >= 0 {
+			rest = rest[at+1:]
+		}
+		host, repoPath, _ = strings.Cut(rest, "/")
+		host, _, _ = strings.Cut(host, ":")
+		return host, repoPath
+	}
+
+	if at := strings.Index(remoteURL, "@"); at >= 0 {
+		remoteURL = remoteURL[at+1:]
+	}
+	host, repoPath, _ = strings.Cut(remoteURL, ":")
+	return host, repoPath
+}
+
 // detectTools identifies package managers and build tools.
 func (d *Detector) detectTools(detection *Detection) error {
 	// Detect CI/CD
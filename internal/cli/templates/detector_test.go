@@ -1075,6 +1075,62 @@ func TestDetector_detectProjectType_Monorepo(t *testing.T) {
 	}
 }
 
+func TestDetector_detectManifests(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"package.json":               `{"name": "root", "workspaces": ["packages/*"]}`,
+		"packages/core/package.json": `{"name": "@acme/core"}`,
+		"services/api/go.mod":        "module github.com/acme/api\n\ngo 1.24\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+
+	detection := &Detection{}
+	NewDetector(tmpDir).detectManifests(detection)
+
+	want := []Manifest{
+		{Path: "package.json", Language: LanguageNode},
+		{Path: "packages/core/package.json", Language: LanguageNode},
+		{Path: "services/api/go.mod", Language: LanguageGo, ModulePath: "github.com/acme/api"},
+	}
+	if len(detection.Manifests) != len(want) {
+		t.Fatalf("Manifests = %+v, want %+v", detection.Manifests, want)
+	}
+	for i, m := range detection.Manifests {
+		if m != want[i] {
+			t.Errorf("Manifests[%d] = %+v, want %+v", i, m, want[i])
+		}
+	}
+	if !detection.HasWorkspace {
+		t.Error("HasWorkspace should be true for package.json workspaces")
+	}
+}
+
+func TestParseForgeRemote(t *testing.T) {
+	tests := []struct {
+		remote, forge, owner, repo string
+	}{
+		{"git@github.com:acme/tool.git", "github", "acme", "tool"},
+		{"https://github.com/acme/tool", "github", "acme", "tool"},
+		{"ssh://git@gitlab.com:2222/group/project.git", "gitlab", "group", "project"},
+		{"https://bitbucket.org/acme/tool.git", "", "", ""},
+	}
+	for _, tt := range tests {
+		forge, owner, repo, _ := ParseForgeRemote(tt.remote)
+		if forge != tt.forge || owner != tt.owner || repo != tt.repo {
+			t.Errorf("ParseForgeRemote(%q) = %q %q %q, want %q %q %q", tt.remote, forge, owner, repo, tt.forge, tt.owner, tt.repo)
+		}
+	}
+}
+
 func TestDetector_detectProjectType_Container(t *testing.T) {
 	tmpDir := t.TempDir()
 
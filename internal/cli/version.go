@@ -0,0 +1,181 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
+	"github.com/relicta-tech/relicta/internal/infrastructure/selfupdate"
+)
+
+// versionCmd prints version information.
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print version information",
+	Long: `Print the relicta version, commit, build date, Go version and platform.
+
+When the binary was built without release ldflags (e.g. go install or a
+local build), the commit and build date are taken from the VCS information
+embedded by the Go toolchain.
+
+Examples:
+  # Print build information
+  relicta version
+
+  # Also report whether a newer stable release exists
+  relicta version --check-update
+
+  # Machine-readable output for bug reports and tooling
+  relicta version --json`,
+	Run: runVersionCmd,
+}
+
+var versionCheckUpdate bool
+
+func init() {
+	versionCmd.Flags().BoolVar(&versionCheckUpdate, "check-update", false, "check whether a newer stable release is available")
+}
+
+// VersionOutput describes the running relicta build.
+type VersionOutput struct {
+	Version   string              `json:"version"`
+	Commit    string              `json:"commit"`
+	BuildDate string              `json:"build_date"`
+	Modified  bool                `json:"modified,omitempty"`
+	GoVersion string              `json:"go_version"`
+	OS        string              `json:"os"`
+	Arch      string              `json:"arch"`
+	Update    *VersionUpdateCheck `json:"update,omitempty"`
+}
+
+// VersionUpdateCheck reports the outcome of a --check-update lookup.
+type VersionUpdateCheck struct {
+	Latest          string `json:"latest,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+	ReleaseURL      string `json:"release_url,omitempty"`
+	DevBuild        bool   `json:"dev_build,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// runVersionCmd prints the build information. A failed update check is
+// reported alongside the build information rather than failing the command,
+// so the output stays usable for support triage when offline.
+func runVersionCmd(cmd *cobra.Command, args []string) {
+	out := buildVersionOutput(debug.ReadBuildInfo)
+	if versionCheckUpdate {
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		out.Update = checkVersionUpdate(ctx, newSelfUpdater(), out.Version)
+	}
+
+	if outputJSON {
+		_ = printStructuredOutput(out) // Writing to stdout; nothing useful to do on failure
+		return
+	}
+	printVersionOutput(out)
+}
+
+// buildVersionOutput collects the version information set by main, falling
+// back to the VCS information embedded in the binary for the commit and
+// build date when they were not set via ldflags.
+func buildVersionOutput(readBuildInfo func() (*debug.BuildInfo, bool)) *VersionOutput {
+	out := &VersionOutput{
+		Version:   versionInfo.Version,
+		Commit:    versionInfo.Commit,
+		BuildDate: versionInfo.Date,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+	if out.Version == "" {
+		out.Version = "dev"
+	}
+
+	if info, ok := readBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if out.Commit == "" || out.Commit == "none" {
+					out.Commit = setting.Value
+				}
+			case "vcs.time":
+				if out.BuildDate == "" || out.BuildDate == "unknown" {
+					out.BuildDate = setting.Value
+				}
+			case "vcs.modified":
+				out.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if out.Commit == "" {
+		out.Commit = "none"
+	}
+	if out.BuildDate == "" {
+		out.BuildDate = "unknown"
+	}
+	return out
+}
+
+// checkVersionUpdate looks up the latest stable release with the
+// self-update checker. Development builds have no comparable version, so
+// only the latest release is reported for them.
+func checkVersionUpdate(ctx context.Context, updater *selfupdate.Updater, current string) *VersionUpdateCheck {
+	if _, err := version.Parse(current); err != nil {
+		latest, err := updater.LatestRelease(ctx, selfupdate.ChannelStable)
+		if err != nil {
+			return &VersionUpdateCheck{DevBuild: true, Error: err.Error()}
+		}
+		return &VersionUpdateCheck{DevBuild: true, Latest: latest.TagName, ReleaseURL: latest.HTMLURL}
+	}
+
+	result, err := updater.Check(ctx, current, selfupdate.ChannelStable)
+	if err != nil {
+		return &VersionUpdateCheck{Error: err.Error()}
+	}
+	return &VersionUpdateCheck{
+		Latest:          result.Latest,
+		UpdateAvailable: result.UpdateAvailable,
+		ReleaseURL:      result.ReleaseURL,
+	}
+}
+
+// printVersionOutput prints the build information as text.
+func printVersionOutput(out *VersionOutput) {
+	fmt.Printf("relicta %s\n", out.Version)
+	commit := out.Commit
+	if out.Modified {
+		commit += " (modified)"
+	}
+	fmt.Printf("  commit:   %s\n", commit)
+	fmt.Printf("  built:    %s\n", out.BuildDate)
+	fmt.Printf("  go:       %s\n", out.GoVersion)
+	fmt.Printf("  platform: %s/%s\n", out.OS, out.Arch)
+
+	update := out.Update
+	if update == nil {
+		return
+	}
+	fmt.Println()
+	switch {
+	case update.Error != "":
+		printWarning(fmt.Sprintf("Update check failed: %s", update.Error))
+	case update.DevBuild:
+		printInfo(fmt.Sprintf("Development build; latest release is %s", update.Latest))
+	case update.UpdateAvailable:
+		printInfo(fmt.Sprintf("Update available: %s → %s", out.Version, update.Latest))
+		if update.ReleaseURL != "" {
+			printSubtle("  " + update.ReleaseURL)
+		}
+		fmt.Println("  Run 'relicta self-update' to install it.")
+	default:
+		printSuccess(fmt.Sprintf("relicta %s is up to date", out.Version))
+	}
+}
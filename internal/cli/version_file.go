@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/application/versioning"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+// projectVersionFile returns the version file of a single-package project
+// whose current version is read from a file (versioning.bump_from: file or
+// package.json). Monorepos version each package through its own file.
+func projectVersionFile() (monorepo.PackageVersionFile, bool) {
+	if cfg.Monorepo.Enabled {
+		return monorepo.PackageVersionFile{}, false
+	}
+	return versioning.ProjectVersionFile(cfg.Versioning.BumpFrom, cfg.Versioning.VersionFile, versionFileTypes())
+}
+
+// fileCurrentVersion reads the current version from the project version
+// file. It returns nil when the current version comes from tags.
+func fileCurrentVersion(repoPath string) (*version.SemanticVersion, error) {
+	f, ok := projectVersionFile()
+	if !ok {
+		return nil, nil
+	}
+	ver, err := versioning.ReadProjectVersion(repoPath, f)
+	if err != nil {
+		return nil, err
+	}
+	return &ver, nil
+}
+
+// updateProjectVersionFile writes ver to the project version file and
+// returns its path, or "" when there is no version file to update.
+func updateProjectVersionFile(repoPath string, ver version.SemanticVersion) (string, error) {
+	f, ok := projectVersionFile()
+	if !ok || !f.Update {
+		return "", nil
+	}
+	if err := versioning.WriteProjectVersion(repoPath, f, ver); err != nil {
+		return "", err
+	}
+	return projectVersionFilePath(repoPath), nil
+}
+
+// projectVersionFilePath returns the path of the project version file that
+// bumps update, or "" when there is none.
+func projectVersionFilePath(repoPath string) string {
+	f, ok := projectVersionFile()
+	if !ok || !f.Update {
+		return ""
+	}
+	return filepath.Join(repoPath, filepath.FromSlash(f.File))
+}
+
+// writeBumpedVersionFile writes the bumped version to the project version
+// file, if there is one.
+func writeBumpedVersionFile(ctx context.Context, app cliApp, ver version.SemanticVersion) error {
+	f, ok := projectVersionFile()
+	if !ok || !f.Update {
+		return nil
+	}
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	if _, err := updateProjectVersionFile(repoInfo.Path, ver); err != nil {
+		return err
+	}
+	if !outputJSON {
+		printInfo(fmt.Sprintf("Updated version in %s", f.File))
+	}
+	return nil
+}
+
+// bumpCurrentVersion reads the current version from the project version
+// file for a bump. It returns nil when the current version comes from tags.
+func bumpCurrentVersion(ctx context.Context, app cliApp) (*version.SemanticVersion, error) {
+	if _, ok := projectVersionFile(); !ok {
+		return nil, nil
+	}
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository info: %w", err)
+	}
+	return fileCurrentVersion(repoInfo.Path)
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func TestProjectVersionFile_ReadAndUpdate(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pkg", "__version__.py")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("__version__ = \"1.2.3\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg = config.DefaultConfig()
+	cfg.Versioning.BumpFrom = "file"
+	cfg.Versioning.VersionFile = "pkg/__version__.py"
+
+	current, err := fileCurrentVersion(dir)
+	if err != nil {
+		t.Fatalf("fileCurrentVersion() error = %v", err)
+	}
+	if current == nil || current.String() != "1.2.3" {
+		t.Fatalf("fileCurrentVersion() = %v, want 1.2.3", current)
+	}
+
+	updated, err := updateProjectVersionFile(dir, version.MustParse("1.3.0"))
+	if err != nil {
+		t.Fatalf("updateProjectVersionFile() error = %v", err)
+	}
+	if updated != path {
+		t.Errorf("updateProjectVersionFile() = %q, want %q", updated, path)
+	}
+	if got := projectVersionFilePath(dir); got != path {
+		t.Errorf("projectVersionFilePath() = %q, want %q", got, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "__version__ = \"1.3.0\"\n" {
+		t.Errorf("version file = %q", data)
+	}
+}
+
+func TestProjectVersionFile_PatternMismatch(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "__version__.py"), []byte("VERSION = '1.2.3'\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg = config.DefaultConfig()
+	cfg.Versioning.BumpFrom = "file"
+	cfg.Versioning.VersionFile = "__version__.py"
+
+	_, err := fileCurrentVersion(dir)
+	if err == nil {
+		t.Fatal("fileCurrentVersion() error = nil, want pattern mismatch")
+	}
+	if !strings.Contains(err.Error(), "__version__.py") || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("fileCurrentVersion() error = %v", err)
+	}
+}
+
+func TestProjectVersionFile_TagsAndMonorepo(t *testing.T) {
+	origCfg := cfg
+	t.Cleanup(func() { cfg = origCfg })
+
+	cfg = config.DefaultConfig()
+	if current, err := fileCurrentVersion(t.TempDir()); err != nil || current != nil {
+		t.Errorf("fileCurrentVersion() with tags = %v, %v; want nil, nil", current, err)
+	}
+
+	cfg.Versioning.BumpFrom = "file"
+	cfg.Versioning.VersionFile = "VERSION"
+	cfg.Monorepo.Enabled = true
+	if _, ok := projectVersionFile(); ok {
+		t.Error("projectVersionFile() in monorepo mode = true, want false")
+	}
+	if got := projectVersionFilePath(t.TempDir()); got != "" {
+		t.Errorf("projectVersionFilePath() in monorepo mode = %q, want empty", got)
+	}
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/selfupdate"
+)
+
+func TestBuildVersionOutput_LdflagsTakePrecedence(t *testing.T) {
+	orig := versionInfo
+	defer func() { versionInfo = orig }()
+	SetVersionInfo("v1.4.0", "abc1234", "2026-01-02T03:04:05Z")
+
+	out := buildVersionOutput(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "fff0000"},
+			{Key: "vcs.time", Value: "2020-01-01T00:00:00Z"},
+		}}, true
+	})
+
+	if out.Version != "v1.4.0" || out.Commit != "abc1234" || out.BuildDate != "2026-01-02T03:04:05Z" {
+		t.Errorf("buildVersionOutput() = %+v, want ldflags values", out)
+	}
+	if out.GoVersion != runtime.Version() || out.OS != runtime.GOOS || out.Arch != runtime.GOARCH {
+		t.Errorf("buildVersionOutput() runtime info = %s %s/%s", out.GoVersion, out.OS, out.Arch)
+	}
+}
+
+func TestBuildVersionOutput_DevBuildUsesEmbeddedVCSInfo(t *testing.T) {
+	orig := versionInfo
+	defer func() { versionInfo = orig }()
+	SetVersionInfo("dev", "none", "unknown")
+
+	out := buildVersionOutput(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "fff0000"},
+			{Key: "vcs.time", Value: "2026-03-04T05:06:07Z"},
+			{Key: "vcs.modified", Value: "true"},
+		}}, true
+	})
+
+	if out.Version != "dev" || out.Commit != "fff0000" || out.BuildDate != "2026-03-04T05:06:07Z" || !out.Modified {
+		t.Errorf("buildVersionOutput() = %+v, want embedded VCS info", out)
+	}
+
+	out = buildVersionOutput(func() (*debug.BuildInfo, bool) { return nil, false })
+	if out.Commit != "none" || out.BuildDate != "unknown" {
+		t.Errorf("buildVersionOutput() without build info = %+v", out)
+	}
+}
+
+func newVersionCheckServer(t *testing.T, status int, tag string) *selfupdate.Updater {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"tag_name": tag, "html_url": "https://example.com/" + tag}})
+	}))
+	t.Cleanup(srv.Close)
+	return selfupdate.NewUpdater(selfupdate.WithAPIURL(srv.URL))
+}
+
+func TestCheckVersionUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	update := checkVersionUpdate(ctx, newVersionCheckServer(t, http.StatusOK, "v2.0.0"), "v1.4.0")
+	if !update.UpdateAvailable || update.Latest != "v2.0.0" || update.ReleaseURL != "https://example.com/v2.0.0" {
+		t.Errorf("checkVersionUpdate() = %+v, want update to v2.0.0", update)
+	}
+
+	update = checkVersionUpdate(ctx, newVersionCheckServer(t, http.StatusOK, "v1.4.0"), "v1.4.0")
+	if update.UpdateAvailable || update.Error != "" {
+		t.Errorf("checkVersionUpdate() = %+v, want up to date", update)
+	}
+
+	update = checkVersionUpdate(ctx, newVersionCheckServer(t, http.StatusOK, "v2.0.0"), "dev")
+	if !update.DevBuild || update.UpdateAvailable || update.Latest != "v2.0.0" {
+		t.Errorf("checkVersionUpdate(dev) = %+v, want latest release without comparison", update)
+	}
+
+	update = checkVersionUpdate(ctx, newVersionCheckServer(t, http.StatusInternalServerError, ""), "v1.4.0")
+	if update.Error == "" {
+		t.Errorf("checkVersionUpdate() = %+v, want error", update)
+	}
+}
+
+func TestVersionCommand_CheckUpdateJSON(t *testing.T) {
+	orig := versionInfo
+	oldUpdater, oldJSON, oldCheck := newSelfUpdater, outputJSON, versionCheckUpdate
+	defer func() {
+		versionInfo = orig
+		newSelfUpdater, outputJSON, versionCheckUpdate = oldUpdater, oldJSON, oldCheck
+	}()
+
+	updater := newVersionCheckServer(t, http.StatusOK, "v2.0.0")
+	newSelfUpdater = func() *selfupdate.Updater { return updater }
+	SetVersionInfo("v1.4.0", "abc1234", "2026-01-02T03:04:05Z")
+	outputJSON = true
+	versionCheckUpdate = true
+
+	output := captureOutput(t, func() { versionCmd.Run(versionCmd, nil) })
+
+	var got VersionOutput
+	if err := json.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("version --json output is not JSON: %v\n%s", err, output)
+	}
+	if got.Version != "v1.4.0" || got.Commit != "abc1234" || got.GoVersion == "" {
+		t.Errorf("version JSON = %+v", got)
+	}
+	if got.Update == nil || !got.Update.UpdateAvailable || got.Update.Latest != "v2.0.0" {
+		t.Errorf("version JSON update = %+v, want update to v2.0.0", got.Update)
+	}
+}
+
+func TestVersionCommand_TextOutput(t *testing.T) {
+	orig := versionInfo
+	oldJSON, oldCheck := outputJSON, versionCheckUpdate
+	defer func() {
+		versionInfo = orig
+		outputJSON, versionCheckUpdate = oldJSON, oldCheck
+	}()
+
+	SetVersionInfo("v1.4.0", "abc1234", "2026-01-02T03:04:05Z")
+	outputJSON = false
+	versionCheckUpdate = false
+
+	output := captureOutput(t, func() { versionCmd.Run(versionCmd, nil) })
+	for _, want := range []string{"relicta v1.4.0", "commit:   abc1234", "built:    2026-01-02T03:04:05Z", "go:       " + runtime.Version(), "platform: " + runtime.GOOS + "/" + runtime.GOARCH} {
+		if !strings.Contains(output, want) {
+			t.Errorf("version output missing %q:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Package cli provides the command-line interface for Relicta.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/webhook"
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Manage webhook deliveries",
+	Long: `Manage webhook deliveries.
+
+Webhook requests are retried with exponential backoff (retry_delay doubling
+up to max_retry_delay). Payloads still undelivered after the last retry are
+saved to .relicta/webhooks/failed/ and can be re-sent with
+'relicta webhooks replay'.`,
+}
+
+var webhooksReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Re-send undelivered webhook payloads",
+	Long: `Re-send the webhook payloads saved in .relicta/webhooks/failed/.
+
+Each payload is sent once to the webhook it was meant for, using the
+webhook's current configuration. Delivered payloads are removed; payloads
+that fail again are kept for a later replay.
+
+Examples:
+  # Re-send all undelivered payloads
+  relicta webhooks replay
+
+  # List the payloads that would be re-sent
+  relicta webhooks replay --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runWebhooksReplay,
+}
+
+func init() {
+	webhooksCmd.AddCommand(webhooksReplayCmd)
+	rootCmd.AddCommand(webhooksCmd)
+}
+
+// WebhookReplayOutput represents the webhooks replay command output.
+type WebhookReplayOutput struct {
+	Replayed []WebhookReplayEntry `json:"replayed"`
+	Failed   int                  `json:"failed"`
+	DryRun   bool                 `json:"dry_run,omitempty"`
+}
+
+// WebhookReplayEntry is the outcome of replaying one dead-lettered payload.
+type WebhookReplayEntry struct {
+	File      string `json:"file"`
+	Webhook   string `json:"webhook"`
+	Event     string `json:"event"`
+	ReleaseID string `json:"release_id,omitempty"`
+	Delivered bool   `json:"delivered"`
+	Status    int    `json:"status,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// runWebhooksReplay implements the webhooks replay command.
+func runWebhooksReplay(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	app, err := newContainerApp(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer closeApp(app)
+
+	repoInfo, err := app.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	output, err := replayDeadLetters(ctx, webhook.DeadLetterDir(repoInfo.Path))
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		if err := printStructuredOutput(output); err != nil {
+			return err
+		}
+	} else {
+		printWebhookReplay(output)
+	}
+
+	if output.Failed > 0 {
+		return fmt.Errorf("%d webhook payload(s) could not be delivered", output.Failed)
+	}
+	return nil
+}
+
+// replayDeadLetters re-sends the dead letters in dir to the configured
+// webhooks. In dry-run mode the dead letters are only listed.
+func replayDeadLetters(ctx context.Context, dir string) (*WebhookReplayOutput, error) {
+	files, err := webhook.ListDeadLetters(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &WebhookReplayOutput{Replayed: []WebhookReplayEntry{}, DryRun: dryRun}
+	publisher := webhook.NewPublisher(cfg.Webhooks, nil)
+	for _, file := range files {
+		entry := WebhookReplayEntry{
+			File:    filepath.Base(file.Path),
+			Webhook: file.Letter.Webhook,
+			Event:   file.Letter.Event,
+		}
+		if file.Letter.Payload != nil {
+			entry.ReleaseID = file.Letter.Payload.ReleaseID
+		}
+		if !dryRun {
+			result := publisher.Replay(ctx, file)
+			entry.Status = result.Status
+			entry.Delivered = result.Err == nil
+			if result.Err != nil {
+				entry.Error = result.Err.Error()
+				output.Failed++
+			}
+		}
+		output.Replayed = append(output.Replayed, entry)
+	}
+	return output, nil
+}
+
+// printWebhookReplay prints the webhooks replay results.
+func printWebhookReplay(output *WebhookReplayOutput) {
+	if len(output.Replayed) == 0 {
+		printInfo("No undelivered webhook payloads")
+		return
+	}
+
+	for _, e := range output.Replayed {
+		label := fmt.Sprintf("%s → %s (%s)", e.Event, e.Webhook, e.File)
+		switch {
+		case output.DryRun:
+			printInfo(fmt.Sprintf("Would re-send %s", label))
+		case e.Delivered:
+			printSuccess(fmt.Sprintf("Delivered %s", label))
+		default:
+			printError(fmt.Sprintf("Failed %s: %s", label, e.Error))
+		}
+	}
+}
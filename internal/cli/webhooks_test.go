@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/infrastructure/webhook"
+)
+
+func TestReplayDeadLetters(t *testing.T) {
+	origCfg, origDryRun := cfg, dryRun
+	t.Cleanup(func() { cfg, dryRun = origCfg, origDryRun })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	cfg = config.DefaultConfig()
+	cfg.Webhooks = []config.WebhookConfig{{Name: "ops", URL: server.URL}}
+
+	dir := t.TempDir()
+	for _, name := range []string{"ops", "removed"} {
+		letter := &webhook.DeadLetter{
+			Webhook:  name,
+			Event:    "run.published",
+			FailedAt: time.Now(),
+			Payload:  &webhook.WebhookPayload{Event: "run.published", ReleaseID: "run-1"},
+		}
+		if _, err := webhook.WriteDeadLetter(dir, letter); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dryRun = true
+	output, err := replayDeadLetters(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("replayDeadLetters() error = %v", err)
+	}
+	if len(output.Replayed) != 2 || output.Failed != 0 || output.Replayed[0].Delivered {
+		t.Fatalf("dry run output = %+v", output)
+	}
+
+	dryRun = false
+	output, err = replayDeadLetters(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("replayDeadLetters() error = %v", err)
+	}
+	if output.Failed != 1 {
+		t.Errorf("Failed = %d, want 1", output.Failed)
+	}
+	for _, e := range output.Replayed {
+		if wantDelivered := e.Webhook == "ops"; e.Delivered != wantDelivered {
+			t.Errorf("%s delivered = %v, want %v", e.Webhook, e.Delivered, wantDelivered)
+		}
+	}
+
+	remaining, err := webhook.ListDeadLetters(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 1 || remaining[0].Letter.Webhook != "removed" {
+		t.Errorf("remaining dead letters = %+v", remaining)
+	}
+}
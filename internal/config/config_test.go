@@ -61,6 +61,9 @@ func TestDefaultConfig(t *testing.T) {
 	if len(cfg.Workflow.AllowedBranches) != 2 {
 		t.Errorf("Workflow.AllowedBranches length = %d, want 2", len(cfg.Workflow.AllowedBranches))
 	}
+	if cfg.Workflow.AutoCommitChangelog {
+		t.Error("Workflow.AutoCommitChangelog should be false by default")
+	}
 
 	// Test output defaults
 	if cfg.Output.Format != "text" {
@@ -0,0 +1,181 @@
+// Package config provides configuration management for Relicta.
+package config
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// extendsKey is the top-level key naming a base configuration.
+const extendsKey = "extends"
+
+// extendsHTTPClient fetches remote base configurations.
+var extendsHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// resolveExtends reads the config at location and, if it declares extends,
+// deep-merges it over its base configuration (recursively). chain holds the
+// locations already being resolved and is used to detect cycles.
+func resolveExtends(location string, chain []string) (map[string]any, error) {
+	settings, err := readConfigMap(location)
+	if err != nil {
+		return nil, err
+	}
+
+	base, _ := settings[extendsKey].(string)
+	delete(settings, extendsKey)
+	if base == "" {
+		return settings, nil
+	}
+
+	baseLocation, err := resolveExtendsLocation(location, base)
+	if err != nil {
+		return nil, err
+	}
+
+	chain = append(chain, location)
+	if slices.Contains(chain, baseLocation) {
+		return nil, fmt.Errorf("cyclic config extends: %s -> %s", strings.Join(chain, " -> "), baseLocation)
+	}
+
+	baseSettings, err := resolveExtends(baseLocation, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeConfigMaps(baseSettings, settings), nil
+}
+
+// resolveExtendsLocation resolves ref relative to the config that references it.
+func resolveExtendsLocation(from, ref string) (string, error) {
+	if isRemoteConfig(ref) {
+		return ref, nil
+	}
+
+	if isRemoteConfig(from) {
+		fromURL, err := url.Parse(from)
+		if err != nil {
+			return "", fmt.Errorf("invalid config URL %s: %w", from, err)
+		}
+		refURL, err := url.Parse(ref)
+		if err != nil {
+			return "", fmt.Errorf("invalid extends reference %q: %w", ref, err)
+		}
+		return fromURL.ResolveReference(refURL).String(), nil
+	}
+
+	if !filepath.IsAbs(ref) {
+		ref = filepath.Join(filepath.Dir(from), ref)
+	}
+	abs, err := filepath.Abs(ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving extends path %s: %w", ref, err)
+	}
+	return abs, nil
+}
+
+// isRemoteConfig reports whether location is an HTTP(S) URL.
+func isRemoteConfig(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
+
+// readConfigMap reads a single config file or URL into a settings map
+// without applying defaults or environment overrides.
+func readConfigMap(location string) (map[string]any, error) {
+	v := viper.New()
+
+	if !isRemoteConfig(location) {
+		v.SetConfigFile(location)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("reading config file %s: %w", location, err)
+		}
+		return v.AllSettings(), nil
+	}
+
+	resp, err := extendsHTTPClient.Get(location) // #nosec G107 -- URL comes from the user's own config
+	if err != nil {
+		return nil, fmt.Errorf("fetching base config %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching base config %s: unexpected status code %d", location, resp.StatusCode)
+	}
+
+	configType := "yaml"
+	if u, err := url.Parse(location); err == nil {
+		if ext := strings.TrimPrefix(path.Ext(u.Path), "."); ext != "" {
+			configType = ext
+		}
+	}
+	v.SetConfigType(configType)
+	if err := v.ReadConfig(resp.Body); err != nil {
+		return nil, fmt.Errorf("reading base config %s: %w", location, err)
+	}
+	return v.AllSettings(), nil
+}
+
+// mergeConfigMaps deep-merges override onto base. Nested maps are merged,
+// plugins are merged by name, and all other values in override replace
+// those in base.
+func mergeConfigMaps(base, override map[string]any) map[string]any {
+	merged := maps.Clone(base)
+	if merged == nil {
+		merged = make(map[string]any, len(override))
+	}
+
+	for key, value := range override {
+		switch {
+		case key == "plugins":
+			merged[key] = mergePluginLists(merged[key], value)
+		default:
+			baseMap, baseOK := merged[key].(map[string]any)
+			overrideMap, overrideOK := value.(map[string]any)
+			if baseOK && overrideOK {
+				merged[key] = mergeConfigMaps(baseMap, overrideMap)
+			} else {
+				merged[key] = value
+			}
+		}
+	}
+
+	return merged
+}
+
+// mergePluginLists merges plugin entries by name: entries in override are
+// deep-merged over the base entry with the same name, new entries are appended.
+func mergePluginLists(base, override any) any {
+	baseList, baseOK := base.([]any)
+	overrideList, overrideOK := override.([]any)
+	if !baseOK || !overrideOK {
+		return override
+	}
+
+	merged := slices.Clone(baseList)
+	for _, entry := range overrideList {
+		entryMap, ok := entry.(map[string]any)
+		name, _ := entryMap["name"].(string)
+		idx := -1
+		if ok && name != "" {
+			idx = slices.IndexFunc(merged, func(existing any) bool {
+				existingMap, ok := existing.(map[string]any)
+				return ok && existingMap["name"] == name
+			})
+		}
+		if idx < 0 {
+			merged = append(merged, entry)
+			continue
+		}
+		merged[idx] = mergeConfigMaps(merged[idx].(map[string]any), entryMap)
+	}
+
+	return merged
+}
@@ -0,0 +1,91 @@
+// Package config provides configuration management for Relicta.
+package config
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect emitted by JSONSchema.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// JSONSchema returns a JSON Schema describing the Config file format.
+// Property names are derived from the mapstructure tags used when loading
+// .relicta.yaml, so editors can offer completion for the same keys.
+func JSONSchema() map[string]any {
+	schema := schemaForType(reflect.TypeOf(Config{}))
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "Relicta configuration"
+	return schema
+}
+
+// schemaForType builds the schema fragment for a Go type.
+func schemaForType(t reflect.Type) map[string]any {
+	if t == durationType {
+		return map[string]any{
+			"type":        "string",
+			"description": "duration, e.g. \"30s\" or \"24h\"",
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaForType(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{
+			"type":  "array",
+			"items": schemaForType(t.Elem()),
+		}
+	case reflect.Map:
+		schema := map[string]any{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = schemaForType(t.Elem())
+		}
+		return schema
+	case reflect.Struct:
+		return schemaForStruct(t)
+	default:
+		// Interfaces accept any value.
+		return map[string]any{}
+	}
+}
+
+// schemaForStruct builds an object schema from the struct's mapstructure tags.
+func schemaForStruct(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		properties[name] = schemaForType(field.Type)
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
@@ -34,6 +34,7 @@ type Loader struct {
 	v           *viper.Viper
 	configPath  string
 	searchPaths []string
+	profile     string
 }
 
 // NewLoader creates a new configuration loader.
@@ -55,6 +56,13 @@ func (l *Loader) WithConfigPath(path string) *Loader {
 	return l
 }
 
+// WithProfile selects a named profile from the config's profiles map to
+// merge over the base configuration. It takes precedence over RELICTA_PROFILE.
+func (l *Loader) WithProfile(name string) *Loader {
+	l.profile = name
+	return l
+}
+
 // WithSearchPaths adds directories to search for config files.
 func (l *Loader) WithSearchPaths(paths ...string) *Loader {
 	l.searchPaths = append(l.searchPaths, paths...)
@@ -79,12 +87,22 @@ func (l *Loader) Load() (*Config, error) {
 		return nil, rperrors.ConfigWrap(err, op, "failed to load config file")
 	}
 
+	// Merge the selected profile over the base config
+	profile := l.selectedProfile()
+	if profile != "" {
+		if err := l.applyProfile(profile); err != nil {
+			return nil, rperrors.ConfigWrap(err, op, "failed to apply config profile")
+		}
+	}
+
 	// Unmarshal into Config struct
 	cfg := &Config{}
 	if err := l.v.Unmarshal(cfg); err != nil {
 		return nil, rperrors.ConfigWrap(err, op, "failed to unmarshal config")
 	}
 
+	cfg.Profile = profile
+
 	// Expand environment variables in sensitive fields
 	l.expandEnvVars(cfg)
 
@@ -117,6 +135,7 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("changelog.link_issues", defaults.Changelog.LinkIssues)
 	l.v.SetDefault("changelog.exclude", defaults.Changelog.Exclude)
 	l.v.SetDefault("changelog.categories", defaults.Changelog.Categories)
+	l.v.SetDefault("changelog.highlights_count", defaults.Changelog.HighlightsCount)
 
 	// AI defaults
 	l.v.SetDefault("ai.enabled", defaults.AI.Enabled)
@@ -137,6 +156,8 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("workflow.dry_run_by_default", defaults.Workflow.DryRunByDefault)
 	l.v.SetDefault("workflow.auto_commit_changelog", defaults.Workflow.AutoCommitChangelog)
 	l.v.SetDefault("workflow.changelog_commit_message", defaults.Workflow.ChangelogCommitMessage)
+	l.v.SetDefault("workflow.on_plugin_failure", defaults.Workflow.OnPluginFailure)
+	l.v.SetDefault("workflow.cleanup_prereleases_on_stable", defaults.Workflow.CleanupPrereleasesOnStable)
 
 	// Output defaults
 	l.v.SetDefault("output.format", defaults.Output.Format)
@@ -163,6 +184,12 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("dashboard.read_timeout", defaults.Dashboard.ReadTimeout)
 	l.v.SetDefault("dashboard.write_timeout", defaults.Dashboard.WriteTimeout)
 	l.v.SetDefault("dashboard.idle_timeout", defaults.Dashboard.IdleTimeout)
+
+	// MCP defaults
+	l.v.SetDefault("mcp.record_transcript", defaults.MCP.RecordTranscript)
+	l.v.SetDefault("mcp.transcript_file", defaults.MCP.TranscriptFile)
+	l.v.SetDefault("mcp.transcript_max_bytes", defaults.MCP.TranscriptMaxBytes)
+	l.v.SetDefault("mcp.actor", defaults.MCP.Actor)
 }
 
 // configFileExists checks if a config file exists in search paths.
@@ -349,11 +376,7 @@ func convertToHTTPSURL(remoteURL string) string {
 func (l *Loader) loadConfigFile() error {
 	// If explicit path provided, use it
 	if l.configPath != "" {
-		l.v.SetConfigFile(l.configPath)
-		if err := l.v.ReadInConfig(); err != nil {
-			return fmt.Errorf("reading config file %s: %w", l.configPath, err)
-		}
-		return nil
+		return l.readConfigFile(l.configPath)
 	}
 
 	// Search for config file in paths
@@ -362,11 +385,7 @@ func (l *Loader) loadConfigFile() error {
 			for _, ext := range ConfigFileExtensions {
 				configFile := filepath.Join(searchPath, name+"."+ext)
 				if _, err := os.Stat(configFile); err == nil {
-					l.v.SetConfigFile(configFile)
-					if err := l.v.ReadInConfig(); err != nil {
-						return fmt.Errorf("reading config file %s: %w", configFile, err)
-					}
-					return nil
+					return l.readConfigFile(configFile)
 				}
 			}
 		}
@@ -376,6 +395,29 @@ func (l *Loader) loadConfigFile() error {
 	return nil
 }
 
+// readConfigFile reads configFile into the loader. If the file declares
+// extends, its base configurations are resolved and merged underneath it.
+func (l *Loader) readConfigFile(configFile string) error {
+	l.v.SetConfigFile(configFile)
+	if err := l.v.ReadInConfig(); err != nil {
+		return fmt.Errorf("reading config file %s: %w", configFile, err)
+	}
+
+	if !l.v.InConfig(extendsKey) {
+		return nil
+	}
+
+	location, err := filepath.Abs(configFile)
+	if err != nil {
+		location = configFile
+	}
+	settings, err := resolveExtends(location, nil)
+	if err != nil {
+		return err
+	}
+	return l.v.MergeConfigMap(settings)
+}
+
 // expandEnvVars expands environment variables in sensitive configuration fields.
 //
 // Security: Environment variable expansion is limited to a whitelist of fields:
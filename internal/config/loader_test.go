@@ -3,7 +3,10 @@ package config
 import (
 	"bytes"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -148,3 +151,180 @@ func TestLoaderAutoDetectRepositoryURL(t *testing.T) {
 		t.Fatalf("expected LinkCommits to remain false when repository already configured")
 	}
 }
+
+func TestLoaderExtends(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	writeFile("org/base.yaml", `
+versioning:
+  tag_prefix: release-
+  git_tag: true
+changelog:
+  file: HISTORY.md
+plugins:
+  - name: github
+    timeout: 30s
+    config:
+      draft: true
+  - name: slack
+    config:
+      channel: "#releases"
+`)
+	local := writeFile("repo/.relicta.yaml", `
+extends: ../org/base.yaml
+versioning:
+  tag_prefix: v
+plugins:
+  - name: github
+    config:
+      draft: false
+  - name: npm
+`)
+
+	cfg, err := NewLoader().WithConfigPath(local).Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.Versioning.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %q, want local override v", cfg.Versioning.TagPrefix)
+	}
+	if cfg.Changelog.File != "HISTORY.md" {
+		t.Errorf("Changelog.File = %q, want inherited HISTORY.md", cfg.Changelog.File)
+	}
+
+	var names []string
+	for _, p := range cfg.Plugins {
+		names = append(names, p.Name)
+	}
+	if strings.Join(names, ",") != "github,slack,npm" {
+		t.Fatalf("plugins = %v, want github,slack,npm", names)
+	}
+	github := cfg.Plugins[0]
+	if github.Config["draft"] != false {
+		t.Errorf("github draft = %v, want local override false", github.Config["draft"])
+	}
+	if github.Timeout.String() != "30s" {
+		t.Errorf("github timeout = %v, want inherited 30s", github.Timeout)
+	}
+}
+
+func TestLoaderExtends_Remote(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("changelog:\n  file: REMOTE.md\n"))
+	}))
+	defer srv.Close()
+
+	local := filepath.Join(t.TempDir(), ".relicta.yaml")
+	if err := os.WriteFile(local, []byte("extends: "+srv.URL+"/base.yaml\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader().WithConfigPath(local).Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Changelog.File != "REMOTE.md" {
+		t.Errorf("Changelog.File = %q, want REMOTE.md", cfg.Changelog.File)
+	}
+}
+
+func TestLoaderExtends_Cycle(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.yaml")
+	b := filepath.Join(dir, "b.yaml")
+	if err := os.WriteFile(a, []byte("extends: b.yaml\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(b, []byte("extends: a.yaml\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewLoader().WithConfigPath(a).Load()
+	if err == nil || !strings.Contains(err.Error(), "cyclic config extends") {
+		t.Fatalf("Load() error = %v, want cyclic extends error", err)
+	}
+}
+
+func TestLoaderProfile(t *testing.T) {
+	local := filepath.Join(t.TempDir(), ".relicta.yaml")
+	if err := os.WriteFile(local, []byte(`
+versioning:
+  tag_prefix: v
+changelog:
+  file: CHANGELOG.md
+plugins:
+  - name: github
+    config:
+      draft: false
+  - name: slack
+profiles:
+  staging:
+    versioning:
+      tag_prefix: staging-v
+    plugins:
+      - name: github
+        config:
+          draft: true
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("flag selects profile", func(t *testing.T) {
+		cfg, err := NewLoader().WithConfigPath(local).WithProfile("staging").Load()
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Profile != "staging" {
+			t.Errorf("Profile = %q, want staging", cfg.Profile)
+		}
+		if cfg.Versioning.TagPrefix != "staging-v" {
+			t.Errorf("TagPrefix = %q, want profile override staging-v", cfg.Versioning.TagPrefix)
+		}
+		if cfg.Changelog.File != "CHANGELOG.md" {
+			t.Errorf("Changelog.File = %q, want base CHANGELOG.md", cfg.Changelog.File)
+		}
+		if len(cfg.Plugins) != 2 || cfg.Plugins[0].Config["draft"] != true {
+			t.Errorf("plugins = %+v, want github draft override with slack kept", cfg.Plugins)
+		}
+	})
+
+	t.Run("environment selects profile", func(t *testing.T) {
+		t.Setenv(ProfileEnvVar, "staging")
+		cfg, err := NewLoader().WithConfigPath(local).Load()
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Versioning.TagPrefix != "staging-v" {
+			t.Errorf("TagPrefix = %q, want staging-v", cfg.Versioning.TagPrefix)
+		}
+	})
+
+	t.Run("no profile keeps base config", func(t *testing.T) {
+		cfg, err := NewLoader().WithConfigPath(local).Load()
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Profile != "" || cfg.Versioning.TagPrefix != "v" {
+			t.Errorf("Profile = %q, TagPrefix = %q, want base config", cfg.Profile, cfg.Versioning.TagPrefix)
+		}
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := NewLoader().WithConfigPath(local).WithProfile("production").Load()
+		if err == nil || !strings.Contains(err.Error(), `config profile "production" not found (available: staging)`) {
+			t.Fatalf("Load() error = %v, want unknown profile error", err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Package config provides configuration management for Relicta.
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	// profilesKey is the top-level key holding named config profiles.
+	profilesKey = "profiles"
+	// ProfileEnvVar selects a profile when --profile is not given.
+	ProfileEnvVar = "RELICTA_PROFILE"
+)
+
+// selectedProfile returns the profile requested via WithProfile, falling
+// back to the RELICTA_PROFILE environment variable.
+func (l *Loader) selectedProfile() string {
+	if l.profile != "" {
+		return strings.TrimSpace(l.profile)
+	}
+	return strings.TrimSpace(os.Getenv(ProfileEnvVar))
+}
+
+// applyProfile merges the named profile over the loaded base config.
+// Profile names are matched case-insensitively since keys are normalized
+// when the config is read.
+func (l *Loader) applyProfile(name string) error {
+	profiles, _ := l.v.Get(profilesKey).(map[string]any)
+	overrides, ok := profiles[strings.ToLower(name)].(map[string]any)
+	if !ok {
+		return fmt.Errorf("config profile %q not found (available: %s)", name, availableProfiles(profiles))
+	}
+
+	overrides = maps.Clone(overrides)
+	delete(overrides, profilesKey)
+	delete(overrides, extendsKey)
+	if plugins, ok := overrides["plugins"]; ok {
+		overrides["plugins"] = mergePluginLists(l.v.Get("plugins"), plugins)
+	}
+	return l.v.MergeConfigMap(overrides)
+}
+
+// availableProfiles formats the defined profile names for error messages.
+func availableProfiles(profiles map[string]any) string {
+	if len(profiles) == 0 {
+		return "none defined"
+	}
+	names := slices.Sorted(maps.Keys(profiles))
+	return strings.Join(names, ", ")
+}
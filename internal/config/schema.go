@@ -7,6 +7,16 @@ import (
 
 // Config is the root configuration for Relicta.
 type Config struct {
+	// Extends is a path (relative to this file) or URL of a base config that
+	// this file is deep-merged over. It is resolved by the loader.
+	Extends string `mapstructure:"extends" json:"extends,omitempty"`
+	// Profiles holds named partial configs (e.g. staging, production) that
+	// are merged over the base config when selected with --profile or
+	// RELICTA_PROFILE.
+	Profiles map[string]map[string]any `mapstructure:"profiles" json:"profiles,omitempty"`
+	// Profile is the name of the active profile, if any. It is set by the
+	// loader and is not read from the config file.
+	Profile string `mapstructure:"-" json:"profile,omitempty"`
 	// Versioning configures version management.
 	Versioning VersioningConfig `mapstructure:"versioning" json:"versioning"`
 	// Git configures git operations and authentication.
@@ -29,8 +39,12 @@ type Config struct {
 	Webhooks []WebhookConfig `mapstructure:"webhooks" json:"webhooks,omitempty"`
 	// Monorepo configures multi-package/monorepo versioning support.
 	Monorepo MonorepoConfig `mapstructure:"monorepo" json:"monorepo,omitempty"`
+	// BlastRadius configures blast radius analysis for monorepos.
+	BlastRadius BlastRadiusConfig `mapstructure:"blast_radius" json:"blast_radius,omitempty"`
 	// Dashboard configures the self-hosted web dashboard.
 	Dashboard DashboardConfig `mapstructure:"dashboard" json:"dashboard,omitempty"`
+	// MCP configures the MCP server.
+	MCP MCPConfig `mapstructure:"mcp" json:"mcp,omitempty"`
 }
 
 // VersioningConfig configures version management.
@@ -39,20 +53,47 @@ type VersioningConfig struct {
 	Strategy string `mapstructure:"strategy" json:"strategy"`
 	// TagPrefix is the prefix for version tags (default: "v").
 	TagPrefix string `mapstructure:"tag_prefix" json:"tag_prefix"`
+	// TagPattern is a regular expression that tag names must match to count
+	// as version tags (e.g., `^v[0-9]+\.[0-9]+\.[0-9]+$`). Other tags are
+	// ignored when determining the current version.
+	TagPattern string `mapstructure:"tag_pattern" json:"tag_pattern,omitempty"`
 	// GitTag indicates whether to create a git tag.
 	GitTag bool `mapstructure:"git_tag" json:"git_tag"`
 	// GitPush indicates whether to push the tag to remote.
 	GitPush bool `mapstructure:"git_push" json:"git_push"`
-	// GitSign indicates whether to sign the tag with GPG.
+	// GitSign indicates whether to sign the tag (see SignFormat).
 	GitSign bool `mapstructure:"git_sign" json:"git_sign"`
+	// SignFormat is the tag signing format: "gpg" (default) or "ssh". SSH
+	// signing uses git.auth.ssh_key_path and requires the git CLI.
+	SignFormat string `mapstructure:"sign_format" json:"sign_format,omitempty"`
 	// PrereleaseSuffix is the suffix for prerelease versions (e.g., "alpha", "beta", "rc").
+	// It is used by 'bump --level prerelease' and to continue an existing
+	// prerelease series; stable releases stay stable.
 	PrereleaseSuffix string `mapstructure:"prerelease_suffix" json:"prerelease_suffix,omitempty"`
 	// BuildMetadata is optional build metadata to append to the version.
 	BuildMetadata string `mapstructure:"build_metadata" json:"build_metadata,omitempty"`
 	// BumpFrom specifies where to read the current version from (tag, file, package.json).
 	BumpFrom string `mapstructure:"bump_from" json:"bump_from"`
-	// VersionFile is the file to update with the new version (if BumpFrom is "file").
+	// VersionFile is the file the current version is read from and the new
+	// version written to (if BumpFrom is "file"; defaults to package.json
+	// for "package.json").
 	VersionFile string `mapstructure:"version_file" json:"version_file,omitempty"`
+	// StrictVersionCheck fails planning when a version file disagrees with the latest tag.
+	StrictVersionCheck bool `mapstructure:"strict_version_check" json:"strict_version_check,omitempty"`
+	// NonConventionalPolicy controls how commits that are not conventional
+	// commits are handled during plan: "infer" (default) classifies them,
+	// "ignore" keeps them out of the version calculation, "patch" treats
+	// them as patch-level changes and "error" fails the plan.
+	NonConventionalPolicy string `mapstructure:"nonconventional_policy" json:"nonconventional_policy,omitempty"`
+	// CommitConvention selects how commit messages are parsed for the
+	// version bump and changelog: "conventional" (default), "gitmoji" or
+	// "custom" (classified by TypePatterns).
+	CommitConvention string `mapstructure:"commit_convention" json:"commit_convention,omitempty"`
+	// TypePatterns maps commit types (feat, fix, docs, ...) and "breaking"
+	// to regular expressions for the custom commit convention. Type patterns
+	// match the subject line and may capture the named groups "scope" and
+	// "subject"; the breaking pattern matches the whole message.
+	TypePatterns map[string]string `mapstructure:"type_patterns" json:"type_patterns,omitempty"`
 }
 
 // GitConfig configures git operations and authentication.
@@ -74,12 +115,14 @@ type GitAuthConfig struct {
 	// "ssh" uses SSH key authentication.
 	// "basic" uses username/password authentication.
 	Type string `mapstructure:"type" json:"type,omitempty"`
-	// Token is the personal access token for HTTPS auth (can use env var expansion).
+	// Token is the personal access token for HTTPS auth (can use env var
+	// expansion or a secret:// reference).
 	// Used when Type is "token" or for GitHub/GitLab APIs.
 	Token string `mapstructure:"token" json:"token,omitempty"`
 	// Username is the username for basic auth.
 	Username string `mapstructure:"username" json:"username,omitempty"`
-	// Password is the password for basic auth (can use env var expansion).
+	// Password is the password for basic auth (can use env var expansion or
+	// a secret:// reference).
 	Password string `mapstructure:"password" json:"password,omitempty"`
 	// SSHKeyPath is the path to the SSH private key file.
 	SSHKeyPath string `mapstructure:"ssh_key_path" json:"ssh_key_path,omitempty"`
@@ -123,8 +166,15 @@ type ChangelogConfig struct {
 	IssueURL string `mapstructure:"issue_url" json:"issue_url,omitempty"`
 	// Exclude lists commit types to exclude from the changelog.
 	Exclude []string `mapstructure:"exclude" json:"exclude,omitempty"`
+	// ExcludeScopes lists commit scopes to omit from the changelog. Entries
+	// may be globs (e.g. "i18n-*"). Excluded commits still count toward the
+	// version bump.
+	ExcludeScopes []string `mapstructure:"exclude_scopes" json:"exclude_scopes,omitempty"`
 	// Categories customizes category labels for commit types.
 	Categories map[string]string `mapstructure:"categories" json:"categories,omitempty"`
+	// HighlightsCount is the number of highlights extracted for release
+	// summaries (0 disables highlights).
+	HighlightsCount int `mapstructure:"highlights_count" json:"highlights_count"`
 }
 
 // AIConfig configures AI integration.
@@ -144,7 +194,8 @@ type AIConfig struct {
 	// For Gemini: "gemini-2.0-flash-exp", "gemini-1.5-pro", "gemini-1.5-flash", etc.
 	// For Azure OpenAI: Use your deployment name.
 	Model string `mapstructure:"model" json:"model"`
-	// APIKey is the API key (can use environment variable expansion).
+	// APIKey is the API key (can use environment variable expansion or a
+	// secret:// reference, resolved when AI is first used).
 	APIKey string `mapstructure:"api_key" json:"api_key,omitempty"`
 	// BaseURL is the API base URL (for custom endpoints).
 	// For Ollama, defaults to "http://localhost:11434/v1".
@@ -226,6 +277,13 @@ type PluginConfig struct {
 	Timeout time.Duration `mapstructure:"timeout" json:"timeout,omitempty"`
 	// ContinueOnError indicates whether to continue if the plugin fails.
 	ContinueOnError bool `mapstructure:"continue_on_error" json:"continue_on_error"`
+	// Priority orders execution within a hook: higher values run first and
+	// plugins with equal priority run concurrently (default: 0). Ties are
+	// ordered by name.
+	Priority int `mapstructure:"priority" json:"priority,omitempty"`
+	// RunAfter lists plugins that must finish before this plugin runs within
+	// a hook. Their outputs are exposed via ReleaseContext.PluginOutputs.
+	RunAfter []string `mapstructure:"run_after" json:"run_after,omitempty"`
 	// Capabilities defines security restrictions for the plugin.
 	Capabilities *PluginCapabilities `mapstructure:"capabilities" json:"capabilities,omitempty"`
 }
@@ -250,14 +308,25 @@ type WorkflowConfig struct {
 	RequireUpToDate bool `mapstructure:"require_up_to_date" json:"require_up_to_date"`
 	// DryRunByDefault runs in dry-run mode by default.
 	DryRunByDefault bool `mapstructure:"dry_run_by_default" json:"dry_run_by_default"`
-	// AutoCommitChangelog automatically commits changelog changes.
+	// AutoCommitChangelog commits the updated changelog after publishing.
+	// The commit is made locally after the release tag and is not pushed.
 	AutoCommitChangelog bool `mapstructure:"auto_commit_changelog" json:"auto_commit_changelog"`
 	// ChangelogCommitMessage is the commit message for changelog updates.
 	ChangelogCommitMessage string `mapstructure:"changelog_commit_message" json:"changelog_commit_message,omitempty"`
+	// ReleaseCommitTrailers are git trailers ("Key: value") appended to the
+	// changelog commit. Values may use ${version}, ${tag}, ${run_id}, ${risk}
+	// and ${commit}.
+	ReleaseCommitTrailers []string `mapstructure:"release_commit_trailers" json:"release_commit_trailers,omitempty"`
 	// PreReleaseHook is a command to run before the release.
 	PreReleaseHook string `mapstructure:"pre_release_hook" json:"pre_release_hook,omitempty"`
 	// PostReleaseHook is a command to run after the release.
 	PostReleaseHook string `mapstructure:"post_release_hook" json:"post_release_hook,omitempty"`
+	// OnPluginFailure controls the release outcome when a plugin step fails
+	// during publish (fail, warn, rollback). Defaults to "fail".
+	OnPluginFailure string `mapstructure:"on_plugin_failure" json:"on_plugin_failure,omitempty"`
+	// CleanupPrereleasesOnStable deletes the prerelease tags of a version
+	// (e.g. v1.2.0-rc.*) once its stable release is published.
+	CleanupPrereleasesOnStable bool `mapstructure:"cleanup_prereleases_on_stable" json:"cleanup_prereleases_on_stable"`
 }
 
 // OutputConfig configures output settings.
@@ -276,6 +345,9 @@ type OutputConfig struct {
 	LogLevel string `mapstructure:"log_level" json:"log_level"`
 	// PluginAuditLog is the path to the plugin audit log file.
 	PluginAuditLog string `mapstructure:"plugin_audit_log" json:"plugin_audit_log,omitempty"`
+	// Strict treats warnings as errors: a command that reports warnings
+	// exits non-zero after listing them (same as --strict).
+	Strict bool `mapstructure:"strict" json:"strict,omitempty"`
 }
 
 // TelemetryConfig configures observability and tracing.
@@ -304,7 +376,8 @@ type TracingConfig struct {
 type MetricsConfig struct {
 	// Enabled indicates whether metrics are enabled.
 	Enabled bool `mapstructure:"enabled" json:"enabled"`
-	// Endpoint is the metrics endpoint (for Prometheus scraping).
+	// Endpoint is the path metrics are served on for Prometheus scraping
+	// (default /metrics). A URL contributes its path.
 	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
 	// Port is the port for the metrics HTTP server.
 	Port int `mapstructure:"port" json:"port"`
@@ -342,6 +415,42 @@ type GovernanceConfig struct {
 	PolicyDir string `mapstructure:"policy_dir" json:"policy_dir,omitempty"`
 	// Policies is a list of custom policy rules defined inline in YAML.
 	Policies []GovernancePolicyConfig `mapstructure:"policies" json:"policies,omitempty"`
+	// RiskWeights overrides the contribution of individual risk factors
+	// (e.g. "api_change", "blast_radius"). Weights are normalized to sum to 1.0.
+	RiskWeights map[string]float64 `mapstructure:"risk_weights" json:"risk_weights,omitempty"`
+	// ApprovalTTL is how long an approval remains valid (e.g. "24h").
+	// The expiry is recorded on the approval when it is granted; publishing
+	// after it requires re-approval. Zero disables expiry.
+	ApprovalTTL time.Duration `mapstructure:"approval_ttl" json:"approval_ttl,omitempty"`
+	// ApprovalLevels lists the approval levels (technical, security, manager,
+	// release) a release needs when it is approved level by level, in order.
+	// Empty requires the release level only.
+	ApprovalLevels []string `mapstructure:"approval_levels" json:"approval_levels,omitempty"`
+	// SequentialApprovals requires the approval levels to be granted in order.
+	SequentialApprovals bool `mapstructure:"sequential_approvals" json:"sequential_approvals,omitempty"`
+	// RequireLinkedIssue flags commits that do not reference an issue. In strict
+	// mode such commits require human review; otherwise they are reported as warnings.
+	RequireLinkedIssue bool `mapstructure:"require_linked_issue" json:"require_linked_issue,omitempty"`
+	// LinkedIssueExemptTypes are commit types that need no linked issue (default: chore, docs).
+	LinkedIssueExemptTypes []string `mapstructure:"linked_issue_exempt_types" json:"linked_issue_exempt_types,omitempty"`
+	// IssuePatterns are regular expressions matching issue references
+	// (default: #123, GH-123 and PROJ-123).
+	IssuePatterns []string `mapstructure:"issue_patterns" json:"issue_patterns,omitempty"`
+	// Advisories configures the advisory lookup for updated dependencies.
+	Advisories AdvisoryConfig `mapstructure:"advisories" json:"advisories,omitempty"`
+}
+
+// AdvisoryConfig configures how dependency updates in go.mod, package.json
+// and Cargo.lock are checked for known vulnerabilities during evaluation.
+type AdvisoryConfig struct {
+	// Source is the advisory source: "none" (default, offline), "osv" or "database".
+	Source string `mapstructure:"source" json:"source,omitempty"`
+	// Database is the path to a local advisory database (JSON) when Source is "database".
+	Database string `mapstructure:"database" json:"database,omitempty"`
+	// URL overrides the OSV batch query endpoint when Source is "osv".
+	URL string `mapstructure:"url" json:"url,omitempty"`
+	// Timeout bounds each advisory lookup so it cannot hang a release (default: 10s).
+	Timeout time.Duration `mapstructure:"timeout" json:"timeout,omitempty"`
 }
 
 // GovernancePolicyConfig configures a custom governance policy rule.
@@ -409,6 +518,7 @@ func DefaultConfig() *Config {
 			IncludeDate:       true,
 			LinkCommits:       false, // Auto-enabled if repository_url is detected from git
 			LinkIssues:        false, // Must be explicitly enabled with issue_url
+			HighlightsCount:   5,
 			Exclude:           []string{"chore", "ci", "docs", "style", "test"},
 			Categories: map[string]string{
 				"feat":     "Features",
@@ -445,8 +555,9 @@ func DefaultConfig() *Config {
 			RequireCleanWorkingTree: true,
 			RequireUpToDate:         false,
 			DryRunByDefault:         false,
-			AutoCommitChangelog:     true,
+			AutoCommitChangelog:     false,
 			ChangelogCommitMessage:  "chore(release): update changelog for ${version}",
+			OnPluginFailure:         "fail",
 		},
 		Output: OutputConfig{
 			Format:   "text",
@@ -488,6 +599,7 @@ func DefaultConfig() *Config {
 			ReleaseGroups:          []ReleaseGroupConfig{},
 			RootPackage:            false,
 			DependencyCoordination: true, // Coordinate internal dependency updates by default
+			CascadeBump:            "patch",
 			VersionFiles:           defaultVersionFiles(),
 			Changelog: MonorepoChangelogConfig{
 				PerPackage:          true, // Generate per-package changelogs
@@ -509,6 +621,12 @@ func DefaultConfig() *Config {
 				SessionMaxAge: 24 * time.Hour,
 			},
 		},
+		MCP: MCPConfig{
+			RecordTranscript:   false, // Opt-in, transcripts are for debugging
+			TranscriptFile:     ".relicta/mcp-transcript.jsonl",
+			TranscriptMaxBytes: 10 * 1024 * 1024,
+			Actor:              "mcp-agent",
+		},
 	}
 }
 
@@ -583,6 +701,10 @@ type GitHubPluginConfig struct {
 	GenerateReleaseNotes bool `mapstructure:"generate_release_notes" json:"generate_release_notes"`
 	// Assets is a list of files to upload as release assets.
 	Assets []string `mapstructure:"assets" json:"assets,omitempty"`
+	// AssetNameTemplate renames uploaded assets with a Go template using
+	// {{.Version}}, {{.OS}}, {{.Arch}}, {{.Base}} and {{.Ext}} derived from
+	// the local file name (e.g. "myapp_{{.Version}}_{{.OS}}_{{.Arch}}{{.Ext}}").
+	AssetNameTemplate string `mapstructure:"asset_name_template" json:"asset_name_template,omitempty"`
 	// DiscussionCategory creates a discussion for the release.
 	DiscussionCategory string `mapstructure:"discussion_category" json:"discussion_category,omitempty"`
 }
@@ -667,6 +789,10 @@ type GitLabPluginConfig struct {
 	Milestones []string `mapstructure:"milestones" json:"milestones,omitempty"`
 	// Assets is a list of files to upload as release assets.
 	Assets []string `mapstructure:"assets" json:"assets,omitempty"`
+	// AssetNameTemplate renames uploaded assets with a Go template using
+	// {{.Version}}, {{.OS}}, {{.Arch}}, {{.Base}} and {{.Ext}} derived from
+	// the local file name (e.g. "myapp_{{.Version}}_{{.OS}}_{{.Arch}}{{.Ext}}").
+	AssetNameTemplate string `mapstructure:"asset_name_template" json:"asset_name_template,omitempty"`
 	// AssetLinks is a list of external asset links.
 	AssetLinks []GitLabAssetLink `mapstructure:"asset_links" json:"asset_links,omitempty"`
 }
@@ -750,6 +876,17 @@ type MonorepoConfig struct {
 	// DependencyCoordination enables automatic dependency version updates
 	// when releasing packages with internal dependencies.
 	DependencyCoordination bool `mapstructure:"dependency_coordination" json:"dependency_coordination"`
+	// AnalysisConcurrency bounds the number of commits analyzed in parallel
+	// when attributing changes to packages (default: number of CPUs).
+	AnalysisConcurrency int `mapstructure:"analysis_concurrency" json:"analysis_concurrency,omitempty"`
+	// CascadeBreaking releases every transitive dependent of a package with a
+	// breaking change and updates their internal dependency constraints.
+	CascadeBreaking bool `mapstructure:"cascade_breaking" json:"cascade_breaking"`
+	// CascadeBump is the minimum bump of a cascaded dependent (patch or minor).
+	CascadeBump string `mapstructure:"cascade_bump" json:"cascade_bump,omitempty"`
+	// MaxCascadeDepth limits how many dependency levels a cascade follows
+	// (default: 0, unlimited).
+	MaxCascadeDepth int `mapstructure:"max_cascade_depth" json:"max_cascade_depth,omitempty"`
 }
 
 // PackageOverrideConfig provides per-package configuration overrides.
@@ -809,7 +946,8 @@ type ReleaseGroupConfig struct {
 	// TagPrefix is the tag prefix for lockstep releases.
 	// Example: "core-v" produces tags like "core-v1.2.3"
 	TagPrefix string `mapstructure:"tag_prefix" json:"tag_prefix,omitempty"`
-	// SharedChangelog indicates whether packages share a single changelog.
+	// SharedChangelog aggregates the group's commits in the changelog of each
+	// released member (requires monorepo.changelog.per_package).
 	SharedChangelog bool `mapstructure:"shared_changelog" json:"shared_changelog"`
 }
 
@@ -864,12 +1002,13 @@ type WebhookConfig struct {
 	// URL is the webhook endpoint URL.
 	URL string `mapstructure:"url" json:"url"`
 	// Secret is an optional HMAC secret for signing payloads.
-	// When set, payloads are signed with X-Relicta-Signature header.
+	// When set, payloads are signed with the X-Relicta-Webhook-Signature header.
 	Secret string `mapstructure:"secret" json:"secret,omitempty"`
 	// Events is a list of event names to send (empty = all events).
 	// Event names: release.initialized, release.planned, release.versioned,
-	// release.notes_generated, release.approved, release.published,
-	// release.failed, release.canceled, release.plugin_executed
+	// release.notes_generated, release.approved, release.rejected,
+	// release.published, release.failed, release.canceled,
+	// release.plugin_executed
 	// Supports wildcards like "release.*" for all release events.
 	Events []string `mapstructure:"events" json:"events,omitempty"`
 	// Headers are custom headers to include in the request.
@@ -878,10 +1017,16 @@ type WebhookConfig struct {
 	Timeout time.Duration `mapstructure:"timeout" json:"timeout,omitempty"`
 	// RetryCount is the number of retries for failed requests (default: 3).
 	RetryCount int `mapstructure:"retry_count" json:"retry_count,omitempty"`
-	// RetryDelay is the delay between retries (default: 1s).
+	// RetryDelay is the base delay between retries (default: 1s). The delay
+	// doubles with each retry, with jitter, up to MaxRetryDelay.
 	RetryDelay time.Duration `mapstructure:"retry_delay" json:"retry_delay,omitempty"`
+	// MaxRetryDelay caps the delay between retries (default: 30s).
+	MaxRetryDelay time.Duration `mapstructure:"max_retry_delay" json:"max_retry_delay,omitempty"`
 	// Enabled indicates whether this webhook is active (default: true).
 	Enabled *bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// IncludeManifest embeds the full publish manifest (all targets and
+	// their outputs) in the published event payload (default: false).
+	IncludeManifest bool `mapstructure:"include_manifest" json:"include_manifest,omitempty"`
 }
 
 // IsWebhookEnabled returns whether the webhook is enabled.
@@ -978,3 +1123,19 @@ var ConfigFileExtensions = []string{
 	"json",
 	"toml",
 }
+
+// MCPConfig configures the MCP server.
+type MCPConfig struct {
+	// RecordTranscript records each MCP session to TranscriptFile, with
+	// secrets redacted. Recording can also be toggled at runtime with the
+	// relicta.transcript tool.
+	RecordTranscript bool `mapstructure:"record_transcript" json:"record_transcript"`
+	// TranscriptFile is the JSONL transcript path (default: ".relicta/mcp-transcript.jsonl").
+	TranscriptFile string `mapstructure:"transcript_file" json:"transcript_file,omitempty"`
+	// TranscriptMaxBytes bounds the transcript size (default: 10 MiB).
+	// Recording stops once the limit is reached.
+	TranscriptMaxBytes int64 `mapstructure:"transcript_max_bytes" json:"transcript_max_bytes,omitempty"`
+	// Actor identifies the MCP client as the approver of the approvals it
+	// grants, unless a tool call names its own approver (default: "mcp-agent").
+	Actor string `mapstructure:"actor" json:"actor,omitempty"`
+}
@@ -5,11 +5,17 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 
+	"github.com/relicta-tech/relicta/internal/cgp/risk"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
 	rperrors "github.com/relicta-tech/relicta/internal/errors"
+	"github.com/relicta-tech/relicta/pkg/plugin"
 )
 
 // openAIKeyLength is the standard length of OpenAI API keys (e.g., "sk-..." format).
@@ -80,12 +86,7 @@ func NewValidator() *Validator {
 
 // Validate validates the configuration.
 func (v *Validator) Validate(cfg *Config) error {
-	v.validateVersioning(cfg.Versioning)
-	v.validateChangelog(cfg.Changelog)
-	v.validateAI(cfg.AI)
-	v.validatePlugins(cfg.Plugins)
-	v.validateWorkflow(cfg.Workflow)
-	v.validateOutput(cfg.Output)
+	v.Check(cfg)
 
 	// Print warnings to stderr even if there are no errors
 	if v.errors.HasWarnings() {
@@ -103,6 +104,34 @@ func (v *Validator) Validate(cfg *Config) error {
 	return nil
 }
 
+// Check runs all validations and returns the collected errors and warnings
+// without printing them. The returned value is never nil.
+func (v *Validator) Check(cfg *Config) *ValidationError {
+	v.validateVersioning(cfg.Versioning)
+	v.validateSigning(cfg.Versioning, cfg.Git)
+	v.validateChangelog(cfg.Changelog)
+	v.validateAI(cfg.AI)
+	v.validatePlugins(cfg.Plugins)
+	v.validateWorkflow(cfg.Workflow)
+	v.validateOutput(cfg.Output)
+	v.validateGovernance(cfg.Governance)
+	v.validateReleaseGroups(cfg.Monorepo.ReleaseGroups)
+	if cfg.Monorepo.AnalysisConcurrency < 0 {
+		v.errors.Addf("monorepo.analysis_concurrency: must be non-negative, got %d", cfg.Monorepo.AnalysisConcurrency)
+	}
+	switch cfg.Monorepo.CascadeBump {
+	case "", "patch", "minor":
+	default:
+		v.errors.Addf("monorepo.cascade_bump: must be patch or minor, got %q", cfg.Monorepo.CascadeBump)
+	}
+	if cfg.Monorepo.MaxCascadeDepth < 0 {
+		v.errors.Addf("monorepo.max_cascade_depth: must be non-negative, got %d", cfg.Monorepo.MaxCascadeDepth)
+	}
+	v.validateMCP(cfg.MCP)
+
+	return v.errors
+}
+
 // validateVersioning validates versioning configuration.
 func (v *Validator) validateVersioning(cfg VersioningConfig) {
 	// Validate strategy
@@ -123,6 +152,48 @@ func (v *Validator) validateVersioning(cfg VersioningConfig) {
 	}
 
 	// Note: Empty tag_prefix is valid (some repos use tags without prefix)
+
+	if cfg.TagPattern != "" {
+		if _, err := regexp.Compile(cfg.TagPattern); err != nil {
+			v.errors.Addf("versioning.tag_pattern: invalid regular expression: %v", err)
+		}
+	}
+
+	validPolicies := []string{"", "infer", "ignore", "patch", "error"}
+	if !slices.Contains(validPolicies, cfg.NonConventionalPolicy) {
+		v.errors.Addf("versioning.nonconventional_policy: must be one of [infer ignore patch error], got %q", cfg.NonConventionalPolicy)
+	}
+
+	switch changes.CommitConvention(cfg.CommitConvention) {
+	case "", changes.ConventionConventional, changes.ConventionGitmoji:
+		if len(cfg.TypePatterns) > 0 {
+			v.errors.Addf("versioning.type_patterns: only used with commit_convention 'custom'")
+		}
+	case changes.ConventionCustom:
+		if _, err := changes.NewPatternParser(cfg.TypePatterns); err != nil {
+			v.errors.Addf("versioning.type_patterns: %v", err)
+		}
+	default:
+		v.errors.Addf("versioning.commit_convention: must be one of [conventional gitmoji custom], got %q", cfg.CommitConvention)
+	}
+}
+
+// validateSigning validates tag signing configuration.
+func (v *Validator) validateSigning(versioning VersioningConfig, git GitConfig) {
+	validFormats := []string{"", "gpg", "ssh"}
+	if !slices.Contains(validFormats, versioning.SignFormat) {
+		v.errors.Addf("versioning.sign_format: must be one of [gpg ssh], got %q", versioning.SignFormat)
+		return
+	}
+	if !versioning.GitSign || versioning.SignFormat != "ssh" {
+		return
+	}
+	if git.Auth.SSHKeyPath == "" {
+		v.errors.Addf("versioning.sign_format: 'ssh' requires git.auth.ssh_key_path")
+	}
+	if !git.UseCLI() {
+		v.errors.Addf("versioning.sign_format: 'ssh' requires git.use_cli_fallback")
+	}
 }
 
 // validateChangelog validates changelog configuration.
@@ -173,6 +244,16 @@ func (v *Validator) validateChangelog(cfg ChangelogConfig) {
 		}
 	}
 
+	if cfg.HighlightsCount < 0 {
+		v.errors.Addf("changelog.highlights_count: must be non-negative, got %d", cfg.HighlightsCount)
+	}
+
+	for _, pattern := range cfg.ExcludeScopes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			v.errors.Addf("changelog.exclude_scopes: invalid pattern %q: %v", pattern, err)
+		}
+	}
+
 	// Validate changelog file path
 	// Note: If changelog directory doesn't exist, it will be created when needed
 }
@@ -236,15 +317,13 @@ func (v *Validator) validateAI(cfg AIConfig) {
 	}
 
 	// Validate tone
-	validTones := []string{"technical", "friendly", "professional", "excited"}
-	if !slices.Contains(validTones, cfg.Tone) {
-		v.errors.Addf("ai.tone: must be one of %v, got %q", validTones, cfg.Tone)
+	if !slices.Contains(releasedomain.NotesTones, cfg.Tone) {
+		v.errors.Addf("ai.tone: must be one of %v, got %q", releasedomain.NotesTones, cfg.Tone)
 	}
 
 	// Validate audience
-	validAudiences := []string{"developers", "users", "public", "marketing"}
-	if !slices.Contains(validAudiences, cfg.Audience) {
-		v.errors.Addf("ai.audience: must be one of %v, got %q", validAudiences, cfg.Audience)
+	if !slices.Contains(releasedomain.NotesAudiences, cfg.Audience) {
+		v.errors.Addf("ai.audience: must be one of %v, got %q", releasedomain.NotesAudiences, cfg.Audience)
 	}
 
 	// Validate temperature
@@ -290,6 +369,10 @@ func (v *Validator) validatePlugins(plugins []PluginConfig) {
 			continue
 		}
 
+		if err := ValidatePluginName(plugin.Name); err != nil {
+			v.errors.Addf("plugins[%d].name: %v", i, err)
+		}
+
 		// Check for duplicates
 		if seenNames[plugin.Name] {
 			v.errors.Addf("plugins[%d].name: duplicate plugin name %q", i, plugin.Name)
@@ -327,6 +410,88 @@ func (v *Validator) validatePlugins(plugins []PluginConfig) {
 		// Plugin-specific validation
 		v.validatePluginConfig(i, plugin)
 	}
+
+	// Validate run_after references
+	for i, plugin := range plugins {
+		for _, dep := range plugin.RunAfter {
+			switch {
+			case dep == plugin.Name:
+				v.errors.Addf("plugins[%d].run_after: plugin %q cannot depend on itself", i, dep)
+			case !seenNames[dep]:
+				v.errors.Addf("plugins[%d].run_after: unknown plugin %q", i, dep)
+			}
+		}
+	}
+	if err := CheckPluginDependencies(plugins); err != nil {
+		v.errors.Addf("plugins.run_after: %v", err)
+	}
+}
+
+// CheckPluginDependencies reports a dependency cycle among the plugins'
+// run_after declarations. References to unknown plugins are ignored.
+func CheckPluginDependencies(plugins []PluginConfig) error {
+	deps := make(map[string][]string, len(plugins))
+	for _, p := range plugins {
+		deps[p.Name] = p.RunAfter
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(plugins))
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return fmt.Errorf("dependency cycle: %s", strings.Join(append(path[start:], name), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if _, ok := deps[dep]; !ok || dep == name {
+				continue
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, p := range plugins {
+		if err := visit(p.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePluginName checks if the plugin name contains only allowed characters.
+// It is shared by config validation and the plugin manager so invalid names
+// are reported at config time rather than when the plugin loads.
+func ValidatePluginName(name string) error {
+	// Only allow alphanumeric characters, hyphens, and underscores
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return fmt.Errorf("plugin name contains invalid character: %q", r)
+		}
+	}
+	if name == "" {
+		return fmt.Errorf("plugin name cannot be empty")
+	}
+	if len(name) > 64 {
+		return fmt.Errorf("plugin name too long (max 64 characters)")
+	}
+	return nil
 }
 
 // validatePluginConfig validates plugin-specific configuration.
@@ -334,6 +499,9 @@ func (v *Validator) validatePluginConfig(index int, plugin PluginConfig) {
 	switch plugin.Name {
 	case "github":
 		v.validateGitHubPlugin(index, plugin.Config)
+		v.validateAssetNameTemplate(index, plugin.Config)
+	case "gitlab":
+		v.validateAssetNameTemplate(index, plugin.Config)
 	case "npm":
 		v.validateNPMPlugin(index, plugin.Config)
 	case "slack":
@@ -358,6 +526,18 @@ func (v *Validator) validateGitHubPlugin(index int, config map[string]any) {
 	// Token validation is optional - user might set it via GITHUB_TOKEN environment variable
 }
 
+// validateAssetNameTemplate validates the asset_name_template of a
+// publishing plugin.
+func (v *Validator) validateAssetNameTemplate(index int, config map[string]any) {
+	text, ok := config["asset_name_template"].(string)
+	if !ok || text == "" {
+		return
+	}
+	if _, err := plugin.ParseAssetNameTemplate(text); err != nil {
+		v.errors.Addf("plugins[%d].config.asset_name_template: %v", index, err)
+	}
+}
+
 // validateNPMPlugin validates npm plugin configuration.
 func (v *Validator) validateNPMPlugin(index int, config map[string]any) {
 	if config == nil {
@@ -400,6 +580,19 @@ func (v *Validator) validateSlackPlugin(index int, config map[string]any) {
 	}
 }
 
+// releaseTrailerRe matches a git trailer line ("Key: value").
+var releaseTrailerRe = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*):\s*(\S.*)$`)
+
+// ParseReleaseTrailer splits a release commit trailer line into its key and
+// value. It reports false if line is not a "Key: value" trailer.
+func ParseReleaseTrailer(line string) (key, value string, ok bool) {
+	m := releaseTrailerRe.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
 // validateWorkflow validates workflow configuration.
 func (v *Validator) validateWorkflow(cfg WorkflowConfig) {
 	// Validate allowed_branches
@@ -410,6 +603,19 @@ func (v *Validator) validateWorkflow(cfg WorkflowConfig) {
 	if cfg.AutoCommitChangelog && cfg.ChangelogCommitMessage == "" {
 		v.errors.Addf("workflow.changelog_commit_message: required when auto_commit_changelog is enabled")
 	}
+
+	// Validate release_commit_trailers
+	for i, trailer := range cfg.ReleaseCommitTrailers {
+		if _, _, ok := ParseReleaseTrailer(trailer); !ok {
+			v.errors.Addf("workflow.release_commit_trailers[%d]: must be \"Key: value\", got %q", i, trailer)
+		}
+	}
+
+	// Validate on_plugin_failure (empty means the default "fail")
+	validFailureModes := []string{"fail", "warn", "rollback"}
+	if cfg.OnPluginFailure != "" && !slices.Contains(validFailureModes, cfg.OnPluginFailure) {
+		v.errors.Addf("workflow.on_plugin_failure: must be one of %v, got %q", validFailureModes, cfg.OnPluginFailure)
+	}
 }
 
 // validateOutput validates output configuration.
@@ -442,11 +648,138 @@ func (v *Validator) validateOutput(cfg OutputConfig) {
 	}
 }
 
+// validateMCP validates MCP server configuration.
+func (v *Validator) validateMCP(cfg MCPConfig) {
+	if cfg.TranscriptMaxBytes < 0 {
+		v.errors.Addf("mcp.transcript_max_bytes: must be non-negative, got %d", cfg.TranscriptMaxBytes)
+	}
+	if cfg.RecordTranscript && cfg.TranscriptFile == "" {
+		v.errors.Addf("mcp.transcript_file: required when mcp.record_transcript is enabled")
+	}
+}
+
+// validateGovernance validates governance configuration.
+func (v *Validator) validateGovernance(cfg GovernanceConfig) {
+	if cfg.AutoApproveThreshold < 0 || cfg.AutoApproveThreshold > 1 {
+		v.errors.Addf("governance.auto_approve_threshold: must be between 0 and 1, got %v", cfg.AutoApproveThreshold)
+	}
+	if cfg.MaxAutoApproveRisk < 0 || cfg.MaxAutoApproveRisk > 1 {
+		v.errors.Addf("governance.max_auto_approve_risk: must be between 0 and 1, got %v", cfg.MaxAutoApproveRisk)
+	}
+
+	knownFactors := risk.FactorNames()
+	names := make([]string, 0, len(cfg.RiskWeights))
+	for name := range cfg.RiskWeights {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if !slices.Contains(knownFactors, name) {
+			v.errors.Warnf("governance.risk_weights: unknown risk factor %q will be ignored (known factors: %v)", name, knownFactors)
+			continue
+		}
+		if cfg.RiskWeights[name] < 0 {
+			v.errors.Addf("governance.risk_weights.%s: weight must be non-negative, got %v", name, cfg.RiskWeights[name])
+		}
+	}
+
+	if cfg.ApprovalTTL < 0 {
+		v.errors.Addf("governance.approval_ttl: must be non-negative, got %s", cfg.ApprovalTTL)
+	}
+
+	validLevels := []string{"technical", "security", "manager", "release"}
+	for i, level := range cfg.ApprovalLevels {
+		if !slices.Contains(validLevels, level) {
+			v.errors.Addf("governance.approval_levels[%d]: must be one of %v, got %q", i, validLevels, level)
+		} else if slices.Index(cfg.ApprovalLevels, level) != i {
+			v.errors.Addf("governance.approval_levels[%d]: duplicate level %q", i, level)
+		}
+	}
+
+	for i, pattern := range cfg.IssuePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			v.errors.Addf("governance.issue_patterns[%d]: invalid regular expression: %v", i, err)
+		}
+	}
+
+	validSources := []string{"", "none", "osv", "database"}
+	if !slices.Contains(validSources, cfg.Advisories.Source) {
+		v.errors.Addf("governance.advisories.source: must be one of none, osv, database, got %q", cfg.Advisories.Source)
+	}
+	if cfg.Advisories.Source == "database" && cfg.Advisories.Database == "" {
+		v.errors.Addf("governance.advisories.database: required when governance.advisories.source is \"database\"")
+	}
+	if cfg.Advisories.Timeout < 0 {
+		v.errors.Addf("governance.advisories.timeout: must be non-negative, got %s", cfg.Advisories.Timeout)
+	}
+}
+
+// validateReleaseGroups validates monorepo release groups. A package may
+// belong to exactly one group, so package patterns must not overlap across groups.
+func (v *Validator) validateReleaseGroups(groups []ReleaseGroupConfig) {
+	validStrategies := []MonorepoStrategy{MonorepoStrategyLockstep, MonorepoStrategyIndependent}
+	names := make(map[string]int, len(groups))
+
+	for i, group := range groups {
+		if group.Name == "" {
+			v.errors.Addf("monorepo.release_groups[%d].name: required", i)
+		} else if prev, ok := names[group.Name]; ok {
+			v.errors.Addf("monorepo.release_groups[%d].name: duplicate group name %q (also used by release_groups[%d])", i, group.Name, prev)
+		} else {
+			names[group.Name] = i
+		}
+
+		if !slices.Contains(validStrategies, group.Strategy) {
+			v.errors.Addf("monorepo.release_groups[%d].strategy: must be one of %v, got %q", i, validStrategies, group.Strategy)
+		}
+		if len(group.Packages) == 0 {
+			v.errors.Addf("monorepo.release_groups[%d].packages: at least one package is required", i)
+		}
+
+		for _, pattern := range group.Packages {
+			if _, err := path.Match(pattern, ""); err != nil {
+				v.errors.Addf("monorepo.release_groups[%d].packages: invalid pattern %q: %v", i, pattern, err)
+			}
+		}
+
+		for j := range i {
+			for _, a := range groups[j].Packages {
+				for _, b := range group.Packages {
+					if packagePatternsOverlap(a, b) {
+						v.errors.Addf("monorepo.release_groups[%d].packages: %q overlaps %q in release_groups[%d]; a package can belong to only one group", i, b, a, j)
+					}
+				}
+			}
+		}
+	}
+}
+
+// packagePatternsOverlap reports whether two package path patterns can match
+// the same package.
+func packagePatternsOverlap(a, b string) bool {
+	a, b = path.Clean(a), path.Clean(b)
+	if a == b {
+		return true
+	}
+	if ok, _ := path.Match(a, b); ok {
+		return true
+	}
+	ok, _ := path.Match(b, a)
+	return ok
+}
+
 // Validate is a convenience function to validate configuration.
 func Validate(cfg *Config) error {
 	return NewValidator().Validate(cfg)
 }
 
+// Check is a convenience function that validates configuration and returns
+// the collected errors and warnings without printing them.
+func Check(cfg *Config) *ValidationError {
+	return NewValidator().Check(cfg)
+}
+
 // ValidateAndLoad loads and validates configuration.
 func ValidateAndLoad() (*Config, error) {
 	cfg, err := NewLoader().Load()
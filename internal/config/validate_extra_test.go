@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestIsOpenAIKeyFormat(t *testing.T) {
@@ -72,6 +73,26 @@ func TestValidator_ChangelogIssues(t *testing.T) {
 	}
 }
 
+func TestValidator_ChangelogHighlightsCount(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Changelog.HighlightsCount = -1
+
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "changelog.highlights_count") {
+		t.Errorf("expected changelog.highlights_count error, got %v", err)
+	}
+}
+
+func TestValidator_ChangelogExcludeScopes(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Changelog.ExcludeScopes = []string{"deps", "i18n-["}
+
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), `changelog.exclude_scopes: invalid pattern "i18n-["`) {
+		t.Errorf("expected changelog.exclude_scopes error, got %v", err)
+	}
+}
+
 func TestValidator_PluginValidation(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.AI.Enabled = false
@@ -99,3 +120,358 @@ func TestValidator_PluginValidation(t *testing.T) {
 		t.Errorf("expected slack webhook error, got %q", err.Error())
 	}
 }
+
+func TestValidator_GovernanceRiskWeights(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Governance.RiskWeights = map[string]float64{
+		"api_change":   0.5,
+		"made_up":      0.2,
+		"blast_radius": -0.1,
+	}
+
+	v := NewValidator()
+	err := v.Validate(cfg)
+	if err == nil {
+		t.Fatal("expected validation error for negative risk weight")
+	}
+	if !strings.Contains(err.Error(), "governance.risk_weights.blast_radius") {
+		t.Errorf("expected blast_radius error, got %q", err.Error())
+	}
+	if len(v.errors.Warnings) != 1 || !strings.Contains(v.errors.Warnings[0], `"made_up"`) {
+		t.Errorf("expected warning for unknown factor, got %v", v.errors.Warnings)
+	}
+}
+
+func TestValidator_GovernanceApprovalTTL(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Governance.ApprovalTTL = -time.Hour
+
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "governance.approval_ttl") {
+		t.Errorf("expected approval_ttl error, got %v", err)
+	}
+}
+
+func TestValidator_VersioningTagPattern(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Versioning.TagPattern = `^v[0-9]+\.[0-9]+\.[0-9]+$`
+	if err := NewValidator().Validate(cfg); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	cfg.Versioning.TagPattern = `^v(`
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "versioning.tag_pattern") {
+		t.Errorf("expected tag_pattern error, got %v", err)
+	}
+}
+
+func TestValidator_SignFormat(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Versioning.GitSign = true
+	cfg.Versioning.SignFormat = "ssh"
+	cfg.Git.Auth.SSHKeyPath = "~/.ssh/id_ed25519"
+	if err := NewValidator().Validate(cfg); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	cfg.Git.Auth.SSHKeyPath = ""
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "git.auth.ssh_key_path") {
+		t.Errorf("expected ssh_key_path error, got %v", err)
+	}
+
+	cfg.Versioning.SignFormat = "x509"
+	err = NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "versioning.sign_format") {
+		t.Errorf("expected sign_format error, got %v", err)
+	}
+}
+
+func TestValidator_NonConventionalPolicy(t *testing.T) {
+	for _, policy := range []string{"", "infer", "ignore", "patch", "error"} {
+		cfg := DefaultConfig()
+		cfg.Versioning.NonConventionalPolicy = policy
+		if err := NewValidator().Validate(cfg); err != nil {
+			t.Errorf("policy %q: unexpected error: %v", policy, err)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.Versioning.NonConventionalPolicy = "minor"
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "versioning.nonconventional_policy") {
+		t.Errorf("expected nonconventional_policy error, got %v", err)
+	}
+}
+
+func TestValidator_CommitConvention(t *testing.T) {
+	for _, convention := range []string{"", "conventional", "gitmoji"} {
+		cfg := DefaultConfig()
+		cfg.Versioning.CommitConvention = convention
+		if err := NewValidator().Validate(cfg); err != nil {
+			t.Errorf("commit_convention %q: unexpected error: %v", convention, err)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.Versioning.CommitConvention = "custom"
+	cfg.Versioning.TypePatterns = map[string]string{"feat": `^\[FEATURE\]`, "breaking": `BREAKING`}
+	if err := NewValidator().Validate(cfg); err != nil {
+		t.Errorf("custom convention: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		convention string
+		patterns   map[string]string
+		wantErr    string
+	}{
+		{"unknown convention", "angular", nil, "versioning.commit_convention"},
+		{"custom without patterns", "custom", nil, "versioning.type_patterns"},
+		{"invalid regex", "custom", map[string]string{"fix": "("}, "versioning.type_patterns"},
+		{"patterns without custom", "gitmoji", map[string]string{"fix": "^fix"}, "only used with commit_convention 'custom'"},
+	}
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		cfg.Versioning.CommitConvention = tt.convention
+		cfg.Versioning.TypePatterns = tt.patterns
+		err := NewValidator().Validate(cfg)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: expected %q error, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidator_WorkflowOnPluginFailure(t *testing.T) {
+	for _, mode := range []string{"", "fail", "warn", "rollback"} {
+		cfg := DefaultConfig()
+		cfg.Workflow.OnPluginFailure = mode
+		if err := NewValidator().Validate(cfg); err != nil {
+			t.Errorf("on_plugin_failure %q: unexpected error %v", mode, err)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.Workflow.OnPluginFailure = "ignore"
+	err := NewValidator().Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "workflow.on_plugin_failure") {
+		t.Errorf("expected on_plugin_failure error, got %v", err)
+	}
+}
+
+func TestValidator_WorkflowReleaseCommitTrailers(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workflow.ReleaseCommitTrailers = []string{"Relicta-Version: ${version}", "Relicta-Run-Id: ${run_id}"}
+	if err := NewValidator().Validate(cfg); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	for _, trailer := range []string{"Relicta Version: 1.0.0", "Relicta-Version:", "no separator"} {
+		cfg := DefaultConfig()
+		cfg.Workflow.ReleaseCommitTrailers = []string{trailer}
+		err := NewValidator().Validate(cfg)
+		if err == nil || !strings.Contains(err.Error(), "workflow.release_commit_trailers[0]") {
+			t.Errorf("trailer %q: expected release_commit_trailers error, got %v", trailer, err)
+		}
+	}
+}
+
+func TestValidator_CheckPluginNameAndThresholds(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Plugins = []PluginConfig{{Name: "../evil"}}
+	cfg.Governance.AutoApproveThreshold = 1.5
+	cfg.Governance.MaxAutoApproveRisk = -0.1
+
+	result := Check(cfg)
+	if !result.HasErrors() {
+		t.Fatal("expected validation errors")
+	}
+	joined := strings.Join(result.Errors, "\n")
+	for _, path := range []string{"plugins[0].name", "governance.auto_approve_threshold", "governance.max_auto_approve_risk"} {
+		if !strings.Contains(joined, path) {
+			t.Errorf("expected error for %s, got %v", path, result.Errors)
+		}
+	}
+
+	if result := Check(DefaultConfig()); result.HasErrors() {
+		t.Errorf("default config should be valid, got %v", result.Errors)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	schema := JSONSchema()
+	if schema["$schema"] != jsonSchemaDraft {
+		t.Errorf("$schema = %v", schema["$schema"])
+	}
+
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatal("expected top-level properties")
+	}
+	versioning, ok := props["versioning"].(map[string]any)
+	if !ok {
+		t.Fatal("expected versioning property")
+	}
+	vprops := versioning["properties"].(map[string]any)
+	if got := vprops["strategy"].(map[string]any)["type"]; got != "string" {
+		t.Errorf("versioning.strategy type = %v, want string", got)
+	}
+
+	plugins := props["plugins"].(map[string]any)
+	if plugins["type"] != "array" {
+		t.Errorf("plugins type = %v, want array", plugins["type"])
+	}
+
+	governance := props["governance"].(map[string]any)["properties"].(map[string]any)
+	if got := governance["approval_ttl"].(map[string]any)["type"]; got != "string" {
+		t.Errorf("governance.approval_ttl type = %v, want string", got)
+	}
+}
+
+func TestValidator_PluginRunAfter(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Plugins = []PluginConfig{
+		{Name: "github"},
+		{Name: "slack", RunAfter: []string{"github"}},
+	}
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.Plugins = []PluginConfig{
+		{Name: "github", RunAfter: []string{"slack"}},
+		{Name: "slack", RunAfter: []string{"github", "jira"}},
+	}
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, `plugins[1].run_after: unknown plugin "jira"`) {
+		t.Errorf("expected unknown plugin error, got %q", joined)
+	}
+	if !strings.Contains(joined, "dependency cycle: github -> slack -> github") {
+		t.Errorf("expected cycle error, got %q", joined)
+	}
+}
+
+func TestValidator_ReleaseGroups(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Monorepo.ReleaseGroups = []ReleaseGroupConfig{
+		{Name: "core", Packages: []string{"packages/core", "packages/shared"}, Strategy: MonorepoStrategyLockstep, TagPrefix: "core-v"},
+		{Name: "plugins", Packages: []string{"plugins/*"}, Strategy: MonorepoStrategyIndependent},
+	}
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.Monorepo.ReleaseGroups = append(cfg.Monorepo.ReleaseGroups,
+		ReleaseGroupConfig{Name: "all", Packages: []string{"packages/*"}, Strategy: MonorepoStrategyHybrid},
+	)
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, `monorepo.release_groups[2].packages: "packages/*" overlaps "packages/core" in release_groups[0]`) {
+		t.Errorf("expected overlap error, got %q", joined)
+	}
+	if !strings.Contains(joined, "monorepo.release_groups[2].strategy") {
+		t.Errorf("expected strategy error, got %q", joined)
+	}
+}
+
+func TestValidator_MonorepoCascade(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Monorepo.CascadeBreaking = true
+	cfg.Monorepo.CascadeBump = "minor"
+	cfg.Monorepo.MaxCascadeDepth = 2
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.Monorepo.CascadeBump = "major"
+	cfg.Monorepo.MaxCascadeDepth = -1
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, "monorepo.cascade_bump") {
+		t.Errorf("expected cascade_bump error, got %q", joined)
+	}
+	if !strings.Contains(joined, "monorepo.max_cascade_depth") {
+		t.Errorf("expected max_cascade_depth error, got %q", joined)
+	}
+}
+
+func TestValidator_MCPTranscript(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.MCP.RecordTranscript = true
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.MCP.TranscriptFile = ""
+	cfg.MCP.TranscriptMaxBytes = -1
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, "mcp.transcript_max_bytes: must be non-negative") {
+		t.Errorf("expected max bytes error, got %q", joined)
+	}
+	if !strings.Contains(joined, "mcp.transcript_file: required") {
+		t.Errorf("expected transcript file error, got %q", joined)
+	}
+}
+
+func TestValidator_GovernanceApprovalLevels(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Governance.ApprovalLevels = []string{"technical", "security", "release"}
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.Governance.ApprovalLevels = []string{"technical", "qa", "technical"}
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, `governance.approval_levels[1]: must be one of`) {
+		t.Errorf("expected invalid level error, got %q", joined)
+	}
+	if !strings.Contains(joined, `governance.approval_levels[2]: duplicate level "technical"`) {
+		t.Errorf("expected duplicate level error, got %q", joined)
+	}
+}
+
+func TestValidator_GovernanceAdvisories(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Governance.Advisories = AdvisoryConfig{Source: "osv", Timeout: 5 * time.Second}
+	if result := Check(cfg); result.HasErrors() {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+
+	cfg.Governance.Advisories = AdvisoryConfig{Source: "snyk", Timeout: -time.Second}
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, `governance.advisories.source: must be one of`) {
+		t.Errorf("expected invalid source error, got %q", joined)
+	}
+	if !strings.Contains(joined, "governance.advisories.timeout: must be non-negative") {
+		t.Errorf("expected timeout error, got %q", joined)
+	}
+
+	cfg.Governance.Advisories = AdvisoryConfig{Source: "database"}
+	joined = strings.Join(Check(cfg).Errors, "\n")
+	if !strings.Contains(joined, "governance.advisories.database: required") {
+		t.Errorf("expected database path error, got %q", joined)
+	}
+}
+
+func TestValidator_AssetNameTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Plugins = []PluginConfig{
+		{Name: "github", Config: map[string]any{"asset_name_template": "myapp_{{.Version}}_{{.OS}}_{{.Arch}}{{.Ext}}"}},
+		{Name: "gitlab", Config: map[string]any{"asset_name_template": "{{.Platform}}"}},
+	}
+
+	joined := strings.Join(Check(cfg).Errors, "\n")
+	if strings.Contains(joined, "plugins[0].config.asset_name_template") {
+		t.Errorf("unexpected error for valid template: %q", joined)
+	}
+	if !strings.Contains(joined, "plugins[1].config.asset_name_template: invalid asset_name_template") {
+		t.Errorf("expected invalid template error, got %q", joined)
+	}
+}
@@ -3,8 +3,10 @@ package container
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
+	"regexp"
 	"sync"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/relicta-tech/relicta/internal/infrastructure/ai"
 	"github.com/relicta-tech/relicta/internal/infrastructure/git"
 	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
 	"github.com/relicta-tech/relicta/internal/infrastructure/webhook"
 	"github.com/relicta-tech/relicta/internal/plugin"
 	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
@@ -43,6 +46,10 @@ type App struct {
 	mu     sync.RWMutex
 	closed bool
 
+	// onWarning, if set, receives the non-fatal initialization failures
+	// that are logged as warnings.
+	onWarning func(msg string)
+
 	// Infrastructure layer
 	gitAdapter         *git.Adapter
 	releaseRepo        *persistence.FileReleaseRepository
@@ -54,6 +61,7 @@ type App struct {
 	pluginExecutor     integration.PluginExecutor
 	pluginManager      *plugin.Manager
 	memoryStore        memory.Store
+	secrets            *secrets.Resolver
 
 	// Services (existing infrastructure)
 	gitService   git.Service
@@ -77,17 +85,42 @@ type App struct {
 	closeables []Closeable
 }
 
+// Option configures an App container.
+type Option func(*App)
+
+// WithWarningHandler sets a function that receives a message for each
+// non-fatal initialization failure, such as a plugin system that failed to
+// start, in addition to the logged warning.
+func WithWarningHandler(fn func(msg string)) Option {
+	return func(c *App) {
+		c.onWarning = fn
+	}
+}
+
 // New creates a new App container with the given configuration.
-func New(cfg *config.Config) (*App, error) {
+func New(cfg *config.Config, opts ...Option) (*App, error) {
 	if cfg == nil {
 		return nil, errors.Config("New", "configuration is required")
 	}
 
-	return &App{
+	c := &App{
 		config:     cfg,
 		logger:     slog.Default(),
+		secrets:    secrets.NewDefaultResolver(),
 		closeables: make([]Closeable, 0),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// warn logs a non-fatal failure and reports it to the warning handler.
+func (c *App) warn(msg string, err error) {
+	c.logger.Warn(msg, "error", err)
+	if c.onWarning != nil {
+		c.onWarning(fmt.Sprintf("%s: %v", msg, err))
+	}
 }
 
 // registerCloseable registers a component for cleanup during shutdown.
@@ -123,18 +156,62 @@ func (c *App) Initialize(ctx context.Context) error {
 	return c.initApplicationLayer(ctx)
 }
 
+// gitServiceOptions returns the git service options for the configuration.
+// With git.auth.type "token" or "basic", the token or password can be a
+// secret reference; it is resolved through resolver the first time a remote
+// is contacted, so commands that stay local never reach the secret backend.
+func gitServiceOptions(cfg *config.Config, resolver *secrets.Resolver) []git.ServiceOption {
+	opts := []git.ServiceOption{git.WithCLIFallback(cfg.Git.UseCLI())}
+	if cfg.Versioning.GitSign {
+		if cfg.Versioning.SignFormat == git.SignFormatSSH {
+			opts = append(opts, git.WithSSHSign(cfg.Git.Auth.SSHKeyPath))
+		} else {
+			opts = append(opts, git.WithGPGSign(""))
+		}
+	}
+
+	auth := cfg.Git.Auth
+	var credential string
+	switch auth.Type {
+	case "token":
+		credential = auth.Token
+	case "basic":
+		credential = auth.Password
+		if auth.Username != "" {
+			opts = append(opts, git.WithAuthUsername(auth.Username))
+		}
+	}
+	switch {
+	case secrets.IsReference(credential):
+		opts = append(opts, git.WithAuthTokenSource(func(ctx context.Context) (string, error) {
+			return resolver.Resolve(ctx, credential)
+		}))
+	case credential != "":
+		opts = append(opts, git.WithAuthToken(credential))
+	}
+	return opts
+}
+
 // initInfrastructure initializes infrastructure layer components.
 func (c *App) initInfrastructure(ctx context.Context) error {
 	var err error
 
 	// Initialize existing git service
-	c.gitService, err = git.NewService()
+	c.gitService, err = git.NewService(gitServiceOptions(c.config, c.secrets)...)
 	if err != nil {
 		return errors.GitWrap(err, "initInfrastructure", "failed to initialize git service")
 	}
 
 	// Create git adapter that implements domain interface
-	c.gitAdapter = git.NewAdapter(c.gitService)
+	var adapterOpts []git.AdapterOption
+	if c.config.Versioning.TagPattern != "" {
+		pattern, err := regexp.Compile(c.config.Versioning.TagPattern)
+		if err != nil {
+			return errors.ConfigWrap(err, "initInfrastructure", "invalid versioning.tag_pattern")
+		}
+		adapterOpts = append(adapterOpts, git.WithTagPattern(pattern))
+	}
+	c.gitAdapter = git.NewAdapter(c.gitService, adapterOpts...)
 
 	// Initialize release repository
 	repoPath := ".relicta/releases"
@@ -152,7 +229,13 @@ func (c *App) initInfrastructure(ctx context.Context) error {
 
 	// Add webhook publisher if webhooks are configured
 	if len(c.config.Webhooks) > 0 {
-		publisher = webhook.NewPublisher(c.config.Webhooks, publisher)
+		// Anchor undelivered payloads at the repository root so that
+		// 'relicta webhooks replay' finds them from any subdirectory
+		repoRoot := ""
+		if info, err := c.gitAdapter.GetInfo(ctx); err == nil {
+			repoRoot = info.Path
+		}
+		publisher = webhook.NewPublisher(c.config.Webhooks, publisher, webhook.WithDeadLetterDir(webhook.DeadLetterDir(repoRoot)))
 		c.logger.Debug("webhook publisher initialized", "webhook_count", len(c.config.Webhooks))
 	}
 
@@ -161,7 +244,7 @@ func (c *App) initInfrastructure(ctx context.Context) error {
 		memoryPath := ".relicta/memory"
 		c.memoryStore, err = memory.NewFileStore(memoryPath)
 		if err != nil {
-			c.logger.Warn("failed to initialize memory store", "error", err)
+			c.warn("failed to initialize memory store", err)
 		} else {
 			publisher = memory.NewOutcomeTracker(c.memoryStore, publisher)
 			c.logger.Debug("outcome tracker initialized", "path", memoryPath)
@@ -179,7 +262,7 @@ func (c *App) initInfrastructure(ctx context.Context) error {
 	// Initialize plugin system
 	if pluginErr := c.initPluginSystem(ctx); pluginErr != nil {
 		// Plugin system failure is non-fatal, use empty executor
-		c.logger.Warn("plugin system initialization failed, using empty executor", "error", pluginErr)
+		c.warn("plugin system initialization failed, using empty executor", pluginErr)
 		c.pluginRegistry = integration.NewInMemoryPluginRegistry()
 		c.pluginExecutor = integration.NewSequentialPluginExecutor(c.pluginRegistry)
 	}
@@ -238,8 +321,9 @@ func (c *App) initAIService(ctx context.Context) (ai.Service, error) {
 		ai.WithModel(c.config.AI.Model),
 	}
 
-	// Only add API key option if we have one
-	if apiKey != "" {
+	// Only add API key option if we have one; secret references are
+	// resolved on first use
+	if apiKey != "" && !secrets.IsReference(apiKey) {
 		opts = append(opts, ai.WithAPIKey(apiKey))
 	}
 
@@ -267,6 +351,10 @@ func (c *App) initAIService(ctx context.Context) (ai.Service, error) {
 	// No network calls occur during construction; actual API calls happen in Generate()
 	// which accepts context for cancellation. Lazy initialization was considered but
 	// adds complexity; eager init is acceptable since this only runs when AI is enabled.
+	if secrets.IsReference(apiKey) {
+		return newSecretKeyAIService(c.secrets, apiKey, opts), nil
+	}
+
 	//nolint:contextcheck // Constructor is pure configuration; context used in method calls
 	return ai.NewService(opts...)
 }
@@ -287,7 +375,9 @@ func (c *App) initPluginSystem(ctx context.Context) error {
 
 	// Register plugins for lazy loading (improves startup time)
 	// Plugins will be loaded on-demand when hooks are executed
-	c.pluginManager.RegisterPlugins()
+	if err := c.pluginManager.RegisterPlugins(); err != nil {
+		return err
+	}
 
 	// Register manager for cleanup
 	c.registerCloseable(c.pluginManager)
@@ -331,7 +421,7 @@ func (c *App) initApplicationLayer(ctx context.Context) error {
 	if c.config.Governance.Enabled {
 		if err := c.initGovernanceService(ctx); err != nil {
 			// Governance failure is non-fatal in advisory mode
-			c.logger.Warn("governance service initialization failed", "error", err)
+			c.warn("governance service initialization failed", err)
 		}
 	}
 
@@ -354,11 +444,17 @@ func (c *App) initGovernanceService(ctx context.Context) error {
 		}
 	}
 
+	var opts []governance.ServiceOption
+	if c.gitAdapter != nil {
+		opts = append(opts, governance.WithManifestReader(c.gitAdapter))
+	}
+
 	var err error
 	c.governanceService, err = governance.NewServiceFromConfig(
 		&c.config.Governance,
 		repoPath,
 		c.logger,
+		opts...,
 	)
 	if err != nil {
 		return errors.StateWrap(err, "initGovernanceService", "failed to create governance service")
@@ -382,7 +478,11 @@ func (c *App) initReleaseServices(ctx context.Context, repoRoot string) error {
 
 	// Create port adapters
 	notesGenerator := NewNotesGeneratorAdapter(c.aiService, c.gitAdapter)
-	publisher := NewPublisherAdapter(c.pluginExecutor, c.gitAdapter, c.tagCreator)
+	publisher := NewPublisherAdapter(c.pluginExecutor, c.gitAdapter, c.tagCreator,
+		WithSkipPush(!c.config.Versioning.GitPush),
+		WithRemote(c.config.Git.DefaultRemote),
+		WithHighlightsCount(c.config.Changelog.HighlightsCount),
+	)
 	versionWriter := NewVersionWriterAdapter(c.gitAdapter, repoRoot)
 
 	// Configure release services
@@ -635,8 +735,8 @@ func (c *App) closeWithContext(ctx context.Context, closeable Closeable) error {
 }
 
 // NewInitialized creates and initializes a new App container.
-func NewInitialized(ctx context.Context, cfg *config.Config) (*App, error) {
-	c, err := New(cfg)
+func NewInitialized(ctx context.Context, cfg *config.Config, opts ...Option) (*App, error) {
+	c, err := New(cfg, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6,12 +6,16 @@ import (
 	"errors"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
 )
 
 // mockCloseable implements Closeable for testing.
@@ -798,6 +802,92 @@ func TestApp_initAIService_ContextCanceled(t *testing.T) {
 	}
 }
 
+// countingSecretBackend returns a fixed secret and counts lookups.
+type countingSecretBackend struct {
+	secret string
+	err    error
+	calls  int
+}
+
+func (b *countingSecretBackend) Resolve(context.Context, secrets.Reference) (string, error) {
+	b.calls++
+	return b.secret, b.err
+}
+
+func TestApp_initAIService_SecretReference(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AI.Enabled = true
+	cfg.AI.Provider = "openai"
+	cfg.AI.APIKey = "secret://mock/openai"
+
+	app, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	backend := &countingSecretBackend{err: errors.New("access denied")}
+	app.secrets = secrets.NewResolver(secrets.WithBackend("mock", backend))
+
+	svc, err := app.initAIService(context.Background())
+	if err != nil {
+		t.Fatalf("initAIService() error = %v", err)
+	}
+	if !svc.IsAvailable() {
+		t.Error("IsAvailable() = false for a configured secret reference")
+	}
+	if backend.calls != 0 {
+		t.Errorf("secret resolved %d times before first use, want 0", backend.calls)
+	}
+
+	_, err = svc.Complete(context.Background(), "system", "user")
+	if err == nil || backend.calls != 1 {
+		t.Fatalf("Complete() error = %v after %d lookups, want resolution error", err, backend.calls)
+	}
+}
+
+func TestGitServiceOptions_SecretToken(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Git.Auth.Type = "token"
+	cfg.Git.Auth.Token = "secret://mock/github"
+
+	backend := &countingSecretBackend{secret: "ghp-test"}
+	resolver := secrets.NewResolver(secrets.WithBackend("mock", backend))
+
+	var serviceCfg git.ServiceConfig
+	for _, opt := range gitServiceOptions(cfg, resolver) {
+		opt(&serviceCfg)
+	}
+	if serviceCfg.AuthToken != "" || serviceCfg.AuthTokenSource == nil {
+		t.Fatalf("AuthToken = %q, want a lazy token source", serviceCfg.AuthToken)
+	}
+	if backend.calls != 0 {
+		t.Errorf("secret resolved %d times before use, want 0", backend.calls)
+	}
+
+	token, err := serviceCfg.AuthTokenSource(context.Background())
+	if err != nil || token != "ghp-test" {
+		t.Errorf("AuthTokenSource() = %q, %v; want resolved secret", token, err)
+	}
+
+	backend.err = errors.New("access denied")
+	if _, err := serviceCfg.AuthTokenSource(context.Background()); err == nil {
+		t.Error("AuthTokenSource() error = nil for an unresolvable token")
+	}
+}
+
+func TestGitServiceOptions_PlainToken(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Git.Auth.Type = "token"
+	cfg.Git.Auth.Token = "ghp-plain"
+
+	var serviceCfg git.ServiceConfig
+	for _, opt := range gitServiceOptions(cfg, nil) {
+		opt(&serviceCfg)
+	}
+	if serviceCfg.AuthToken != "ghp-plain" || serviceCfg.AuthTokenSource != nil {
+		t.Errorf("AuthToken = %q, want plain token", serviceCfg.AuthToken)
+	}
+}
+
 func TestApp_Initialize_WithGovernanceEnabled(t *testing.T) {
 	tmpDir := t.TempDir()
 	cfg := config.DefaultConfig()
@@ -831,6 +921,38 @@ func TestApp_Initialize_WithGovernanceEnabled(t *testing.T) {
 	}
 }
 
+func TestApp_Initialize_ReportsWarnings(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := config.DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Plugins = nil
+	cfg.Governance.MemoryEnabled = true
+
+	var warnings []string
+	app, err := New(cfg, WithWarningHandler(func(msg string) { warnings = append(warnings, msg) }))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	t.Chdir(tmpDir)
+	initGitRepo(t, tmpDir)
+
+	// A file in place of the memory directory makes the store fail.
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".relicta"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".relicta", "memory"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := app.Initialize(context.Background()); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "failed to initialize memory store") {
+		t.Errorf("warnings = %v, want the memory store failure", warnings)
+	}
+}
+
 func TestApp_UnitOfWork_AfterInitialize(t *testing.T) {
 	tmpDir := t.TempDir()
 	cfg := config.DefaultConfig()
@@ -5,16 +5,21 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/communication"
 	"github.com/relicta-tech/relicta/internal/domain/integration"
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/version"
 	"github.com/relicta-tech/relicta/internal/infrastructure/ai"
 	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+	"github.com/relicta-tech/relicta/internal/infrastructure/template"
 )
 
 // NotesGeneratorAdapter adapts the AI service to the ports.NotesGenerator interface.
@@ -33,13 +38,28 @@ func NewNotesGeneratorAdapter(aiService ai.Service, gitAdapter *git.Adapter) *No
 
 // Generate creates release notes for the given run.
 func (a *NotesGeneratorAdapter) Generate(ctx context.Context, run *domain.ReleaseRun, options ports.NotesOptions) (*domain.ReleaseNotes, error) {
+	notes, err := a.generate(ctx, run, options)
+	if err != nil {
+		return nil, err
+	}
+	notes.Highlights = runHighlights(notesChangeSet(run, options), options.HighlightsCount)
+	return notes, nil
+}
+
+// generate creates release notes from a template, the AI service, or the
+// basic commit list, in that order of preference.
+func (a *NotesGeneratorAdapter) generate(ctx context.Context, run *domain.ReleaseRun, options ports.NotesOptions) (*domain.ReleaseNotes, error) {
+	if options.Template != "" {
+		return a.generateTemplateNotes(ctx, run, options)
+	}
+
 	if a.aiService == nil || !a.aiService.IsAvailable() {
 		// Fallback to basic changelog without AI enhancement
 		return a.generateBasicNotes(ctx, run, options)
 	}
 
 	// Get the changeset from the run
-	changeSet := run.ChangeSet()
+	changeSet := notesChangeSet(run, options)
 	if changeSet == nil {
 		return nil, fmt.Errorf("no changeset available in run")
 	}
@@ -54,6 +74,9 @@ func (a *NotesGeneratorAdapter) Generate(ctx context.Context, run *domain.Releas
 		Tone:        a.mapTone(options.TonePreset),
 		Audience:    a.mapAudience(options.AudiencePreset),
 	}
+	if highlights := runHighlights(changeSet, options.HighlightsCount); len(highlights) > 0 {
+		genOpts.Context = "Highlight these changes first:\n- " + strings.Join(highlights, "\n- ")
+	}
 
 	// Generate changelog using AI
 	changelog, err := a.aiService.GenerateChangelog(ctx, categorized, genOpts)
@@ -96,6 +119,19 @@ func (a *NotesGeneratorAdapter) ComputeInputsHash(run *domain.ReleaseRun, option
 	// Include options
 	h.Write([]byte(options.AudiencePreset))
 	h.Write([]byte(options.TonePreset))
+	fmt.Fprintf(h, "highlights:%d", options.HighlightsCount)
+	if len(options.ExcludeScopes) > 0 {
+		h.Write([]byte("exclude_scopes:" + strings.Join(options.ExcludeScopes, ",")))
+	}
+	if options.Template != "" {
+		h.Write([]byte("template:" + options.Template))
+		h.Write([]byte("tag_prefix:" + options.TagPrefix))
+		// Include the template contents so edits to the template regenerate
+		// the notes. An unreadable template fails generation anyway.
+		if content, err := os.ReadFile(options.Template); err == nil { // #nosec G304 -- path from user config
+			h.Write(content)
+		}
+	}
 	if options.UseAI {
 		h.Write([]byte("ai:true"))
 		h.Write([]byte(options.Provider))
@@ -107,7 +143,7 @@ func (a *NotesGeneratorAdapter) ComputeInputsHash(run *domain.ReleaseRun, option
 
 // generateBasicNotes creates basic release notes without AI.
 func (a *NotesGeneratorAdapter) generateBasicNotes(ctx context.Context, run *domain.ReleaseRun, options ports.NotesOptions) (*domain.ReleaseNotes, error) {
-	changeSet := run.ChangeSet()
+	changeSet := notesChangeSet(run, options)
 	if changeSet == nil {
 		return &domain.ReleaseNotes{
 			Text:           "Release " + run.VersionNext().String(),
@@ -135,6 +171,76 @@ func (a *NotesGeneratorAdapter) generateBasicNotes(ctx context.Context, run *dom
 	}, nil
 }
 
+// generateTemplateNotes renders release notes from a custom changelog template.
+// Template errors are returned rather than falling back to generated notes.
+func (a *NotesGeneratorAdapter) generateTemplateNotes(ctx context.Context, run *domain.ReleaseRun, options ports.NotesOptions) (*domain.ReleaseNotes, error) {
+	svc, err := template.NewService()
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := svc.RenderChangelogFile(ctx, options.Template, changelogEntry(run, options), options.RepositoryURL, options.IssueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ReleaseNotes{
+		Text:           text,
+		AudiencePreset: options.AudiencePreset,
+		TonePreset:     options.TonePreset,
+		Provider:       "template",
+		Model:          "",
+		GeneratedAt:    time.Now(),
+	}, nil
+}
+
+// changelogEntry builds the changelog entry custom templates render for a
+// run. The release is tagged with the run's tag, or else with the configured
+// tag prefix.
+func changelogEntry(run *domain.ReleaseRun, options ports.NotesOptions) communication.ChangelogEntry {
+	next := run.VersionNext()
+	tagPrefix := options.TagPrefix
+	if tag := run.TagName(); tag != "" {
+		tagPrefix = strings.TrimSuffix(tag, next.String())
+	}
+
+	changeSet := notesChangeSet(run, options)
+	if changeSet == nil {
+		return communication.ChangelogEntry{Version: next, Date: time.Now(), Tag: tagPrefix + next.String()}
+	}
+
+	entry := communication.CreateEntryFromChangeSet(next, changeSet, strings.TrimSuffix(options.RepositoryURL, "/"), tagPrefix)
+	entry.Highlights = runHighlights(changeSet, options.HighlightsCount)
+	return entry
+}
+
+// runHighlights extracts the n most significant changes of a changeset.
+func runHighlights(cs *changes.ChangeSet, n int) []string {
+	if cs == nil {
+		return nil
+	}
+	return cs.Highlights(n)
+}
+
+// notesChangeSet returns the run's changeset as it should appear in the
+// notes, without commits in excluded scopes. The run's own changeset is left
+// untouched so excluded commits still count toward the version bump.
+func notesChangeSet(run *domain.ReleaseRun, options ports.NotesOptions) *changes.ChangeSet {
+	changeSet := run.ChangeSet()
+	if changeSet == nil || len(options.ExcludeScopes) == 0 {
+		return changeSet
+	}
+
+	filter := git.CommitFilter{ExcludeScopes: options.ExcludeScopes}
+	filtered := changes.NewChangeSet(changeSet.ID()+"-notes", changeSet.FromRef(), changeSet.ToRef())
+	for _, c := range changeSet.Commits() {
+		if !filter.ExcludesScope(c.Scope()) {
+			filtered.AddCommit(c)
+		}
+	}
+	return filtered
+}
+
 // convertToCategorizedChanges converts a ChangeSet to git.CategorizedChanges.
 func (a *NotesGeneratorAdapter) convertToCategorizedChanges(cs *changes.ChangeSet) *git.CategorizedChanges {
 	result := &git.CategorizedChanges{
@@ -229,7 +335,10 @@ type PublisherAdapter struct {
 	executor   integration.PluginExecutor
 	gitAdapter *git.Adapter
 	tagCreator ports.TagCreator
-	skipPush   bool // Skip pushing tags (useful for dry-run or local testing)
+	skipPush   bool   // Skip pushing tags (useful for dry-run or local testing)
+	remote     string // Remote tags are pushed to and deleted from
+
+	highlightsCount int // Highlights extracted for runs without notes (0 disables)
 }
 
 // PublisherAdapterOption configures the PublisherAdapter.
@@ -242,12 +351,32 @@ func WithSkipPush(skip bool) PublisherAdapterOption {
 	}
 }
 
+// WithRemote configures the remote tags are pushed to and deleted from.
+// An empty name keeps the default remote ("origin").
+func WithRemote(remote string) PublisherAdapterOption {
+	return func(a *PublisherAdapter) {
+		if remote != "" {
+			a.remote = remote
+		}
+	}
+}
+
+// WithHighlightsCount configures the number of highlights extracted for
+// plugins when the run has no notes. Zero disables highlights.
+func WithHighlightsCount(n int) PublisherAdapterOption {
+	return func(a *PublisherAdapter) {
+		a.highlightsCount = n
+	}
+}
+
 // NewPublisherAdapter creates a new PublisherAdapter.
 func NewPublisherAdapter(executor integration.PluginExecutor, gitAdapter *git.Adapter, tagCreator ports.TagCreator, opts ...PublisherAdapterOption) *PublisherAdapter {
 	a := &PublisherAdapter{
-		executor:   executor,
-		gitAdapter: gitAdapter,
-		tagCreator: tagCreator,
+		executor:        executor,
+		gitAdapter:      gitAdapter,
+		tagCreator:      tagCreator,
+		remote:          "origin",
+		highlightsCount: changes.DefaultHighlightsCount,
 	}
 	for _, opt := range opts {
 		opt(a)
@@ -259,7 +388,7 @@ func NewPublisherAdapter(executor integration.PluginExecutor, gitAdapter *git.Ad
 func (a *PublisherAdapter) ExecuteStep(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (*ports.StepResult, error) {
 	// Handle tag step specially - this is where tags are created during publish
 	if step.Type == domain.StepTypeTag {
-		return a.executeTagStep(ctx, run)
+		return a.executeTagStep(ctx, run, step)
 	}
 
 	// For other steps, use the plugin executor
@@ -267,6 +396,11 @@ func (a *PublisherAdapter) ExecuteStep(ctx context.Context, run *domain.ReleaseR
 		return nil, fmt.Errorf("no plugin executor configured")
 	}
 
+	// Contributed steps run only the plugin that declared them
+	if step.Type == domain.StepTypePlugin && step.Step != "" {
+		return a.executePluginStep(ctx, run, step)
+	}
+
 	// Build release context from run
 	releaseCtx := a.buildReleaseContext(run)
 
@@ -307,16 +441,68 @@ func (a *PublisherAdapter) ExecuteStep(ctx context.Context, run *domain.ReleaseR
 	}, nil
 }
 
+// executePluginStep runs a step contributed by a plugin.
+func (a *PublisherAdapter) executePluginStep(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (*ports.StepResult, error) {
+	resp, err := a.executor.ExecutePlugin(ctx, integration.PluginID(step.PluginName), integration.ExecuteRequest{
+		Hook:           integration.HookPostPublish,
+		Context:        a.buildReleaseContext(run),
+		Step:           step.Step,
+		IdempotencyKey: step.IdempotencyKey,
+	})
+	if err != nil {
+		return &ports.StepResult{
+			Success: false,
+			Error:   err,
+		}, err
+	}
+	if resp == nil {
+		return &ports.StepResult{Success: true}, nil
+	}
+	if !resp.Success {
+		return &ports.StepResult{
+			Success: false,
+			Output:  resp.Message,
+			Error:   fmt.Errorf("%s", resp.Error),
+		}, nil
+	}
+	return &ports.StepResult{
+		Success: true,
+		Output:  resp.Message,
+	}, nil
+}
+
+// ContributedSteps returns the publish steps contributed by plugins, if the
+// plugin executor supports contributed steps.
+func (a *PublisherAdapter) ContributedSteps(ctx context.Context) ([]domain.PluginStep, error) {
+	provider, ok := a.executor.(integration.StepProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	contributed, err := provider.PluginSteps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]domain.PluginStep, len(contributed))
+	for i, c := range contributed {
+		steps[i] = domain.PluginStep{
+			Plugin:      string(c.PluginID),
+			Name:        c.Name,
+			Description: c.Description,
+		}
+	}
+	return steps, nil
+}
+
 // executeTagStep creates and pushes the git tag for the release.
-func (a *PublisherAdapter) executeTagStep(ctx context.Context, run *domain.ReleaseRun) (*ports.StepResult, error) {
+// Per-package tag steps carry their own tag name.
+func (a *PublisherAdapter) executeTagStep(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (*ports.StepResult, error) {
 	if a.tagCreator == nil {
 		return nil, fmt.Errorf("tag creator not configured")
 	}
 
-	tagName := run.TagName()
-	if tagName == "" {
-		tagName = "v" + run.VersionNext().String()
-	}
+	tagName := stepTagName(run, step)
 
 	// Check if tag already exists (idempotency)
 	exists, err := a.tagCreator.TagExists(ctx, tagName)
@@ -351,7 +537,7 @@ func (a *PublisherAdapter) executeTagStep(ctx context.Context, run *domain.Relea
 
 	// Push the tag unless skipPush is set
 	if !a.skipPush {
-		if err := a.tagCreator.PushTag(ctx, tagName, "origin"); err != nil {
+		if err := a.tagCreator.PushTag(ctx, tagName, a.remote); err != nil {
 			return &ports.StepResult{
 				Success: false,
 				Output:  output,
@@ -367,6 +553,76 @@ func (a *PublisherAdapter) executeTagStep(ctx context.Context, run *domain.Relea
 	}, nil
 }
 
+// RemoveTag deletes the tag created by a tag step, including the pushed
+// remote tag unless pushing is disabled.
+func (a *PublisherAdapter) RemoveTag(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (string, error) {
+	if a.gitAdapter == nil {
+		return "", fmt.Errorf("git adapter not configured")
+	}
+
+	tagName := stepTagName(run, step)
+
+	if !a.skipPush {
+		if err := a.gitAdapter.DeleteRemoteTag(ctx, tagName, a.remote); err != nil {
+			return "", fmt.Errorf("failed to delete remote tag %s: %w", tagName, err)
+		}
+	}
+	if err := a.gitAdapter.DeleteTag(ctx, tagName); err != nil {
+		return "", fmt.Errorf("failed to delete tag %s: %w", tagName, err)
+	}
+
+	return tagName, nil
+}
+
+// NotifyRollback runs the on-error plugin hook for a rolled back release.
+func (a *PublisherAdapter) NotifyRollback(ctx context.Context, run *domain.ReleaseRun, _ string) error {
+	if a.executor == nil {
+		return nil
+	}
+
+	responses, err := a.executor.ExecuteHook(ctx, integration.HookOnError, a.buildReleaseContext(run))
+	if err != nil {
+		return fmt.Errorf("rollback hook failed: %w", err)
+	}
+
+	var errs []error
+	for _, resp := range responses {
+		if !resp.Success {
+			errs = append(errs, fmt.Errorf("%s", resp.Error))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidatePrePublish runs the pre-publish plugin hook. Any plugin that
+// reports failure vetoes the release.
+func (a *PublisherAdapter) ValidatePrePublish(ctx context.Context, run *domain.ReleaseRun, dryRun bool) error {
+	if a.executor == nil {
+		return nil
+	}
+
+	releaseCtx := a.buildReleaseContext(run)
+	releaseCtx.DryRun = dryRun
+
+	responses, err := a.executor.ExecuteHook(ctx, integration.HookPrePublish, releaseCtx)
+	if err != nil {
+		return fmt.Errorf("pre-publish hook failed: %w", err)
+	}
+
+	var errs []error
+	for _, resp := range responses {
+		if resp.Success {
+			continue
+		}
+		msg := resp.Error
+		if msg == "" {
+			msg = resp.Message
+		}
+		errs = append(errs, errors.New(msg))
+	}
+	return errors.Join(errs...)
+}
+
 // CheckIdempotency checks if a step has already been executed.
 func (a *PublisherAdapter) CheckIdempotency(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (bool, error) {
 	// Check specific step types for idempotency
@@ -374,10 +630,7 @@ func (a *PublisherAdapter) CheckIdempotency(ctx context.Context, run *domain.Rel
 	case domain.StepTypeTag:
 		// Check if tag already exists
 		if a.gitAdapter != nil {
-			tagName := run.TagName()
-			if tagName == "" {
-				tagName = "v" + run.VersionNext().String()
-			}
+			tagName := stepTagName(run, step)
 			// GetTag returns nil and error if tag doesn't exist
 			tag, err := a.gitAdapter.GetTag(ctx, tagName)
 			if err != nil {
@@ -393,6 +646,17 @@ func (a *PublisherAdapter) CheckIdempotency(ctx context.Context, run *domain.Rel
 	return false, nil
 }
 
+// stepTagName returns the tag created by a tag step.
+func stepTagName(run *domain.ReleaseRun, step *domain.StepPlan) string {
+	if step.TagName != "" {
+		return step.TagName
+	}
+	if run.TagName() != "" {
+		return run.TagName()
+	}
+	return "v" + run.VersionNext().String()
+}
+
 // buildReleaseContext builds an integration.ReleaseContext from a ReleaseRun.
 func (a *PublisherAdapter) buildReleaseContext(run *domain.ReleaseRun) integration.ReleaseContext {
 	ctx := integration.ReleaseContext{
@@ -401,17 +665,23 @@ func (a *PublisherAdapter) buildReleaseContext(run *domain.ReleaseRun) integrati
 		ReleaseType:     changes.ReleaseType(run.BumpKind()),
 		RepositoryPath:  run.RepoRoot(),
 		TagName:         run.TagName(),
+		CommitSHA:       string(run.HeadSHA()),
 	}
 
-	// Add notes if available
+	// Add notes if available. Their highlights were extracted with the
+	// configured count, so an empty list means highlights are disabled.
 	if run.Notes() != nil {
 		ctx.Changelog = run.Notes().Text
 		ctx.ReleaseNotes = run.Notes().Text
+		ctx.Highlights = run.Notes().Highlights
 	}
 
 	// Add changeset if available
 	if run.HasChangeSet() {
 		ctx.Changes = run.ChangeSet()
+		if run.Notes() == nil {
+			ctx.Highlights = run.ChangeSet().Highlights(a.highlightsCount)
+		}
 	}
 
 	return ctx
@@ -4,10 +4,14 @@ package container
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/domain/changes"
+	"github.com/relicta-tech/relicta/internal/domain/integration"
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/version"
@@ -108,6 +112,25 @@ func TestNewPublisherAdapter_WithSkipPush(t *testing.T) {
 	}
 }
 
+func TestPublisherAdapter_ExecuteStep_TagStep_PushesToRemote(t *testing.T) {
+	mockTC := &mockTagCreator{}
+	adapter := NewPublisherAdapter(nil, nil, mockTC, WithRemote("upstream"))
+
+	run := createTestReleaseRun(t)
+	step := &domain.StepPlan{Name: "create-tag", Type: domain.StepTypeTag}
+
+	if _, err := adapter.ExecuteStep(context.Background(), run, step); err != nil {
+		t.Fatalf("ExecuteStep error = %v", err)
+	}
+	if len(mockTC.pushTagCalls) != 1 || mockTC.pushTagCalls[0].remote != "upstream" {
+		t.Errorf("expected the tag to be pushed to upstream, got %+v", mockTC.pushTagCalls)
+	}
+
+	if adapter := NewPublisherAdapter(nil, nil, mockTC, WithRemote("")); adapter.remote != "origin" {
+		t.Errorf("remote = %q, want origin by default", adapter.remote)
+	}
+}
+
 func TestPublisherAdapter_ExecuteStep_TagStep_NilTagCreator(t *testing.T) {
 	adapter := NewPublisherAdapter(nil, nil, nil)
 
@@ -174,6 +197,25 @@ func TestPublisherAdapter_ExecuteStep_TagStep_TagExistsError(t *testing.T) {
 	}
 }
 
+func TestPublisherAdapter_ExecuteStep_PackageTagStep(t *testing.T) {
+	mockTC := &mockTagCreator{}
+	adapter := NewPublisherAdapter(nil, nil, mockTC, WithSkipPush(true))
+
+	run := createTestReleaseRun(t)
+	steps := domain.NewPackageTagSteps([]domain.PackageTag{{Package: "packages/core", TagName: "core-v1.0.0"}})
+
+	result, err := adapter.ExecuteStep(context.Background(), run, &steps[0])
+	if err != nil {
+		t.Fatalf("ExecuteStep error = %v", err)
+	}
+	if !result.Success {
+		t.Error("result should be successful")
+	}
+	if len(mockTC.createTagCalls) != 1 || mockTC.createTagCalls[0].name != "core-v1.0.0" {
+		t.Errorf("expected tag core-v1.0.0 to be created, got %+v", mockTC.createTagCalls)
+	}
+}
+
 func TestPublisherAdapter_ExecuteStep_TagStep_CreateTagError(t *testing.T) {
 	expectedErr := errors.New("failed to create tag")
 	mockTC := &mockTagCreator{
@@ -349,6 +391,147 @@ func TestPublisherAdapter_CheckIdempotency_NonTagStep(t *testing.T) {
 	}
 }
 
+// hookRecorder implements integration.PluginExecutor for testing hooks.
+type hookRecorder struct {
+	hooks     []integration.Hook
+	responses []integration.ExecuteResponse
+}
+
+func (h *hookRecorder) ExecuteHook(_ context.Context, hook integration.Hook, _ integration.ReleaseContext) ([]integration.ExecuteResponse, error) {
+	h.hooks = append(h.hooks, hook)
+	return h.responses, nil
+}
+
+func (h *hookRecorder) ExecutePlugin(_ context.Context, _ integration.PluginID, _ integration.ExecuteRequest) (*integration.ExecuteResponse, error) {
+	return nil, nil
+}
+
+func TestPublisherAdapter_NotifyRollback(t *testing.T) {
+	recorder := &hookRecorder{}
+	adapter := NewPublisherAdapter(recorder, nil, nil)
+	run := createTestReleaseRun(t)
+
+	if err := adapter.NotifyRollback(context.Background(), run, "bad release"); err != nil {
+		t.Fatalf("NotifyRollback() error = %v", err)
+	}
+	if len(recorder.hooks) != 1 || recorder.hooks[0] != integration.HookOnError {
+		t.Errorf("hooks = %v, want [%s]", recorder.hooks, integration.HookOnError)
+	}
+
+	recorder.responses = []integration.ExecuteResponse{{Success: false, Error: "slack unavailable"}}
+	if err := adapter.NotifyRollback(context.Background(), run, "bad release"); err == nil {
+		t.Error("NotifyRollback() expected error for failed plugin")
+	}
+
+	if err := NewPublisherAdapter(nil, nil, nil).NotifyRollback(context.Background(), run, ""); err != nil {
+		t.Errorf("NotifyRollback() without executor error = %v", err)
+	}
+}
+
+func TestPublisherAdapter_ValidatePrePublish(t *testing.T) {
+	recorder := &hookRecorder{}
+	adapter := NewPublisherAdapter(recorder, nil, nil)
+	run := createTestReleaseRun(t)
+
+	if err := adapter.ValidatePrePublish(context.Background(), run, false); err != nil {
+		t.Fatalf("ValidatePrePublish() error = %v", err)
+	}
+	if len(recorder.hooks) != 1 || recorder.hooks[0] != integration.HookPrePublish {
+		t.Errorf("hooks = %v, want [%s]", recorder.hooks, integration.HookPrePublish)
+	}
+
+	recorder.responses = []integration.ExecuteResponse{
+		{Success: true},
+		{Success: false, Error: "version 1.0.0 already published"},
+		{Success: false, Message: "registry unreachable"},
+	}
+	err := adapter.ValidatePrePublish(context.Background(), run, false)
+	if err == nil {
+		t.Fatal("ValidatePrePublish() expected error for failed plugin")
+	}
+	for _, want := range []string{"already published", "registry unreachable"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ValidatePrePublish() error = %q, want it to contain %q", err, want)
+		}
+	}
+
+	if err := NewPublisherAdapter(nil, nil, nil).ValidatePrePublish(context.Background(), run, false); err != nil {
+		t.Errorf("ValidatePrePublish() without executor error = %v", err)
+	}
+}
+
+// stepExecutor implements integration.PluginExecutor and
+// integration.StepProvider for testing contributed steps.
+type stepExecutor struct {
+	hookRecorder
+	steps    []integration.PluginStep
+	requests []integration.ExecuteRequest
+	ids      []integration.PluginID
+	response *integration.ExecuteResponse
+}
+
+func (e *stepExecutor) ExecutePlugin(_ context.Context, id integration.PluginID, req integration.ExecuteRequest) (*integration.ExecuteResponse, error) {
+	e.ids = append(e.ids, id)
+	e.requests = append(e.requests, req)
+	return e.response, nil
+}
+
+func (e *stepExecutor) PluginSteps(context.Context) ([]integration.PluginStep, error) {
+	return e.steps, nil
+}
+
+func TestPublisherAdapter_ExecuteStep_PluginStep(t *testing.T) {
+	executor := &stepExecutor{response: &integration.ExecuteResponse{Success: true, Message: "uploaded 3 assets"}}
+	adapter := NewPublisherAdapter(executor, nil, nil)
+	run := createTestReleaseRun(t)
+	step := &domain.NewPluginSteps(run.ID(), []domain.PluginStep{{Plugin: "assets", Name: "upload"}})[0]
+
+	result, err := adapter.ExecuteStep(context.Background(), run, step)
+	if err != nil {
+		t.Fatalf("ExecuteStep() error = %v", err)
+	}
+	if !result.Success || result.Output != "uploaded 3 assets" {
+		t.Errorf("result = %+v, want success with plugin message", result)
+	}
+	if len(executor.hooks) != 0 {
+		t.Errorf("hooks = %v, want none (only the declaring plugin runs)", executor.hooks)
+	}
+	if len(executor.ids) != 1 || executor.ids[0] != "assets" {
+		t.Fatalf("executed plugins = %v, want [assets]", executor.ids)
+	}
+	req := executor.requests[0]
+	if req.Step != "upload" || req.IdempotencyKey != step.IdempotencyKey {
+		t.Errorf("request step = %q key = %q, want upload and %q", req.Step, req.IdempotencyKey, step.IdempotencyKey)
+	}
+
+	executor.response = &integration.ExecuteResponse{Success: false, Error: "quota exceeded"}
+	result, err = adapter.ExecuteStep(context.Background(), run, step)
+	if err != nil {
+		t.Fatalf("ExecuteStep() error = %v", err)
+	}
+	if result.Success || result.Error == nil || result.Error.Error() != "quota exceeded" {
+		t.Errorf("result = %+v, want failure with plugin error", result)
+	}
+}
+
+func TestPublisherAdapter_ContributedSteps(t *testing.T) {
+	executor := &stepExecutor{steps: []integration.PluginStep{
+		{PluginID: "assets", Name: "upload", Description: "Upload assets"},
+	}}
+	steps, err := NewPublisherAdapter(executor, nil, nil).ContributedSteps(context.Background())
+	if err != nil {
+		t.Fatalf("ContributedSteps() error = %v", err)
+	}
+	if len(steps) != 1 || steps[0] != (domain.PluginStep{Plugin: "assets", Name: "upload", Description: "Upload assets"}) {
+		t.Errorf("ContributedSteps() = %+v", steps)
+	}
+
+	steps, err = NewPublisherAdapter(&hookRecorder{}, nil, nil).ContributedSteps(context.Background())
+	if err != nil || steps != nil {
+		t.Errorf("ContributedSteps() without step provider = %v, %v; want nil, nil", steps, err)
+	}
+}
+
 func TestPublisherAdapter_mapStepTypeToHook(t *testing.T) {
 	mockTC := &mockTagCreator{}
 	adapter := NewPublisherAdapter(nil, nil, mockTC)
@@ -398,6 +581,18 @@ func TestNotesGeneratorAdapter_Generate_NoAIService(t *testing.T) {
 	if notes.Provider != "basic" {
 		t.Errorf("expected basic provider, got: %s", notes.Provider)
 	}
+	if len(notes.Highlights) != 0 {
+		t.Errorf("expected no highlights without highlights count, got: %v", notes.Highlights)
+	}
+
+	options.HighlightsCount = 3
+	notes, err = adapter.Generate(context.Background(), run, options)
+	if err != nil {
+		t.Fatalf("Generate should not return error: %v", err)
+	}
+	if len(notes.Highlights) != 1 || notes.Highlights[0] != "add feature" {
+		t.Errorf("unexpected highlights: %v", notes.Highlights)
+	}
 }
 
 func TestNotesGeneratorAdapter_Generate_NoChangeset(t *testing.T) {
@@ -421,6 +616,78 @@ func TestNotesGeneratorAdapter_Generate_NoChangeset(t *testing.T) {
 	}
 }
 
+func TestNotesGeneratorAdapter_Generate_Template(t *testing.T) {
+	adapter := NewNotesGeneratorAdapter(nil, nil)
+	run := createTestReleaseRunWithChangeset(t)
+
+	path := filepath.Join(t.TempDir(), "changelog.tmpl")
+	if err := os.WriteFile(path, []byte("{{ .Version }}:{{ range .Sections }}{{ .Title }}={{ len .Items }}{{ end }}"), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+
+	notes, err := adapter.Generate(context.Background(), run, ports.NotesOptions{Template: path})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if notes.Text != "1.0.0:Features=1" {
+		t.Errorf("unexpected notes text: %q", notes.Text)
+	}
+	if notes.Provider != "template" {
+		t.Errorf("expected template provider, got: %s", notes.Provider)
+	}
+
+	// Template errors must not fall back to basic notes
+	if err := os.WriteFile(path, []byte("{{ .Nope }}"), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+	if _, err := adapter.Generate(context.Background(), run, ports.NotesOptions{Template: path}); err == nil {
+		t.Error("expected template error, got nil")
+	}
+}
+
+func TestNotesGeneratorAdapter_Generate_ExcludeScopes(t *testing.T) {
+	adapter := NewNotesGeneratorAdapter(nil, nil)
+	run := createTestReleaseRun(t)
+
+	cs := changes.NewChangeSet("test-cs", "v0.9.0", "HEAD")
+	cs.AddCommits([]*changes.ConventionalCommit{
+		changes.NewConventionalCommit("aaa111", changes.CommitTypeFix, "fix login redirect", changes.WithScope("auth")),
+		changes.NewConventionalCommit("bbb222", changes.CommitTypeFeat, "bump lodash", changes.WithScope("deps")),
+		changes.NewConventionalCommit("ccc333", changes.CommitTypeFix, "update French strings", changes.WithScope("i18n-fr")),
+	})
+	run.SetChangeSet(cs)
+
+	options := ports.NotesOptions{ExcludeScopes: []string{"deps", "i18n-*"}}
+	notes, err := adapter.Generate(context.Background(), run, options)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if notes.Text != "- fix login redirect\n" {
+		t.Errorf("unexpected notes text: %q", notes.Text)
+	}
+
+	path := filepath.Join(t.TempDir(), "changelog.tmpl")
+	if err := os.WriteFile(path, []byte("{{ range .Items }}{{ .Description }};{{ end }}"), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+	options.Template = path
+	notes, err = adapter.Generate(context.Background(), run, options)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if notes.Text != "fix login redirect;" {
+		t.Errorf("unexpected template notes text: %q", notes.Text)
+	}
+
+	// Excluded commits remain part of the run and still drive the bump.
+	if run.ChangeSet().CommitCount() != 3 {
+		t.Errorf("run changeset commits = %d, want 3", run.ChangeSet().CommitCount())
+	}
+	if run.ChangeSet().ReleaseType() != changes.ReleaseTypeMinor {
+		t.Errorf("release type = %s, want minor from the excluded feat(deps) commit", run.ChangeSet().ReleaseType())
+	}
+}
+
 func TestNotesGeneratorAdapter_ComputeInputsHash(t *testing.T) {
 	adapter := NewNotesGeneratorAdapter(nil, nil)
 
@@ -449,6 +716,46 @@ func TestNotesGeneratorAdapter_ComputeInputsHash(t *testing.T) {
 	}
 }
 
+func TestNotesGeneratorAdapter_ComputeInputsHash_TemplateContents(t *testing.T) {
+	adapter := NewNotesGeneratorAdapter(nil, nil)
+	run := createTestReleaseRun(t)
+
+	path := filepath.Join(t.TempDir(), "changelog.tmpl")
+	if err := os.WriteFile(path, []byte("{{ .Version }}"), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+	options := ports.NotesOptions{Template: path}
+	before := adapter.ComputeInputsHash(run, options)
+
+	if err := os.WriteFile(path, []byte("## {{ .Version }}"), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+	if adapter.ComputeInputsHash(run, options) == before {
+		t.Error("editing the template should change the inputs hash")
+	}
+}
+
+func TestChangelogEntry_TagPrefix(t *testing.T) {
+	run := createTestReleaseRunWithChangeset(t)
+	options := ports.NotesOptions{RepositoryURL: "https://github.com/acme/app/", TagPrefix: "release-"}
+
+	entry := changelogEntry(run, options)
+	if entry.Tag != run.TagName() {
+		t.Errorf("Tag = %q, want the run's tag %q", entry.Tag, run.TagName())
+	}
+
+	run = domain.NewReleaseRun("test-repo", "/tmp/test-repo", "release-0.9.0", domain.CommitSHA("abc123"), nil, "", "")
+	cs := changes.NewChangeSet("test-cs", "release-0.9.0", "HEAD")
+	cs.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "add feature"))
+	run.SetChangeSet(cs)
+
+	entry = changelogEntry(run, options)
+	want := "https://github.com/acme/app/compare/release-0.9.0...release-" + run.VersionNext().String()
+	if entry.CompareURL != want {
+		t.Errorf("CompareURL = %q, want %q", entry.CompareURL, want)
+	}
+}
+
 func TestNotesGeneratorAdapter_mapTone(t *testing.T) {
 	adapter := NewNotesGeneratorAdapter(nil, nil)
 
@@ -579,6 +886,21 @@ func TestPublisherAdapter_buildReleaseContext(t *testing.T) {
 	if ctx.TagName != "v1.0.0" {
 		t.Errorf("unexpected tag name: %s", ctx.TagName)
 	}
+	if ctx.CommitSHA != string(run.HeadSHA()) {
+		t.Errorf("CommitSHA = %q, want the run's head %q", ctx.CommitSHA, run.HeadSHA())
+	}
+	if len(ctx.Highlights) != 1 || ctx.Highlights[0] != "add feature" {
+		t.Errorf("unexpected highlights: %v", ctx.Highlights)
+	}
+}
+
+func TestPublisherAdapter_buildReleaseContext_HighlightsDisabled(t *testing.T) {
+	adapter := NewPublisherAdapter(nil, nil, &mockTagCreator{}, WithHighlightsCount(0))
+
+	ctx := adapter.buildReleaseContext(createTestReleaseRunWithChangeset(t))
+	if len(ctx.Highlights) != 0 {
+		t.Errorf("expected no highlights with highlights_count 0, got: %v", ctx.Highlights)
+	}
 }
 
 func TestPublisherAdapter_ExecuteStep_TagStep_WithNotes(t *testing.T) {
@@ -0,0 +1,100 @@
+package container
+
+import (
+	"context"
+	"sync"
+
+	"github.com/relicta-tech/relicta/internal/infrastructure/ai"
+	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+)
+
+// secretKeyAIService is an ai.Service whose API key is a secret reference.
+// The key is resolved and the underlying service created on first use, so
+// the secret backend is only contacted when AI is actually needed.
+type secretKeyAIService struct {
+	resolver *secrets.Resolver
+	ref      string
+	opts     []ai.ServiceOption
+
+	mu      sync.Mutex
+	service ai.Service
+}
+
+// newSecretKeyAIService creates an AI service that resolves ref as its API key.
+func newSecretKeyAIService(resolver *secrets.Resolver, ref string, opts []ai.ServiceOption) *secretKeyAIService {
+	return &secretKeyAIService{resolver: resolver, ref: ref, opts: opts}
+}
+
+// get returns the underlying service, creating it on first use. A failed
+// resolution is retried on the next call.
+func (s *secretKeyAIService) get(ctx context.Context) (ai.Service, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.service != nil {
+		return s.service, nil
+	}
+	apiKey, err := s.resolver.Resolve(ctx, s.ref)
+	if err != nil {
+		return nil, err
+	}
+	opts := append(append([]ai.ServiceOption{}, s.opts...), ai.WithAPIKey(apiKey))
+	service, err := ai.NewService(opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.service = service
+	return service, nil
+}
+
+// GenerateChangelog implements ai.Service.
+func (s *secretKeyAIService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts ai.GenerateOptions) (string, error) {
+	service, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GenerateChangelog(ctx, changes, opts)
+}
+
+// GenerateReleaseNotes implements ai.Service.
+func (s *secretKeyAIService) GenerateReleaseNotes(ctx context.Context, changelog string, opts ai.GenerateOptions) (string, error) {
+	service, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GenerateReleaseNotes(ctx, changelog, opts)
+}
+
+// GenerateMarketingBlurb implements ai.Service.
+func (s *secretKeyAIService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts ai.GenerateOptions) (string, error) {
+	service, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GenerateMarketingBlurb(ctx, releaseNotes, opts)
+}
+
+// SummarizeChanges implements ai.Service.
+func (s *secretKeyAIService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts ai.GenerateOptions) (string, error) {
+	service, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.SummarizeChanges(ctx, changes, opts)
+}
+
+// Complete implements ai.Service.
+func (s *secretKeyAIService) Complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	service, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.Complete(ctx, systemPrompt, userPrompt)
+}
+
+// IsAvailable implements ai.Service. A configured secret reference counts
+// as available; resolution errors surface when the service is used.
+func (s *secretKeyAIService) IsAvailable() bool {
+	return true
+}
@@ -0,0 +1,268 @@
+package changes
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CommitConvention names the commit message convention a repository follows.
+type CommitConvention string
+
+// Supported commit conventions.
+const (
+	// ConventionConventional parses Conventional Commits (type(scope): subject).
+	ConventionConventional CommitConvention = "conventional"
+	// ConventionGitmoji parses gitmoji commits (:sparkles: subject).
+	ConventionGitmoji CommitConvention = "gitmoji"
+	// ConventionCustom classifies commits with user-supplied patterns.
+	ConventionCustom CommitConvention = "custom"
+)
+
+// BreakingPatternKey is the type_patterns key whose pattern marks a commit
+// as a breaking change.
+const BreakingPatternKey = "breaking"
+
+// CommitParser parses commit messages into typed commits. Parse returns nil
+// for messages that do not follow the parser's convention.
+type CommitParser interface {
+	Parse(hash, message string, opts ...ConventionalCommitOption) *ConventionalCommit
+}
+
+// NewCommitParser returns the parser for a convention. An empty convention
+// selects Conventional Commits; typePatterns is only used by the custom
+// convention.
+func NewCommitParser(convention CommitConvention, typePatterns map[string]string) (CommitParser, error) {
+	switch convention {
+	case "", ConventionConventional:
+		return ConventionalParser{}, nil
+	case ConventionGitmoji:
+		return GitmojiParser{}, nil
+	case ConventionCustom:
+		return NewPatternParser(typePatterns)
+	default:
+		return nil, fmt.Errorf("unknown commit convention %q: use conventional, gitmoji or custom", convention)
+	}
+}
+
+// ParseCommit parses a commit with parser, falling back to Conventional
+// Commits when parser is nil.
+func ParseCommit(parser CommitParser, hash, message string, opts ...ConventionalCommitOption) *ConventionalCommit {
+	if parser == nil {
+		return ParseConventionalCommit(hash, message, opts...)
+	}
+	return parser.Parse(hash, message, opts...)
+}
+
+// ConventionalParser parses Conventional Commits.
+type ConventionalParser struct{}
+
+// Parse implements CommitParser.
+func (ConventionalParser) Parse(hash, message string, opts ...ConventionalCommitOption) *ConventionalCommit {
+	return ParseConventionalCommit(hash, message, opts...)
+}
+
+// gitmoji maps a gitmoji to the commit type it stands for.
+type gitmoji struct {
+	code     string
+	emoji    string
+	typ      CommitType
+	breaking bool
+}
+
+// gitmojis lists the recognized gitmojis (https://gitmoji.dev). Only
+// :boom:, :sparkles: and the fix emojis affect the version bump.
+var gitmojis = []gitmoji{
+	{":boom:", "💥", CommitTypeFeat, true},
+	{":sparkles:", "✨", CommitTypeFeat, false},
+	{":tada:", "🎉", CommitTypeFeat, false},
+	{":bug:", "🐛", CommitTypeFix, false},
+	{":ambulance:", "🚑", CommitTypeFix, false},
+	{":lock:", "🔒", CommitTypeFix, false},
+	{":adhesive_bandage:", "🩹", CommitTypeFix, false},
+	{":zap:", "⚡", CommitTypePerf, false},
+	{":memo:", "📝", CommitTypeDocs, false},
+	{":art:", "🎨", CommitTypeStyle, false},
+	{":lipstick:", "💄", CommitTypeStyle, false},
+	{":recycle:", "♻", CommitTypeRefactor, false},
+	{":fire:", "🔥", CommitTypeRefactor, false},
+	{":white_check_mark:", "✅", CommitTypeTest, false},
+	{":construction_worker:", "👷", CommitTypeCI, false},
+	{":green_heart:", "💚", CommitTypeCI, false},
+	{":arrow_up:", "⬆", CommitTypeBuild, false},
+	{":arrow_down:", "⬇", CommitTypeBuild, false},
+	{":heavy_plus_sign:", "➕", CommitTypeBuild, false},
+	{":heavy_minus_sign:", "➖", CommitTypeBuild, false},
+	{":package:", "📦", CommitTypeBuild, false},
+	{":rewind:", "⏪", CommitTypeRevert, false},
+	{":wrench:", "🔧", CommitTypeChore, false},
+	{":bookmark:", "🔖", CommitTypeChore, false},
+	{":rocket:", "🚀", CommitTypeChore, false},
+}
+
+// gitmojiScopeRegex matches an optional "(scope)" or "(scope):" after the gitmoji.
+var gitmojiScopeRegex = regexp.MustCompile(`^\(([^)]+)\):?\s*`)
+
+// GitmojiParser parses gitmoji commits: a gitmoji shortcode or emoji,
+// an optional scope and the subject, e.g. ":sparkles: (api) add export".
+// A BREAKING CHANGE footer marks the commit as breaking, as does :boom:.
+type GitmojiParser struct{}
+
+// Parse implements CommitParser.
+func (GitmojiParser) Parse(hash, message string, opts ...ConventionalCommitOption) *ConventionalCommit {
+	if strings.TrimSpace(message) == "" {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	first := strings.TrimSpace(lines[0])
+
+	var match *gitmoji
+	rest := ""
+	for i := range gitmojis {
+		g := &gitmojis[i]
+		if after, ok := strings.CutPrefix(first, g.code); ok {
+			match, rest = g, after
+			break
+		}
+		if after, ok := strings.CutPrefix(first, g.emoji); ok {
+			// Drop the emoji variation selector, e.g. in ♻️ and ⚡️.
+			match, rest = g, strings.TrimPrefix(after, "\ufe0f")
+			break
+		}
+	}
+	if match == nil {
+		return nil
+	}
+
+	rest = strings.TrimSpace(rest)
+	scope := ""
+	if m := gitmojiScopeRegex.FindStringSubmatch(rest); m != nil {
+		scope = m[1]
+		rest = rest[len(m[0]):]
+	}
+	if rest == "" {
+		return nil
+	}
+
+	body, footer, breakingMsg, breaking := parseBodyAndFooter(lines[1:])
+	return newParsedCommit(hash, message, match.typ, scope, rest, body, footer, breakingMsg, breaking || match.breaking, opts)
+}
+
+// typePattern classifies commits whose subject matches re as typ.
+type typePattern struct {
+	typ CommitType
+	re  *regexp.Regexp
+}
+
+// PatternParser classifies commits with user-supplied regular expressions.
+// A commit takes the type of the first matching type pattern (feat, then
+// fix, then the other types alphabetically); the breaking pattern marks a
+// commit as breaking and is matched against the whole message. The named
+// groups "scope" and "subject" extract those parts of the subject line.
+type PatternParser struct {
+	types    []typePattern
+	breaking *regexp.Regexp
+}
+
+// NewPatternParser compiles type patterns keyed by commit type (feat, fix,
+// docs, ...) or "breaking".
+func NewPatternParser(patterns map[string]string) (*PatternParser, error) {
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("the custom commit convention requires type_patterns")
+	}
+
+	p := &PatternParser{}
+	for key, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type pattern for %q: %w", key, err)
+		}
+		if key == BreakingPatternKey {
+			p.breaking = re
+			continue
+		}
+		typ, ok := ParseCommitType(key)
+		if !ok {
+			return nil, fmt.Errorf("invalid type pattern key %q: use a commit type (feat, fix, ...) or %q", key, BreakingPatternKey)
+		}
+		p.types = append(p.types, typePattern{typ: typ, re: re})
+	}
+
+	slices.SortFunc(p.types, func(a, b typePattern) int {
+		return cmp.Or(cmp.Compare(typePatternRank(a.typ), typePatternRank(b.typ)), cmp.Compare(a.typ, b.typ))
+	})
+	return p, nil
+}
+
+// typePatternRank orders feat and fix patterns before the others.
+func typePatternRank(t CommitType) int {
+	switch t {
+	case CommitTypeFeat:
+		return 0
+	case CommitTypeFix:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Parse implements CommitParser. A commit matching only the breaking
+// pattern is a breaking feature.
+func (p *PatternParser) Parse(hash, message string, opts ...ConventionalCommitOption) *ConventionalCommit {
+	if strings.TrimSpace(message) == "" {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	first := strings.TrimSpace(lines[0])
+	body, footer, breakingMsg, breaking := parseBodyAndFooter(lines[1:])
+	if p.breaking != nil && p.breaking.MatchString(message) {
+		breaking = true
+	}
+
+	for _, tp := range p.types {
+		m := tp.re.FindStringSubmatch(first)
+		if m == nil {
+			continue
+		}
+		scope, subject := "", first
+		for i, name := range tp.re.SubexpNames() {
+			switch {
+			case name == "scope":
+				scope = m[i]
+			case name == "subject" && strings.TrimSpace(m[i]) != "":
+				subject = strings.TrimSpace(m[i])
+			}
+		}
+		return newParsedCommit(hash, message, tp.typ, scope, subject, body, footer, breakingMsg, breaking, opts)
+	}
+
+	if breaking {
+		return newParsedCommit(hash, message, CommitTypeFeat, "", first, body, footer, breakingMsg, true, opts)
+	}
+	return nil
+}
+
+// newParsedCommit builds a commit parsed by a non-conventional parser.
+func newParsedCommit(hash, message string, typ CommitType, scope, subject, body, footer, breakingMsg string, breaking bool, opts []ConventionalCommitOption) *ConventionalCommit {
+	c := &ConventionalCommit{
+		hash:        hash,
+		commitType:  typ,
+		scope:       scope,
+		subject:     subject,
+		body:        body,
+		footer:      footer,
+		breaking:    breaking,
+		breakingMsg: breakingMsg,
+		rawMessage:  message,
+		date:        time.Now(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
@@ -0,0 +1,226 @@
+package changes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGitmojiParser_Parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     string
+		wantType    CommitType
+		wantScope   string
+		wantSubject string
+		wantBreak   bool
+		wantNil     bool
+	}{
+		{
+			name:        "sparkles shortcode is a feature",
+			message:     ":sparkles: Add CSV export",
+			wantType:    CommitTypeFeat,
+			wantSubject: "Add CSV export",
+		},
+		{
+			name:        "sparkles emoji is a feature",
+			message:     "✨ Add CSV export",
+			wantType:    CommitTypeFeat,
+			wantSubject: "Add CSV export",
+		},
+		{
+			name:        "bug with scope is a fix",
+			message:     ":bug: (parser) Handle empty input",
+			wantType:    CommitTypeFix,
+			wantScope:   "parser",
+			wantSubject: "Handle empty input",
+		},
+		{
+			name:        "ambulance emoji with colon scope is a fix",
+			message:     "🚑 (api): Restore login",
+			wantType:    CommitTypeFix,
+			wantScope:   "api",
+			wantSubject: "Restore login",
+		},
+		{
+			name:        "boom is breaking",
+			message:     ":boom: Drop the v1 API",
+			wantType:    CommitTypeFeat,
+			wantSubject: "Drop the v1 API",
+			wantBreak:   true,
+		},
+		{
+			name:        "breaking change footer",
+			message:     ":recycle: Rework config loading\n\nBREAKING CHANGE: config.yml is no longer read",
+			wantType:    CommitTypeRefactor,
+			wantSubject: "Rework config loading",
+			wantBreak:   true,
+		},
+		{
+			name:        "emoji with variation selector",
+			message:     "♻️ Simplify the loader",
+			wantType:    CommitTypeRefactor,
+			wantSubject: "Simplify the loader",
+		},
+		{
+			name:        "docs",
+			message:     "📝 Document the CLI",
+			wantType:    CommitTypeDocs,
+			wantSubject: "Document the CLI",
+		},
+		{
+			name:    "conventional commit is not gitmoji",
+			message: "feat: add export",
+			wantNil: true,
+		},
+		{
+			name:    "unknown shortcode",
+			message: ":unicorn: Something magical",
+			wantNil: true,
+		},
+		{
+			name:    "gitmoji without subject",
+			message: ":sparkles:",
+			wantNil: true,
+		},
+		{
+			name:    "empty message",
+			message: "",
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GitmojiParser{}.Parse("abc1234", tt.message)
+			if tt.wantNil {
+				if got != nil {
+					t.Fatalf("Parse() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("Parse() = nil")
+			}
+			if got.Type() != tt.wantType || got.Scope() != tt.wantScope || got.Subject() != tt.wantSubject {
+				t.Errorf("Parse() = %s(%s) %q, want %s(%s) %q", got.Type(), got.Scope(), got.Subject(), tt.wantType, tt.wantScope, tt.wantSubject)
+			}
+			if got.IsBreaking() != tt.wantBreak {
+				t.Errorf("IsBreaking() = %v, want %v", got.IsBreaking(), tt.wantBreak)
+			}
+			if got.RawMessage() != tt.message {
+				t.Errorf("RawMessage() = %q", got.RawMessage())
+			}
+		})
+	}
+}
+
+func TestGitmojiParser_ReleaseType(t *testing.T) {
+	cs := NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	for _, msg := range []string{":bug: Fix crash", ":sparkles: Add export", ":memo: Update docs"} {
+		cs.AddCommit(GitmojiParser{}.Parse("abc", msg))
+	}
+	if got := cs.ReleaseType(); got != ReleaseTypeMinor {
+		t.Errorf("ReleaseType() = %s, want minor", got)
+	}
+
+	cs.AddCommit(GitmojiParser{}.Parse("def", "💥 Remove the legacy flag"))
+	if got := cs.ReleaseType(); got != ReleaseTypeMajor {
+		t.Errorf("ReleaseType() = %s, want major", got)
+	}
+}
+
+func TestPatternParser_Parse(t *testing.T) {
+	parser, err := NewPatternParser(map[string]string{
+		"feat":     `^\[FEATURE\]\s*(?P<subject>.+)$`,
+		"fix":      `^\[(?:BUG|FIX)\](?:\((?P<scope>[^)]+)\))?\s*(?P<subject>.+)$`,
+		"docs":     `^\[DOCS\]`,
+		"breaking": `(?m)^\[BREAKING\]|^BREAKING:`,
+	})
+	if err != nil {
+		t.Fatalf("NewPatternParser() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		message     string
+		wantType    CommitType
+		wantScope   string
+		wantSubject string
+		wantBreak   bool
+		wantNil     bool
+	}{
+		{name: "feature", message: "[FEATURE] Add export", wantType: CommitTypeFeat, wantSubject: "Add export"},
+		{name: "fix with scope", message: "[BUG](auth) Fix token refresh", wantType: CommitTypeFix, wantScope: "auth", wantSubject: "Fix token refresh"},
+		{name: "no subject group keeps the line", message: "[DOCS] Explain setup", wantType: CommitTypeDocs, wantSubject: "[DOCS] Explain setup"},
+		{name: "breaking pattern in body", message: "[FEATURE] New storage\n\nBREAKING: data must be migrated", wantType: CommitTypeFeat, wantSubject: "New storage", wantBreak: true},
+		{name: "breaking only is a breaking feature", message: "[BREAKING] Remove v1", wantType: CommitTypeFeat, wantSubject: "[BREAKING] Remove v1", wantBreak: true},
+		{name: "no match", message: "Update readme", wantNil: true},
+		{name: "empty message", message: "", wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parser.Parse("abc1234", tt.message)
+			if tt.wantNil {
+				if got != nil {
+					t.Fatalf("Parse() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("Parse() = nil")
+			}
+			if got.Type() != tt.wantType || got.Scope() != tt.wantScope || got.Subject() != tt.wantSubject {
+				t.Errorf("Parse() = %s(%s) %q, want %s(%s) %q", got.Type(), got.Scope(), got.Subject(), tt.wantType, tt.wantScope, tt.wantSubject)
+			}
+			if got.IsBreaking() != tt.wantBreak {
+				t.Errorf("IsBreaking() = %v, want %v", got.IsBreaking(), tt.wantBreak)
+			}
+		})
+	}
+}
+
+func TestNewCommitParser(t *testing.T) {
+	for _, convention := range []CommitConvention{"", ConventionConventional} {
+		parser, err := NewCommitParser(convention, nil)
+		if err != nil {
+			t.Fatalf("NewCommitParser(%q) error = %v", convention, err)
+		}
+		if c := parser.Parse("abc", "feat(api): add endpoint"); c == nil || c.Type() != CommitTypeFeat {
+			t.Errorf("NewCommitParser(%q) did not parse a conventional commit", convention)
+		}
+	}
+
+	if parser, err := NewCommitParser(ConventionGitmoji, nil); err != nil || parser.Parse("abc", ":bug: Fix") == nil {
+		t.Errorf("NewCommitParser(gitmoji) = %v, %v", parser, err)
+	}
+
+	errTests := []struct {
+		name       string
+		convention CommitConvention
+		patterns   map[string]string
+		wantErr    string
+	}{
+		{"unknown convention", "angular", nil, "unknown commit convention"},
+		{"custom without patterns", ConventionCustom, nil, "requires type_patterns"},
+		{"invalid regex", ConventionCustom, map[string]string{"feat": "("}, "invalid type pattern"},
+		{"unknown type", ConventionCustom, map[string]string{"feature": "^F"}, "invalid type pattern key"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCommitParser(tt.convention, tt.patterns)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewCommitParser() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCommit_NilParserIsConventional(t *testing.T) {
+	if c := ParseCommit(nil, "abc", "fix: handle nil"); c == nil || c.Type() != CommitTypeFix {
+		t.Errorf("ParseCommit(nil) = %v, want a conventional fix", c)
+	}
+	if c := ParseCommit(GitmojiParser{}, "abc", "fix: handle nil"); c != nil {
+		t.Errorf("ParseCommit(gitmoji) = %v, want nil for a conventional commit", c)
+	}
+}
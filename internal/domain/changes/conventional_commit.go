@@ -139,53 +139,8 @@ func ParseConventionalCommit(hash, message string, opts ...ConventionalCommitOpt
 	breaking := matches[3] == "!"
 	subject := strings.TrimSpace(matches[4])
 
-	// Parse body and footer
-	var body, footer string
-	var breakingMsg string
-
-	if len(lines) > 1 {
-		// Skip empty line after subject
-		bodyStart := 1
-		if bodyStart < len(lines) && strings.TrimSpace(lines[bodyStart]) == "" {
-			bodyStart++
-		}
-
-		// Collect body and footer
-		var bodyLines, footerLines []string
-		inFooter := false
-
-		for i := bodyStart; i < len(lines); i++ {
-			line := lines[i]
-
-			// Check for breaking change in footer
-			if bcMatch := breakingChangeRegex.FindStringSubmatch(line); bcMatch != nil {
-				breaking = true
-				breakingMsg = bcMatch[1]
-				inFooter = true
-				footerLines = append(footerLines, line)
-				continue
-			}
-
-			// Simple footer detection: lines starting with token:
-			if strings.Contains(line, ":") && !strings.HasPrefix(line, " ") {
-				parts := strings.SplitN(line, ":", 2)
-				if len(parts) == 2 && isFooterToken(parts[0]) {
-					inFooter = true
-					footerLines = append(footerLines, line)
-					continue
-				}
-			}
-
-			if inFooter {
-				footerLines = append(footerLines, line)
-			} else {
-				bodyLines = append(bodyLines, line)
-			}
-		}
-
-		body = strings.TrimSpace(strings.Join(bodyLines, "\n"))
-		footer = strings.TrimSpace(strings.Join(footerLines, "\n"))
-	}
+	body, footer, breakingMsg, footerBreaking := parseBodyAndFooter(lines[1:])
+	breaking = breaking || footerBreaking
 
 	c := &ConventionalCommit{
 		hash:        hash,
@@ -207,6 +162,57 @@ func ParseConventionalCommit(hash, message string, opts ...ConventionalCommitOpt
 	return c
 }
 
+// parseBodyAndFooter splits the lines after a commit subject into body and
+// footer, reporting a BREAKING CHANGE footer.
+func parseBodyAndFooter(lines []string) (body, footer, breakingMsg string, breaking bool) {
+	if len(lines) == 0 {
+		return "", "", "", false
+	}
+
+	// Skip empty line after subject
+	bodyStart := 0
+	if strings.TrimSpace(lines[bodyStart]) == "" {
+		bodyStart++
+	}
+
+	// Collect body and footer
+	var bodyLines, footerLines []string
+	inFooter := false
+
+	for i := bodyStart; i < len(lines); i++ {
+		line := lines[i]
+
+		// Check for breaking change in footer
+		if bcMatch := breakingChangeRegex.FindStringSubmatch(line); bcMatch != nil {
+			breaking = true
+			breakingMsg = bcMatch[1]
+			inFooter = true
+			footerLines = append(footerLines, line)
+			continue
+		}
+
+		// Simple footer detection: lines starting with token:
+		if strings.Contains(line, ":") && !strings.HasPrefix(line, " ") {
+			parts := strings.SplitN(line, ":", 2)
+			if len(parts) == 2 && isFooterToken(parts[0]) {
+				inFooter = true
+				footerLines = append(footerLines, line)
+				continue
+			}
+		}
+
+		if inFooter {
+			footerLines = append(footerLines, line)
+		} else {
+			bodyLines = append(bodyLines, line)
+		}
+	}
+
+	body = strings.TrimSpace(strings.Join(bodyLines, "\n"))
+	footer = strings.TrimSpace(strings.Join(footerLines, "\n"))
+	return body, footer, breakingMsg, breaking
+}
+
 // isFooterToken checks if a string looks like a git trailer token.
 func isFooterToken(s string) bool {
 	s = strings.TrimSpace(s)
@@ -0,0 +1,81 @@
+package changes
+
+import "sort"
+
+// DefaultHighlightsCount is the number of highlights extracted when
+// changelog.highlights_count is not configured.
+const DefaultHighlightsCount = 5
+
+// highlightTiers orders commit types by significance after breaking changes.
+var highlightTiers = map[CommitType]int{
+	CommitTypeFeat: 1,
+	CommitTypePerf: 2,
+	CommitTypeFix:  3,
+}
+
+// Highlights returns up to n of the most significant changes in the
+// changeset, formatted as "scope: subject". Breaking changes always come
+// first, followed by features, performance improvements and fixes. Within
+// a tier, changes in frequently touched scopes rank higher, then changes
+// with longer commit bodies, then changeset order.
+func (cs *ChangeSet) Highlights(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	type candidate struct {
+		commit *ConventionalCommit
+		tier   int
+		index  int
+	}
+
+	commits := cs.Commits()
+	scopeCount := make(map[string]int)
+	for _, c := range commits {
+		if c.Scope() != "" {
+			scopeCount[c.Scope()]++
+		}
+	}
+
+	candidates := make([]candidate, 0, len(commits))
+	for i, c := range commits {
+		tier, ok := highlightTiers[c.Type()]
+		if c.IsBreaking() {
+			tier, ok = 0, true
+		}
+		if ok {
+			candidates = append(candidates, candidate{commit: c, tier: tier, index: i})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.tier != b.tier {
+			return a.tier < b.tier
+		}
+		if sa, sb := scopeCount[a.commit.Scope()], scopeCount[b.commit.Scope()]; sa != sb {
+			return sa > sb
+		}
+		if la, lb := len(a.commit.Body()), len(b.commit.Body()); la != lb {
+			return la > lb
+		}
+		return a.index < b.index
+	})
+
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	highlights := make([]string, len(candidates))
+	for i, c := range candidates {
+		highlights[i] = highlightText(c.commit)
+	}
+	return highlights
+}
+
+// highlightText formats a commit as a highlight line.
+func highlightText(c *ConventionalCommit) string {
+	if c.Scope() != "" {
+		return c.Scope() + ": " + c.Subject()
+	}
+	return c.Subject()
+}
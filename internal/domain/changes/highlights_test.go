@@ -0,0 +1,56 @@
+package changes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangeSet_Highlights_BreakingFirst(t *testing.T) {
+	cs := NewChangeSet("cs-highlights", "v1.0.0", "HEAD")
+	cs.AddCommit(NewConventionalCommit("a1", CommitTypeFix, "fix crash on empty config"))
+	cs.AddCommit(NewConventionalCommit("a2", CommitTypeFeat, "add export command", WithScope("cli")))
+	cs.AddCommit(NewConventionalCommit("a3", CommitTypeRefactor, "rework storage layer", WithBreaking("storage format changed")))
+	cs.AddCommit(NewConventionalCommit("a4", CommitTypeChore, "bump dependencies"))
+	cs.AddCommit(NewConventionalCommit("a5", CommitTypeFix, "remove v1 endpoints", WithScope("api"), WithBreaking("v1 removed")))
+
+	got := cs.Highlights(10)
+	want := []string{
+		"api: remove v1 endpoints",
+		"rework storage layer",
+		"cli: add export command",
+		"fix crash on empty config",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Highlights() = %v, want %v", got, want)
+	}
+
+	// Breaking changes lead even when the count truncates the list
+	if got := cs.Highlights(1); len(got) != 1 || got[0] != "api: remove v1 endpoints" {
+		t.Errorf("Highlights(1) = %v, want the first breaking change", got)
+	}
+}
+
+func TestChangeSet_Highlights_FeatureRanking(t *testing.T) {
+	cs := NewChangeSet("cs-ranking", "v1.0.0", "HEAD")
+	cs.AddCommit(NewConventionalCommit("b1", CommitTypeFeat, "add logo", WithScope("ui")))
+	cs.AddCommit(NewConventionalCommit("b2", CommitTypeFeat, "add retries", WithScope("api")))
+	cs.AddCommit(NewConventionalCommit("b3", CommitTypeFix, "fix timeout", WithScope("api")))
+	cs.AddCommit(NewConventionalCommit("b4", CommitTypeFeat, "add dark mode", WithScope("ui"), WithBody("Adds a dark theme with automatic switching.")))
+	cs.AddCommit(NewConventionalCommit("b5", CommitTypeFeat, "add search", WithScope("ui")))
+
+	got := cs.Highlights(3)
+	// ui is the most touched scope; the commit with a body ranks first within it
+	want := []string{"ui: add dark mode", "ui: add logo", "ui: add search"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Highlights(3) = %v, want %v", got, want)
+	}
+}
+
+func TestChangeSet_Highlights_Disabled(t *testing.T) {
+	cs := NewChangeSet("cs-disabled", "v1.0.0", "HEAD")
+	cs.AddCommit(NewConventionalCommit("c1", CommitTypeFeat, "add feature"))
+
+	if got := cs.Highlights(0); got != nil {
+		t.Errorf("Highlights(0) = %v, want nil", got)
+	}
+}
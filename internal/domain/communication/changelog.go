@@ -3,6 +3,7 @@ package communication
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -39,6 +40,17 @@ type ChangelogEntry struct {
 	Sections     []ChangelogSection
 	CompareURL   string
 	IsUnreleased bool
+	// Tag and PreviousTag are the tag names of the release and of the
+	// release it is compared with.
+	Tag         string
+	PreviousTag string
+	// Items are all changes of the entry in changeset order, including
+	// types that have no section.
+	Items []ChangelogItem
+	// Contributors are the unique change authors, sorted by name.
+	Contributors []string
+	// Highlights are the most significant changes, breaking changes first.
+	Highlights []string
 }
 
 // ChangelogSection represents a section within a changelog entry.
@@ -55,6 +67,10 @@ type ChangelogItem struct {
 	Author      string
 	IssueRefs   []string
 	PRRefs      []string
+	// Type is the conventional commit type of the change.
+	Type string
+	// Breaking reports whether the change is breaking.
+	Breaking bool
 }
 
 // Changelog is a value object representing a complete changelog.
@@ -113,15 +129,18 @@ func (c *Changelog) LatestEntry() *ChangelogEntry {
 	return &c.entries[0]
 }
 
-// CreateEntryFromChangeSet creates a changelog entry from a changeset.
-func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, repoURL string) ChangelogEntry {
+// CreateEntryFromChangeSet creates a changelog entry from a changeset. The
+// release is tagged tagPrefix followed by the version.
+func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, repoURL, tagPrefix string) ChangelogEntry {
 	entry := ChangelogEntry{
-		Version: ver,
-		Date:    time.Now(),
+		Version:     ver,
+		Date:        time.Now(),
+		Tag:         tagPrefix + ver.String(),
+		PreviousTag: cs.FromRef(),
 	}
 
 	if repoURL != "" && cs.FromRef() != "" {
-		entry.CompareURL = fmt.Sprintf("%s/compare/%s...%s", repoURL, cs.FromRef(), ver.TagString())
+		entry.CompareURL = fmt.Sprintf("%s/compare/%s...%s", repoURL, cs.FromRef(), entry.Tag)
 	}
 
 	cats := cs.Categories()
@@ -130,11 +149,7 @@ func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet
 	if len(cats.Breaking) > 0 {
 		section := ChangelogSection{Title: "⚠ BREAKING CHANGES"}
 		for _, commit := range cats.Breaking {
-			item := ChangelogItem{
-				Description: commit.Subject(),
-				Scope:       commit.Scope(),
-				CommitHash:  commit.ShortHash(),
-			}
+			item := newChangelogItem(commit)
 			if commit.BreakingMessage() != "" {
 				item.Description = commit.BreakingMessage()
 			}
@@ -147,11 +162,7 @@ func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet
 	if len(cats.Features) > 0 {
 		section := ChangelogSection{Title: "Features"}
 		for _, commit := range cats.Features {
-			section.Items = append(section.Items, ChangelogItem{
-				Description: commit.Subject(),
-				Scope:       commit.Scope(),
-				CommitHash:  commit.ShortHash(),
-			})
+			section.Items = append(section.Items, newChangelogItem(commit))
 		}
 		entry.Sections = append(entry.Sections, section)
 	}
@@ -160,11 +171,7 @@ func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet
 	if len(cats.Fixes) > 0 {
 		section := ChangelogSection{Title: "Bug Fixes"}
 		for _, commit := range cats.Fixes {
-			section.Items = append(section.Items, ChangelogItem{
-				Description: commit.Subject(),
-				Scope:       commit.Scope(),
-				CommitHash:  commit.ShortHash(),
-			})
+			section.Items = append(section.Items, newChangelogItem(commit))
 		}
 		entry.Sections = append(entry.Sections, section)
 	}
@@ -173,18 +180,36 @@ func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet
 	if len(cats.Perf) > 0 {
 		section := ChangelogSection{Title: "Performance Improvements"}
 		for _, commit := range cats.Perf {
-			section.Items = append(section.Items, ChangelogItem{
-				Description: commit.Subject(),
-				Scope:       commit.Scope(),
-				CommitHash:  commit.ShortHash(),
-			})
+			section.Items = append(section.Items, newChangelogItem(commit))
 		}
 		entry.Sections = append(entry.Sections, section)
 	}
 
+	seen := make(map[string]bool)
+	for _, commit := range cs.Commits() {
+		entry.Items = append(entry.Items, newChangelogItem(commit))
+		if author := commit.Author(); author != "" && !seen[author] {
+			seen[author] = true
+			entry.Contributors = append(entry.Contributors, author)
+		}
+	}
+	sort.Strings(entry.Contributors)
+
 	return entry
 }
 
+// newChangelogItem creates a changelog item from a commit.
+func newChangelogItem(commit *changes.ConventionalCommit) ChangelogItem {
+	return ChangelogItem{
+		Description: commit.Subject(),
+		Scope:       commit.Scope(),
+		CommitHash:  commit.ShortHash(),
+		Author:      commit.Author(),
+		Type:        string(commit.Type()),
+		Breaking:    commit.IsBreaking(),
+	}
+}
+
 // Render renders the changelog to a string including header.
 func (c *Changelog) Render() string {
 	var sb strings.Builder
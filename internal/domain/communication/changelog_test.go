@@ -227,7 +227,7 @@ func TestCreateEntryFromChangeSet(t *testing.T) {
 	cs := changes.NewChangeSet("test", "v0.9.0", "HEAD")
 	cs.AddCommits([]*changes.ConventionalCommit{feat, fix, breaking, perf})
 
-	entry := CreateEntryFromChangeSet(ver, cs, "https://github.com/owner/repo")
+	entry := CreateEntryFromChangeSet(ver, cs, "https://github.com/owner/repo", "v")
 
 	if entry.Version.String() != "1.0.0" {
 		t.Errorf("Version = %v, want 1.0.0", entry.Version.String())
@@ -257,7 +257,7 @@ func TestCreateEntryFromChangeSet_BreakingMessageUsed(t *testing.T) {
 	cs := changes.NewChangeSet("test", "v1.0.0", "HEAD")
 	cs.AddCommits([]*changes.ConventionalCommit{breaking})
 
-	entry := CreateEntryFromChangeSet(ver, cs, "")
+	entry := CreateEntryFromChangeSet(ver, cs, "", "v")
 
 	// First section should be breaking changes
 	if len(entry.Sections) < 1 {
@@ -281,13 +281,37 @@ func TestCreateEntryFromChangeSet_NoRepoURL(t *testing.T) {
 	ver := version.MustParse("1.0.0")
 	cs := changes.NewChangeSet("test", "v0.9.0", "HEAD")
 
-	entry := CreateEntryFromChangeSet(ver, cs, "")
+	entry := CreateEntryFromChangeSet(ver, cs, "", "v")
 
 	if entry.CompareURL != "" {
 		t.Errorf("CompareURL should be empty when no repo URL, got %v", entry.CompareURL)
 	}
 }
 
+func TestCreateEntryFromChangeSet_TagPrefixAndItems(t *testing.T) {
+	ver := version.MustParse("1.1.0")
+	docs := changes.NewConventionalCommit("abc1234567", changes.CommitTypeDocs, "document api", changes.WithAuthor("Sam", ""))
+	feat := changes.NewConventionalCommit("def4567890", changes.CommitTypeFeat, "add feature", changes.WithAuthor("Alex", ""))
+
+	cs := changes.NewChangeSet("test", "release-1.0.0", "HEAD")
+	cs.AddCommits([]*changes.ConventionalCommit{docs, feat})
+
+	entry := CreateEntryFromChangeSet(ver, cs, "https://github.com/owner/repo", "release-")
+
+	if entry.Tag != "release-1.1.0" || entry.PreviousTag != "release-1.0.0" {
+		t.Errorf("Tag = %q, PreviousTag = %q, want release-1.1.0 and release-1.0.0", entry.Tag, entry.PreviousTag)
+	}
+	if !strings.HasSuffix(entry.CompareURL, "/compare/release-1.0.0...release-1.1.0") {
+		t.Errorf("CompareURL = %v, want the prefixed tag range", entry.CompareURL)
+	}
+	if len(entry.Items) != 2 || entry.Items[0].Type != "docs" || entry.Items[1].Author != "Alex" {
+		t.Errorf("Items = %+v, want both commits in changeset order", entry.Items)
+	}
+	if strings.Join(entry.Contributors, ",") != "Alex,Sam" {
+		t.Errorf("Contributors = %v, want [Alex Sam]", entry.Contributors)
+	}
+}
+
 func TestChangelog_Render(t *testing.T) {
 	cl := NewChangelog("Changelog", FormatKeepAChangelog)
 	cl.SetDescription("All notable changes to this project")
@@ -39,11 +39,13 @@ type ReleaseContext struct {
 	RepositoryPath  string
 	Branch          string
 	TagName         string
+	CommitSHA       string // Commit the release was planned against
 
 	// Changes info
 	Changes      *changes.ChangeSet
 	Changelog    string
 	ReleaseNotes string
+	Highlights   []string
 
 	// Metadata
 	DryRun    bool
@@ -56,6 +58,11 @@ type ExecuteRequest struct {
 	Context ReleaseContext
 	Config  PluginConfig
 	DryRun  bool
+
+	// Step names a contributed publish step to run, if any.
+	Step string
+	// IdempotencyKey is stable across retries of the same step.
+	IdempotencyKey string
 }
 
 // ExecuteResponse represents a plugin execution response.
@@ -143,6 +150,21 @@ type PluginExecutor interface {
 	ExecutePlugin(ctx context.Context, id PluginID, req ExecuteRequest) (*ExecuteResponse, error)
 }
 
+// PluginStep is a publish step contributed by a plugin.
+type PluginStep struct {
+	PluginID    PluginID
+	Name        string
+	Description string
+}
+
+// StepProvider lists the publish steps contributed by plugins. Executors
+// may implement it when their plugins can contribute steps; contributed
+// steps are run with ExecutePlugin and ExecuteRequest.Step set.
+type StepProvider interface {
+	// PluginSteps returns the contributed steps in execution order.
+	PluginSteps(ctx context.Context) ([]PluginStep, error)
+}
+
 // PluginState represents the state of a plugin.
 type PluginState string
 
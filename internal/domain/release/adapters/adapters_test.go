@@ -1073,6 +1073,82 @@ func TestDTO_WithApproval(t *testing.T) {
 	if reconstructed.Approval().ApprovedBy != "approver@example.com" {
 		t.Errorf("ApprovedBy mismatch in reconstructed: got %s", reconstructed.Approval().ApprovedBy)
 	}
+	if dto.Approval.ExpiresAt != nil || !reconstructed.Approval().ExpiresAt.IsZero() {
+		t.Errorf("approval without TTL should not expire, got %v", dto.Approval.ExpiresAt)
+	}
+}
+
+func TestDTO_WithApprovalExpiry(t *testing.T) {
+	run := domain.NewReleaseRun(
+		"github.com/test/repo",
+		"/tmp/repo",
+		"v1.0.0",
+		domain.CommitSHA("abc123"),
+		[]domain.CommitSHA{"abc123"},
+		"config-hash",
+		"plugin-hash",
+	)
+
+	_ = run.Plan("system")
+	_ = run.SetVersion(version.NewSemanticVersion(1, 1, 0), "v1.1.0")
+	_ = run.Bump("system")
+	_ = run.GenerateNotes(&domain.ReleaseNotes{Text: "notes", GeneratedAt: time.Now()}, "hash", "system")
+	run.SetApprovalTTL(time.Hour)
+	_ = run.Approve("approver@example.com", false)
+
+	reconstructed, err := fromDTO(toDTO(run))
+	if err != nil {
+		t.Fatalf("fromDTO failed: %v", err)
+	}
+	want := run.Approval().ExpiresAt
+	if want.IsZero() || !reconstructed.Approval().ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %v, want %v", reconstructed.Approval().ExpiresAt, want)
+	}
+	// The reconstructed run has no TTL configured but keeps the expiry
+	if !reconstructed.IsApprovalExpired(want.Add(time.Minute)) {
+		t.Error("reconstructed approval should expire at the persisted time")
+	}
+}
+
+func TestDTO_WithMultiLevelApproval(t *testing.T) {
+	run := domain.NewReleaseRun(
+		"github.com/test/repo",
+		"/tmp/repo",
+		"v1.0.0",
+		domain.CommitSHA("abc123"),
+		[]domain.CommitSHA{"abc123"},
+		"config-hash",
+		"plugin-hash",
+	)
+
+	_ = run.Plan("system")
+	_ = run.SetVersion(version.NewSemanticVersion(1, 1, 0), "v1.1.0")
+	_ = run.Bump("system")
+	_ = run.GenerateNotes(&domain.ReleaseNotes{Text: "notes", GeneratedAt: time.Now()}, "hash", "system")
+	run.SetApprovalPolicy(domain.HighRiskApprovalPolicy())
+	if err := run.ApproveAtLevel(domain.ApprovalLevelTechnical, "tech-lead", domain.ActorHuman, "reviewed"); err != nil {
+		t.Fatalf("ApproveAtLevel failed: %v", err)
+	}
+
+	reconstructed, err := fromDTO(toDTO(run))
+	if err != nil {
+		t.Fatalf("fromDTO failed: %v", err)
+	}
+
+	ml := reconstructed.MultiLevelApprovalStatus()
+	if ml == nil {
+		t.Fatal("Expected multi-level approval in reconstructed run")
+	}
+	if !ml.Policy.Sequential || len(ml.Policy.Requirements) != 3 {
+		t.Errorf("policy mismatch: %+v", ml.Policy)
+	}
+	granted := ml.GetApproval(domain.ApprovalLevelTechnical)
+	if granted == nil || granted.ApprovedBy != "tech-lead" || granted.Justification != "reviewed" || granted.Level != domain.ApprovalLevelTechnical {
+		t.Errorf("technical approval mismatch: %+v", granted)
+	}
+	if next := ml.NextRequiredLevel(); next == nil || next.Level != domain.ApprovalLevelSecurity {
+		t.Errorf("expected security to be next, got %+v", next)
+	}
 }
 
 // =============================================================================
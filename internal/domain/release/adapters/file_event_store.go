@@ -14,6 +14,7 @@ import (
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/internal/fileutil"
 )
 
 const (
@@ -36,7 +37,7 @@ var _ ports.EventStore = (*FileEventStore)(nil)
 
 // eventsPath returns the path to the events directory for a repo.
 func eventsPath(repoRoot string) string {
-	return filepath.Join(repoRoot, eventsDir)
+	return filepath.Join(fileutil.StateRoot(repoRoot), eventsDir)
 }
 
 // eventFilePath returns the path to events file for a specific run.
@@ -302,6 +303,12 @@ func deserializeEvent(eventName string, payload json.RawMessage) (domain.DomainE
 			return nil, err
 		}
 		return &e, nil
+	case "run.rejected":
+		var e domain.RunRejectedEvent
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return nil, err
+		}
+		return &e, nil
 	case "run.publishing_started":
 		var e domain.RunPublishingStartedEvent
 		if err := json.Unmarshal(payload, &e); err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/internal/fileutil"
 )
 
 const (
@@ -40,7 +41,7 @@ type LockFileContents struct {
 
 // lockPath returns the path to the lock file.
 func lockPath(repoRoot string) string {
-	return filepath.Join(repoRoot, runsDir, lockFileName)
+	return filepath.Join(fileutil.StateRoot(repoRoot), runsDir, lockFileName)
 }
 
 // Acquire attempts to acquire an exclusive lock for the given run.
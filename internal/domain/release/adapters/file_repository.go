@@ -16,6 +16,7 @@ import (
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/version"
+	"github.com/relicta-tech/relicta/internal/fileutil"
 )
 
 const (
@@ -80,7 +81,7 @@ var _ ports.ReleaseRunRepository = (*FileReleaseRunRepository)(nil)
 
 // runsPath returns the path to the runs directory for a repo.
 func runsPath(repoRoot string) string {
-	return filepath.Join(repoRoot, runsDir)
+	return filepath.Join(fileutil.StateRoot(repoRoot), runsDir)
 }
 
 // runPath returns the path to a specific run file.
@@ -114,37 +115,39 @@ func ensureDir(repoRoot string) error {
 
 // ReleaseRunDTO is the data transfer object for serialization.
 type ReleaseRunDTO struct {
-	ID             string                   `json:"id"`
-	PlanHash       string                   `json:"plan_hash"`
-	RepoID         string                   `json:"repo_id"`
-	RepoRoot       string                   `json:"repo_root"`
-	BaseRef        string                   `json:"base_ref"`
-	HeadSHA        string                   `json:"head_sha"`
-	Commits        []string                 `json:"commits"`
-	ConfigHash     string                   `json:"config_hash"`
-	PluginPlanHash string                   `json:"plugin_plan_hash"`
-	VersionCurrent string                   `json:"version_current"`
-	VersionNext    string                   `json:"version_next"`
-	BumpKind       string                   `json:"bump_kind"`
-	Confidence     float64                  `json:"confidence"`
-	RiskScore      float64                  `json:"risk_score"`
-	Reasons        []string                 `json:"reasons"`
-	ActorType      string                   `json:"actor_type"`
-	ActorID        string                   `json:"actor_id"`
-	Thresholds     PolicyThresholdsDTO      `json:"thresholds"`
-	TagName        string                   `json:"tag_name,omitempty"`
-	Notes          *ReleaseNotesDTO         `json:"notes,omitempty"`
-	NotesInputHash string                   `json:"notes_inputs_hash,omitempty"`
-	Approval       *ApprovalDTO             `json:"approval,omitempty"`
-	Steps          []StepPlanDTO            `json:"steps"`
-	StepStatus     map[string]StepStatusDTO `json:"step_status"`
-	State          string                   `json:"state"`
-	History        []TransitionRecordDTO    `json:"history"`
-	LastError      string                   `json:"last_error,omitempty"`
-	ChangesetID    string                   `json:"changeset_id,omitempty"`
-	CreatedAt      time.Time                `json:"created_at"`
-	UpdatedAt      time.Time                `json:"updated_at"`
-	PublishedAt    *time.Time               `json:"published_at,omitempty"`
+	ID              string                   `json:"id"`
+	PlanHash        string                   `json:"plan_hash"`
+	RepoID          string                   `json:"repo_id"`
+	RepoRoot        string                   `json:"repo_root"`
+	BaseRef         string                   `json:"base_ref"`
+	HeadSHA         string                   `json:"head_sha"`
+	Commits         []string                 `json:"commits"`
+	ExcludedCommits []string                 `json:"excluded_commits,omitempty"`
+	ConfigHash      string                   `json:"config_hash"`
+	PluginPlanHash  string                   `json:"plugin_plan_hash"`
+	VersionCurrent  string                   `json:"version_current"`
+	VersionNext     string                   `json:"version_next"`
+	BumpKind        string                   `json:"bump_kind"`
+	Confidence      float64                  `json:"confidence"`
+	RiskScore       float64                  `json:"risk_score"`
+	Reasons         []string                 `json:"reasons"`
+	ActorType       string                   `json:"actor_type"`
+	ActorID         string                   `json:"actor_id"`
+	Thresholds      PolicyThresholdsDTO      `json:"thresholds"`
+	TagName         string                   `json:"tag_name,omitempty"`
+	Notes           *ReleaseNotesDTO         `json:"notes,omitempty"`
+	NotesInputHash  string                   `json:"notes_inputs_hash,omitempty"`
+	Approval        *ApprovalDTO             `json:"approval,omitempty"`
+	MultiLevel      *MultiLevelApprovalDTO   `json:"multi_level_approval,omitempty"`
+	Steps           []StepPlanDTO            `json:"steps"`
+	StepStatus      map[string]StepStatusDTO `json:"step_status"`
+	State           string                   `json:"state"`
+	History         []TransitionRecordDTO    `json:"history"`
+	LastError       string                   `json:"last_error,omitempty"`
+	ChangesetID     string                   `json:"changeset_id,omitempty"`
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+	PublishedAt     *time.Time               `json:"published_at,omitempty"`
 }
 
 // PolicyThresholdsDTO is the DTO for policy thresholds.
@@ -157,6 +160,7 @@ type PolicyThresholdsDTO struct {
 // ReleaseNotesDTO is the DTO for release notes.
 type ReleaseNotesDTO struct {
 	Text           string    `json:"text"`
+	Highlights     []string  `json:"highlights,omitempty"`
 	AudiencePreset string    `json:"audience_preset"`
 	TonePreset     string    `json:"tone_preset"`
 	Provider       string    `json:"provider"`
@@ -172,7 +176,10 @@ type StepPlanDTO struct {
 	IdempotencyKey string `json:"idempotency_key"`
 	PluginName     string `json:"plugin_name,omitempty"`
 	Hook           string `json:"hook,omitempty"`
+	Step           string `json:"step,omitempty"`
 	Unsafe         bool   `json:"unsafe,omitempty"`
+	Package        string `json:"package,omitempty"`
+	TagName        string `json:"tag_name,omitempty"`
 }
 
 // StepStatusDTO is the DTO for step status.
@@ -198,13 +205,30 @@ type TransitionRecordDTO struct {
 
 // ApprovalDTO is the DTO for approval information.
 type ApprovalDTO struct {
-	ApprovedBy    string    `json:"approved_by"`
-	ApprovedAt    time.Time `json:"approved_at"`
-	AutoApproved  bool      `json:"auto_approved"`
-	PlanHash      string    `json:"plan_hash"`
-	RiskScore     float64   `json:"risk_score"`
-	ApproverType  string    `json:"approver_type"`
-	Justification string    `json:"justification,omitempty"`
+	ApprovedBy    string     `json:"approved_by"`
+	ApprovedAt    time.Time  `json:"approved_at"`
+	AutoApproved  bool       `json:"auto_approved"`
+	PlanHash      string     `json:"plan_hash"`
+	RiskScore     float64    `json:"risk_score"`
+	ApproverType  string     `json:"approver_type"`
+	Justification string     `json:"justification,omitempty"`
+	Level         string     `json:"level,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// MultiLevelApprovalDTO is the DTO for multi-level approval tracking.
+type MultiLevelApprovalDTO struct {
+	Requirements []ApprovalRequirementDTO `json:"requirements"`
+	Sequential   bool                     `json:"sequential"`
+	Approvals    map[string]*ApprovalDTO  `json:"approvals,omitempty"`
+}
+
+// ApprovalRequirementDTO is the DTO for an approval requirement.
+type ApprovalRequirementDTO struct {
+	Level       string   `json:"level"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required"`
+	AllowedBy   []string `json:"allowed_by,omitempty"`
 }
 
 // trackRepoRoot adds a repo root to the known set (must be called with lock held).
@@ -599,6 +623,11 @@ func toDTO(run *domain.ReleaseRun) *ReleaseRunDTO {
 		commits[i] = string(c)
 	}
 
+	var excluded []string
+	for _, c := range run.ExcludedCommits() {
+		excluded = append(excluded, string(c))
+	}
+
 	steps := make([]StepPlanDTO, len(run.Steps()))
 	for i, s := range run.Steps() {
 		steps[i] = StepPlanDTO{
@@ -608,7 +637,10 @@ func toDTO(run *domain.ReleaseRun) *ReleaseRunDTO {
 			IdempotencyKey: s.IdempotencyKey,
 			PluginName:     s.PluginName,
 			Hook:           s.Hook,
+			Step:           s.Step,
 			Unsafe:         s.Unsafe,
+			Package:        s.Package,
+			TagName:        s.TagName,
 		}
 	}
 
@@ -638,35 +670,42 @@ func toDTO(run *domain.ReleaseRun) *ReleaseRunDTO {
 	}
 
 	dto := &ReleaseRunDTO{
-		ID:             string(run.ID()),
-		PlanHash:       run.PlanHash(),
-		RepoID:         run.RepoID(),
-		RepoRoot:       run.RepoRoot(),
-		BaseRef:        run.BaseRef(),
-		HeadSHA:        string(run.HeadSHA()),
-		Commits:        commits,
-		VersionCurrent: run.VersionCurrent().String(),
-		VersionNext:    run.VersionNext().String(),
-		BumpKind:       string(run.BumpKind()),
-		RiskScore:      run.RiskScore(),
-		Reasons:        run.Reasons(),
-		ActorType:      string(run.ActorType()),
-		ActorID:        run.ActorID(),
-		TagName:        run.TagName(),
-		Steps:          steps,
-		StepStatus:     stepStatus,
-		State:          string(run.State()),
-		History:        history,
-		LastError:      run.LastError(),
-		ChangesetID:    run.ChangesetID(),
-		CreatedAt:      run.CreatedAt(),
-		UpdatedAt:      run.UpdatedAt(),
-		PublishedAt:    run.PublishedAt(),
+		ID:              string(run.ID()),
+		PlanHash:        run.PlanHash(),
+		RepoID:          run.RepoID(),
+		RepoRoot:        run.RepoRoot(),
+		BaseRef:         run.BaseRef(),
+		HeadSHA:         string(run.HeadSHA()),
+		Commits:         commits,
+		ExcludedCommits: excluded,
+		VersionCurrent:  run.VersionCurrent().String(),
+		VersionNext:     run.VersionNext().String(),
+		BumpKind:        string(run.BumpKind()),
+		RiskScore:       run.RiskScore(),
+		Reasons:         run.Reasons(),
+		Thresholds: PolicyThresholdsDTO{
+			AutoApproveRiskThreshold: run.Thresholds().AutoApproveRiskThreshold,
+			RequireApprovalAbove:     run.Thresholds().RequireApprovalAbove,
+			BlockReleaseAbove:        run.Thresholds().BlockReleaseAbove,
+		},
+		ActorType:   string(run.ActorType()),
+		ActorID:     run.ActorID(),
+		TagName:     run.TagName(),
+		Steps:       steps,
+		StepStatus:  stepStatus,
+		State:       string(run.State()),
+		History:     history,
+		LastError:   run.LastError(),
+		ChangesetID: run.ChangesetID(),
+		CreatedAt:   run.CreatedAt(),
+		UpdatedAt:   run.UpdatedAt(),
+		PublishedAt: run.PublishedAt(),
 	}
 
 	if run.Notes() != nil {
 		dto.Notes = &ReleaseNotesDTO{
 			Text:           run.Notes().Text,
+			Highlights:     run.Notes().Highlights,
 			AudiencePreset: run.Notes().AudiencePreset,
 			TonePreset:     run.Notes().TonePreset,
 			Provider:       run.Notes().Provider,
@@ -675,22 +714,96 @@ func toDTO(run *domain.ReleaseRun) *ReleaseRunDTO {
 		}
 	}
 
-	if run.Approval() != nil {
-		approval := run.Approval()
-		dto.Approval = &ApprovalDTO{
-			ApprovedBy:    approval.ApprovedBy,
-			ApprovedAt:    approval.ApprovedAt,
-			AutoApproved:  approval.AutoApproved,
-			PlanHash:      approval.PlanHash,
-			RiskScore:     approval.RiskScore,
-			ApproverType:  string(approval.ApproverType),
-			Justification: approval.Justification,
+	dto.Approval = approvalToDTO(run.Approval())
+
+	if ml := run.MultiLevelApprovalStatus(); ml != nil {
+		dto.MultiLevel = &MultiLevelApprovalDTO{
+			Requirements: make([]ApprovalRequirementDTO, len(ml.Policy.Requirements)),
+			Sequential:   ml.Policy.Sequential,
+			Approvals:    make(map[string]*ApprovalDTO, len(ml.Approvals)),
+		}
+		for i, req := range ml.Policy.Requirements {
+			dto.MultiLevel.Requirements[i] = ApprovalRequirementDTO{
+				Level:       string(req.Level),
+				Description: req.Description,
+				Required:    req.Required,
+				AllowedBy:   req.AllowedBy,
+			}
+		}
+		for level, approval := range ml.Approvals {
+			dto.MultiLevel.Approvals[string(level)] = approvalToDTO(approval)
 		}
 	}
 
 	return dto
 }
 
+func approvalToDTO(approval *domain.Approval) *ApprovalDTO {
+	if approval == nil {
+		return nil
+	}
+	dto := &ApprovalDTO{
+		ApprovedBy:    approval.ApprovedBy,
+		ApprovedAt:    approval.ApprovedAt,
+		AutoApproved:  approval.AutoApproved,
+		PlanHash:      approval.PlanHash,
+		RiskScore:     approval.RiskScore,
+		ApproverType:  string(approval.ApproverType),
+		Justification: approval.Justification,
+		Level:         string(approval.Level),
+	}
+	if !approval.ExpiresAt.IsZero() {
+		expiresAt := approval.ExpiresAt
+		dto.ExpiresAt = &expiresAt
+	}
+	return dto
+}
+
+func approvalFromDTO(dto *ApprovalDTO) *domain.Approval {
+	if dto == nil {
+		return nil
+	}
+	approval := &domain.Approval{
+		ApprovedBy:    dto.ApprovedBy,
+		ApprovedAt:    dto.ApprovedAt,
+		AutoApproved:  dto.AutoApproved,
+		PlanHash:      dto.PlanHash,
+		RiskScore:     dto.RiskScore,
+		ApproverType:  domain.ActorType(dto.ApproverType),
+		Justification: dto.Justification,
+		Level:         domain.ApprovalLevel(dto.Level),
+	}
+	if dto.ExpiresAt != nil {
+		approval.ExpiresAt = *dto.ExpiresAt
+	}
+	return approval
+}
+
+func multiLevelApprovalFromDTO(dto *MultiLevelApprovalDTO) *domain.MultiLevelApproval {
+	if dto == nil {
+		return nil
+	}
+	policy := domain.ApprovalPolicy{
+		Requirements: make([]domain.ApprovalRequirement, len(dto.Requirements)),
+		Sequential:   dto.Sequential,
+	}
+	for i, req := range dto.Requirements {
+		policy.Requirements[i] = domain.ApprovalRequirement{
+			Level:       domain.ApprovalLevel(req.Level),
+			Description: req.Description,
+			Required:    req.Required,
+			AllowedBy:   req.AllowedBy,
+		}
+	}
+	ml := domain.NewMultiLevelApproval(policy)
+	for level, approval := range dto.Approvals {
+		if approval != nil {
+			ml.Approvals[domain.ApprovalLevel(level)] = approvalFromDTO(approval)
+		}
+	}
+	return ml
+}
+
 func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 	// Convert commits
 	commits := make([]domain.CommitSHA, len(dto.Commits))
@@ -698,6 +811,11 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 		commits[i] = domain.CommitSHA(c)
 	}
 
+	var excluded []domain.CommitSHA
+	for _, c := range dto.ExcludedCommits {
+		excluded = append(excluded, domain.CommitSHA(c))
+	}
+
 	// Parse versions
 	currentVer, _ := version.Parse(dto.VersionCurrent)
 	nextVer, _ := version.Parse(dto.VersionNext)
@@ -715,6 +833,7 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 	if dto.Notes != nil {
 		notes = &domain.ReleaseNotes{
 			Text:           dto.Notes.Text,
+			Highlights:     dto.Notes.Highlights,
 			AudiencePreset: dto.Notes.AudiencePreset,
 			TonePreset:     dto.Notes.TonePreset,
 			Provider:       dto.Notes.Provider,
@@ -723,19 +842,9 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 		}
 	}
 
-	// Convert approval
-	var approval *domain.Approval
-	if dto.Approval != nil {
-		approval = &domain.Approval{
-			ApprovedBy:    dto.Approval.ApprovedBy,
-			ApprovedAt:    dto.Approval.ApprovedAt,
-			AutoApproved:  dto.Approval.AutoApproved,
-			PlanHash:      dto.Approval.PlanHash,
-			RiskScore:     dto.Approval.RiskScore,
-			ApproverType:  domain.ActorType(dto.Approval.ApproverType),
-			Justification: dto.Approval.Justification,
-		}
-	}
+	// Convert approvals
+	approval := approvalFromDTO(dto.Approval)
+	multiLevel := multiLevelApprovalFromDTO(dto.MultiLevel)
 
 	// Convert steps
 	steps := make([]domain.StepPlan, len(dto.Steps))
@@ -747,7 +856,10 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 			IdempotencyKey: s.IdempotencyKey,
 			PluginName:     s.PluginName,
 			Hook:           s.Hook,
+			Step:           s.Step,
 			Unsafe:         s.Unsafe,
+			Package:        s.Package,
+			TagName:        s.TagName,
 		}
 	}
 
@@ -788,6 +900,7 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 		BaseRef:         dto.BaseRef,
 		HeadSHA:         domain.CommitSHA(dto.HeadSHA),
 		Commits:         commits,
+		ExcludedCommits: excluded,
 		ConfigHash:      dto.ConfigHash,
 		PluginPlanHash:  dto.PluginPlanHash,
 		VersionCurrent:  currentVer,
@@ -803,6 +916,7 @@ func fromDTO(dto *ReleaseRunDTO) (*domain.ReleaseRun, error) {
 		Notes:           notes,
 		NotesInputsHash: dto.NotesInputHash,
 		Approval:        approval,
+		MultiLevel:      multiLevel,
 		Steps:           steps,
 		StepStatus:      stepStatus,
 		State:           domain.RunState(dto.State),
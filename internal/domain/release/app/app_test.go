@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -106,7 +107,7 @@ func (m *mockRepository) FindActive(_ context.Context, _ string) ([]*domain.Rele
 	}
 	var runs []*domain.ReleaseRun
 	for _, run := range m.runs {
-		if !run.State().IsFinal() {
+		if !run.State().IsFinished() {
 			runs = append(runs, run)
 		}
 	}
@@ -247,21 +248,25 @@ func (m *mockVersionWriter) WriteChangelog(_ context.Context, _ version.Semantic
 }
 
 type mockNotesGenerator struct {
-	notes    string
-	provider string
-	model    string
-	err      error
+	notes       string
+	provider    string
+	model       string
+	err         error
+	lastOptions ports.NotesOptions
 }
 
-func (m *mockNotesGenerator) Generate(_ context.Context, _ *domain.ReleaseRun, _ ports.NotesOptions) (*domain.ReleaseNotes, error) {
+func (m *mockNotesGenerator) Generate(_ context.Context, _ *domain.ReleaseRun, options ports.NotesOptions) (*domain.ReleaseNotes, error) {
+	m.lastOptions = options
 	if m.err != nil {
 		return nil, m.err
 	}
 	return &domain.ReleaseNotes{
-		Text:        m.notes,
-		Provider:    m.provider,
-		Model:       m.model,
-		GeneratedAt: time.Now(),
+		Text:           m.notes,
+		AudiencePreset: options.AudiencePreset,
+		TonePreset:     options.TonePreset,
+		Provider:       m.provider,
+		Model:          m.model,
+		GeneratedAt:    time.Now(),
 	}, nil
 }
 
@@ -313,6 +318,70 @@ func TestPlanReleaseUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestPlanReleaseUseCase_Execute_ExcludedCommits(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	uc := NewPlanReleaseUseCase(repo, inspector, nil)
+
+	input := PlanReleaseInput{
+		RepoRoot:        "/path/to/repo",
+		Actor:           ports.ActorInfo{Type: domain.ActorHuman, ID: "user@example.com"},
+		ExcludedCommits: []string{"def789"},
+	}
+
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Commits) != 1 || output.Commits[0] != "abc123def456" {
+		t.Errorf("Execute() Commits = %v, want [abc123def456]", output.Commits)
+	}
+	if len(output.Excluded) != 1 || output.Excluded[0] != "def789012345" {
+		t.Errorf("Execute() Excluded = %v, want [def789012345]", output.Excluded)
+	}
+
+	input.ExcludedCommits = []string{"ffffff"}
+	input.Force = true
+	if _, err := uc.Execute(ctx, input); !errors.Is(err, domain.ErrCommitNotInRange) {
+		t.Errorf("Execute() error = %v, want ErrCommitNotInRange", err)
+	}
+}
+
+func TestPlanReleaseUseCase_Execute_AnalyzedCommits(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	inspector.commitsErr = errors.New("range must not be re-resolved")
+
+	uc := NewPlanReleaseUseCase(repo, inspector, nil)
+
+	// A --since window reaching past the latest tag, with one commit
+	// excluded by the analysis
+	input := PlanReleaseInput{
+		RepoRoot:        "/path/to/repo",
+		BaseRef:         "v0.9.0",
+		Actor:           ports.ActorInfo{Type: domain.ActorHuman, ID: "user@example.com"},
+		Commits:         []domain.CommitSHA{"abc123def456", "0123456789ab"},
+		ExcludedCommits: []string{"ffffff000000"},
+	}
+
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Commits) != 2 || output.Commits[1] != "0123456789ab" {
+		t.Errorf("Execute() Commits = %v, want the analyzed commits", output.Commits)
+	}
+	if len(output.Excluded) != 1 || output.Excluded[0] != "ffffff000000" {
+		t.Errorf("Execute() Excluded = %v, want [ffffff000000]", output.Excluded)
+	}
+	if base := repo.runs[output.RunID].BaseRef(); base != "v0.9.0" {
+		t.Errorf("BaseRef() = %q, want v0.9.0", base)
+	}
+}
+
 func TestPlanReleaseUseCase_Execute_ActiveRunExists(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -879,6 +948,111 @@ func TestBumpVersionUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestBumpVersionUseCase_Execute_Prerelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		planned string
+		wantVer string
+	}{
+		{"enters prerelease line from stable", "1.2.0", "1.3.0", "1.3.0-beta.1"},
+		{"continues existing series", "1.3.0-beta.1", "1.3.0", "1.3.0-beta.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+			run := domain.NewReleaseRun(
+				"repo", "/path/to/repo", "v"+tt.current,
+				domain.CommitSHA("abc123def456"), nil, "", "",
+			)
+			_ = run.SetVersionProposal(version.MustParse(tt.current), version.MustParse(tt.planned), domain.BumpMinor, 0.95)
+			_ = run.Plan("test")
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			uc := NewBumpVersionUseCase(repo, newMockRepoInspector(), &mockLockManager{}, nil, nil)
+			output, err := uc.Execute(context.Background(), BumpVersionInput{
+				RepoRoot:   "/path/to/repo",
+				Actor:      ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+				Force:      true,
+				Prerelease: version.PrereleaseBeta,
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if output.VersionNext != tt.wantVer {
+				t.Errorf("VersionNext = %s, want %s", output.VersionNext, tt.wantVer)
+			}
+			if output.TagName != "v"+tt.wantVer {
+				t.Errorf("TagName = %s, want v%s", output.TagName, tt.wantVer)
+			}
+			if got := repo.runs[run.ID()].VersionNext().String(); got != tt.wantVer {
+				t.Errorf("run VersionNext = %s, want %s", got, tt.wantVer)
+			}
+		})
+	}
+}
+
+func TestBumpVersionUseCase_Execute_OverrideVersionOrdering(t *testing.T) {
+	tests := []struct {
+		name           string
+		current        string
+		override       string
+		allowDowngrade bool
+		wantErr        bool
+	}{
+		{"greater version", "1.2.0", "1.3.0", false, false},
+		{"lower version", "1.2.0", "1.1.0", false, true},
+		{"same version", "1.2.0", "1.2.0", false, true},
+		{"release after its prerelease", "1.0.0-rc.1", "1.0.0", false, false},
+		{"prerelease of current release", "1.0.0", "1.0.0-rc.1", false, true},
+		{"numeric prerelease ordering", "1.0.0-rc.2", "1.0.0-rc.10", false, false},
+		{"lower version allowed", "1.2.0", "1.1.0", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+			run := domain.NewReleaseRun(
+				"repo", "/path/to/repo", "v"+tt.current,
+				domain.CommitSHA("abc123def456"), nil, "", "",
+			)
+			_ = run.SetVersionProposal(version.MustParse(tt.current), version.MustParse("2.0.0"), domain.BumpMajor, 0.95)
+			_ = run.Plan("test")
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			override := version.MustParse(tt.override)
+			uc := NewBumpVersionUseCase(repo, newMockRepoInspector(), &mockLockManager{}, nil, nil)
+			output, err := uc.Execute(context.Background(), BumpVersionInput{
+				RepoRoot:        "/path/to/repo",
+				Actor:           ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+				Force:           true,
+				OverrideVersion: &override,
+				AllowDowngrade:  tt.allowDowngrade,
+			})
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrVersionNotIncreasing) {
+					t.Fatalf("Execute() error = %v, want ErrVersionNotIncreasing", err)
+				}
+				if got := repo.runs[run.ID()].State(); got != domain.StatePlanned {
+					t.Errorf("run state = %v, want %v", got, domain.StatePlanned)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output.VersionNext != tt.override {
+				t.Errorf("VersionNext = %s, want %s", output.VersionNext, tt.override)
+			}
+		})
+	}
+}
+
 func TestBumpVersionUseCase_Execute_NoLatestRun(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -949,6 +1123,258 @@ func TestGenerateNotesUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestGenerateNotesUseCase_Execute_Presets(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      ports.NotesOptions
+		wantAudience string
+		wantTone     string
+		wantErr      bool
+	}{
+		{"defaults", ports.NotesOptions{}, domain.DefaultNotesAudience, domain.DefaultNotesTone, false},
+		{"explicit presets", ports.NotesOptions{AudiencePreset: "users", TonePreset: "friendly"}, "users", "friendly", false},
+		{"aliases", ports.NotesOptions{AudiencePreset: "all", TonePreset: "marketing"}, "public", "excited", false},
+		{"unknown tone", ports.NotesOptions{TonePreset: "sarcastic"}, "", "", true},
+		{"unknown audience", ports.NotesOptions{AudiencePreset: "stakeholders"}, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+			run := domain.NewReleaseRun(
+				"repo", "/path/to/repo", "v1.0.0",
+				domain.CommitSHA("abc123def456"), nil, "", "",
+			)
+			_ = run.SetVersionProposal(version.MustParse("1.0.0"), version.MustParse("1.1.0"), domain.BumpMinor, 0.95)
+			_ = run.Plan("test")
+			_ = run.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+			_ = run.Bump("test")
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			notesGen := &mockNotesGenerator{notes: "## Release Notes", provider: "mock"}
+			uc := NewGenerateNotesUseCase(repo, newMockRepoInspector(), notesGen, nil)
+			output, err := uc.Execute(context.Background(), GenerateNotesInput{
+				RepoRoot: "/path/to/repo",
+				Options:  tt.options,
+				Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+			})
+
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrUnknownNotesPreset) {
+					t.Fatalf("Execute() error = %v, want ErrUnknownNotesPreset", err)
+				}
+				if run.State() != domain.StateVersioned {
+					t.Errorf("Run state = %v, want %v", run.State(), domain.StateVersioned)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if notesGen.lastOptions.AudiencePreset != tt.wantAudience || notesGen.lastOptions.TonePreset != tt.wantTone {
+				t.Errorf("generator presets = %q/%q, want %q/%q",
+					notesGen.lastOptions.AudiencePreset, notesGen.lastOptions.TonePreset, tt.wantAudience, tt.wantTone)
+			}
+			if output.Notes.AudiencePreset != tt.wantAudience || output.Notes.TonePreset != tt.wantTone {
+				t.Errorf("recorded presets = %q/%q, want %q/%q",
+					output.Notes.AudiencePreset, output.Notes.TonePreset, tt.wantAudience, tt.wantTone)
+			}
+		})
+	}
+}
+
+func TestGenerateNotesUseCase_Execute_Regenerate(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	_ = run.UpdateNotes(&domain.ReleaseNotes{
+		Text:           "## Old Notes",
+		AudiencePreset: "users",
+		TonePreset:     "friendly",
+	}, "test")
+	run.ClearDomainEvents()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	notesGen := &mockNotesGenerator{notes: "## New Notes", provider: "mock"}
+	uc := NewGenerateNotesUseCase(repo, inspector, notesGen, nil)
+
+	output, err := uc.Execute(ctx, GenerateNotesInput{
+		RepoRoot:   "/path/to/repo",
+		Options:    ports.NotesOptions{TonePreset: "technical"},
+		Actor:      ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+		Regenerate: true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !output.Regenerated {
+		t.Error("Execute() Regenerated = false, want true")
+	}
+
+	// Audience is kept from the old notes; tone is overridden
+	if notesGen.lastOptions.AudiencePreset != "users" {
+		t.Errorf("AudiencePreset = %q, want %q", notesGen.lastOptions.AudiencePreset, "users")
+	}
+	if notesGen.lastOptions.TonePreset != "technical" {
+		t.Errorf("TonePreset = %q, want %q", notesGen.lastOptions.TonePreset, "technical")
+	}
+
+	savedRun := repo.runs[run.ID()]
+	if savedRun.State() != domain.StateNotesReady {
+		t.Errorf("Run state = %v, want %v", savedRun.State(), domain.StateNotesReady)
+	}
+	if savedRun.Notes().Text != "## New Notes" {
+		t.Errorf("Notes().Text = %q, want %q", savedRun.Notes().Text, "## New Notes")
+	}
+
+	var updated bool
+	for _, event := range savedRun.DomainEvents() {
+		if _, ok := event.(*domain.RunNotesUpdatedEvent); ok {
+			updated = true
+		}
+	}
+	if !updated {
+		t.Error("Execute() did not record RunNotesUpdatedEvent")
+	}
+}
+
+func TestGenerateNotesUseCase_Execute_RegenerateWrongState(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	notesGen := &mockNotesGenerator{notes: "## New Notes"}
+	uc := NewGenerateNotesUseCase(repo, inspector, notesGen, nil)
+
+	_, err := uc.Execute(ctx, GenerateNotesInput{
+		RepoRoot:   "/path/to/repo",
+		Actor:      ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+		Regenerate: true,
+	})
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Execute() error = %v, want ErrInvalidState", err)
+	}
+	if run.Notes().Text != "## Release Notes" {
+		t.Errorf("Notes().Text = %q, want notes unchanged", run.Notes().Text)
+	}
+}
+
+func TestGenerateNotesUseCase_Execute_ImportText(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := domain.NewReleaseRun(
+		"repo", "/path/to/repo", "v1.0.0",
+		domain.CommitSHA("abc123def456"), nil, "", "",
+	)
+	_ = run.SetVersionProposal(version.MustParse("1.0.0"), version.MustParse("1.1.0"), domain.BumpMinor, 0.95)
+	_ = run.Plan("test")
+	_ = run.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = run.Bump("test")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	// The generator must not be called for imported notes.
+	notesGen := &mockNotesGenerator{err: errors.New("generator called")}
+	uc := NewGenerateNotesUseCase(repo, inspector, notesGen, nil)
+
+	output, err := uc.Execute(ctx, GenerateNotesInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+		Text:     "## Hand-written Notes",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Regenerated {
+		t.Error("Execute() Regenerated = true, want false")
+	}
+	if output.Notes.Provider != NotesProviderImported {
+		t.Errorf("Notes.Provider = %q, want %q", output.Notes.Provider, NotesProviderImported)
+	}
+
+	savedRun := repo.runs[run.ID()]
+	if savedRun.State() != domain.StateNotesReady {
+		t.Errorf("Run state = %v, want %v", savedRun.State(), domain.StateNotesReady)
+	}
+	if savedRun.Notes().Text != "## Hand-written Notes" {
+		t.Errorf("Notes().Text = %q, want %q", savedRun.Notes().Text, "## Hand-written Notes")
+	}
+}
+
+func TestGenerateNotesUseCase_Execute_ImportTextReplacesNotes(t *testing.T) {
+	tests := []struct {
+		name   string
+		reject bool
+	}{
+		{name: "notes ready"},
+		{name: "changes requested", reject: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+			run := createNotesReadyRun()
+			if tt.reject {
+				if err := run.Reject("needs work", "reviewer"); err != nil {
+					t.Fatalf("Reject() error = %v", err)
+				}
+			}
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			uc := NewGenerateNotesUseCase(repo, newMockRepoInspector(), &mockNotesGenerator{err: errors.New("generator called")}, nil)
+
+			output, err := uc.Execute(context.Background(), GenerateNotesInput{
+				RepoRoot: "/path/to/repo",
+				Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+				Text:     "## Edited Notes",
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !output.Regenerated {
+				t.Error("Execute() Regenerated = false, want true")
+			}
+			if run.State() != domain.StateNotesReady {
+				t.Errorf("Run state = %v, want %v", run.State(), domain.StateNotesReady)
+			}
+			if run.Notes().Text != "## Edited Notes" {
+				t.Errorf("Notes().Text = %q, want %q", run.Notes().Text, "## Edited Notes")
+			}
+		})
+	}
+}
+
+func TestGenerateNotesUseCase_Execute_ImportTextWrongState(t *testing.T) {
+	repo := newMockRepository()
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewGenerateNotesUseCase(repo, newMockRepoInspector(), &mockNotesGenerator{}, nil)
+
+	_, err := uc.Execute(context.Background(), GenerateNotesInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "test-actor"},
+		Text:     "## Late Notes",
+	})
+	if !errors.Is(err, domain.ErrInvalidState) {
+		t.Errorf("Execute() error = %v, want ErrInvalidState", err)
+	}
+}
+
 func TestGenerateNotesUseCase_Execute_GeneratorError(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1022,14 +1448,12 @@ func TestApproveReleaseUseCase_Execute(t *testing.T) {
 	}
 }
 
-func TestApproveReleaseUseCase_Execute_AlreadyApproved(t *testing.T) {
+func TestApproveReleaseUseCase_Execute_PackageTags(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
 	inspector := newMockRepoInspector()
 
-	// Create an already approved run
 	run := createNotesReadyRun()
-	_ = run.Approve("previous-approver", false)
 	repo.runs[run.ID()] = run
 	repo.latestRuns["/path/to/repo"] = run.ID()
 
@@ -1037,35 +1461,361 @@ func TestApproveReleaseUseCase_Execute_AlreadyApproved(t *testing.T) {
 
 	input := ApproveReleaseInput{
 		RepoRoot: "/path/to/repo",
-		Actor: ports.ActorInfo{
-			Type: domain.ActorHuman,
-			ID:   "approver@example.com",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "approver@example.com"},
+		PackageTags: []domain.PackageTag{
+			{Package: "packages/core", TagName: "core-v1.1.0"},
+			{Package: "packages/ui", TagName: "ui-v1.1.0"},
 		},
 	}
 
-	_, err := uc.Execute(ctx, input)
-	if err == nil {
-		t.Error("Execute() expected error when already approved")
+	if _, err := uc.Execute(ctx, input); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	steps := repo.runs[run.ID()].Steps()
+	if len(steps) < 3 {
+		t.Fatalf("expected at least 3 steps, got %d", len(steps))
+	}
+	if steps[0].Name != "create-tag" || steps[0].Type != domain.StepTypeTag || steps[0].Package != "" {
+		t.Errorf("steps[0] = %+v, want the release tag step", steps[0])
+	}
+	for i, want := range input.PackageTags {
+		step := steps[i+1]
+		if step.Name != "create-tag:"+want.Package || step.Type != domain.StepTypeTag || step.Package != want.Package || step.TagName != want.TagName {
+			t.Errorf("steps[%d] = %+v, want tag step for %s", i+1, step, want.Package)
+		}
 	}
 }
 
-func TestGetStatusUseCase_Execute(t *testing.T) {
+// mockStepContributor contributes fixed plugin steps.
+type mockStepContributor struct {
+	steps []domain.PluginStep
+	err   error
+}
+
+func (m *mockStepContributor) ContributedSteps(context.Context) ([]domain.PluginStep, error) {
+	return m.steps, m.err
+}
+
+func TestApproveReleaseUseCase_Execute_PluginSteps(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
 	inspector := newMockRepoInspector()
 
-	// Create a run
-	run := domain.NewReleaseRun(
-		"repo", "/path/to/repo", "v1.0.0",
-		domain.CommitSHA("abc123def456"), nil, "", "",
-	)
-	_ = run.Plan("test")
+	run := createNotesReadyRun()
 	repo.runs[run.ID()] = run
 	repo.latestRuns["/path/to/repo"] = run.ID()
 
-	uc := NewGetStatusUseCase(repo, inspector)
+	uc := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+	uc.SetStepContributor(&mockStepContributor{steps: []domain.PluginStep{
+		{Plugin: "assets", Name: "upload"},
+		{Plugin: "assets", Name: "verify"},
+	}})
 
-	input := GetStatusInput{
+	_, err := uc.Execute(ctx, ApproveReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "approver@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	steps := repo.runs[run.ID()].Steps()
+	want := []string{"create-tag", "assets:upload", "assets:verify"}
+	if len(steps) != len(want) {
+		t.Fatalf("Steps len = %d, want %d", len(steps), len(want))
+	}
+	for i, name := range want {
+		if steps[i].Name != name {
+			t.Errorf("Steps[%d].Name = %s, want %s", i, steps[i].Name, name)
+		}
+	}
+	upload := steps[1]
+	if upload.Type != domain.StepTypePlugin || upload.PluginName != "assets" || upload.Step != "upload" {
+		t.Errorf("plugin step = %+v, want plugin step upload of assets", upload)
+	}
+	if upload.IdempotencyKey == "" {
+		t.Error("plugin step IdempotencyKey is empty")
+	}
+	if status := repo.runs[run.ID()].StepStatus("assets:upload"); status == nil || status.State != domain.StepPending {
+		t.Errorf("plugin step status = %+v, want pending", status)
+	}
+}
+
+func TestApproveReleaseUseCase_PlannedSteps(t *testing.T) {
+	run := createNotesReadyRun()
+	uc := NewApproveReleaseUseCase(newMockRepository(), newMockRepoInspector(), nil, nil)
+	uc.SetStepContributor(&mockStepContributor{steps: []domain.PluginStep{{Plugin: "assets", Name: "upload"}}})
+
+	steps, err := uc.PlannedSteps(context.Background(), run, []domain.PackageTag{
+		{Package: "packages/core", TagName: "core-v1.1.0"},
+		{Package: "packages/web", TagName: "web-v2.0.0"},
+	})
+	if err != nil {
+		t.Fatalf("PlannedSteps() error = %v", err)
+	}
+
+	want := []domain.StepType{domain.StepTypeTag, domain.StepTypeTag, domain.StepTypeTag, domain.StepTypePlugin}
+	if len(steps) != len(want) {
+		t.Fatalf("Steps len = %d, want %d: %+v", len(steps), len(want), steps)
+	}
+	for i, typ := range want {
+		if steps[i].Type != typ {
+			t.Errorf("Steps[%d].Type = %s, want %s", i, steps[i].Type, typ)
+		}
+	}
+	if steps[0].Name != "create-tag" || steps[1].TagName != "core-v1.1.0" || steps[3].Name != "assets:upload" {
+		t.Errorf("unexpected steps: %+v", steps)
+	}
+	if len(run.Steps()) != 0 {
+		t.Errorf("PlannedSteps modified the run: %+v", run.Steps())
+	}
+}
+
+func TestApproveReleaseUseCase_Execute_PluginStepsError(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+	uc.SetStepContributor(&mockStepContributor{err: errors.New("plugin unavailable")})
+
+	_, err := uc.Execute(ctx, ApproveReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "approver@example.com"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to plan plugin steps") {
+		t.Fatalf("Execute() error = %v, want plugin step planning error", err)
+	}
+	if repo.runs[run.ID()].State() == domain.StateApproved {
+		t.Error("run approved despite plugin step planning failure")
+	}
+}
+
+func TestApproveReleaseUseCase_Execute_AlreadyApproved(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	// Create an already approved run
+	run := createNotesReadyRun()
+	_ = run.Approve("previous-approver", false)
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+
+	input := ApproveReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor: ports.ActorInfo{
+			Type: domain.ActorHuman,
+			ID:   "approver@example.com",
+		},
+	}
+
+	_, err := uc.Execute(ctx, input)
+	if err == nil {
+		t.Error("Execute() expected error when already approved")
+	}
+}
+
+func TestRejectReleaseUseCase_RegenerateAndReapprove(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	reject := NewRejectReleaseUseCase(repo, &mockLockManager{})
+	output, err := reject.Execute(ctx, RejectReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "reviewer@example.com"},
+		Reason:   "Highlight the deprecated flags",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.RejectedBy != "reviewer@example.com" || output.Reason != "Highlight the deprecated flags" {
+		t.Errorf("Execute() output = %+v", output)
+	}
+	if state := repo.runs[run.ID()].State(); state != domain.StateChangesRequested {
+		t.Fatalf("Run state = %v, want %v", state, domain.StateChangesRequested)
+	}
+
+	status, err := NewGetStatusUseCase(repo, inspector).Execute(ctx, GetStatusInput{RepoRoot: "/path/to/repo"})
+	if err != nil {
+		t.Fatalf("GetStatus error = %v", err)
+	}
+	if status.CanApprove || status.Rejection == nil || status.Rejection.Reason != "Highlight the deprecated flags" {
+		t.Errorf("status = CanApprove %v, Rejection %+v", status.CanApprove, status.Rejection)
+	}
+	if status.NextCommand != "relicta notes --regenerate" {
+		t.Errorf("NextCommand = %q, want relicta notes --regenerate", status.NextCommand)
+	}
+
+	notes := NewGenerateNotesUseCase(repo, inspector, &mockNotesGenerator{notes: "## Revised Notes"}, nil)
+	if _, err := notes.Execute(ctx, GenerateNotesInput{
+		RepoRoot:   "/path/to/repo",
+		Actor:      ports.ActorInfo{Type: domain.ActorHuman, ID: "author"},
+		Regenerate: true,
+	}); err != nil {
+		t.Fatalf("regenerate notes error = %v", err)
+	}
+	if state := repo.runs[run.ID()].State(); state != domain.StateNotesReady {
+		t.Fatalf("Run state after revision = %v, want %v", state, domain.StateNotesReady)
+	}
+
+	approve := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+	if _, err := approve.Execute(ctx, ApproveReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "reviewer@example.com"},
+	}); err != nil {
+		t.Fatalf("approve error = %v", err)
+	}
+	if state := repo.runs[run.ID()].State(); state != domain.StateApproved {
+		t.Errorf("Run state = %v, want %v", state, domain.StateApproved)
+	}
+}
+
+func TestRejectReleaseUseCase_RequiresReason(t *testing.T) {
+	repo := newMockRepository()
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	_, err := NewRejectReleaseUseCase(repo, nil).Execute(context.Background(), RejectReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "reviewer"},
+	})
+	if !errors.Is(err, domain.ErrRejectionReasonRequired) {
+		t.Errorf("Execute() error = %v, want ErrRejectionReasonRequired", err)
+	}
+	if run.State() != domain.StateNotesReady {
+		t.Errorf("Run state = %v, want %v", run.State(), domain.StateNotesReady)
+	}
+}
+
+func TestApproveReleaseUseCase_ApproveLevel(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+	policy := domain.HighRiskApprovalPolicy()
+	approve := func(level domain.ApprovalLevel, actor string) (*ApproveLevelOutput, error) {
+		return uc.ApproveLevel(ctx, ApproveLevelInput{
+			RepoRoot:      "/path/to/repo",
+			Actor:         ports.ActorInfo{Type: domain.ActorHuman, ID: actor},
+			Level:         level,
+			Justification: "reviewed",
+			Policy:        &policy,
+		})
+	}
+
+	// Sequential policies reject out-of-order approvals.
+	_, err := approve(domain.ApprovalLevelSecurity, "security-team")
+	if err == nil || !strings.Contains(err.Error(), "expecting technical approval, got security") {
+		t.Fatalf("ApproveLevel() error = %v, want sequential approval error", err)
+	}
+	_, err = approve(domain.ApprovalLevelManager, "manager")
+	if err == nil || !strings.Contains(err.Error(), "not part of the approval policy") {
+		t.Fatalf("ApproveLevel() error = %v, want policy error", err)
+	}
+
+	output, err := approve(domain.ApprovalLevelTechnical, "tech-lead")
+	if err != nil {
+		t.Fatalf("ApproveLevel() error = %v", err)
+	}
+	if output.FullyApproved || len(output.Pending) != 2 || output.NextLevel != domain.ApprovalLevelSecurity {
+		t.Errorf("after technical: %+v", output)
+	}
+	if got := repo.runs[run.ID()].State(); got != domain.StateNotesReady {
+		t.Errorf("Run state = %v, want %v", got, domain.StateNotesReady)
+	}
+
+	// An approver grants at most one level of a run.
+	if _, err := approve(domain.ApprovalLevelSecurity, "tech-lead"); !errors.Is(err, domain.ErrApproverAlreadyApproved) {
+		t.Fatalf("ApproveLevel() error = %v, want ErrApproverAlreadyApproved", err)
+	}
+
+	if _, err := approve(domain.ApprovalLevelSecurity, "security-team"); err != nil {
+		t.Fatalf("ApproveLevel() error = %v", err)
+	}
+	output, err = approve(domain.ApprovalLevelRelease, "release-manager")
+	if err != nil {
+		t.Fatalf("ApproveLevel() error = %v", err)
+	}
+	if !output.FullyApproved || len(output.Pending) != 0 || len(output.Granted) != 3 {
+		t.Errorf("after release: %+v", output)
+	}
+
+	saved := repo.runs[run.ID()]
+	if saved.State() != domain.StateApproved {
+		t.Errorf("Run state = %v, want %v", saved.State(), domain.StateApproved)
+	}
+	if saved.Approval() == nil || saved.Approval().ApprovedBy != "release-manager" {
+		t.Errorf("Approval = %+v, want release-manager", saved.Approval())
+	}
+	hasTagStep := false
+	for _, step := range saved.Steps() {
+		hasTagStep = hasTagStep || step.Type == domain.StepTypeTag
+	}
+	if !hasTagStep {
+		t.Error("expected a tag step once fully approved")
+	}
+}
+
+func TestApproveReleaseUseCase_ApproveLevel_AllowedBy(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewApproveReleaseUseCase(repo, newMockRepoInspector(), nil, nil)
+	policy := domain.ApprovalPolicy{Requirements: []domain.ApprovalRequirement{
+		{Level: domain.ApprovalLevelRelease, Required: true, AllowedBy: []string{"release-manager"}},
+	}}
+
+	_, err := uc.ApproveLevel(ctx, ApproveLevelInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorAgent, ID: "mcp-agent"},
+		Level:    domain.ApprovalLevelRelease,
+		Policy:   &policy,
+	})
+	if err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Fatalf("ApproveLevel() error = %v, want not allowed", err)
+	}
+}
+
+func TestGetStatusUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	// Create a run
+	run := domain.NewReleaseRun(
+		"repo", "/path/to/repo", "v1.0.0",
+		domain.CommitSHA("abc123def456"), nil, "", "",
+	)
+	_ = run.Plan("test")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewGetStatusUseCase(repo, inspector)
+
+	input := GetStatusInput{
 		RepoRoot: "/path/to/repo",
 	}
 
@@ -1085,6 +1835,10 @@ func TestGetStatusUseCase_Execute(t *testing.T) {
 	if output.NextAction != "bump" {
 		t.Errorf("Execute() NextAction = %v, want bump", output.NextAction)
 	}
+
+	if output.NextStep != "bump version" || output.NextCommand != "relicta bump" {
+		t.Errorf("Execute() NextStep = %v (%v), want bump version (relicta bump)", output.NextStep, output.NextCommand)
+	}
 }
 
 func TestGetStatusUseCase_Execute_NoRun(t *testing.T) {
@@ -1106,24 +1860,27 @@ func TestGetStatusUseCase_Execute_NoRun(t *testing.T) {
 
 func TestDetermineNextAction(t *testing.T) {
 	tests := []struct {
-		state  domain.RunState
-		action string
+		state   domain.RunState
+		expired bool
+		action  string
 	}{
-		{domain.StateDraft, "plan"},
-		{domain.StatePlanned, "bump"},
-		{domain.StateVersioned, "notes"},
-		{domain.StateNotesReady, "approve"},
-		{domain.StateApproved, "publish"},
-		{domain.StatePublishing, "wait"},
-		{domain.StatePublished, "done"},
-		{domain.StateFailed, "retry or cancel"},
-		{domain.StateCanceled, "plan"},
+		{domain.StateDraft, false, "plan"},
+		{domain.StatePlanned, false, "bump"},
+		{domain.StateVersioned, false, "notes"},
+		{domain.StateNotesReady, false, "approve"},
+		{domain.StateChangesRequested, false, "notes"},
+		{domain.StateApproved, false, "publish"},
+		{domain.StateApproved, true, "approve"},
+		{domain.StatePublishing, false, "wait"},
+		{domain.StatePublished, false, "done"},
+		{domain.StateFailed, false, "retry or cancel"},
+		{domain.StateCanceled, false, "plan"},
 	}
 
 	for _, tt := range tests {
-		got := determineNextAction(tt.state)
+		got := determineNextAction(tt.state, tt.expired)
 		if got != tt.action {
-			t.Errorf("determineNextAction(%v) = %v, want %v", tt.state, got, tt.action)
+			t.Errorf("determineNextAction(%v, %v) = %v, want %v", tt.state, tt.expired, got, tt.action)
 		}
 	}
 }
@@ -1134,6 +1891,9 @@ type mockPublisher struct {
 	checkIdempotency map[string]bool
 	executeErr       error
 	checkErr         error
+	removedTags      []string
+	prePublishErr    error
+	prePublishCalls  int
 }
 
 func newMockPublisher() *mockPublisher {
@@ -1156,6 +1916,16 @@ func (m *mockPublisher) ExecuteStep(_ context.Context, _ *domain.ReleaseRun, ste
 	}, nil
 }
 
+func (m *mockPublisher) RemoveTag(_ context.Context, run *domain.ReleaseRun, _ *domain.StepPlan) (string, error) {
+	m.removedTags = append(m.removedTags, run.TagName())
+	return run.TagName(), nil
+}
+
+func (m *mockPublisher) ValidatePrePublish(_ context.Context, _ *domain.ReleaseRun, _ bool) error {
+	m.prePublishCalls++
+	return m.prePublishErr
+}
+
 func (m *mockPublisher) CheckIdempotency(_ context.Context, _ *domain.ReleaseRun, step *domain.StepPlan) (bool, error) {
 	if m.checkErr != nil {
 		return false, m.checkErr
@@ -1271,6 +2041,46 @@ func TestPublishReleaseUseCase_Execute_WrongState(t *testing.T) {
 	}
 }
 
+func TestPublishReleaseUseCase_Execute_ApprovalExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+
+	run := createNotesReadyRun()
+	run.SetApprovalTTL(time.Nanosecond)
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan([]domain.StepPlan{{Name: "tag", Type: domain.StepTypeTag}})
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, inspector, nil, nil, nil)
+
+	time.Sleep(time.Millisecond)
+	_, err := uc.Execute(ctx, PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+	})
+	if !errors.Is(err, domain.ErrApprovalExpired) {
+		t.Fatalf("Execute() error = %v, want ErrApprovalExpired", err)
+	}
+	if run.State() != domain.StateApproved {
+		t.Errorf("State() = %v, want approved", run.State())
+	}
+
+	// The expired approval can be renewed
+	approveUC := NewApproveReleaseUseCase(repo, inspector, nil, nil)
+	if _, err := approveUC.Execute(ctx, ApproveReleaseInput{
+		RepoRoot:    "/path/to/repo",
+		Actor:       ports.ActorInfo{Type: domain.ActorHuman, ID: "approver-2"},
+		ApprovalTTL: time.Nanosecond,
+	}); err != nil {
+		t.Fatalf("re-approve error = %v", err)
+	}
+	if got := run.Approval().ApprovedBy; got != "approver-2" {
+		t.Errorf("ApprovedBy = %q, want approver-2", got)
+	}
+}
+
 func TestPublishReleaseUseCase_Execute_HeadMismatch(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1335,6 +2145,201 @@ func TestPublishReleaseUseCase_Execute_ForceBypassesHeadCheck(t *testing.T) {
 	}
 }
 
+func TestPublishReleaseUseCase_Execute_OnPluginFailure(t *testing.T) {
+	tests := []struct {
+		mode          PluginFailureMode
+		wantErr       bool
+		wantPublished bool
+		wantState     domain.RunState
+		wantRemoved   []string
+	}{
+		{mode: "", wantErr: true, wantState: domain.StatePublishing},
+		{mode: PluginFailureFail, wantErr: true, wantState: domain.StatePublishing},
+		{mode: PluginFailureWarn, wantPublished: true, wantState: domain.StatePublished},
+		{mode: PluginFailureRollback, wantErr: true, wantState: domain.StateFailed, wantRemoved: []string{"v1.1.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			ctx := context.Background()
+			repo := newMockRepository()
+			inspector := newMockRepoInspector()
+			publisher := newMockPublisher()
+			publisher.stepResults["notify"] = &ports.StepResult{
+				Success: false,
+				Error:   errors.New("slack unavailable"),
+			}
+
+			run := createNotesReadyRun()
+			_ = run.Approve("approver", false)
+			run.SetExecutionPlan([]domain.StepPlan{
+				{Name: "tag", Type: domain.StepTypeTag},
+				{Name: "notify", Type: domain.StepTypeNotify},
+				{Name: "finalize", Type: domain.StepTypeFinalize},
+			})
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			uc := NewPublishReleaseUseCase(repo, inspector, nil, publisher, nil)
+			output, err := uc.Execute(ctx, PublishReleaseInput{
+				RepoRoot:        "/path/to/repo",
+				Actor:           ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+				OnPluginFailure: tt.mode,
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output == nil {
+				t.Fatal("Execute() output = nil")
+			}
+			if output.Published != tt.wantPublished {
+				t.Errorf("Published = %v, want %v", output.Published, tt.wantPublished)
+			}
+			if run.State() != tt.wantState {
+				t.Errorf("State() = %v, want %v", run.State(), tt.wantState)
+			}
+			if len(output.PluginFailures) != 1 || output.PluginFailures[0].StepName != "notify" {
+				t.Errorf("PluginFailures = %+v, want notify", output.PluginFailures)
+			}
+			if tt.mode != "" && output.OnPluginFailure != tt.mode {
+				t.Errorf("OnPluginFailure = %q, want %q", output.OnPluginFailure, tt.mode)
+			}
+			if !reflect.DeepEqual(publisher.removedTags, tt.wantRemoved) || !reflect.DeepEqual(output.RolledBackTags, tt.wantRemoved) {
+				t.Errorf("removed tags = %v / %v, want %v", publisher.removedTags, output.RolledBackTags, tt.wantRemoved)
+			}
+			if tt.mode == PluginFailureRollback && run.StepStatus("tag").State != domain.StepPending {
+				t.Errorf("tag step state = %v, want pending after rollback", run.StepStatus("tag").State)
+			}
+		})
+	}
+}
+
+func TestPublishReleaseUseCase_Execute_Only(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	publisher := newMockPublisher()
+	publisher.stepResults["npm:publish"] = &ports.StepResult{
+		Success: false,
+		Error:   errors.New("registry unavailable"),
+	}
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan(append([]domain.StepPlan{{Name: "tag", Type: domain.StepTypeTag}},
+		domain.NewPluginSteps(run.ID(), []domain.PluginStep{
+			{Plugin: "github", Name: "release"},
+			{Plugin: "npm", Name: "publish"},
+		})...))
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, inspector, nil, publisher, nil)
+	input := PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+	}
+
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Fatal("Execute() expected npm step failure")
+	}
+
+	// Re-run only npm once the registry is back
+	delete(publisher.stepResults, "npm:publish")
+	input.Only = []string{"npm", "github"}
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !output.Published {
+		t.Error("Execute() Published = false, want true")
+	}
+
+	want := []StepResult{
+		{StepName: "github:release", Success: true, Skipped: true, Output: "Skipped: already completed"},
+		{StepName: "npm:publish", Success: true, Output: "step completed"},
+	}
+	if !reflect.DeepEqual(output.StepResults, want) {
+		t.Errorf("StepResults = %+v, want %+v", output.StepResults, want)
+	}
+	if run.State() != domain.StatePublished {
+		t.Errorf("State() = %v, want %v", run.State(), domain.StatePublished)
+	}
+}
+
+func TestPublishReleaseUseCase_Execute_OnlyRetriesFailedRun(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	publisher := newMockPublisher()
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan([]domain.StepPlan{
+		{Name: "tag", Type: domain.StepTypeTag},
+		{Name: "notify", Type: domain.StepTypeNotify},
+	})
+	_ = run.StartPublishing("publisher")
+	_ = run.MarkStepDone("tag", "created")
+	_ = run.MarkStepFailed("notify", errors.New("slack unavailable"))
+	_ = run.MarkFailed("step notify failed", "publisher")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, newMockRepoInspector(), nil, publisher, nil)
+	output, err := uc.Execute(ctx, PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+		Only:     []string{"notify"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !output.Published || run.State() != domain.StatePublished {
+		t.Errorf("Published = %v, State() = %v, want published", output.Published, run.State())
+	}
+}
+
+func TestPublishReleaseUseCase_Execute_OnlyErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		only    []string
+		wantErr string
+	}{
+		{name: "unknown plugin", only: []string{"pypi"}, wantErr: "pypi"},
+		{name: "tag not created", only: []string{"notify"}, wantErr: "tag step tag has not completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+			publisher := newMockPublisher()
+
+			run := createNotesReadyRun()
+			_ = run.Approve("approver", false)
+			run.SetExecutionPlan([]domain.StepPlan{
+				{Name: "tag", Type: domain.StepTypeTag},
+				{Name: "notify", Type: domain.StepTypeNotify},
+			})
+			repo.runs[run.ID()] = run
+			repo.latestRuns["/path/to/repo"] = run.ID()
+
+			uc := NewPublishReleaseUseCase(repo, newMockRepoInspector(), nil, publisher, nil)
+			_, err := uc.Execute(context.Background(), PublishReleaseInput{
+				RepoRoot: "/path/to/repo",
+				Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+				Only:     tt.only,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+			if run.State() != domain.StateApproved {
+				t.Errorf("State() = %v, want %v", run.State(), domain.StateApproved)
+			}
+		})
+	}
+}
+
 func TestPublishReleaseUseCase_Execute_StepFailure(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1380,6 +2385,84 @@ func TestPublishReleaseUseCase_Execute_StepFailure(t *testing.T) {
 	}
 }
 
+func TestPublishReleaseUseCase_Execute_PrePublishRejected(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	publisher := newMockPublisher()
+	publisher.prePublishErr = errors.New("npm: version 1.1.0 is already published")
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan([]domain.StepPlan{
+		{Name: "tag", Type: domain.StepTypeTag},
+		{Name: "notify", Type: domain.StepTypeNotify},
+	})
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, inspector, nil, publisher, nil)
+
+	output, err := uc.Execute(ctx, PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+	})
+	if !errors.Is(err, domain.ErrPrePublishRejected) {
+		t.Fatalf("Execute() error = %v, want ErrPrePublishRejected", err)
+	}
+	if !strings.Contains(err.Error(), "already published") {
+		t.Errorf("Execute() error = %v, want plugin message", err)
+	}
+	if output != nil {
+		t.Errorf("Execute() output = %+v, want nil", output)
+	}
+
+	// No step ran and the run stays approved for another attempt
+	savedRun := repo.runs[run.ID()]
+	if savedRun.State() != domain.StateApproved {
+		t.Errorf("Run state = %v, want %v", savedRun.State(), domain.StateApproved)
+	}
+	if status := savedRun.StepStatus("tag"); status != nil && status.State != domain.StepPending {
+		t.Errorf("tag step state = %v, want pending", status.State)
+	}
+}
+
+func TestPublishReleaseUseCase_Execute_PrePublishSkippedAfterTag(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	publisher := newMockPublisher()
+	publisher.prePublishErr = errors.New("registry unreachable")
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan([]domain.StepPlan{
+		{Name: "tag", Type: domain.StepTypeTag},
+		{Name: "notify", Type: domain.StepTypeNotify},
+	})
+	_ = run.StartPublishing("publisher@example.com")
+	_ = run.MarkStepStarted("tag")
+	_ = run.MarkStepDone("tag", "Created tag")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, inspector, nil, publisher, nil)
+
+	output, err := uc.Execute(ctx, PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !output.Published {
+		t.Error("Execute() Published = false, want true")
+	}
+	if publisher.prePublishCalls != 0 {
+		t.Errorf("ValidatePrePublish called %d times after tag was created, want 0", publisher.prePublishCalls)
+	}
+}
+
 func TestPublishReleaseUseCase_Execute_DryRun(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1628,6 +2711,52 @@ func TestPublishReleaseUseCase_Execute_ExecuteStepError(t *testing.T) {
 	}
 }
 
+func TestPublishReleaseUseCase_Execute_PluginStepResume(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	publisher := newMockPublisher()
+	publisher.stepResults["assets:upload"] = &ports.StepResult{Success: false, Error: errors.New("upload timed out")}
+
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan(append([]domain.StepPlan{{Name: "create-tag", Type: domain.StepTypeTag}},
+		domain.NewPluginSteps(run.ID(), []domain.PluginStep{{Plugin: "assets", Name: "upload"}})...))
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewPublishReleaseUseCase(repo, inspector, nil, publisher, nil)
+	input := PublishReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "publisher@example.com"},
+	}
+
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Fatal("Execute() expected error when plugin step fails")
+	}
+	saved := repo.runs[run.ID()]
+	if status := saved.StepStatus("assets:upload"); status.State != domain.StepFailed || status.Attempts != 1 {
+		t.Fatalf("plugin step status = %+v, want failed after 1 attempt", status)
+	}
+
+	// Resume: the tag step is done, only the plugin step runs again
+	delete(publisher.stepResults, "assets:upload")
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Execute() resume error = %v", err)
+	}
+	if len(output.StepResults) != 1 || output.StepResults[0].StepName != "assets:upload" {
+		t.Fatalf("StepResults = %+v, want only assets:upload", output.StepResults)
+	}
+	if !output.Published {
+		t.Error("Execute() Published = false, want true")
+	}
+	status := repo.runs[run.ID()].StepStatus("assets:upload")
+	if status.State != domain.StepDone || status.Attempts != 2 {
+		t.Errorf("plugin step status = %+v, want done after 2 attempts", status)
+	}
+}
+
 // Tests for RetryPublishUseCase
 
 func TestRetryPublishUseCase_Execute(t *testing.T) {
@@ -1670,6 +2799,47 @@ func TestRetryPublishUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestRetryPublishUseCase_Execute_PluginStep(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	inspector := newMockRepoInspector()
+	publisher := newMockPublisher()
+
+	// The tag was created before the contributed step failed
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan(append([]domain.StepPlan{{Name: "create-tag", Type: domain.StepTypeTag}},
+		domain.NewPluginSteps(run.ID(), []domain.PluginStep{{Plugin: "assets", Name: "upload"}})...))
+	_ = run.StartPublishing("test")
+	_ = run.MarkStepStarted("create-tag")
+	_ = run.MarkStepDone("create-tag", "tagged")
+	_ = run.MarkStepStarted("assets:upload")
+	_ = run.MarkStepFailed("assets:upload", errors.New("upload failed"))
+	_ = run.MarkFailed("step failed", "test")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewRetryPublishUseCase(repo, inspector, nil, publisher, nil)
+	output, err := uc.Execute(ctx, RetryPublishInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "retry@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !output.Published {
+		t.Error("Execute() Published = false, want true")
+	}
+
+	saved := repo.runs[run.ID()]
+	if status := saved.StepStatus("create-tag"); status.Attempts != 1 {
+		t.Errorf("tag step attempts = %d, want 1 (not re-run)", status.Attempts)
+	}
+	if status := saved.StepStatus("assets:upload"); status.State != domain.StepDone || status.Attempts != 2 {
+		t.Errorf("plugin step status = %+v, want done after 2 attempts", status)
+	}
+}
+
 func TestRetryPublishUseCase_Execute_NotFailed(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1944,6 +3114,154 @@ func TestApproveReleaseUseCase_Execute_NoRun(t *testing.T) {
 	}
 }
 
+// createPublishedRun creates a published run whose tag step completed.
+func createPublishedRun() *domain.ReleaseRun {
+	run := createNotesReadyRun()
+	_ = run.Approve("approver", false)
+	run.SetExecutionPlan([]domain.StepPlan{
+		{Name: "tag", Type: domain.StepTypeTag},
+		{Name: "notify", Type: domain.StepTypeNotify},
+	})
+	_ = run.StartPublishing("test")
+	_ = run.MarkStepDone("tag", "created")
+	_ = run.MarkStepDone("notify", "sent")
+	_ = run.MarkPublished("test")
+	return run
+}
+
+func TestRollbackReleaseUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	publisher := newMockPublisher()
+	publisher.checkIdempotency["tag"] = true
+
+	run := createPublishedRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewRollbackReleaseUseCase(repo, &mockLockManager{}, publisher)
+
+	input := RollbackReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "rollback@example.com"},
+	}
+
+	_, err := uc.Execute(ctx, input)
+	if !errors.Is(err, ErrRollbackRequiresForce) {
+		t.Fatalf("Execute() error = %v, want ErrRollbackRequiresForce", err)
+	}
+	if run.State() != domain.StatePublished {
+		t.Fatalf("State() = %v, want published after refused rollback", run.State())
+	}
+
+	input.Force = true
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if output.PreviousState != domain.StatePublished || output.State != domain.StateFailed {
+		t.Errorf("states = %v -> %v, want published -> failed", output.PreviousState, output.State)
+	}
+	if !reflect.DeepEqual(output.RemovedTags, []string{"v1.1.0"}) {
+		t.Errorf("RemovedTags = %v, want [v1.1.0]", output.RemovedTags)
+	}
+	if len(output.RevertedSteps) != 1 || output.RevertedSteps[0].StepName != "tag" {
+		t.Errorf("RevertedSteps = %+v, want tag", output.RevertedSteps)
+	}
+	if run.StepStatus("tag").State != domain.StepPending {
+		t.Errorf("tag step state = %v, want pending", run.StepStatus("tag").State)
+	}
+	if run.StepStatus("notify").State != domain.StepDone {
+		t.Errorf("notify step state = %v, want done", run.StepStatus("notify").State)
+	}
+}
+
+func TestRollbackReleaseUseCase_Execute_Resume(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	publisher := newMockPublisher()
+
+	// A rollback interrupted after failing the run but before the tag was
+	// reverted; the tag is already gone from the repository.
+	run := createPublishedRun()
+	_ = run.Rollback("interrupted", "test")
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewRollbackReleaseUseCase(repo, nil, publisher)
+	output, err := uc.Execute(ctx, RollbackReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Actor:    ports.ActorInfo{Type: domain.ActorHuman, ID: "rollback@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(publisher.removedTags) != 0 {
+		t.Errorf("removed tags = %v, want none for an already removed tag", publisher.removedTags)
+	}
+	if len(output.RevertedSteps) != 1 || !output.RevertedSteps[0].Skipped {
+		t.Errorf("RevertedSteps = %+v, want one skipped step", output.RevertedSteps)
+	}
+	if run.StepStatus("tag").State != domain.StepPending {
+		t.Errorf("tag step state = %v, want pending", run.StepStatus("tag").State)
+	}
+
+	// Running again has nothing left to revert.
+	output, err = uc.Execute(ctx, RollbackReleaseInput{RepoRoot: "/path/to/repo"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.RevertedSteps) != 0 {
+		t.Errorf("RevertedSteps = %+v, want none", output.RevertedSteps)
+	}
+}
+
+func TestRollbackReleaseUseCase_Execute_DryRun(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	publisher := newMockPublisher()
+
+	run := createPublishedRun()
+	repo.runs[run.ID()] = run
+
+	uc := NewRollbackReleaseUseCase(repo, nil, publisher)
+	output, err := uc.Execute(ctx, RollbackReleaseInput{
+		RepoRoot: "/path/to/repo",
+		Version:  "1.1.0",
+		Force:    true,
+		DryRun:   true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if run.State() != domain.StatePublished || len(publisher.removedTags) != 0 {
+		t.Errorf("dry run changed the release: state %v, removed %v", run.State(), publisher.removedTags)
+	}
+	if len(output.RevertedSteps) != 1 || !strings.Contains(output.RevertedSteps[0].Output, "v1.1.0") {
+		t.Errorf("RevertedSteps = %+v, want tag v1.1.0", output.RevertedSteps)
+	}
+}
+
+func TestRollbackReleaseUseCase_Execute_InvalidState(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+
+	run := createNotesReadyRun()
+	repo.runs[run.ID()] = run
+	repo.latestRuns["/path/to/repo"] = run.ID()
+
+	uc := NewRollbackReleaseUseCase(repo, nil, newMockPublisher())
+	if _, err := uc.Execute(ctx, RollbackReleaseInput{RepoRoot: "/path/to/repo", Force: true}); err == nil {
+		t.Error("Execute() expected error for unpublished run")
+	}
+	if _, err := uc.Execute(ctx, RollbackReleaseInput{RepoRoot: "/path/to/repo", Version: "9.9.9"}); !errors.Is(err, domain.ErrRunNotFound) {
+		t.Errorf("Execute() error = %v, want ErrRunNotFound", err)
+	}
+}
+
 func TestPublishReleaseUseCase_Execute_NoRun(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockRepository()
@@ -1974,16 +3292,17 @@ func TestGetStatusUseCase_Execute_AllStates(t *testing.T) {
 		name           string
 		state          domain.RunState
 		expectedAction string
+		expectedStep   string
 	}{
-		{"draft", domain.StateDraft, "plan"},
-		{"planned", domain.StatePlanned, "bump"},
-		{"versioned", domain.StateVersioned, "notes"},
-		{"notes_ready", domain.StateNotesReady, "approve"},
-		{"approved", domain.StateApproved, "publish"},
-		{"publishing", domain.StatePublishing, "wait"},
-		{"published", domain.StatePublished, "done"},
-		{"failed", domain.StateFailed, "retry or cancel"},
-		{"canceled", domain.StateCanceled, "plan"},
+		{"draft", domain.StateDraft, "plan", "plan release"},
+		{"planned", domain.StatePlanned, "bump", "bump version"},
+		{"versioned", domain.StateVersioned, "notes", "generate notes"},
+		{"notes_ready", domain.StateNotesReady, "approve", "approve release"},
+		{"approved", domain.StateApproved, "publish", "publish release"},
+		{"publishing", domain.StatePublishing, "wait", "wait for publishing to complete"},
+		{"published", domain.StatePublished, "done", "plan next release"},
+		{"failed", domain.StateFailed, "retry or cancel", "reset failed release"},
+		{"canceled", domain.StateCanceled, "plan", "plan new release"},
 	}
 
 	for _, tc := range tests {
@@ -2004,6 +3323,9 @@ func TestGetStatusUseCase_Execute_AllStates(t *testing.T) {
 			if output.NextAction != tc.expectedAction {
 				t.Errorf("NextAction = %v, want %v", output.NextAction, tc.expectedAction)
 			}
+			if output.NextStep != tc.expectedStep {
+				t.Errorf("NextStep = %v, want %v", output.NextStep, tc.expectedStep)
+			}
 		})
 	}
 }
@@ -4,6 +4,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
@@ -14,8 +15,14 @@ type ApproveReleaseInput struct {
 	RepoRoot    string
 	RunID       domain.RunID // If empty, uses latest
 	Actor       ports.ActorInfo
-	AutoApprove bool // CI/--yes mode
-	Force       bool // Force approval even if HEAD changed
+	AutoApprove bool          // CI/--yes mode
+	Force       bool          // Force approval even if HEAD changed
+	ApprovalTTL time.Duration // Approval validity window; an expired approval may be renewed
+
+	// PackageTags lists per-package tags for monorepo releases in release
+	// order. When set, one tag step per package is planned in this order
+	// instead of a single release tag step.
+	PackageTags []domain.PackageTag
 }
 
 // ApproveReleaseOutput contains the output from approving a release.
@@ -33,6 +40,8 @@ type ApproveReleaseUseCase struct {
 	repoInspector ports.RepoInspector
 	lockManager   ports.LockManager
 	stateMachine  *domain.StateMachineService
+
+	stepContributor ports.StepContributor // Optional
 }
 
 // NewApproveReleaseUseCase creates a new ApproveReleaseUseCase.
@@ -50,6 +59,12 @@ func NewApproveReleaseUseCase(
 	}
 }
 
+// SetStepContributor sets the source of plugin-contributed publish steps,
+// which are planned after the tag steps on approval.
+func (uc *ApproveReleaseUseCase) SetStepContributor(contributor ports.StepContributor) {
+	uc.stepContributor = contributor
+}
+
 // Execute approves a release.
 func (uc *ApproveReleaseUseCase) Execute(ctx context.Context, input ApproveReleaseInput) (*ApproveReleaseOutput, error) {
 	// Load the run
@@ -58,6 +73,8 @@ func (uc *ApproveReleaseUseCase) Execute(ctx context.Context, input ApproveRelea
 		return nil, err
 	}
 
+	run.SetApprovalTTL(input.ApprovalTTL)
+
 	// Acquire lock
 	if uc.lockManager != nil {
 		release, err := uc.lockManager.Acquire(ctx, input.RepoRoot, run.ID())
@@ -87,7 +104,10 @@ func (uc *ApproveReleaseUseCase) Execute(ctx context.Context, input ApproveRelea
 
 	// Ensure the execution plan includes a tag step
 	// The tag step is the first step in publish, creating the version tag
-	uc.ensureTagStep(run)
+	uc.ensureTagStep(run, input.PackageTags)
+	if err := uc.ensurePluginSteps(ctx, run); err != nil {
+		return nil, err
+	}
 
 	// Approve the release
 	if err := run.Approve(input.Actor.ID, input.AutoApprove); err != nil {
@@ -121,29 +141,91 @@ func (uc *ApproveReleaseUseCase) loadRun(ctx context.Context, repoRoot string, r
 	return uc.repo.LoadLatest(ctx, repoRoot)
 }
 
+// PlannedSteps returns the execution plan that approving run would give
+// it, with its tag steps and the steps contributed by plugins. The run is
+// not modified.
+func (uc *ApproveReleaseUseCase) PlannedSteps(ctx context.Context, run *domain.ReleaseRun, packageTags []domain.PackageTag) ([]domain.StepPlan, error) {
+	steps, _ := withTagSteps(run.Steps(), packageTags)
+	steps, _, err := uc.withPluginSteps(ctx, run.ID(), steps)
+	return steps, err
+}
+
 // ensureTagStep ensures the execution plan includes a tag step.
 // The tag step creates and pushes the git tag during publish.
 // This is called during approve to ensure the plan is complete before publishing.
-func (uc *ApproveReleaseUseCase) ensureTagStep(run *domain.ReleaseRun) {
-	steps := run.Steps()
+// When packageTags is non-empty, per-package tag steps are planned in order
+// after the release tag step.
+func (uc *ApproveReleaseUseCase) ensureTagStep(run *domain.ReleaseRun, packageTags []domain.PackageTag) {
+	if steps, added := withTagSteps(run.Steps(), packageTags); added {
+		run.SetExecutionPlan(steps)
+	}
+}
 
+// withTagSteps returns steps with the tag steps planned first, and whether
+// they were added. Steps that already include a tag step are returned as is.
+func withTagSteps(steps []domain.StepPlan, packageTags []domain.PackageTag) ([]domain.StepPlan, bool) {
 	// Check if tag step already exists
 	for _, step := range steps {
 		if step.Type == domain.StepTypeTag {
-			return // Already has tag step
+			return steps, false // Already has tag step
 		}
 	}
 
 	// Add tag step as the first step in the execution plan
-	// The tag step should be first because it creates the version marker
-	tagStep := domain.StepPlan{
+	// The tag step should be first because it creates the version marker;
+	// per-package tags of a monorepo release follow it
+	tagSteps := []domain.StepPlan{{
 		Name: "create-tag",
 		Type: domain.StepTypeTag,
-	}
+	}}
+	tagSteps = append(tagSteps, domain.NewPackageTagSteps(packageTags)...)
 
-	newSteps := make([]domain.StepPlan, 0, len(steps)+1)
-	newSteps = append(newSteps, tagStep)
+	newSteps := make([]domain.StepPlan, 0, len(steps)+len(tagSteps))
+	newSteps = append(newSteps, tagSteps...)
 	newSteps = append(newSteps, steps...)
+	return newSteps, true
+}
 
-	run.SetExecutionPlan(newSteps)
+// ensurePluginSteps appends the steps contributed by plugins to the
+// execution plan, after the steps already planned. Steps that are already
+// in the plan are left untouched.
+func (uc *ApproveReleaseUseCase) ensurePluginSteps(ctx context.Context, run *domain.ReleaseRun) error {
+	steps, added, err := uc.withPluginSteps(ctx, run.ID(), run.Steps())
+	if err != nil {
+		return err
+	}
+	if added {
+		run.SetExecutionPlan(steps)
+	}
+	return nil
+}
+
+// withPluginSteps returns steps followed by the plugin-contributed steps
+// not already among them, and whether any were added.
+func (uc *ApproveReleaseUseCase) withPluginSteps(ctx context.Context, runID domain.RunID, steps []domain.StepPlan) ([]domain.StepPlan, bool, error) {
+	if uc.stepContributor == nil {
+		return steps, false, nil
+	}
+
+	contributed, err := uc.stepContributor.ContributedSteps(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to plan plugin steps: %w", err)
+	}
+
+	steps = append([]domain.StepPlan(nil), steps...)
+	planned := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		planned[step.Name] = true
+	}
+
+	added := false
+	for _, step := range domain.NewPluginSteps(runID, contributed) {
+		if planned[step.Name] {
+			continue
+		}
+		steps = append(steps, step)
+		planned[step.Name] = true
+		added = true
+	}
+	return steps, added, nil
 }
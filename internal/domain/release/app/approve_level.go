@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+)
+
+// ApproveLevelInput contains the input for granting one level of a
+// multi-level approval.
+type ApproveLevelInput struct {
+	RepoRoot      string
+	RunID         domain.RunID // If empty, uses latest
+	Actor         ports.ActorInfo
+	Level         domain.ApprovalLevel
+	Justification string
+	Force         bool          // Force approval even if HEAD changed
+	ApprovalTTL   time.Duration // Approval validity window, applied once fully approved
+
+	// Policy is the approval policy to use if the run has none yet. If nil,
+	// the default policy (release approval only) is used.
+	Policy *domain.ApprovalPolicy
+
+	// PackageTags lists per-package tags for monorepo releases, as in
+	// ApproveReleaseInput.
+	PackageTags []domain.PackageTag
+}
+
+// ApproveLevelOutput contains the output from granting an approval level.
+type ApproveLevelOutput struct {
+	RunID         domain.RunID
+	PlanHash      string
+	Level         domain.ApprovalLevel
+	Granted       []domain.ApprovalLevel
+	Pending       []domain.ApprovalRequirement
+	NextLevel     domain.ApprovalLevel // Next level for sequential policies, if any
+	FullyApproved bool                 // All required levels are granted and the run is approved
+	VersionNext   string
+}
+
+// ApproveLevel grants one level of a multi-level approval. Once all
+// required levels are granted, the multi-level approval is completed and
+// the run transitions to approved.
+func (uc *ApproveReleaseUseCase) ApproveLevel(ctx context.Context, input ApproveLevelInput) (*ApproveLevelOutput, error) {
+	run, err := uc.loadRun(ctx, input.RepoRoot, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Acquire lock
+	if uc.lockManager != nil {
+		release, err := uc.lockManager.Acquire(ctx, input.RepoRoot, run.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		defer release()
+	}
+
+	// Validate HEAD matches unless forced
+	if !input.Force {
+		currentHead, err := uc.repoInspector.HeadSHA(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current HEAD: %w", err)
+		}
+		if err := run.ValidateHeadMatch(currentHead); err != nil {
+			return nil, fmt.Errorf("%w (use --force to override)", err)
+		}
+	}
+
+	if !run.IsMultiLevelApprovalEnabled() {
+		policy := domain.DefaultApprovalPolicy()
+		if input.Policy != nil {
+			policy = *input.Policy
+		}
+		run.SetApprovalPolicy(policy)
+	}
+
+	req, ok := run.MultiLevelApprovalStatus().Policy.Requirement(input.Level)
+	if !ok {
+		return nil, fmt.Errorf("approval level %q is not part of the approval policy", input.Level)
+	}
+	if len(req.AllowedBy) > 0 && !slices.Contains(req.AllowedBy, input.Actor.ID) {
+		return nil, fmt.Errorf("%s is not allowed to grant %s approval", input.Actor.ID, input.Level)
+	}
+
+	if err := run.ApproveAtLevel(input.Level, input.Actor.ID, input.Actor.Type, input.Justification); err != nil {
+		return nil, fmt.Errorf("failed to approve: %w", err)
+	}
+
+	ml := run.MultiLevelApprovalStatus()
+	fullyApproved := ml.IsFullyApproved()
+	if fullyApproved {
+		run.SetApprovalTTL(input.ApprovalTTL)
+		uc.ensureTagStep(run, input.PackageTags)
+		if err := uc.ensurePluginSteps(ctx, run); err != nil {
+			return nil, err
+		}
+		if err := run.CompleteMultiLevelApproval(input.Actor.ID); err != nil {
+			return nil, fmt.Errorf("failed to complete approval: %w", err)
+		}
+	}
+
+	if err := uc.repo.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to save run: %w", err)
+	}
+
+	output := &ApproveLevelOutput{
+		RunID:         run.ID(),
+		PlanHash:      run.PlanHash(),
+		Level:         input.Level,
+		Pending:       ml.PendingApprovals(),
+		FullyApproved: fullyApproved,
+		VersionNext:   run.VersionNext().String(),
+	}
+	for _, r := range ml.Policy.Requirements {
+		if ml.IsLevelApproved(r.Level) {
+			output.Granted = append(output.Granted, r.Level)
+		}
+	}
+	if ml.Policy.Sequential {
+		if next := ml.NextRequiredLevel(); next != nil {
+			output.NextLevel = next.Level
+		}
+	}
+	return output, nil
+}
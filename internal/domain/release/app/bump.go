@@ -3,13 +3,20 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/version"
 )
 
+// ErrVersionNotIncreasing is returned when an override version is not greater
+// than the current version, which would tag a release that sorts before (or
+// alongside) one that already exists.
+var ErrVersionNotIncreasing = errors.New("version is not greater than the current version")
+
 // BumpVersionInput contains the input for bumping the version.
 type BumpVersionInput struct {
 	RepoRoot string
@@ -20,6 +27,15 @@ type BumpVersionInput struct {
 	// Optional: if not provided, uses the version proposal from planning
 	OverrideVersion *version.SemanticVersion
 	OverrideTagName string
+
+	// AllowDowngrade accepts an OverrideVersion that is not greater than the
+	// current version, for correcting a mistaken release.
+	AllowDowngrade bool
+
+	// Optional: turns the planned version into a prerelease with this
+	// identifier (e.g. "beta"), continuing an existing series of the same
+	// identifier. Ignored when OverrideVersion is set.
+	Prerelease version.Prerelease
 }
 
 // BumpVersionOutput contains the output from bumping the version.
@@ -93,8 +109,17 @@ func (uc *BumpVersionUseCase) Execute(ctx context.Context, input BumpVersionInpu
 	versionNext := run.VersionNext()
 	tagName := run.TagName()
 
+	planned := versionNext
 	if input.OverrideVersion != nil {
 		versionNext = *input.OverrideVersion
+		if err := CheckVersionIncreases(run.VersionCurrent(), versionNext, input.AllowDowngrade); err != nil {
+			return nil, err
+		}
+	} else if input.Prerelease != "" {
+		versionNext = version.NextPrerelease(run.VersionCurrent(), planned, input.Prerelease)
+	}
+	if tagName != "" && !versionNext.Equals(planned) {
+		tagName = strings.TrimSuffix(tagName, planned.String()) + versionNext.String()
 	}
 	if input.OverrideTagName != "" {
 		tagName = input.OverrideTagName
@@ -152,3 +177,13 @@ func (uc *BumpVersionUseCase) loadRun(ctx context.Context, repoRoot string, runI
 	}
 	return uc.repo.LoadLatest(ctx, repoRoot)
 }
+
+// CheckVersionIncreases returns an error wrapping ErrVersionNotIncreasing if
+// next is not strictly greater than current, unless allowDowngrade is set.
+func CheckVersionIncreases(current, next version.SemanticVersion, allowDowngrade bool) error {
+	if allowDowngrade || next.GreaterThan(current) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not greater than %s (use --allow-downgrade to override)",
+		ErrVersionNotIncreasing, next.String(), current.String())
+}
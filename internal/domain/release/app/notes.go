@@ -4,6 +4,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
@@ -16,13 +18,29 @@ type GenerateNotesInput struct {
 	Options  ports.NotesOptions
 	Actor    ports.ActorInfo
 	Force    bool // Force regeneration even if HEAD changed
+
+	// Regenerate replaces the notes of a run in NotesReady instead of
+	// generating the first notes of a versioned run. Presets not set in
+	// Options are taken from the existing notes. Regenerating the notes of
+	// a run in ChangesRequested resubmits it for approval.
+	Regenerate bool
+
+	// Text, when set, is used as the notes instead of generating them.
+	// The notes of a versioned run are set with GenerateNotes; those of a
+	// run in NotesReady or ChangesRequested are replaced with UpdateNotes.
+	Text string
 }
 
+// NotesProviderImported is the provider recorded for notes supplied as
+// text instead of being generated.
+const NotesProviderImported = "imported"
+
 // GenerateNotesOutput contains the output from generating release notes.
 type GenerateNotesOutput struct {
-	RunID      domain.RunID
-	Notes      *domain.ReleaseNotes
-	InputsHash string
+	RunID       domain.RunID
+	Notes       *domain.ReleaseNotes
+	InputsHash  string
+	Regenerated bool
 }
 
 // GenerateNotesUseCase handles the generate notes use case.
@@ -67,17 +85,42 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 		}
 	}
 
+	if input.Text != "" {
+		return uc.importNotes(ctx, run, input)
+	}
+
+	options := input.Options
+	if input.Regenerate {
+		if run.State() != domain.StateNotesReady && run.State() != domain.StateChangesRequested {
+			return nil, domain.NewStateTransitionError(run.State(), "regenerate notes")
+		}
+		options = inheritNotesPresets(options, run.Notes())
+	}
+	if options.AudiencePreset, err = domain.ResolveNotesAudience(options.AudiencePreset); err != nil {
+		return nil, err
+	}
+	if options.TonePreset, err = domain.ResolveNotesTone(options.TonePreset); err != nil {
+		return nil, err
+	}
+
 	// Generate notes
-	notes, err := uc.notesGen.Generate(ctx, run, input.Options)
+	notes, err := uc.notesGen.Generate(ctx, run, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate notes: %w", err)
 	}
+	notes.AudiencePreset = options.AudiencePreset
+	notes.TonePreset = options.TonePreset
 
 	// Compute inputs hash
-	inputsHash := uc.notesGen.ComputeInputsHash(run, input.Options)
+	inputsHash := uc.notesGen.ComputeInputsHash(run, options)
 
 	// Update the run with notes
-	if err := run.GenerateNotes(notes, inputsHash, input.Actor.ID); err != nil {
+	if input.Regenerate {
+		err = run.RegenerateNotes(notes, inputsHash, input.Actor.ID)
+	} else {
+		err = run.GenerateNotes(notes, inputsHash, input.Actor.ID)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("failed to update run with notes: %w", err)
 	}
 
@@ -87,12 +130,82 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 	}
 
 	return &GenerateNotesOutput{
-		RunID:      run.ID(),
-		Notes:      notes,
-		InputsHash: inputsHash,
+		RunID:       run.ID(),
+		Notes:       notes,
+		InputsHash:  inputsHash,
+		Regenerated: input.Regenerate,
 	}, nil
 }
 
+// importNotes sets the notes of a run to input.Text without running the
+// notes generator.
+func (uc *GenerateNotesUseCase) importNotes(ctx context.Context, run *domain.ReleaseRun, input GenerateNotesInput) (*GenerateNotesOutput, error) {
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, fmt.Errorf("notes text cannot be empty")
+	}
+
+	// Imported notes record the presets they were written for, if any.
+	audience, tone := input.Options.AudiencePreset, input.Options.TonePreset
+	var err error
+	if audience != "" {
+		if audience, err = domain.ResolveNotesAudience(audience); err != nil {
+			return nil, err
+		}
+	}
+	if tone != "" {
+		if tone, err = domain.ResolveNotesTone(tone); err != nil {
+			return nil, err
+		}
+	}
+
+	notes := &domain.ReleaseNotes{
+		Text:           input.Text,
+		AudiencePreset: audience,
+		TonePreset:     tone,
+		Provider:       NotesProviderImported,
+		GeneratedAt:    time.Now(),
+	}
+
+	replaced := false
+	switch run.State() {
+	case domain.StateVersioned:
+		err = run.GenerateNotes(notes, "", input.Actor.ID)
+	case domain.StateNotesReady, domain.StateChangesRequested:
+		err = run.UpdateNotes(notes, input.Actor.ID)
+		replaced = true
+	default:
+		err = domain.NewStateTransitionError(run.State(), "import notes")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update run with notes: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to save run: %w", err)
+	}
+
+	return &GenerateNotesOutput{
+		RunID:       run.ID(),
+		Notes:       notes,
+		Regenerated: replaced,
+	}, nil
+}
+
+// inheritNotesPresets fills the audience and tone presets that options leave
+// unset from the existing notes.
+func inheritNotesPresets(options ports.NotesOptions, existing *domain.ReleaseNotes) ports.NotesOptions {
+	if existing == nil {
+		return options
+	}
+	if options.AudiencePreset == "" {
+		options.AudiencePreset = existing.AudiencePreset
+	}
+	if options.TonePreset == "" {
+		options.TonePreset = existing.TonePreset
+	}
+	return options
+}
+
 // loadRun loads a run by ID or the latest run.
 func (uc *GenerateNotesUseCase) loadRun(ctx context.Context, repoRoot string, runID domain.RunID) (*domain.ReleaseRun, error) {
 	if runID != "" {
@@ -21,6 +21,16 @@ type PlanReleaseInput struct {
 	Actor          ports.ActorInfo
 	Force          bool // Force planning even if there's an active run
 
+	// ExcludedCommits lists commit SHAs (full or abbreviated) to remove from the
+	// release range. Each must resolve to exactly one commit in the range.
+	// With Commits they are the full SHAs of commits already removed.
+	ExcludedCommits []string
+
+	// Commits is the analyzed release range, after exclusions. When set, the
+	// range is taken as is instead of being resolved from BaseRef to HEAD, so
+	// the run covers the same commits as a --from or --since analysis.
+	Commits []domain.CommitSHA
+
 	// Optional pre-computed data from commit analysis
 	// If provided, these bypass the basic commit resolution and enable full release planning
 	ChangeSet      *changes.ChangeSet       // Pre-computed changeset from analysis
@@ -40,6 +50,7 @@ type PlanReleaseOutput struct {
 	RunID          domain.RunID
 	HeadSHA        domain.CommitSHA
 	Commits        []domain.CommitSHA
+	Excluded       []domain.CommitSHA
 	PlanHash       string
 	CurrentVersion version.SemanticVersion
 	VersionNext    version.SemanticVersion
@@ -94,22 +105,9 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		return nil, fmt.Errorf("failed to get HEAD SHA: %w", err)
 	}
 
-	// Get base ref if not provided
-	baseRef := input.BaseRef
-	if baseRef == "" {
-		tag, err := uc.repoInspector.GetLatestVersionTag(ctx, "v")
-		if err != nil {
-			// No previous version tag - use initial commit or empty
-			baseRef = ""
-		} else {
-			baseRef = tag
-		}
-	}
-
-	// Resolve commits between base and head
-	commits, err := uc.repoInspector.ResolveCommits(ctx, baseRef, headSHA)
+	baseRef, commits, excluded, err := uc.releaseRange(ctx, input, headSHA)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve commits: %w", err)
+		return nil, err
 	}
 
 	// Get repo ID if not provided
@@ -134,6 +132,12 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		input.PluginPlanHash,
 	)
 
+	if len(excluded) > 0 {
+		if err := run.SetExcludedCommits(excluded); err != nil {
+			return nil, fmt.Errorf("failed to record excluded commits: %w", err)
+		}
+	}
+
 	// Set actor
 	run.SetActor(input.Actor.Type, input.Actor.ID)
 
@@ -202,6 +206,7 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		RunID:          run.ID(),
 		HeadSHA:        run.HeadSHA(),
 		Commits:        run.Commits(),
+		Excluded:       run.ExcludedCommits(),
 		PlanHash:       run.PlanHash(),
 		CurrentVersion: run.VersionCurrent(),
 		VersionNext:    run.VersionNext(),
@@ -210,3 +215,40 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		ChangeSet:      input.ChangeSet,
 	}, nil
 }
+
+// releaseRange returns the base reference, commits and excluded commits of
+// the run. The range is input.Commits when given; otherwise it is resolved
+// from the base reference, or the latest version tag, to headSHA.
+func (uc *PlanReleaseUseCase) releaseRange(ctx context.Context, input PlanReleaseInput, headSHA domain.CommitSHA) (string, []domain.CommitSHA, []domain.CommitSHA, error) {
+	if input.Commits != nil {
+		excluded := make([]domain.CommitSHA, len(input.ExcludedCommits))
+		for i, sha := range input.ExcludedCommits {
+			excluded[i] = domain.CommitSHA(sha)
+		}
+		return input.BaseRef, input.Commits, excluded, nil
+	}
+
+	// Get base ref if not provided
+	baseRef := input.BaseRef
+	if baseRef == "" {
+		if tag, err := uc.repoInspector.GetLatestVersionTag(ctx, "v"); err == nil {
+			baseRef = tag
+		}
+	}
+
+	// Resolve commits between base and head
+	commits, err := uc.repoInspector.ResolveCommits(ctx, baseRef, headSHA)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to resolve commits: %w", err)
+	}
+
+	// Remove explicitly excluded commits from the range
+	var excluded []domain.CommitSHA
+	if len(input.ExcludedCommits) > 0 {
+		commits, excluded, err = domain.ExcludeCommits(commits, input.ExcludedCommits)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("failed to exclude commits: %w", err)
+		}
+	}
+	return baseRef, commits, excluded, nil
+}
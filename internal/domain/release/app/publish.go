@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
@@ -17,6 +19,15 @@ type PublishReleaseInput struct {
 	Actor    ports.ActorInfo
 	Force    bool // Force publishing even if HEAD changed
 	DryRun   bool // Simulate without making changes
+
+	// OnPluginFailure controls what happens when a plugin step fails.
+	// Defaults to PluginFailureFail.
+	OnPluginFailure PluginFailureMode
+
+	// Only restricts publishing to the steps of the named plugins (or the
+	// steps with these names). Steps that are already done are skipped, and a
+	// failed run is retried. Empty runs every pending step.
+	Only []string
 }
 
 // PublishReleaseOutput contains the output from publishing a release.
@@ -25,8 +36,28 @@ type PublishReleaseOutput struct {
 	Published   bool
 	StepResults []StepResult
 	VersionNext string
+
+	// OnPluginFailure is the failure behavior applied during this publish.
+	OnPluginFailure PluginFailureMode
+	// PluginFailures lists plugin steps that failed. With PluginFailureWarn
+	// the release is published despite these failures.
+	PluginFailures []StepResult
+	// RolledBackTags lists tags removed by PluginFailureRollback.
+	RolledBackTags []string
 }
 
+// PluginFailureMode controls the release outcome when a plugin step fails.
+type PluginFailureMode string
+
+const (
+	// PluginFailureFail stops publishing and leaves the run to be retried.
+	PluginFailureFail PluginFailureMode = "fail"
+	// PluginFailureWarn records the failure and completes the release.
+	PluginFailureWarn PluginFailureMode = "warn"
+	// PluginFailureRollback removes the release tags and marks the run failed.
+	PluginFailureRollback PluginFailureMode = "rollback"
+)
+
 // StepResult contains the result of executing a step.
 type StepResult struct {
 	StepName string
@@ -99,15 +130,47 @@ func (uc *PublishReleaseUseCase) Execute(ctx context.Context, input PublishRelea
 		}, nil // Already published - idempotent success
 	}
 
-	if run.State() != domain.StateApproved && run.State() != domain.StatePublishing {
+	// Selective publishing may also retry a failed run
+	retrying := len(input.Only) > 0 && run.State() == domain.StateFailed
+	if run.State() != domain.StateApproved && run.State() != domain.StatePublishing && !retrying {
 		return nil, fmt.Errorf("cannot publish from state %s (must be approved or publishing)", run.State())
 	}
 
+	var selected map[string]bool
+	if len(input.Only) > 0 {
+		if selected, err = selectSteps(run, input.Only); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate approval is bound to current plan hash (idempotency check)
 	if err := run.ValidateApprovalPlanHash(); err != nil {
 		return nil, fmt.Errorf("approval validation failed: %w", err)
 	}
 
+	// Expiry only gates starting a publish; an interrupted publish may resume
+	if run.State() == domain.StateApproved {
+		if err := run.ValidateApprovalNotExpired(time.Now()); err != nil {
+			return nil, fmt.Errorf("approval validation failed: %w", err)
+		}
+	}
+
+	// Plugins may veto the release until the first tag exists
+	if len(completedTagSteps(run)) == 0 {
+		if err := uc.validatePrePublish(ctx, run, input.DryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	if retrying {
+		if err := run.RetryPublish(input.Actor.ID); err != nil {
+			return nil, fmt.Errorf("failed to retry publishing: %w", err)
+		}
+		if err := uc.repo.Save(ctx, run); err != nil {
+			return nil, fmt.Errorf("failed to save run: %w", err)
+		}
+	}
+
 	// Transition to Publishing if not already
 	if run.State() == domain.StateApproved {
 		if err := run.StartPublishing(input.Actor.ID); err != nil {
@@ -118,10 +181,20 @@ func (uc *PublishReleaseUseCase) Execute(ctx context.Context, input PublishRelea
 		}
 	}
 
+	mode := input.OnPluginFailure
+	if mode == "" {
+		mode = PluginFailureFail
+	}
+
 	// Execute steps with idempotency
-	var stepResults []StepResult
+	var stepResults, pluginFailures []StepResult
+	next := run.NextPendingStep
+	if selected != nil {
+		stepResults = completedSelectedSteps(run, selected)
+		next = func() *domain.StepPlan { return nextSelectedStep(run, selected) }
+	}
 	for {
-		step := run.NextPendingStep()
+		step := next()
 		if step == nil {
 			break // All steps done
 		}
@@ -130,16 +203,49 @@ func (uc *PublishReleaseUseCase) Execute(ctx context.Context, input PublishRelea
 		stepResults = append(stepResults, *result)
 
 		if err != nil || !result.Success {
+			// Tag failures always stop the release; plugin failures follow the configured mode
+			isPluginStep := step.Type != domain.StepTypeTag
+			if isPluginStep {
+				pluginFailures = append(pluginFailures, *result)
+			}
+
+			if isPluginStep && mode == PluginFailureWarn {
+				reason := fmt.Sprintf("Failure ignored (on_plugin_failure=warn): %s", result.Error)
+				if err := run.MarkStepSkipped(step.Name, reason); err != nil {
+					return nil, fmt.Errorf("failed to record ignored step failure: %w", err)
+				}
+				if err := uc.repo.Save(ctx, run); err != nil {
+					return nil, fmt.Errorf("failed to save run after step failure: %w", err)
+				}
+				continue
+			}
+
+			output := &PublishReleaseOutput{
+				RunID:           run.ID(),
+				Published:       false,
+				StepResults:     stepResults,
+				VersionNext:     run.VersionNext().String(),
+				OnPluginFailure: mode,
+				PluginFailures:  pluginFailures,
+			}
+			stepErr := fmt.Errorf("step %s failed: %w", step.Name, err)
+
+			if isPluginStep && mode == PluginFailureRollback {
+				tags, rollbackErr := uc.rollbackTags(ctx, run)
+				output.RolledBackTags = tags
+				if rollbackErr != nil {
+					stepErr = errors.Join(stepErr, fmt.Errorf("tag rollback failed: %w", rollbackErr))
+				}
+				if err := run.MarkFailed(stepErr.Error(), input.Actor.ID); err != nil {
+					return nil, fmt.Errorf("failed to mark run failed: %w", err)
+				}
+			}
+
 			// Step failed - save state and return
 			if err := uc.repo.Save(ctx, run); err != nil {
 				return nil, fmt.Errorf("failed to save run after step failure: %w", err)
 			}
-			return &PublishReleaseOutput{
-				RunID:       run.ID(),
-				Published:   false,
-				StepResults: stepResults,
-				VersionNext: run.VersionNext().String(),
-			}, fmt.Errorf("step %s failed: %w", step.Name, err)
+			return output, stepErr
 		}
 
 		// Save after each successful step for resumability
@@ -159,13 +265,126 @@ func (uc *PublishReleaseUseCase) Execute(ctx context.Context, input PublishRelea
 	}
 
 	return &PublishReleaseOutput{
-		RunID:       run.ID(),
-		Published:   run.State() == domain.StatePublished,
-		StepResults: stepResults,
-		VersionNext: run.VersionNext().String(),
+		RunID:           run.ID(),
+		Published:       run.State() == domain.StatePublished,
+		StepResults:     stepResults,
+		VersionNext:     run.VersionNext().String(),
+		OnPluginFailure: mode,
+		PluginFailures:  pluginFailures,
 	}, nil
 }
 
+// selectSteps returns the names of the steps matching only, by plugin name
+// or step name. Plugin steps need the release tag, so every tag step must be
+// done or selected.
+func selectSteps(run *domain.ReleaseRun, only []string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	var unknown []string
+	for _, name := range only {
+		found := false
+		for _, step := range run.Steps() {
+			if step.Name == name || (step.PluginName != "" && step.PluginName == name) {
+				selected[step.Name] = true
+				found = true
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrStepNotFound, strings.Join(unknown, ", "))
+	}
+
+	for _, step := range run.Steps() {
+		if step.Type != domain.StepTypeTag || selected[step.Name] {
+			continue
+		}
+		if status := run.StepStatus(step.Name); status == nil || status.State != domain.StepDone {
+			return nil, fmt.Errorf("tag step %s has not completed; publish the tag before running plugin steps selectively", step.Name)
+		}
+	}
+	return selected, nil
+}
+
+// completedSelectedSteps reports the selected steps that are already done as
+// skipped, in plan order.
+func completedSelectedSteps(run *domain.ReleaseRun, selected map[string]bool) []StepResult {
+	var results []StepResult
+	for _, step := range run.Steps() {
+		if !selected[step.Name] {
+			continue
+		}
+		if status := run.StepStatus(step.Name); status != nil && (status.State == domain.StepDone || status.State == domain.StepSkipped) {
+			results = append(results, StepResult{
+				StepName: step.Name,
+				Success:  true,
+				Skipped:  true,
+				Output:   "Skipped: already completed",
+			})
+		}
+	}
+	return results
+}
+
+// nextSelectedStep returns the next selected step that needs to be executed.
+func nextSelectedStep(run *domain.ReleaseRun, selected map[string]bool) *domain.StepPlan {
+	steps := run.Steps()
+	for i := range steps {
+		if !selected[steps[i].Name] {
+			continue
+		}
+		status := run.StepStatus(steps[i].Name)
+		if status != nil && (status.State == domain.StepPending || status.State == domain.StepFailed) {
+			return &steps[i]
+		}
+	}
+	return nil
+}
+
+// validatePrePublish runs the publisher's pre-publish checks, if any.
+func (uc *PublishReleaseUseCase) validatePrePublish(ctx context.Context, run *domain.ReleaseRun, dryRun bool) error {
+	validator, ok := uc.publisher.(ports.PrePublishValidator)
+	if !ok {
+		return nil
+	}
+	if err := validator.ValidatePrePublish(ctx, run, dryRun); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrPrePublishRejected, err)
+	}
+	return nil
+}
+
+// rollbackTags removes the tags created by completed tag steps and resets
+// those steps so a retry creates the tags again.
+func (uc *PublishReleaseUseCase) rollbackTags(ctx context.Context, run *domain.ReleaseRun) ([]string, error) {
+	remover, ok := uc.publisher.(ports.TagRemover)
+	if !ok {
+		return nil, fmt.Errorf("publisher does not support tag removal")
+	}
+
+	var removed []string
+	var errs []error
+	for _, step := range run.Steps() {
+		if step.Type != domain.StepTypeTag {
+			continue
+		}
+		if status := run.StepStatus(step.Name); status == nil || status.State != domain.StepDone {
+			continue
+		}
+		tagName, err := remover.RemoveTag(ctx, run, &step)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", step.Name, err))
+			continue
+		}
+		removed = append(removed, tagName)
+		if err := run.ResetStep(step.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return removed, errors.Join(errs...)
+}
+
 // executeStep executes a single step with idempotency checks.
 func (uc *PublishReleaseUseCase) executeStep(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan, dryRun bool) (*StepResult, error) {
 	result := &StepResult{
@@ -0,0 +1,91 @@
+// Package app provides application services (use cases) for release governance.
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+)
+
+// RejectReleaseInput contains the input for rejecting a release.
+type RejectReleaseInput struct {
+	RepoRoot string
+	RunID    domain.RunID // If empty, uses latest
+	Actor    ports.ActorInfo
+	Reason   string // Feedback for the notes author; required
+}
+
+// RejectReleaseOutput contains the output from rejecting a release.
+type RejectReleaseOutput struct {
+	RunID       domain.RunID
+	PlanHash    string
+	RejectedBy  string
+	Reason      string
+	VersionNext string
+}
+
+// RejectReleaseUseCase handles the reject release use case. A rejected
+// release moves to ChangesRequested and can be revised and resubmitted,
+// unlike a canceled one.
+type RejectReleaseUseCase struct {
+	repo        ports.ReleaseRunRepository
+	lockManager ports.LockManager
+}
+
+// NewRejectReleaseUseCase creates a new RejectReleaseUseCase.
+func NewRejectReleaseUseCase(
+	repo ports.ReleaseRunRepository,
+	lockManager ports.LockManager,
+) *RejectReleaseUseCase {
+	return &RejectReleaseUseCase{
+		repo:        repo,
+		lockManager: lockManager,
+	}
+}
+
+// Execute rejects a release awaiting approval.
+func (uc *RejectReleaseUseCase) Execute(ctx context.Context, input RejectReleaseInput) (*RejectReleaseOutput, error) {
+	run, err := uc.loadRun(ctx, input.RepoRoot, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.lockManager != nil {
+		release, err := uc.lockManager.Acquire(ctx, input.RepoRoot, run.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		defer release()
+	}
+
+	if err := run.Reject(input.Reason, input.Actor.ID); err != nil {
+		return nil, fmt.Errorf("failed to reject: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to save run: %w", err)
+	}
+
+	return &RejectReleaseOutput{
+		RunID:       run.ID(),
+		PlanHash:    run.PlanHash(),
+		RejectedBy:  input.Actor.ID,
+		Reason:      input.Reason,
+		VersionNext: run.VersionNext().String(),
+	}, nil
+}
+
+// loadRun loads a run by ID or the latest run.
+func (uc *RejectReleaseUseCase) loadRun(ctx context.Context, repoRoot string, runID domain.RunID) (*domain.ReleaseRun, error) {
+	if runID != "" {
+		if fileRepo, ok := uc.repo.(interface {
+			LoadFromRepo(context.Context, string, domain.RunID) (*domain.ReleaseRun, error)
+		}); ok {
+			return fileRepo.LoadFromRepo(ctx, repoRoot, runID)
+		}
+		return uc.repo.Load(ctx, runID)
+	}
+	return uc.repo.LoadLatest(ctx, repoRoot)
+}
@@ -0,0 +1,231 @@
+// Package app provides application services (use cases) for release governance.
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+)
+
+// ErrRollbackRequiresForce is returned when rolling back a published release
+// without Force. Consumers may already have fetched the tag.
+var ErrRollbackRequiresForce = errors.New("release is already published and may have been consumed")
+
+// RollbackReleaseInput contains the input for rolling back a release.
+type RollbackReleaseInput struct {
+	RepoRoot string
+	RunID    domain.RunID // If empty, uses Version or the latest run
+	Version  string       // Optional version of the run to roll back
+	Actor    ports.ActorInfo
+	Reason   string
+	Force    bool // Roll back a release that is already published
+	DryRun   bool // Report the steps that would be reverted
+}
+
+// RollbackReleaseOutput contains the output from rolling back a release.
+type RollbackReleaseOutput struct {
+	RunID         domain.RunID
+	VersionNext   string
+	PreviousState domain.RunState
+	State         domain.RunState
+	// RevertedSteps lists the tag steps reverted by this rollback. Steps
+	// whose tag no longer exists are reported as skipped.
+	RevertedSteps []StepResult
+	// RemovedTags lists the tags deleted by this rollback.
+	RemovedTags []string
+	// NotifyError is set when the rollback hooks failed. The rollback itself
+	// still succeeded.
+	NotifyError string
+}
+
+// RollbackReleaseUseCase handles the rollback release use case.
+type RollbackReleaseUseCase struct {
+	repo        ports.ReleaseRunRepository
+	lockManager ports.LockManager
+	publisher   ports.Publisher
+}
+
+// NewRollbackReleaseUseCase creates a new RollbackReleaseUseCase.
+func NewRollbackReleaseUseCase(
+	repo ports.ReleaseRunRepository,
+	lockManager ports.LockManager,
+	publisher ports.Publisher,
+) *RollbackReleaseUseCase {
+	return &RollbackReleaseUseCase{
+		repo:        repo,
+		lockManager: lockManager,
+		publisher:   publisher,
+	}
+}
+
+// Execute rolls back a release by deleting the tags created by its completed
+// tag steps and marking the run failed. Each reverted step is reset and saved
+// before the next one runs, so an interrupted rollback can be resumed by
+// running it again against the failed run.
+func (uc *RollbackReleaseUseCase) Execute(ctx context.Context, input RollbackReleaseInput) (*RollbackReleaseOutput, error) {
+	run, err := uc.loadRun(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RollbackReleaseOutput{
+		RunID:         run.ID(),
+		VersionNext:   run.VersionNext().String(),
+		PreviousState: run.State(),
+	}
+
+	switch run.State() {
+	case domain.StatePublished:
+		if !input.Force {
+			return nil, fmt.Errorf("%w (use --force to roll back anyway)", ErrRollbackRequiresForce)
+		}
+	case domain.StatePublishing, domain.StateFailed:
+	default:
+		return nil, fmt.Errorf("cannot roll back from state %s (must be publishing, published or failed)", run.State())
+	}
+
+	if input.DryRun {
+		for _, step := range completedTagSteps(run) {
+			output.RevertedSteps = append(output.RevertedSteps, StepResult{
+				StepName: step.Name,
+				Success:  true,
+				Skipped:  true,
+				Output:   "Dry run: would delete tag " + rollbackTagName(run, &step),
+			})
+		}
+		output.State = run.State()
+		return output, nil
+	}
+
+	remover, ok := uc.publisher.(ports.TagRemover)
+	if !ok {
+		return nil, fmt.Errorf("publisher does not support tag removal")
+	}
+
+	// Acquire lock
+	if uc.lockManager != nil {
+		release, err := uc.lockManager.Acquire(ctx, input.RepoRoot, run.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		defer release()
+	}
+
+	reason := input.Reason
+	if reason == "" {
+		reason = "Release rolled back"
+	}
+
+	// Fail the run before touching any tags so a resumed rollback no longer
+	// needs --force.
+	if run.State() != domain.StateFailed {
+		if err := run.Rollback(reason, input.Actor.ID); err != nil {
+			return nil, fmt.Errorf("failed to roll back run: %w", err)
+		}
+		if err := uc.repo.Save(ctx, run); err != nil {
+			return nil, fmt.Errorf("failed to save run: %w", err)
+		}
+	}
+
+	for _, step := range completedTagSteps(run) {
+		result := StepResult{StepName: step.Name}
+
+		exists, err := uc.publisher.CheckIdempotency(ctx, run, &step)
+		if err == nil && !exists {
+			result.Success = true
+			result.Skipped = true
+			result.Output = "Skipped: tag already removed"
+		} else {
+			tagName, err := remover.RemoveTag(ctx, run, &step)
+			if err != nil {
+				result.Error = err.Error()
+				output.RevertedSteps = append(output.RevertedSteps, result)
+				output.State = run.State()
+				return output, fmt.Errorf("step %s rollback failed: %w", step.Name, err)
+			}
+			result.Success = true
+			result.Output = "Deleted tag " + tagName
+			output.RemovedTags = append(output.RemovedTags, tagName)
+		}
+
+		if err := run.ResetStep(step.Name); err != nil {
+			return nil, fmt.Errorf("failed to reset step %s: %w", step.Name, err)
+		}
+		// Save after each step for resumability
+		if err := uc.repo.Save(ctx, run); err != nil {
+			return nil, fmt.Errorf("failed to save run after step: %w", err)
+		}
+		output.RevertedSteps = append(output.RevertedSteps, result)
+	}
+
+	if notifier, ok := uc.publisher.(ports.RollbackNotifier); ok {
+		if err := notifier.NotifyRollback(ctx, run, reason); err != nil {
+			output.NotifyError = err.Error()
+		}
+	}
+
+	output.State = run.State()
+	return output, nil
+}
+
+// completedTagSteps returns the tag steps that completed and still need to
+// be reverted.
+func completedTagSteps(run *domain.ReleaseRun) []domain.StepPlan {
+	var steps []domain.StepPlan
+	for _, step := range run.Steps() {
+		if step.Type != domain.StepTypeTag {
+			continue
+		}
+		if status := run.StepStatus(step.Name); status == nil || status.State != domain.StepDone {
+			continue
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// rollbackTagName returns the tag a tag step created.
+func rollbackTagName(run *domain.ReleaseRun, step *domain.StepPlan) string {
+	if step.TagName != "" {
+		return step.TagName
+	}
+	return run.TagName()
+}
+
+// loadRun loads the run by ID, by version or the latest run.
+func (uc *RollbackReleaseUseCase) loadRun(ctx context.Context, input RollbackReleaseInput) (*domain.ReleaseRun, error) {
+	if input.RunID != "" {
+		if fileRepo, ok := uc.repo.(interface {
+			LoadFromRepo(context.Context, string, domain.RunID) (*domain.ReleaseRun, error)
+		}); ok {
+			return fileRepo.LoadFromRepo(ctx, input.RepoRoot, input.RunID)
+		}
+		return uc.repo.Load(ctx, input.RunID)
+	}
+	if input.Version == "" {
+		return uc.repo.LoadLatest(ctx, input.RepoRoot)
+	}
+
+	runIDs, err := uc.repo.List(ctx, input.RepoRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs: %w", err)
+	}
+	runs, err := uc.repo.LoadBatch(ctx, input.RepoRoot, runIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load runs: %w", err)
+	}
+	// Runs are listed newest first.
+	for _, id := range runIDs {
+		run, ok := runs[id]
+		if !ok {
+			continue
+		}
+		if run.VersionNext().String() == input.Version || run.TagName() == input.Version {
+			return run, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no run for version %s", domain.ErrRunNotFound, input.Version)
+}
@@ -31,7 +31,9 @@ type GetStatusOutput struct {
 	StepsDone      int
 	StepsFailed    int
 	StepsPending   int
-	NextAction     string
+	NextAction     string // Machine-readable next action (e.g. "bump")
+	NextStep       string // Canonical next step (see ReleaseRun.NextAction)
+	NextCommand    string // CLI command for the next step
 	CanBump        bool
 	CanApprove     bool
 	CanPublish     bool
@@ -42,6 +44,15 @@ type GetStatusOutput struct {
 	UpdatedAt      time.Time
 	PublishedAt    *time.Time
 	LastError      string
+	Rejection      *domain.Rejection // Most recent rejection, if the run was ever rejected
+
+	// CanAutoApprove reports whether the risk score is within the
+	// auto-approval threshold captured at plan time.
+	CanAutoApprove       bool
+	AutoApproveThreshold float64
+	// MultiLevelApproval is the approval progress under a multi-level
+	// approval policy, nil for single-level approval.
+	MultiLevelApproval *domain.MultiLevelApproval
 }
 
 // GetStatusUseCase handles the get status use case.
@@ -75,12 +86,12 @@ func (uc *GetStatusUseCase) Execute(ctx context.Context, input GetStatusInput) (
 	stepsPending := summary.StepsTotal - summary.StepsDone - summary.StepsFailed
 
 	// Determine next action
-	nextAction := determineNextAction(run.State())
+	nextStep, nextCommand := run.NextAction()
 
 	// Check for staleness
 	stale := false
 	warning := ""
-	if !run.State().IsFinal() {
+	if !run.State().IsFinished() {
 		staleThreshold := time.Now().Add(-1 * time.Hour)
 		if run.UpdatedAt().Before(staleThreshold) {
 			stale = true
@@ -90,7 +101,7 @@ func (uc *GetStatusUseCase) Execute(ctx context.Context, input GetStatusInput) (
 
 	// Check if HEAD has drifted
 	currentHead, err := uc.repoInspector.HeadSHA(ctx)
-	if err == nil && currentHead != run.HeadSHA() && !run.State().IsFinal() {
+	if err == nil && currentHead != run.HeadSHA() && !run.State().IsFinished() {
 		if warning != "" {
 			warning += " "
 		}
@@ -112,10 +123,12 @@ func (uc *GetStatusUseCase) Execute(ctx context.Context, input GetStatusInput) (
 		StepsDone:      summary.StepsDone,
 		StepsFailed:    summary.StepsFailed,
 		StepsPending:   stepsPending,
-		NextAction:     nextAction,
+		NextAction:     determineNextAction(run.State(), run.IsApprovalExpired(time.Now())),
+		NextStep:       nextStep,
+		NextCommand:    nextCommand,
 		CanBump:        run.State() == domain.StatePlanned,
-		CanApprove:     run.State() == domain.StateNotesReady,
-		CanPublish:     run.State() == domain.StateApproved,
+		CanApprove:     run.CanApprove(),
+		CanPublish:     run.CanProceedToPublish(),
 		CanRetry:       run.State() == domain.StateFailed,
 		Stale:          stale,
 		Warning:        warning,
@@ -123,11 +136,17 @@ func (uc *GetStatusUseCase) Execute(ctx context.Context, input GetStatusInput) (
 		UpdatedAt:      run.UpdatedAt(),
 		PublishedAt:    run.PublishedAt(),
 		LastError:      run.LastError(),
+		Rejection:      run.LastRejection(),
+
+		CanAutoApprove:       run.CanAutoApprove(),
+		AutoApproveThreshold: run.Thresholds().AutoApproveRiskThreshold,
+		MultiLevelApproval:   run.MultiLevelApprovalStatus(),
 	}, nil
 }
 
-// determineNextAction returns the suggested next action based on state.
-func determineNextAction(state domain.RunState) string {
+// determineNextAction returns the suggested next action based on state. An
+// approved run whose approval has expired must be approved again.
+func determineNextAction(state domain.RunState, approvalExpired bool) string {
 	switch state {
 	case domain.StateDraft:
 		return "plan"
@@ -137,7 +156,12 @@ func determineNextAction(state domain.RunState) string {
 		return "notes"
 	case domain.StateNotesReady:
 		return "approve"
+	case domain.StateChangesRequested:
+		return "notes"
 	case domain.StateApproved:
+		if approvalExpired {
+			return "approve"
+		}
 		return "publish"
 	case domain.StatePublishing:
 		return "wait"
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RunDiff is a structured comparison of two release runs.
+type RunDiff struct {
+	RunA RunID `json:"run_a"`
+	RunB RunID `json:"run_b"`
+
+	// Fields compares the runs' summary, policy and approval facts.
+	Fields []FieldDiff `json:"fields"`
+
+	// OnlyInA and OnlyInB list the commits present in one run but not the other.
+	OnlyInA       []CommitSHA `json:"only_in_a"`
+	OnlyInB       []CommitSHA `json:"only_in_b"`
+	CommonCommits int         `json:"common_commits"`
+
+	// Steps compares step outcomes, in plan order.
+	Steps []StepDiff `json:"steps"`
+}
+
+// FieldDiff compares a single fact of two runs.
+type FieldDiff struct {
+	Field   string `json:"field"`
+	A       string `json:"a"`
+	B       string `json:"b"`
+	Changed bool   `json:"changed"`
+}
+
+// StepDiff compares the outcome of a step in two runs.
+// A state is empty when the step is not part of that run's plan.
+type StepDiff struct {
+	Step    string    `json:"step"`
+	A       StepState `json:"a,omitempty"`
+	B       StepState `json:"b,omitempty"`
+	ErrorA  string    `json:"error_a,omitempty"`
+	ErrorB  string    `json:"error_b,omitempty"`
+	Changed bool      `json:"changed"`
+}
+
+// HasChanges reports whether the runs differ in any compared fact.
+func (d *RunDiff) HasChanges() bool {
+	if len(d.OnlyInA) > 0 || len(d.OnlyInB) > 0 {
+		return true
+	}
+	for _, f := range d.Fields {
+		if f.Changed {
+			return true
+		}
+	}
+	for _, s := range d.Steps {
+		if s.Changed {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareRuns compares two release runs, typically a failed run and its retry.
+func CompareRuns(a, b *ReleaseRun) RunDiff {
+	sa, sb := a.Summary(), b.Summary()
+	ta, tb := a.Thresholds(), b.Thresholds()
+
+	diff := RunDiff{RunA: a.id, RunB: b.id}
+	field := func(name, va, vb string) {
+		diff.Fields = append(diff.Fields, FieldDiff{Field: name, A: va, B: vb, Changed: va != vb})
+	}
+
+	field("state", string(sa.State), string(sb.State))
+	field("head_sha", string(sa.HeadSHA), string(sb.HeadSHA))
+	field("version_current", sa.VersionCurrent, sb.VersionCurrent)
+	field("version_next", sa.VersionNext, sb.VersionNext)
+	field("bump_kind", string(sa.BumpKind), string(sb.BumpKind))
+	field("tag_name", a.tagName, b.tagName)
+	field("commit_count", strconv.Itoa(sa.CommitCount), strconv.Itoa(sb.CommitCount))
+	field("risk_score", formatScore(sa.RiskScore), formatScore(sb.RiskScore))
+	field("thresholds.auto_approve", formatScore(ta.AutoApproveRiskThreshold), formatScore(tb.AutoApproveRiskThreshold))
+	field("thresholds.require_approval_above", formatScore(ta.RequireApprovalAbove), formatScore(tb.RequireApprovalAbove))
+	field("thresholds.block_release_above", formatScore(ta.BlockReleaseAbove), formatScore(tb.BlockReleaseAbove))
+	field("approval", describeApproval(a.approval), describeApproval(b.approval))
+	field("steps_done", fmt.Sprintf("%d/%d", sa.StepsDone, sa.StepsTotal), fmt.Sprintf("%d/%d", sb.StepsDone, sb.StepsTotal))
+	field("steps_failed", strconv.Itoa(sa.StepsFailed), strconv.Itoa(sb.StepsFailed))
+	field("last_error", a.lastError, b.lastError)
+
+	diff.OnlyInA, diff.OnlyInB, diff.CommonCommits = diffCommits(a.commits, b.commits)
+	diff.Steps = diffSteps(a, b)
+
+	return diff
+}
+
+// diffCommits returns the commits only in a, only in b, and the number in both.
+func diffCommits(a, b []CommitSHA) (onlyA, onlyB []CommitSHA, common int) {
+	inA := make(map[CommitSHA]bool, len(a))
+	for _, c := range a {
+		inA[c] = true
+	}
+	inB := make(map[CommitSHA]bool, len(b))
+	for _, c := range b {
+		inB[c] = true
+	}
+
+	onlyA, onlyB = []CommitSHA{}, []CommitSHA{}
+	for _, c := range a {
+		if inB[c] {
+			common++
+		} else {
+			onlyA = append(onlyA, c)
+		}
+	}
+	for _, c := range b {
+		if !inA[c] {
+			onlyB = append(onlyB, c)
+		}
+	}
+	return onlyA, onlyB, common
+}
+
+// diffSteps compares step outcomes in a's plan order, followed by steps only in b.
+func diffSteps(a, b *ReleaseRun) []StepDiff {
+	seen := make(map[string]bool)
+	var names []string
+	for _, run := range []*ReleaseRun{a, b} {
+		for _, step := range run.steps {
+			if !seen[step.Name] {
+				seen[step.Name] = true
+				names = append(names, step.Name)
+			}
+		}
+	}
+
+	steps := make([]StepDiff, 0, len(names))
+	for _, name := range names {
+		d := StepDiff{Step: name}
+		d.A, d.ErrorA = stepOutcome(a, name)
+		d.B, d.ErrorB = stepOutcome(b, name)
+		d.Changed = d.A != d.B
+		steps = append(steps, d)
+	}
+	return steps
+}
+
+// stepOutcome returns a step's state and last error in run. The state is
+// empty when the step is not planned in run.
+func stepOutcome(run *ReleaseRun, name string) (StepState, string) {
+	planned := false
+	for _, step := range run.steps {
+		if step.Name == name {
+			planned = true
+			break
+		}
+	}
+	if !planned {
+		return "", ""
+	}
+	if status := run.stepStatus[name]; status != nil {
+		return status.State, status.LastError
+	}
+	return StepPending, ""
+}
+
+// describeApproval renders an approval for comparison.
+func describeApproval(a *Approval) string {
+	switch {
+	case a == nil:
+		return "none"
+	case a.AutoApproved:
+		return "auto"
+	case a.Level != "":
+		return fmt.Sprintf("%s (%s)", a.ApprovedBy, a.Level)
+	default:
+		return a.ApprovedBy
+	}
+}
+
+func formatScore(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
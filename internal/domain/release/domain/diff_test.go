@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompareRuns(t *testing.T) {
+	failed := NewReleaseRun("github.com/test/repo", "/path/to/repo", "v1.0.0", "ccc333",
+		[]CommitSHA{"aaa111", "bbb222"}, "config-hash", "plugin-hash")
+	failed.SetPolicyEvaluation(0.4, nil, PolicyThresholds{AutoApproveRiskThreshold: 0.3, BlockReleaseAbove: 0.9})
+	failed.SetExecutionPlan([]StepPlan{{Name: "tag", Type: StepTypeTag}, {Name: "github", Type: StepTypePlugin}})
+	failed.stepStatus["tag"] = &StepStatus{State: StepDone}
+	failed.stepStatus["github"] = &StepStatus{State: StepFailed, LastError: "rate limited"}
+
+	retry := NewReleaseRun("github.com/test/repo", "/path/to/repo", "v1.0.0", "ddd444",
+		[]CommitSHA{"bbb222", "ccc333", "ddd444"}, "config-hash", "plugin-hash")
+	retry.SetPolicyEvaluation(0.4, nil, PolicyThresholds{AutoApproveRiskThreshold: 0.5, BlockReleaseAbove: 0.9})
+	retry.approval = &Approval{ApprovedBy: "alice", Level: ApprovalLevelRelease}
+	retry.SetExecutionPlan([]StepPlan{{Name: "tag", Type: StepTypeTag}, {Name: "github", Type: StepTypePlugin}, {Name: "slack", Type: StepTypeNotify}})
+	retry.stepStatus["tag"] = &StepStatus{State: StepDone}
+	retry.stepStatus["github"] = &StepStatus{State: StepDone}
+
+	diff := CompareRuns(failed, retry)
+
+	if !reflect.DeepEqual(diff.OnlyInA, []CommitSHA{"aaa111"}) {
+		t.Errorf("OnlyInA = %v, want [aaa111]", diff.OnlyInA)
+	}
+	if !reflect.DeepEqual(diff.OnlyInB, []CommitSHA{"ccc333", "ddd444"}) {
+		t.Errorf("OnlyInB = %v, want [ccc333 ddd444]", diff.OnlyInB)
+	}
+	if diff.CommonCommits != 1 {
+		t.Errorf("CommonCommits = %d, want 1", diff.CommonCommits)
+	}
+
+	changed := make(map[string]FieldDiff)
+	for _, f := range diff.Fields {
+		if f.Changed {
+			changed[f.Field] = f
+		}
+	}
+	for _, field := range []string{"head_sha", "commit_count", "thresholds.auto_approve", "approval", "steps_failed"} {
+		if _, ok := changed[field]; !ok {
+			t.Errorf("expected %s to differ", field)
+		}
+	}
+	for _, field := range []string{"risk_score", "thresholds.block_release_above"} {
+		if _, ok := changed[field]; ok {
+			t.Errorf("%s should not differ", field)
+		}
+	}
+	if got := changed["approval"]; got.A != "none" || got.B != "alice (release)" {
+		t.Errorf("approval = %q -> %q, want none -> alice (release)", got.A, got.B)
+	}
+
+	wantSteps := []StepDiff{
+		{Step: "tag", A: StepDone, B: StepDone},
+		{Step: "github", A: StepFailed, B: StepDone, ErrorA: "rate limited", Changed: true},
+		{Step: "slack", B: StepPending, Changed: true},
+	}
+	if !reflect.DeepEqual(diff.Steps, wantSteps) {
+		t.Errorf("Steps = %+v, want %+v", diff.Steps, wantSteps)
+	}
+	if !diff.HasChanges() {
+		t.Error("HasChanges() = false, want true")
+	}
+}
+
+func TestCompareRuns_Identical(t *testing.T) {
+	run := newPublishingRun()
+	_ = run.MarkStepFailed("tag", errors.New("boom"))
+
+	diff := CompareRuns(run, run)
+	if diff.HasChanges() {
+		t.Errorf("HasChanges() = true for identical runs: %+v", diff)
+	}
+	if diff.CommonCommits != len(run.Commits()) {
+		t.Errorf("CommonCommits = %d, want %d", diff.CommonCommits, len(run.Commits()))
+	}
+}
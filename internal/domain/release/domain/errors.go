@@ -35,12 +35,25 @@ var (
 	// ErrApprovalBoundToHash indicates approval is bound to a different plan hash.
 	ErrApprovalBoundToHash = errors.New("approval is bound to a different plan hash")
 
+	// ErrApprovalExpired indicates the approval is older than the configured approval TTL.
+	ErrApprovalExpired = errors.New("approval has expired")
+
+	// ErrPrePublishRejected indicates a pre-publish check vetoed the release.
+	ErrPrePublishRejected = errors.New("pre-publish validation failed")
+
 	// ErrNilNotes indicates nil release notes were provided.
 	ErrNilNotes = errors.New("release notes cannot be nil")
 
 	// ErrNoChanges indicates there are no changes to release.
 	ErrNoChanges = errors.New("no changes to release")
 
+	// ErrRejectionReasonRequired indicates a rejection was attempted without a reason.
+	ErrRejectionReasonRequired = errors.New("a reason is required to reject a release")
+
+	// ErrApproverAlreadyApproved indicates an approver tried to grant a second
+	// level of a multi-level approval.
+	ErrApproverAlreadyApproved = errors.New("approver has already granted another approval level")
+
 	// ErrCannotCancel indicates the release cannot be canceled.
 	ErrCannotCancel = errors.New("release cannot be canceled in current state")
 
@@ -55,6 +68,15 @@ var (
 
 	// ErrDuplicateRun indicates a run with the same plan hash already exists.
 	ErrDuplicateRun = errors.New("a release run with this plan already exists")
+
+	// ErrCommitNotInRange indicates an excluded commit is not part of the release range.
+	ErrCommitNotInRange = errors.New("commit is not within the release range")
+
+	// ErrAmbiguousCommit indicates a short SHA matches more than one commit.
+	ErrAmbiguousCommit = errors.New("commit SHA is ambiguous")
+
+	// ErrUnknownNotesPreset indicates an audience or tone preset that is not supported.
+	ErrUnknownNotesPreset = errors.New("unknown release notes preset")
 )
 
 // StateTransitionError provides a detailed error message for invalid state transitions.
@@ -86,7 +108,7 @@ func (e *StateTransitionError) getGuidance() string {
 		switch e.CurrentState {
 		case StateDraft:
 			return "Run 'relicta plan' first to analyze changes."
-		case StateVersioned, StateNotesReady, StateApproved, StatePublished:
+		case StateVersioned, StateNotesReady, StateChangesRequested, StateApproved, StatePublished:
 			return "Version is already set. Use 'relicta release' for a new release."
 		case StateFailed:
 			return "Release failed. Use 'relicta retry' or start a new release."
@@ -99,11 +121,22 @@ func (e *StateTransitionError) getGuidance() string {
 			return "Run 'relicta plan' then 'relicta bump' first."
 		case StatePlanned:
 			return "Run 'relicta bump' first to set the version."
-		case StateNotesReady, StateApproved, StatePublished:
+		case StateNotesReady:
+			return "Notes are already generated. Use 'relicta notes --regenerate' to replace them."
+		case StateChangesRequested:
+			return "Changes were requested. Use 'relicta notes --regenerate' or 'relicta approve --edit' to revise the notes."
+		case StateApproved, StatePublished:
 			return "Notes are already generated. Use 'relicta release' for a new release."
 		case StateFailed:
 			return "Release failed. Use 'relicta retry' or start a new release."
 		}
+	case "regenerate notes":
+		switch e.CurrentState {
+		case StateVersioned:
+			return "Run 'relicta notes' without --regenerate to generate the first notes."
+		default:
+			return "Notes can only be regenerated in 'notes_ready' or 'changes_requested' state before approval."
+		}
 	case "approve":
 		switch e.CurrentState {
 		case StateDraft:
@@ -112,6 +145,8 @@ func (e *StateTransitionError) getGuidance() string {
 			return "Run 'relicta bump' and 'relicta notes' first."
 		case StateVersioned:
 			return "Run 'relicta notes' first to generate release notes."
+		case StateChangesRequested:
+			return "Changes were requested. Revise the notes with 'relicta notes --regenerate' or 'relicta approve --edit'."
 		case StateApproved:
 			return "Release is already approved. Ready to publish."
 		case StatePublished:
@@ -131,6 +166,8 @@ func (e *StateTransitionError) getGuidance() string {
 			return "Run 'relicta notes' and 'relicta approve' first."
 		case StateNotesReady:
 			return "Run 'relicta approve' first to approve the release."
+		case StateChangesRequested:
+			return "Changes were requested. Revise the notes and run 'relicta approve' again."
 		case StatePublishing:
 			return "Release is already being published."
 		case StatePublished:
@@ -152,12 +189,21 @@ func (e *StateTransitionError) getGuidance() string {
 			return "Release is already canceled."
 		}
 	case "update notes":
-		if e.CurrentState != StateNotesReady {
-			return "Notes can only be updated in 'notes_ready' state before approval."
+		if e.CurrentState != StateNotesReady && e.CurrentState != StateChangesRequested {
+			return "Notes can only be updated in 'notes_ready' or 'changes_requested' state before approval."
+		}
+	case "reject":
+		switch e.CurrentState {
+		case StateChangesRequested:
+			return "Changes were already requested. Revise the notes to resubmit them."
+		case StateApproved:
+			return "Release is already approved. Use 'relicta cancel' to stop it."
+		default:
+			return "Only releases in 'notes_ready' state can be rejected. Run 'relicta notes' first."
 		}
 	case "plan":
 		switch e.CurrentState {
-		case StateVersioned, StateNotesReady, StateApproved, StatePublishing, StatePublished:
+		case StateVersioned, StateNotesReady, StateChangesRequested, StateApproved, StatePublishing, StatePublished:
 			return "Release has progressed past planning. Use 'relicta release' for a new release."
 		case StateFailed:
 			return "Release failed. Use 'relicta retry' or start a new release."
@@ -50,6 +50,19 @@ type RunApprovedEvent struct {
 func (e *RunApprovedEvent) EventName() string     { return "run.approved" }
 func (e *RunApprovedEvent) OccurredAt() time.Time { return e.At }
 
+// RunRejectedEvent is emitted when a run's release notes are rejected and
+// changes are requested.
+type RunRejectedEvent struct {
+	RunID      RunID
+	PlanHash   string
+	Reason     string
+	RejectedBy string
+	At         time.Time
+}
+
+func (e *RunRejectedEvent) EventName() string     { return "run.rejected" }
+func (e *RunRejectedEvent) OccurredAt() time.Time { return e.At }
+
 // StepCompletedEvent is emitted when a publishing step completes.
 type StepCompletedEvent struct {
 	RunID    RunID
@@ -64,9 +77,10 @@ func (e *StepCompletedEvent) OccurredAt() time.Time { return e.At }
 
 // RunPublishedEvent is emitted when a run is successfully published.
 type RunPublishedEvent struct {
-	RunID   RunID
-	Version version.SemanticVersion
-	At      time.Time
+	RunID    RunID
+	Version  version.SemanticVersion
+	Manifest PublishManifest
+	At       time.Time
 }
 
 func (e *RunPublishedEvent) EventName() string     { return "run.published" }
@@ -199,6 +213,7 @@ func (e *TagPushModeDetectedEvent) OccurredAt() time.Time { return e.At }
 func (e *RunCreatedEvent) AggregateID() RunID           { return e.RunID }
 func (e *StateTransitionedEvent) AggregateID() RunID    { return e.RunID }
 func (e *RunApprovedEvent) AggregateID() RunID          { return e.RunID }
+func (e *RunRejectedEvent) AggregateID() RunID          { return e.RunID }
 func (e *StepCompletedEvent) AggregateID() RunID        { return e.RunID }
 func (e *RunPublishedEvent) AggregateID() RunID         { return e.RunID }
 func (e *RunFailedEvent) AggregateID() RunID            { return e.RunID }
@@ -23,6 +23,8 @@ const (
 	EventBump          statekit.EventType = "BUMP"
 	EventGenerateNotes statekit.EventType = "GENERATE_NOTES"
 	EventApprove       statekit.EventType = "APPROVE"
+	EventReject        statekit.EventType = "REJECT"
+	EventResubmit      statekit.EventType = "RESUBMIT"
 	EventStartPublish  statekit.EventType = "START_PUBLISH"
 	EventStepOK        statekit.EventType = "STEP_OK"
 	EventStepFail      statekit.EventType = "STEP_FAIL"
@@ -30,6 +32,7 @@ const (
 	EventRetryPublish  statekit.EventType = "RETRY_PUBLISH"
 	EventCancel        statekit.EventType = "CANCEL"
 	EventFail          statekit.EventType = "FAIL"
+	EventRollback      statekit.EventType = "ROLLBACK"
 )
 
 // Guard names for the state machine.
@@ -41,15 +44,16 @@ const (
 
 // State IDs for the state machine.
 var (
-	StateIDDraft      statekit.StateID = statekit.StateID(StateDraft)
-	StateIDPlanned    statekit.StateID = statekit.StateID(StatePlanned)
-	StateIDVersioned  statekit.StateID = statekit.StateID(StateVersioned)
-	StateIDNotesReady statekit.StateID = statekit.StateID(StateNotesReady)
-	StateIDApproved   statekit.StateID = statekit.StateID(StateApproved)
-	StateIDPublishing statekit.StateID = statekit.StateID(StatePublishing)
-	StateIDPublished  statekit.StateID = statekit.StateID(StatePublished)
-	StateIDFailed     statekit.StateID = statekit.StateID(StateFailed)
-	StateIDCanceled   statekit.StateID = statekit.StateID(StateCanceled)
+	StateIDDraft            statekit.StateID = statekit.StateID(StateDraft)
+	StateIDPlanned          statekit.StateID = statekit.StateID(StatePlanned)
+	StateIDVersioned        statekit.StateID = statekit.StateID(StateVersioned)
+	StateIDNotesReady       statekit.StateID = statekit.StateID(StateNotesReady)
+	StateIDChangesRequested statekit.StateID = statekit.StateID(StateChangesRequested)
+	StateIDApproved         statekit.StateID = statekit.StateID(StateApproved)
+	StateIDPublishing       statekit.StateID = statekit.StateID(StatePublishing)
+	StateIDPublished        statekit.StateID = statekit.StateID(StatePublished)
+	StateIDFailed           statekit.StateID = statekit.StateID(StateFailed)
+	StateIDCanceled         statekit.StateID = statekit.StateID(StateCanceled)
 )
 
 // ReleaseRunMachine wraps the Statekit state machine for release runs.
@@ -86,6 +90,12 @@ func NewReleaseRunMachine() (*ReleaseRunMachine, error) {
 		On(EventApprove).Target(StateIDApproved).Guard(GuardHeadMatches).
 		On(EventGenerateNotes).Target(StateIDNotesReady).Guard(GuardHeadMatches). // Regenerate notes
 		On(EventBump).Target(StateIDVersioned).Guard(GuardHeadMatches).           // Can go back to versioned
+		On(EventReject).Target(StateIDChangesRequested).
+		On(EventCancel).Target(StateIDCanceled).
+		Done().
+		// ChangesRequested state (notes rejected, awaiting revision)
+		State(StateIDChangesRequested).
+		On(EventResubmit).Target(StateIDNotesReady).Guard(GuardHeadMatches).
 		On(EventCancel).Target(StateIDCanceled).
 		Done().
 		// Approved state
@@ -99,9 +109,9 @@ func NewReleaseRunMachine() (*ReleaseRunMachine, error) {
 		On(EventStepFail).Target(StateIDFailed).   // Step failed
 		On(EventPublishDone).Target(StateIDPublished).Guard(GuardAllStepsSucceeded).
 		Done().
-		// Published state (terminal)
+		// Published state (terminal unless rolled back)
 		State(StateIDPublished).
-		Final().
+		On(EventRollback).Target(StateIDFailed).
 		Done().
 		// Failed state
 		State(StateIDFailed).
@@ -233,9 +243,16 @@ func (m *ReleaseRunMachine) ExportXStateJSON() ([]byte, error) {
 					string(EventApprove):       {Target: string(StateApproved), Guard: string(GuardHeadMatches)},
 					string(EventGenerateNotes): {Target: string(StateNotesReady), Guard: string(GuardHeadMatches)},
 					string(EventBump):          {Target: string(StateVersioned), Guard: string(GuardHeadMatches)},
+					string(EventReject):        {Target: string(StateChangesRequested)},
 					string(EventCancel):        {Target: string(StateCanceled)},
 				},
 			},
+			string(StateChangesRequested): {
+				On: map[string]XStateTransition{
+					string(EventResubmit): {Target: string(StateNotesReady), Guard: string(GuardHeadMatches)},
+					string(EventCancel):   {Target: string(StateCanceled)},
+				},
+			},
 			string(StateApproved): {
 				On: map[string]XStateTransition{
 					string(EventStartPublish): {Target: string(StatePublishing), Guard: string(GuardHeadMatches)},
@@ -250,7 +267,9 @@ func (m *ReleaseRunMachine) ExportXStateJSON() ([]byte, error) {
 				},
 			},
 			string(StatePublished): {
-				Type: "final",
+				On: map[string]XStateTransition{
+					string(EventRollback): {Target: string(StateFailed)},
+				},
 			},
 			string(StateFailed): {
 				On: map[string]XStateTransition{
@@ -302,7 +321,7 @@ func ValidateTransition(run *ReleaseRun, event statekit.EventType, currentHead C
 
 	// Check guards based on event
 	switch event {
-	case EventBump, EventGenerateNotes, EventApprove, EventStartPublish:
+	case EventBump, EventGenerateNotes, EventResubmit, EventApprove, EventStartPublish:
 		if !guardHeadMatches(ctx, statekit.Event{}) {
 			return fmt.Errorf("%w: expected %s, got %s", ErrHeadSHAChanged, run.HeadSHA().Short(), currentHead.Short())
 		}
@@ -319,6 +338,10 @@ func ValidateTransition(run *ReleaseRun, event statekit.EventType, currentHead C
 		targetState = StateNotesReady
 	case EventApprove:
 		targetState = StateApproved
+	case EventReject:
+		targetState = StateChangesRequested
+	case EventResubmit:
+		targetState = StateNotesReady
 	case EventStartPublish:
 		targetState = StatePublishing
 	case EventRetryPublish:
@@ -386,6 +409,11 @@ func (s *StateMachineService) ValidateAndTransition(
 		return nil
 	case EventApprove:
 		return run.Approve(actor, false)
+	case EventReject:
+		return run.Reject("Rejected by user", actor)
+	case EventResubmit:
+		// Revised notes are set separately, this just validates the transition is possible
+		return nil
 	case EventStartPublish:
 		return run.StartPublishing(actor)
 	case EventRetryPublish:
@@ -109,14 +109,14 @@ func TestReleaseRunMachine_ExportXStateJSON(t *testing.T) {
 	if xstate.Initial != "draft" {
 		t.Errorf("XState Initial = %v, want draft", xstate.Initial)
 	}
-	if len(xstate.States) != 9 {
-		t.Errorf("XState States count = %d, want 9", len(xstate.States))
+	if len(xstate.States) != 10 {
+		t.Errorf("XState States count = %d, want 10", len(xstate.States))
 	}
 
 	// Verify terminal states have correct type
 	publishedState := xstate.States["published"]
-	if publishedState.Type != "final" {
-		t.Errorf("Published state type = %v, want final", publishedState.Type)
+	if got := publishedState.On["ROLLBACK"].Target; got != "failed" {
+		t.Errorf("Published ROLLBACK target = %v, want failed", got)
 	}
 	canceledState := xstate.States["canceled"]
 	if canceledState.Type != "final" {
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// PublishManifestSchemaVersion is the schema version of PublishManifest.
+// Bump it when fields are removed or change meaning.
+const PublishManifestSchemaVersion = "1"
+
+// PublishManifest describes everything a run published: the release
+// identity and the outcome of every publish step.
+type PublishManifest struct {
+	SchemaVersion string          `json:"schema_version"`
+	RunID         RunID           `json:"run_id"`
+	Version       string          `json:"version"`
+	TagName       string          `json:"tag_name"`
+	HeadSHA       CommitSHA       `json:"head_sha"`
+	PlanHash      string          `json:"plan_hash"`
+	PublishedAt   *time.Time      `json:"published_at,omitempty"`
+	Targets       []PublishTarget `json:"targets"`
+}
+
+// PublishTarget is the outcome of a single publish step.
+type PublishTarget struct {
+	Step        string     `json:"step"`
+	Type        StepType   `json:"type"`
+	Plugin      string     `json:"plugin,omitempty"`
+	Package     string     `json:"package,omitempty"`
+	TagName     string     `json:"tag_name,omitempty"`
+	State       StepState  `json:"state"`
+	Output      string     `json:"output,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// PublishManifest builds the manifest for the run's publish steps in plan order.
+func (r *ReleaseRun) PublishManifest() PublishManifest {
+	m := PublishManifest{
+		SchemaVersion: PublishManifestSchemaVersion,
+		RunID:         r.id,
+		Version:       r.versionNext.String(),
+		TagName:       r.tagName,
+		HeadSHA:       r.headSHA,
+		PlanHash:      r.planHash,
+		PublishedAt:   r.publishedAt,
+		Targets:       make([]PublishTarget, 0, len(r.steps)),
+	}
+
+	for _, step := range r.steps {
+		target := PublishTarget{
+			Step:    step.Name,
+			Type:    step.Type,
+			Plugin:  step.PluginName,
+			Package: step.Package,
+			TagName: step.TagName,
+			State:   StepPending,
+		}
+		if status := r.stepStatus[step.Name]; status != nil {
+			target.State = status.State
+			target.Output = status.Output
+			target.CompletedAt = status.CompletedAt
+		}
+		m.Targets = append(m.Targets, target)
+	}
+
+	return m
+}
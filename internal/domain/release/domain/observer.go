@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"sync"
+	"time"
+)
+
+// TransitionObserver is notified of every state transition recorded on a run.
+// Observers receive the same records that are appended to History, so
+// metrics derived from them stay consistent with the audit trail.
+type TransitionObserver interface {
+	// ObserveTransition is called after a transition of run runID is
+	// recorded. inState is the time the run spent in record.From before the
+	// transition.
+	ObserveTransition(repoID string, runID RunID, record TransitionRecord, inState time.Duration)
+}
+
+// TransitionObserverFunc adapts a function to a TransitionObserver.
+type TransitionObserverFunc func(repoID string, runID RunID, record TransitionRecord, inState time.Duration)
+
+// ObserveTransition calls f.
+func (f TransitionObserverFunc) ObserveTransition(repoID string, runID RunID, record TransitionRecord, inState time.Duration) {
+	f(repoID, runID, record, inState)
+}
+
+var (
+	observerMu sync.RWMutex
+	observer   TransitionObserver
+)
+
+// SetTransitionObserver installs the observer notified of run transitions.
+// Passing nil removes it.
+func SetTransitionObserver(o TransitionObserver) {
+	observerMu.Lock()
+	defer observerMu.Unlock()
+	observer = o
+}
+
+// notifyTransition passes a recorded transition to the installed observer.
+func notifyTransition(repoID string, runID RunID, record TransitionRecord, inState time.Duration) {
+	observerMu.RLock()
+	o := observer
+	observerMu.RUnlock()
+	if o != nil {
+		o.ObserveTransition(repoID, runID, record, inState)
+	}
+}
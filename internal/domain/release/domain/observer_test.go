@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetTransitionObserver(t *testing.T) {
+	type observed struct {
+		repoID  string
+		runID   RunID
+		record  TransitionRecord
+		inState time.Duration
+	}
+	var got []observed
+	SetTransitionObserver(TransitionObserverFunc(func(repoID string, runID RunID, record TransitionRecord, inState time.Duration) {
+		got = append(got, observed{repoID, runID, record, inState})
+	}))
+	t.Cleanup(func() { SetTransitionObserver(nil) })
+
+	run := newTestRun()
+	if err := run.Plan("test-actor"); err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if err := run.Cancel("no longer needed", "test-actor"); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+
+	history := run.History()
+	if len(got) != len(history) {
+		t.Fatalf("observed %d transitions, history has %d", len(got), len(history))
+	}
+	for i, o := range got {
+		if o.repoID != run.RepoID() || o.runID != run.ID() {
+			t.Errorf("transition %d of %q/%q, want %q/%q", i, o.repoID, o.runID, run.RepoID(), run.ID())
+		}
+		if o.record.From != history[i].From || o.record.To != history[i].To {
+			t.Errorf("transition %d = %s→%s, history has %s→%s", i, o.record.From, o.record.To, history[i].From, history[i].To)
+		}
+		if o.inState < 0 {
+			t.Errorf("transition %d inState = %v, want non-negative", i, o.inState)
+		}
+	}
+	if got[0].record.From != StateDraft || got[0].record.To != StatePlanned {
+		t.Errorf("first transition = %s→%s, want draft→planned", got[0].record.From, got[0].record.To)
+	}
+	if got[len(got)-1].record.To != StateCanceled {
+		t.Errorf("last transition to = %s, want canceled", got[len(got)-1].record.To)
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Release notes presets. They are the tones and audiences accepted by the
+// ai.tone and ai.audience settings.
+const (
+	// DefaultNotesTone is the tone used when none is configured.
+	DefaultNotesTone = "professional"
+	// DefaultNotesAudience is the audience used when none is configured.
+	DefaultNotesAudience = "developers"
+)
+
+// NotesTones lists the tone presets for release notes.
+var NotesTones = []string{"technical", "friendly", "professional", "excited"}
+
+// NotesAudiences lists the audience presets for release notes.
+var NotesAudiences = []string{"developers", "users", "public", "marketing"}
+
+// Older spellings of the presets, still accepted.
+var (
+	notesToneAliases = map[string]string{
+		"casual":    "friendly",
+		"formal":    "professional",
+		"marketing": "excited",
+	}
+	notesAudienceAliases = map[string]string{
+		"developer": "developers",
+		"user":      "users",
+		"all":       "public",
+	}
+)
+
+// ResolveNotesTone returns the tone preset named by tone, resolving aliases.
+// An empty tone resolves to DefaultNotesTone.
+func ResolveNotesTone(tone string) (string, error) {
+	return resolveNotesPreset("tone", tone, DefaultNotesTone, NotesTones, notesToneAliases)
+}
+
+// ResolveNotesAudience returns the audience preset named by audience,
+// resolving aliases. An empty audience resolves to DefaultNotesAudience.
+func ResolveNotesAudience(audience string) (string, error) {
+	return resolveNotesPreset("audience", audience, DefaultNotesAudience, NotesAudiences, notesAudienceAliases)
+}
+
+func resolveNotesPreset(kind, name, def string, presets []string, aliases map[string]string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return def, nil
+	}
+	for _, preset := range presets {
+		if name == preset {
+			return preset, nil
+		}
+	}
+	if preset, ok := aliases[name]; ok {
+		return preset, nil
+	}
+	return "", fmt.Errorf("%w: %s %q (valid: %s)", ErrUnknownNotesPreset, kind, name, strings.Join(presets, ", "))
+}
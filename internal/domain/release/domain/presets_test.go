@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolveNotesPresets(t *testing.T) {
+	tests := []struct {
+		name    string
+		resolve func(string) (string, error)
+		preset  string
+		want    string
+		wantErr bool
+	}{
+		{"default tone", ResolveNotesTone, "", DefaultNotesTone, false},
+		{"tone", ResolveNotesTone, "technical", "technical", false},
+		{"tone alias", ResolveNotesTone, "marketing", "excited", false},
+		{"tone case", ResolveNotesTone, "Friendly", "friendly", false},
+		{"unknown tone", ResolveNotesTone, "sarcastic", "", true},
+		{"default audience", ResolveNotesAudience, "", DefaultNotesAudience, false},
+		{"audience", ResolveNotesAudience, "marketing", "marketing", false},
+		{"audience alias", ResolveNotesAudience, "user", "users", false},
+		{"unknown audience", ResolveNotesAudience, "stakeholders", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resolve(tt.preset)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnknownNotesPreset) {
+					t.Fatalf("error = %v, want ErrUnknownNotesPreset", err)
+				}
+				if !strings.Contains(err.Error(), "valid: ") {
+					t.Errorf("error %q does not list the valid presets", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -79,6 +79,7 @@ type ReleaseRun struct {
 	baseRef        string      // Base reference (tag or commit)
 	headSHA        CommitSHA   // Exact SHA pinned at plan time - IMMUTABLE after planning
 	commits        []CommitSHA // Explicit list of commit SHAs in this release
+	excluded       []CommitSHA // Commits explicitly excluded from the release range
 	configHash     string      // Hash of relevant config snapshot
 	pluginPlanHash string      // Hash of plugin configuration
 
@@ -105,6 +106,7 @@ type ReleaseRun struct {
 	// Approval
 	approval           *Approval
 	multiLevelApproval *MultiLevelApproval // Optional multi-level approval tracking
+	approvalTTL        time.Duration       // How long the next approval stays valid (transient, from config)
 
 	// Execution plan
 	steps      []StepPlan
@@ -144,6 +146,17 @@ const (
 	ApprovalLevelAuto      ApprovalLevel = "auto"      // Auto-approval (low risk)
 )
 
+// ParseApprovalLevel parses a human approval level name.
+func ParseApprovalLevel(s string) (ApprovalLevel, error) {
+	level := ApprovalLevel(strings.ToLower(strings.TrimSpace(s)))
+	switch level {
+	case ApprovalLevelTechnical, ApprovalLevelSecurity, ApprovalLevelManager, ApprovalLevelRelease:
+		return level, nil
+	default:
+		return "", fmt.Errorf("invalid approval level %q: must be technical, security, manager or release", s)
+	}
+}
+
 // Approval holds release approval information.
 type Approval struct {
 	ApprovedBy    string        // Who approved the release
@@ -154,6 +167,7 @@ type Approval struct {
 	ApproverType  ActorType     // Type of approver (human, ci, agent)
 	Justification string        // Optional justification for approval
 	Level         ApprovalLevel // The level of this approval
+	ExpiresAt     time.Time     // When the approval expires (zero = never)
 }
 
 // IsManual returns true if this was a manual approval.
@@ -175,6 +189,16 @@ type ApprovalPolicy struct {
 	Sequential   bool                  // If true, approvals must be in order
 }
 
+// Requirement returns the requirement for the given level, if the policy has one.
+func (p ApprovalPolicy) Requirement(level ApprovalLevel) (ApprovalRequirement, bool) {
+	for _, req := range p.Requirements {
+		if req.Level == level {
+			return req, true
+		}
+	}
+	return ApprovalRequirement{}, false
+}
+
 // MultiLevelApproval tracks multiple approvals for a release.
 type MultiLevelApproval struct {
 	Policy    ApprovalPolicy              // The approval policy in effect
@@ -297,6 +321,7 @@ func HighRiskApprovalPolicy() ApprovalPolicy {
 // ReleaseNotes holds the generated release notes.
 type ReleaseNotes struct {
 	Text           string
+	Highlights     []string
 	AudiencePreset string
 	TonePreset     string
 	Provider       string
@@ -325,7 +350,57 @@ type StepPlan struct {
 	IdempotencyKey string // Derived from run_id + step_name + config_hash
 	PluginName     string // For plugin steps
 	Hook           string // For plugin steps
+	Step           string // For plugin-contributed steps: the step name declared by the plugin
 	Unsafe         bool   // If true, requires explicit approval
+	Package        string // For per-package tag steps (monorepo)
+	TagName        string // For per-package tag steps (monorepo)
+}
+
+// PackageTag names the tag to create for a monorepo package.
+type PackageTag struct {
+	Package string
+	TagName string
+}
+
+// NewPackageTagSteps returns one tag step per package, preserving the order of
+// tags. Callers pass tags in dependency order so that upstream packages are
+// tagged before the packages that depend on them.
+func NewPackageTagSteps(tags []PackageTag) []StepPlan {
+	steps := make([]StepPlan, 0, len(tags))
+	for _, t := range tags {
+		steps = append(steps, StepPlan{
+			Name:    "create-tag:" + t.Package,
+			Type:    StepTypeTag,
+			Package: t.Package,
+			TagName: t.TagName,
+		})
+	}
+	return steps
+}
+
+// PluginStep is a publish step contributed by a plugin.
+type PluginStep struct {
+	Plugin      string
+	Name        string
+	Description string
+}
+
+// NewPluginSteps returns one plugin step per contributed step, preserving
+// order. Step names are prefixed with the plugin name so that steps of
+// different plugins never share a status entry.
+func NewPluginSteps(runID RunID, contributed []PluginStep) []StepPlan {
+	steps := make([]StepPlan, 0, len(contributed))
+	for _, c := range contributed {
+		name := c.Plugin + ":" + c.Name
+		steps = append(steps, StepPlan{
+			Name:           name,
+			Type:           StepTypePlugin,
+			IdempotencyKey: BuildIdempotencyKey(runID, name, ""),
+			PluginName:     c.Plugin,
+			Step:           c.Name,
+		})
+	}
+	return steps
 }
 
 // StepState represents the execution state of a step.
@@ -422,6 +497,15 @@ func (r *ReleaseRun) computePlanHash() string {
 		h.Write([]byte(c))
 	}
 
+	// Excluded commits are already sorted; only hashed when present so
+	// runs without exclusions keep their original plan hash.
+	if len(r.excluded) > 0 {
+		h.Write([]byte("excluded:"))
+		for _, c := range r.excluded {
+			h.Write([]byte(c))
+		}
+	}
+
 	h.Write([]byte(r.versionNext.String()))
 	h.Write([]byte(r.configHash))
 	h.Write([]byte(r.pluginPlanHash))
@@ -464,6 +548,11 @@ func (r *ReleaseRun) Commits() []CommitSHA {
 	return r.commits
 }
 
+// ExcludedCommits returns the commits explicitly excluded from this release.
+func (r *ReleaseRun) ExcludedCommits() []CommitSHA {
+	return r.excluded
+}
+
 // VersionCurrent returns the current version.
 func (r *ReleaseRun) VersionCurrent() version.SemanticVersion {
 	return r.versionCurrent
@@ -489,6 +578,11 @@ func (r *ReleaseRun) Reasons() []string {
 	return r.reasons
 }
 
+// Thresholds returns the policy thresholds captured at plan time.
+func (r *ReleaseRun) Thresholds() PolicyThresholds {
+	return r.thresholds
+}
+
 // ActorType returns the type of actor who initiated the release.
 func (r *ReleaseRun) ActorType() ActorType {
 	return r.actorType
@@ -609,7 +703,12 @@ func (r *ReleaseRun) addEvent(event DomainEvent) {
 
 // recordTransition records a state transition in history.
 func (r *ReleaseRun) recordTransition(from, to RunState, event, actor, reason string, metadata map[string]string) {
-	r.history = append(r.history, TransitionRecord{
+	enteredAt := r.createdAt
+	if n := len(r.history); n > 0 {
+		enteredAt = r.history[n-1].At
+	}
+
+	record := TransitionRecord{
 		At:       time.Now(),
 		From:     from,
 		To:       to,
@@ -617,8 +716,11 @@ func (r *ReleaseRun) recordTransition(from, to RunState, event, actor, reason st
 		Actor:    actor,
 		Reason:   reason,
 		Metadata: metadata,
-	})
+	}
+	r.history = append(r.history, record)
 	r.updatedAt = time.Now()
+
+	notifyTransition(r.repoID, r.id, record, record.At.Sub(enteredAt))
 }
 
 // SetVersionProposal sets the version proposal during planning.
@@ -661,6 +763,64 @@ func (r *ReleaseRun) SetChangesetID(id string) {
 	r.updatedAt = time.Now()
 }
 
+// SetExcludedCommits records commits that were explicitly excluded from the
+// release range. The commits must already be removed from the pinned commit
+// list (see ExcludeCommits). The exclusions are part of the plan hash.
+func (r *ReleaseRun) SetExcludedCommits(excluded []CommitSHA) error {
+	if r.state != StateDraft {
+		return fmt.Errorf("%w: cannot exclude commits in state %s", ErrInvalidState, r.state)
+	}
+
+	sorted := make([]CommitSHA, len(excluded))
+	copy(sorted, excluded)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	r.excluded = sorted
+
+	r.planHash = r.computePlanHash()
+	r.updatedAt = time.Now()
+	return nil
+}
+
+// ExcludeCommits removes the given commits from a commit range. Each SHA may be
+// a full or abbreviated hash and must match exactly one commit in the range.
+// It returns the remaining commits and the full SHAs of the excluded commits.
+func ExcludeCommits(commits []CommitSHA, shas []string) ([]CommitSHA, []CommitSHA, error) {
+	excludedSet := make(map[CommitSHA]bool, len(shas))
+	for _, sha := range shas {
+		sha = strings.ToLower(strings.TrimSpace(sha))
+		if sha == "" {
+			continue
+		}
+
+		var match CommitSHA
+		for _, c := range commits {
+			if strings.HasPrefix(strings.ToLower(string(c)), sha) {
+				if match != "" && match != c {
+					return nil, nil, fmt.Errorf("%w: %s", ErrAmbiguousCommit, sha)
+				}
+				match = c
+			}
+		}
+		if match == "" {
+			return nil, nil, fmt.Errorf("%w: %s", ErrCommitNotInRange, sha)
+		}
+		excludedSet[match] = true
+	}
+
+	remaining := make([]CommitSHA, 0, len(commits))
+	excluded := make([]CommitSHA, 0, len(excludedSet))
+	for _, c := range commits {
+		if excludedSet[c] {
+			excluded = append(excluded, c)
+			continue
+		}
+		remaining = append(remaining, c)
+	}
+	sort.Slice(excluded, func(i, j int) bool { return excluded[i] < excluded[j] })
+
+	return remaining, excluded, nil
+}
+
 // SetExecutionPlan sets the publishing execution plan.
 func (r *ReleaseRun) SetExecutionPlan(steps []StepPlan) {
 	r.steps = steps
@@ -705,7 +865,16 @@ func (r *ReleaseRun) Plan(actor string) error {
 		return NewStateTransitionError(r.state, "plan")
 	}
 
-	return r.TransitionTo(StatePlanned, "PLAN", actor, "Release planned", nil)
+	var metadata map[string]string
+	if len(r.excluded) > 0 {
+		shas := make([]string, len(r.excluded))
+		for i, c := range r.excluded {
+			shas[i] = string(c)
+		}
+		metadata = map[string]string{"excluded_commits": strings.Join(shas, ",")}
+	}
+
+	return r.TransitionTo(StatePlanned, "PLAN", actor, "Release planned", metadata)
 }
 
 // SetVersion sets the calculated version for the release.
@@ -781,7 +950,7 @@ func (r *ReleaseRun) GenerateNotes(notes *ReleaseNotes, inputsHash, actor string
 // Approve approves the release and transitions to Approved state.
 // The approval is bound to the current plan hash.
 func (r *ReleaseRun) Approve(actor string, autoApproved bool) error {
-	if r.state != StateNotesReady {
+	if !r.CanApprove() {
 		return NewStateTransitionError(r.state, "approve")
 	}
 
@@ -790,12 +959,12 @@ func (r *ReleaseRun) Approve(actor string, autoApproved bool) error {
 
 // ApproveWithOptions approves the release with additional options.
 func (r *ReleaseRun) ApproveWithOptions(actor string, autoApproved bool, approverType ActorType, justification string) error {
-	if r.state != StateNotesReady {
+	now := time.Now()
+	reapproval := r.state == StateApproved && r.IsApprovalExpired(now)
+	if r.state != StateNotesReady && !reapproval {
 		return NewStateTransitionError(r.state, "approve")
 	}
 
-	now := time.Now()
-
 	// Create the approval record
 	r.approval = &Approval{
 		ApprovedBy:    actor,
@@ -805,6 +974,7 @@ func (r *ReleaseRun) ApproveWithOptions(actor string, autoApproved bool, approve
 		RiskScore:     r.riskScore,
 		ApproverType:  approverType,
 		Justification: justification,
+		ExpiresAt:     r.approvalExpiry(now),
 	}
 
 	metadata := map[string]string{
@@ -821,6 +991,13 @@ func (r *ReleaseRun) ApproveWithOptions(actor string, autoApproved bool, approve
 		At:           now,
 	})
 
+	if reapproval {
+		// Renewing an expired approval keeps the run in Approved state
+		r.updatedAt = now
+		r.recordTransition(StateApproved, StateApproved, "REAPPROVE", actor, "Expired approval renewed", metadata)
+		return nil
+	}
+
 	return r.TransitionTo(StateApproved, "APPROVE", actor, "Release approved", metadata)
 }
 
@@ -839,6 +1016,8 @@ func (r *ReleaseRun) Approval() *Approval {
 		RiskScore:     r.approval.RiskScore,
 		ApproverType:  r.approval.ApproverType,
 		Justification: r.approval.Justification,
+		Level:         r.approval.Level,
+		ExpiresAt:     r.approval.ExpiresAt,
 	}
 }
 
@@ -863,6 +1042,12 @@ func (r *ReleaseRun) ApprovalStatus() ApprovalStatus {
 			Reason:     "Release is ready for approval",
 		}
 	case StateApproved:
+		if r.IsApprovalExpired(time.Now()) {
+			return ApprovalStatus{
+				CanApprove: true,
+				Reason:     "Approval has expired; re-approval is required",
+			}
+		}
 		return ApprovalStatus{
 			CanApprove: false,
 			Reason:     "Release is already approved",
@@ -872,6 +1057,11 @@ func (r *ReleaseRun) ApprovalStatus() ApprovalStatus {
 			CanApprove: false,
 			Reason:     "Release has already progressed past approval",
 		}
+	case StateChangesRequested:
+		return ApprovalStatus{
+			CanApprove: false,
+			Reason:     "Changes were requested; revise the notes to resubmit them for approval",
+		}
 	case StateFailed, StateCanceled:
 		return ApprovalStatus{
 			CanApprove: false,
@@ -885,14 +1075,103 @@ func (r *ReleaseRun) ApprovalStatus() ApprovalStatus {
 	}
 }
 
+// NextAction returns the canonical next step in the release workflow and the
+// CLI command that performs it.
+// MCP tools share the CLI command names (e.g. "relicta notes" → relicta.notes).
+func (r *ReleaseRun) NextAction() (action string, command string) {
+	switch r.state {
+	case StateDraft:
+		return "plan release", "relicta plan"
+	case StatePlanned:
+		return "bump version", "relicta bump"
+	case StateVersioned:
+		return "generate notes", "relicta notes"
+	case StateNotesReady:
+		if r.multiLevelApproval != nil {
+			if pending := r.multiLevelApproval.PendingApprovals(); len(pending) > 0 && len(r.multiLevelApproval.Approvals) > 0 {
+				levels := make([]string, len(pending))
+				for i, req := range pending {
+					levels[i] = string(req.Level)
+				}
+				return "collect remaining approvals (" + strings.Join(levels, ", ") + ")", "relicta approve"
+			}
+		}
+		return "approve release", "relicta approve"
+	case StateChangesRequested:
+		return "revise release notes", "relicta notes --regenerate"
+	case StateApproved:
+		if r.IsApprovalExpired(time.Now()) {
+			return "re-approve expired approval", "relicta approve"
+		}
+		return "publish release", "relicta publish"
+	case StatePublishing:
+		return "wait for publishing to complete", "relicta status"
+	case StatePublished:
+		return "plan next release", "relicta plan"
+	case StateFailed:
+		return "reset failed release", "relicta reset"
+	case StateCanceled:
+		return "plan new release", "relicta plan"
+	default:
+		return "check status", "relicta status"
+	}
+}
+
 // CanApprove returns true if the release can be approved.
+// An approved release whose approval has expired can be approved again.
 func (r *ReleaseRun) CanApprove() bool {
-	return r.state == StateNotesReady
+	return r.state == StateNotesReady || (r.state == StateApproved && r.IsApprovalExpired(time.Now()))
 }
 
 // CanProceedToPublish returns true if the release can proceed to publishing.
+// Use ValidateApprovalNotExpired to get the reason when it cannot.
 func (r *ReleaseRun) CanProceedToPublish() bool {
-	return r.IsApproved() && r.state == StateApproved
+	return r.IsApproved() && r.state == StateApproved && !r.IsApprovalExpired(time.Now())
+}
+
+// SetApprovalTTL sets how long the next approval remains valid. Zero
+// disables expiry. The TTL comes from configuration; the resulting expiry is
+// recorded on the approval, so changing the TTL later does not extend or
+// void existing approvals.
+func (r *ReleaseRun) SetApprovalTTL(ttl time.Duration) {
+	r.approvalTTL = ttl
+}
+
+// ApprovalTTL returns how long the next approval remains valid (zero = no expiry).
+func (r *ReleaseRun) ApprovalTTL() time.Duration {
+	return r.approvalTTL
+}
+
+// approvalExpiry returns when an approval granted at approvedAt expires, or
+// the zero time when the approval TTL is not set.
+func (r *ReleaseRun) approvalExpiry(approvedAt time.Time) time.Time {
+	if r.approvalTTL <= 0 {
+		return time.Time{}
+	}
+	return approvedAt.Add(r.approvalTTL)
+}
+
+// IsApprovalExpired returns true if the approval expired before now.
+// Runs without an approval or an approval expiry never expire.
+func (r *ReleaseRun) IsApprovalExpired(now time.Time) bool {
+	if r.approval == nil || r.approval.ExpiresAt.IsZero() {
+		return false
+	}
+	return now.After(r.approval.ExpiresAt)
+}
+
+// ValidateApprovalNotExpired validates that the release is approved and the
+// approval is still within its TTL.
+// Returns ErrNotApproved if not approved, ErrApprovalExpired if expired.
+func (r *ReleaseRun) ValidateApprovalNotExpired(now time.Time) error {
+	if r.approval == nil {
+		return ErrNotApproved
+	}
+	if r.IsApprovalExpired(now) {
+		return fmt.Errorf("%w: approved by %s at %s, expired at %s; re-approve the release",
+			ErrApprovalExpired, r.approval.ApprovedBy, r.approval.ApprovedAt.Format(time.RFC3339), r.approval.ExpiresAt.Format(time.RFC3339))
+	}
+	return nil
 }
 
 // ValidateApprovalPlanHash validates that the approval is bound to the current plan hash.
@@ -941,6 +1220,13 @@ func (r *ReleaseRun) ApproveAtLevel(level ApprovalLevel, actor string, approverT
 		}
 	}
 
+	// Each level is a separate review, so one approver may grant only one level
+	for _, granted := range r.multiLevelApproval.AllApprovals() {
+		if granted.Level != level && granted.ApprovedBy == actor {
+			return fmt.Errorf("%w: %s granted %s approval", ErrApproverAlreadyApproved, actor, granted.Level)
+		}
+	}
+
 	// Create and grant the approval
 	approval := &Approval{
 		ApprovedBy:    actor,
@@ -990,7 +1276,11 @@ func (r *ReleaseRun) CompleteMultiLevelApproval(actor string) error {
 			finalApproval = allApprovals[len(allApprovals)-1]
 		}
 	}
-	r.approval = finalApproval
+	if finalApproval != nil {
+		approval := *finalApproval
+		approval.ExpiresAt = r.approvalExpiry(time.Now())
+		r.approval = &approval
+	}
 
 	metadata := map[string]string{
 		"plan_hash":       r.planHash,
@@ -1127,6 +1417,23 @@ func (r *ReleaseRun) MarkStepSkipped(stepName string, reason string) error {
 	return nil
 }
 
+// ResetStep returns a step to pending so it runs again on the next publish
+// (e.g., a tag step whose tag was rolled back).
+func (r *ReleaseRun) ResetStep(stepName string) error {
+	status, ok := r.stepStatus[stepName]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrStepNotFound, stepName)
+	}
+
+	status.State = StepPending
+	status.StartedAt = nil
+	status.CompletedAt = nil
+	status.Output = ""
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
 // AllStepsDone returns true if all steps are in a terminal state (done/skipped/failed).
 func (r *ReleaseRun) AllStepsDone() bool {
 	for _, status := range r.stepStatus {
@@ -1172,9 +1479,10 @@ func (r *ReleaseRun) MarkPublished(actor string) error {
 	r.publishedAt = &now
 
 	r.addEvent(&RunPublishedEvent{
-		RunID:   r.id,
-		Version: r.versionNext,
-		At:      now,
+		RunID:    r.id,
+		Version:  r.versionNext,
+		Manifest: r.PublishManifest(),
+		At:       now,
 	})
 
 	return r.TransitionTo(StatePublished, "PUBLISH_COMPLETE", actor, "Release published", nil)
@@ -1193,6 +1501,25 @@ func (r *ReleaseRun) MarkFailed(reason, actor string) error {
 	return r.TransitionTo(StateFailed, "FAIL", actor, reason, nil)
 }
 
+// Rollback transitions a published or publishing run to Failed because its
+// release is being reverted. The run can then be retried or reset.
+func (r *ReleaseRun) Rollback(reason, actor string) error {
+	if r.state != StatePublished && r.state != StatePublishing {
+		return NewStateTransitionError(r.state, "rollback")
+	}
+
+	r.lastError = reason
+	r.publishedAt = nil
+
+	r.addEvent(&RunFailedEvent{
+		RunID:  r.id,
+		Reason: reason,
+		At:     time.Now(),
+	})
+
+	return r.TransitionTo(StateFailed, "ROLLBACK", actor, reason, nil)
+}
+
 // Cancel cancels the release run.
 func (r *ReleaseRun) Cancel(reason, actor string) error {
 	if r.state == StatePublished {
@@ -1211,6 +1538,63 @@ func (r *ReleaseRun) Cancel(reason, actor string) error {
 	return r.TransitionTo(StateCanceled, "CANCEL", actor, reason, nil)
 }
 
+// Rejection records why a release's notes were sent back for changes.
+type Rejection struct {
+	RejectedBy string
+	RejectedAt time.Time
+	Reason     string
+	PlanHash   string
+}
+
+// Reject sends a release in NotesReady back for changes instead of canceling
+// it. The run moves to ChangesRequested, where the notes can be revised;
+// revising them resubmits the release for approval. Levels already granted
+// in a multi-level approval are discarded since they approved the rejected
+// notes.
+func (r *ReleaseRun) Reject(reason, actor string) error {
+	if r.state != StateNotesReady {
+		return NewStateTransitionError(r.state, "reject")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return ErrRejectionReasonRequired
+	}
+
+	if r.multiLevelApproval != nil {
+		r.multiLevelApproval = NewMultiLevelApproval(r.multiLevelApproval.Policy)
+	}
+
+	r.addEvent(&RunRejectedEvent{
+		RunID:      r.id,
+		PlanHash:   r.planHash,
+		Reason:     reason,
+		RejectedBy: actor,
+		At:         time.Now(),
+	})
+
+	return r.TransitionTo(StateChangesRequested, "REJECT", actor, reason, map[string]string{
+		"plan_hash": r.planHash,
+	})
+}
+
+// LastRejection returns the most recent rejection of the run, or nil if it
+// was never rejected. Rejections are read from the transition history, so
+// they survive resubmission and are available for audit.
+func (r *ReleaseRun) LastRejection() *Rejection {
+	for i := len(r.history) - 1; i >= 0; i-- {
+		record := r.history[i]
+		if record.Event != "REJECT" {
+			continue
+		}
+		return &Rejection{
+			RejectedBy: record.Actor,
+			RejectedAt: record.At,
+			Reason:     record.Reason,
+			PlanHash:   record.Metadata["plan_hash"],
+		}
+	}
+	return nil
+}
+
 // RetryPublish prepares the run for retry by resetting failed steps.
 func (r *ReleaseRun) RetryPublish(actor string) error {
 	if r.state != StateFailed {
@@ -1516,6 +1900,7 @@ type RunSnapshot struct {
 	BaseRef         string
 	HeadSHA         CommitSHA
 	Commits         []CommitSHA
+	ExcludedCommits []CommitSHA
 	ConfigHash      string
 	PluginPlanHash  string
 	VersionCurrent  version.SemanticVersion
@@ -1531,6 +1916,7 @@ type RunSnapshot struct {
 	Notes           *ReleaseNotes
 	NotesInputsHash string
 	Approval        *Approval
+	MultiLevel      *MultiLevelApproval
 	Steps           []StepPlan
 	StepStatus      map[string]*StepStatus
 	State           RunState
@@ -1553,6 +1939,7 @@ func (r *ReleaseRun) ReconstructState(snapshot RunSnapshot) {
 	r.baseRef = snapshot.BaseRef
 	r.headSHA = snapshot.HeadSHA
 	r.commits = snapshot.Commits
+	r.excluded = snapshot.ExcludedCommits
 	r.configHash = snapshot.ConfigHash
 	r.pluginPlanHash = snapshot.PluginPlanHash
 	r.versionCurrent = snapshot.VersionCurrent
@@ -1568,6 +1955,7 @@ func (r *ReleaseRun) ReconstructState(snapshot RunSnapshot) {
 	r.notes = snapshot.Notes
 	r.notesInputsHash = snapshot.NotesInputsHash
 	r.approval = snapshot.Approval
+	r.multiLevelApproval = snapshot.MultiLevel
 	r.steps = snapshot.Steps
 	r.stepStatus = snapshot.StepStatus
 	r.state = snapshot.State
@@ -1582,9 +1970,10 @@ func (r *ReleaseRun) ReconstructState(snapshot RunSnapshot) {
 }
 
 // UpdateNotes updates the release notes in NotesReady state.
-// This allows manual editing of notes before approval.
+// This allows manual editing of notes before approval. Updating the notes
+// of a run in ChangesRequested resubmits it, moving it back to NotesReady.
 func (r *ReleaseRun) UpdateNotes(notes *ReleaseNotes, actor string) error {
-	if r.state != StateNotesReady {
+	if r.state != StateNotesReady && r.state != StateChangesRequested {
 		return NewStateTransitionError(r.state, "update notes")
 	}
 
@@ -1602,6 +1991,19 @@ func (r *ReleaseRun) UpdateNotes(notes *ReleaseNotes, actor string) error {
 		At:          time.Now(),
 	})
 
+	if r.state == StateChangesRequested {
+		return r.TransitionTo(StateNotesReady, "RESUBMIT", actor, "Notes revised after changes were requested", nil)
+	}
+	return nil
+}
+
+// RegenerateNotes replaces the notes with freshly generated ones while in
+// NotesReady or ChangesRequested, keeping the inputs hash in step with the new notes.
+func (r *ReleaseRun) RegenerateNotes(notes *ReleaseNotes, inputsHash, actor string) error {
+	if err := r.UpdateNotes(notes, actor); err != nil {
+		return err
+	}
+	r.notesInputsHash = inputsHash
 	return nil
 }
 
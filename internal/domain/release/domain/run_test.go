@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -166,6 +167,112 @@ func TestReleaseRun_Plan(t *testing.T) {
 	}
 }
 
+func TestExcludeCommits(t *testing.T) {
+	commits := []CommitSHA{"aaa111", "bbb222", "bbc333", "ccc444"}
+
+	remaining, excluded, err := ExcludeCommits(commits, []string{"ccc", "aaa111", "ccc444"})
+	if err != nil {
+		t.Fatalf("ExcludeCommits() error = %v", err)
+	}
+	if len(remaining) != 2 || remaining[0] != "bbb222" || remaining[1] != "bbc333" {
+		t.Errorf("remaining = %v, want [bbb222 bbc333]", remaining)
+	}
+	if len(excluded) != 2 || excluded[0] != "aaa111" || excluded[1] != "ccc444" {
+		t.Errorf("excluded = %v, want [aaa111 ccc444]", excluded)
+	}
+
+	if _, _, err := ExcludeCommits(commits, []string{"ddd"}); !errors.Is(err, ErrCommitNotInRange) {
+		t.Errorf("expected ErrCommitNotInRange, got %v", err)
+	}
+	if _, _, err := ExcludeCommits(commits, []string{"bb"}); !errors.Is(err, ErrAmbiguousCommit) {
+		t.Errorf("expected ErrAmbiguousCommit, got %v", err)
+	}
+}
+
+func TestReleaseRun_SetExcludedCommits(t *testing.T) {
+	newRun := func(excluded ...CommitSHA) *ReleaseRun {
+		run := NewReleaseRun("github.com/test/repo", "/path/to/repo", "v1.0.0",
+			CommitSHA("abc123"), []CommitSHA{"abc123"}, "config-hash", "plugin-hash")
+		if err := run.SetExcludedCommits(excluded); err != nil {
+			t.Fatalf("SetExcludedCommits() error = %v", err)
+		}
+		return run
+	}
+
+	base := newTestRun()
+	a := newRun("fff000", "eee000")
+	b := newRun("eee000", "fff000")
+
+	if a.PlanHash() != b.PlanHash() {
+		t.Errorf("plan hash should not depend on exclusion order: %s != %s", a.PlanHash(), b.PlanHash())
+	}
+	if a.PlanHash() == base.PlanHash() {
+		t.Error("plan hash should change when commits are excluded")
+	}
+	if newRun().PlanHash() != base.PlanHash() {
+		t.Error("empty exclusion set should not change the plan hash")
+	}
+
+	if err := a.Plan("test-actor"); err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	history := a.History()
+	if got := history[len(history)-1].Metadata["excluded_commits"]; got != "eee000,fff000" {
+		t.Errorf("excluded_commits metadata = %q, want %q", got, "eee000,fff000")
+	}
+
+	if err := a.SetExcludedCommits(nil); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("expected ErrInvalidState after planning, got %v", err)
+	}
+}
+
+func TestReleaseRun_NextAction(t *testing.T) {
+	tests := []struct {
+		state   RunState
+		action  string
+		command string
+	}{
+		{StateDraft, "plan release", "relicta plan"},
+		{StatePlanned, "bump version", "relicta bump"},
+		{StateVersioned, "generate notes", "relicta notes"},
+		{StateNotesReady, "approve release", "relicta approve"},
+		{StateApproved, "publish release", "relicta publish"},
+		{StatePublishing, "wait for publishing to complete", "relicta status"},
+		{StatePublished, "plan next release", "relicta plan"},
+		{StateFailed, "reset failed release", "relicta reset"},
+		{StateCanceled, "plan new release", "relicta plan"},
+		{RunState("unknown"), "check status", "relicta status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			run := newTestRun()
+			run.state = tt.state
+
+			action, command := run.NextAction()
+			if action != tt.action || command != tt.command {
+				t.Errorf("NextAction() = (%q, %q), want (%q, %q)", action, command, tt.action, tt.command)
+			}
+		})
+	}
+}
+
+func TestReleaseRun_NextAction_PartialMultiLevelApproval(t *testing.T) {
+	run := newTestRun()
+	run.state = StateNotesReady
+	run.SetApprovalPolicy(ApprovalPolicy{Requirements: []ApprovalRequirement{
+		{Level: ApprovalLevelTechnical, Required: true},
+		{Level: ApprovalLevelSecurity, Required: true},
+		{Level: ApprovalLevelManager, Required: true},
+	}})
+	_ = run.multiLevelApproval.Grant(ApprovalLevelTechnical, &Approval{ApprovedBy: "alice"})
+
+	action, command := run.NextAction()
+	if action != "collect remaining approvals (security, manager)" || command != "relicta approve" {
+		t.Errorf("NextAction() = (%q, %q)", action, command)
+	}
+}
+
 func TestReleaseRun_Plan_WrongState(t *testing.T) {
 	run := newTestRun()
 	_ = run.Plan("test-actor")
@@ -574,6 +681,135 @@ func TestReleaseRun_CancelAlreadyPublished(t *testing.T) {
 	}
 }
 
+func TestReleaseRun_RejectReviseReapprove(t *testing.T) {
+	run := newNotesReadyRun()
+	run.ClearDomainEvents()
+
+	if err := run.Reject("Breaking change is not called out", "reviewer"); err != nil {
+		t.Fatalf("Reject() error = %v", err)
+	}
+	if run.State() != StateChangesRequested {
+		t.Fatalf("State() = %v, want %v", run.State(), StateChangesRequested)
+	}
+	if run.CanApprove() {
+		t.Error("CanApprove() = true after rejection, want false")
+	}
+	if err := run.Approve("reviewer", false); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Approve() before revision error = %v, want ErrInvalidState", err)
+	}
+
+	rejection := run.LastRejection()
+	if rejection == nil || rejection.Reason != "Breaking change is not called out" || rejection.RejectedBy != "reviewer" {
+		t.Fatalf("LastRejection() = %+v", rejection)
+	}
+	if rejection.PlanHash != run.PlanHash() {
+		t.Errorf("LastRejection().PlanHash = %v, want %v", rejection.PlanHash, run.PlanHash())
+	}
+
+	var rejected *RunRejectedEvent
+	for _, e := range run.DomainEvents() {
+		if ev, ok := e.(*RunRejectedEvent); ok {
+			rejected = ev
+		}
+	}
+	if rejected == nil || rejected.Reason != rejection.Reason || rejected.EventName() != "run.rejected" {
+		t.Errorf("RunRejectedEvent = %+v, want run.rejected with the reason", rejected)
+	}
+
+	// Revising the notes resubmits the release for approval
+	if err := run.UpdateNotes(&ReleaseNotes{Text: "BREAKING: config format changed"}, "author"); err != nil {
+		t.Fatalf("UpdateNotes() error = %v", err)
+	}
+	if run.State() != StateNotesReady {
+		t.Fatalf("State() after revision = %v, want %v", run.State(), StateNotesReady)
+	}
+	if err := run.Approve("reviewer", false); err != nil {
+		t.Fatalf("Approve() after revision error = %v", err)
+	}
+	if run.State() != StateApproved {
+		t.Errorf("State() = %v, want %v", run.State(), StateApproved)
+	}
+
+	// The rejection stays on record for audit
+	if got := run.LastRejection(); got == nil || got.Reason != rejection.Reason {
+		t.Errorf("LastRejection() after approval = %+v, want the earlier rejection", got)
+	}
+	var events []string
+	for _, h := range run.History() {
+		events = append(events, h.Event)
+	}
+	if got := strings.Join(events, ","); !strings.HasSuffix(got, "REJECT,RESUBMIT,APPROVE") {
+		t.Errorf("History events = %s, want ...REJECT,RESUBMIT,APPROVE", got)
+	}
+}
+
+func TestReleaseRun_Reject_Validation(t *testing.T) {
+	run := newNotesReadyRun()
+	if err := run.Reject("  ", "reviewer"); !errors.Is(err, ErrRejectionReasonRequired) {
+		t.Errorf("Reject() without reason error = %v, want ErrRejectionReasonRequired", err)
+	}
+	if run.LastRejection() != nil {
+		t.Error("LastRejection() should be nil for a run never rejected")
+	}
+
+	for name, r := range map[string]*ReleaseRun{
+		"versioned": newVersionedRun(),
+		"approved":  newApprovedRun(),
+	} {
+		if err := r.Reject("nope", "reviewer"); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Reject() in %s state error = %v, want ErrInvalidState", name, err)
+		}
+	}
+
+	// A rejected release can still be canceled
+	_ = run.Reject("needs work", "reviewer")
+	if err := run.Cancel("abandoned", "reviewer"); err != nil || run.State() != StateCanceled {
+		t.Errorf("Cancel() after rejection = %v, state %v", err, run.State())
+	}
+}
+
+func TestReleaseRun_Reject_ResetsMultiLevelApprovals(t *testing.T) {
+	run := newNotesReadyRun()
+	run.SetApprovalPolicy(DefaultApprovalPolicy())
+	if err := run.ApproveAtLevel(ApprovalLevelTechnical, "tech-lead", ActorHuman, ""); err != nil {
+		t.Fatalf("ApproveAtLevel() error = %v", err)
+	}
+
+	if err := run.Reject("Missing migration guide", "release-manager"); err != nil {
+		t.Fatalf("Reject() error = %v", err)
+	}
+	if run.MultiLevelApprovalStatus().IsLevelApproved(ApprovalLevelTechnical) {
+		t.Error("technical approval should be discarded by the rejection")
+	}
+}
+
+func TestReleaseRun_Rollback(t *testing.T) {
+	run := newPublishingRun()
+	_ = run.MarkPublished("test-actor")
+
+	if err := run.Rollback("bad release", "test-actor"); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+
+	if run.State() != StateFailed {
+		t.Errorf("State() = %v, want %v", run.State(), StateFailed)
+	}
+	if run.PublishedAt() != nil {
+		t.Error("PublishedAt() should be cleared after rollback")
+	}
+	if run.LastError() != "bad release" {
+		t.Errorf("LastError() = %v, want %v", run.LastError(), "bad release")
+	}
+}
+
+func TestReleaseRun_RollbackInvalidState(t *testing.T) {
+	run := newApprovedRun()
+
+	if err := run.Rollback("nothing to revert", "test-actor"); err == nil {
+		t.Error("Rollback() expected error for approved run")
+	}
+}
+
 func TestReleaseRun_RetryPublish(t *testing.T) {
 	run := newApprovedRun()
 	run.SetExecutionPlan([]StepPlan{{Name: "tag", Type: StepTypeTag}})
@@ -738,6 +974,29 @@ func TestReleaseRun_UpdateNotes_NilNotes(t *testing.T) {
 	}
 }
 
+func TestReleaseRun_RegenerateNotes(t *testing.T) {
+	run := newNotesReadyRun()
+
+	err := run.RegenerateNotes(&ReleaseNotes{Text: "Regenerated notes"}, "new-hash", "editor")
+	if err != nil {
+		t.Fatalf("RegenerateNotes() error = %v", err)
+	}
+	if run.Notes().Text != "Regenerated notes" {
+		t.Errorf("Notes().Text = %v, want %v", run.Notes().Text, "Regenerated notes")
+	}
+	if run.notesInputsHash != "new-hash" {
+		t.Errorf("notesInputsHash = %v, want %v", run.notesInputsHash, "new-hash")
+	}
+	if run.State() != StateNotesReady {
+		t.Errorf("State() = %v, want %v", run.State(), StateNotesReady)
+	}
+
+	approved := newApprovedRun()
+	if err := approved.RegenerateNotes(&ReleaseNotes{Text: "x"}, "hash", "editor"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("RegenerateNotes() in approved state error = %v, want ErrInvalidState", err)
+	}
+}
+
 func TestReleaseRun_UpdateNotesText(t *testing.T) {
 	run := newNotesReadyRun()
 
@@ -906,6 +1165,76 @@ func newPublishingRun() *ReleaseRun {
 	return run
 }
 
+func TestReleaseRun_ApprovalExpiry(t *testing.T) {
+	if run := newApprovedRun(); run.IsApprovalExpired(run.approval.ApprovedAt.Add(365 * 24 * time.Hour)) {
+		t.Error("approval without TTL should never expire")
+	}
+
+	run := newNotesReadyRun()
+	run.SetApprovalTTL(24 * time.Hour)
+	if err := run.Approve("approver", false); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	approvedAt := run.approval.ApprovedAt
+	if got := run.Approval().ExpiresAt; !got.Equal(approvedAt.Add(24 * time.Hour)) {
+		t.Errorf("ExpiresAt = %v, want %v", got, approvedAt.Add(24*time.Hour))
+	}
+
+	if run.IsApprovalExpired(approvedAt.Add(23 * time.Hour)) {
+		t.Error("approval within TTL should not be expired")
+	}
+	if !run.IsApprovalExpired(approvedAt.Add(25 * time.Hour)) {
+		t.Error("approval past TTL should be expired")
+	}
+
+	// The expiry is fixed at approval; a later TTL change does not affect it
+	run.SetApprovalTTL(0)
+	if !run.IsApprovalExpired(approvedAt.Add(25 * time.Hour)) {
+		t.Error("changing the TTL must not extend an existing approval")
+	}
+	run.SetApprovalTTL(time.Hour)
+	if run.IsApprovalExpired(approvedAt.Add(23 * time.Hour)) {
+		t.Error("changing the TTL must not void an existing approval")
+	}
+
+	if err := run.ValidateApprovalNotExpired(approvedAt.Add(25 * time.Hour)); !errors.Is(err, ErrApprovalExpired) {
+		t.Errorf("ValidateApprovalNotExpired() error = %v, want ErrApprovalExpired", err)
+	}
+	if err := newNotesReadyRun().ValidateApprovalNotExpired(time.Now()); !errors.Is(err, ErrNotApproved) {
+		t.Errorf("ValidateApprovalNotExpired() error = %v, want ErrNotApproved", err)
+	}
+
+	// Backdate the approval so it is expired now
+	run.approval.ExpiresAt = time.Now().Add(-time.Hour)
+	if run.CanProceedToPublish() {
+		t.Error("CanProceedToPublish() should be false for an expired approval")
+	}
+	if status := run.ApprovalStatus(); !status.CanApprove {
+		t.Errorf("ApprovalStatus() = %+v, want re-approval allowed", status)
+	}
+	if action, command := run.NextAction(); command != "relicta approve" {
+		t.Errorf("NextAction() = (%q, %q), want relicta approve", action, command)
+	}
+
+	if err := run.Approve("approver-2", false); err != nil {
+		t.Fatalf("re-approve error = %v", err)
+	}
+	if run.State() != StateApproved || run.Approval().ApprovedBy != "approver-2" {
+		t.Errorf("after re-approval: state=%v approvedBy=%v", run.State(), run.Approval().ApprovedBy)
+	}
+	if !run.CanProceedToPublish() {
+		t.Error("CanProceedToPublish() should be true after re-approval")
+	}
+	if last := run.History()[len(run.History())-1]; last.Event != "REAPPROVE" {
+		t.Errorf("last transition event = %q, want REAPPROVE", last.Event)
+	}
+
+	// A valid approval cannot be replaced
+	if err := run.Approve("approver-3", false); err == nil {
+		t.Error("Approve() should fail while the approval is still valid")
+	}
+}
+
 func TestReleaseRun_ValidateApprovalPlanHash(t *testing.T) {
 	t.Run("valid approval matches plan hash", func(t *testing.T) {
 		run := newApprovedRun()
@@ -1084,6 +1413,23 @@ func TestReleaseRun_MultiLevelApproval(t *testing.T) {
 			t.Error("ApproveAtLevel should fail when skipping required levels in sequential policy")
 		}
 	})
+
+	t.Run("approver cannot grant a second level", func(t *testing.T) {
+		run := newNotesReadyRun()
+		run.SetApprovalPolicy(HighRiskApprovalPolicy())
+
+		if err := run.ApproveAtLevel(ApprovalLevelTechnical, "alice", ActorAgent, "Code reviewed"); err != nil {
+			t.Fatalf("ApproveAtLevel(technical) failed: %v", err)
+		}
+
+		err := run.ApproveAtLevel(ApprovalLevelSecurity, "alice", ActorAgent, "Security review")
+		if !errors.Is(err, ErrApproverAlreadyApproved) {
+			t.Errorf("ApproveAtLevel(security) error = %v, want ErrApproverAlreadyApproved", err)
+		}
+		if run.MultiLevelApprovalStatus().IsLevelApproved(ApprovalLevelSecurity) {
+			t.Error("security level should not be approved")
+		}
+	})
 }
 
 func TestMultiLevelApproval_PolicyHelpers(t *testing.T) {
@@ -90,20 +90,20 @@ func (s *StateSpecification) IsSatisfiedBy(r *ReleaseRun) bool {
 	return r.State() == s.state
 }
 
-// ActiveSpecification matches runs that are not in a final state.
+// ActiveSpecification matches runs that are not finished.
 type ActiveSpecification struct{}
 
-// Active creates a specification for active (non-final) runs.
+// Active creates a specification for active (unfinished) runs.
 func Active() *ActiveSpecification {
 	return &ActiveSpecification{}
 }
 
-// IsSatisfiedBy returns true if the run is not in a final state.
+// IsSatisfiedBy returns true if the run is not finished.
 func (s *ActiveSpecification) IsSatisfiedBy(r *ReleaseRun) bool {
-	return !r.State().IsFinal()
+	return !r.State().IsFinished()
 }
 
-// FinalSpecification matches runs that are in a final state.
+// FinalSpecification matches runs that are finished.
 type FinalSpecification struct{}
 
 // Final creates a specification for final (completed/failed/canceled) runs.
@@ -111,9 +111,9 @@ func Final() *FinalSpecification {
 	return &FinalSpecification{}
 }
 
-// IsSatisfiedBy returns true if the run is in a final state.
+// IsSatisfiedBy returns true if the run is finished.
 func (s *FinalSpecification) IsSatisfiedBy(r *ReleaseRun) bool {
-	return r.State().IsFinal()
+	return r.State().IsFinished()
 }
 
 // RepositoryPathSpecification matches runs for a specific repository.
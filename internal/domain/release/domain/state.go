@@ -19,6 +19,10 @@ const (
 	// StateNotesReady means release notes have been generated.
 	StateNotesReady RunState = "notes_ready"
 
+	// StateChangesRequested means the release notes were rejected during
+	// review and must be revised before the release can be approved.
+	StateChangesRequested RunState = "changes_requested"
+
 	// StateApproved means the release has been approved for publishing.
 	StateApproved RunState = "approved"
 
@@ -26,7 +30,7 @@ const (
 	// This is a compound state with sub-states for each step.
 	StatePublishing RunState = "publishing"
 
-	// StatePublished is the terminal success state.
+	// StatePublished is the success state. Only a rollback leaves it.
 	StatePublished RunState = "published"
 
 	// StateFailed indicates the release failed during publishing.
@@ -43,6 +47,7 @@ func AllStates() []RunState {
 		StatePlanned,
 		StateVersioned,
 		StateNotesReady,
+		StateChangesRequested,
 		StateApproved,
 		StatePublishing,
 		StatePublished,
@@ -59,22 +64,30 @@ func (s RunState) String() string {
 // IsValid returns true if the state is a valid run state.
 func (s RunState) IsValid() bool {
 	switch s {
-	case StateDraft, StatePlanned, StateVersioned, StateNotesReady, StateApproved,
-		StatePublishing, StatePublished, StateFailed, StateCanceled:
+	case StateDraft, StatePlanned, StateVersioned, StateNotesReady, StateChangesRequested,
+		StateApproved, StatePublishing, StatePublished, StateFailed, StateCanceled:
 		return true
 	default:
 		return false
 	}
 }
 
-// IsFinal returns true if this is a terminal state.
+// IsFinal returns true if the run ended without a release: it failed or was
+// canceled. Published is not final, since a rollback can still move it to
+// failed; use IsFinished to include it.
 func (s RunState) IsFinal() bool {
-	return s == StatePublished || s == StateFailed || s == StateCanceled
+	return s == StateFailed || s == StateCanceled
+}
+
+// IsFinished returns true if the run is no longer in progress: it was
+// published, or it is in a final state.
+func (s RunState) IsFinished() bool {
+	return s == StatePublished || s.IsFinal()
 }
 
 // IsActive returns true if the run is actively in progress.
 func (s RunState) IsActive() bool {
-	return !s.IsFinal() && s != StateDraft
+	return !s.IsFinished() && s != StateDraft
 }
 
 // CanTransitionTo returns true if transitioning to the target state is valid.
@@ -96,15 +109,16 @@ func (s RunState) CanTransitionTo(target RunState) bool {
 // validTransitions defines the state machine transitions.
 func validTransitions() map[RunState][]RunState {
 	return map[RunState][]RunState{
-		StateDraft:      {StatePlanned, StateCanceled},
-		StatePlanned:    {StateVersioned, StateCanceled},                // Plan -> Bump
-		StateVersioned:  {StateNotesReady, StatePlanned, StateCanceled}, // Bump -> Notes (can go back to re-plan)
-		StateNotesReady: {StateApproved, StateVersioned, StateCanceled}, // Can go back to Versioned to regenerate notes
-		StateApproved:   {StatePublishing, StateCanceled},
-		StatePublishing: {StatePublished, StateFailed},
-		StatePublished:  {},                            // Terminal - no transitions
-		StateFailed:     {StatePublishing, StateDraft}, // Can retry or start over
-		StateCanceled:   {StateDraft},                  // Can restart
+		StateDraft:            {StatePlanned, StateCanceled},
+		StatePlanned:          {StateVersioned, StateCanceled},                                       // Plan -> Bump
+		StateVersioned:        {StateNotesReady, StatePlanned, StateCanceled},                        // Bump -> Notes (can go back to re-plan)
+		StateNotesReady:       {StateApproved, StateChangesRequested, StateVersioned, StateCanceled}, // Can go back to Versioned to regenerate notes
+		StateChangesRequested: {StateNotesReady, StateCanceled},                                      // Revising the notes resubmits them
+		StateApproved:         {StatePublishing, StateCanceled},
+		StatePublishing:       {StatePublished, StateFailed},
+		StatePublished:        {StateFailed},                 // Terminal - only a rollback reopens it
+		StateFailed:           {StatePublishing, StateDraft}, // Can retry or start over
+		StateCanceled:         {StateDraft},                  // Can restart
 	}
 }
 
@@ -137,6 +151,8 @@ func (s RunState) Description() string {
 		return "Version calculated and applied"
 	case StateNotesReady:
 		return "Release notes generated and ready for review"
+	case StateChangesRequested:
+		return "Release notes rejected, changes requested before approval"
 	case StateApproved:
 		return "Release approved and ready to publish"
 	case StatePublishing:
@@ -163,6 +179,8 @@ func (s RunState) Icon() string {
 		return "[VERSIONED]"
 	case StateNotesReady:
 		return "[NOTES]"
+	case StateChangesRequested:
+		return "[CHANGES]"
 	case StateApproved:
 		return "[APPROVED]"
 	case StatePublishing:
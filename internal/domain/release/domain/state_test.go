@@ -51,21 +51,38 @@ func TestRunState_IsValid(t *testing.T) {
 }
 
 func TestRunState_IsFinal(t *testing.T) {
-	terminal := []RunState{StatePublished, StateFailed, StateCanceled}
-	for _, s := range terminal {
+	final := []RunState{StateFailed, StateCanceled}
+	for _, s := range final {
 		if !s.IsFinal() {
 			t.Errorf("RunState(%v).IsFinal() = false, want true", s)
 		}
 	}
 
-	nonTerminal := []RunState{StateDraft, StatePlanned, StateVersioned, StateNotesReady, StateApproved, StatePublishing}
-	for _, s := range nonTerminal {
+	// Published is not final: a rollback can still move it to failed
+	nonFinal := []RunState{StateDraft, StatePlanned, StateVersioned, StateNotesReady, StateApproved, StatePublishing, StatePublished}
+	for _, s := range nonFinal {
 		if s.IsFinal() {
 			t.Errorf("RunState(%v).IsFinal() = true, want false", s)
 		}
 	}
 }
 
+func TestRunState_IsFinished(t *testing.T) {
+	finished := []RunState{StatePublished, StateFailed, StateCanceled}
+	for _, s := range finished {
+		if !s.IsFinished() {
+			t.Errorf("RunState(%v).IsFinished() = false, want true", s)
+		}
+	}
+
+	inProgress := []RunState{StateDraft, StatePlanned, StateVersioned, StateNotesReady, StateApproved, StatePublishing}
+	for _, s := range inProgress {
+		if s.IsFinished() {
+			t.Errorf("RunState(%v).IsFinished() = true, want false", s)
+		}
+	}
+}
+
 func TestRunState_CanTransitionTo(t *testing.T) {
 	tests := []struct {
 		from    RunState
@@ -108,6 +125,7 @@ func TestRunState_CanTransitionTo(t *testing.T) {
 		// Terminal states
 		{StatePublished, StateDraft, false},
 		{StatePublished, StateCanceled, false},
+		{StatePublished, StateFailed, true},  // Rollback
 		{StateFailed, StatePublishing, true}, // Retry allowed
 		{StateFailed, StateDraft, true},      // Can start over
 		{StateCanceled, StateDraft, true},    // Can restart
@@ -123,8 +141,8 @@ func TestRunState_CanTransitionTo(t *testing.T) {
 
 func TestAllStates(t *testing.T) {
 	states := AllStates()
-	if len(states) != 9 {
-		t.Errorf("AllStates() returned %d states, want 9", len(states))
+	if len(states) != 10 {
+		t.Errorf("AllStates() returned %d states, want 10", len(states))
 	}
 
 	// Verify all states are valid
@@ -136,7 +154,7 @@ func TestAllStates(t *testing.T) {
 }
 
 func TestRunState_IsActive(t *testing.T) {
-	active := []RunState{StatePlanned, StateVersioned, StateNotesReady, StateApproved, StatePublishing}
+	active := []RunState{StatePlanned, StateVersioned, StateNotesReady, StateChangesRequested, StateApproved, StatePublishing}
 	for _, s := range active {
 		if !s.IsActive() {
 			t.Errorf("RunState(%v).IsActive() = false, want true", s)
@@ -156,15 +174,16 @@ func TestRunState_NextValidStates(t *testing.T) {
 		state    RunState
 		expected int
 	}{
-		{StateDraft, 2},      // Planned, Canceled
-		{StatePlanned, 2},    // Versioned, Canceled
-		{StateVersioned, 3},  // NotesReady, Planned, Canceled
-		{StateNotesReady, 3}, // Approved, Versioned, Canceled
-		{StateApproved, 2},   // Publishing, Canceled
-		{StatePublishing, 2}, // Published, Failed
-		{StatePublished, 0},  // Terminal
-		{StateFailed, 2},     // Publishing, Draft
-		{StateCanceled, 1},   // Draft
+		{StateDraft, 2},            // Planned, Canceled
+		{StatePlanned, 2},          // Versioned, Canceled
+		{StateVersioned, 3},        // NotesReady, Planned, Canceled
+		{StateNotesReady, 4},       // Approved, ChangesRequested, Versioned, Canceled
+		{StateChangesRequested, 2}, // NotesReady, Canceled
+		{StateApproved, 2},         // Publishing, Canceled
+		{StatePublishing, 2},       // Published, Failed
+		{StatePublished, 1},        // Failed (rollback)
+		{StateFailed, 2},           // Publishing, Draft
+		{StateCanceled, 1},         // Draft
 	}
 
 	for _, tt := range tests {
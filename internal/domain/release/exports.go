@@ -171,23 +171,53 @@ type (
 	// StepPlan describes a single step in the publishing execution plan.
 	StepPlan = domain.StepPlan
 
+	// PackageTag names the tag to create for a monorepo package.
+	PackageTag = domain.PackageTag
+
+	// PluginStep is a publish step contributed by a plugin.
+	PluginStep = domain.PluginStep
+
 	// StepState represents the execution state of a step.
 	StepState = domain.StepState
 
 	// StepStatus tracks the execution status of a step.
 	StepStatus = domain.StepStatus
 
+	// PublishManifest describes everything a run published.
+	PublishManifest = domain.PublishManifest
+
+	// PublishTarget is the outcome of a single publish step.
+	PublishTarget = domain.PublishTarget
+
 	// TransitionRecord records a state transition for audit.
 	TransitionRecord = domain.TransitionRecord
 
+	// TransitionObserver is notified of every recorded state transition.
+	TransitionObserver = domain.TransitionObserver
+
+	// TransitionObserverFunc adapts a function to a TransitionObserver.
+	TransitionObserverFunc = domain.TransitionObserverFunc
+
 	// RunSummary is a summary of the release run.
 	RunSummary = domain.RunSummary
 
+	// RunDiff is a structured comparison of two release runs.
+	RunDiff = domain.RunDiff
+
+	// FieldDiff compares a single fact of two runs.
+	FieldDiff = domain.FieldDiff
+
+	// StepDiff compares the outcome of a step in two runs.
+	StepDiff = domain.StepDiff
+
 	// Invariant provides information about aggregate invariant validation.
 	Invariant = domain.Invariant
 
 	// ApprovalStatus represents the approval readiness of a release.
 	ApprovalStatus = domain.ApprovalStatus
+
+	// Rejection records why a release's notes were sent back for changes.
+	Rejection = domain.Rejection
 )
 
 // Re-export domain events
@@ -200,6 +230,7 @@ type (
 	RunNotesGeneratedEvent    = domain.RunNotesGeneratedEvent
 	RunNotesUpdatedEvent      = domain.RunNotesUpdatedEvent
 	RunApprovedEvent          = domain.RunApprovedEvent
+	RunRejectedEvent          = domain.RunRejectedEvent
 	RunPublishingStartedEvent = domain.RunPublishingStartedEvent
 	RunPublishedEvent         = domain.RunPublishedEvent
 	RunFailedEvent            = domain.RunFailedEvent
@@ -216,35 +247,40 @@ type (
 
 // Re-export errors
 var (
-	ErrInvalidState        = domain.ErrInvalidState
-	ErrHeadSHAChanged      = domain.ErrHeadSHAChanged
-	ErrAlreadyPublished    = domain.ErrAlreadyPublished
-	ErrNotApproved         = domain.ErrNotApproved
-	ErrStepNotFound        = domain.ErrStepNotFound
-	ErrStepAlreadyDone     = domain.ErrStepAlreadyDone
-	ErrNilNotes            = domain.ErrNilNotes
-	ErrRunNotFound         = domain.ErrRunNotFound
-	ErrPlanHashMismatch    = domain.ErrPlanHashMismatch
-	ErrApprovalBoundToHash = domain.ErrApprovalBoundToHash
-	ErrNoChanges           = domain.ErrNoChanges
-	ErrCannotCancel        = domain.ErrCannotCancel
-	ErrCannotRetry         = domain.ErrCannotRetry
-	ErrVersionNotSet       = domain.ErrVersionNotSet
-	ErrRiskTooHigh         = domain.ErrRiskTooHigh
-	ErrDuplicateRun        = domain.ErrDuplicateRun
+	ErrInvalidState            = domain.ErrInvalidState
+	ErrHeadSHAChanged          = domain.ErrHeadSHAChanged
+	ErrAlreadyPublished        = domain.ErrAlreadyPublished
+	ErrNotApproved             = domain.ErrNotApproved
+	ErrStepNotFound            = domain.ErrStepNotFound
+	ErrStepAlreadyDone         = domain.ErrStepAlreadyDone
+	ErrNilNotes                = domain.ErrNilNotes
+	ErrRunNotFound             = domain.ErrRunNotFound
+	ErrPlanHashMismatch        = domain.ErrPlanHashMismatch
+	ErrApprovalBoundToHash     = domain.ErrApprovalBoundToHash
+	ErrApprovalExpired         = domain.ErrApprovalExpired
+	ErrPrePublishRejected      = domain.ErrPrePublishRejected
+	ErrNoChanges               = domain.ErrNoChanges
+	ErrCannotCancel            = domain.ErrCannotCancel
+	ErrRejectionReasonRequired = domain.ErrRejectionReasonRequired
+	ErrApproverAlreadyApproved = domain.ErrApproverAlreadyApproved
+	ErrCannotRetry             = domain.ErrCannotRetry
+	ErrVersionNotSet           = domain.ErrVersionNotSet
+	ErrRiskTooHigh             = domain.ErrRiskTooHigh
+	ErrDuplicateRun            = domain.ErrDuplicateRun
 )
 
 // State constants
 const (
-	StateDraft      = domain.StateDraft
-	StatePlanned    = domain.StatePlanned
-	StateVersioned  = domain.StateVersioned
-	StateNotesReady = domain.StateNotesReady
-	StateApproved   = domain.StateApproved
-	StatePublishing = domain.StatePublishing
-	StatePublished  = domain.StatePublished
-	StateFailed     = domain.StateFailed
-	StateCanceled   = domain.StateCanceled
+	StateDraft            = domain.StateDraft
+	StatePlanned          = domain.StatePlanned
+	StateVersioned        = domain.StateVersioned
+	StateNotesReady       = domain.StateNotesReady
+	StateChangesRequested = domain.StateChangesRequested
+	StateApproved         = domain.StateApproved
+	StatePublishing       = domain.StatePublishing
+	StatePublished        = domain.StatePublished
+	StateFailed           = domain.StateFailed
+	StateCanceled         = domain.StateCanceled
 )
 
 // Actor type constants
@@ -274,6 +310,9 @@ const (
 	StepTypeChangelog = domain.StepTypeChangelog
 )
 
+// PublishManifestSchemaVersion is the schema version of PublishManifest.
+const PublishManifestSchemaVersion = domain.PublishManifestSchemaVersion
+
 // Step state constants
 const (
 	StepPending = domain.StepPending
@@ -301,6 +340,8 @@ var (
 	AllStates               = domain.AllStates
 	ParseRunState           = domain.ParseRunState
 	NewStateTransitionError = domain.NewStateTransitionError
+	CompareRuns             = domain.CompareRuns
+	SetTransitionObserver   = domain.SetTransitionObserver
 
 	// Approval policy helpers
 	DefaultApprovalPolicy  = domain.DefaultApprovalPolicy
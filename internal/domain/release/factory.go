@@ -16,8 +16,10 @@ type Services struct {
 	BumpVersion    *app.BumpVersionUseCase
 	GenerateNotes  *app.GenerateNotesUseCase
 	ApproveRelease *app.ApproveReleaseUseCase
+	RejectRelease  *app.RejectReleaseUseCase
 	PublishRelease *app.PublishReleaseUseCase
 	RetryPublish   *app.RetryPublishUseCase
+	Rollback       *app.RollbackReleaseUseCase
 	GetStatus      *app.GetStatusUseCase
 
 	// Infrastructure
@@ -89,6 +91,15 @@ func NewServices(cfg Config) (*Services, error) {
 		stateMachine,
 	)
 
+	if contributor, ok := cfg.Publisher.(ports.StepContributor); ok {
+		approveRelease.SetStepContributor(contributor)
+	}
+
+	rejectRelease := app.NewRejectReleaseUseCase(
+		repository,
+		lockManager,
+	)
+
 	publishRelease := app.NewPublishReleaseUseCase(
 		repository,
 		repoInspector,
@@ -105,6 +116,12 @@ func NewServices(cfg Config) (*Services, error) {
 		stateMachine,
 	)
 
+	rollback := app.NewRollbackReleaseUseCase(
+		repository,
+		lockManager,
+		cfg.Publisher,
+	)
+
 	getStatus := app.NewGetStatusUseCase(
 		repository,
 		repoInspector,
@@ -115,8 +132,10 @@ func NewServices(cfg Config) (*Services, error) {
 		BumpVersion:    bumpVersion,
 		GenerateNotes:  generateNotes,
 		ApproveRelease: approveRelease,
+		RejectRelease:  rejectRelease,
 		PublishRelease: publishRelease,
 		RetryPublish:   retryPublish,
+		Rollback:       rollback,
 		GetStatus:      getStatus,
 		Repository:     repository,
 		RepoInspector:  repoInspector,
@@ -28,6 +28,38 @@ type Publisher interface {
 	CheckIdempotency(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (bool, error)
 }
 
+// PrePublishValidator runs checks that may veto a release before any tag
+// is created. Publishers may implement it to let plugins reject a publish.
+type PrePublishValidator interface {
+	// ValidatePrePublish returns an error describing why the release must
+	// not be published, or nil if publishing may proceed.
+	ValidatePrePublish(ctx context.Context, run *domain.ReleaseRun, dryRun bool) error
+}
+
+// TagRemover removes tags created by tag steps.
+// Publishers may implement it to support rolling back a release whose
+// later steps failed.
+type TagRemover interface {
+	// RemoveTag deletes the tag created by the given tag step, locally and on
+	// the remote when the tag was pushed. It returns the removed tag name.
+	RemoveTag(ctx context.Context, run *domain.ReleaseRun, step *domain.StepPlan) (string, error)
+}
+
+// StepContributor lists the steps plugins contribute to the publish
+// execution plan. Publishers may implement it so that contributed steps are
+// planned when a release is approved.
+type StepContributor interface {
+	// ContributedSteps returns the contributed steps in execution order.
+	ContributedSteps(ctx context.Context) ([]domain.PluginStep, error)
+}
+
+// RollbackNotifier notifies integrations that a release was rolled back.
+// Publishers may implement it so plugins can react to a reverted release.
+type RollbackNotifier interface {
+	// NotifyRollback runs the rollback hooks for the given run.
+	NotifyRollback(ctx context.Context, run *domain.ReleaseRun, reason string) error
+}
+
 // NotesGenerator generates release notes.
 type NotesGenerator interface {
 	// Generate creates release notes for the given run.
@@ -46,6 +78,17 @@ type NotesOptions struct {
 	Provider       string
 	Model          string
 	RepositoryURL  string
+	IssueURL       string
+	// Template is a custom changelog template file. When set, notes are
+	// rendered from it instead of being generated.
+	Template string
+	// TagPrefix is the configured tag prefix, used for template tag links
+	// when the run has no tag name yet.
+	TagPrefix string
+	// HighlightsCount is the number of highlights to extract (0 disables).
+	HighlightsCount int
+	// ExcludeScopes lists commit scope globs omitted from the notes.
+	ExcludeScopes []string
 }
 
 // VersionCalculator calculates the next version.
@@ -2,6 +2,7 @@
 package sourcecontrol
 
 import (
+	"regexp"
 	"strings"
 	"time"
 
@@ -35,6 +36,16 @@ func NewTag(name string, hash CommitHash) *Tag {
 	return t
 }
 
+// NewTagMatching creates a new Tag that counts as a version tag only if its
+// name matches pattern. A nil pattern behaves like NewTag.
+func NewTagMatching(name string, hash CommitHash, pattern *regexp.Regexp) *Tag {
+	t := NewTag(name, hash)
+	if pattern != nil && !pattern.MatchString(name) {
+		t.version = nil
+	}
+	return t
+}
+
 // NewAnnotatedTag creates a new annotated Tag entity.
 func NewAnnotatedTag(name string, hash CommitHash, message string, tagger Author) *Tag {
 	t := &Tag{
@@ -176,3 +187,23 @@ func (tl TagList) VersionTags() TagList {
 	}
 	return result
 }
+
+// PrereleasesOf returns the prerelease tags with the given prefix that lead
+// up to the stable version, e.g. v1.2.0-rc.1 and v1.2.0-beta.2 for v1.2.0.
+// Prereleases of other base versions are never returned.
+func (tl TagList) PrereleasesOf(prefix string, stable version.SemanticVersion) TagList {
+	var result TagList
+	for _, t := range tl {
+		if !t.HasPrefix(prefix) {
+			continue
+		}
+		v, err := version.Parse(t.WithoutPrefix(prefix))
+		if err != nil || !v.IsPrerelease() {
+			continue
+		}
+		if v.Major() == stable.Major() && v.Minor() == stable.Minor() && v.Patch() == stable.Patch() {
+			result = append(result, t)
+		}
+	}
+	return result
+}
@@ -2,9 +2,13 @@
 package sourcecontrol
 
 import (
+	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
 )
 
 func TestNewTag(t *testing.T) {
@@ -38,6 +42,20 @@ func TestNewTag_NonVersionTag(t *testing.T) {
 	}
 }
 
+func TestNewTagMatching(t *testing.T) {
+	pattern := regexp.MustCompile(`^v[0-9]+\.[0-9]+\.[0-9]+$`)
+
+	if tag := NewTagMatching("v1.2.0", CommitHash("abc123"), pattern); !tag.IsVersionTag() {
+		t.Error("v1.2.0 matches the pattern and should be a version tag")
+	}
+	if tag := NewTagMatching("v1.2.0-rc.1", CommitHash("abc123"), pattern); tag.IsVersionTag() {
+		t.Error("v1.2.0-rc.1 does not match the pattern and should not be a version tag")
+	}
+	if tag := NewTagMatching("v1.2.0-rc.1", CommitHash("abc123"), nil); !tag.IsVersionTag() {
+		t.Error("a nil pattern should behave like NewTag")
+	}
+}
+
 func TestNewAnnotatedTag(t *testing.T) {
 	tagger := Author{Name: "John Doe", Email: "john@example.com"}
 	tag := NewAnnotatedTag("v2.0.0", CommitHash("def456"), "Release v2.0.0", tagger)
@@ -341,3 +359,38 @@ func TestTagList_VersionTags_Empty(t *testing.T) {
 		t.Errorf("VersionTags() should return empty list when no version tags, got %d", len(versionTags))
 	}
 }
+
+func TestTagList_PrereleasesOf(t *testing.T) {
+	tl := TagList{
+		NewTag("v1.2.0-rc.1", CommitHash("a")),
+		NewTag("v1.2.0-rc.2", CommitHash("b")),
+		NewTag("v1.2.0-beta.1", CommitHash("c")),
+		NewTag("v1.2.0", CommitHash("d")),
+		NewTag("v1.2.1-rc.1", CommitHash("e")),
+		NewTag("v1.3.0-rc.1", CommitHash("f")),
+		NewTag("api/v1.2.0-rc.1", CommitHash("g")),
+		NewTag("latest", CommitHash("h")),
+	}
+
+	var names []string
+	for _, tag := range tl.PrereleasesOf("v", version.MustParse("1.2.0")) {
+		names = append(names, tag.Name())
+	}
+
+	want := []string{"v1.2.0-rc.1", "v1.2.0-rc.2", "v1.2.0-beta.1"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("PrereleasesOf() = %v, want %v", names, want)
+	}
+}
+
+func TestTagList_PrereleasesOf_CustomPrefix(t *testing.T) {
+	tl := TagList{
+		NewTag("api/v2.0.0-alpha.1", CommitHash("a")),
+		NewTag("v2.0.0-alpha.1", CommitHash("b")),
+	}
+
+	got := tl.PrereleasesOf("api/v", version.MustParse("2.0.0"))
+	if len(got) != 1 || got[0].Name() != "api/v2.0.0-alpha.1" {
+		t.Errorf("PrereleasesOf(\"api/v\") = %v, want [api/v2.0.0-alpha.1]", got)
+	}
+}
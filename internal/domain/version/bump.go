@@ -3,6 +3,8 @@ package version
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // BumpType represents the type of version bump to apply.
@@ -76,6 +78,10 @@ func (b VersionBump) PrereleaseIdentifier() Prerelease {
 func (b VersionBump) Apply(v SemanticVersion) SemanticVersion {
 	switch b.bumpType {
 	case BumpMajor:
+		// A prerelease of the next major (2.0.0-rc.1) is promoted to 2.0.0
+		if v.IsPrerelease() && v.minor == 0 && v.patch == 0 {
+			return v.WithoutPrerelease().WithMetadata("")
+		}
 		return SemanticVersion{
 			major: v.major + 1,
 			minor: 0,
@@ -83,6 +89,10 @@ func (b VersionBump) Apply(v SemanticVersion) SemanticVersion {
 		}
 
 	case BumpMinor:
+		// A prerelease of the next minor (1.3.0-beta.2) is promoted to 1.3.0
+		if v.IsPrerelease() && v.patch == 0 {
+			return v.WithoutPrerelease().WithMetadata("")
+		}
 		return SemanticVersion{
 			major: v.major,
 			minor: v.minor + 1,
@@ -102,20 +112,24 @@ func (b VersionBump) Apply(v SemanticVersion) SemanticVersion {
 
 	case BumpPrerelease:
 		// Prerelease bump logic:
-		// - If no prerelease, increment patch and add prerelease
+		// - If no prerelease, increment minor and add prerelease with .1
 		// - If same prerelease type, increment prerelease number
 		// - If different prerelease type, use new type with .1
-		if b.prerelease != "" {
-			if v.IsPrerelease() {
-				// Already a prerelease, update the identifier
-				return v.WithPrerelease(b.prerelease)
+		// - Without an identifier, increment the existing prerelease number
+		if v.IsPrerelease() {
+			return SemanticVersion{
+				major:      v.major,
+				minor:      v.minor,
+				patch:      v.patch,
+				prerelease: incrementPrerelease(v.prerelease, b.prerelease),
 			}
-			// Not a prerelease, bump minor and add prerelease
+		}
+		if b.prerelease != "" {
 			return SemanticVersion{
 				major:      v.major,
 				minor:      v.minor + 1,
 				patch:      0,
-				prerelease: b.prerelease,
+				prerelease: seedPrerelease(b.prerelease),
 			}
 		}
 		return v
@@ -125,6 +139,64 @@ func (b VersionBump) Apply(v SemanticVersion) SemanticVersion {
 	}
 }
 
+// NextPrerelease returns the prerelease that follows current on the way to
+// next, the stable version the pending changes call for. If current is
+// already a prerelease of a version at or beyond next, its counter is
+// incremented (1.2.0-beta.1 -> 1.2.0-beta.2); otherwise a new series is
+// started on next (1.1.0 -> 1.2.0-beta.1).
+func NextPrerelease(current, next SemanticVersion, id Prerelease) SemanticVersion {
+	if current.IsPrerelease() && current.WithoutPrerelease().Compare(next.WithoutPrerelease()) >= 0 {
+		return NewPrereleaseBump(id).Apply(current).WithMetadata("")
+	}
+	return next.WithoutPrerelease().WithMetadata("").WithPrerelease(seedPrerelease(id))
+}
+
+// splitPrerelease splits a prerelease into its identifier and numeric
+// counter, e.g. "beta.2" -> ("beta", 2, true).
+func splitPrerelease(pre Prerelease) (Prerelease, uint64, bool) {
+	s := string(pre)
+	idx := strings.LastIndex(s, ".")
+	if idx < 0 {
+		if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return "", n, true
+		}
+		return pre, 0, false
+	}
+	n, err := strconv.ParseUint(s[idx+1:], 10, 64)
+	if err != nil {
+		return pre, 0, false
+	}
+	return Prerelease(s[:idx]), n, true
+}
+
+// seedPrerelease appends the initial counter to an identifier that has none.
+func seedPrerelease(id Prerelease) Prerelease {
+	if _, _, ok := splitPrerelease(id); ok {
+		return id
+	}
+	return id + ".1"
+}
+
+// incrementPrerelease returns the prerelease following current for id. An
+// empty id, or one matching the current identifier, increments the counter;
+// a different id starts a new series at .1.
+func incrementPrerelease(current, id Prerelease) Prerelease {
+	if _, _, ok := splitPrerelease(id); ok {
+		return id
+	}
+	base, n, ok := splitPrerelease(current)
+	if id == "" || id == base {
+		if !ok {
+			return current + ".1"
+		}
+		if base == "" {
+			return Prerelease(strconv.FormatUint(n+1, 10))
+		}
+		return Prerelease(fmt.Sprintf("%s.%d", base, n+1))
+	}
+	return seedPrerelease(id)
+}
+
 // BumpMajorVersion returns a new version with the major component incremented.
 func BumpMajorVersion(v SemanticVersion) SemanticVersion {
 	return NewVersionBump(BumpMajor).Apply(v)
@@ -124,6 +124,7 @@ func TestVersionBump_Apply_Major(t *testing.T) {
 		{"simple", "1.2.3", "2.0.0"},
 		{"from zero", "0.1.0", "1.0.0"},
 		{"with prerelease", "1.2.3-alpha", "2.0.0"},
+		{"promotes major prerelease", "2.0.0-rc.2", "2.0.0"},
 		{"with metadata", "1.2.3+build", "2.0.0"},
 		{"large version", "99.88.77", "100.0.0"},
 	}
@@ -149,6 +150,7 @@ func TestVersionBump_Apply_Minor(t *testing.T) {
 		{"simple", "1.2.3", "1.3.0"},
 		{"from zero", "0.0.0", "0.1.0"},
 		{"with prerelease", "1.2.3-alpha", "1.3.0"},
+		{"promotes minor prerelease", "1.3.0-beta.2", "1.3.0"},
 		{"with metadata", "1.2.3+build", "1.3.0"},
 		{"large version", "1.99.99", "1.100.0"},
 	}
@@ -197,11 +199,17 @@ func TestVersionBump_Apply_Prerelease(t *testing.T) {
 		prerelease Prerelease
 		want       string
 	}{
-		{"add alpha to stable", "1.2.3", PrereleaseAlpha, "1.3.0-alpha"},
-		{"add beta to stable", "1.2.3", PrereleaseBeta, "1.3.0-beta"},
-		{"add rc to stable", "1.2.3", PrereleaseRC, "1.3.0-rc"},
-		{"update alpha to beta", "1.3.0-alpha", PrereleaseBeta, "1.3.0-beta"},
-		{"same prerelease", "1.3.0-alpha", PrereleaseAlpha, "1.3.0-alpha"},
+		{"add alpha to stable", "1.2.3", PrereleaseAlpha, "1.3.0-alpha.1"},
+		{"add beta to stable", "1.2.3", PrereleaseBeta, "1.3.0-beta.1"},
+		{"add rc to stable", "1.2.3", PrereleaseRC, "1.3.0-rc.1"},
+		{"update alpha to beta", "1.3.0-alpha", PrereleaseBeta, "1.3.0-beta.1"},
+		{"same prerelease without counter", "1.3.0-alpha", PrereleaseAlpha, "1.3.0-alpha.1"},
+		{"increment counter", "1.2.0-beta.1", PrereleaseBeta, "1.2.0-beta.2"},
+		{"increment multi-digit counter", "1.2.0-beta.9", PrereleaseBeta, "1.2.0-beta.10"},
+		{"switch identifier resets counter", "1.2.0-beta.3", PrereleaseRC, "1.2.0-rc.1"},
+		{"explicit counter is kept", "1.2.0-beta.3", Prerelease("beta.7"), "1.2.0-beta.7"},
+		{"empty identifier increments existing", "1.2.0-rc.2", "", "1.2.0-rc.3"},
+		{"numeric prerelease", "1.2.0-4", "", "1.2.0-5"},
 	}
 
 	for _, tt := range tests {
@@ -226,6 +234,32 @@ func TestVersionBump_Apply_PrereleaseEmpty(t *testing.T) {
 	}
 }
 
+func TestNextPrerelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		next    string
+		id      Prerelease
+		want    string
+	}{
+		{"seeds from stable", "1.1.0", "1.2.0", PrereleaseBeta, "1.2.0-beta.1"},
+		{"continues series", "1.2.0-beta.1", "1.2.0", PrereleaseBeta, "1.2.0-beta.2"},
+		{"patch changes continue series", "1.2.0-beta.2", "1.2.0", PrereleaseBeta, "1.2.0-beta.3"},
+		{"switches identifier", "1.2.0-beta.2", "1.2.0", PrereleaseRC, "1.2.0-rc.1"},
+		{"breaking change starts new series", "1.2.0-beta.2", "2.0.0", PrereleaseBeta, "2.0.0-beta.1"},
+		{"drops metadata", "1.1.0+build.5", "1.2.0+build.5", PrereleaseAlpha, "1.2.0-alpha.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextPrerelease(MustParse(tt.current), MustParse(tt.next), tt.id)
+			if got.String() != tt.want {
+				t.Errorf("NextPrerelease(%s, %s, %s) = %v, want %v", tt.current, tt.next, tt.id, got.String(), tt.want)
+			}
+		})
+	}
+}
+
 func TestVersionBump_Apply_UnknownType(t *testing.T) {
 	// Unknown bump type should return version unchanged
 	v := MustParse("1.2.3")
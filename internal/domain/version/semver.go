@@ -244,13 +244,46 @@ func (v SemanticVersion) Compare(other SemanticVersion) int {
 	if v.prerelease != "" && other.prerelease == "" {
 		return -1
 	}
-	if v.prerelease < other.prerelease {
-		return -1
+	return comparePrerelease(v.prerelease, other.prerelease)
+}
+
+// comparePrerelease compares two prerelease strings by SemVer precedence:
+// dot-separated identifiers are compared left to right, numeric identifiers
+// numerically and below alphanumeric ones, and a shorter series of otherwise
+// equal identifiers sorts first (so rc.2 < rc.10 and rc < rc.1).
+func comparePrerelease(a, b Prerelease) int {
+	if a == b {
+		return 0
+	}
+	aIDs := strings.Split(string(a), ".")
+	bIDs := strings.Split(string(b), ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		aNum, aErr := strconv.ParseUint(aIDs[i], 10, 64)
+		bNum, bErr := strconv.ParseUint(bIDs[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(aIDs[i], bIDs[i]); c != 0 {
+				return c
+			}
+		}
 	}
-	if v.prerelease > other.prerelease {
+	switch {
+	case len(aIDs) < len(bIDs):
+		return -1
+	case len(aIDs) > len(bIDs):
 		return 1
 	}
-
 	return 0
 }
 
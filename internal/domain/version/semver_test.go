@@ -84,6 +84,12 @@ func TestSemanticVersion_Compare(t *testing.T) {
 		{"prerelease vs stable", "1.0.0-alpha", "1.0.0", -1},
 		{"stable vs prerelease", "1.0.0", "1.0.0-alpha", 1},
 		{"prerelease ordering", "1.0.0-alpha", "1.0.0-beta", -1},
+		{"rc vs release", "1.0.0-rc.1", "1.0.0", -1},
+		{"numeric identifiers", "1.0.0-rc.2", "1.0.0-rc.10", -1},
+		{"numeric below alphanumeric", "1.0.0-1", "1.0.0-alpha", -1},
+		{"shorter series first", "1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"equal prereleases", "1.0.0-rc.1", "1.0.0-rc.1", 0},
+		{"prerelease of next patch", "1.0.0", "1.0.1-rc.1", -1},
 	}
 
 	for _, tt := range tests {
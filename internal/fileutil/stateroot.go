@@ -0,0 +1,51 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StateRoot returns the directory holding the .relicta/ release state of
+// the repository checked out at repoRoot. For a linked git worktree (git
+// worktree add) this is the main working tree, so that release state is
+// shared rather than written into throwaway worktree directories. Regular
+// repositories, submodules and worktrees of bare repositories keep their
+// state at repoRoot.
+func StateRoot(repoRoot string) string {
+	dotGit := filepath.Join(repoRoot, ".git")
+	info, err := os.Lstat(dotGit)
+	if err != nil || info.IsDir() {
+		return repoRoot
+	}
+
+	// A linked worktree has a .git file pointing at .git/worktrees/<name>,
+	// whose commondir file points back at the shared git dir.
+	data, err := ReadFileLimited(dotGit, 4<<10)
+	if err != nil {
+		return repoRoot
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return repoRoot
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(repoRoot, gitDir)
+	}
+
+	common, err := ReadFileLimited(filepath.Join(gitDir, "commondir"), 4<<10)
+	if err != nil {
+		return repoRoot // Submodule, not a linked worktree
+	}
+	commonDir := strings.TrimSpace(string(common))
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+	commonDir = filepath.Clean(commonDir)
+
+	if filepath.Base(commonDir) != ".git" {
+		return repoRoot // Bare repository has no main working tree
+	}
+	return filepath.Dir(commonDir)
+}
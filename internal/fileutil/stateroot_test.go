@@ -0,0 +1,55 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStateRoot(t *testing.T) {
+	main := t.TempDir()
+	gitDir := filepath.Join(main, ".git")
+	wtGitDir := filepath.Join(gitDir, "worktrees", "wt")
+	if err := os.MkdirAll(wtGitDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(wtGitDir, "commondir"), []byte("../..\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	worktree := t.TempDir()
+	if err := os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+wtGitDir+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	submodule := t.TempDir()
+	subGitDir := filepath.Join(gitDir, "modules", "sub")
+	if err := os.MkdirAll(subGitDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(submodule, ".git"), []byte("gitdir: "+subGitDir+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		root string
+		want string
+	}{
+		{"main working tree", main, main},
+		{"linked worktree", worktree, main},
+		{"submodule", submodule, submodule},
+		{"not a repository", t.TempDir(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = tt.root
+			}
+			if got := StateRoot(tt.root); got != want {
+				t.Errorf("StateRoot(%q) = %q, want %q", tt.root, got, want)
+			}
+		})
+	}
+}
@@ -142,7 +142,8 @@ type RejectRequest struct {
 	Reason string `json:"reason"`
 }
 
-// RejectRelease rejects a pending release.
+// RejectRelease rejects a pending release, requesting changes to its notes.
+// The release can be revised and resubmitted for approval.
 func RejectRelease(w http.ResponseWriter, r *http.Request) {
 	user := middleware.GetUser(r)
 	if user == nil || !user.CanApprove() {
@@ -151,7 +152,7 @@ func RejectRelease(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := GetContext()
-	if ctx == nil || ctx.ReleaseServices == nil {
+	if ctx == nil || ctx.ReleaseServices == nil || ctx.ReleaseServices.RejectRelease == nil {
 		respondError(w, http.StatusServiceUnavailable, "release service not available", "")
 		return
 	}
@@ -176,29 +177,31 @@ func RejectRelease(w http.ResponseWriter, r *http.Request) {
 		req.Reason = "Rejected via dashboard"
 	}
 
-	// Load and cancel the run
-	run, err := ctx.ReleaseServices.Repository.Load(r.Context(), domain.RunID(runID))
+	repoRoot, err := os.Getwd()
 	if err != nil {
-		respondError(w, http.StatusNotFound, "release not found", err.Error())
+		respondError(w, http.StatusInternalServerError, "failed to get working directory", "")
 		return
 	}
 
-	// Cancel the release
-	if err := run.Cancel(req.Reason, user.Name); err != nil {
+	output, err := ctx.ReleaseServices.RejectRelease.Execute(r.Context(), app.RejectReleaseInput{
+		RepoRoot: repoRoot,
+		RunID:    domain.RunID(runID),
+		Actor: ports.ActorInfo{
+			Type: domain.ActorHuman,
+			ID:   user.Name,
+		},
+		Reason: req.Reason,
+	})
+	if err != nil {
 		respondError(w, http.StatusBadRequest, "failed to reject release", err.Error())
 		return
 	}
 
-	// Save the updated run
-	if err := ctx.ReleaseServices.Repository.Save(r.Context(), run); err != nil {
-		respondError(w, http.StatusInternalServerError, "failed to save rejection", err.Error())
-		return
-	}
-
 	respondJSON(w, http.StatusOK, map[string]any{
 		"rejected":    true,
-		"run_id":      runID,
-		"reason":      req.Reason,
-		"rejected_by": user.Name,
+		"run_id":      string(output.RunID),
+		"reason":      output.Reason,
+		"rejected_by": output.RejectedBy,
+		"state":       string(domain.StateChangesRequested),
 	})
 }
@@ -33,6 +33,11 @@ func (s *Server) setupRouter() chi.Router {
 	r.Get("/health", handlers.Health)
 	r.Get("/api/v1/health", handlers.Health)
 
+	// Prometheus metrics (authenticated, when enabled)
+	if s.metrics != nil {
+		r.With(middleware.Auth(s.config.Auth)).Handle("/metrics", s.metrics)
+	}
+
 	// API routes (authenticated)
 	r.Route("/api/v1", func(r chi.Router) {
 		// Apply authentication middleware
@@ -21,6 +21,7 @@ import (
 // Server is the HTTP server for the dashboard.
 type Server struct {
 	config     config.DashboardConfig
+	metrics    http.Handler
 	router     chi.Router
 	httpServer *http.Server
 	wsHub      *httpws.Hub
@@ -32,6 +33,7 @@ type ServerDeps struct {
 	Config          config.DashboardConfig
 	Frontend        fs.FS             // Embedded frontend files (nil for API-only mode)
 	ReleaseServices *release.Services // Release domain services (optional)
+	Metrics         http.Handler      // Prometheus metrics handler (optional)
 }
 
 // NewServer creates a new HTTP server for the dashboard.
@@ -40,6 +42,7 @@ func NewServer(deps ServerDeps) *Server {
 		config:   deps.Config,
 		wsHub:    httpws.NewHub(deps.Config.CORSOrigins),
 		frontend: deps.Frontend,
+		metrics:  deps.Metrics,
 	}
 
 	// Set handler context for dependency injection
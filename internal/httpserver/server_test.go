@@ -162,6 +162,40 @@ func TestAPIAuthenticationWithBearerToken(t *testing.T) {
 	}
 }
 
+func TestMetricsAuthenticationRequired(t *testing.T) {
+	cfg := config.DashboardConfig{
+		Address: ":0",
+		Auth: config.DashboardAuthConfig{
+			Mode: config.DashboardAuthAPIKey,
+			APIKeys: []config.DashboardAPIKeyConfig{
+				{Key: "metrics-key", Name: "Test", Roles: []string{"viewer"}},
+			},
+		},
+	}
+
+	server := NewServer(ServerDeps{
+		Config: cfg,
+		Metrics: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("relicta_up 1\n"))
+		}),
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	server.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 without API key, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("X-API-Key", "metrics-key")
+	rec = httptest.NewRecorder()
+	server.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 with valid API key, got %d", rec.Code)
+	}
+}
+
 func TestServerShutdown(t *testing.T) {
 	cfg := config.DashboardConfig{
 		Address: ":0",
@@ -78,6 +78,14 @@ func (b *EventBroadcaster) eventToMessage(event domain.DomainEvent) Message {
 		payload["approved_at"] = e.At.Format(time.RFC3339)
 		return Message{Type: "release.approved", Payload: payload}
 
+	case *domain.RunRejectedEvent:
+		payload["run_id"] = string(e.RunID)
+		payload["plan_hash"] = e.PlanHash
+		payload["reason"] = e.Reason
+		payload["rejected_by"] = e.RejectedBy
+		payload["rejected_at"] = e.At.Format(time.RFC3339)
+		return Message{Type: "release.rejected", Payload: payload}
+
 	case *domain.RunPublishedEvent:
 		payload["run_id"] = string(e.RunID)
 		payload["version"] = e.Version.String()
@@ -98,6 +98,17 @@ func TestEventBroadcaster_EventToMessage(t *testing.T) {
 			},
 			expectedType: "release.approved",
 		},
+		{
+			name: "RunRejectedEvent",
+			event: &domain.RunRejectedEvent{
+				RunID:      "run-1",
+				PlanHash:   "hash123",
+				Reason:     "notes miss the migration steps",
+				RejectedBy: "reviewer",
+				At:         time.Now(),
+			},
+			expectedType: "release.rejected",
+		},
 		{
 			name: "RunPublishedEvent",
 			event: &domain.RunPublishedEvent{
@@ -0,0 +1,130 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// commentsPerPage is the page size used when listing comments.
+const commentsPerPage = 100
+
+// IssueComment is a comment on a GitHub issue or pull request.
+type IssueComment struct {
+	ID      int64  `json:"id"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
+// OpenPullRequest is an open GitHub pull request.
+type OpenPullRequest struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+}
+
+// FindPullRequest returns the open pull request whose head is branch.
+func (c *GitHubClient) FindPullRequest(ctx context.Context, owner, repo, branch string) (*OpenPullRequest, error) {
+	query := url.Values{}
+	query.Set("state", "open")
+	query.Set("head", owner+":"+branch)
+
+	var prs []OpenPullRequest
+	path := fmt.Sprintf("/repos/%s/%s/pulls?%s", owner, repo, query.Encode())
+	if err := c.doJSON(ctx, http.MethodGet, path, nil, http.StatusOK, &prs); err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	if len(prs) == 0 {
+		return nil, fmt.Errorf("no open pull request for branch %s", branch)
+	}
+	return &prs[0], nil
+}
+
+// UpsertComment creates a comment on an issue or pull request, or updates the
+// first existing comment containing marker so that repeated calls edit one
+// sticky comment. body should contain marker. It reports whether a new
+// comment was created.
+func (c *GitHubClient) UpsertComment(ctx context.Context, owner, repo string, number int, marker, body string) (*IssueComment, bool, error) {
+	existing, err := c.findComment(ctx, owner, repo, number, marker)
+	if err != nil {
+		return nil, false, err
+	}
+
+	payload := map[string]string{"body": body}
+	var comment IssueComment
+	if existing != nil {
+		path := fmt.Sprintf("/repos/%s/%s/issues/comments/%d", owner, repo, existing.ID)
+		if err := c.doJSON(ctx, http.MethodPatch, path, payload, http.StatusOK, &comment); err != nil {
+			return nil, false, fmt.Errorf("failed to update comment %d: %w", existing.ID, err)
+		}
+		return &comment, false, nil
+	}
+
+	path := fmt.Sprintf("/repos/%s/%s/issues/%d/comments", owner, repo, number)
+	if err := c.doJSON(ctx, http.MethodPost, path, payload, http.StatusCreated, &comment); err != nil {
+		return nil, false, fmt.Errorf("failed to create comment on #%d: %w", number, err)
+	}
+	return &comment, true, nil
+}
+
+// findComment returns the first comment on the issue containing marker, or
+// nil if there is none.
+func (c *GitHubClient) findComment(ctx context.Context, owner, repo string, number int, marker string) (*IssueComment, error) {
+	for page := 1; ; page++ {
+		var comments []IssueComment
+		path := fmt.Sprintf("/repos/%s/%s/issues/%d/comments?per_page=%d&page=%d", owner, repo, number, commentsPerPage, page)
+		if err := c.doJSON(ctx, http.MethodGet, path, nil, http.StatusOK, &comments); err != nil {
+			return nil, fmt.Errorf("failed to list comments on #%d: %w", number, err)
+		}
+		for i := range comments {
+			if strings.Contains(comments[i].Body, marker) {
+				return &comments[i], nil
+			}
+		}
+		if len(comments) < commentsPerPage {
+			return nil, nil
+		}
+	}
+}
+
+// doJSON sends a request with an optional JSON body and decodes the JSON
+// response into out, failing unless the response has the wanted status.
+func (c *GitHubClient) doJSON(ctx context.Context, method, path string, in any, wantStatus int, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := c.forge.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.forge.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// mockCommentAPI is an in-memory GitHub issue comment API.
+type mockCommentAPI struct {
+	mu       sync.Mutex
+	comments []IssueComment
+	nextID   int64
+	requests []string
+}
+
+func (m *mockCommentAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, r.Method+" "+r.URL.Path)
+
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var in struct {
+		Body string `json:"body"`
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/repos/acme/widget/pulls":
+		if r.URL.Query().Get("head") != "acme:release/1.1" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]OpenPullRequest{{Number: 7, Title: "Release 1.1"}})
+	case r.Method == http.MethodGet && r.URL.Path == "/repos/acme/widget/issues/7/comments":
+		page := []IssueComment{}
+		if r.URL.Query().Get("page") == "1" {
+			page = m.comments
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	case r.Method == http.MethodPost && r.URL.Path == "/repos/acme/widget/issues/7/comments":
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		m.nextID++
+		comment := IssueComment{ID: m.nextID, Body: in.Body}
+		m.comments = append(m.comments, comment)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(comment)
+	case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/repos/acme/widget/issues/comments/"):
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		for i := range m.comments {
+			if fmt.Sprintf("/repos/acme/widget/issues/comments/%d", m.comments[i].ID) == r.URL.Path {
+				m.comments[i].Body = in.Body
+				_ = json.NewEncoder(w).Encode(m.comments[i])
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newCommentClient(t *testing.T, api *mockCommentAPI) *GitHubClient {
+	t.Helper()
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	client, err := NewGitHubClient("test-token", WithAPIURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestGitHubClient_UpsertComment(t *testing.T) {
+	const marker = "<!-- relicta:release-plan -->"
+	api := &mockCommentAPI{comments: []IssueComment{{ID: 100, Body: "LGTM"}}, nextID: 100}
+	client := newCommentClient(t, api)
+	ctx := context.Background()
+
+	comment, created, err := client.UpsertComment(ctx, "acme", "widget", 7, marker, marker+"\nv1.1.0")
+	if err != nil {
+		t.Fatalf("UpsertComment() error = %v", err)
+	}
+	if !created || comment.ID != 101 {
+		t.Errorf("first UpsertComment() = %+v, created %v; want new comment 101", comment, created)
+	}
+
+	comment, created, err = client.UpsertComment(ctx, "acme", "widget", 7, marker, marker+"\nv1.2.0")
+	if err != nil {
+		t.Fatalf("UpsertComment() error = %v", err)
+	}
+	if created || comment.ID != 101 {
+		t.Errorf("second UpsertComment() = %+v, created %v; want comment 101 updated", comment, created)
+	}
+
+	if len(api.comments) != 2 {
+		t.Fatalf("comments = %+v, want the original and one sticky comment", api.comments)
+	}
+	if api.comments[0].Body != "LGTM" || !strings.HasSuffix(api.comments[1].Body, "v1.2.0") {
+		t.Errorf("comments = %+v", api.comments)
+	}
+}
+
+func TestGitHubClient_FindPullRequest(t *testing.T) {
+	client := newCommentClient(t, &mockCommentAPI{})
+	ctx := context.Background()
+
+	pr, err := client.FindPullRequest(ctx, "acme", "widget", "release/1.1")
+	if err != nil {
+		t.Fatalf("FindPullRequest() error = %v", err)
+	}
+	if pr.Number != 7 {
+		t.Errorf("FindPullRequest() = %+v, want #7", pr)
+	}
+
+	if _, err := client.FindPullRequest(ctx, "acme", "widget", "main"); err == nil || !strings.Contains(err.Error(), "no open pull request") {
+		t.Errorf("FindPullRequest(main) error = %v", err)
+	}
+}
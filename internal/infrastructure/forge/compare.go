@@ -0,0 +1,186 @@
+package forge
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// githubNotesEntryRegex matches the entries of GitHub's generated notes:
+	// "* Title by @user in https://github.com/owner/repo/pull/12".
+	githubNotesEntryRegex = regexp.MustCompile(`^\s*[*-]\s+(.+?)\s+by\s+@(\S+)\s+in\s+(?:\S+/pull/|#)(\d+)\s*$`)
+
+	// prRefRegex matches pull request references in changelog text:
+	// "#12" or ".../pull/12".
+	prRefRegex = regexp.MustCompile(`(?:/pull/|#)(\d+)\b`)
+
+	// subjectPRRegex matches the pull request number in a squash-merge
+	// subject ("feat: add x (#12)") or a merge commit subject
+	// ("Merge pull request #12 from ...").
+	subjectPRRegex = regexp.MustCompile(`\(#(\d+)\)\s*$|^Merge pull request #(\d+)\b`)
+
+	// conventionalPrefixRegex matches the "type(scope)!: " subject prefix.
+	conventionalPrefixRegex = regexp.MustCompile(`^\w+(?:\([^)]*\))?!?\s*:\s*`)
+)
+
+// PullRequest is a pull request listed in release notes.
+type PullRequest struct {
+	Number int    `json:"number"`
+	Title  string `json:"title,omitempty"`
+	Author string `json:"author,omitempty"`
+}
+
+// Commit is a commit in the release range.
+type Commit struct {
+	SHA     string `json:"sha"`
+	Subject string `json:"subject"`
+}
+
+// NotesDiscrepancy is a pull request listed in only one of the notes.
+type NotesDiscrepancy struct {
+	PullRequest
+	// Commits are the commits in the release range that reference the pull
+	// request.
+	Commits []Commit `json:"commits,omitempty"`
+	// Reason explains the likely cause of the discrepancy.
+	Reason string `json:"reason"`
+}
+
+// NotesComparison is the result of comparing relicta's notes with GitHub's
+// generated notes.
+type NotesComparison struct {
+	// Matched lists the pull requests present in both notes.
+	Matched []int `json:"matched"`
+	// OnlyInGitHub lists pull requests missing from relicta's notes.
+	OnlyInGitHub []NotesDiscrepancy `json:"only_in_github"`
+	// OnlyInRelicta lists pull requests missing from GitHub's notes.
+	OnlyInRelicta []NotesDiscrepancy `json:"only_in_relicta"`
+	// UnreferencedCommits lists commits in the range that reference no pull
+	// request and cannot be compared.
+	UnreferencedCommits []Commit `json:"unreferenced_commits,omitempty"`
+}
+
+// HasDiscrepancies reports whether the notes disagree.
+func (c *NotesComparison) HasDiscrepancies() bool {
+	return len(c.OnlyInGitHub) > 0 || len(c.OnlyInRelicta) > 0
+}
+
+// ParseGitHubNotes extracts the pull requests listed in GitHub's generated
+// release notes.
+func ParseGitHubNotes(body string) []PullRequest {
+	var prs []PullRequest
+	seen := make(map[int]bool)
+	for _, line := range strings.Split(body, "\n") {
+		m := githubNotesEntryRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		n, err := strconv.Atoi(m[3])
+		if err != nil || seen[n] {
+			continue
+		}
+		seen[n] = true
+		prs = append(prs, PullRequest{Number: n, Title: m[1], Author: m[2]})
+	}
+	return prs
+}
+
+// PullRequestFromSubject returns the pull request number referenced by a
+// squash-merge or merge commit subject, or 0.
+func PullRequestFromSubject(subject string) int {
+	m := subjectPRRegex.FindStringSubmatch(strings.TrimSpace(subject))
+	if m == nil {
+		return 0
+	}
+	ref := m[1]
+	if ref == "" {
+		ref = m[2]
+	}
+	n, _ := strconv.Atoi(ref)
+	return n
+}
+
+// CompareNotes compares relicta's notes for a release range with GitHub's
+// generated notes for the same range. A pull request counts as included in
+// relicta's notes when the notes reference it or contain the description of
+// a commit that references it.
+func CompareNotes(relictaNotes, githubNotes string, commits []Commit) *NotesComparison {
+	github := make(map[int]PullRequest)
+	for _, pr := range ParseGitHubNotes(githubNotes) {
+		github[pr.Number] = pr
+	}
+
+	relicta := make(map[int]bool)
+	for _, m := range prRefRegex.FindAllStringSubmatch(relictaNotes, -1) {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			relicta[n] = true
+		}
+	}
+
+	result := &NotesComparison{
+		Matched:       []int{},
+		OnlyInGitHub:  []NotesDiscrepancy{},
+		OnlyInRelicta: []NotesDiscrepancy{},
+	}
+
+	byPR := make(map[int][]Commit)
+	for _, c := range commits {
+		n := PullRequestFromSubject(c.Subject)
+		if n == 0 {
+			result.UnreferencedCommits = append(result.UnreferencedCommits, c)
+			continue
+		}
+		byPR[n] = append(byPR[n], c)
+		if desc := commitDescription(c.Subject); desc != "" && strings.Contains(relictaNotes, desc) {
+			relicta[n] = true
+		}
+	}
+
+	for _, n := range sortedKeys(github) {
+		if relicta[n] {
+			result.Matched = append(result.Matched, n)
+			continue
+		}
+		d := NotesDiscrepancy{PullRequest: github[n], Commits: byPR[n]}
+		if len(d.Commits) > 0 {
+			d.Reason = "commit in the release range was excluded from relicta's notes"
+		} else {
+			d.Reason = "no commit in the release range references this pull request"
+		}
+		result.OnlyInGitHub = append(result.OnlyInGitHub, d)
+	}
+
+	for _, n := range sortedKeys(relicta) {
+		if _, ok := github[n]; ok {
+			continue
+		}
+		result.OnlyInRelicta = append(result.OnlyInRelicta, NotesDiscrepancy{
+			PullRequest: PullRequest{Number: n},
+			Commits:     byPR[n],
+			Reason:      "not listed in GitHub's generated notes",
+		})
+	}
+
+	return result
+}
+
+// commitDescription returns the subject without its conventional commit
+// prefix and pull request suffix, as it appears in relicta's notes.
+func commitDescription(subject string) string {
+	desc := conventionalPrefixRegex.ReplaceAllString(strings.TrimSpace(subject), "")
+	if i := strings.LastIndex(desc, "(#"); i > 0 {
+		desc = desc[:i]
+	}
+	return strings.TrimSpace(desc)
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
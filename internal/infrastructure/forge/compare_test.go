@@ -0,0 +1,114 @@
+package forge
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseGitHubNotes(t *testing.T) {
+	prs := ParseGitHubNotes(generatedNotesBody)
+
+	want := []PullRequest{
+		{Number: 12, Title: "feat: add retries", Author: "alice"},
+		{Number: 15, Title: "fix: handle empty config", Author: "bob"},
+		{Number: 16, Title: "chore(deps): bump x", Author: "dependabot[bot]"},
+	}
+	if !reflect.DeepEqual(prs, want) {
+		t.Errorf("ParseGitHubNotes() = %+v, want %+v", prs, want)
+	}
+}
+
+func TestPullRequestFromSubject(t *testing.T) {
+	tests := map[string]int{
+		"feat: add retries (#12)":                   12,
+		"Merge pull request #15 from bob/fix-empty": 15,
+		"fix: see #3 for details":                   0,
+		"docs: update readme":                       0,
+	}
+	for subject, want := range tests {
+		if got := PullRequestFromSubject(subject); got != want {
+			t.Errorf("PullRequestFromSubject(%q) = %d, want %d", subject, got, want)
+		}
+	}
+}
+
+func TestCompareNotes(t *testing.T) {
+	relictaNotes := `# Release 1.1.0
+
+## What's New
+
+- add retries
+
+## Bug Fixes
+
+- **api:** fix timeout handling (#20)
+`
+	commits := []Commit{
+		{SHA: "1111111111", Subject: "feat: add retries (#12)"},
+		{SHA: "2222222222", Subject: "Merge pull request #15 from bob/fix-empty"},
+		{SHA: "3333333333", Subject: "chore(deps): bump x (#16)"},
+		{SHA: "4444444444", Subject: "fix(api): fix timeout handling (#20)"},
+		{SHA: "5555555555", Subject: "refactor: tidy"},
+	}
+
+	c := CompareNotes(relictaNotes, generatedNotesBody, commits)
+
+	if !reflect.DeepEqual(c.Matched, []int{12}) {
+		t.Errorf("Matched = %v, want [12]", c.Matched)
+	}
+	if !c.HasDiscrepancies() {
+		t.Fatal("HasDiscrepancies() = false, want true")
+	}
+
+	if len(c.OnlyInGitHub) != 2 {
+		t.Fatalf("OnlyInGitHub = %+v, want #15 and #16", c.OnlyInGitHub)
+	}
+	for i, n := range []int{15, 16} {
+		d := c.OnlyInGitHub[i]
+		if d.Number != n || len(d.Commits) != 1 || d.Reason != "commit in the release range was excluded from relicta's notes" {
+			t.Errorf("OnlyInGitHub[%d] = %+v", i, d)
+		}
+	}
+
+	if len(c.OnlyInRelicta) != 1 || c.OnlyInRelicta[0].Number != 20 || c.OnlyInRelicta[0].Commits[0].SHA != "4444444444" {
+		t.Errorf("OnlyInRelicta = %+v, want #20", c.OnlyInRelicta)
+	}
+	if len(c.UnreferencedCommits) != 1 || c.UnreferencedCommits[0].SHA != "5555555555" {
+		t.Errorf("UnreferencedCommits = %+v", c.UnreferencedCommits)
+	}
+}
+
+func TestCompareNotes_PullRequestOutsideRange(t *testing.T) {
+	c := CompareNotes("- add retries (#12)", generatedNotesBody, nil)
+
+	if len(c.OnlyInGitHub) != 2 {
+		t.Fatalf("OnlyInGitHub = %+v", c.OnlyInGitHub)
+	}
+	if c.OnlyInGitHub[0].Reason != "no commit in the release range references this pull request" {
+		t.Errorf("Reason = %q", c.OnlyInGitHub[0].Reason)
+	}
+}
+
+func TestCompareNotes_AgainstMockForge(t *testing.T) {
+	var got GenerateNotesRequest
+	srv := newMockForge(t, &got)
+	client, err := NewGitHubClient("test-token", WithAPIURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notes, err := client.GenerateReleaseNotes(context.Background(), "acme", "widget", GenerateNotesRequest{TagName: "v1.1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relictaNotes := "- add retries (#12)\n- handle empty config (#15)\n- bump x (#16)\n"
+	c := CompareNotes(relictaNotes, notes.Body, nil)
+	if c.HasDiscrepancies() {
+		t.Errorf("unexpected discrepancies: %+v", c)
+	}
+	if !reflect.DeepEqual(c.Matched, []int{12, 15, 16}) {
+		t.Errorf("Matched = %v", c.Matched)
+	}
+}
@@ -0,0 +1,164 @@
+// Package forge provides access to forge (GitHub) APIs used for release QA
+// and review: generated release notes and pull request comments.
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/relicta-tech/relicta/pkg/plugin"
+)
+
+// DefaultGitHubAPIURL is the GitHub API base URL.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// ErrNoToken is returned when a forge token is required but not configured.
+var ErrNoToken = errors.New("forge token not configured: set GITHUB_TOKEN or GH_TOKEN")
+
+// GitHubTokenFromEnv returns the GitHub token from GITHUB_TOKEN or GH_TOKEN.
+func GitHubTokenFromEnv() string {
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		return token
+	}
+	return os.Getenv("GH_TOKEN")
+}
+
+// GitHubClient is a minimal GitHub REST API client. Requests go through the
+// shared, rate-limit-aware forge client for the API URL and token.
+type GitHubClient struct {
+	forge *plugin.ForgeClient
+}
+
+// Option configures a GitHubClient.
+type Option func(*githubOptions)
+
+type githubOptions struct {
+	apiURL     string
+	forgeOpts  []plugin.ForgeOption
+	httpClient *http.Client
+}
+
+// WithHTTPClient sets the HTTP client used for API calls.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *githubOptions) {
+		o.httpClient = client
+	}
+}
+
+// WithAPIURL overrides the GitHub API base URL, e.g. for GitHub Enterprise.
+func WithAPIURL(url string) Option {
+	return func(o *githubOptions) {
+		o.apiURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithLogger sets the logger that reports the remaining rate limit at
+// debug level.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *githubOptions) {
+		o.forgeOpts = append(o.forgeOpts, plugin.WithForgeLogger(logger))
+	}
+}
+
+// NewGitHubClient creates a client authenticating with token. Proxy settings
+// are taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables. Clients for the same API URL and token share their rate limit
+// state.
+func NewGitHubClient(token string, opts ...Option) (*GitHubClient, error) {
+	if token == "" {
+		return nil, ErrNoToken
+	}
+	o := &githubOptions{apiURL: DefaultGitHubAPIURL}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.httpClient != nil {
+		o.forgeOpts = append(o.forgeOpts, plugin.WithForgeHTTPClient(o.httpClient))
+	}
+	return &GitHubClient{forge: plugin.SharedForgeClient(o.apiURL, token, o.forgeOpts...)}, nil
+}
+
+// RateLimit returns the last rate limit reported by GitHub.
+func (c *GitHubClient) RateLimit() plugin.RateLimit {
+	return c.forge.RateLimit()
+}
+
+// CheckRateLimit queries the rate limit endpoint, which does not count
+// against the limit.
+func (c *GitHubClient) CheckRateLimit(ctx context.Context) (plugin.RateLimit, error) {
+	req, err := c.forge.NewRequest(ctx, http.MethodGet, "/rate_limit", nil)
+	if err != nil {
+		return plugin.RateLimit{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.forge.Do(req)
+	if err != nil {
+		return plugin.RateLimit{}, fmt.Errorf("failed to query GitHub rate limit: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return plugin.RateLimit{}, fmt.Errorf("unexpected status code %d querying GitHub rate limit", resp.StatusCode)
+	}
+	return c.forge.RateLimit(), nil
+}
+
+// GenerateNotesRequest selects the range for GitHub's generated release notes.
+type GenerateNotesRequest struct {
+	// TagName is the tag of the release. It does not need to exist.
+	TagName string `json:"tag_name"`
+	// TargetCommitish is the commit the tag points to if it does not exist.
+	TargetCommitish string `json:"target_commitish,omitempty"`
+	// PreviousTagName is the start of the range. GitHub picks the previous
+	// release if it is empty.
+	PreviousTagName string `json:"previous_tag_name,omitempty"`
+}
+
+// GeneratedNotes are release notes generated by GitHub.
+type GeneratedNotes struct {
+	Name string `json:"name"`
+	Body string `json:"body"`
+}
+
+// GenerateReleaseNotes asks GitHub to generate release notes for the range.
+// It does not create or modify a release.
+func (c *GitHubClient) GenerateReleaseNotes(ctx context.Context, owner, repo string, in GenerateNotesRequest) (*GeneratedNotes, error) {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	path := fmt.Sprintf("/repos/%s/%s/releases/generate-notes", owner, repo)
+	req, err := c.forge.NewRequest(ctx, http.MethodPost, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.forge.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate GitHub release notes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status code %d generating GitHub release notes: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var notes GeneratedNotes
+	if err := json.NewDecoder(resp.Body).Decode(&notes); err != nil {
+		return nil, fmt.Errorf("failed to decode generated notes: %w", err)
+	}
+	return &notes, nil
+}
@@ -0,0 +1,139 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const generatedNotesBody = `## What's Changed
+* feat: add retries by @alice in https://github.com/acme/widget/pull/12
+* fix: handle empty config by @bob in https://github.com/acme/widget/pull/15
+* chore(deps): bump x by @dependabot[bot] in https://github.com/acme/widget/pull/16
+
+## New Contributors
+* @bob made their first contribution in https://github.com/acme/widget/pull/15
+
+**Full Changelog**: https://github.com/acme/widget/compare/v1.0.0...v1.1.0`
+
+// newMockForge starts a mock GitHub API serving generated release notes.
+func newMockForge(t *testing.T, got *GenerateNotesRequest) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/acme/widget/releases/generate-notes" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(GeneratedNotes{Name: got.TagName, Body: generatedNotesBody})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewGitHubClient_RequiresToken(t *testing.T) {
+	if _, err := NewGitHubClient(""); !errors.Is(err, ErrNoToken) {
+		t.Fatalf("NewGitHubClient(\"\") error = %v, want ErrNoToken", err)
+	}
+}
+
+func TestGitHubTokenFromEnv(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "gh")
+	if got := GitHubTokenFromEnv(); got != "gh" {
+		t.Errorf("GitHubTokenFromEnv() = %q, want gh", got)
+	}
+	t.Setenv("GITHUB_TOKEN", "github")
+	if got := GitHubTokenFromEnv(); got != "github" {
+		t.Errorf("GitHubTokenFromEnv() = %q, want github", got)
+	}
+}
+
+func TestGitHubClient_GenerateReleaseNotes(t *testing.T) {
+	var got GenerateNotesRequest
+	srv := newMockForge(t, &got)
+
+	client, err := NewGitHubClient("test-token", WithAPIURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notes, err := client.GenerateReleaseNotes(context.Background(), "acme", "widget", GenerateNotesRequest{
+		TagName:         "v1.1.0",
+		TargetCommitish: "abc123",
+		PreviousTagName: "v1.0.0",
+	})
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+	if notes.Name != "v1.1.0" || !strings.Contains(notes.Body, "pull/12") {
+		t.Errorf("unexpected notes: %+v", notes)
+	}
+	if got.TagName != "v1.1.0" || got.TargetCommitish != "abc123" || got.PreviousTagName != "v1.0.0" {
+		t.Errorf("unexpected request: %+v", got)
+	}
+}
+
+func TestGitHubClient_GenerateReleaseNotesError(t *testing.T) {
+	var got GenerateNotesRequest
+	srv := newMockForge(t, &got)
+
+	client, err := NewGitHubClient("wrong-token", WithAPIURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GenerateReleaseNotes(context.Background(), "acme", "widget", GenerateNotesRequest{TagName: "v1.1.0"})
+	if err == nil || !strings.Contains(err.Error(), "401") || !strings.Contains(err.Error(), "Bad credentials") {
+		t.Fatalf("GenerateReleaseNotes() error = %v, want 401 with message", err)
+	}
+}
+
+func TestGitHubClient_CheckRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rate_limit" || r.Header.Get("Authorization") != "Bearer rate-token" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4321")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewGitHubClient("rate-token", WithAPIURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rate, err := client.CheckRateLimit(context.Background())
+	if err != nil {
+		t.Fatalf("CheckRateLimit() error = %v", err)
+	}
+	if rate.Limit != 5000 || rate.Remaining != 4321 {
+		t.Errorf("CheckRateLimit() = %+v, want 4321/5000", rate)
+	}
+
+	// Clients for the same API URL and token share the rate limit state.
+	other, err := NewGitHubClient("rate-token", WithAPIURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.RateLimit().Remaining != 4321 {
+		t.Errorf("shared client RateLimit() = %+v, want the state of the first client", other.RateLimit())
+	}
+}
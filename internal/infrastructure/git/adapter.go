@@ -3,6 +3,8 @@ package git
 
 import (
 	"context"
+	"log/slog"
+	"regexp"
 	"strings"
 	"time"
 
@@ -42,12 +44,28 @@ func withRemoteTimeout(ctx context.Context) (context.Context, context.CancelFunc
 
 // Adapter adapts the existing git service to the domain interface.
 type Adapter struct {
-	svc Service
+	svc        Service
+	tagPattern *regexp.Regexp
+}
+
+// AdapterOption configures the git adapter.
+type AdapterOption func(*Adapter)
+
+// WithTagPattern restricts version tags to tags whose names match pattern.
+// Other tags are still listed but are not treated as versions.
+func WithTagPattern(pattern *regexp.Regexp) AdapterOption {
+	return func(a *Adapter) {
+		a.tagPattern = pattern
+	}
 }
 
 // NewAdapter creates a new git adapter.
-func NewAdapter(svc Service) *Adapter {
-	return &Adapter{svc: svc}
+func NewAdapter(svc Service, opts ...AdapterOption) *Adapter {
+	a := &Adapter{svc: svc}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
 }
 
 // GetInfo retrieves repository information.
@@ -204,9 +222,10 @@ func (a *Adapter) GetTags(ctx context.Context) (sourcecontrol.TagList, error) {
 
 	result := make(sourcecontrol.TagList, len(tags))
 	for i, t := range tags {
-		result[i] = sourcecontrol.NewTag(
+		result[i] = sourcecontrol.NewTagMatching(
 			t.Name,
 			sourcecontrol.CommitHash(t.Hash),
+			a.tagPattern,
 		)
 	}
 	return result, nil
@@ -221,19 +240,35 @@ func (a *Adapter) GetTag(ctx context.Context, name string) (*sourcecontrol.Tag,
 	if tag == nil {
 		return nil, sourcecontrol.ErrTagNotFound
 	}
-	return sourcecontrol.NewTag(tag.Name, sourcecontrol.CommitHash(tag.Hash)), nil
+	return sourcecontrol.NewTagMatching(tag.Name, sourcecontrol.CommitHash(tag.Hash), a.tagPattern), nil
 }
 
-// GetLatestVersionTag retrieves the latest version tag.
+// GetLatestVersionTag retrieves the latest version tag. With a tag pattern,
+// the latest version tag matching the pattern is returned.
 func (a *Adapter) GetLatestVersionTag(ctx context.Context, prefix string) (*sourcecontrol.Tag, error) {
-	tag, err := a.svc.GetLatestVersionTag(ctx, prefix)
+	if a.tagPattern == nil {
+		tag, err := a.svc.GetLatestVersionTag(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+		if tag == nil {
+			return nil, nil
+		}
+		return sourcecontrol.NewTag(tag.Name, sourcecontrol.CommitHash(tag.Hash)), nil
+	}
+
+	tags, err := a.svc.ListVersionTags(ctx, prefix)
 	if err != nil {
 		return nil, err
 	}
-	if tag == nil {
-		return nil, nil
+	for _, t := range tags {
+		if !a.tagPattern.MatchString(t.Name) {
+			slog.Debug("ignoring tag not matching versioning.tag_pattern", "tag", t.Name, "pattern", a.tagPattern.String())
+			continue
+		}
+		return sourcecontrol.NewTag(t.Name, sourcecontrol.CommitHash(t.Hash)), nil
 	}
-	return sourcecontrol.NewTag(tag.Name, sourcecontrol.CommitHash(tag.Hash)), nil
+	return nil, sourcecontrol.ErrNoTags
 }
 
 // CreateTag creates a new tag.
@@ -270,6 +305,17 @@ func (a *Adapter) PushTag(ctx context.Context, name string, remote string) error
 	return a.svc.PushTag(ctx, name, opts)
 }
 
+// DeleteRemoteTag deletes a tag from the remote.
+func (a *Adapter) DeleteRemoteTag(ctx context.Context, name string, remote string) error {
+	ctx, cancel := withRemoteTimeout(ctx)
+	defer cancel()
+
+	return a.svc.Push(ctx, PushOptions{
+		Remote:  remote,
+		RefSpec: ":refs/tags/" + name,
+	})
+}
+
 // IsDirty checks if the working tree is dirty.
 func (a *Adapter) IsDirty(ctx context.Context) (bool, error) {
 	clean, err := a.svc.IsClean(ctx)
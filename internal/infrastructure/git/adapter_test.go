@@ -3,6 +3,7 @@ package git
 import (
 	"context"
 	"errors"
+	"regexp"
 	"testing"
 	"time"
 
@@ -570,6 +571,44 @@ func TestAdapter_GetLatestVersionTag(t *testing.T) {
 	})
 }
 
+func TestAdapter_TagPattern(t *testing.T) {
+	pattern := regexp.MustCompile(`^v[0-9]+\.[0-9]+\.[0-9]+$`)
+	mock := &mockService{
+		tags: []Tag{
+			{Name: "v2.0.0-rc.1", Hash: "abc123"},
+			{Name: "v1.1.0", Hash: "def456"},
+			{Name: "release-2024-01", Hash: "789abc"},
+		},
+	}
+	adapter := NewAdapter(mock, WithTagPattern(pattern))
+	ctx := context.Background()
+
+	tag, err := adapter.GetLatestVersionTag(ctx, "v")
+	if err != nil {
+		t.Fatalf("GetLatestVersionTag failed: %v", err)
+	}
+	if tag.Name() != "v1.1.0" {
+		t.Errorf("expected v1.1.0, got %s", tag.Name())
+	}
+
+	tags, err := adapter.GetTags(ctx)
+	if err != nil {
+		t.Fatalf("GetTags failed: %v", err)
+	}
+	if len(tags) != 3 {
+		t.Fatalf("expected all 3 tags to be listed, got %d", len(tags))
+	}
+	versions := tags.VersionTags()
+	if len(versions) != 1 || versions[0].Name() != "v1.1.0" {
+		t.Errorf("expected only v1.1.0 to be a version tag, got %v", versions)
+	}
+
+	mock.tags = []Tag{{Name: "release-2024-01", Hash: "789abc"}}
+	if _, err := adapter.GetLatestVersionTag(ctx, "v"); !errors.Is(err, sourcecontrol.ErrNoTags) {
+		t.Errorf("expected ErrNoTags without matching tags, got %v", err)
+	}
+}
+
 func TestAdapter_CreateTag(t *testing.T) {
 	mock := &mockService{
 		tag: &Tag{Name: "v1.0.0", Hash: "abc123"},
@@ -277,7 +277,6 @@ type compiledFilter struct {
 	authors        map[string]struct{}
 	excludeAuthors map[string]struct{}
 	scopes         map[string]struct{}
-	excludeScopes  map[string]struct{}
 	filter         CommitFilter // original filter for non-map fields
 }
 
@@ -317,14 +316,6 @@ func compileFilter(filter CommitFilter) *compiledFilter {
 		}
 	}
 
-	// Build exclude scopes lookup map
-	if len(filter.ExcludeScopes) > 0 {
-		cf.excludeScopes = make(map[string]struct{}, len(filter.ExcludeScopes))
-		for _, scope := range filter.ExcludeScopes {
-			cf.excludeScopes[scope] = struct{}{}
-		}
-	}
-
 	return cf
 }
 
@@ -377,11 +368,9 @@ func (cf *compiledFilter) matches(commit ConventionalCommit) bool {
 		}
 	}
 
-	// Exclude scopes (O(1) lookup)
-	if cf.excludeScopes != nil {
-		if _, ok := cf.excludeScopes[commit.Scope]; ok {
-			return false
-		}
+	// Exclude scopes (glob patterns)
+	if cf.filter.ExcludesScope(commit.Scope) {
+		return false
 	}
 
 	// Include non-conventional
@@ -997,9 +997,6 @@ func TestCompileFilter_EdgeCases(t *testing.T) {
 		if len(cf.scopes) != 2 {
 			t.Errorf("scopes map size = %d, want 2", len(cf.scopes))
 		}
-		if len(cf.excludeScopes) != 1 {
-			t.Errorf("excludeScopes map size = %d, want 1", len(cf.excludeScopes))
-		}
 	})
 }
 
@@ -1029,6 +1026,16 @@ func TestMatches_EdgeCases(t *testing.T) {
 			filter:  CommitFilter{ExcludeScopes: []string{"api"}, IncludeNonConventional: true},
 			matches: false,
 		},
+		{
+			name:    "exclude scope glob match",
+			filter:  CommitFilter{ExcludeScopes: []string{"a*"}, IncludeNonConventional: true},
+			matches: false,
+		},
+		{
+			name:    "exclude scope glob mismatch",
+			filter:  CommitFilter{ExcludeScopes: []string{"i18n-*"}, IncludeNonConventional: true},
+			matches: true,
+		},
 		{
 			name:    "date since future",
 			filter:  CommitFilter{Since: &tomorrow, IncludeNonConventional: true},
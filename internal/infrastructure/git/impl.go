@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os/exec"
 	"path/filepath"
 	"sort"
@@ -46,6 +47,7 @@ type ServiceImpl struct {
 	cfg      ServiceConfig
 	repo     *git.Repository
 	worktree *git.Worktree
+	authMu   sync.Mutex // guards auth and cfg.AuthTokenSource
 	auth     transport.AuthMethod
 
 	// Repository info cache with TTL
@@ -65,7 +67,8 @@ func NewService(opts ...ServiceOption) (*ServiceImpl, error) {
 		return nil, rperrors.GitWrap(err, "git.NewService", "failed to get absolute path")
 	}
 
-	repo, err := git.PlainOpen(absPath)
+	// Linked worktrees keep refs and objects in the common git dir
+	repo, err := git.PlainOpenWithOptions(absPath, &git.PlainOpenOptions{EnableDotGitCommonDir: true})
 	if err != nil {
 		return nil, rperrors.GitWrap(err, "git.NewService", "failed to open repository")
 	}
@@ -75,28 +78,49 @@ func NewService(opts ...ServiceOption) (*ServiceImpl, error) {
 		return nil, rperrors.GitWrap(err, "git.NewService", "failed to get worktree")
 	}
 
-	// Initialize authentication if token is provided
-	var auth transport.AuthMethod
-	if cfg.AuthToken != "" {
-		username := cfg.AuthUsername
-		if username == "" {
-			username = "git" // Default for GitHub token auth
-		}
-		auth = &http.BasicAuth{
-			Username: username,
-			Password: cfg.AuthToken,
-		}
-	}
-
 	return &ServiceImpl{
 		cfg:      cfg,
 		repo:     repo,
 		worktree: worktree,
-		auth:     auth,
+		auth:     tokenAuth(cfg.AuthUsername, cfg.AuthToken),
 	}, nil
 }
 
-// GetRepositoryRoot returns the absolute path to the repository root.
+// tokenAuth returns HTTP basic auth for token, or nil if token is empty.
+func tokenAuth(username, token string) transport.AuthMethod {
+	if token == "" {
+		return nil
+	}
+	if username == "" {
+		username = "git" // Default for GitHub token auth
+	}
+	return &http.BasicAuth{
+		Username: username,
+		Password: token,
+	}
+}
+
+// authMethod returns the authentication for remote operations, fetching
+// the token from AuthTokenSource on first use.
+func (s *ServiceImpl) authMethod(ctx context.Context) (transport.AuthMethod, error) {
+	s.authMu.Lock()
+	defer s.authMu.Unlock()
+
+	if s.cfg.AuthTokenSource == nil {
+		return s.auth, nil
+	}
+	token, err := s.cfg.AuthTokenSource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve git auth token: %w", err)
+	}
+	s.auth = tokenAuth(s.cfg.AuthUsername, token)
+	s.cfg.AuthTokenSource = nil
+	return s.auth, nil
+}
+
+// GetRepositoryRoot returns the absolute path to the repository root. In a
+// linked worktree this is the worktree's own checkout; release state under
+// .relicta/ is kept at fileutil.StateRoot of it.
 func (s *ServiceImpl) GetRepositoryRoot(_ context.Context) (string, error) {
 	return s.worktree.Filesystem.Root(), nil
 }
@@ -263,13 +287,18 @@ func (s *ServiceImpl) GetCommitsSince(ctx context.Context, ref string) ([]Commit
 	return s.getCommitsBetweenHashes(ctx, refHash, head.Hash())
 }
 
-// GetCommitsBetween returns all commits between two references.
+// GetCommitsBetween returns all commits between two references. An empty
+// from returns all commits reachable from to.
 func (s *ServiceImpl) GetCommitsBetween(ctx context.Context, from, to string) ([]Commit, error) {
 	const op = "git.GetCommitsBetween"
 
-	fromHash, err := s.resolveRef(from)
-	if err != nil {
-		return nil, rperrors.GitWrap(err, op, fmt.Sprintf("failed to resolve from reference %s", from))
+	var fromHash plumbing.Hash
+	if from != "" {
+		var err error
+		fromHash, err = s.resolveRef(from)
+		if err != nil {
+			return nil, rperrors.GitWrap(err, op, fmt.Sprintf("failed to resolve from reference %s", from))
+		}
 	}
 
 	toHash, err := s.resolveRef(to)
@@ -482,10 +511,15 @@ func (s *ServiceImpl) ListVersionTags(ctx context.Context, prefix string) ([]Tag
 			continue
 		}
 
-		versionStr := strings.TrimPrefix(name, prefix)
-		if v, err := semver.NewVersion(versionStr); err == nil {
-			cache = append(cache, versionTagCache{tag: tag, version: v})
+		// Only full SemVer versions count; the lenient parser would read
+		// tags like "2024" or "1.2" as versions.
+		versionStr := strings.TrimPrefix(strings.TrimPrefix(name, prefix), "v")
+		v, err := semver.StrictNewVersion(versionStr)
+		if err != nil {
+			slog.Debug("ignoring tag that is not a semantic version", "tag", name, "prefix", prefix)
+			continue
 		}
+		cache = append(cache, versionTagCache{tag: tag, version: v})
 	}
 
 	// Sort by semver using cached versions, newest first
@@ -536,7 +570,7 @@ func (s *ServiceImpl) GetTag(_ context.Context, name string) (*Tag, error) {
 }
 
 // CreateTag creates a new tag.
-func (s *ServiceImpl) CreateTag(_ context.Context, name, message string, opts TagOptions) error {
+func (s *ServiceImpl) CreateTag(ctx context.Context, name, message string, opts TagOptions) error {
 	const op = "git.CreateTag"
 
 	// Resolve the reference
@@ -550,7 +584,9 @@ func (s *ServiceImpl) CreateTag(_ context.Context, name, message string, opts Ta
 		return rperrors.GitWrap(err, op, fmt.Sprintf("failed to resolve reference %s", ref))
 	}
 
-	if opts.Annotated {
+	if opts.Sign || s.cfg.signTags() {
+		err = s.createSignedTag(ctx, name, message, hash, opts)
+	} else if opts.Annotated {
 		// Determine tagger name and email
 		taggerName := opts.TaggerName
 		taggerEmail := opts.TaggerEmail
@@ -631,10 +667,14 @@ func (s *ServiceImpl) PushTag(ctx context.Context, name string, opts PushOptions
 
 	refSpec := config.RefSpec(fmt.Sprintf("refs/tags/%s:refs/tags/%s", name, name))
 
-	err := s.repo.Push(&git.PushOptions{
+	auth, err := s.authMethod(ctx)
+	if err != nil {
+		return rperrors.GitWrap(err, op, fmt.Sprintf("failed to push tag %s", name))
+	}
+	err = s.repo.Push(&git.PushOptions{
 		RemoteName: remote,
 		RefSpecs:   []config.RefSpec{refSpec},
-		Auth:       s.auth,
+		Auth:       auth,
 		Force:      opts.Force,
 	})
 	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
@@ -704,11 +744,12 @@ func (s *ServiceImpl) GetCurrentBranch(_ context.Context) (string, error) {
 }
 
 // GetDefaultBranch returns the default branch name (main/master).
-func (s *ServiceImpl) GetDefaultBranch(_ context.Context) (string, error) {
+func (s *ServiceImpl) GetDefaultBranch(ctx context.Context) (string, error) {
 	// Try to get from remote HEAD reference
 	remote, err := s.repo.Remote(s.cfg.DefaultRemote)
-	if err == nil {
-		refs, err := remote.List(&git.ListOptions{Auth: s.auth})
+	auth, authErr := s.authMethod(ctx)
+	if err == nil && authErr == nil {
+		refs, err := remote.List(&git.ListOptions{Auth: auth})
 		if err == nil {
 			for _, ref := range refs {
 				if ref.Name() == plumbing.HEAD {
@@ -786,7 +827,7 @@ func (s *ServiceImpl) GetRemoteURL(_ context.Context, name string) (string, erro
 }
 
 // Push pushes changes to the remote.
-func (s *ServiceImpl) Push(_ context.Context, opts PushOptions) error {
+func (s *ServiceImpl) Push(ctx context.Context, opts PushOptions) error {
 	const op = "git.Push"
 
 	if opts.DryRun {
@@ -798,9 +839,14 @@ func (s *ServiceImpl) Push(_ context.Context, opts PushOptions) error {
 		remote = s.cfg.DefaultRemote
 	}
 
+	auth, err := s.authMethod(ctx)
+	if err != nil {
+		return rperrors.GitWrap(err, op, "failed to push")
+	}
+
 	pushOpts := &git.PushOptions{
 		RemoteName: remote,
-		Auth:       s.auth,
+		Auth:       auth,
 		Force:      opts.Force,
 	}
 
@@ -808,7 +854,7 @@ func (s *ServiceImpl) Push(_ context.Context, opts PushOptions) error {
 		pushOpts.RefSpecs = []config.RefSpec{config.RefSpec(opts.RefSpec)}
 	}
 
-	err := s.repo.Push(pushOpts)
+	err = s.repo.Push(pushOpts)
 	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
 		return rperrors.GitWrap(err, op, "failed to push")
 	}
@@ -817,7 +863,7 @@ func (s *ServiceImpl) Push(_ context.Context, opts PushOptions) error {
 }
 
 // Fetch fetches from the remote.
-func (s *ServiceImpl) Fetch(_ context.Context, opts FetchOptions) error {
+func (s *ServiceImpl) Fetch(ctx context.Context, opts FetchOptions) error {
 	const op = "git.Fetch"
 
 	remote := opts.Remote
@@ -825,9 +871,14 @@ func (s *ServiceImpl) Fetch(_ context.Context, opts FetchOptions) error {
 		remote = s.cfg.DefaultRemote
 	}
 
+	auth, err := s.authMethod(ctx)
+	if err != nil {
+		return rperrors.GitWrap(err, op, "failed to fetch")
+	}
+
 	fetchOpts := &git.FetchOptions{
 		RemoteName: remote,
-		Auth:       s.auth,
+		Auth:       auth,
 		Prune:      opts.Prune,
 	}
 
@@ -839,7 +890,7 @@ func (s *ServiceImpl) Fetch(_ context.Context, opts FetchOptions) error {
 		fetchOpts.Depth = opts.Depth
 	}
 
-	err := s.repo.Fetch(fetchOpts)
+	err = s.repo.Fetch(fetchOpts)
 	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
 		return rperrors.GitWrap(err, op, "failed to fetch")
 	}
@@ -848,7 +899,7 @@ func (s *ServiceImpl) Fetch(_ context.Context, opts FetchOptions) error {
 }
 
 // Pull pulls changes from the remote and merges them.
-func (s *ServiceImpl) Pull(_ context.Context, opts PullOptions) error {
+func (s *ServiceImpl) Pull(ctx context.Context, opts PullOptions) error {
 	const op = "git.Pull"
 
 	remote := opts.Remote
@@ -856,9 +907,14 @@ func (s *ServiceImpl) Pull(_ context.Context, opts PullOptions) error {
 		remote = s.cfg.DefaultRemote
 	}
 
+	auth, err := s.authMethod(ctx)
+	if err != nil {
+		return rperrors.GitWrap(err, op, "failed to pull")
+	}
+
 	pullOpts := &git.PullOptions{
 		RemoteName: remote,
-		Auth:       s.auth,
+		Auth:       auth,
 	}
 
 	if opts.Branch != "" {
@@ -869,7 +925,7 @@ func (s *ServiceImpl) Pull(_ context.Context, opts PullOptions) error {
 		pullOpts.Depth = opts.Depth
 	}
 
-	err := s.worktree.Pull(pullOpts)
+	err = s.worktree.Pull(pullOpts)
 	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
 		return rperrors.GitWrap(err, op, "failed to pull")
 	}
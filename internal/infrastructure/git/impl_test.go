@@ -3,8 +3,11 @@ package git
 
 import (
 	"context"
+	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +15,11 @@ import (
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	releaseadapters "github.com/relicta-tech/relicta/internal/domain/release/adapters"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/version"
 )
 
 // testRepoHelper provides helper functions for creating test git repositories.
@@ -260,6 +268,81 @@ func TestGetRepositoryRoot(t *testing.T) {
 	}
 }
 
+// TestGetRepositoryRoot_LinkedWorktree tests that a linked worktree reports
+// its own root while release state is shared with the main working tree.
+func TestGetRepositoryRoot_LinkedWorktree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git CLI not available")
+	}
+
+	helper := newTestRepo(t)
+	helper.makeCommit("Initial commit")
+	helper.makeTag("v1.0.0", "")
+
+	worktreeDir := filepath.Join(t.TempDir(), "release-wt")
+	cmd := exec.Command("git", "worktree", "add", "--detach", worktreeDir, "v1.0.0")
+	cmd.Dir = helper.repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git worktree add failed: %v\n%s", err, out)
+	}
+
+	svc, err := NewService(WithRepoPath(worktreeDir))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	ctx := context.Background()
+	root, err := svc.GetRepositoryRoot(ctx)
+	if err != nil {
+		t.Fatalf("GetRepositoryRoot() error = %v", err)
+	}
+	wantRoot, _ := filepath.EvalSymlinks(worktreeDir)
+	if gotRoot, _ := filepath.EvalSymlinks(root); gotRoot != wantRoot {
+		t.Fatalf("GetRepositoryRoot() = %v, want worktree %v", root, worktreeDir)
+	}
+
+	// The worktree still reads its own checkout
+	head, err := svc.GetHeadCommit(ctx)
+	if err != nil {
+		t.Fatalf("GetHeadCommit() error = %v", err)
+	}
+	if head.Hash == "" {
+		t.Error("GetHeadCommit() returned empty hash")
+	}
+
+	// plan -> bump state persists to the shared location
+	run := releasedomain.NewReleaseRun("repo", root, "v1.0.0", releasedomain.CommitSHA(head.Hash), nil, "", "")
+	_ = run.SetVersionProposal(version.MustParse("1.0.0"), version.MustParse("1.1.0"), releasedomain.BumpMinor, 1)
+	if err := run.Plan("ci"); err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if err := run.SetVersion(version.MustParse("1.1.0"), "v1.1.0"); err != nil {
+		t.Fatalf("SetVersion() error = %v", err)
+	}
+	if err := run.Bump("ci"); err != nil {
+		t.Fatalf("Bump() error = %v", err)
+	}
+
+	repo := releaseadapters.NewFileReleaseRunRepository()
+	if err := repo.Save(ctx, run); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := repo.SetLatest(ctx, root, run.ID()); err != nil {
+		t.Fatalf("SetLatest() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(worktreeDir, ".relicta")); !os.IsNotExist(err) {
+		t.Errorf("release state written into the worktree (stat err = %v)", err)
+	}
+	loaded, err := repo.LoadLatest(ctx, helper.repoDir)
+	if err != nil {
+		t.Fatalf("LoadLatest() from main working tree error = %v", err)
+	}
+	if loaded.State() != releasedomain.StateVersioned {
+		t.Errorf("loaded state = %v, want versioned", loaded.State())
+	}
+}
+
 // TestIsClean tests checking if the working tree is clean.
 func TestIsClean(t *testing.T) {
 	helper := newTestRepo(t)
@@ -447,6 +530,17 @@ func TestGetCommitsBetween(t *testing.T) {
 		}
 	})
 
+	t.Run("empty from returns all history", func(t *testing.T) {
+		commits, err := svc.GetCommitsBetween(ctx, "", "HEAD")
+		if err != nil {
+			t.Fatalf("GetCommitsBetween() error = %v", err)
+		}
+
+		if len(commits) != 4 {
+			t.Errorf("GetCommitsBetween() returned %d commits, want 4", len(commits))
+		}
+	})
+
 	t.Run("error on invalid from ref", func(t *testing.T) {
 		_, err := svc.GetCommitsBetween(ctx, "invalid", "HEAD")
 		if err == nil {
@@ -621,6 +715,45 @@ func TestGetLatestTag(t *testing.T) {
 	})
 }
 
+// TestListVersionTags_IgnoresNonSemverTags tests that arbitrary tags mixed
+// with version tags do not count as versions.
+func TestListVersionTags_IgnoresNonSemverTags(t *testing.T) {
+	helper := newTestRepo(t)
+	helper.makeCommit("Initial commit")
+
+	helper.makeTag("v1.0.0", "Version 1.0.0")
+	helper.makeTag("release-2024-01", "Monthly release")
+	helper.makeTag("2024", "Year tag")
+	helper.makeCommit("Second commit")
+	helper.makeTag("v1.1.0", "Version 1.1.0")
+	helper.makeTag("v9.9", "Not a full version")
+	helper.makeTag("v10", "Not a full version")
+
+	svc, err := NewService(WithRepoPath(helper.repoDir))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	tags, err := svc.ListVersionTags(ctx, "v")
+	if err != nil {
+		t.Fatalf("ListVersionTags() error = %v", err)
+	}
+	if len(tags) != 2 || tags[0].Name != "v1.1.0" || tags[1].Name != "v1.0.0" {
+		t.Errorf("ListVersionTags() = %v, want [v1.1.0 v1.0.0]", tags)
+	}
+
+	// Without a prefix, "2024" must not be read as version 2024.0.0.
+	latest, err := svc.GetLatestVersionTag(ctx, "")
+	if err != nil {
+		t.Fatalf("GetLatestVersionTag() error = %v", err)
+	}
+	if latest.Name != "v1.1.0" {
+		t.Errorf("GetLatestVersionTag() = %v, want v1.1.0", latest.Name)
+	}
+}
+
 // TestGetLatestVersionTag tests getting the latest version tag.
 func TestGetLatestVersionTag(t *testing.T) {
 	helper := newTestRepo(t)
@@ -1268,3 +1401,47 @@ func TestServiceImplConventionalCommitMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestServiceImpl_AuthTokenSource(t *testing.T) {
+	h := newTestRepo(t)
+
+	calls := 0
+	svc, err := NewService(WithRepoPath(h.repoDir), WithAuthTokenSource(func(context.Context) (string, error) {
+		calls++
+		return "ghp-test", nil
+	}))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("token source called %d times by NewService, want 0", calls)
+	}
+
+	for i := 0; i < 2; i++ {
+		auth, err := svc.authMethod(context.Background())
+		if err != nil {
+			t.Fatalf("authMethod() error = %v", err)
+		}
+		basic, ok := auth.(*http.BasicAuth)
+		if !ok || basic.Password != "ghp-test" || basic.Username != "git" {
+			t.Errorf("authMethod() = %#v", auth)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("token source called %d times, want 1", calls)
+	}
+}
+
+func TestServiceImpl_AuthTokenSourceError(t *testing.T) {
+	h := newTestRepo(t)
+
+	svc, err := NewService(WithRepoPath(h.repoDir), WithAuthTokenSource(func(context.Context) (string, error) {
+		return "", errors.New("vault unavailable")
+	}))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	if err := svc.Push(context.Background(), PushOptions{}); err == nil || !strings.Contains(err.Error(), "vault unavailable") {
+		t.Errorf("Push() error = %v, want token source error", err)
+	}
+}
@@ -122,7 +122,7 @@ type Service interface {
 type TagOptions struct {
 	// Annotated creates an annotated tag (vs lightweight).
 	Annotated bool
-	// Sign signs the tag with GPG.
+	// Sign signs the tag in the service's signing format (GPG by default).
 	Sign bool
 	// Force overwrites an existing tag.
 	Force bool
@@ -237,12 +237,20 @@ type ServiceConfig struct {
 	GPGSign bool
 	// GPGKeyID is the GPG key ID to use for signing.
 	GPGKeyID string
+	// SignFormat is the tag signing format: "gpg" (default) or "ssh".
+	SignFormat string
+	// SSHSigningKey is the SSH key used to sign tags when SignFormat is "ssh".
+	SSHSigningKey string
 	// UseCLIFallback enables falling back to git CLI when go-git fails.
 	// This is useful for authentication with credential helpers.
 	UseCLIFallback bool
 	// AuthToken is an optional authentication token for HTTPS push.
 	// When set, this is used instead of relying on credential helpers.
 	AuthToken string
+	// AuthTokenSource, if set, supplies the token the first time a remote
+	// is contacted, so a token held in a secret manager is only fetched
+	// when it is needed. It takes precedence over AuthToken.
+	AuthTokenSource func(ctx context.Context) (string, error)
 	// AuthUsername is the username for token auth (default: "git" for GitHub).
 	AuthUsername string
 }
@@ -283,6 +291,14 @@ func WithGPGSign(keyID string) ServiceOption {
 	}
 }
 
+// WithSSHSign enables signing tags with the SSH key at keyPath.
+func WithSSHSign(keyPath string) ServiceOption {
+	return func(cfg *ServiceConfig) {
+		cfg.SignFormat = SignFormatSSH
+		cfg.SSHSigningKey = keyPath
+	}
+}
+
 // WithCLIFallback sets whether to use CLI fallback.
 func WithCLIFallback(enabled bool) ServiceOption {
 	return func(cfg *ServiceConfig) {
@@ -297,6 +313,14 @@ func WithAuthToken(token string) ServiceOption {
 	}
 }
 
+// WithAuthTokenSource sets a function that supplies the authentication
+// token for HTTPS push when it is first needed.
+func WithAuthTokenSource(source func(ctx context.Context) (string, error)) ServiceOption {
+	return func(cfg *ServiceConfig) {
+		cfg.AuthTokenSource = source
+	}
+}
+
 // WithAuthUsername sets the username for token auth.
 func WithAuthUsername(username string) ServiceOption {
 	return func(cfg *ServiceConfig) {
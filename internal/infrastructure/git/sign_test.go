@@ -0,0 +1,145 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSignedTagArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		gpgKeyID string
+		force    bool
+		want     []string
+	}{
+		{
+			name:   "ssh",
+			format: SignFormatSSH,
+			want:   []string{"-c", "gpg.format=ssh", "-c", "user.signingkey=/keys/id_ed25519", "tag", "-s", "-m", "msg", "v1.0.0", "abc"},
+		},
+		{
+			name:   "gpg default key",
+			format: SignFormatGPG,
+			want:   []string{"-c", "gpg.format=openpgp", "tag", "-s", "-m", "msg", "v1.0.0", "abc"},
+		},
+		{
+			name:     "gpg key id with force",
+			format:   SignFormatGPG,
+			gpgKeyID: "ABCD1234",
+			force:    true,
+			want:     []string{"-c", "gpg.format=openpgp", "tag", "-u", "ABCD1234", "-f", "-m", "msg", "v1.0.0", "abc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := signedTagArgs(tt.format, "/keys/id_ed25519", tt.gpgKeyID, "v1.0.0", "msg", "abc", tt.force)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("signedTagArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckTagSigning(t *testing.T) {
+	origCLI, origLookPath := isGitCLIAvailable, lookPath
+	defer func() { isGitCLIAvailable, lookPath = origCLI, origLookPath }()
+	isGitCLIAvailable = func() bool { return true }
+	lookPath = func(string) (string, error) { return "/usr/bin/tool", nil }
+
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyPath, []byte("key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CheckTagSigning(SignFormatSSH, keyPath, true); err != nil {
+		t.Errorf("CheckTagSigning(ssh) error = %v", err)
+	}
+	if err := CheckTagSigning("", "", true); err != nil {
+		t.Errorf("CheckTagSigning(gpg) error = %v", err)
+	}
+
+	errorCases := map[string]struct {
+		format, key string
+		useCLI      bool
+		want        string
+	}{
+		"go-git only":    {SignFormatSSH, keyPath, false, "not supported by go-git"},
+		"missing key":    {SignFormatSSH, "", true, "git.auth.ssh_key_path"},
+		"key not found":  {SignFormatSSH, keyPath + ".missing", true, "ssh signing key not available"},
+		"unknown format": {"x509", "", true, "unknown tag signing format"},
+	}
+	for name, tc := range errorCases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckTagSigning(tc.format, tc.key, tc.useCLI)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("CheckTagSigning() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+
+	lookPath = func(string) (string, error) { return "", errors.New("not found") }
+	if err := CheckTagSigning(SignFormatSSH, keyPath, true); err == nil || !strings.Contains(err.Error(), "ssh-keygen") {
+		t.Errorf("CheckTagSigning() without ssh-keygen error = %v", err)
+	}
+}
+
+func TestCreateTag_SSHSigned(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git CLI not available")
+	}
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not available")
+	}
+
+	helper := newTestRepo(t)
+	helper.makeCommit("Initial commit")
+
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	if out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath).CombinedOutput(); err != nil {
+		t.Fatalf("ssh-keygen failed: %v\n%s", err, out)
+	}
+
+	svc, err := NewService(WithRepoPath(helper.repoDir), WithSSHSign(keyPath))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	opts := DefaultTagOptions()
+	opts.TaggerName = "Relicta"
+	opts.TaggerEmail = "relicta@localhost"
+	if err := svc.CreateTag(context.Background(), "v1.0.0", "Version 1.0.0", opts); err != nil {
+		t.Fatalf("CreateTag() error = %v", err)
+	}
+
+	cmd := exec.Command("git", "cat-file", "tag", "v1.0.0")
+	cmd.Dir = helper.repoDir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git cat-file failed: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), "-----BEGIN SSH SIGNATURE-----") {
+		t.Errorf("tag is not SSH signed:\n%s", out)
+	}
+}
+
+func TestCreateTag_SigningWithoutCLI(t *testing.T) {
+	helper := newTestRepo(t)
+	helper.makeCommit("Initial commit")
+
+	svc, err := NewService(WithRepoPath(helper.repoDir), WithCLIFallback(false), WithSSHSign("/keys/id_ed25519"))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	err = svc.CreateTag(context.Background(), "v1.0.0", "Version 1.0.0", DefaultTagOptions())
+	if err == nil || !strings.Contains(err.Error(), "not supported by go-git") {
+		t.Errorf("CreateTag() error = %v, want go-git support error", err)
+	}
+}
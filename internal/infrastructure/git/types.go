@@ -4,6 +4,7 @@ package git
 import (
 	"errors"
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 	"time"
@@ -239,7 +240,8 @@ type CommitFilter struct {
 	ExcludeAuthors []string
 	// Scopes filters commits by scope.
 	Scopes []string
-	// ExcludeScopes excludes commits by scope.
+	// ExcludeScopes excludes commits by scope. Entries may be globs (see
+	// path.Match).
 	ExcludeScopes []string
 	// IncludeNonConventional includes non-conventional commits.
 	IncludeNonConventional bool
@@ -253,6 +255,20 @@ type CommitFilter struct {
 	PathFilter []string
 }
 
+// ExcludesScope reports whether a commit with the given scope is excluded by
+// ExcludeScopes. Commits without a scope are never excluded.
+func (f CommitFilter) ExcludesScope(scope string) bool {
+	if scope == "" {
+		return false
+	}
+	for _, pattern := range f.ExcludeScopes {
+		if ok, _ := path.Match(pattern, scope); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // TagFilter defines criteria for filtering tags.
 type TagFilter struct {
 	// Prefix filters tags by prefix (e.g., "v").
@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+const (
+	// freezeFile stores the active release freeze, relative to the repository root.
+	freezeFile = ".relicta/freeze.json"
+	// freezeAuditFile is the append-only log of freeze changes and overrides.
+	freezeAuditFile = ".relicta/freeze-audit.jsonl"
+
+	// maxFreezeFileSize bounds the freeze file read from disk.
+	maxFreezeFileSize = 64 << 10 // 64KB
+)
+
+// Freeze audit actions.
+const (
+	FreezeActionOn       = "on"
+	FreezeActionOff      = "off"
+	FreezeActionOverride = "override"
+)
+
+// ReleaseFreeze is an ad-hoc release freeze. Unlike scheduled freeze
+// periods, it stays active until it is lifted.
+type ReleaseFreeze struct {
+	Reason   string    `json:"reason"`
+	FrozenBy string    `json:"frozen_by"`
+	FrozenAt time.Time `json:"frozen_at"`
+}
+
+// Summary describes the freeze for messages that block an operation.
+func (f *ReleaseFreeze) Summary() string {
+	return fmt.Sprintf("releases are frozen since %s by %s: %s",
+		f.FrozenAt.Format(time.RFC3339), f.FrozenBy, f.Reason)
+}
+
+// FreezeEvent is an audit record of a freeze change or override.
+type FreezeEvent struct {
+	Action  string    `json:"action"`
+	Actor   string    `json:"actor"`
+	Reason  string    `json:"reason,omitempty"`
+	Command string    `json:"command,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// FreezeStore persists the release freeze of a repository under .relicta/.
+type FreezeStore struct {
+	repoRoot string
+}
+
+// NewFreezeStore creates a freeze store for the repository at repoRoot.
+func NewFreezeStore(repoRoot string) *FreezeStore {
+	if repoRoot == "" {
+		repoRoot = "."
+	}
+	return &FreezeStore{repoRoot: fileutil.StateRoot(repoRoot)}
+}
+
+// Load returns the active freeze, or nil if releases are not frozen.
+func (s *FreezeStore) Load() (*ReleaseFreeze, error) {
+	data, err := fileutil.ReadFileLimited(s.path(freezeFile), maxFreezeFileSize)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release freeze: %w", err)
+	}
+
+	var freeze ReleaseFreeze
+	if err := json.Unmarshal(data, &freeze); err != nil {
+		return nil, fmt.Errorf("failed to parse release freeze: %w", err)
+	}
+	return &freeze, nil
+}
+
+// Freeze activates a release freeze, replacing any active freeze.
+func (s *FreezeStore) Freeze(reason, actor string) (*ReleaseFreeze, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("a reason is required to freeze releases")
+	}
+
+	freeze := &ReleaseFreeze{
+		Reason:   reason,
+		FrozenBy: actor,
+		FrozenAt: time.Now().UTC(),
+	}
+	data, err := json.MarshalIndent(freeze, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode release freeze: %w", err)
+	}
+
+	path := s.path(freezeFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create freeze directory: %w", err)
+	}
+	if err := fileutil.AtomicWriteFile(path, data, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write release freeze: %w", err)
+	}
+
+	if err := s.record(FreezeEvent{Action: FreezeActionOn, Actor: actor, Reason: reason, At: freeze.FrozenAt}); err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
+
+// Unfreeze lifts the active release freeze. It returns false if releases
+// were not frozen.
+func (s *FreezeStore) Unfreeze(actor, reason string) (bool, error) {
+	err := os.Remove(s.path(freezeFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to lift release freeze: %w", err)
+	}
+
+	if err := s.record(FreezeEvent{Action: FreezeActionOff, Actor: actor, Reason: reason, At: time.Now().UTC()}); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// RecordOverride records that command ran despite the active freeze.
+func (s *FreezeStore) RecordOverride(actor, command string, freeze *ReleaseFreeze) error {
+	event := FreezeEvent{Action: FreezeActionOverride, Actor: actor, Command: command, At: time.Now().UTC()}
+	if freeze != nil {
+		event.Reason = freeze.Reason
+	}
+	return s.record(event)
+}
+
+// Events returns the freeze audit log, oldest first.
+func (s *FreezeStore) Events() ([]FreezeEvent, error) {
+	data, err := os.ReadFile(s.path(freezeAuditFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read freeze audit log: %w", err)
+	}
+
+	var events []FreezeEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		var event FreezeEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, fmt.Errorf("failed to parse freeze audit log: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// record appends an event to the freeze audit log.
+func (s *FreezeStore) record(event FreezeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode freeze audit event: %w", err)
+	}
+
+	path := s.path(freezeAuditFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create freeze directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) // #nosec G304 -- path under the repository's .relicta directory
+	if err != nil {
+		return fmt.Errorf("failed to open freeze audit log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write freeze audit log: %w", err)
+	}
+	return nil
+}
+
+func (s *FreezeStore) path(rel string) string {
+	return filepath.Join(s.repoRoot, filepath.FromSlash(rel))
+}
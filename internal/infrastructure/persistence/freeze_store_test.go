@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFreezeStore(t *testing.T) {
+	store := NewFreezeStore(t.TempDir())
+
+	freeze, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if freeze != nil {
+		t.Fatalf("Load() = %+v, want nil before freezing", freeze)
+	}
+
+	if _, err := store.Freeze("  ", "alice"); err == nil {
+		t.Error("Freeze() with empty reason should fail")
+	}
+
+	if _, err := store.Freeze("incident INC-1234", "alice"); err != nil {
+		t.Fatalf("Freeze() error = %v", err)
+	}
+	freeze, err = store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if freeze == nil || freeze.Reason != "incident INC-1234" || freeze.FrozenBy != "alice" {
+		t.Fatalf("Load() = %+v, want freeze by alice", freeze)
+	}
+	if !strings.Contains(freeze.Summary(), "releases are frozen") {
+		t.Errorf("Summary() = %q", freeze.Summary())
+	}
+
+	if err := store.RecordOverride("bob", "publish", freeze); err != nil {
+		t.Fatalf("RecordOverride() error = %v", err)
+	}
+
+	lifted, err := store.Unfreeze("alice", "resolved")
+	if err != nil || !lifted {
+		t.Fatalf("Unfreeze() = %v, %v; want true, nil", lifted, err)
+	}
+	if lifted, err := store.Unfreeze("alice", ""); err != nil || lifted {
+		t.Errorf("second Unfreeze() = %v, %v; want false, nil", lifted, err)
+	}
+	if freeze, _ := store.Load(); freeze != nil {
+		t.Errorf("Load() after Unfreeze() = %+v, want nil", freeze)
+	}
+
+	events, err := store.Events()
+	if err != nil {
+		t.Fatalf("Events() error = %v", err)
+	}
+	wantActions := []string{FreezeActionOn, FreezeActionOverride, FreezeActionOff}
+	if len(events) != len(wantActions) {
+		t.Fatalf("Events() returned %d events, want %d", len(events), len(wantActions))
+	}
+	for i, want := range wantActions {
+		if events[i].Action != want {
+			t.Errorf("event %d action = %q, want %q", i, events[i].Action, want)
+		}
+	}
+	if events[1].Actor != "bob" || events[1].Command != "publish" || events[1].Reason != "incident INC-1234" {
+		t.Errorf("override event = %+v", events[1])
+	}
+}
@@ -107,12 +107,13 @@ type versionDTO struct {
 }
 
 type notesDTO struct {
-	Text           string `json:"text"`
-	AudiencePreset string `json:"audience_preset,omitempty"`
-	TonePreset     string `json:"tone_preset,omitempty"`
-	Provider       string `json:"provider,omitempty"`
-	Model          string `json:"model,omitempty"`
-	GeneratedAt    string `json:"generated_at"`
+	Text           string   `json:"text"`
+	Highlights     []string `json:"highlights,omitempty"`
+	AudiencePreset string   `json:"audience_preset,omitempty"`
+	TonePreset     string   `json:"tone_preset,omitempty"`
+	Provider       string   `json:"provider,omitempty"`
+	Model          string   `json:"model,omitempty"`
+	GeneratedAt    string   `json:"generated_at"`
 }
 
 type approvalDTO struct {
@@ -374,7 +375,7 @@ func (r *FileReleaseRepository) FindByState(ctx context.Context, state release.R
 	})
 }
 
-// FindActive retrieves all active (non-final) releases.
+// FindActive retrieves all active (unfinished) releases.
 func (r *FileReleaseRepository) FindActive(ctx context.Context) ([]*release.ReleaseRun, error) {
 	if err := checkContext(ctx); err != nil {
 		return nil, err
@@ -385,7 +386,7 @@ func (r *FileReleaseRepository) FindActive(ctx context.Context) ([]*release.Rele
 
 	return r.scanReleases(ctx, func(dto *releaseDTO) bool {
 		state := release.RunState(dto.State)
-		return !state.IsFinal()
+		return !state.IsFinished()
 	})
 }
 
@@ -548,6 +549,7 @@ func (r *FileReleaseRepository) toDTO(rel *release.ReleaseRun) *releaseDTO {
 		notes := rel.Notes()
 		dto.Notes = &notesDTO{
 			Text:           notes.Text,
+			Highlights:     notes.Highlights,
 			AudiencePreset: notes.AudiencePreset,
 			TonePreset:     notes.TonePreset,
 			Provider:       notes.Provider,
@@ -677,6 +679,7 @@ func (r *FileReleaseRepository) fromDTO(dto *releaseDTO) (*release.ReleaseRun, e
 		}
 		notes = &release.ReleaseNotes{
 			Text:           dto.Notes.Text,
+			Highlights:     dto.Notes.Highlights,
 			AudiencePreset: dto.Notes.AudiencePreset,
 			TonePreset:     dto.Notes.TonePreset,
 			Provider:       dto.Notes.Provider,
@@ -0,0 +1,197 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+const (
+	// stateLockFile guards the release state of a repository, relative to the
+	// repository root.
+	stateLockFile = ".relicta/lock"
+
+	// maxStateLockFileSize bounds the lock file read from disk.
+	maxStateLockFileSize = 4 << 10 // 4KB
+
+	// DefaultStateLockTimeout is how long a state lock held from another host
+	// is honored before it is considered stale and taken over, e.g. after the
+	// holder crashed. Locks held on this host are honored for as long as the
+	// holding process runs.
+	DefaultStateLockTimeout = 10 * time.Minute
+)
+
+// ErrStateLocked is returned when another process holds the state lock.
+var ErrStateLocked = errors.New("another relicta operation is in progress")
+
+// StateLockHolder describes the process holding the state lock.
+type StateLockHolder struct {
+	PID        int       `json:"pid"`
+	Hostname   string    `json:"hostname"`
+	Command    string    `json:"command"`
+	AcquiredAt time.Time `json:"acquired_at"`
+}
+
+// StateLockedError reports the holder of a state lock that could not be
+// acquired. It matches ErrStateLocked with errors.Is.
+type StateLockedError struct {
+	Path   string
+	Holder StateLockHolder
+}
+
+func (e *StateLockedError) Error() string {
+	return fmt.Sprintf("%s: %s (PID %d on %s) holds %s since %s; wait for it to finish, or remove the lock file if that process is no longer running",
+		ErrStateLocked, e.Holder.Command, e.Holder.PID, e.Holder.Hostname, e.Path, e.Holder.AcquiredAt.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrStateLocked.
+func (e *StateLockedError) Is(target error) bool {
+	return target == ErrStateLocked
+}
+
+// StateLock is an advisory lock that serializes the relicta operations that
+// mutate the release state under .relicta/. Read-only operations do not take
+// it.
+type StateLock struct {
+	repoRoot string
+	timeout  time.Duration
+}
+
+// NewStateLock creates the state lock of the repository at repoRoot. A lock
+// held by a process on this host is stale once that process has exited;
+// locks from other hosts are stale when older than timeout. A zero timeout
+// uses DefaultStateLockTimeout.
+func NewStateLock(repoRoot string, timeout time.Duration) *StateLock {
+	if repoRoot == "" {
+		repoRoot = "."
+	}
+	if timeout <= 0 {
+		timeout = DefaultStateLockTimeout
+	}
+	return &StateLock{repoRoot: fileutil.StateRoot(repoRoot), timeout: timeout}
+}
+
+// Acquire takes the lock on behalf of command, without waiting. It returns a
+// *StateLockedError if another process holds a lock that is not stale. The
+// returned release function is safe to call more than once and only removes
+// the lock while this process still holds it. It also removes the state
+// directory if Acquire created it and nothing else was written there.
+func (l *StateLock) Acquire(command string) (release func(), err error) {
+	path := filepath.Join(l.repoRoot, stateLockFile)
+	dir := filepath.Dir(path)
+	_, statErr := os.Stat(dir)
+	createdDir := errors.Is(statErr, os.ErrNotExist)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	holder := StateLockHolder{
+		PID:        os.Getpid(),
+		Hostname:   hostname,
+		Command:    command,
+		AcquiredAt: time.Now().UTC(),
+	}
+	data, err := json.MarshalIndent(holder, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state lock: %w", err)
+	}
+
+	// A stale lock is removed once and creation retried; losing that race to
+	// another process reports its lock.
+	for attempt := 0; ; attempt++ {
+		err := createExclusive(path, data)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create state lock: %w", err)
+		}
+
+		existing, readErr := l.readHolder(path)
+		if errors.Is(readErr, os.ErrNotExist) {
+			continue
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+		if attempt > 0 || !l.stale(existing, hostname) {
+			return nil, &StateLockedError{Path: path, Holder: *existing}
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove stale state lock: %w", err)
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			current, err := l.readHolder(path)
+			if err != nil || current.PID != holder.PID || !current.AcquiredAt.Equal(holder.AcquiredAt) {
+				return
+			}
+			_ = os.Remove(path) // Best-effort cleanup
+			if createdDir {
+				_ = os.Remove(dir) // Fails while the directory is not empty
+			}
+		})
+	}, nil
+}
+
+// stale reports whether the lock of holder can be taken over by a process
+// on hostname. The holder's PID is checked when it runs on the same host;
+// otherwise the lock goes stale after the timeout.
+func (l *StateLock) stale(holder *StateLockHolder, hostname string) bool {
+	if holder.PID > 0 && hostname != "" && holder.Hostname == hostname {
+		return !processAlive(holder.PID)
+	}
+	return time.Since(holder.AcquiredAt) > l.timeout
+}
+
+// readHolder reads the lock file at path. A lock file that cannot be parsed,
+// e.g. because its writer crashed mid-write, is attributed to an unknown
+// holder at the file's modification time so that it eventually goes stale.
+func (l *StateLock) readHolder(path string) (*StateLockHolder, error) {
+	data, err := fileutil.ReadFileLimited(path, maxStateLockFileSize)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read state lock: %w", err)
+	}
+
+	var holder StateLockHolder
+	if err := json.Unmarshal(data, &holder); err != nil || holder.AcquiredAt.IsZero() {
+		info, statErr := os.Stat(path)
+		if statErr != nil {
+			return nil, statErr
+		}
+		return &StateLockHolder{Command: "unknown", AcquiredAt: info.ModTime()}, nil
+	}
+	return &holder, nil
+}
+
+// createExclusive writes data to a new file at path, failing with
+// os.ErrExist if the file already exists.
+func createExclusive(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStateLock_AcquireAndRelease(t *testing.T) {
+	root := t.TempDir()
+	lock := NewStateLock(root, 0)
+
+	release, err := lock.Acquire("relicta bump")
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	_, err = NewStateLock(root, 0).Acquire("relicta publish")
+	if !errors.Is(err, ErrStateLocked) {
+		t.Fatalf("second Acquire() error = %v, want ErrStateLocked", err)
+	}
+	var lockedErr *StateLockedError
+	if !errors.As(err, &lockedErr) || lockedErr.Holder.PID != os.Getpid() || lockedErr.Holder.Command != "relicta bump" {
+		t.Fatalf("second Acquire() error = %#v, want holder relicta bump", err)
+	}
+	if !strings.Contains(err.Error(), "relicta bump") || !strings.Contains(err.Error(), "another relicta operation is in progress") {
+		t.Errorf("error message = %q", err.Error())
+	}
+
+	release()
+	release()
+	if _, err := os.Stat(filepath.Join(root, ".relicta")); !os.IsNotExist(err) {
+		t.Fatalf("state directory created for the lock still exists after release: %v", err)
+	}
+
+	release, err = lock.Acquire("relicta publish")
+	if err != nil {
+		t.Fatalf("Acquire() after release error = %v", err)
+	}
+	release()
+}
+
+func TestStateLock_TakesOverStaleLock(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, stateLockFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	stale, _ := json.Marshal(StateLockHolder{PID: 1, Hostname: "ci", Command: "relicta publish", AcquiredAt: time.Now().Add(-time.Hour)})
+	if err := os.WriteFile(path, stale, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewStateLock(root, 2*time.Hour).Acquire("relicta bump"); !errors.Is(err, ErrStateLocked) {
+		t.Fatalf("Acquire() error = %v, want ErrStateLocked within timeout", err)
+	}
+
+	release, err := NewStateLock(root, time.Minute).Acquire("relicta bump")
+	if err != nil {
+		t.Fatalf("Acquire() of stale lock error = %v", err)
+	}
+	defer release()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "relicta bump") {
+		t.Errorf("lock file = %s, want it taken over by relicta bump", data)
+	}
+}
+
+func TestStateLock_LocalHolderLiveness(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, stateLockFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Skipf("hostname unavailable: %v", err)
+	}
+	writeHolder := func(pid int, acquiredAt time.Time) {
+		t.Helper()
+		data, _ := json.Marshal(StateLockHolder{PID: pid, Hostname: hostname, Command: "relicta publish", AcquiredAt: acquiredAt})
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A running holder keeps the lock past the timeout.
+	writeHolder(os.Getpid(), time.Now().Add(-time.Hour))
+	if _, err := NewStateLock(root, time.Minute).Acquire("relicta bump"); !errors.Is(err, ErrStateLocked) {
+		t.Fatalf("Acquire() error = %v, want ErrStateLocked while the holder runs", err)
+	}
+
+	// A holder that exited loses the lock before the timeout.
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("failed to run helper process: %v", err)
+	}
+	writeHolder(cmd.Process.Pid, time.Now())
+	release, err := NewStateLock(root, time.Hour).Acquire("relicta bump")
+	if err != nil {
+		t.Fatalf("Acquire() of lock held by exited process error = %v", err)
+	}
+	release()
+}
+
+func TestStateLock_ReleaseKeepsLockTakenOverByOthers(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, stateLockFile)
+
+	release, err := NewStateLock(root, 0).Acquire("relicta publish")
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	other, _ := json.Marshal(StateLockHolder{PID: os.Getpid() + 1, Hostname: "ci", Command: "relicta bump", AcquiredAt: time.Now()})
+	if err := os.WriteFile(path, other, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	release()
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("release removed a lock held by another process: %v", err)
+	}
+}
+
+func TestStateLock_ReleaseKeepsStateDirectory(t *testing.T) {
+	root := t.TempDir()
+
+	release, err := NewStateLock(root, 0).Acquire("relicta plan")
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	state := filepath.Join(root, ".relicta", "freeze.json")
+	if err := os.WriteFile(state, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	release()
+	if _, err := os.Stat(state); err != nil {
+		t.Errorf("release removed state written while locked: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, stateLockFile)); !os.IsNotExist(err) {
+		t.Errorf("lock file still exists after release: %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package persistence
+
+import (
+	"os"
+)
+
+// processAlive reports whether a process with the given PID exists on this
+// host. On Windows, FindProcess fails for a PID that is not running.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	_ = p.Release()
+	return true
+}
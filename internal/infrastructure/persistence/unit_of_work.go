@@ -308,7 +308,7 @@ func (r *unitOfWorkRepository) FindByState(ctx context.Context, state release.Ru
 	return result, nil
 }
 
-// FindActive retrieves all active (non-final) releases.
+// FindActive retrieves all active (unfinished) releases.
 func (r *unitOfWorkRepository) FindActive(ctx context.Context) ([]*release.ReleaseRun, error) {
 	r.uow.mu.Lock()
 	defer r.uow.mu.Unlock()
@@ -332,7 +332,7 @@ func (r *unitOfWorkRepository) FindActive(ctx context.Context) ([]*release.Relea
 		if _, deleted := r.uow.pendingDeletes[id]; deleted {
 			continue
 		}
-		if !rel.State().IsFinal() {
+		if !rel.State().IsFinished() {
 			result = append(result, rel)
 			seen[id] = true
 		}
@@ -348,7 +348,7 @@ func (r *unitOfWorkRepository) FindActive(ctx context.Context) ([]*release.Relea
 		}
 		// Check if overridden in pending writes
 		if pending, ok := r.uow.pendingWrites[rel.ID()]; ok {
-			if !pending.State().IsFinal() {
+			if !pending.State().IsFinished() {
 				result = append(result, pending)
 			}
 			continue
@@ -0,0 +1,258 @@
+// Package secrets resolves secret references in configuration values.
+//
+// A secret reference has the form secret://<backend>/<name>[#field], for
+// example secret://vault/secret/data/relicta#openai_key or
+// secret://aws-sm/relicta/openai. References are resolved at runtime, when
+// the secret is needed; resolved values are never written back to the
+// configuration or logged.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Scheme is the prefix of a secret reference.
+const Scheme = "secret://"
+
+// Backend names.
+const (
+	BackendVault = "vault"
+	BackendAWS   = "aws-sm"
+	BackendGCP   = "gcp-sm"
+)
+
+// ErrUnknownBackend is returned for a reference to an unregistered backend.
+var ErrUnknownBackend = errors.New("unknown secret backend")
+
+// Reference identifies a secret in a backend.
+type Reference struct {
+	// Backend is the secret backend (e.g. "vault", "aws-sm", "gcp-sm").
+	Backend string
+	// Name is the secret path or name within the backend.
+	Name string
+	// Field selects a key of a secret holding several values. Empty
+	// selects the whole secret, or its only key.
+	Field string
+}
+
+// String returns the reference in secret:// form.
+func (r Reference) String() string {
+	s := Scheme + r.Backend + "/" + r.Name
+	if r.Field != "" {
+		s += "#" + r.Field
+	}
+	return s
+}
+
+// IsReference reports whether value is a secret reference.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, Scheme)
+}
+
+// ParseReference parses a secret://<backend>/<name>[#field] reference.
+func ParseReference(value string) (Reference, error) {
+	if !IsReference(value) {
+		return Reference{}, fmt.Errorf("not a secret reference: must start with %s", Scheme)
+	}
+
+	rest := strings.TrimPrefix(value, Scheme)
+	var ref Reference
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		rest, ref.Field = rest[:i], rest[i+1:]
+		if ref.Field == "" {
+			return Reference{}, fmt.Errorf("invalid secret reference %q: empty field after #", value)
+		}
+	}
+
+	backend, name, ok := strings.Cut(rest, "/")
+	if !ok || backend == "" || strings.Trim(name, "/") == "" {
+		return Reference{}, fmt.Errorf("invalid secret reference %q: expected %s<backend>/<name>", value, Scheme)
+	}
+	ref.Backend = backend
+	ref.Name = strings.Trim(name, "/")
+
+	if strings.HasPrefix(ref.Name, "-") {
+		return Reference{}, fmt.Errorf("invalid secret reference %q: name must not start with '-'", value)
+	}
+	for _, segment := range strings.Split(ref.Name, "/") {
+		if segment == ".." {
+			return Reference{}, fmt.Errorf("invalid secret reference %q: path traversal not allowed", value)
+		}
+	}
+	return ref, nil
+}
+
+// Backend fetches secrets from a secret manager.
+type Backend interface {
+	// Resolve returns the value of the referenced secret.
+	Resolve(ctx context.Context, ref Reference) (string, error)
+}
+
+// Resolver resolves secret references using registered backends.
+type Resolver struct {
+	backends map[string]Backend
+}
+
+// Option configures a Resolver.
+type Option func(*Resolver)
+
+// WithBackend registers a backend under name, replacing any existing one.
+func WithBackend(name string, backend Backend) Option {
+	return func(r *Resolver) {
+		r.backends[name] = backend
+	}
+}
+
+// NewResolver creates a resolver with the given backends.
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{backends: make(map[string]Backend)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// NewDefaultResolver creates a resolver with the HashiCorp Vault, AWS
+// Secrets Manager and GCP Secret Manager backends, configured from the
+// environment.
+func NewDefaultResolver(opts ...Option) *Resolver {
+	defaults := []Option{
+		WithBackend(BackendVault, NewVaultBackend()),
+		WithBackend(BackendAWS, NewAWSBackend()),
+		WithBackend(BackendGCP, NewGCPBackend()),
+	}
+	return NewResolver(append(defaults, opts...)...)
+}
+
+// Resolve returns value unchanged unless it is a secret reference, in which
+// case the referenced secret is fetched. Errors name the reference but never
+// contain secret material. A nil Resolver has no backends.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+
+	ref, err := ParseReference(value)
+	if err != nil {
+		return "", err
+	}
+	var backend Backend
+	ok := false
+	if r != nil {
+		backend, ok = r.backends[ref.Backend]
+	}
+	if !ok {
+		return "", fmt.Errorf("%w %q in %s", ErrUnknownBackend, ref.Backend, ref)
+	}
+
+	secret, err := backend.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", ref, err)
+	}
+	if secret == "" {
+		return "", fmt.Errorf("failed to resolve %s: secret is empty", ref)
+	}
+	return secret, nil
+}
+
+// ResolveMap returns a copy of values with every secret reference resolved,
+// including those in nested maps and lists. values itself is not modified.
+func (r *Resolver) ResolveMap(ctx context.Context, values map[string]any) (map[string]any, error) {
+	if values == nil {
+		return nil, nil
+	}
+	resolved, err := r.resolveValue(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]any), nil
+}
+
+func (r *Resolver) resolveValue(ctx context.Context, value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return r.Resolve(ctx, v)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			resolved, err := r.resolveValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := r.resolveValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
+
+// FindReferences returns the secret references in values keyed by their
+// dotted path below prefix (e.g. "config.token").
+func FindReferences(prefix string, values map[string]any) map[string]string {
+	found := make(map[string]string)
+	findReferences(prefix, values, found)
+	return found
+}
+
+func findReferences(path string, value any, found map[string]string) {
+	switch v := value.(type) {
+	case string:
+		if IsReference(v) {
+			found[path] = v
+		}
+	case map[string]any:
+		for key, item := range v {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			findReferences(child, item, found)
+		}
+	case []any:
+		for i, item := range v {
+			findReferences(fmt.Sprintf("%s[%d]", path, i), item, found)
+		}
+	}
+}
+
+// selectField returns the value of a secret. A secret with a field
+// selector must be a JSON object holding that key.
+func selectField(secret string, ref Reference) (string, error) {
+	if ref.Field == "" {
+		return secret, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("field %q requested but the secret is not a JSON object", ref.Field)
+	}
+	return fieldValue(fields, ref.Field)
+}
+
+// fieldValue returns the string value of key in fields.
+func fieldValue(fields map[string]any, key string) (string, error) {
+	value, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("field %q not found in secret", key)
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field %q is not a string", key)
+	}
+	return s, nil
+}
@@ -0,0 +1,298 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// mockBackend serves secrets from a map keyed by reference name.
+type mockBackend struct {
+	secrets map[string]string
+	calls   int
+}
+
+func (m *mockBackend) Resolve(_ context.Context, ref Reference) (string, error) {
+	m.calls++
+	secret, ok := m.secrets[ref.Name]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return selectField(secret, ref)
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Reference
+		wantErr string
+	}{
+		{value: "secret://vault/secret/data/relicta#openai_key", want: Reference{Backend: "vault", Name: "secret/data/relicta", Field: "openai_key"}},
+		{value: "secret://aws-sm/relicta/openai", want: Reference{Backend: "aws-sm", Name: "relicta/openai"}},
+		{value: "secret://gcp-sm/openai-key", want: Reference{Backend: "gcp-sm", Name: "openai-key"}},
+		{value: "plain-token", wantErr: "not a secret reference"},
+		{value: "secret://vault", wantErr: "expected secret://<backend>/<name>"},
+		{value: "secret://vault/", wantErr: "expected secret://<backend>/<name>"},
+		{value: "secret://vault/path#", wantErr: "empty field"},
+		{value: "secret://aws-sm/--profile", wantErr: "must not start with '-'"},
+		{value: "secret://vault/secret/../sys/keys", wantErr: "path traversal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseReference(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseReference() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseReference() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReference() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.value {
+				t.Errorf("String() = %q, want %q", got.String(), tt.value)
+			}
+		})
+	}
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	backend := &mockBackend{secrets: map[string]string{
+		"openai": "sk-test",
+		"tokens": `{"github":"ghp-test","count":3}`,
+		"empty":  "",
+	}}
+	r := NewResolver(WithBackend("mock", backend))
+	ctx := context.Background()
+
+	t.Run("plain values are returned unchanged", func(t *testing.T) {
+		got, err := r.Resolve(ctx, "sk-plain")
+		if err != nil || got != "sk-plain" {
+			t.Errorf("Resolve() = %q, %v; want sk-plain", got, err)
+		}
+		if backend.calls != 0 {
+			t.Errorf("backend called %d times for a plain value", backend.calls)
+		}
+	})
+
+	t.Run("resolves a whole secret", func(t *testing.T) {
+		got, err := r.Resolve(ctx, "secret://mock/openai")
+		if err != nil || got != "sk-test" {
+			t.Errorf("Resolve() = %q, %v; want sk-test", got, err)
+		}
+	})
+
+	t.Run("resolves a field", func(t *testing.T) {
+		got, err := r.Resolve(ctx, "secret://mock/tokens#github")
+		if err != nil || got != "ghp-test" {
+			t.Errorf("Resolve() = %q, %v; want ghp-test", got, err)
+		}
+	})
+
+	errorTests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "missing field", value: "secret://mock/tokens#gitlab", wantErr: `field "gitlab" not found`},
+		{name: "non-string field", value: "secret://mock/tokens#count", wantErr: "is not a string"},
+		{name: "field of a plain secret", value: "secret://mock/openai#key", wantErr: "not a JSON object"},
+		{name: "empty secret", value: "secret://mock/empty", wantErr: "secret is empty"},
+		{name: "unknown secret", value: "secret://mock/missing", wantErr: "failed to resolve secret://mock/missing"},
+		{name: "unknown backend", value: "secret://other/name", wantErr: "unknown secret backend"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Resolve(ctx, tt.value)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want containing %q", err, tt.wantErr)
+			}
+			if strings.Contains(err.Error(), "sk-test") || strings.Contains(err.Error(), "ghp-test") {
+				t.Errorf("Resolve() error leaks secret material: %v", err)
+			}
+		})
+	}
+}
+
+func TestResolver_ResolveMap(t *testing.T) {
+	backend := &mockBackend{secrets: map[string]string{"token": "ghp-test"}}
+	r := NewResolver(WithBackend("mock", backend))
+
+	values := map[string]any{
+		"token":  "secret://mock/token",
+		"owner":  "relicta-tech",
+		"draft":  true,
+		"nested": map[string]any{"headers": []any{"secret://mock/token", "plain"}},
+	}
+
+	got, err := r.ResolveMap(context.Background(), values)
+	if err != nil {
+		t.Fatalf("ResolveMap() error = %v", err)
+	}
+	if got["token"] != "ghp-test" || got["owner"] != "relicta-tech" || got["draft"] != true {
+		t.Errorf("ResolveMap() = %v", got)
+	}
+	headers := got["nested"].(map[string]any)["headers"].([]any)
+	if headers[0] != "ghp-test" || headers[1] != "plain" {
+		t.Errorf("nested headers = %v", headers)
+	}
+
+	// The input must not be modified so resolved secrets are never persisted.
+	if values["token"] != "secret://mock/token" {
+		t.Errorf("ResolveMap() modified its input: token = %v", values["token"])
+	}
+	if values["nested"].(map[string]any)["headers"].([]any)[0] != "secret://mock/token" {
+		t.Error("ResolveMap() modified a nested input list")
+	}
+
+	if _, err := r.ResolveMap(context.Background(), map[string]any{"token": "secret://mock/missing"}); err == nil {
+		t.Error("ResolveMap() error = nil for an unresolvable reference")
+	}
+}
+
+func TestFindReferences(t *testing.T) {
+	values := map[string]any{
+		"token":  "secret://vault/kv/data/ci#token",
+		"owner":  "relicta-tech",
+		"nested": map[string]any{"list": []any{"secret://aws-sm/key"}},
+	}
+
+	got := FindReferences("config", values)
+	want := map[string]string{
+		"config.token":          "secret://vault/kv/data/ci#token",
+		"config.nested.list[0]": "secret://aws-sm/key",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("FindReferences() = %v, want %v", got, want)
+	}
+	for path, ref := range want {
+		if got[path] != ref {
+			t.Errorf("FindReferences()[%q] = %q, want %q", path, got[path], ref)
+		}
+	}
+}
+
+func TestVaultBackend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/relicta":
+			_, _ = w.Write([]byte(`{"data":{"data":{"openai_key":"sk-v2","github":"ghp-v2"},"metadata":{"version":3}}}`))
+		case "/v1/kv/relicta":
+			_, _ = w.Write([]byte(`{"data":{"value":"sk-v1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	backend := NewVaultBackend(WithVaultAddress(server.URL+"/"), WithVaultToken("vault-token"), WithVaultHTTPClient(server.Client()))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		ref     Reference
+		want    string
+		wantErr string
+	}{
+		{name: "kv v2 field", ref: Reference{Name: "secret/data/relicta", Field: "openai_key"}, want: "sk-v2"},
+		{name: "kv v1 single field", ref: Reference{Name: "kv/relicta"}, want: "sk-v1"},
+		{name: "several fields need a selector", ref: Reference{Name: "secret/data/relicta"}, wantErr: "select one with #field"},
+		{name: "missing secret", ref: Reference{Name: "secret/data/missing"}, wantErr: "404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := backend.Resolve(ctx, tt.ref)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Resolve() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Resolve() = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+
+	t.Run("requires an address and token", func(t *testing.T) {
+		_, err := NewVaultBackend(WithVaultAddress(""), WithVaultToken("t")).Resolve(ctx, Reference{Name: "kv/x"})
+		if err == nil || !strings.Contains(err.Error(), "VAULT_ADDR") {
+			t.Errorf("Resolve() error = %v, want VAULT_ADDR hint", err)
+		}
+		_, err = NewVaultBackend(WithVaultAddress(server.URL), WithVaultToken("")).Resolve(ctx, Reference{Name: "kv/x"})
+		if err == nil || !strings.Contains(err.Error(), "VAULT_TOKEN") {
+			t.Errorf("Resolve() error = %v, want VAULT_TOKEN hint", err)
+		}
+	})
+}
+
+func TestCloudBackends(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+	runner := func(output string) CommandRunner {
+		return func(_ context.Context, name string, args ...string) ([]byte, error) {
+			gotName, gotArgs = name, args
+			return []byte(output), nil
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("aws secret string", func(t *testing.T) {
+		got, err := NewAWSBackendWithRunner(runner("sk-aws\n")).Resolve(ctx, Reference{Name: "relicta/openai"})
+		if err != nil || got != "sk-aws" {
+			t.Fatalf("Resolve() = %q, %v; want sk-aws", got, err)
+		}
+		want := "secretsmanager get-secret-value --secret-id relicta/openai --query SecretString --output text"
+		if gotName != "aws" || strings.Join(gotArgs, " ") != want {
+			t.Errorf("ran %s %v", gotName, gotArgs)
+		}
+	})
+
+	t.Run("aws json field", func(t *testing.T) {
+		got, err := NewAWSBackendWithRunner(runner(`{"token":"ghp-aws"}`)).Resolve(ctx, Reference{Name: "ci", Field: "token"})
+		if err != nil || got != "ghp-aws" {
+			t.Errorf("Resolve() = %q, %v; want ghp-aws", got, err)
+		}
+	})
+
+	t.Run("gcp secret name", func(t *testing.T) {
+		got, err := NewGCPBackendWithRunner(runner("sk-gcp")).Resolve(ctx, Reference{Name: "openai-key"})
+		if err != nil || got != "sk-gcp" {
+			t.Fatalf("Resolve() = %q, %v; want sk-gcp", got, err)
+		}
+		if gotName != "gcloud" || strings.Join(gotArgs, " ") != "secrets versions access latest --secret=openai-key" {
+			t.Errorf("ran %s %v", gotName, gotArgs)
+		}
+	})
+
+	t.Run("gcp resource name", func(t *testing.T) {
+		_, err := NewGCPBackendWithRunner(runner("sk-gcp")).Resolve(ctx, Reference{Name: "projects/p/secrets/openai"})
+		if err != nil {
+			t.Fatalf("Resolve() error = %v", err)
+		}
+		if strings.Join(gotArgs, " ") != "secrets versions access projects/p/secrets/openai/versions/latest" {
+			t.Errorf("ran %s %v", gotName, gotArgs)
+		}
+	})
+
+	t.Run("command errors are returned", func(t *testing.T) {
+		failing := func(context.Context, string, ...string) ([]byte, error) {
+			return nil, errors.New("gcloud failed: permission denied")
+		}
+		_, err := NewGCPBackendWithRunner(failing).Resolve(ctx, Reference{Name: "openai-key"})
+		if err == nil || !strings.Contains(err.Error(), "permission denied") {
+			t.Errorf("Resolve() error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxVaultResponseSize limits the size of a Vault response body.
+const maxVaultResponseSize = 1 << 20 // 1MB
+
+// VaultBackend reads secrets from HashiCorp Vault over its HTTP API.
+//
+// The reference name is the API path below /v1, e.g.
+// secret://vault/secret/data/relicta#openai_key for a KV v2 secret.
+// Both KV v1 and KV v2 responses are supported.
+type VaultBackend struct {
+	addr       string
+	token      string
+	namespace  string
+	httpClient *http.Client
+}
+
+// VaultOption configures a VaultBackend.
+type VaultOption func(*VaultBackend)
+
+// WithVaultAddress sets the Vault address (default: VAULT_ADDR).
+func WithVaultAddress(addr string) VaultOption {
+	return func(b *VaultBackend) {
+		b.addr = strings.TrimSuffix(addr, "/")
+	}
+}
+
+// WithVaultToken sets the Vault token (default: VAULT_TOKEN).
+func WithVaultToken(token string) VaultOption {
+	return func(b *VaultBackend) {
+		b.token = token
+	}
+}
+
+// WithVaultHTTPClient sets the HTTP client used for Vault requests.
+func WithVaultHTTPClient(client *http.Client) VaultOption {
+	return func(b *VaultBackend) {
+		b.httpClient = client
+	}
+}
+
+// NewVaultBackend creates a Vault backend configured from VAULT_ADDR,
+// VAULT_TOKEN and VAULT_NAMESPACE.
+func NewVaultBackend(opts ...VaultOption) *VaultBackend {
+	b := &VaultBackend{
+		addr:      strings.TrimSuffix(os.Getenv("VAULT_ADDR"), "/"),
+		token:     os.Getenv("VAULT_TOKEN"),
+		namespace: os.Getenv("VAULT_NAMESPACE"),
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+		},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Resolve implements Backend.
+func (b *VaultBackend) Resolve(ctx context.Context, ref Reference) (string, error) {
+	if b.addr == "" {
+		return "", fmt.Errorf("vault address not configured: set VAULT_ADDR")
+	}
+	if b.token == "" {
+		return "", fmt.Errorf("vault token not configured: set VAULT_TOKEN")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.addr+"/v1/"+ref.Name, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", b.token)
+	if b.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", b.namespace)
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to query vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault request failed: %s", resp.Status)
+	}
+
+	var body struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseSize)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+
+	fields := body.Data
+	// KV v2 nests the secret under data.data next to data.metadata.
+	if inner, ok := fields["data"].(map[string]any); ok {
+		if _, hasMetadata := fields["metadata"]; hasMetadata {
+			fields = inner
+		}
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("vault secret has no data")
+	}
+
+	if ref.Field != "" {
+		return fieldValue(fields, ref.Field)
+	}
+	if len(fields) == 1 {
+		for key := range fields {
+			return fieldValue(fields, key)
+		}
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return "", fmt.Errorf("vault secret has several fields (%s): select one with #field", strings.Join(keys, ", "))
+}
@@ -0,0 +1,541 @@
+// Package selfupdate checks GitHub releases for newer relicta versions and
+// replaces the running executable with a verified download.
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+const (
+	// DefaultRepository is the GitHub repository that publishes relicta releases.
+	DefaultRepository = "relicta-tech/relicta"
+	// DefaultAPIURL is the GitHub API base URL.
+	DefaultAPIURL = "https://api.github.com"
+
+	// checksumsAsset is the checksum file published with every release.
+	checksumsAsset = "checksums.txt"
+	// maxBinarySize caps the extracted binary size to prevent decompression bombs.
+	maxBinarySize = 200 * 1024 * 1024
+)
+
+// Channel selects which releases are considered for updates.
+type Channel string
+
+const (
+	// ChannelStable considers only stable releases.
+	ChannelStable Channel = "stable"
+	// ChannelPrerelease also considers prereleases (e.g. "v3.1.0-rc.1").
+	ChannelPrerelease Channel = "prerelease"
+)
+
+// ParseChannel parses a channel name.
+func ParseChannel(s string) (Channel, error) {
+	switch Channel(strings.ToLower(s)) {
+	case "", ChannelStable:
+		return ChannelStable, nil
+	case ChannelPrerelease:
+		return ChannelPrerelease, nil
+	default:
+		return "", fmt.Errorf("invalid channel %q: must be %q or %q", s, ChannelStable, ChannelPrerelease)
+	}
+}
+
+// ErrPermission is returned when the executable cannot be replaced due to
+// insufficient permissions.
+var ErrPermission = errors.New("permission denied replacing executable")
+
+// Asset is a downloadable file attached to a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Release is a published relicta release.
+type Release struct {
+	TagName    string  `json:"tag_name"`
+	HTMLURL    string  `json:"html_url"`
+	Draft      bool    `json:"draft"`
+	Prerelease bool    `json:"prerelease"`
+	Assets     []Asset `json:"assets"`
+
+	version version.SemanticVersion
+}
+
+// Version returns the parsed release version.
+func (r *Release) Version() version.SemanticVersion {
+	return r.version
+}
+
+// asset returns the named asset, if present.
+func (r *Release) asset(name string) (Asset, bool) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Asset{}, false
+}
+
+// CheckResult reports whether a newer release is available.
+type CheckResult struct {
+	Current         string   `json:"current"`
+	Latest          string   `json:"latest"`
+	Channel         Channel  `json:"channel"`
+	UpdateAvailable bool     `json:"update_available"`
+	ReleaseURL      string   `json:"release_url,omitempty"`
+	Release         *Release `json:"-"`
+}
+
+// Updater checks for and applies relicta updates.
+type Updater struct {
+	httpClient *http.Client
+	apiURL     string
+	repository string
+	goos       string
+	goarch     string
+	lookPath   func(string) (string, error)
+}
+
+// Option configures an Updater.
+type Option func(*Updater)
+
+// WithHTTPClient sets the HTTP client used for API calls and downloads.
+func WithHTTPClient(client *http.Client) Option {
+	return func(u *Updater) {
+		u.httpClient = client
+	}
+}
+
+// WithAPIURL overrides the GitHub API base URL.
+func WithAPIURL(url string) Option {
+	return func(u *Updater) {
+		u.apiURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithRepository overrides the repository releases are fetched from.
+func WithRepository(repo string) Option {
+	return func(u *Updater) {
+		u.repository = repo
+	}
+}
+
+// WithPlatform overrides the target OS and architecture.
+func WithPlatform(goos, goarch string) Option {
+	return func(u *Updater) {
+		u.goos = goos
+		u.goarch = goarch
+	}
+}
+
+// NewUpdater creates an Updater. Proxy settings are taken from the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func NewUpdater(opts ...Option) *Updater {
+	u := &Updater{
+		httpClient: &http.Client{
+			Timeout:   5 * time.Minute, // Larger timeout for downloads
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+		},
+		apiURL:     DefaultAPIURL,
+		repository: DefaultRepository,
+		goos:       runtime.GOOS,
+		goarch:     runtime.GOARCH,
+		lookPath:   exec.LookPath,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// IsNewer reports whether candidate is a newer version than current.
+func IsNewer(current, candidate string) (bool, error) {
+	cur, err := version.Parse(current)
+	if err != nil {
+		return false, fmt.Errorf("invalid current version %q: %w", current, err)
+	}
+	cand, err := version.Parse(candidate)
+	if err != nil {
+		return false, fmt.Errorf("invalid candidate version %q: %w", candidate, err)
+	}
+	return cand.GreaterThan(cur), nil
+}
+
+// LatestRelease returns the newest non-draft release on the channel.
+func (u *Updater) LatestRelease(ctx context.Context, channel Channel) (*Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases?per_page=30", u.apiURL, u.repository)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "relicta-self-update")
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d fetching releases", resp.StatusCode)
+	}
+
+	var releases []*Release
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("failed to decode releases: %w", err)
+	}
+
+	var latest *Release
+	for _, r := range releases {
+		if r.Draft {
+			continue
+		}
+		v, err := version.Parse(r.TagName)
+		if err != nil {
+			continue
+		}
+		if (r.Prerelease || v.IsPrerelease()) && channel != ChannelPrerelease {
+			continue
+		}
+		r.version = v
+		if latest == nil || v.GreaterThan(latest.version) {
+			latest = r
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no %s releases found for %s", channel, u.repository)
+	}
+	return latest, nil
+}
+
+// Check compares the current version against the latest release on the channel.
+func (u *Updater) Check(ctx context.Context, current string, channel Channel) (*CheckResult, error) {
+	latest, err := u.LatestRelease(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	newer, err := IsNewer(current, latest.TagName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckResult{
+		Current:         current,
+		Latest:          latest.TagName,
+		Channel:         channel,
+		UpdateAvailable: newer,
+		ReleaseURL:      latest.HTMLURL,
+		Release:         latest,
+	}, nil
+}
+
+// ArchiveNames returns the candidate release archive names for the updater's
+// platform: the GoReleaser name template "relicta_{os}_{arch}" followed by
+// the "relicta_Linux_x86_64" style used by earlier release downloads.
+func (u *Updater) ArchiveNames() []string {
+	ext := ".tar.gz"
+	if u.goos == "windows" {
+		ext = ".zip"
+	}
+
+	arch := u.goarch
+	switch arch {
+	case "amd64":
+		arch = "x86_64"
+	case "arm64":
+		arch = "aarch64"
+	}
+	goos := strings.ToUpper(u.goos[:1]) + u.goos[1:]
+
+	return []string{
+		fmt.Sprintf("relicta_%s_%s%s", u.goos, u.goarch, ext),
+		fmt.Sprintf("relicta_%s_%s%s", goos, arch, ext),
+	}
+}
+
+// binaryName returns the executable name inside the archive.
+func (u *Updater) binaryName() string {
+	if u.goos == "windows" {
+		return "relicta.exe"
+	}
+	return "relicta"
+}
+
+// ApplyResult describes a completed update.
+type ApplyResult struct {
+	// SignatureVerified is true when the checksum file's cosign signature
+	// was verified. It is false when cosign is not installed.
+	SignatureVerified bool
+}
+
+// Apply downloads the release for the current platform, verifies it against
+// the published checksums and atomically replaces the executable at exePath.
+func (u *Updater) Apply(ctx context.Context, release *Release, exePath string) (*ApplyResult, error) {
+	var archiveAsset Asset
+	for _, name := range u.ArchiveNames() {
+		if a, ok := release.asset(name); ok {
+			archiveAsset = a
+			break
+		}
+	}
+	if archiveAsset.Name == "" {
+		return nil, fmt.Errorf("release %s has no asset for %s/%s (looked for %s)",
+			release.TagName, u.goos, u.goarch, strings.Join(u.ArchiveNames(), ", "))
+	}
+	archiveName := archiveAsset.Name
+	checksumAsset, ok := release.asset(checksumsAsset)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no %s; refusing to install an unverified binary", release.TagName, checksumsAsset)
+	}
+
+	checksums, err := u.download(ctx, checksumAsset.URL)
+	if err != nil {
+		return nil, err
+	}
+	result := &ApplyResult{}
+	if result.SignatureVerified, err = u.verifySignature(ctx, release, checksums); err != nil {
+		return nil, err
+	}
+
+	expected, err := lookupChecksum(checksums, archiveName)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := u.download(ctx, archiveAsset.URL)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(archive)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, expected) {
+		return nil, fmt.Errorf("checksum verification failed for %s: expected %s, got %s", archiveName, expected, actual)
+	}
+
+	binary, err := u.extractBinary(archiveName, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := replaceExecutable(exePath, binary); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// download fetches a URL into memory.
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "relicta-self-update")
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d for URL: %s", resp.StatusCode, url)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBinarySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	if len(data) > maxBinarySize {
+		return nil, fmt.Errorf("download %s exceeds maximum allowed size of %d bytes", url, maxBinarySize)
+	}
+	return data, nil
+}
+
+// verifySignature verifies the checksum file's keyless cosign signature when
+// cosign is installed. It returns false without error when cosign is missing
+// or the release carries no signature.
+func (u *Updater) verifySignature(ctx context.Context, release *Release, checksums []byte) (bool, error) {
+	sigAsset, hasSig := release.asset(checksumsAsset + ".sig")
+	certAsset, hasCert := release.asset(checksumsAsset + ".pem")
+	if !hasSig || !hasCert {
+		return false, nil
+	}
+	cosign, err := u.lookPath("cosign")
+	if err != nil {
+		return false, nil
+	}
+
+	dir, err := os.MkdirTemp("", "relicta-self-update-*")
+	if err != nil {
+		return false, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{checksumsAsset: checksums}
+	for name, url := range map[string]string{"checksums.sig": sigAsset.URL, "checksums.pem": certAsset.URL} {
+		data, err := u.download(ctx, url)
+		if err != nil {
+			return false, err
+		}
+		files[name] = data
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			return false, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, cosign, "verify-blob", // #nosec G204 -- cosign resolved from PATH, args are fixed
+		"--certificate", filepath.Join(dir, "checksums.pem"),
+		"--signature", filepath.Join(dir, "checksums.sig"),
+		"--certificate-identity-regexp", fmt.Sprintf("^https://github.com/%s/", u.repository),
+		"--certificate-oidc-issuer", "https://token.actions.githubusercontent.com",
+		filepath.Join(dir, checksumsAsset),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return false, fmt.Errorf("signature verification failed for %s: %s", checksumsAsset, strings.TrimSpace(string(out)))
+	}
+	return true, nil
+}
+
+// lookupChecksum finds the SHA-256 for name in a checksums.txt file.
+func lookupChecksum(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return fields[0], nil
+		}
+	}
+	return "", fmt.Errorf("no checksum for %s in %s", name, checksumsAsset)
+}
+
+// extractBinary returns the relicta executable from a release archive.
+func (u *Updater) extractBinary(archiveName string, archive []byte) ([]byte, error) {
+	want := u.binaryName()
+
+	if strings.HasSuffix(archiveName, ".zip") {
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open zip archive: %w", err)
+		}
+		for _, f := range zr.File {
+			if filepath.Base(f.Name) != want || f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("failed to open %s in archive: %w", f.Name, err)
+			}
+			defer rc.Close()
+			return readLimited(rc)
+		}
+		return nil, fmt.Errorf("binary %s not found in archive", want)
+	}
+
+	gzr, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	defer func() { _ = gzr.Close() }()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar header: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg && filepath.Base(header.Name) == want {
+			return readLimited(tr)
+		}
+	}
+	return nil, fmt.Errorf("binary %s not found in archive", want)
+}
+
+// readLimited reads r, rejecting content larger than maxBinarySize.
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxBinarySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binary: %w", err)
+	}
+	if len(data) > maxBinarySize {
+		return nil, fmt.Errorf("binary exceeds maximum allowed size of %d bytes", maxBinarySize)
+	}
+	return data, nil
+}
+
+// replaceExecutable atomically replaces exePath with binary. The new file is
+// written next to the target and renamed over it so a failure never leaves a
+// partially written executable behind.
+func replaceExecutable(exePath string, binary []byte) error {
+	dir := filepath.Dir(exePath)
+
+	tmp, err := os.CreateTemp(dir, ".relicta-update-*")
+	if err != nil {
+		return wrapPermission(err, exePath)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // No-op once renamed
+
+	if _, err := tmp.Write(binary); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0o755); err != nil { // #nosec G302 -- executable must be runnable
+		return wrapPermission(err, exePath)
+	}
+
+	// Windows cannot overwrite a running executable, but it can rename it
+	if runtime.GOOS == "windows" {
+		oldPath := exePath + ".old"
+		_ = os.Remove(oldPath)
+		if err := os.Rename(exePath, oldPath); err != nil {
+			return wrapPermission(err, exePath)
+		}
+	}
+
+	if err := os.Rename(tmpPath, exePath); err != nil {
+		return wrapPermission(err, exePath)
+	}
+	return nil
+}
+
+// wrapPermission maps permission failures to ErrPermission.
+func wrapPermission(err error, exePath string) error {
+	if errors.Is(err, os.ErrPermission) {
+		return fmt.Errorf("%w: %s: %v", ErrPermission, exePath, err)
+	}
+	return fmt.Errorf("failed to replace %s: %w", exePath, err)
+}
@@ -0,0 +1,234 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIsNewer(t *testing.T) {
+	tests := []struct {
+		current   string
+		candidate string
+		want      bool
+		wantErr   bool
+	}{
+		{"v3.0.0", "v3.1.0", true, false},
+		{"3.0.0", "v3.0.1", true, false},
+		{"v3.1.0", "v3.0.9", false, false},
+		{"v3.1.0", "v3.1.0", false, false},
+		{"v3.1.0-rc.1", "v3.1.0", true, false},
+		{"v3.1.0", "v3.2.0-rc.1", true, false},
+		{"v3.1.0-rc.2", "v3.1.0-rc.1", false, false},
+		{"dev", "v3.1.0", false, true},
+		{"v3.1.0", "nightly", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+"->"+tt.candidate, func(t *testing.T) {
+			got, err := IsNewer(tt.current, tt.candidate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsNewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsNewer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseChannel(t *testing.T) {
+	for in, want := range map[string]Channel{"": ChannelStable, "stable": ChannelStable, "Prerelease": ChannelPrerelease} {
+		got, err := ParseChannel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseChannel(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseChannel("nightly"); err == nil {
+		t.Error("ParseChannel(nightly) should fail")
+	}
+}
+
+// releaseServer serves a GitHub releases listing and release assets.
+func releaseServer(t *testing.T, releases []map[string]any, assets map[string][]byte) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/relicta-tech/relicta/releases" {
+			for _, rel := range releases {
+				var list []map[string]string
+				for name := range assets {
+					list = append(list, map[string]string{"name": name, "browser_download_url": srv.URL + "/download/" + name})
+				}
+				rel["assets"] = list
+			}
+			_ = json.NewEncoder(w).Encode(releases)
+			return
+		}
+		if data, ok := assets[strings.TrimPrefix(r.URL.Path, "/download/")]; ok {
+			_, _ = w.Write(data)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestUpdater_Check(t *testing.T) {
+	releases := []map[string]any{
+		{"tag_name": "v3.2.0-rc.1", "prerelease": true},
+		{"tag_name": "v3.1.0"},
+		{"tag_name": "v3.0.0"},
+		{"tag_name": "v4.0.0", "draft": true},
+	}
+	srv := releaseServer(t, releases, nil)
+	u := NewUpdater(WithAPIURL(srv.URL))
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		current    string
+		channel    Channel
+		wantLatest string
+		wantUpdate bool
+	}{
+		{"stable update available", "v3.0.0", ChannelStable, "v3.1.0", true},
+		{"stable up to date", "v3.1.0", ChannelStable, "v3.1.0", false},
+		{"prerelease channel", "v3.1.0", ChannelPrerelease, "v3.2.0-rc.1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := u.Check(ctx, tt.current, tt.channel)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if result.Latest != tt.wantLatest || result.UpdateAvailable != tt.wantUpdate {
+				t.Errorf("Check() = latest %s update %v, want %s %v", result.Latest, result.UpdateAvailable, tt.wantLatest, tt.wantUpdate)
+			}
+		})
+	}
+}
+
+// tarGz builds a tar.gz archive containing a single file.
+func tarGz(t *testing.T, name string, content []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUpdater_Apply(t *testing.T) {
+	archive := tarGz(t, "relicta", []byte("new binary"))
+	sum := sha256.Sum256(archive)
+	archiveName := "relicta_linux_amd64.tar.gz"
+
+	tests := []struct {
+		name      string
+		checksums string
+		wantErr   string
+	}{
+		{"verified", fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), archiveName), ""},
+		{"checksum mismatch", fmt.Sprintf("%s  %s\n", strings.Repeat("0", 64), archiveName), "checksum verification failed"},
+		{"checksum missing", "", "no checksum for"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := releaseServer(t, []map[string]any{{"tag_name": "v3.1.0"}}, map[string][]byte{
+				archiveName:    archive,
+				checksumsAsset: []byte(tt.checksums),
+			})
+			u := NewUpdater(WithAPIURL(srv.URL), WithPlatform("linux", "amd64"))
+
+			exePath := filepath.Join(t.TempDir(), "relicta")
+			if err := os.WriteFile(exePath, []byte("old binary"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+
+			release, err := u.LatestRelease(context.Background(), ChannelStable)
+			if err != nil {
+				t.Fatalf("LatestRelease() error = %v", err)
+			}
+			_, err = u.Apply(context.Background(), release, exePath)
+
+			got, _ := os.ReadFile(exePath)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Apply() error = %v, want %q", err, tt.wantErr)
+				}
+				if string(got) != "old binary" {
+					t.Errorf("executable modified after failed update: %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if string(got) != "new binary" {
+				t.Errorf("executable content = %q, want new binary", got)
+			}
+		})
+	}
+}
+
+func TestReplaceExecutable_Permission(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permission checks do not apply to root")
+	}
+	dir := t.TempDir()
+	exePath := filepath.Join(dir, "relicta")
+	if err := os.WriteFile(exePath, []byte("old"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(dir, 0o555); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chmod(dir, 0o755) })
+
+	err := replaceExecutable(exePath, []byte("new"))
+	if !errors.Is(err, ErrPermission) {
+		t.Errorf("replaceExecutable() error = %v, want ErrPermission", err)
+	}
+}
+
+func TestUpdater_ArchiveNames(t *testing.T) {
+	tests := []struct {
+		goos, goarch string
+		want         []string
+	}{
+		{"linux", "amd64", []string{"relicta_linux_amd64.tar.gz", "relicta_Linux_x86_64.tar.gz"}},
+		{"darwin", "arm64", []string{"relicta_darwin_arm64.tar.gz", "relicta_Darwin_aarch64.tar.gz"}},
+		{"windows", "amd64", []string{"relicta_windows_amd64.zip", "relicta_Windows_x86_64.zip"}},
+	}
+	for _, tt := range tests {
+		got := NewUpdater(WithPlatform(tt.goos, tt.goarch)).ArchiveNames()
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("ArchiveNames(%s/%s) = %v, want %v", tt.goos, tt.goarch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/relicta-tech/relicta/internal/domain/communication"
+	rperrors "github.com/relicta-tech/relicta/internal/errors"
+	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+)
+
+// changelogTypeOrder is the display order of commit type groups.
+var changelogTypeOrder = []string{"feat", "fix", "perf", "refactor", "docs", "revert", "build", "ci", "test", "style", "chore"}
+
+// groupByTypeFunc groups changelog items by commit type in changelog
+// display order. Types outside the conventional set are grouped last,
+// alphabetically.
+func groupByTypeFunc(items []communication.ChangelogItem) []communication.ChangelogSection {
+	rank := make(map[string]int, len(changelogTypeOrder))
+	for i, t := range changelogTypeOrder {
+		rank[t] = i
+	}
+	groups := groupItems(items, func(item communication.ChangelogItem) string { return item.Type })
+	sort.SliceStable(groups, func(i, j int) bool {
+		ti, tj := groups[i].Items[0].Type, groups[j].Items[0].Type
+		ri, oki := rank[ti]
+		rj, okj := rank[tj]
+		switch {
+		case oki && okj:
+			return ri < rj
+		case oki != okj:
+			return oki
+		default:
+			return ti < tj
+		}
+	})
+	for i := range groups {
+		groups[i].Title = git.CommitTypeDisplayName(git.CommitType(groups[i].Items[0].Type))
+	}
+	return groups
+}
+
+// groupByScopeFunc groups changelog items by scope, sorted by scope name.
+// Items without a scope are grouped last under "General".
+func groupByScopeFunc(items []communication.ChangelogItem) []communication.ChangelogSection {
+	groups := groupItems(items, func(item communication.ChangelogItem) string { return item.Scope })
+	sort.SliceStable(groups, func(i, j int) bool {
+		si, sj := groups[i].Items[0].Scope, groups[j].Items[0].Scope
+		if si == "" || sj == "" {
+			return sj == ""
+		}
+		return si < sj
+	})
+	for i := range groups {
+		groups[i].Title = groups[i].Items[0].Scope
+		if groups[i].Title == "" {
+			groups[i].Title = "General"
+		}
+	}
+	return groups
+}
+
+// groupItems groups items by key, preserving first-seen order.
+func groupItems(items []communication.ChangelogItem, key func(communication.ChangelogItem) string) []communication.ChangelogSection {
+	var groups []communication.ChangelogSection
+	index := make(map[string]int)
+	for _, item := range items {
+		k := key(item)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, communication.ChangelogSection{})
+		}
+		groups[i].Items = append(groups[i].Items, item)
+	}
+	return groups
+}
+
+var issueRefRe = regexp.MustCompile(`#(\d+)\b`)
+
+// issueLinkFunc returns a template function that links issue references
+// (#123) in text to the issue tracker. issueURL may contain an {id}
+// placeholder; otherwise the issue number is appended to it. Without an
+// issueURL, issues are linked under repoURL.
+func issueLinkFunc(repoURL, issueURL string) func(string) string {
+	base := issueURL
+	if base == "" && repoURL != "" {
+		base = strings.TrimSuffix(repoURL, "/") + "/issues/"
+	}
+	return func(text string) string {
+		if base == "" {
+			return text
+		}
+		return issueRefRe.ReplaceAllStringFunc(text, func(ref string) string {
+			id := strings.TrimPrefix(ref, "#")
+			var url string
+			if strings.Contains(base, "{id}") {
+				url = strings.ReplaceAll(base, "{id}", id)
+			} else {
+				url = base + id
+			}
+			return mdLinkFunc(ref, url)
+		})
+	}
+}
+
+// RenderChangelogFile renders a changelog entry with a custom changelog
+// template file. Issue references are linked with repoURL and issueURL.
+// Parse and execution errors are returned with the template file name and
+// line number.
+func (s *ServiceImpl) RenderChangelogFile(ctx context.Context, path string, entry communication.ChangelogEntry, repoURL, issueURL string) (string, error) {
+	const op = "template.RenderChangelogFile"
+
+	content, err := os.ReadFile(path) // #nosec G304 -- path from user config
+	if err != nil {
+		return "", rperrors.IOWrap(err, op, fmt.Sprintf("failed to read changelog template: %s", path))
+	}
+
+	funcs := template.FuncMap{
+		"groupByScope": groupByScopeFunc,
+		"groupByType":  groupByTypeFunc,
+		"issueLink":    issueLinkFunc(repoURL, issueURL),
+	}
+	tmpl, err := template.New(filepath.Base(path)).Funcs(s.funcMap).Funcs(funcs).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return "", rperrors.TemplateWrap(err, op, "failed to parse changelog template")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.executionTimeout)
+	defer cancel()
+	return s.executeWithTimeout(ctx, op, tmpl, entry)
+}
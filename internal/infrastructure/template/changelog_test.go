@@ -0,0 +1,129 @@
+package template
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/domain/communication"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+const testRepoURL = "https://github.com/acme/app"
+
+func writeChangelogTemplate(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "CHANGELOG.tmpl")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+	return path
+}
+
+func testChangelogEntry() communication.ChangelogEntry {
+	return communication.ChangelogEntry{
+		Version: version.MustParse("1.2.0"),
+		Items: []communication.ChangelogItem{
+			{Type: "fix", Scope: "api", Description: "handle nil body (#12)", CommitHash: "abc1234", Author: "Sam"},
+			{Type: "feat", Scope: "cli", Description: "add --template flag", CommitHash: "def5678", Author: "Alex"},
+			{Type: "feat", Description: "new engine", CommitHash: "0123456", Author: "Sam", Breaking: true},
+		},
+		CompareURL: "https://github.com/acme/app/compare/v1.1.0...v1.2.0",
+	}
+}
+
+func TestGroupByType(t *testing.T) {
+	groups := groupByTypeFunc([]communication.ChangelogItem{
+		{Type: "chore"}, {Type: "custom"}, {Type: "fix"}, {Type: "feat"}, {Type: "fix"},
+	})
+
+	var types []string
+	for _, g := range groups {
+		types = append(types, g.Items[0].Type)
+	}
+	if got := strings.Join(types, ","); got != "feat,fix,chore,custom" {
+		t.Errorf("group order = %s, want feat,fix,chore,custom", got)
+	}
+	if groups[1].Title != "Bug Fixes" || len(groups[1].Items) != 2 {
+		t.Errorf("fix group = %+v", groups[1])
+	}
+}
+
+func TestRenderChangelogFile(t *testing.T) {
+	svc, err := NewService()
+	if err != nil {
+		t.Fatalf("NewService failed: %v", err)
+	}
+
+	path := writeChangelogTemplate(t, `## {{ upper .Version.String }}
+{{ range groupByType .Items }}### {{ .Title }}
+{{ range .Items }}- {{ issueLink .Description }} ({{ .CommitHash }})
+{{ end }}{{ end }}{{ range groupByScope .Items }}[{{ .Title }}]{{ end }}
+{{ .CompareURL }}`)
+
+	got, err := svc.RenderChangelogFile(context.Background(), path, testChangelogEntry(), testRepoURL, "")
+	if err != nil {
+		t.Fatalf("RenderChangelogFile failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"## 1.2.0",
+		"### Features\n- add --template flag (def5678)\n- new engine (0123456)\n### Bug Fixes",
+		"- handle nil body ([#12](https://github.com/acme/app/issues/12)) (abc1234)",
+		"[api][cli][General]",
+		"compare/v1.1.0...v1.2.0",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderChangelogFile_IssueURLPattern(t *testing.T) {
+	svc, err := NewService()
+	if err != nil {
+		t.Fatalf("NewService failed: %v", err)
+	}
+
+	path := writeChangelogTemplate(t, `{{ issueLink "fixes #7" }}`)
+
+	got, err := svc.RenderChangelogFile(context.Background(), path, testChangelogEntry(), testRepoURL, "https://jira.example.com/browse/APP-{id}")
+	if err != nil {
+		t.Fatalf("RenderChangelogFile failed: %v", err)
+	}
+	if got != "fixes [#7](https://jira.example.com/browse/APP-7)" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestRenderChangelogFile_ErrorsIncludeLine(t *testing.T) {
+	svc, err := NewService()
+	if err != nil {
+		t.Fatalf("NewService failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"parse error", "line one\n{{ .Version \n", "CHANGELOG.tmpl:2"},
+		{"unknown field", "ok\n\n{{ .Missing }}", "CHANGELOG.tmpl:3"},
+		{"unknown function", "{{ shout .Version }}", "CHANGELOG.tmpl:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeChangelogTemplate(t, tt.template)
+			_, err := svc.RenderChangelogFile(context.Background(), path, testChangelogEntry(), testRepoURL, "")
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
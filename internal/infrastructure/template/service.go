@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"text/template"
@@ -517,6 +518,12 @@ func lenFunc(v any) int {
 	case map[string]any:
 		return len(val)
 	default:
+		// Typed slices and maps, such as the changelog template data
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice:
+			return rv.Len()
+		}
 		return 0
 	}
 }
@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/fileutil"
+)
+
+// DefaultDeadLetterDir is the directory, relative to the release state root,
+// where undelivered webhook payloads are written.
+const DefaultDeadLetterDir = ".relicta/webhooks/failed"
+
+// DeadLetterDir returns the dead-letter directory of the repository at
+// repoRoot. Linked worktrees share the dead-letter directory of the main
+// working tree, like the rest of the release state.
+func DeadLetterDir(repoRoot string) string {
+	return filepath.Join(fileutil.StateRoot(repoRoot), DefaultDeadLetterDir)
+}
+
+// maxDeadLetterSize is the maximum size of a dead-letter file.
+const maxDeadLetterSize = 10 * 1024 * 1024
+
+// unsafeNameChars matches characters not allowed in dead-letter file names.
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// DeadLetter is a webhook payload that could not be delivered.
+type DeadLetter struct {
+	// Webhook is the name of the webhook the payload was sent to.
+	Webhook string `json:"webhook"`
+	// URL is the webhook endpoint.
+	URL string `json:"url"`
+	// Event is the event name.
+	Event string `json:"event"`
+	// LastStatus is the HTTP status of the last response, or 0 if the
+	// endpoint could not be reached.
+	LastStatus int `json:"last_status,omitempty"`
+	// LastError describes the last delivery failure.
+	LastError string `json:"last_error"`
+	// Attempts is the number of delivery attempts made.
+	Attempts int `json:"attempts"`
+	// FailedAt is when delivery was given up.
+	FailedAt time.Time `json:"failed_at"`
+	// Payload is the undelivered payload.
+	Payload *WebhookPayload `json:"payload"`
+}
+
+// DeadLetterFile is a dead letter read from disk.
+type DeadLetterFile struct {
+	// Path is the dead-letter file path.
+	Path string
+	// Letter is the file's content.
+	Letter *DeadLetter
+}
+
+// WriteDeadLetter atomically writes the dead letter to a new file in dir and
+// returns the file path.
+func WriteDeadLetter(dir string, letter *DeadLetter) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create dead-letter directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(letter, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal dead letter: %w", err)
+	}
+
+	name := fmt.Sprintf("%d-%s-%s.json",
+		letter.FailedAt.UnixNano(),
+		unsafeNameChars.ReplaceAllString(letter.Event, "_"),
+		unsafeNameChars.ReplaceAllString(letter.Webhook, "_"))
+	path := filepath.Join(dir, name)
+	// 0600 as payloads may contain release metadata
+	if err := fileutil.AtomicWriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write dead letter: %w", err)
+	}
+	return path, nil
+}
+
+// ListDeadLetters returns the dead letters in dir, oldest first. A missing
+// directory has no dead letters.
+func ListDeadLetters(dir string) ([]DeadLetterFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read dead-letter directory: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	slices.Sort(names)
+
+	files := make([]DeadLetterFile, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		data, err := fileutil.ReadFileLimited(path, maxDeadLetterSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dead letter %s: %w", name, err)
+		}
+		var letter DeadLetter
+		if err := json.Unmarshal(data, &letter); err != nil {
+			return nil, fmt.Errorf("invalid dead letter %s: %w", name, err)
+		}
+		files = append(files, DeadLetterFile{Path: path, Letter: &letter})
+	}
+	return files, nil
+}
+
+// ReplayResult is the outcome of replaying a dead letter.
+type ReplayResult struct {
+	// File is the replayed dead letter.
+	File DeadLetterFile
+	// Status is the HTTP status of the response, or 0 if none was received.
+	Status int
+	// Err is the delivery error, if the payload was not delivered.
+	Err error
+}
+
+// Replay re-sends a dead-lettered payload once to the webhook it was sent
+// to, using the webhook's current configuration (secret, headers, timeout).
+// A delivered dead letter is removed; an undelivered one is kept.
+func (p *Publisher) Replay(ctx context.Context, file DeadLetterFile) ReplayResult {
+	result := ReplayResult{File: file}
+
+	wh := p.findWebhook(file.Letter)
+	if wh == nil {
+		result.Err = fmt.Errorf("webhook %q is no longer configured", file.Letter.Webhook)
+		return result
+	}
+	if file.Letter.Payload == nil {
+		result.Err = fmt.Errorf("dead letter has no payload")
+		return result
+	}
+
+	result.Status, result.Err = p.send(ctx, wh, file.Letter.Payload)
+	if result.Err == nil {
+		if err := os.Remove(file.Path); err != nil && !os.IsNotExist(err) {
+			result.Err = fmt.Errorf("delivered, but failed to remove dead letter: %w", err)
+		}
+	}
+	return result
+}
+
+// findWebhook returns the configured webhook a dead letter was sent to,
+// matching by name and then by URL.
+func (p *Publisher) findWebhook(letter *DeadLetter) *config.WebhookConfig {
+	for i := range p.webhooks {
+		if letter.Webhook != "" && p.webhooks[i].Name == letter.Webhook {
+			return &p.webhooks[i]
+		}
+	}
+	for i := range p.webhooks {
+		if p.webhooks[i].URL == letter.URL {
+			return &p.webhooks[i]
+		}
+	}
+	return nil
+}
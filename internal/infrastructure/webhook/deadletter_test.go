@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	"github.com/relicta-tech/relicta/internal/domain/version"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	wh := &config.WebhookConfig{RetryDelay: 100 * time.Millisecond, MaxRetryDelay: time.Second}
+
+	tests := []struct {
+		retry    int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{5, 500 * time.Millisecond, time.Second},
+		{50, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if got := retryBackoff(wh, tt.retry); got < tt.min || got > tt.max {
+				t.Fatalf("retryBackoff(%d) = %v, want within [%v, %v]", tt.retry, got, tt.min, tt.max)
+			}
+		}
+	}
+
+	if got := getMaxRetryDelay(&config.WebhookConfig{}); got != 30*time.Second {
+		t.Errorf("default max retry delay = %v, want 30s", got)
+	}
+}
+
+func TestPublisher_WritesDeadLetter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "failed")
+	webhooks := []config.WebhookConfig{{
+		Name:       "ops",
+		URL:        server.URL,
+		RetryCount: 2,
+		RetryDelay: time.Millisecond,
+	}}
+	publisher := NewPublisher(webhooks, nil, WithDeadLetterDir(dir))
+
+	event := &release.RunPublishedEvent{RunID: "run-1", Version: version.MustParse("1.2.0"), At: time.Now()}
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+
+	var files []DeadLetterFile
+	deadline := time.Now().Add(2 * time.Second)
+	for len(files) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		var err error
+		if files, err = ListDeadLetters(dir); err != nil {
+			t.Fatalf("ListDeadLetters() error = %v", err)
+		}
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 dead letter, got %d", len(files))
+	}
+
+	letter := files[0].Letter
+	if letter.Webhook != "ops" || letter.URL != server.URL || letter.Event != "run.published" {
+		t.Errorf("dead letter = %+v", letter)
+	}
+	if letter.LastStatus != http.StatusServiceUnavailable || letter.Attempts != 3 || attempts.Load() != 3 {
+		t.Errorf("LastStatus = %d, Attempts = %d, server attempts = %d; want 503, 3, 3", letter.LastStatus, letter.Attempts, attempts.Load())
+	}
+	if letter.Payload == nil || letter.Payload.ReleaseID != "run-1" || letter.Payload.Data["version"] != "1.2.0" {
+		t.Errorf("payload = %+v", letter.Payload)
+	}
+
+	info, err := os.Stat(files[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("dead letter mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestPublisher_Replay(t *testing.T) {
+	var received atomic.Int32
+	var signature atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		signature.Store(r.Header.Get("X-Relicta-Signature"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	letter := &DeadLetter{
+		Webhook:  "ops",
+		URL:      "http://old.example.invalid",
+		Event:    "run.published",
+		FailedAt: time.Now(),
+		Payload:  &WebhookPayload{Event: "run.published", ReleaseID: "run-1", Data: map[string]any{}},
+	}
+	path, err := WriteDeadLetter(dir, letter)
+	if err != nil {
+		t.Fatalf("WriteDeadLetter() error = %v", err)
+	}
+	files, err := ListDeadLetters(dir)
+	if err != nil || len(files) != 1 {
+		t.Fatalf("ListDeadLetters() = %v, %v", files, err)
+	}
+
+	// An unknown webhook keeps the dead letter
+	result := NewPublisher([]config.WebhookConfig{{Name: "other", URL: server.URL}}, nil).Replay(context.Background(), files[0])
+	if result.Err == nil {
+		t.Fatal("expected error for unconfigured webhook")
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("dead letter removed after failed replay: %v", err)
+	}
+
+	// The current webhook configuration is used, matched by name
+	publisher := NewPublisher([]config.WebhookConfig{{Name: "ops", URL: server.URL, Secret: "s3cret"}}, nil)
+	result = publisher.Replay(context.Background(), files[0])
+	if result.Err != nil || result.Status != http.StatusOK {
+		t.Fatalf("Replay() = %d, %v", result.Status, result.Err)
+	}
+	if received.Load() != 1 || signature.Load() == "" {
+		t.Errorf("received = %d, signature = %q", received.Load(), signature.Load())
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected dead letter to be removed, stat error = %v", err)
+	}
+}
+
+func TestPublisher_ReplayRespectsTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	publisher := NewPublisher([]config.WebhookConfig{{Name: "slow", URL: server.URL, Timeout: 20 * time.Millisecond}}, nil)
+	file := DeadLetterFile{
+		Path:   filepath.Join(t.TempDir(), "letter.json"),
+		Letter: &DeadLetter{Webhook: "slow", Payload: &WebhookPayload{Event: "run.failed"}},
+	}
+
+	start := time.Now()
+	result := publisher.Replay(context.Background(), file)
+	if result.Err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Replay took %v, want timeout near 20ms", elapsed)
+	}
+}
+
+func TestListDeadLetters_MissingDir(t *testing.T) {
+	files, err := ListDeadLetters(filepath.Join(t.TempDir(), "missing"))
+	if err != nil || files != nil {
+		t.Errorf("ListDeadLetters() = %v, %v; want nil, nil", files, err)
+	}
+}
+
+func TestDeadLetterDir(t *testing.T) {
+	root := t.TempDir()
+	want := filepath.Join(root, ".relicta", "webhooks", "failed")
+	if got := DeadLetterDir(root); got != want {
+		t.Errorf("DeadLetterDir() = %q, want %q", got, want)
+	}
+
+	// A linked worktree uses the dead-letter directory of the main working tree
+	wtGitDir := filepath.Join(root, ".git", "worktrees", "wt")
+	if err := os.MkdirAll(wtGitDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(wtGitDir, "commondir"), []byte("../..\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	worktree := t.TempDir()
+	if err := os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+wtGitDir+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := DeadLetterDir(worktree); got != want {
+		t.Errorf("DeadLetterDir(worktree) = %q, want %q", got, want)
+	}
+}
@@ -4,19 +4,19 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/release"
+	webhooksig "github.com/relicta-tech/relicta/pkg/webhook"
 )
 
 // getTimeout returns the configured timeout or default.
@@ -43,6 +43,33 @@ func getRetryDelay(c *config.WebhookConfig) time.Duration {
 	return c.RetryDelay
 }
 
+// getMaxRetryDelay returns the configured maximum retry delay or default.
+func getMaxRetryDelay(c *config.WebhookConfig) time.Duration {
+	if c.MaxRetryDelay == 0 {
+		return 30 * time.Second
+	}
+	return c.MaxRetryDelay
+}
+
+// retryBackoff returns the delay before the given retry (starting at 1).
+// The delay doubles from the retry delay with each retry, is capped at the
+// maximum retry delay, and is jittered to between half and all of that value
+// so that failing webhooks are not retried in lockstep.
+func retryBackoff(c *config.WebhookConfig, retry int) time.Duration {
+	maxDelay := getMaxRetryDelay(c)
+	delay := getRetryDelay(c)
+	for i := 1; i < retry && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(delay-half+1) // #nosec G404 -- jitter does not need a secure source
+}
+
 // WebhookPayload is the JSON payload sent to webhook endpoints.
 type WebhookPayload struct {
 	// Event is the event name (e.g., "release.published").
@@ -57,21 +84,37 @@ type WebhookPayload struct {
 
 // Publisher implements release.EventPublisher and sends events to webhook endpoints.
 type Publisher struct {
-	webhooks []config.WebhookConfig
-	client   *http.Client
-	next     release.EventPublisher
-	logger   *slog.Logger
+	webhooks      []config.WebhookConfig
+	client        *http.Client
+	next          release.EventPublisher
+	logger        *slog.Logger
+	deadLetterDir string
+}
+
+// PublisherOption configures a Publisher.
+type PublisherOption func(*Publisher)
+
+// WithDeadLetterDir writes payloads that could not be delivered after all
+// retries to dir so they can be replayed later.
+func WithDeadLetterDir(dir string) PublisherOption {
+	return func(p *Publisher) {
+		p.deadLetterDir = dir
+	}
 }
 
 // NewPublisher creates a new webhook publisher.
 // The next parameter is optional - if nil, events are not forwarded.
-func NewPublisher(webhooks []config.WebhookConfig, next release.EventPublisher) *Publisher {
-	return &Publisher{
+func NewPublisher(webhooks []config.WebhookConfig, next release.EventPublisher, opts ...PublisherOption) *Publisher {
+	p := &Publisher{
 		webhooks: webhooks,
 		client:   &http.Client{},
 		next:     next,
 		logger:   slog.Default().With("component", "webhook_publisher"),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // Publish sends events to configured webhook endpoints.
@@ -87,7 +130,7 @@ func (p *Publisher) Publish(ctx context.Context, events ...release.DomainEvent)
 				continue
 			}
 
-			payload := p.buildPayload(event)
+			payload := p.buildPayload(wh, event)
 			go p.sendWithRetry(ctx, wh, payload)
 		}
 	}
@@ -123,7 +166,9 @@ func (p *Publisher) shouldSendEvent(wh *config.WebhookConfig, eventName string)
 }
 
 // buildPayload creates a WebhookPayload from a domain event.
-func (p *Publisher) buildPayload(event release.DomainEvent) *WebhookPayload {
+// The publish manifest is only embedded for webhooks that opt in, keeping
+// the default payload lean.
+func (p *Publisher) buildPayload(wh *config.WebhookConfig, event release.DomainEvent) *WebhookPayload {
 	payload := &WebhookPayload{
 		Event:     event.EventName(),
 		Timestamp: event.OccurredAt(),
@@ -159,12 +204,20 @@ func (p *Publisher) buildPayload(event release.DomainEvent) *WebhookPayload {
 		payload.Data["auto_approved"] = e.AutoApproved
 		payload.Data["plan_hash"] = e.PlanHash
 
+	case *release.RunRejectedEvent:
+		payload.Data["rejected_by"] = e.RejectedBy
+		payload.Data["reason"] = e.Reason
+		payload.Data["plan_hash"] = e.PlanHash
+
 	case *release.RunPublishingStartedEvent:
 		payload.Data["steps"] = e.Steps
 		payload.Data["plan_hash"] = e.PlanHash
 
 	case *release.RunPublishedEvent:
 		payload.Data["version"] = e.Version.String()
+		if wh.IncludeManifest {
+			payload.Data["manifest"] = e.Manifest
+		}
 
 	case *release.RunFailedEvent:
 		payload.Data["reason"] = e.Reason
@@ -193,9 +246,58 @@ func (p *Publisher) buildPayload(event release.DomainEvent) *WebhookPayload {
 	return payload
 }
 
-// sendWithRetry sends a webhook request with retries.
+// sendWithRetry sends a webhook request with retries. A payload that is
+// still undelivered after the last retry is written to the dead-letter
+// directory, if one is configured.
 func (p *Publisher) sendWithRetry(ctx context.Context, wh *config.WebhookConfig, payload *WebhookPayload) {
+	attempts, status, err := p.deliver(ctx, wh, payload)
+	if err == nil {
+		p.logger.Debug("webhook sent successfully",
+			"webhook", wh.Name,
+			"event", payload.Event,
+			"release_id", payload.ReleaseID)
+		return
+	}
+
+	p.logger.Error("webhook failed after all retries",
+		"webhook", wh.Name,
+		"event", payload.Event,
+		"release_id", payload.ReleaseID,
+		"error", err)
+
+	if p.deadLetterDir == "" {
+		return
+	}
+	letter := &DeadLetter{
+		Webhook:    wh.Name,
+		URL:        wh.URL,
+		Event:      payload.Event,
+		LastStatus: status,
+		LastError:  err.Error(),
+		Attempts:   attempts,
+		FailedAt:   time.Now().UTC(),
+		Payload:    payload,
+	}
+	if path, err := WriteDeadLetter(p.deadLetterDir, letter); err != nil {
+		p.logger.Error("failed to write webhook dead letter",
+			"webhook", wh.Name,
+			"event", payload.Event,
+			"error", err)
+	} else {
+		p.logger.Warn("undelivered webhook payload saved for replay",
+			"webhook", wh.Name,
+			"event", payload.Event,
+			"path", path)
+	}
+}
+
+// deliver sends a webhook request, retrying with exponential backoff. It
+// returns the number of attempts made, the HTTP status of the last response
+// (0 if none was received) and the last error.
+func (p *Publisher) deliver(ctx context.Context, wh *config.WebhookConfig, payload *WebhookPayload) (int, int, error) {
 	var lastErr error
+	var lastStatus int
+	attempts := 0
 
 	for attempt := 0; attempt <= getRetryCount(wh); attempt++ {
 		if attempt > 0 {
@@ -205,21 +307,19 @@ func (p *Publisher) sendWithRetry(ctx context.Context, wh *config.WebhookConfig,
 					"webhook", wh.Name,
 					"attempt", attempt,
 					"error", ctx.Err())
-				return
-			case <-time.After(getRetryDelay(wh)):
+				return attempts, lastStatus, ctx.Err()
+			case <-time.After(retryBackoff(wh, attempt)):
 			}
 		}
 
-		err := p.send(ctx, wh, payload)
+		attempts++
+		status, err := p.send(ctx, wh, payload)
 		if err == nil {
-			p.logger.Debug("webhook sent successfully",
-				"webhook", wh.Name,
-				"event", payload.Event,
-				"release_id", payload.ReleaseID)
-			return
+			return attempts, status, nil
 		}
 
 		lastErr = err
+		lastStatus = status
 		p.logger.Warn("webhook request failed",
 			"webhook", wh.Name,
 			"attempt", attempt+1,
@@ -227,18 +327,15 @@ func (p *Publisher) sendWithRetry(ctx context.Context, wh *config.WebhookConfig,
 			"error", err)
 	}
 
-	p.logger.Error("webhook failed after all retries",
-		"webhook", wh.Name,
-		"event", payload.Event,
-		"release_id", payload.ReleaseID,
-		"error", lastErr)
+	return attempts, lastStatus, lastErr
 }
 
-// send performs a single webhook request.
-func (p *Publisher) send(ctx context.Context, wh *config.WebhookConfig, payload *WebhookPayload) error {
+// send performs a single webhook request and returns the response status,
+// or 0 when no response was received.
+func (p *Publisher) send(ctx context.Context, wh *config.WebhookConfig, payload *WebhookPayload) (int, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
+		return 0, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, getTimeout(wh))
@@ -246,7 +343,7 @@ func (p *Publisher) send(ctx context.Context, wh *config.WebhookConfig, payload
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -260,15 +357,18 @@ func (p *Publisher) send(ctx context.Context, wh *config.WebhookConfig, payload
 		req.Header.Set(key, value)
 	}
 
-	// Sign the payload if secret is configured
+	// Sign the timestamp and payload if secret is configured. The legacy
+	// body-only signature is still sent for receivers that verify it.
 	if wh.Secret != "" {
-		signature := signPayload(body, wh.Secret)
-		req.Header.Set("X-Relicta-Signature", "sha256="+signature)
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(webhooksig.TimestampHeader, timestamp)
+		req.Header.Set(webhooksig.SignatureHeader, webhooksig.Sign(wh.Secret, timestamp, body))
+		req.Header.Set(webhooksig.LegacySignatureHeader, webhooksig.SignLegacy(wh.Secret, body))
 	}
 
 	resp, err := p.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -276,27 +376,10 @@ func (p *Publisher) send(ctx context.Context, wh *config.WebhookConfig, payload
 	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
 
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("server returned %d: %s", resp.StatusCode, string(respBody))
+		return resp.StatusCode, fmt.Errorf("server returned %d: %s", resp.StatusCode, string(respBody))
 	}
 
-	return nil
-}
-
-// signPayload creates an HMAC-SHA256 signature of the payload.
-func signPayload(payload []byte, secret string) string {
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write(payload)
-	return hex.EncodeToString(mac.Sum(nil))
-}
-
-// VerifySignature verifies a webhook signature.
-// This is a helper for webhook receivers to validate payloads.
-func VerifySignature(payload []byte, signature, secret string) bool {
-	// Remove "sha256=" prefix if present
-	signature = strings.TrimPrefix(signature, "sha256=")
-
-	expected := signPayload(payload, secret)
-	return hmac.Equal([]byte(expected), []byte(signature))
+	return resp.StatusCode, nil
 }
 
 // Ensure Publisher implements release.EventPublisher.
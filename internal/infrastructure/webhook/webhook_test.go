@@ -13,6 +13,7 @@ import (
 	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	"github.com/relicta-tech/relicta/internal/domain/version"
+	webhooksig "github.com/relicta-tech/relicta/pkg/webhook"
 )
 
 func TestPublisher_SendsToWebhook(t *testing.T) {
@@ -187,7 +188,7 @@ func TestPublisher_WildcardFilter(t *testing.T) {
 
 func TestPublisher_SignsPayload(t *testing.T) {
 	secret := "my-webhook-secret"
-	var receivedSignature string
+	var receivedSignature, receivedLegacySignature, receivedTimestamp string
 	var receivedBody []byte
 	var mu sync.Mutex
 
@@ -195,7 +196,9 @@ func TestPublisher_SignsPayload(t *testing.T) {
 		mu.Lock()
 		defer mu.Unlock()
 
-		receivedSignature = r.Header.Get("X-Relicta-Signature")
+		receivedSignature = r.Header.Get("X-Relicta-Webhook-Signature")
+		receivedLegacySignature = r.Header.Get("X-Relicta-Signature")
+		receivedTimestamp = r.Header.Get("X-Relicta-Timestamp")
 		receivedBody, _ = io.ReadAll(r.Body)
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -226,12 +229,17 @@ func TestPublisher_SignsPayload(t *testing.T) {
 	defer mu.Unlock()
 
 	if receivedSignature == "" {
-		t.Fatal("expected X-Relicta-Signature header")
+		t.Fatal("expected X-Relicta-Webhook-Signature header")
 	}
 
 	// Verify the signature is valid
-	if !VerifySignature(receivedBody, receivedSignature, secret) {
-		t.Error("signature verification failed")
+	if err := webhooksig.VerifySignature(secret, receivedTimestamp, string(receivedBody), receivedSignature); err != nil {
+		t.Errorf("signature verification failed: %v", err)
+	}
+
+	// Receivers that have not migrated still get the legacy signature
+	if err := webhooksig.VerifyLegacySignature(secret, string(receivedBody), receivedLegacySignature); err != nil {
+		t.Errorf("legacy signature verification failed: %v", err)
 	}
 }
 
@@ -429,6 +437,7 @@ func TestPublisher_AllEventTypes(t *testing.T) {
 		&release.RunVersionedEvent{RunID: releaseID, VersionNext: version.MustParse("1.1.0"), TagName: "v1.1.0", At: time.Now()},
 		&release.RunNotesGeneratedEvent{RunID: releaseID, NotesLength: 500, At: time.Now()},
 		&release.RunApprovedEvent{RunID: releaseID, ApprovedBy: "admin", At: time.Now()},
+		&release.RunRejectedEvent{RunID: releaseID, RejectedBy: "reviewer", Reason: "missing migration notes", At: time.Now()},
 		&release.RunPublishingStartedEvent{RunID: releaseID, Steps: []string{"github", "slack"}, At: time.Now()},
 		&release.RunPublishedEvent{RunID: releaseID, Version: version.MustParse("1.1.0"), At: time.Now()},
 		&release.RunFailedEvent{RunID: releaseID, Reason: "test failure", At: time.Now()},
@@ -464,6 +473,7 @@ func TestPublisher_AllEventTypes(t *testing.T) {
 		"run.versioned",
 		"run.notes_generated",
 		"run.approved",
+		"run.rejected",
 		"run.publishing_started",
 		"run.published",
 		"run.failed",
@@ -479,25 +489,6 @@ func TestPublisher_AllEventTypes(t *testing.T) {
 	}
 }
 
-func TestVerifySignature(t *testing.T) {
-	payload := []byte(`{"event":"release.published","release_id":"test"}`)
-	secret := "test-secret"
-
-	signature := signPayload(payload, secret)
-
-	if !VerifySignature(payload, "sha256="+signature, secret) {
-		t.Error("signature verification should pass with correct secret")
-	}
-
-	if VerifySignature(payload, "sha256="+signature, "wrong-secret") {
-		t.Error("signature verification should fail with wrong secret")
-	}
-
-	if VerifySignature([]byte("different payload"), "sha256="+signature, secret) {
-		t.Error("signature verification should fail with different payload")
-	}
-}
-
 func TestWebhookConfig_Defaults(t *testing.T) {
 	cfg := &config.WebhookConfig{
 		Name: "test",
@@ -529,3 +520,97 @@ func (m *mockEventPublisher) Publish(ctx context.Context, events ...release.Doma
 	m.events = append(m.events, events...)
 	return nil
 }
+
+func TestPublisher_IncludeManifest(t *testing.T) {
+	secret := "manifest-secret"
+	manifest := release.PublishManifest{
+		SchemaVersion: release.PublishManifestSchemaVersion,
+		RunID:         "test-release",
+		Version:       "1.0.0",
+		TagName:       "v1.0.0",
+		Targets: []release.PublishTarget{
+			{Step: "create-tag", Type: release.StepTypeTag, TagName: "v1.0.0", State: release.StepDone},
+			{Step: "github", Type: release.StepTypePlugin, Plugin: "github", State: release.StepDone},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		includeManifest bool
+	}{
+		{"enabled", true},
+		{"disabled", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var receivedSignature, receivedTimestamp string
+			var receivedBody []byte
+			var mu sync.Mutex
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				receivedSignature = r.Header.Get("X-Relicta-Webhook-Signature")
+				receivedTimestamp = r.Header.Get("X-Relicta-Timestamp")
+				receivedBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			publisher := NewPublisher([]config.WebhookConfig{
+				{Name: "manifest", URL: server.URL, Secret: secret, IncludeManifest: tt.includeManifest},
+			}, nil)
+
+			event := &release.RunPublishedEvent{
+				RunID:    "test-release",
+				Version:  version.MustParse("1.0.0"),
+				Manifest: manifest,
+				At:       time.Now(),
+			}
+			if err := publisher.Publish(context.Background(), event); err != nil {
+				t.Fatalf("Publish failed: %v", err)
+			}
+
+			// Wait for async send
+			time.Sleep(100 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err := webhooksig.VerifySignature(secret, receivedTimestamp, string(receivedBody), receivedSignature); err != nil {
+				t.Fatalf("signature verification failed: %v", err)
+			}
+
+			var payload struct {
+				Data map[string]json.RawMessage `json:"data"`
+			}
+			if err := json.Unmarshal(receivedBody, &payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+
+			raw, ok := payload.Data["manifest"]
+			if !tt.includeManifest {
+				if ok {
+					t.Error("manifest should not be included by default")
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("expected manifest in payload data")
+			}
+
+			var got release.PublishManifest
+			if err := json.Unmarshal(raw, &got); err != nil {
+				t.Fatalf("failed to decode manifest: %v", err)
+			}
+			if got.SchemaVersion != release.PublishManifestSchemaVersion {
+				t.Errorf("SchemaVersion = %q, want %q", got.SchemaVersion, release.PublishManifestSchemaVersion)
+			}
+			if len(got.Targets) != 2 || got.Targets[1].Plugin != "github" {
+				t.Errorf("Targets = %+v, want tag and github targets", got.Targets)
+			}
+		})
+	}
+}
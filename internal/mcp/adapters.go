@@ -4,16 +4,23 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/relicta-tech/relicta/internal/application/blast"
 	"github.com/relicta-tech/relicta/internal/application/governance"
+	appmonorepo "github.com/relicta-tech/relicta/internal/application/monorepo"
+	"github.com/relicta-tech/relicta/internal/application/versioning"
 	"github.com/relicta-tech/relicta/internal/cgp"
+	"github.com/relicta-tech/relicta/internal/cgp/risk"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
 	domainrelease "github.com/relicta-tech/relicta/internal/domain/release"
 	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
 	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
 	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/internal/domain/version"
 	"github.com/relicta-tech/relicta/internal/infrastructure/ai"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
 	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
 )
 
@@ -28,6 +35,34 @@ type Adapter struct {
 
 	// repoRoot caches the repository root path for use cases
 	repoRoot string
+
+	// approvalTTL is how long approvals stay valid (zero = no expiry)
+	approvalTTL time.Duration
+
+	// onPluginFailure is the publish behavior when a plugin step fails
+	onPluginFailure releaseapp.PluginFailureMode
+
+	// approvalPolicy is the multi-level approval policy (nil = release level only)
+	approvalPolicy *releasedomain.ApprovalPolicy
+
+	// nonConventionalPolicy controls how non-conventional commits affect
+	// the version (empty = infer)
+	nonConventionalPolicy servicerelease.NonConventionalPolicy
+
+	// commitParser parses commit messages (nil = Conventional Commits)
+	commitParser changes.CommitParser
+
+	// versionFile is the project version file holding the current version
+	// (nil = the current version comes from tags)
+	versionFile *appmonorepo.PackageVersionFile
+
+	// excludeScopes lists commit scope globs omitted from generated notes
+	excludeScopes []string
+
+	// previewGenerator renders the dry-run changelog preview with
+	// previewOptions (nil = no preview)
+	previewGenerator ports.NotesGenerator
+	previewOptions   ports.NotesOptions
 }
 
 // AdapterOption configures the Adapter.
@@ -102,6 +137,83 @@ func WithRepoRoot(path string) AdapterOption {
 	}
 }
 
+// WithApprovalTTL sets how long an approval stays valid before publishing
+// requires re-approval.
+func WithApprovalTTL(ttl time.Duration) AdapterOption {
+	return func(a *Adapter) {
+		a.approvalTTL = ttl
+	}
+}
+
+// WithOnPluginFailure sets how publish handles failed plugin steps.
+func WithOnPluginFailure(mode releaseapp.PluginFailureMode) AdapterOption {
+	return func(a *Adapter) {
+		a.onPluginFailure = mode
+	}
+}
+
+// WithApprovalPolicy sets the policy used for multi-level approvals.
+func WithApprovalPolicy(policy releasedomain.ApprovalPolicy) AdapterOption {
+	return func(a *Adapter) {
+		a.approvalPolicy = &policy
+	}
+}
+
+// WithNonConventionalPolicy sets how plan and version inference handle
+// commits that are not conventional commits.
+func WithNonConventionalPolicy(policy servicerelease.NonConventionalPolicy) AdapterOption {
+	return func(a *Adapter) {
+		a.nonConventionalPolicy = policy
+	}
+}
+
+// WithCommitParser sets the parser for the configured commit convention.
+func WithCommitParser(parser changes.CommitParser) AdapterOption {
+	return func(a *Adapter) {
+		a.commitParser = parser
+	}
+}
+
+// WithVersionFile sets the project version file that plan and version
+// inference read the current version from (versioning.bump_from: file or
+// package.json).
+func WithVersionFile(f appmonorepo.PackageVersionFile) AdapterOption {
+	return func(a *Adapter) {
+		a.versionFile = &f
+	}
+}
+
+// WithExcludeScopes sets the commit scopes omitted from generated notes
+// (changelog.exclude_scopes).
+func WithExcludeScopes(scopes []string) AdapterOption {
+	return func(a *Adapter) {
+		a.excludeScopes = scopes
+	}
+}
+
+// WithChangelogPreview sets the notes generator and options used to render
+// the changelog preview of a dry-run plan, so the preview matches the notes
+// a release would publish.
+func WithChangelogPreview(generator ports.NotesGenerator, options ports.NotesOptions) AdapterOption {
+	return func(a *Adapter) {
+		a.previewGenerator = generator
+		a.previewOptions = options
+	}
+}
+
+// fileCurrentVersion reads the current version from the project version
+// file. It returns nil when the current version comes from tags.
+func (a *Adapter) fileCurrentVersion(repoPath string) (*version.SemanticVersion, error) {
+	if a.versionFile == nil {
+		return nil, nil
+	}
+	ver, err := versioning.ReadProjectVersion(repoPath, *a.versionFile)
+	if err != nil {
+		return nil, err
+	}
+	return &ver, nil
+}
+
 // SetRepoRoot sets the repository root path dynamically.
 func (a *Adapter) SetRepoRoot(path string) {
 	a.repoRoot = path
@@ -112,13 +224,25 @@ func (a *Adapter) GetRepoRoot() string {
 	return a.repoRoot
 }
 
+// repoRootFor returns the repository root of the request: the root carried
+// by ctx, falling back to the adapter's configured root.
+func (a *Adapter) repoRootFor(ctx context.Context) string {
+	if root := repoRootFromContext(ctx); root != "" {
+		return root
+	}
+	return a.repoRoot
+}
+
 // PlanInput represents input for the Plan operation.
 type PlanInput struct {
 	RepositoryPath string
 	FromRef        string
 	ToRef          string
+	Since          time.Time // Drops commits older than this when non-zero
 	Analyze        bool
 	DryRun         bool
+	// NonConventionalPolicy overrides the adapter's policy when set.
+	NonConventionalPolicy servicerelease.NonConventionalPolicy
 }
 
 // CommitInfo represents a single commit's details.
@@ -141,6 +265,17 @@ type PlanOutput struct {
 	HasFeatures    bool
 	HasFixes       bool
 	Commits        []CommitInfo // Populated when analyze=true
+	Window         servicerelease.CommitWindow
+
+	// NonConventional reports the non-conventional commits and the policy
+	// applied to them.
+	NonConventional servicerelease.NonConventionalSummary
+
+	// BumpKind, Summary and Changelog preview the release and are populated
+	// when DryRun is set.
+	BumpKind  string
+	Summary   changes.ChangeSetSummary
+	Changelog string
 }
 
 // Plan executes the plan release use case via MCP.
@@ -153,14 +288,34 @@ func (a *Adapter) Plan(ctx context.Context, input PlanInput) (*PlanOutput, error
 	// Determine repository path
 	repoPath := input.RepositoryPath
 	if repoPath == "" {
-		repoPath = a.repoRoot
+		repoPath = a.repoRootFor(ctx)
+	}
+
+	if !input.DryRun {
+		if err := checkReleaseFreeze(repoPath); err != nil {
+			return nil, fmt.Errorf("plan failed: %w", err)
+		}
+	}
+
+	currentVersion, err := a.fileCurrentVersion(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("plan failed: %w", err)
+	}
+
+	policy := a.nonConventionalPolicy
+	if input.NonConventionalPolicy != "" {
+		policy = input.NonConventionalPolicy
 	}
 
 	// Step 1: Run analysis to get changeset and version info
 	analyzeInput := servicerelease.AnalyzeInput{
-		RepositoryPath: repoPath,
-		FromRef:        input.FromRef,
-		ToRef:          input.ToRef,
+		RepositoryPath:        repoPath,
+		FromRef:               input.FromRef,
+		ToRef:                 input.ToRef,
+		Since:                 input.Since,
+		CurrentVersion:        currentVersion,
+		NonConventionalPolicy: policy,
+		CommitParser:          a.commitParser,
 	}
 
 	output, err := a.releaseAnalyzer.Analyze(ctx, analyzeInput)
@@ -172,6 +327,9 @@ func (a *Adapter) Plan(ctx context.Context, input PlanInput) (*PlanOutput, error
 		CurrentVersion: output.CurrentVersion.String(),
 		NextVersion:    output.NextVersion.String(),
 		ReleaseType:    string(output.ReleaseType),
+		Window:         output.Window,
+
+		NonConventional: output.NonConventional,
 	}
 
 	if output.ChangeSet != nil {
@@ -181,6 +339,17 @@ func (a *Adapter) Plan(ctx context.Context, input PlanInput) (*PlanOutput, error
 		result.HasFeatures = len(cats.Features) > 0
 		result.HasFixes = len(cats.Fixes) > 0
 
+		if input.DryRun {
+			result.BumpKind = string(releaseTypeToBumpKind(output.ReleaseType))
+			result.Summary = output.ChangeSet.Summary()
+			if a.previewGenerator != nil {
+				result.Changelog, err = output.ChangelogPreview(ctx, a.previewGenerator, a.previewOptions)
+				if err != nil {
+					return nil, fmt.Errorf("plan failed: %w", err)
+				}
+			}
+		}
+
 		// Include commit details when analyze=true
 		if input.Analyze {
 			for _, c := range output.ChangeSet.Commits() {
@@ -203,6 +372,8 @@ func (a *Adapter) Plan(ctx context.Context, input PlanInput) (*PlanOutput, error
 		planInput := releaseapp.PlanReleaseInput{
 			RepoRoot:       repoPath,
 			RepoID:         repoPath, // Use path as ID if no remote
+			BaseRef:        output.ChangeSet.FromRef(),
+			Commits:        output.ReleaseCommits(),
 			ChangeSet:      output.ChangeSet,
 			CurrentVersion: &output.CurrentVersion,
 			NextVersion:    &output.NextVersion,
@@ -245,6 +416,7 @@ type BumpInput struct {
 	RepositoryPath string
 	BumpType       string // major, minor, patch, auto
 	Version        string // explicit version (overrides bump type)
+	AllowDowngrade bool   // accept a Version not greater than the current one
 	Prerelease     string
 	CreateTag      bool
 	DryRun         bool
@@ -270,7 +442,7 @@ func (a *Adapter) Bump(ctx context.Context, input BumpInput) (*BumpOutput, error
 	// Determine repository path
 	repoPath := input.RepositoryPath
 	if repoPath == "" {
-		repoPath = a.repoRoot
+		repoPath = a.repoRootFor(ctx)
 	}
 	if repoPath == "" {
 		repoPath = "."
@@ -283,7 +455,16 @@ func (a *Adapter) Bump(ctx context.Context, input BumpInput) (*BumpOutput, error
 			Type: "agent",
 			ID:   "mcp-agent",
 		},
-		Force: true, // MCP operations are already validated upstream
+		Force:          true, // MCP operations are already validated upstream
+		Prerelease:     version.Prerelease(input.Prerelease),
+		AllowDowngrade: input.AllowDowngrade,
+	}
+	if input.Version != "" {
+		ver, err := version.Parse(input.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", input.Version, err)
+		}
+		bumpInput.OverrideVersion = &ver
 	}
 
 	// Execute the use case
@@ -306,6 +487,10 @@ type NotesInput struct {
 	UseAI            bool
 	IncludeChangelog bool
 	RepositoryURL    string
+	Audience         string
+	Tone             string
+	Regenerate       bool
+	Text             string // Notes to use instead of generating them
 }
 
 // NotesOutput represents output from the Notes operation.
@@ -313,6 +498,9 @@ type NotesOutput struct {
 	Summary     string
 	Changelog   string
 	AIGenerated bool
+	Regenerated bool
+	Audience    string // audience preset the notes were generated for
+	Tone        string // tone preset the notes were generated with
 }
 
 // Notes executes the generate notes use case via MCP.
@@ -328,7 +516,7 @@ func (a *Adapter) Notes(ctx context.Context, input NotesInput) (*NotesOutput, er
 	}
 
 	// Determine repository path
-	repoPath := a.repoRoot
+	repoPath := a.repoRootFor(ctx)
 	if repoPath == "" {
 		repoPath = "."
 	}
@@ -337,14 +525,19 @@ func (a *Adapter) Notes(ctx context.Context, input NotesInput) (*NotesOutput, er
 	notesInput := releaseapp.GenerateNotesInput{
 		RepoRoot: repoPath,
 		Options: ports.NotesOptions{
-			UseAI:         input.UseAI,
-			RepositoryURL: input.RepositoryURL,
+			UseAI:          input.UseAI,
+			RepositoryURL:  input.RepositoryURL,
+			AudiencePreset: input.Audience,
+			TonePreset:     input.Tone,
+			ExcludeScopes:  a.excludeScopes,
 		},
 		Actor: ports.ActorInfo{
 			Type: "agent",
 			ID:   "mcp-agent",
 		},
-		Force: true, // Allow notes regeneration via MCP
+		Force:      true, // Allow notes regeneration via MCP
+		Regenerate: input.Regenerate,
+		Text:       input.Text,
 	}
 
 	// Set run ID if provided
@@ -360,11 +553,14 @@ func (a *Adapter) Notes(ctx context.Context, input NotesInput) (*NotesOutput, er
 
 	// Build output from domain notes
 	result := &NotesOutput{
-		AIGenerated: input.UseAI,
+		AIGenerated: input.UseAI && input.Text == "",
+		Regenerated: output.Regenerated,
 	}
 
 	if output.Notes != nil {
 		result.Summary = output.Notes.Text
+		result.Audience = output.Notes.AudiencePreset
+		result.Tone = output.Notes.TonePreset
 		// Changelog is same as notes text for now
 		if input.IncludeChangelog {
 			result.Changelog = output.Notes.Text
@@ -392,6 +588,8 @@ type EvaluateOutput struct {
 	RequiredActions []string
 	RiskFactors     []string
 	Rationale       []string
+	UnlinkedCommits []governance.UnlinkedCommit
+	Warnings        []string
 }
 
 // Evaluate executes the CGP evaluation via MCP.
@@ -433,11 +631,13 @@ func (a *Adapter) Evaluate(ctx context.Context, input EvaluateInput) (*EvaluateO
 	}
 
 	result := &EvaluateOutput{
-		Decision:       string(output.Decision),
-		RiskScore:      output.RiskScore,
-		Severity:       string(output.Severity),
-		CanAutoApprove: output.CanAutoApprove,
-		Rationale:      output.Rationale,
+		Decision:        string(output.Decision),
+		RiskScore:       output.RiskScore,
+		Severity:        string(output.Severity),
+		CanAutoApprove:  output.CanAutoApprove,
+		Rationale:       output.Rationale,
+		UnlinkedCommits: output.UnlinkedCommits,
+		Warnings:        output.Warnings,
 	}
 
 	for _, action := range output.RequiredActions {
@@ -445,7 +645,12 @@ func (a *Adapter) Evaluate(ctx context.Context, input EvaluateInput) (*EvaluateO
 	}
 
 	for _, factor := range output.RiskFactors {
-		result.RiskFactors = append(result.RiskFactors, fmt.Sprintf("%s: %.2f", factor.Category, factor.Score))
+		entry := fmt.Sprintf("%s: %.2f", factor.Category, factor.Score)
+		if factor.Category == risk.FactorVulnerableDependencies {
+			// Name the affected packages so they can be acted on.
+			entry += " (" + factor.Description + ")"
+		}
+		result.RiskFactors = append(result.RiskFactors, entry)
 	}
 
 	return result, nil
@@ -479,7 +684,7 @@ func (a *Adapter) Approve(ctx context.Context, input ApproveInput) (*ApproveOutp
 	}
 
 	// Determine repository path
-	repoPath := a.repoRoot
+	repoPath := a.repoRootFor(ctx)
 	if repoPath == "" {
 		repoPath = "."
 	}
@@ -498,6 +703,7 @@ func (a *Adapter) Approve(ctx context.Context, input ApproveInput) (*ApproveOutp
 		},
 		AutoApprove: input.AutoApprove,
 		Force:       true, // MCP approvals skip HEAD validation by default
+		ApprovalTTL: a.approvalTTL,
 	}
 
 	// Set run ID if provided
@@ -518,6 +724,93 @@ func (a *Adapter) Approve(ctx context.Context, input ApproveInput) (*ApproveOutp
 	}, nil
 }
 
+// ApproveLevelInput represents input for the ApproveLevel operation.
+type ApproveLevelInput struct {
+	ReleaseID     string
+	Level         string
+	ApprovedBy    string
+	Justification string
+}
+
+// ApproveLevelOutput represents output from the ApproveLevel operation.
+type ApproveLevelOutput struct {
+	Level         string
+	ApprovedBy    string
+	Granted       []string
+	Pending       []string
+	NextLevel     string
+	FullyApproved bool
+	Version       string
+}
+
+// ApproveLevel grants one level of a multi-level approval via MCP. The
+// release is approved once all required levels are granted.
+func (a *Adapter) ApproveLevel(ctx context.Context, input ApproveLevelInput) (*ApproveLevelOutput, error) {
+	if a.releaseServices == nil {
+		return nil, fmt.Errorf("release services not configured")
+	}
+
+	if a.releaseServices.ApproveRelease == nil {
+		return nil, fmt.Errorf("approve release use case not configured")
+	}
+
+	level, err := releasedomain.ParseApprovalLevel(input.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine repository path
+	repoPath := a.repoRootFor(ctx)
+	if repoPath == "" {
+		repoPath = "."
+	}
+
+	approver := input.ApprovedBy
+	if approver == "" {
+		approver = "mcp-agent"
+	}
+
+	levelInput := releaseapp.ApproveLevelInput{
+		RepoRoot: repoPath,
+		Actor: ports.ActorInfo{
+			Type: releasedomain.ActorAgent,
+			ID:   approver,
+		},
+		Level:         level,
+		Justification: input.Justification,
+		Force:         true, // MCP approvals skip HEAD validation by default
+		ApprovalTTL:   a.approvalTTL,
+		Policy:        a.approvalPolicy,
+	}
+
+	// Set run ID if provided
+	if input.ReleaseID != "" {
+		levelInput.RunID = releasedomain.RunID(input.ReleaseID)
+	}
+
+	output, err := a.releaseServices.ApproveRelease.ApproveLevel(ctx, levelInput)
+	if err != nil {
+		return nil, fmt.Errorf("approve failed: %w", err)
+	}
+
+	result := &ApproveLevelOutput{
+		Level:         string(output.Level),
+		ApprovedBy:    approver,
+		Granted:       []string{},
+		Pending:       []string{},
+		NextLevel:     string(output.NextLevel),
+		FullyApproved: output.FullyApproved,
+		Version:       output.VersionNext,
+	}
+	for _, l := range output.Granted {
+		result.Granted = append(result.Granted, string(l))
+	}
+	for _, req := range output.Pending {
+		result.Pending = append(result.Pending, string(req.Level))
+	}
+	return result, nil
+}
+
 // PublishInput represents input for the Publish operation.
 type PublishInput struct {
 	ReleaseID string
@@ -526,13 +819,19 @@ type PublishInput struct {
 	PushTag   bool
 	TagPrefix string
 	Remote    string
+	Only      []string // Restricts publishing to these plugins or steps
 }
 
 // PublishOutput represents output from the Publish operation.
 type PublishOutput struct {
-	TagName       string
-	ReleaseURL    string
-	PluginResults []PluginResultInfo
+	TagName         string
+	ReleaseURL      string
+	Published       bool
+	PluginResults   []PluginResultInfo
+	OnPluginFailure string
+	PluginFailures  int
+	Executed        []string // Steps run by this publish
+	Skipped         []string // Steps skipped because they were already done
 }
 
 // PluginResultInfo represents plugin execution result.
@@ -556,11 +855,17 @@ func (a *Adapter) Publish(ctx context.Context, input PublishInput) (*PublishOutp
 	}
 
 	// Determine repository path
-	repoPath := a.repoRoot
+	repoPath := a.repoRootFor(ctx)
 	if repoPath == "" {
 		repoPath = "."
 	}
 
+	if !input.DryRun {
+		if err := checkReleaseFreeze(repoPath); err != nil {
+			return nil, fmt.Errorf("publish failed: %w", err)
+		}
+	}
+
 	// Build the use case input
 	publishInput := releaseapp.PublishReleaseInput{
 		RepoRoot: repoPath,
@@ -568,8 +873,10 @@ func (a *Adapter) Publish(ctx context.Context, input PublishInput) (*PublishOutp
 			Type: "agent",
 			ID:   "mcp-agent",
 		},
-		Force:  true, // MCP publishes skip HEAD validation by default
-		DryRun: input.DryRun,
+		Force:           true, // MCP publishes skip HEAD validation by default
+		DryRun:          input.DryRun,
+		OnPluginFailure: a.onPluginFailure,
+		Only:            input.Only,
 	}
 
 	// Set run ID if provided
@@ -584,7 +891,11 @@ func (a *Adapter) Publish(ctx context.Context, input PublishInput) (*PublishOutp
 	}
 
 	// Build result with plugin results
-	result := &PublishOutput{}
+	result := &PublishOutput{
+		Published:       output.Published,
+		OnPluginFailure: string(output.OnPluginFailure),
+		PluginFailures:  len(output.PluginFailures),
+	}
 
 	// Get tag name from the release
 	if a.releaseRepo != nil && input.ReleaseID != "" {
@@ -602,6 +913,11 @@ func (a *Adapter) Publish(ctx context.Context, input PublishInput) (*PublishOutp
 			Success:    step.Success,
 			Message:    step.Output,
 		})
+		if step.Skipped {
+			result.Skipped = append(result.Skipped, step.StepName)
+		} else {
+			result.Executed = append(result.Executed, step.StepName)
+		}
 	}
 
 	return result, nil
@@ -698,8 +1014,24 @@ type GetStatusOutput struct {
 	CanApprove  bool
 	ApprovalMsg string
 	NextAction  string // Suggested next step in the workflow
-	Stale       bool   // True if release may be stale (old and not terminal)
+	NextStep    string // Human-readable description of the next step
+	NextCommand string // CLI command for the next step
+	NextTool    string // MCP tool for the next step
+	Stale       bool   // True if release may be stale (old and not finished)
 	Warning     string // Warning message if any
+
+	Rejection *releasedomain.Rejection // Most recent rejection, if any
+
+	// Approval progress. Multi-level approval policies report their granted
+	// and pending levels; single-level approval reports whether the risk
+	// score allows auto-approval.
+	ApprovalMode          string // "single" or "multi_level"
+	GrantedApprovalLevels []string
+	PendingApprovalLevels []string
+	NextApprovalLevel     string // Next level of a sequential policy
+	CanAutoApprove        bool
+	RiskScore             float64
+	AutoApproveThreshold  float64
 }
 
 // GetStatus retrieves the current release status.
@@ -715,7 +1047,7 @@ func (a *Adapter) GetStatus(ctx context.Context) (*GetStatusOutput, error) {
 	}
 
 	// Determine repository path
-	repoPath := a.repoRoot
+	repoPath := a.repoRootFor(ctx)
 	if repoPath == "" {
 		repoPath = "."
 	}
@@ -733,14 +1065,18 @@ func (a *Adapter) GetStatus(ctx context.Context) (*GetStatusOutput, error) {
 
 	// Build result
 	result := &GetStatusOutput{
-		ReleaseID:  string(output.RunID),
-		State:      output.State.String(),
-		CreatedAt:  output.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:  output.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		NextAction: output.NextAction,
-		Stale:      output.Stale,
-		Warning:    output.Warning,
-		CanApprove: output.CanApprove,
+		ReleaseID:   string(output.RunID),
+		State:       output.State.String(),
+		CreatedAt:   output.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   output.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		NextAction:  output.NextAction,
+		NextStep:    output.NextStep,
+		NextCommand: output.NextCommand,
+		NextTool:    toolForCommand(output.NextCommand),
+		Stale:       output.Stale,
+		Warning:     output.Warning,
+		CanApprove:  output.CanApprove,
+		Rejection:   output.Rejection,
 	}
 
 	// Set version
@@ -748,9 +1084,86 @@ func (a *Adapter) GetStatus(ctx context.Context) (*GetStatusOutput, error) {
 		result.Version = output.VersionNext
 	}
 
+	// The configured policy is recorded on the run when its first level is
+	// granted; until then it applies to runs that are not approved yet.
+	ml := output.MultiLevelApproval
+	if ml == nil && a.approvalPolicy != nil && awaitsApproval(output.State) {
+		ml = releasedomain.NewMultiLevelApproval(*a.approvalPolicy)
+	}
+	if ml != nil {
+		result.ApprovalMode = "multi_level"
+		result.GrantedApprovalLevels, result.PendingApprovalLevels = approvalLevels(ml)
+		if ml.Policy.Sequential {
+			if next := ml.NextRequiredLevel(); next != nil {
+				result.NextApprovalLevel = string(next.Level)
+			}
+		}
+	} else {
+		result.ApprovalMode = "single"
+		result.CanAutoApprove = output.CanAutoApprove
+		result.RiskScore = output.RiskScore
+		result.AutoApproveThreshold = output.AutoApproveThreshold
+	}
+
 	return result, nil
 }
 
+// awaitsApproval reports whether a run in state has not been approved yet.
+func awaitsApproval(state releasedomain.RunState) bool {
+	switch state {
+	case releasedomain.StateApproved, releasedomain.StatePublishing:
+		return false
+	}
+	return !state.IsFinished()
+}
+
+// approvalLevels returns the levels of a multi-level approval policy that
+// have been granted and the required levels still pending, in policy order.
+func approvalLevels(ml *releasedomain.MultiLevelApproval) (granted, pending []string) {
+	granted, pending = []string{}, []string{}
+	for _, req := range ml.Policy.Requirements {
+		switch {
+		case ml.GetApproval(req.Level) != nil:
+			granted = append(granted, string(req.Level))
+		case req.Required:
+			pending = append(pending, string(req.Level))
+		}
+	}
+	return granted, pending
+}
+
+// LatestRun loads the latest release run of the request's repository.
+func (a *Adapter) LatestRun(ctx context.Context) (*releasedomain.ReleaseRun, error) {
+	if a.releaseServices == nil || a.releaseServices.Repository == nil {
+		return nil, fmt.Errorf("release services not configured")
+	}
+
+	repoPath := a.repoRootFor(ctx)
+	if repoPath == "" {
+		repoPath = "."
+	}
+	return a.releaseServices.Repository.LoadLatest(ctx, repoPath)
+}
+
+// ReleaseFreeze returns the active release freeze of the request's
+// repository, or nil if releases are not frozen.
+func (a *Adapter) ReleaseFreeze(ctx context.Context) (*persistence.ReleaseFreeze, error) {
+	return persistence.NewFreezeStore(a.repoRootFor(ctx)).Load()
+}
+
+// checkReleaseFreeze returns an error if releases are frozen. Unlike the CLI,
+// MCP offers no override: only a maintainer can lift the freeze.
+func checkReleaseFreeze(repoPath string) error {
+	freeze, err := persistence.NewFreezeStore(repoPath).Load()
+	if err != nil {
+		return err
+	}
+	if freeze != nil {
+		return fmt.Errorf("%s; a maintainer must lift it with 'relicta freeze off'", freeze.Summary())
+	}
+	return nil
+}
+
 // nextActionForState returns the suggested next action based on release state.
 func nextActionForState(state string) string {
 	switch state {
@@ -777,6 +1190,15 @@ func nextActionForState(state string) string {
 	}
 }
 
+// toolForCommand returns the MCP tool equivalent of a relicta CLI command.
+func toolForCommand(command string) string {
+	fields := strings.Fields(command)
+	if len(fields) < 2 || fields[0] != "relicta" {
+		return ""
+	}
+	return "relicta." + fields[1]
+}
+
 // HasReleaseAnalyzer returns true if the release analyzer is configured.
 func (a *Adapter) HasReleaseAnalyzer() bool {
 	return a.releaseAnalyzer != nil
@@ -976,9 +1398,17 @@ func (a *Adapter) InferVersion(ctx context.Context, input InferVersionInput) (*I
 		return nil, fmt.Errorf("release analyzer not configured")
 	}
 
+	currentVersion, err := a.fileCurrentVersion(a.repoRootFor(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("version inference failed: %w", err)
+	}
+
 	analyzeInput := servicerelease.AnalyzeInput{
-		FromRef: input.FromRef,
-		ToRef:   input.ToRef,
+		FromRef:               input.FromRef,
+		ToRef:                 input.ToRef,
+		CurrentVersion:        currentVersion,
+		NonConventionalPolicy: a.nonConventionalPolicy,
+		CommitParser:          a.commitParser,
 	}
 
 	result, err := a.releaseAnalyzer.Analyze(ctx, analyzeInput)
@@ -1056,8 +1486,9 @@ func (a *Adapter) SummarizeDiff(ctx context.Context, input SummarizeDiffInput) (
 
 	// Get change analysis
 	analyzeInput := servicerelease.AnalyzeInput{
-		FromRef: input.FromRef,
-		ToRef:   input.ToRef,
+		FromRef:      input.FromRef,
+		ToRef:        input.ToRef,
+		CommitParser: a.commitParser,
 	}
 
 	result, err := a.releaseAnalyzer.Analyze(ctx, analyzeInput)
@@ -1200,6 +1631,29 @@ func (a *Adapter) ValidateRelease(ctx context.Context, input ValidateReleaseInpu
 		}
 	}
 
+	// Release freeze check
+	if freeze, err := a.ReleaseFreeze(ctx); err != nil {
+		output.Checks = append(output.Checks, ValidationCheckResult{
+			Name:    "release_freeze",
+			Status:  "warning",
+			Message: err.Error(),
+		})
+		output.Warnings = append(output.Warnings, err.Error())
+	} else if freeze != nil {
+		output.Checks = append(output.Checks, ValidationCheckResult{
+			Name:    "release_freeze",
+			Status:  "failed",
+			Message: freeze.Summary(),
+		})
+		output.BlockingIssues = append(output.BlockingIssues, "Releases are frozen: "+freeze.Reason)
+	} else {
+		output.Checks = append(output.Checks, ValidationCheckResult{
+			Name:    "release_freeze",
+			Status:  "passed",
+			Message: "Releases are not frozen",
+		})
+	}
+
 	// Governance checks
 	if input.CheckGovernance && a.governanceSvc != nil {
 		output.Checks = append(output.Checks, ValidationCheckResult{
@@ -3,15 +3,20 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/relicta-tech/relicta/internal/application/governance"
+	appmonorepo "github.com/relicta-tech/relicta/internal/application/monorepo"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
 	domainrelease "github.com/relicta-tech/relicta/internal/domain/release"
 	"github.com/relicta-tech/relicta/internal/domain/version"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
 )
 
 func TestNewAdapter(t *testing.T) {
@@ -45,6 +50,36 @@ func TestAdapterOptions(t *testing.T) {
 		adapter := NewAdapter(WithAdapterRepo(nil))
 		assert.False(t, adapter.HasReleaseRepository())
 	})
+
+	t.Run("WithExcludeScopes sets the scopes omitted from notes", func(t *testing.T) {
+		adapter := NewAdapter(WithExcludeScopes([]string{"deps", "i18n-*"}))
+		assert.Equal(t, []string{"deps", "i18n-*"}, adapter.excludeScopes)
+	})
+}
+
+func TestAdapterFileCurrentVersion(t *testing.T) {
+	t.Run("tags are the version source", func(t *testing.T) {
+		ver, err := NewAdapter().fileCurrentVersion(t.TempDir())
+		require.NoError(t, err)
+		assert.Nil(t, ver)
+	})
+
+	t.Run("reads the project version file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"version":"2.3.4"}`), 0o644))
+		adapter := NewAdapter(WithVersionFile(appmonorepo.PackageVersionFile{File: "package.json", Field: "version"}))
+
+		ver, err := adapter.fileCurrentVersion(dir)
+		require.NoError(t, err)
+		require.NotNil(t, ver)
+		assert.Equal(t, "2.3.4", ver.String())
+	})
+
+	t.Run("missing version file fails", func(t *testing.T) {
+		adapter := NewAdapter(WithVersionFile(appmonorepo.PackageVersionFile{File: "VERSION", Pattern: `(\d+\.\d+\.\d+)`}))
+		_, err := adapter.fileCurrentVersion(t.TempDir())
+		assert.Error(t, err)
+	})
 }
 
 func TestAdapterPlanWithoutUseCase(t *testing.T) {
@@ -125,6 +160,18 @@ func TestAdapterApproveWithoutUseCase(t *testing.T) {
 	assert.Contains(t, err.Error(), "release services not configured")
 }
 
+func TestAdapterApproveLevelWithoutUseCase(t *testing.T) {
+	adapter := NewAdapter()
+
+	output, err := adapter.ApproveLevel(context.Background(), ApproveLevelInput{
+		ReleaseID: "test-release-id",
+		Level:     "security",
+	})
+	require.Error(t, err)
+	assert.Nil(t, output)
+	assert.Contains(t, err.Error(), "release services not configured")
+}
+
 func TestAdapterPublishWithoutUseCase(t *testing.T) {
 	adapter := NewAdapter()
 
@@ -155,6 +202,7 @@ func TestPlanInputFields(t *testing.T) {
 		RepositoryPath: "/path/to/repo",
 		FromRef:        "v1.0.0",
 		ToRef:          "HEAD",
+		Since:          time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
 		Analyze:        true,
 		DryRun:         true,
 	}
@@ -162,6 +210,7 @@ func TestPlanInputFields(t *testing.T) {
 	assert.Equal(t, "/path/to/repo", input.RepositoryPath)
 	assert.Equal(t, "v1.0.0", input.FromRef)
 	assert.Equal(t, "HEAD", input.ToRef)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), input.Since)
 	assert.True(t, input.Analyze)
 	assert.True(t, input.DryRun)
 }
@@ -991,6 +1040,28 @@ func TestAdapterValidateReleaseNotFound(t *testing.T) {
 	assert.Contains(t, output.BlockingIssues, "Release not found")
 }
 
+func TestAdapterValidateReleaseFrozen(t *testing.T) {
+	repoRoot := t.TempDir()
+	_, err := persistence.NewFreezeStore(repoRoot).Freeze("incident INC-1234", "alice")
+	require.NoError(t, err)
+
+	adapter := NewAdapter()
+	adapter.SetRepoRoot(repoRoot)
+
+	output, err := adapter.ValidateRelease(context.Background(), ValidateReleaseInput{})
+	require.NoError(t, err)
+	assert.False(t, output.Valid)
+	assert.False(t, output.CanProceed)
+	assert.Contains(t, output.BlockingIssues, "Releases are frozen: incident INC-1234")
+
+	err = checkReleaseFreeze(repoRoot)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "relicta freeze off")
+	remediation, ok := explainError("", err.Error())
+	assert.True(t, ok)
+	assert.Equal(t, "RELEASE_FROZEN", remediation.Code)
+}
+
 func TestAdapterValidateReleaseWithGovernance(t *testing.T) {
 	govSvc := &governance.Service{}
 	adapter := NewAdapter(WithGovernanceService(govSvc))
@@ -49,6 +49,9 @@ func NewResourceCache() *ResourceCache {
 			"relicta://commits":     CommitsTTL,
 			"relicta://changelog":   ChangelogTTL,
 			"relicta://risk-report": RiskReportTTL,
+			"relicta://approvals":   StateTTL,
+			"relicta://metrics":     RiskReportTTL,
+			"relicta://plan":        StateTTL,
 		},
 		enabled: true,
 	}
@@ -124,6 +127,9 @@ func (c *ResourceCache) InvalidateStateDependent() {
 		"relicta://commits",
 		"relicta://changelog",
 		"relicta://risk-report",
+		"relicta://approvals",
+		"relicta://metrics",
+		"relicta://plan",
 	}
 
 	for _, uri := range stateDependent {
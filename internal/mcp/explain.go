@@ -0,0 +1,267 @@
+package mcp
+
+import (
+	"strings"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+// errorRemediation describes a known error and how to recover from it.
+type errorRemediation struct {
+	Code        string
+	Title       string
+	Explanation string
+	NextAction  string
+	Command     string // CLI command to run next
+	Tool        string // MCP tool to call next
+	Retryable   bool   // whether retrying the failed call can succeed once NextAction is done
+
+	// patterns are lowercase message fragments that identify the error.
+	patterns []string
+}
+
+// errorRemediations maps the release domain error taxonomy to recovery
+// guidance. More specific entries come first, since a message is matched
+// against the patterns in order.
+var errorRemediations = []errorRemediation{
+	{
+		Code:        "HEAD_CHANGED",
+		Title:       "Repository HEAD changed since planning",
+		Explanation: "New commits were added after the release was planned, so the plan no longer describes what would be released.",
+		NextAction:  "Plan the release again so it includes the new commits, then bump, generate notes and approve.",
+		Command:     "relicta plan",
+		Tool:        "relicta.plan",
+		Retryable:   true,
+		patterns:    []string{release.ErrHeadSHAChanged.Error()},
+	},
+	{
+		Code:        "APPROVAL_HASH_MISMATCH",
+		Title:       "Approval is bound to a different plan",
+		Explanation: "The release was approved, but the plan changed afterwards. An approval only covers the exact plan it was given for.",
+		NextAction:  "Review the current plan and approve it again.",
+		Command:     "relicta approve",
+		Tool:        "relicta.approve",
+		Retryable:   true,
+		patterns:    []string{release.ErrApprovalBoundToHash.Error()},
+	},
+	{
+		Code:        "PLAN_HASH_MISMATCH",
+		Title:       "Plan does not match the approved plan",
+		Explanation: "The release plan was modified after approval, so the approval no longer applies.",
+		NextAction:  "Review the current plan and approve it again.",
+		Command:     "relicta approve",
+		Tool:        "relicta.approve",
+		Retryable:   true,
+		patterns:    []string{release.ErrPlanHashMismatch.Error()},
+	},
+	{
+		Code:        "APPROVAL_EXPIRED",
+		Title:       "Approval has expired",
+		Explanation: "The approval is older than governance.approval_ttl and can no longer be used to start a publish.",
+		NextAction:  "Approve the release again, then publish.",
+		Command:     "relicta approve",
+		Tool:        "relicta.approve",
+		Retryable:   true,
+		patterns:    []string{release.ErrApprovalExpired.Error(), "approval expired"},
+	},
+	{
+		Code:        "NOT_APPROVED",
+		Title:       "Release is not approved",
+		Explanation: "Publishing requires an approval of the current plan.",
+		NextAction:  "Approve the release, then publish.",
+		Command:     "relicta approve",
+		Tool:        "relicta.approve",
+		Retryable:   true,
+		patterns:    []string{release.ErrNotApproved.Error(), "release not approved"},
+	},
+	{
+		Code:        "PRE_PUBLISH_REJECTED",
+		Title:       "A plugin rejected the release before publishing",
+		Explanation: "A pre-publish plugin check failed. No tag was created and the release is still approved.",
+		NextAction:  "Resolve the problem reported by the plugin, then publish again.",
+		Command:     "relicta publish",
+		Tool:        "relicta.publish",
+		Retryable:   true,
+		patterns:    []string{release.ErrPrePublishRejected.Error(), "pre-publish rejected"},
+	},
+	{
+		Code:        "ROLLBACK_REQUIRES_FORCE",
+		Title:       "Rolling back a published release requires force",
+		Explanation: "The release is already published; consumers may have fetched the tag or artifacts built from it.",
+		NextAction:  "Confirm the rollback is intended and run it with --force.",
+		Command:     "relicta rollback --force",
+		Retryable:   true,
+		patterns:    []string{releaseapp.ErrRollbackRequiresForce.Error()},
+	},
+	{
+		Code:        "VERSION_NOT_INCREASING",
+		Title:       "Requested version is not greater than the current version",
+		Explanation: "Tagging it would create a release that sorts before (or alongside) an existing one. Prereleases sort before their release, so 1.0.0-rc.1 is lower than 1.0.0.",
+		NextAction:  "Request a version greater than the current one, or allow the downgrade if you are correcting a mistaken release.",
+		Command:     "relicta bump --version <version> --allow-downgrade",
+		Tool:        "relicta.bump",
+		Retryable:   true,
+		patterns:    []string{releaseapp.ErrVersionNotIncreasing.Error()},
+	},
+	{
+		Code:        "ALREADY_PUBLISHED",
+		Title:       "Release is already published",
+		Explanation: "This release run has completed; there is nothing left to publish.",
+		NextAction:  "Start a new release for further changes.",
+		Command:     "relicta plan",
+		Tool:        "relicta.plan",
+		patterns:    []string{release.ErrAlreadyPublished.Error()},
+	},
+	{
+		Code:        "DUPLICATE_RUN",
+		Title:       "A release run for this plan already exists",
+		Explanation: "The same commits were already planned in another release run.",
+		NextAction:  "Continue the existing release run instead of planning a new one.",
+		Command:     "relicta status",
+		Tool:        "relicta.status",
+		patterns:    []string{release.ErrDuplicateRun.Error()},
+	},
+	{
+		Code:        "RUN_NOT_FOUND",
+		Title:       "Release run not found",
+		Explanation: "There is no release run with the given ID, or no release has been planned yet.",
+		NextAction:  "Plan a new release.",
+		Command:     "relicta plan",
+		Tool:        "relicta.plan",
+		patterns:    []string{release.ErrRunNotFound.Error(), "no active release"},
+	},
+	{
+		Code:        "NO_CHANGES",
+		Title:       "No changes to release",
+		Explanation: "No commits were found since the last release.",
+		NextAction:  "Commit changes before planning a release.",
+		Command:     "relicta plan",
+		Tool:        "relicta.plan",
+		patterns:    []string{release.ErrNoChanges.Error()},
+	},
+	{
+		Code:        "VERSION_NOT_SET",
+		Title:       "Version has not been set",
+		Explanation: "The operation needs the next version, which is set by the bump step.",
+		NextAction:  "Bump the version first.",
+		Command:     "relicta bump",
+		Tool:        "relicta.bump",
+		Retryable:   true,
+		patterns:    []string{release.ErrVersionNotSet.Error()},
+	},
+	{
+		Code:        "NOTES_MISSING",
+		Title:       "Release notes are missing",
+		Explanation: "The operation needs release notes, which have not been generated.",
+		NextAction:  "Generate release notes first.",
+		Command:     "relicta notes",
+		Tool:        "relicta.notes",
+		Retryable:   true,
+		patterns:    []string{release.ErrNilNotes.Error()},
+	},
+	{
+		Code:        "RISK_TOO_HIGH",
+		Title:       "Risk score exceeds the threshold",
+		Explanation: "The release is too risky to proceed automatically under the configured governance policy.",
+		NextAction:  "Review the risk assessment and have a human approve the release.",
+		Command:     "relicta evaluate",
+		Tool:        "relicta.evaluate",
+		patterns:    []string{release.ErrRiskTooHigh.Error()},
+	},
+	{
+		Code:        "CANNOT_RETRY",
+		Title:       "Release cannot be retried",
+		Explanation: "Only failed releases can be retried.",
+		NextAction:  "Check the release state; reset it to start over if needed.",
+		Command:     "relicta status",
+		Tool:        "relicta.status",
+		patterns:    []string{release.ErrCannotRetry.Error()},
+	},
+	{
+		Code:        "CANNOT_CANCEL",
+		Title:       "Release cannot be canceled",
+		Explanation: "The release is in a state that cannot be canceled, such as published.",
+		NextAction:  "Check the release state.",
+		Command:     "relicta status",
+		Tool:        "relicta.status",
+		patterns:    []string{release.ErrCannotCancel.Error()},
+	},
+	{
+		Code:        "RELEASE_FROZEN",
+		Title:       "Releases are frozen",
+		Explanation: "A maintainer froze releases with 'relicta freeze on'. Planning and publishing are blocked until the freeze is lifted.",
+		NextAction:  "Ask a maintainer to lift the freeze once the reason no longer applies.",
+		Command:     "relicta freeze off",
+		patterns:    []string{"releases are frozen"},
+	},
+	{
+		Code:        "LOCK_HELD",
+		Title:       "Release is locked by another process",
+		Explanation: "Another relicta process (a CLI command or MCP tool call) is changing the release state of this repository.",
+		NextAction:  "Wait for the other process to finish, then retry.",
+		Command:     "relicta status",
+		Tool:        "relicta.status",
+		Retryable:   true,
+		patterns:    []string{"lock acquired by another process", persistence.ErrStateLocked.Error()},
+	},
+	{
+		Code:        "INVALID_STATE",
+		Title:       "Operation not allowed in the current state",
+		Explanation: "The release run is not in a state that allows this operation.",
+		NextAction:  "Check the release state and run the step it recommends next.",
+		Command:     "relicta status",
+		Tool:        "relicta.status",
+		patterns:    []string{release.ErrInvalidState.Error(), "release run is in", "cannot publish from state"},
+	},
+}
+
+// unknownErrorRemediation is returned when no known error matches.
+var unknownErrorRemediation = errorRemediation{
+	Code:        "UNKNOWN",
+	Title:       "Unrecognized error",
+	Explanation: "The error does not match a known Relicta error.",
+	NextAction:  "Check the release state and the full error message.",
+	Command:     "relicta status",
+	Tool:        "relicta.status",
+}
+
+// explainError finds the remediation for an error code or message. The code
+// takes precedence; the message is matched against known error messages.
+func explainError(code, message string) (errorRemediation, bool) {
+	if code = normalizeErrorCode(code); code != "" {
+		for _, r := range errorRemediations {
+			if r.Code == code {
+				return r, true
+			}
+		}
+	}
+
+	if message = strings.ToLower(message); message != "" {
+		for _, r := range errorRemediations {
+			for _, p := range r.patterns {
+				if strings.Contains(message, strings.ToLower(p)) {
+					return r, true
+				}
+			}
+		}
+	}
+
+	return unknownErrorRemediation, false
+}
+
+// normalizeErrorCode accepts codes such as "head-changed" or "Head Changed".
+func normalizeErrorCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "_", " ", "_").Replace(code)
+}
+
+// knownErrorCodes lists the codes explainError recognizes.
+func knownErrorCodes() []string {
+	codes := make([]string, 0, len(errorRemediations))
+	for _, r := range errorRemediations {
+		codes = append(codes, r.Code)
+	}
+	return codes
+}
@@ -0,0 +1,133 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+func TestExplainError_Messages(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+		wantTool string
+	}{
+		{
+			name:     "head changed",
+			err:      fmt.Errorf("publish failed: %w (use --force to override)", release.ErrHeadSHAChanged),
+			wantCode: "HEAD_CHANGED",
+			wantTool: "relicta.plan",
+		},
+		{
+			name:     "approval bound to other plan",
+			err:      fmt.Errorf("approval validation failed: %w", release.ErrApprovalBoundToHash),
+			wantCode: "APPROVAL_HASH_MISMATCH",
+			wantTool: "relicta.approve",
+		},
+		{
+			name:     "plan hash mismatch",
+			err:      release.ErrPlanHashMismatch,
+			wantCode: "PLAN_HASH_MISMATCH",
+			wantTool: "relicta.approve",
+		},
+		{
+			name:     "approval expired",
+			err:      fmt.Errorf("approval validation failed: %w", release.ErrApprovalExpired),
+			wantCode: "APPROVAL_EXPIRED",
+			wantTool: "relicta.approve",
+		},
+		{
+			name:     "pre-publish rejected",
+			err:      fmt.Errorf("%w: npm: version exists", release.ErrPrePublishRejected),
+			wantCode: "PRE_PUBLISH_REJECTED",
+			wantTool: "relicta.publish",
+		},
+		{
+			name:     "rollback requires force",
+			err:      releaseapp.ErrRollbackRequiresForce,
+			wantCode: "ROLLBACK_REQUIRES_FORCE",
+		},
+		{
+			name:     "version not increasing",
+			err:      fmt.Errorf("bump version failed: %w: 1.0.0-rc.1 is not greater than 1.0.0", releaseapp.ErrVersionNotIncreasing),
+			wantCode: "VERSION_NOT_INCREASING",
+			wantTool: "relicta.bump",
+		},
+		{
+			name:     "state locked",
+			err:      &persistence.StateLockedError{Path: ".relicta/lock", Holder: persistence.StateLockHolder{PID: 42, Command: "relicta bump"}},
+			wantCode: "LOCK_HELD",
+			wantTool: "relicta.status",
+		},
+		{
+			name:     "state transition",
+			err:      fmt.Errorf("cannot bump: release run is in 'published' state"),
+			wantCode: "INVALID_STATE",
+			wantTool: "relicta.status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, matched := explainError("", tt.err.Error())
+			require.True(t, matched)
+			assert.Equal(t, tt.wantCode, r.Code)
+			assert.Equal(t, tt.wantTool, r.Tool)
+			assert.NotEmpty(t, r.NextAction)
+			assert.NotEmpty(t, r.Command)
+		})
+	}
+}
+
+func TestExplainError_Codes(t *testing.T) {
+	for _, code := range knownErrorCodes() {
+		r, matched := explainError(code, "")
+		assert.True(t, matched, code)
+		assert.Equal(t, code, r.Code)
+	}
+
+	r, matched := explainError("head-changed", "release not approved")
+	assert.True(t, matched)
+	assert.Equal(t, "HEAD_CHANGED", r.Code, "code takes precedence over message")
+}
+
+func TestExplainError_Unknown(t *testing.T) {
+	r, matched := explainError("NOPE", "something odd happened")
+	assert.False(t, matched)
+	assert.Equal(t, "UNKNOWN", r.Code)
+	assert.Equal(t, "relicta status", r.Command)
+}
+
+func TestHandleExplainError(t *testing.T) {
+	server, err := NewServer("1.0.0")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result, err := server.handleExplainError(ctx, ExplainErrorToolInput{
+		Message: "repository HEAD has changed since planning",
+	})
+	require.NoError(t, err)
+	out := parseJSONResult(t, result)
+	assert.Equal(t, "HEAD_CHANGED", out["code"])
+	assert.Equal(t, true, out["matched"])
+	assert.Equal(t, "relicta plan", out["command"])
+	assert.Equal(t, "relicta.plan", out["tool"])
+	assert.Equal(t, true, out["retryable"])
+
+	result, err = server.handleExplainError(ctx, ExplainErrorToolInput{Message: "boom"})
+	require.NoError(t, err)
+	out = parseJSONResult(t, result)
+	assert.Equal(t, false, out["matched"])
+	assert.NotEmpty(t, out["known_codes"])
+
+	_, err = server.handleExplainError(ctx, ExplainErrorToolInput{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,249 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/felixgeelhaar/mcp-go"
+	"github.com/felixgeelhaar/mcp-go/protocol"
+	"github.com/felixgeelhaar/mcp-go/server"
+	"github.com/felixgeelhaar/mcp-go/transport"
+)
+
+// requestHandler dispatches MCP requests to the tools, resources and prompts
+// registered on an mcp-go server. mcp-go only builds its own handler inside
+// its serve functions, which own the process stdio, so the stdio transport
+// is served with this handler instead. Responses have the same shape as
+// those of mcp-go.
+type requestHandler struct {
+	srv *mcp.Server
+}
+
+// newRequestHandler returns a request handler for srv.
+func newRequestHandler(srv *mcp.Server) *requestHandler {
+	return &requestHandler{srv: srv}
+}
+
+// HandleRequest implements transport.Handler.
+func (h *requestHandler) HandleRequest(ctx context.Context, req *protocol.Request) (*protocol.Response, error) {
+	switch req.Method {
+	case protocol.MethodInitialize:
+		return h.handleInitialize(req)
+	case protocol.MethodToolsList:
+		return h.handleToolsList(req)
+	case protocol.MethodToolsCall:
+		return h.handleToolsCall(ctx, req)
+	case protocol.MethodResourcesList:
+		return h.handleResourcesList(req)
+	case protocol.MethodResourcesRead:
+		return h.handleResourcesRead(ctx, req)
+	case protocol.MethodPromptsList:
+		return h.handlePromptsList(req)
+	case protocol.MethodPromptsGet:
+		return h.handlePromptsGet(ctx, req)
+	case protocol.MethodPing:
+		return protocol.NewResponse(req.ID, map[string]any{}), nil
+	default:
+		return nil, protocol.NewMethodNotFound(req.Method)
+	}
+}
+
+func (h *requestHandler) handleInitialize(req *protocol.Request) (*protocol.Response, error) {
+	manifest := h.srv.Manifest()
+
+	capabilities := make(map[string]any)
+	if manifest.Capabilities.Tools {
+		capabilities["tools"] = map[string]any{}
+	}
+	if manifest.Capabilities.Resources {
+		capabilities["resources"] = map[string]any{}
+	}
+	if manifest.Capabilities.Prompts {
+		capabilities["prompts"] = map[string]any{}
+	}
+
+	result := map[string]any{
+		"protocolVersion": manifest.ProtocolVersion,
+		"serverInfo": map[string]any{
+			"name":    manifest.Name,
+			"version": manifest.Version,
+		},
+		"capabilities": capabilities,
+	}
+	if instructions := h.srv.Instructions(); instructions != "" {
+		result["instructions"] = instructions
+	}
+
+	return protocol.NewResponse(req.ID, result), nil
+}
+
+func (h *requestHandler) handleToolsList(req *protocol.Request) (*protocol.Response, error) {
+	tools := h.srv.Tools()
+	toolList := make([]map[string]any, 0, len(tools))
+	for _, t := range tools {
+		item := map[string]any{
+			"name":        t.Name,
+			"description": t.Description,
+			"inputSchema": t.InputSchema,
+		}
+		if t.Annotations != nil {
+			item["annotations"] = t.Annotations
+		}
+		toolList = append(toolList, item)
+	}
+
+	return protocol.NewResponse(req.ID, map[string]any{"tools": toolList}), nil
+}
+
+func (h *requestHandler) handleToolsCall(ctx context.Context, req *protocol.Request) (*protocol.Response, error) {
+	var params struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, protocol.NewInvalidParams(err.Error())
+	}
+
+	tool, ok := h.srv.GetTool(params.Name)
+	if !ok {
+		return nil, protocol.NewNotFound("tool not found: " + params.Name)
+	}
+
+	// Report progress if the client asked for it
+	if token := server.ExtractProgressToken(req.Params); token != "" {
+		if sender := transport.NotificationSenderFromContext(ctx); sender != nil {
+			ctx = server.ContextWithProgress(ctx, server.NewProgressReporter(token, sender))
+		}
+	}
+
+	result, err := tool.Execute(ctx, params.Arguments)
+	if err != nil {
+		return nil, protocolError(err, protocol.NewInternalError)
+	}
+
+	return protocol.NewResponse(req.ID, map[string]any{
+		"content": []map[string]any{{"type": "text", "text": result}},
+	}), nil
+}
+
+func (h *requestHandler) handleResourcesList(req *protocol.Request) (*protocol.Response, error) {
+	resources := h.srv.Resources()
+	resourceList := make([]map[string]any, 0, len(resources))
+	for _, r := range resources {
+		item := map[string]any{
+			"uri":  r.URITemplate,
+			"name": r.Name,
+		}
+		if r.Description != "" {
+			item["description"] = r.Description
+		}
+		if r.MimeType != "" {
+			item["mimeType"] = r.MimeType
+		}
+		if r.Annotations != nil {
+			item["annotations"] = r.Annotations
+		}
+		resourceList = append(resourceList, item)
+	}
+
+	return protocol.NewResponse(req.ID, map[string]any{"resources": resourceList}), nil
+}
+
+func (h *requestHandler) handleResourcesRead(ctx context.Context, req *protocol.Request) (*protocol.Response, error) {
+	var params struct {
+		URI string `json:"uri"`
+	}
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, protocol.NewInvalidParams(err.Error())
+	}
+
+	resource, ok := h.srv.FindResourceForURI(params.URI)
+	if !ok {
+		return nil, protocol.NewNotFound("resource not found: " + params.URI)
+	}
+
+	content, err := resource.Read(ctx, params.URI)
+	if err != nil {
+		return nil, protocolError(err, protocol.NewInternalError)
+	}
+
+	item := map[string]any{
+		"uri":      content.URI,
+		"mimeType": content.MimeType,
+		"text":     content.Text,
+	}
+	if content.Blob != "" {
+		item["blob"] = content.Blob
+	}
+
+	return protocol.NewResponse(req.ID, map[string]any{"contents": []map[string]any{item}}), nil
+}
+
+func (h *requestHandler) handlePromptsList(req *protocol.Request) (*protocol.Response, error) {
+	prompts := h.srv.Prompts()
+	promptList := make([]map[string]any, 0, len(prompts))
+	for _, p := range prompts {
+		item := map[string]any{"name": p.Name}
+		if p.Description != "" {
+			item["description"] = p.Description
+		}
+		if len(p.Arguments) > 0 {
+			args := make([]map[string]any, 0, len(p.Arguments))
+			for _, arg := range p.Arguments {
+				argItem := map[string]any{
+					"name":     arg.Name,
+					"required": arg.Required,
+				}
+				if arg.Description != "" {
+					argItem["description"] = arg.Description
+				}
+				args = append(args, argItem)
+			}
+			item["arguments"] = args
+		}
+		if p.Annotations != nil {
+			item["annotations"] = p.Annotations
+		}
+		promptList = append(promptList, item)
+	}
+
+	return protocol.NewResponse(req.ID, map[string]any{"prompts": promptList}), nil
+}
+
+func (h *requestHandler) handlePromptsGet(ctx context.Context, req *protocol.Request) (*protocol.Response, error) {
+	var params struct {
+		Name      string            `json:"name"`
+		Arguments map[string]string `json:"arguments"`
+	}
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, protocol.NewInvalidParams(err.Error())
+	}
+
+	prompt, ok := h.srv.GetPrompt(params.Name)
+	if !ok {
+		return nil, protocol.NewNotFound("prompt not found: " + params.Name)
+	}
+
+	result, err := prompt.Get(ctx, params.Arguments)
+	if err != nil {
+		return nil, protocolError(err, protocol.NewInvalidParams)
+	}
+
+	response := map[string]any{"messages": result.Messages}
+	if result.Description != "" {
+		response["description"] = result.Description
+	}
+
+	return protocol.NewResponse(req.ID, response), nil
+}
+
+// protocolError returns err if it already is an MCP error, and otherwise
+// wraps its message with wrap.
+func protocolError(err error, wrap func(string) *protocol.Error) *protocol.Error {
+	var mcpErr *protocol.Error
+	if errors.As(err, &mcpErr) {
+		return mcpErr
+	}
+	return wrap(err.Error())
+}
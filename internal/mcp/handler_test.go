@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/felixgeelhaar/mcp-go/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// handle sends a request to the server's request handler and returns the
+// JSON-encoded result.
+func handle(t *testing.T, server *Server, method, params string) (map[string]any, error) {
+	t.Helper()
+
+	req := &protocol.Request{JSONRPC: "2.0", ID: json.RawMessage(`1`), Method: method}
+	if params != "" {
+		req.Params = json.RawMessage(params)
+	}
+	resp, err := newRequestHandler(server.server).HandleRequest(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Result)
+	require.NoError(t, err)
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(data, &result))
+	return result, nil
+}
+
+func TestRequestHandler(t *testing.T) {
+	server, err := NewServer("1.2.3")
+	require.NoError(t, err)
+
+	t.Run("initialize", func(t *testing.T) {
+		result, err := handle(t, server, "initialize", "")
+		require.NoError(t, err)
+		info := result["serverInfo"].(map[string]any)
+		assert.Equal(t, "1.2.3", info["version"])
+		assert.Contains(t, result["capabilities"], "tools")
+		assert.NotEmpty(t, result["protocolVersion"])
+	})
+
+	t.Run("tools", func(t *testing.T) {
+		result, err := handle(t, server, "tools/list", "")
+		require.NoError(t, err)
+		tools := result["tools"].([]any)
+		require.NotEmpty(t, tools)
+		assert.Contains(t, tools[0], "inputSchema")
+
+		result, err = handle(t, server, "tools/call", `{"name":"relicta.status","arguments":{}}`)
+		require.NoError(t, err)
+		content := result["content"].([]any)
+		require.Len(t, content, 1)
+		assert.Equal(t, "text", content[0].(map[string]any)["type"])
+	})
+
+	t.Run("resources and prompts", func(t *testing.T) {
+		result, err := handle(t, server, "resources/list", "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, result["resources"])
+
+		result, err = handle(t, server, "resources/read", `{"uri":"relicta://config"}`)
+		require.NoError(t, err)
+		contents := result["contents"].([]any)
+		require.Len(t, contents, 1)
+		assert.Equal(t, "relicta://config", contents[0].(map[string]any)["uri"])
+
+		result, err = handle(t, server, "prompts/list", "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, result["prompts"])
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			method string
+			params string
+			code   int
+		}{
+			{"tools/call", `{"name":"relicta.unknown"}`, protocol.CodeNotFound},
+			{"tools/call", `[]`, protocol.CodeInvalidParams},
+			{"resources/read", `{"uri":"relicta://unknown"}`, protocol.CodeNotFound},
+			{"prompts/get", `{"name":"unknown"}`, protocol.CodeNotFound},
+			{"unknown/method", "", protocol.CodeMethodNotFound},
+		}
+		for _, tt := range tests {
+			_, err := handle(t, server, tt.method, tt.params)
+			var mcpErr *protocol.Error
+			require.ErrorAs(t, err, &mcpErr, tt.method)
+			assert.Equal(t, tt.code, mcpErr.Code, tt.method)
+		}
+	})
+}
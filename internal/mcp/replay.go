@@ -0,0 +1,330 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+// ReplayOptions configures a transcript replay.
+type ReplayOptions struct {
+	// AllowPublish replays relicta.publish calls. They are skipped by
+	// default so that replaying a session cannot publish a release.
+	// relicta.transcript calls are always skipped.
+	AllowPublish bool
+}
+
+// ReplayResult summarizes a transcript replay.
+type ReplayResult struct {
+	// Requests is the number of client messages replayed.
+	Requests int `json:"requests"`
+	// Matched is the number of replayed messages whose output matched the
+	// recording.
+	Matched int `json:"matched"`
+	// Skipped is the number of client messages not replayed: relicta.publish
+	// calls unless AllowPublish is set, and relicta.transcript calls.
+	Skipped int `json:"skipped"`
+	// Mismatches lists the replayed messages whose output differed.
+	Mismatches []ReplayMismatch `json:"mismatches,omitempty"`
+}
+
+// ReplayMismatch is a client message whose replayed output differs from the
+// recorded output.
+type ReplayMismatch struct {
+	// Index is the position of the client message in the transcript.
+	Index    int               `json:"index"`
+	Request  json.RawMessage   `json:"request"`
+	Expected []json.RawMessage `json:"expected"`
+	Actual   []json.RawMessage `json:"actual"`
+}
+
+// Replay drives a fresh server session through the client messages of a
+// recorded transcript and compares the output with the recording.
+//
+// Replayed tool calls act on the repository's release state, so Replay
+// refuses to run while a release run is in progress.
+func (s *Server) Replay(ctx context.Context, entries []TranscriptEntry, opts ReplayOptions) (*ReplayResult, error) {
+	if err := s.checkNoRunInProgress(ctx); err != nil {
+		return nil, err
+	}
+	return replayTranscript(ctx, entries, s.serveInner, opts)
+}
+
+// checkNoRunInProgress returns an error if the repository has a release run
+// that is not finished.
+func (s *Server) checkNoRunInProgress(ctx context.Context) error {
+	var runs []*release.ReleaseRun
+	switch {
+	case s.adapter != nil && s.adapter.HasReleaseServices():
+		rel, err := s.adapter.LatestRun(ctx)
+		if err != nil && !errors.Is(err, release.ErrRunNotFound) {
+			return fmt.Errorf("failed to check for a release run in progress: %w", err)
+		}
+		if rel != nil {
+			runs = append(runs, rel)
+		}
+	case s.releaseRepo != nil:
+		active, err := s.releaseRepo.FindActive(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check for a release run in progress: %w", err)
+		}
+		runs = active
+	}
+
+	for _, rel := range runs {
+		if !rel.State().IsFinished() {
+			return fmt.Errorf("release run %s is in progress (state %s): replaying would change it; cancel the run or replay in a scratch clone", rel.ID(), rel.State())
+		}
+	}
+	return nil
+}
+
+// replayTranscript replays the client messages of entries through serve.
+// The server messages recorded after a client message are its expected
+// output.
+func replayTranscript(ctx context.Context, entries []TranscriptEntry, serve innerServeFunc, opts ReplayOptions) (*ReplayResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var out bytes.Buffer
+	f, stop, err := startFramer(ctx, &out, serve, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The server handles messages one at a time, so its recorded output is
+	// the output of each client message in turn.
+	var recorded []json.RawMessage
+	for _, entry := range entries {
+		if entry.Direction == TranscriptServer {
+			recorded = append(recorded, entry.Message)
+		}
+	}
+
+	result := &ReplayResult{}
+	for i, entry := range entries {
+		if entry.Direction != TranscriptClient {
+			continue
+		}
+
+		msg := transcriptLine(entry.Message)
+		var expected []json.RawMessage
+		expected, recorded = expectedOutput(recorded, msg)
+		if callsTool(msg, "relicta.transcript") || (!opts.AllowPublish && callsTool(msg, "relicta.publish")) {
+			result.Skipped++
+			continue
+		}
+
+		result.Requests++
+		if err := f.handleLine(msg); err != nil {
+			_ = stop()
+			return result, err
+		}
+
+		f.await(&out, msg)
+		actual := f.drain(&out)
+		if sameMessages(expected, actual) {
+			result.Matched++
+			continue
+		}
+		result.Mismatches = append(result.Mismatches, ReplayMismatch{
+			Index:    i,
+			Request:  entry.Message,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+
+	if err := stop(); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// drain returns the redacted lines written to out so far and resets it.
+func (f *stdioFramer) drain(out *bytes.Buffer) []json.RawMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var msgs []json.RawMessage
+	for _, line := range bytes.Split(out.Bytes(), []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		msgs = append(msgs, redactMessage(line))
+	}
+	out.Reset()
+	return msgs
+}
+
+// expectedOutput splits the recorded server messages into the output of
+// msg, up to and including its response, and the rest.
+func expectedOutput(recorded []json.RawMessage, msg []byte) (expected, rest []json.RawMessage) {
+	done := responseMatcher(msg)
+	if done == nil {
+		return nil, recorded
+	}
+
+	var output bytes.Buffer
+	for i, line := range recorded {
+		if err := json.Compact(&output, line); err != nil {
+			output.Write(line)
+		}
+		output.WriteByte('\n')
+		if done(output.Bytes()) {
+			return recorded[:i+1], recorded[i+1:]
+		}
+	}
+	return recorded, nil
+}
+
+// await blocks until the response to msg has been written to out, or the
+// inner transport stops. Notifications and batches of notifications are
+// not waited for.
+func (f *stdioFramer) await(out *bytes.Buffer, msg []byte) {
+	done := responseMatcher(msg)
+	if done == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for !f.closed && !done(out.Bytes()) {
+		f.written.Wait()
+	}
+}
+
+// responseMatcher returns a function reporting whether the output written
+// so far holds the response to msg, or nil if msg gets no response.
+func responseMatcher(msg []byte) func(output []byte) bool {
+	lines := func(output []byte) [][]byte {
+		return bytes.Split(bytes.TrimSpace(output), []byte("\n"))
+	}
+
+	var elems []json.RawMessage
+	if msg[0] == '[' && json.Unmarshal(msg, &elems) == nil && len(elems) > 0 {
+		if allNotifications(elems) {
+			return nil
+		}
+		return func(output []byte) bool {
+			for _, line := range lines(output) {
+				if len(line) > 0 && line[0] == '[' {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	// Malformed and empty batches get a single error response.
+	if msg[0] != '[' && !expectsResponse(msg) {
+		return nil
+	}
+
+	id := json.RawMessage("null")
+	var req struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(msg, &req); err == nil && len(req.ID) > 0 {
+		id = req.ID
+	}
+	return func(output []byte) bool {
+		for _, line := range lines(output) {
+			var resp struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}
+			if err := json.Unmarshal(line, &resp); err == nil && resp.Method == "" && sameJSON(resp.ID, id) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// allNotifications reports whether every batch element is a notification.
+func allNotifications(elems []json.RawMessage) bool {
+	for _, elem := range elems {
+		if _, req, ok := parseBatchElement(elem); !ok || !req.IsNotification() {
+			return false
+		}
+	}
+	return true
+}
+
+// transcriptLine returns the line to send for a recorded client message.
+// Lines that were not valid JSON are recorded as JSON strings.
+func transcriptLine(msg json.RawMessage) []byte {
+	var s string
+	if err := json.Unmarshal(msg, &s); err == nil {
+		return []byte(s)
+	}
+	return msg
+}
+
+// callsTool reports whether msg, or any message in a batch, calls the named
+// tool.
+func callsTool(msg []byte, name string) bool {
+	type call struct {
+		Method string `json:"method"`
+		Params struct {
+			Name string `json:"name"`
+		} `json:"params"`
+	}
+	matches := func(c call) bool {
+		return c.Method == "tools/call" && c.Params.Name == name
+	}
+
+	var batch []call
+	if err := json.Unmarshal(msg, &batch); err == nil {
+		for _, c := range batch {
+			if matches(c) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var c call
+	if err := json.Unmarshal(msg, &c); err != nil {
+		return false
+	}
+	return matches(c)
+}
+
+// sameMessages reports whether two message lists are semantically equal.
+func sameMessages(a, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !sameJSON(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameJSON reports whether two JSON documents are equal, ignoring
+// formatting and key order.
+func sameJSON(a, b json.RawMessage) bool {
+	decode := func(data json.RawMessage) (any, bool) {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return nil, false
+		}
+		return v, true
+	}
+	va, okA := decode(a)
+	vb, okB := decode(b)
+	if !okA || !okB {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(va, vb)
+}
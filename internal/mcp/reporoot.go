@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// repoRootKey is the context key carrying the repository root of a tool call.
+type repoRootKey struct{}
+
+// withRepoRoot returns a copy of ctx carrying the repository root a tool
+// call operates on.
+func withRepoRoot(ctx context.Context, root string) context.Context {
+	return context.WithValue(ctx, repoRootKey{}, root)
+}
+
+// repoRootFromContext returns the repository root carried by ctx, or "".
+func repoRootFromContext(ctx context.Context) string {
+	root, _ := ctx.Value(repoRootKey{}).(string)
+	return root
+}
+
+// findRepositoryRoot returns the top-level directory of the git repository
+// containing dir, walking up until a .git directory or file is found.
+func findRepositoryRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository path %s: %w", dir, err)
+	}
+	if info, err := os.Stat(abs); err != nil {
+		return "", fmt.Errorf("repository path %s: %w", dir, err)
+	} else if !info.IsDir() {
+		return "", fmt.Errorf("repository path %s is not a directory", dir)
+	}
+
+	for current := abs; ; {
+		if _, err := os.Stat(filepath.Join(current, ".git")); err == nil {
+			return current, nil
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", fmt.Errorf("%s is not inside a git repository", dir)
+		}
+		current = parent
+	}
+}
+
+// boundRepoRoot returns the repository root the server was started for:
+// the git service's root, the configured root, or the adapter's root.
+// It returns "" when none is known.
+func (s *Server) boundRepoRoot(ctx context.Context) string {
+	if s.gitService != nil {
+		if root, err := s.gitService.GetRepositoryRoot(ctx); err == nil && root != "" {
+			return root
+		}
+	}
+	if s.repoRoot != "" {
+		return s.repoRoot
+	}
+	if s.adapter != nil {
+		return s.adapter.GetRepoRoot()
+	}
+	return ""
+}
+
+// ensureRepoPath resolves the repository root of a tool call and returns a
+// context carrying it, so that git operations, config discovery and
+// .relicta/ state use the same repository regardless of the process working
+// directory (fixes issue #35).
+//
+// requested is the tool's optional repository argument; it may name any
+// directory inside the repository. The git services are opened for the
+// repository the server was started in, so a request for another repository
+// is rejected rather than silently operating on the wrong one. Without a
+// known repository the current directory is used.
+func (s *Server) ensureRepoPath(ctx context.Context, requested string) (context.Context, string, error) {
+	bound := s.boundRepoRoot(ctx)
+
+	root := bound
+	if requested != "" {
+		found, err := findRepositoryRoot(requested)
+		if err != nil {
+			return ctx, "", err
+		}
+		if bound != "" && !sameDir(found, bound) {
+			return ctx, "", fmt.Errorf("repository %s is not served by this MCP server (serving %s); start 'relicta mcp serve' in that repository", found, bound)
+		}
+		root = found
+	}
+	if root == "" {
+		root = "."
+	}
+
+	return withRepoRoot(ctx, root), root, nil
+}
+
+// sameDir reports whether a and b name the same directory.
+func sameDir(a, b string) bool {
+	if filepath.Clean(a) == filepath.Clean(b) {
+		return true
+	}
+	infoA, errA := os.Stat(a)
+	infoB, errB := os.Stat(b)
+	return errA == nil && errB == nil && os.SameFile(infoA, infoB)
+}
@@ -4,19 +4,31 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/felixgeelhaar/mcp-go"
+	"github.com/felixgeelhaar/mcp-go/schema"
+	"github.com/felixgeelhaar/mcp-go/transport"
 
 	"github.com/relicta-tech/relicta/internal/cgp"
 	"github.com/relicta-tech/relicta/internal/cgp/evaluator"
+	"github.com/relicta-tech/relicta/internal/cgp/memory"
 	"github.com/relicta-tech/relicta/internal/cgp/policy"
 	"github.com/relicta-tech/relicta/internal/cgp/risk"
 	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/domain/release"
 	relictaerrors "github.com/relicta-tech/relicta/internal/errors"
 	"github.com/relicta-tech/relicta/internal/infrastructure/git"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
 )
 
 // Server wraps the MCP server for Relicta.
@@ -33,11 +45,22 @@ type Server struct {
 	riskCalc     *risk.Calculator
 	evaluator    *evaluator.Evaluator
 
+	// Release Memory, nil when governance memory is disabled
+	memoryStore      memory.Store
+	memoryRepository string
+
 	// Application layer adapter
 	adapter *Adapter
 
 	// Resource cache for improved read performance
 	cache *ResourceCache
+
+	// Session transcript recorder, nil when recording is not configured
+	transcript *TranscriptRecorder
+
+	// repoRoot is the repository the server was started for, used when no
+	// git service is configured
+	repoRoot string
 }
 
 // ServerOption configures the MCP server.
@@ -92,6 +115,24 @@ func WithEvaluator(ev *evaluator.Evaluator) ServerOption {
 	}
 }
 
+// WithMemoryStore sets the Release Memory store and the repository whose
+// history the relicta://metrics resource reports.
+func WithMemoryStore(store memory.Store, repository string) ServerOption {
+	return func(s *Server) {
+		s.memoryStore = store
+		s.memoryRepository = repository
+	}
+}
+
+// WithRepositoryRoot sets the repository the server operates on when no git
+// service is configured. Tool calls resolve paths against it instead of the
+// process working directory.
+func WithRepositoryRoot(root string) ServerOption {
+	return func(s *Server) {
+		s.repoRoot = root
+	}
+}
+
 // WithAdapter sets the application layer adapter.
 func WithAdapter(adapter *Adapter) ServerOption {
 	return func(s *Server) {
@@ -99,6 +140,13 @@ func WithAdapter(adapter *Adapter) ServerOption {
 	}
 }
 
+// WithTranscriptRecorder records the stdio session to a transcript.
+func WithTranscriptRecorder(recorder *TranscriptRecorder) ServerOption {
+	return func(s *Server) {
+		s.transcript = recorder
+	}
+}
+
 // WithCache sets a custom resource cache.
 func WithCache(cache *ResourceCache) ServerOption {
 	return func(s *Server) {
@@ -133,10 +181,18 @@ func toJSONString(m map[string]any) string {
 
 // Tool input types with JSON Schema generation via struct tags.
 
+// RepositoryInput selects the repository a tool acts on. Tool inputs embed
+// it; see inlineEmbeddedInputs for how it appears in their schemas.
+type RepositoryInput struct {
+	Repository string `json:"repository,omitempty" jsonschema:"description=Path to the target repository or a directory inside it. Defaults to the repository the server was started in."`
+}
+
 // StatusInput represents input for the status tool.
 // Maps to CLI: relicta status (no additional flags)
 // Returns current release state, version, and next recommended action.
-type StatusInput struct{}
+type StatusInput struct {
+	RepositoryInput
+}
 
 // InitToolInput represents input for the init tool.
 // Maps to CLI: relicta init [--force] [--format FORMAT]
@@ -144,6 +200,7 @@ type StatusInput struct{}
 type InitToolInput struct {
 	Force  bool   `json:"force,omitempty" jsonschema:"description=Overwrite existing configuration file if one exists."`
 	Format string `json:"format,omitempty" jsonschema:"description=Configuration file format.,enum=yaml|json,default=yaml"`
+	RepositoryInput
 }
 
 // PlanToolInput represents input for the plan tool.
@@ -151,48 +208,73 @@ type InitToolInput struct {
 type PlanToolInput struct {
 	From          string  `json:"from,omitempty" jsonschema:"description=Starting reference for commit analysis (tag like 'v1.0.0' or commit SHA). Leave empty for automatic detection from latest version tag."`
 	To            string  `json:"to,omitempty" jsonschema:"description=Ending reference for commit analysis (tag or commit SHA). Defaults to HEAD."`
+	Since         string  `json:"since,omitempty" jsonschema:"description=Only include commits newer than this. Accepts a duration (e.g. '72h' or '14d' or '2w') or a date (YYYY-MM-DD or RFC 3339). Without from it reaches past the latest tag; with from it further restricts that range."`
 	Analyze       bool    `json:"analyze,omitempty" jsonschema:"description=Include detailed commit classification analysis in the output. Shows how each commit was categorized."`
 	NoAI          bool    `json:"no_ai,omitempty" jsonschema:"description=Disable AI-powered commit classification. Uses only conventional commit parsing."`
 	MinConfidence float64 `json:"min_confidence,omitempty" jsonschema:"description=Minimum confidence threshold (0.0-1.0) to accept AI commit classifications. Default is 0.7."`
+	RepositoryInput
+	Dry             bool   `json:"dry,omitempty" jsonschema:"description=Preview the next version and changelog without creating a release run. Nothing under .relicta/ is written."`
+	NonConventional string `json:"nonconventional,omitempty" jsonschema:"description=Handling of non-conventional commits for this call. Overrides versioning.nonconventional_policy.,enum=infer|ignore|patch|error"`
 }
 
 // BumpToolInput represents input for the bump tool.
-// Maps to CLI: relicta bump [--level LEVEL] [--version VERSION] [--prerelease ID] [--build META]
+// Maps to CLI: relicta bump [--level LEVEL] [--version VERSION] [--pre ID] [--build META]
 type BumpToolInput struct {
-	Level      string `json:"level,omitempty" jsonschema:"description=Version bump level. Use 'auto' to determine from commits or specify 'major'/'minor'/'patch' explicitly.,enum=major|minor|patch|auto,default=auto"`
-	Version    string `json:"version,omitempty" jsonschema:"description=Set an explicit version (e.g. '2.0.0'). Overrides level and bypasses commit analysis."`
-	Prerelease string `json:"prerelease,omitempty" jsonschema:"description=Prerelease identifier to append (e.g. 'alpha', 'beta', 'rc.1'). Creates versions like '1.2.0-beta'."`
-	Build      string `json:"build,omitempty" jsonschema:"description=Build metadata to append (e.g. 'build.123'). Creates versions like '1.2.0+build.123'."`
+	Level          string `json:"level,omitempty" jsonschema:"description=Version bump level. Use 'auto' to determine from commits or specify 'major'/'minor'/'patch' explicitly.,enum=major|minor|patch|auto,default=auto"`
+	Version        string `json:"version,omitempty" jsonschema:"description=Set an explicit version (e.g. '2.0.0'). Overrides level and bypasses commit analysis. Must be greater than the current version unless allow_downgrade is set."`
+	AllowDowngrade bool   `json:"allow_downgrade,omitempty" jsonschema:"description=Accept an explicit version that is not greater than the current version. Only for correcting a mistaken release."`
+	Prerelease     string `json:"prerelease,omitempty" jsonschema:"description=Deprecated alias for pre."`
+	Pre            string `json:"pre,omitempty" jsonschema:"description=Prerelease suffix (e.g. 'beta', 'rc'). Combined with the bump kind, a minor bump of 1.2.0 becomes 1.3.0-beta.1; repeating it continues the series (1.3.0-beta.2)."`
+	Build          string `json:"build,omitempty" jsonschema:"description=Build metadata to append (e.g. 'build.123'). Creates versions like '1.2.0+build.123'."`
+	RepositoryInput
 }
 
 // NotesToolInput represents input for the notes tool.
-// Maps to CLI: relicta notes [--ai] [--audience TYPE] [--tone STYLE] [--language LANG] [--emoji]
+// Maps to CLI: relicta notes [--ai] [--audience TYPE] [--tone STYLE] [--language LANG] [--emoji] [--from-file PATH]
 type NotesToolInput struct {
-	AI       bool   `json:"ai,omitempty" jsonschema:"description=Use AI to generate enhanced release notes. Requires OPENAI_API_KEY or configured AI provider."`
-	Audience string `json:"audience,omitempty" jsonschema:"description=Target audience affects terminology and detail level.,enum=developers|users|public|stakeholders,default=developers"`
-	Tone     string `json:"tone,omitempty" jsonschema:"description=Writing style for AI-generated notes.,enum=technical|friendly|professional|marketing,default=professional"`
-	Language string `json:"language,omitempty" jsonschema:"description=Output language for release notes (e.g. 'English', 'Spanish', 'Japanese'). Default is English."`
-	Emoji    bool   `json:"emoji,omitempty" jsonschema:"description=Include emojis in release notes output for visual categorization."`
+	AI         bool   `json:"ai,omitempty" jsonschema:"description=Use AI to generate enhanced release notes. Requires OPENAI_API_KEY or configured AI provider."`
+	Audience   string `json:"audience,omitempty" jsonschema:"description=Audience preset; affects terminology and detail level. Overrides ai.audience for this generation.,enum=developers|users|public|marketing"`
+	Tone       string `json:"tone,omitempty" jsonschema:"description=Tone preset for AI-generated notes. Overrides ai.tone for this generation.,enum=technical|friendly|professional|excited"`
+	Language   string `json:"language,omitempty" jsonschema:"description=Output language for release notes (e.g. 'English', 'Spanish', 'Japanese'). Default is English."`
+	Emoji      bool   `json:"emoji,omitempty" jsonschema:"description=Include emojis in release notes output for visual categorization."`
+	Regenerate bool   `json:"regenerate,omitempty" jsonschema:"description=Replace notes that were already generated (notes_ready state). Keeps the existing audience and tone unless overridden."`
+	NotesText  string `json:"notes_text,omitempty" jsonschema:"description=Use this text as the release notes instead of generating them. Replaces existing notes before approval. Cannot be combined with ai or regenerate."`
+	RepositoryInput
 }
 
 // EvaluateToolInput represents input for the evaluate tool.
-// Maps to CLI: relicta evaluate (no additional flags)
-type EvaluateToolInput struct{}
+// Maps to CLI: relicta evaluate [--advisory]
+type EvaluateToolInput struct {
+	Advisory bool `json:"advisory,omitempty" jsonschema:"description=Evaluate in advisory mode for this call only: report the decision and risk without enforcing governance strict mode. The result is marked advisory and cannot block a publish."`
+	RepositoryInput
+}
 
 // ApproveToolInput represents input for the approve tool.
 // Maps to CLI: relicta approve [--yes] [--edit]
 type ApproveToolInput struct {
 	Notes   string `json:"notes,omitempty" jsonschema:"description=Updated release notes content. If provided, replaces the generated notes before approval."`
 	Message string `json:"message,omitempty" jsonschema:"description=Approval message or reason for the release. Recorded in the audit trail."`
+	RepositoryInput
+}
+
+// ApproveLevelToolInput represents input for the approve_level tool.
+// Maps to the multi-level approval workflow (no CLI equivalent).
+type ApproveLevelToolInput struct {
+	Level         string `json:"level" jsonschema:"required,description=Approval level to grant.,enum=technical|security|manager|release"`
+	Justification string `json:"justification,omitempty" jsonschema:"description=Reason for granting the approval. Recorded in the audit trail."`
+	Approver      string `json:"approver,omitempty" jsonschema:"description=Identity of the agent granting the approval (default: mcp.actor). An approver can grant only one level of a release."`
+	RepositoryInput
 }
 
 // PublishToolInput represents input for the publish tool.
-// Maps to CLI: relicta publish [--dry-run] [--skip-push] [--skip-tag] [--skip-plugins]
+// Maps to CLI: relicta publish [--dry-run] [--skip-push] [--skip-tag] [--skip-plugins] [--only PLUGIN]...
 type PublishToolInput struct {
-	DryRun      bool `json:"dry_run,omitempty" jsonschema:"description=Simulate the release without making actual changes. Shows what would happen."`
-	SkipPush    bool `json:"skip_push,omitempty" jsonschema:"description=Skip pushing git tags to the remote repository."`
-	SkipTag     bool `json:"skip_tag,omitempty" jsonschema:"description=Skip creating the git tag. Useful when tag already exists."`
-	SkipPlugins bool `json:"skip_plugins,omitempty" jsonschema:"description=Skip running configured plugins (GitHub release, Slack notification, etc.)."`
+	DryRun      bool     `json:"dry_run,omitempty" jsonschema:"description=Simulate the release without making actual changes. Shows what would happen."`
+	SkipPush    bool     `json:"skip_push,omitempty" jsonschema:"description=Skip pushing git tags to the remote repository."`
+	SkipTag     bool     `json:"skip_tag,omitempty" jsonschema:"description=Skip creating the git tag. Useful when tag already exists."`
+	SkipPlugins bool     `json:"skip_plugins,omitempty" jsonschema:"description=Skip running configured plugins (GitHub release, Slack notification, etc.)."`
+	Only        []string `json:"only,omitempty" jsonschema:"description=Run only the steps of these plugins (or steps with these names). Steps already done are skipped; a failed release is retried."`
+	RepositoryInput
 }
 
 // CancelToolInput represents input for the cancel tool.
@@ -200,12 +282,14 @@ type PublishToolInput struct {
 type CancelToolInput struct {
 	Reason string `json:"reason,omitempty" jsonschema:"description=Reason for canceling the release. Recorded in the audit trail for traceability."`
 	Force  bool   `json:"force,omitempty" jsonschema:"description=Force cancel even if release is in publishing state. Use with caution - may leave artifacts in inconsistent state."`
+	RepositoryInput
 }
 
 // ResetToolInput represents input for the reset tool.
 // Maps to CLI: relicta reset [--force]
 type ResetToolInput struct {
 	Force bool `json:"force,omitempty" jsonschema:"description=Force reset even if a release is in progress. Clears all release state and starts fresh."`
+	RepositoryInput
 }
 
 // --- Specialized AI Agent Tool Inputs ---
@@ -241,6 +325,23 @@ type ValidateReleaseToolInput struct {
 	CheckPlugins    bool     `json:"check_plugins,omitempty" jsonschema:"description=Check plugin availability and configuration"`
 	CheckGovernance bool     `json:"check_governance,omitempty" jsonschema:"description=Check CGP governance requirements"`
 	Checks          []string `json:"checks,omitempty" jsonschema:"description=Specific checks to run (subset of all checks)"`
+	RepositoryInput
+}
+
+// DryRunPlanToolInput represents input for the dryrun_plan tool.
+type DryRunPlanToolInput struct {
+	RepositoryInput
+}
+
+// TranscriptToolInput represents input for the transcript tool.
+type TranscriptToolInput struct {
+	Action string `json:"action,omitempty" jsonschema:"description=Start or stop recording the session transcript or report its status.,enum=start|stop|status,default=status"`
+}
+
+// ExplainErrorToolInput represents input for the explain_error tool.
+type ExplainErrorToolInput struct {
+	Code    string `json:"code,omitempty" jsonschema:"description=Error code to explain (e.g. HEAD_CHANGED). Takes precedence over message."`
+	Message string `json:"message,omitempty" jsonschema:"description=Error message returned by a failed tool call"`
 }
 
 // Prompt argument input types.
@@ -260,6 +361,11 @@ type MigrationGuideArgs struct {
 	Audience string `json:"audience,omitempty" jsonschema:"description=Target audience: developer, operator, or end-user,enum=developer|operator|end-user,default=developer"`
 }
 
+// DeprecationTimelineArgs represents arguments for the deprecation-timeline prompt.
+type DeprecationTimelineArgs struct {
+	Horizon string `json:"horizon,omitempty" jsonschema:"description=Removal window after deprecation (e.g. 2 minor versions),default=next major version"`
+}
+
 // ReleaseAnnouncementArgs represents arguments for the release-announcement prompt.
 type ReleaseAnnouncementArgs struct {
 	Channel string `json:"channel,omitempty" jsonschema:"description=Target channel: github, blog, social, or email,enum=github|blog|social|email,default=github"`
@@ -292,6 +398,7 @@ func NewServer(version string, opts ...ServerOption) (*Server, error) {
 
 	// Register tools
 	s.registerTools()
+	s.inlineEmbeddedInputs()
 
 	// Register resources
 	s.registerResources()
@@ -302,17 +409,51 @@ func NewServer(version string, opts ...ServerOption) (*Server, error) {
 	return s, nil
 }
 
-// ServeStdio starts the MCP server on stdio transport.
+// ServeStdio starts the MCP server on stdio transport. JSON-RPC batches are
+// supported in addition to single messages.
 func (s *Server) ServeStdio() error {
 	s.logger.Info("MCP server started", "version", s.version)
-	return mcp.ServeStdio(context.Background(), s.server)
+	defer func() {
+		if err := s.transcript.Close(); err != nil {
+			s.logger.Warn("failed to close MCP transcript", "error", err)
+		}
+	}()
+	return serveFramed(context.Background(), os.Stdin, os.Stdout, s.serveInner, s.transcript)
+}
+
+// serveInner runs the mcp-go stdio transport on the given reader and
+// writer. The transport gets its own streams, so the process stdio is left
+// to serveFramed.
+func (s *Server) serveInner(ctx context.Context, in io.Reader, out io.Writer) error {
+	t := transport.NewStdio(transport.WithStdin(in), transport.WithStdout(out), transport.WithStderr(os.Stderr))
+	return t.Serve(ctx, newRequestHandler(s.server))
+}
+
+// inlineEmbeddedInputs moves the properties of RepositoryInput to the top
+// level of the tool input schemas. mcp-go describes an embedded struct as a
+// nested object, while encoding/json decodes its fields inline.
+func (s *Server) inlineEmbeddedInputs() {
+	embedded := reflect.TypeFor[RepositoryInput]().Name()
+	for _, tool := range s.server.Tools() {
+		inputSchema, ok := tool.InputSchema.(*schema.Schema)
+		if !ok {
+			continue
+		}
+		nested, ok := inputSchema.Properties[embedded]
+		if !ok {
+			continue
+		}
+		delete(inputSchema.Properties, embedded)
+		maps.Copy(inputSchema.Properties, nested.Properties)
+		inputSchema.Required = append(inputSchema.Required, nested.Required...)
+	}
 }
 
 // registerTools registers all tool handlers.
 func (s *Server) registerTools() {
 	// Status tool
 	s.server.Tool("relicta.status").
-		Description("Get the current release state and pending actions").
+		Description("Get the current release state, pending actions and approval progress").
 		Handler(s.handleStatus)
 
 	// Init tool
@@ -345,6 +486,11 @@ func (s *Server) registerTools() {
 		Description("Approve the release for publishing").
 		Handler(s.handleApprove)
 
+	// Approve level tool - Multi-level approvals
+	s.server.Tool("relicta.approve_level").
+		Description("Grant one approval level (technical, security, manager or release) for a release that needs multi-level approval. Returns the granted and pending levels; the release is approved once all required levels are granted.").
+		Handler(s.handleApproveLevel)
+
 	// Publish tool
 	s.server.Tool("relicta.publish").
 		Description("Execute the release by creating tags and running plugins").
@@ -381,6 +527,21 @@ func (s *Server) registerTools() {
 	s.server.Tool("relicta.validate_release").
 		Description("Run pre-flight validation checks before release. Validates git state, plugins, and governance requirements.").
 		Handler(s.handleValidateRelease)
+
+	// Explain Error tool - Guided recovery from failures
+	s.server.Tool("relicta.explain_error").
+		Description("Explain a Relicta error code or message and return the recommended next action, CLI command and MCP tool to recover.").
+		Handler(s.handleExplainError)
+
+	// Dry-run Plan tool - Execution plan preview before publishing
+	s.server.Tool("relicta.dryrun_plan").
+		Description("Preview the execution plan of the approved release without executing it: the ordered publish steps with their type, plugin, hook and unsafe flag, each step's status, and the plugins that relicta.publish will run, in order.").
+		Handler(s.handleDryRunPlan)
+
+	// Transcript tool - Session recording for debugging
+	s.server.Tool("relicta.transcript").
+		Description("Start or stop recording this MCP session to the transcript file (mcp.transcript_file), or report the recording status. Transcripts can be replayed with 'relicta mcp replay'.").
+		Handler(s.handleTranscript)
 }
 
 // registerResources registers all resource handlers.
@@ -414,6 +575,24 @@ func (s *Server) registerResources() {
 		Description("CGP risk assessment for current release").
 		MimeType("application/json").
 		Handler(s.handleResourceRiskReport)
+
+	s.server.Resource("relicta://approvals").
+		Name("Approvals").
+		Description("Granted and pending approvals for current release").
+		MimeType("application/json").
+		Handler(s.handleResourceApprovals)
+
+	s.server.Resource("relicta://metrics").
+		Name("Release Metrics").
+		Description("Release Memory analytics: bump kinds, risk, approvals and failure rate").
+		MimeType("application/json").
+		Handler(s.handleResourceMetrics)
+
+	s.server.Resource("relicta://plan").
+		Name("Execution Plan").
+		Description("Ordered publish steps of the current release and their status").
+		MimeType("application/json").
+		Handler(s.handleResourceExecutionPlan)
 }
 
 // registerPrompts registers all prompt handlers.
@@ -441,6 +620,11 @@ func (s *Server) registerPrompts() {
 		Argument("audience", "Target audience: developer, operator, or end-user", false).
 		Handler(s.handlePromptMigrationGuide)
 
+	s.server.Prompt("deprecation-timeline").
+		Description("Build a deprecation timeline with removal versions and replacements").
+		Argument("horizon", "Removal window after deprecation (e.g. \"2 minor versions\"); defaults to the next major version", false).
+		Handler(s.handlePromptDeprecationTimeline)
+
 	s.server.Prompt("release-announcement").
 		Description("Generate a release announcement for publishing").
 		Argument("channel", "Target channel: github, blog, social, or email", false).
@@ -458,31 +642,14 @@ func (s *Server) invalidateCache() {
 	}
 }
 
-// ensureRepoPath gets the repository path from git service and updates the adapter.
-// This ensures consistent repository path handling across all MCP tool calls,
-// fixing issue #35 where state wasn't persisted between tool calls due to path mismatch.
-func (s *Server) ensureRepoPath(ctx context.Context) string {
-	repoPath := ""
-	if s.gitService != nil {
-		if path, err := s.gitService.GetRepositoryRoot(ctx); err == nil {
-			repoPath = path
-		}
-	}
-	if repoPath == "" {
-		repoPath = "."
-	}
-	// Update adapter's repoRoot to ensure consistent path across calls
-	if s.adapter != nil {
-		s.adapter.SetRepoRoot(repoPath)
-	}
-	return repoPath
-}
-
 // Tool handlers
 
 func (s *Server) handleInit(ctx context.Context, input InitToolInput) (string, error) {
-	// Get current working directory for config placement
-	repoPath := s.ensureRepoPath(ctx)
+	// Place the config file in the target repository, not the process CWD
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Check for existing config
 	existingConfig, _ := config.FindConfigFile(repoPath)
@@ -529,7 +696,7 @@ func (s *Server) handleInit(ctx context.Context, input InitToolInput) (string, e
 	}
 
 	// Write config file
-	if err := config.WriteConfig(cfg, configFile); err != nil {
+	if err := config.WriteConfig(cfg, filepath.Join(repoPath, configFile)); err != nil {
 		return "", fmt.Errorf("failed to write config file: %w", err)
 	}
 
@@ -591,7 +758,10 @@ func parseRemoteURL(remoteURL string) string {
 
 func (s *Server) handleStatus(ctx context.Context, input StatusInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, _, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available (GetStatus uses releaseServices, not releaseRepo)
 	if s.adapter != nil && s.adapter.HasReleaseServices() {
@@ -613,15 +783,48 @@ func (s *Server) handleStatus(ctx context.Context, input StatusInput) (string, e
 			"next_action": status.NextAction,
 		}
 
+		if status.NextStep != "" {
+			result["next_step"] = status.NextStep
+		}
+		if status.NextCommand != "" {
+			result["next_command"] = status.NextCommand
+			result["next_tool"] = status.NextTool
+		}
+
 		if status.ApprovalMsg != "" {
 			result["approval_message"] = status.ApprovalMsg
 		}
 
+		result["approval_mode"] = status.ApprovalMode
+		if status.ApprovalMode == "multi_level" {
+			result["granted_approval_levels"] = status.GrantedApprovalLevels
+			result["pending_approval_levels"] = status.PendingApprovalLevels
+			if status.NextApprovalLevel != "" {
+				result["next_approval_level"] = status.NextApprovalLevel
+			}
+		} else {
+			result["can_auto_approve"] = status.CanAutoApprove
+			result["risk_score"] = status.RiskScore
+			result["auto_approve_threshold"] = status.AutoApproveThreshold
+		}
+
 		if status.Stale {
 			result["stale"] = true
 			result["warning"] = status.Warning
 		}
 
+		if rejection := status.Rejection; rejection != nil {
+			result["rejection"] = map[string]any{
+				"rejected_by": rejection.RejectedBy,
+				"rejected_at": rejection.RejectedAt.Format(time.RFC3339),
+				"reason":      rejection.Reason,
+			}
+		}
+
+		if freeze, err := s.adapter.ReleaseFreeze(ctx); err == nil && freeze != nil {
+			result["freeze"] = freeze
+		}
+
 		return toJSONString(result), nil
 	}
 
@@ -656,21 +859,60 @@ func (s *Server) handleStatus(ctx context.Context, input StatusInput) (string, e
 	return toJSONString(result), nil
 }
 
+// nonConventionalResult reports how many commits were not conventional
+// commits and how they were handled.
+func nonConventionalResult(summary servicerelease.NonConventionalSummary) map[string]any {
+	policy := summary.Policy
+	if policy == "" {
+		policy = servicerelease.NonConventionalInfer
+	}
+	return map[string]any{
+		"count":  len(summary.Commits),
+		"policy": string(policy),
+	}
+}
+
 func (s *Server) handlePlan(ctx context.Context, input PlanToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	repoPath := s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available
 	if s.adapter != nil && s.adapter.HasReleaseAnalyzer() {
+		if !input.Dry {
+			unlock, err := lockState(repoPath, "relicta.plan")
+			if err != nil {
+				return "", err
+			}
+			defer unlock()
+		}
+
 		fromRef := ""
 		if input.From != "" && input.From != "auto" {
 			fromRef = input.From
 		}
 
+		since, err := servicerelease.ParseSince(input.Since, time.Now())
+		if err != nil {
+			return "", userError(err)
+		}
+
+		var policy servicerelease.NonConventionalPolicy
+		if input.NonConventional != "" {
+			if policy, err = servicerelease.ParseNonConventionalPolicy(input.NonConventional); err != nil {
+				return "", userError(err)
+			}
+		}
+
 		planInput := PlanInput{
-			RepositoryPath: repoPath,
-			FromRef:        fromRef,
-			Analyze:        input.Analyze,
+			RepositoryPath:        repoPath,
+			FromRef:               fromRef,
+			Since:                 since,
+			Analyze:               input.Analyze,
+			DryRun:                input.Dry,
+			NonConventionalPolicy: policy,
 		}
 
 		// Report progress
@@ -690,14 +932,16 @@ func (s *Server) handlePlan(ctx context.Context, input PlanToolInput) (string, e
 		}
 
 		result := map[string]any{
-			"release_id":      output.ReleaseID,
-			"current_version": output.CurrentVersion,
-			"next_version":    output.NextVersion,
-			"release_type":    output.ReleaseType,
-			"commit_count":    output.CommitCount,
-			"has_breaking":    output.HasBreaking,
-			"has_features":    output.HasFeatures,
-			"has_fixes":       output.HasFixes,
+			"release_id":       output.ReleaseID,
+			"current_version":  output.CurrentVersion,
+			"next_version":     output.NextVersion,
+			"release_type":     output.ReleaseType,
+			"commit_count":     output.CommitCount,
+			"has_breaking":     output.HasBreaking,
+			"has_features":     output.HasFeatures,
+			"has_fixes":        output.HasFixes,
+			"commit_window":    output.Window.Fields(),
+			"non_conventional": nonConventionalResult(output.NonConventional),
 		}
 
 		// Include commit details when analyze=true
@@ -718,12 +962,32 @@ func (s *Server) handlePlan(ctx context.Context, input PlanToolInput) (string, e
 			result["commits"] = commits
 		}
 
+		if input.Dry {
+			summary := output.Summary
+			result["dry"] = true
+			result["bump_kind"] = output.BumpKind
+			result["summary"] = map[string]int{
+				"total":            summary.TotalCommits,
+				"features":         summary.Features,
+				"fixes":            summary.Fixes,
+				"breaking_changes": summary.Breaking,
+				"performance":      summary.Performance,
+				"documentation":    summary.Documentation,
+				"refactoring":      summary.Refactoring,
+				"tests":            summary.Tests,
+				"other":            summary.Other,
+			}
+			result["changelog"] = output.Changelog
+		}
+
 		if progress := mcp.ProgressFromContext(ctx); progress != nil {
 			total := 3.0
 			_ = progress.Report(3, &total)
 		}
 
-		s.invalidateCache()
+		if !input.Dry {
+			s.invalidateCache()
+		}
 		return toJSONString(result), nil
 	}
 
@@ -735,7 +999,10 @@ func (s *Server) handlePlan(ctx context.Context, input PlanToolInput) (string, e
 
 func (s *Server) handleBump(ctx context.Context, input BumpToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	repoPath := s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	bumpType := input.Level
 	if bumpType == "" {
@@ -744,10 +1011,23 @@ func (s *Server) handleBump(ctx context.Context, input BumpToolInput) (string, e
 
 	// Use adapter if available
 	if s.adapter != nil && s.adapter.HasReleaseServices() {
+		unlock, err := lockState(repoPath, "relicta.bump")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
+		pre := input.Pre
+		if pre == "" {
+			pre = input.Prerelease
+		}
+
 		bumpInput := BumpInput{
 			RepositoryPath: repoPath,
 			BumpType:       bumpType,
 			Version:        input.Version,
+			AllowDowngrade: input.AllowDowngrade,
+			Prerelease:     pre,
 		}
 
 		output, err := s.adapter.Bump(ctx, bumpInput)
@@ -780,10 +1060,23 @@ func (s *Server) handleBump(ctx context.Context, input BumpToolInput) (string, e
 
 func (s *Server) handleNotes(ctx context.Context, input NotesToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
+
+	if strings.TrimSpace(input.NotesText) != "" && (input.AI || input.Regenerate) {
+		return "", userError(fmt.Errorf("notes_text cannot be combined with ai or regenerate"))
+	}
 
 	// Use adapter if available (GetStatus and Notes both use releaseServices)
 	if s.adapter != nil && s.adapter.HasReleaseServices() {
+		unlock, err := lockState(repoPath, "relicta.notes")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
 		status, err := s.adapter.GetStatus(ctx)
 		if err != nil {
 			return "", fmt.Errorf("no active release: %w", err)
@@ -798,10 +1091,26 @@ func (s *Server) handleNotes(ctx context.Context, input NotesToolInput) (string,
 			_ = progress.Report(1, &totalSteps)
 		}
 
+		// Presets not given come from ai.audience and ai.tone, except when
+		// regenerating or importing notes.
+		audience, tone := input.Audience, input.Tone
+		if s.config != nil && !input.Regenerate && strings.TrimSpace(input.NotesText) == "" {
+			if audience == "" {
+				audience = s.config.AI.Audience
+			}
+			if tone == "" {
+				tone = s.config.AI.Tone
+			}
+		}
+
 		notesInput := NotesInput{
 			ReleaseID:        status.ReleaseID,
 			UseAI:            input.AI,
 			IncludeChangelog: true,
+			Audience:         audience,
+			Tone:             tone,
+			Regenerate:       input.Regenerate,
+			Text:             strings.TrimSpace(input.NotesText),
 		}
 
 		if progress := mcp.ProgressFromContext(ctx); progress != nil {
@@ -820,6 +1129,13 @@ func (s *Server) handleNotes(ctx context.Context, input NotesToolInput) (string,
 		result := map[string]any{
 			"summary":      output.Summary,
 			"ai_generated": output.AIGenerated,
+			"regenerated":  output.Regenerated,
+		}
+		if output.Audience != "" {
+			result["audience"] = output.Audience
+		}
+		if output.Tone != "" {
+			result["tone"] = output.Tone
 		}
 
 		if output.Changelog != "" {
@@ -838,7 +1154,10 @@ func (s *Server) handleNotes(ctx context.Context, input NotesToolInput) (string,
 
 func (s *Server) handleEvaluate(ctx context.Context, input EvaluateToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, _, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter for full governance evaluation if available (GetStatus uses releaseServices)
 	if s.adapter != nil && s.adapter.HasGovernanceService() && s.adapter.HasReleaseServices() {
@@ -873,7 +1192,7 @@ func (s *Server) handleEvaluate(ctx context.Context, input EvaluateToolInput) (s
 			_ = progress.Report(4, &total)
 		}
 
-		return toJSONString(map[string]any{
+		result := map[string]any{
 			"decision":         output.Decision,
 			"risk_score":       output.RiskScore,
 			"severity":         output.Severity,
@@ -881,7 +1200,15 @@ func (s *Server) handleEvaluate(ctx context.Context, input EvaluateToolInput) (s
 			"required_actions": output.RequiredActions,
 			"risk_factors":     output.RiskFactors,
 			"rationale":        output.Rationale,
-		}), nil
+		}
+		if len(output.UnlinkedCommits) > 0 {
+			result["unlinked_commits"] = output.UnlinkedCommits
+		}
+		if len(output.Warnings) > 0 {
+			result["warnings"] = output.Warnings
+		}
+		s.markEvaluationMode(result, input.Advisory)
+		return toJSONString(result), nil
 	}
 
 	// Fallback to basic risk calculation
@@ -910,20 +1237,48 @@ func (s *Server) handleEvaluate(ctx context.Context, input EvaluateToolInput) (s
 		return "", userError(err)
 	}
 
-	return toJSONString(map[string]any{
+	result := map[string]any{
 		"score":    assessment.Score,
 		"severity": string(assessment.Severity),
 		"summary":  assessment.Summary,
 		"factors":  assessment.Factors,
-	}), nil
+	}
+	s.markEvaluationMode(result, input.Advisory)
+	return toJSONString(result), nil
+}
+
+// markEvaluationMode records in an evaluate result whether its decision is
+// binding. Evaluations are advisory when requested for the call or when
+// governance strict mode is off; only binding decisions block a release.
+func (s *Server) markEvaluationMode(result map[string]any, advisory bool) {
+	strict := s.config != nil && s.config.Governance.StrictMode
+	switch {
+	case advisory:
+		result["advisory"] = true
+		result["advisory_reason"] = "requested for this evaluation; the decision is informational and does not block publishing"
+	case !strict:
+		result["advisory"] = true
+		result["advisory_reason"] = "governance strict mode is disabled; the decision does not block publishing"
+	default:
+		result["advisory"] = false
+	}
 }
 
 func (s *Server) handleApprove(ctx context.Context, input ApproveToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available (GetStatus and Approve both use releaseServices)
 	if s.adapter != nil && s.adapter.HasReleaseServices() {
+		unlock, err := lockState(repoPath, "relicta.approve")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
 		status, err := s.adapter.GetStatus(ctx)
 		if err != nil {
 			return "", fmt.Errorf("no active release: %w", err)
@@ -931,7 +1286,7 @@ func (s *Server) handleApprove(ctx context.Context, input ApproveToolInput) (str
 
 		approveInput := ApproveInput{
 			ReleaseID:   status.ReleaseID,
-			ApprovedBy:  "mcp-agent",
+			ApprovedBy:  s.mcpActor(),
 			AutoApprove: true,
 			EditedNotes: input.Notes,
 		}
@@ -955,12 +1310,90 @@ func (s *Server) handleApprove(ctx context.Context, input ApproveToolInput) (str
 	}), nil
 }
 
+func (s *Server) handleApproveLevel(ctx context.Context, input ApproveLevelToolInput) (string, error) {
+	if input.Level == "" {
+		return "", fmt.Errorf("level is required: technical, security, manager or release")
+	}
+
+	// Ensure consistent repository path (fixes issue #35)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
+
+	if s.adapter != nil && s.adapter.HasReleaseServices() {
+		unlock, err := lockState(repoPath, "relicta.approve_level")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
+		status, err := s.adapter.GetStatus(ctx)
+		if err != nil {
+			return "", fmt.Errorf("no active release: %w", err)
+		}
+
+		approver := strings.TrimSpace(input.Approver)
+		if approver == "" {
+			approver = s.mcpActor()
+		}
+
+		output, err := s.adapter.ApproveLevel(ctx, ApproveLevelInput{
+			ReleaseID:     status.ReleaseID,
+			Level:         input.Level,
+			ApprovedBy:    approver,
+			Justification: input.Justification,
+		})
+		if err != nil {
+			return "", userError(err)
+		}
+
+		s.invalidateCache()
+		result := map[string]any{
+			"level":          output.Level,
+			"approved_by":    output.ApprovedBy,
+			"granted_levels": output.Granted,
+			"pending_levels": output.Pending,
+			"approved":       output.FullyApproved,
+			"version":        output.Version,
+		}
+		if output.NextLevel != "" {
+			result["next_level"] = output.NextLevel
+		}
+		return toJSONString(result), nil
+	}
+
+	return toJSONString(map[string]any{
+		"level":  input.Level,
+		"status": "run 'relicta mcp serve' with configured dependencies",
+	}), nil
+}
+
+// mcpActor returns the configured identity of the MCP client.
+func (s *Server) mcpActor() string {
+	if s.config != nil && s.config.MCP.Actor != "" {
+		return s.config.MCP.Actor
+	}
+	return "mcp-agent"
+}
+
 func (s *Server) handlePublish(ctx context.Context, input PublishToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available (GetStatus and Publish both use releaseServices)
 	if s.adapter != nil && s.adapter.HasReleaseServices() {
+		if !input.DryRun {
+			unlock, err := lockState(repoPath, "relicta.publish")
+			if err != nil {
+				return "", err
+			}
+			defer unlock()
+		}
+
 		status, err := s.adapter.GetStatus(ctx)
 		if err != nil {
 			return "", fmt.Errorf("no active release: %w", err)
@@ -977,6 +1410,7 @@ func (s *Server) handlePublish(ctx context.Context, input PublishToolInput) (str
 			DryRun:    input.DryRun,
 			CreateTag: true,
 			PushTag:   !input.DryRun,
+			Only:      input.Only,
 		}
 
 		if progress := mcp.ProgressFromContext(ctx); progress != nil {
@@ -986,6 +1420,14 @@ func (s *Server) handlePublish(ctx context.Context, input PublishToolInput) (str
 
 		output, err := s.adapter.Publish(ctx, publishInput)
 		if err != nil {
+			switch {
+			case errors.Is(err, release.ErrApprovalExpired):
+				return "", fmt.Errorf("approval expired: the approval is older than governance.approval_ttl; re-approve with relicta.approve before publishing")
+			case errors.Is(err, release.ErrNotApproved):
+				return "", fmt.Errorf("release not approved: approve with relicta.approve before publishing")
+			case errors.Is(err, release.ErrPrePublishRejected):
+				return "", fmt.Errorf("pre-publish rejected: no tag was created; resolve the reported problem and call relicta.publish again: %w", err)
+			}
 			return "", userError(err)
 		}
 
@@ -1000,6 +1442,18 @@ func (s *Server) handlePublish(ctx context.Context, input PublishToolInput) (str
 			"dry_run":     input.DryRun,
 		}
 
+		if len(input.Only) > 0 {
+			result["only"] = input.Only
+			result["published"] = output.Published
+			result["executed"] = output.Executed
+			result["skipped"] = output.Skipped
+		}
+
+		if output.OnPluginFailure != "" {
+			result["on_plugin_failure"] = output.OnPluginFailure
+			result["plugin_failures"] = output.PluginFailures
+		}
+
 		if len(output.PluginResults) > 0 {
 			plugins := make([]map[string]any, 0, len(output.PluginResults))
 			for _, pr := range output.PluginResults {
@@ -1030,10 +1484,19 @@ func (s *Server) handlePublish(ctx context.Context, input PublishToolInput) (str
 
 func (s *Server) handleCancel(ctx context.Context, input CancelToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available (GetStatus uses releaseServices, Cancel uses releaseRepo)
 	if s.adapter != nil && s.adapter.HasReleaseServices() && s.adapter.HasReleaseRepository() {
+		unlock, err := lockState(repoPath, "relicta.cancel")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
 		status, err := s.adapter.GetStatus(ctx)
 		if err != nil {
 			return "", fmt.Errorf("no active release to cancel: %w", err)
@@ -1087,10 +1550,19 @@ func (s *Server) handleCancel(ctx context.Context, input CancelToolInput) (strin
 
 func (s *Server) handleReset(ctx context.Context, input ResetToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, repoPath, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Use adapter if available (GetStatus uses releaseServices, Reset uses releaseRepo)
 	if s.adapter != nil && s.adapter.HasReleaseServices() && s.adapter.HasReleaseRepository() {
+		unlock, err := lockState(repoPath, "relicta.reset")
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+
 		status, err := s.adapter.GetStatus(ctx)
 		if err != nil {
 			return toJSONString(map[string]any{
@@ -1315,7 +1787,10 @@ func (s *Server) handleSummarizeDiff(ctx context.Context, input SummarizeDiffToo
 
 func (s *Server) handleValidateRelease(ctx context.Context, input ValidateReleaseToolInput) (string, error) {
 	// Ensure consistent repository path (fixes issue #35)
-	s.ensureRepoPath(ctx)
+	ctx, _, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
 
 	// Get release ID from input or active release
 	releaseID := input.ReleaseID
@@ -1384,6 +1859,95 @@ func (s *Server) handleValidateRelease(ctx context.Context, input ValidateReleas
 	}), nil
 }
 
+func (s *Server) handleExplainError(_ context.Context, input ExplainErrorToolInput) (string, error) {
+	if input.Code == "" && input.Message == "" {
+		return "", fmt.Errorf("code or message is required")
+	}
+
+	r, matched := explainError(input.Code, input.Message)
+	result := map[string]any{
+		"code":        r.Code,
+		"matched":     matched,
+		"title":       r.Title,
+		"explanation": r.Explanation,
+		"next_action": r.NextAction,
+		"command":     r.Command,
+		"retryable":   r.Retryable,
+	}
+	if r.Tool != "" {
+		result["tool"] = r.Tool
+	}
+	if !matched {
+		result["known_codes"] = knownErrorCodes()
+	}
+
+	return toJSONString(result), nil
+}
+
+func (s *Server) handleDryRunPlan(ctx context.Context, input DryRunPlanToolInput) (string, error) {
+	ctx, _, err := s.ensureRepoPath(ctx, input.Repository)
+	if err != nil {
+		return "", userError(err)
+	}
+
+	var rel *release.ReleaseRun
+	switch {
+	case s.adapter != nil && s.adapter.HasReleaseServices():
+		if rel, err = s.adapter.LatestRun(ctx); err != nil {
+			return "", fmt.Errorf("no active release: %w", err)
+		}
+	case s.releaseRepo != nil:
+		releases, err := s.releaseRepo.FindActive(ctx)
+		if err != nil || len(releases) == 0 {
+			return toJSONString(map[string]any{
+				"status":  "no_active_release",
+				"message": "No active release found. Run 'relicta plan' to start a new release.",
+			}), nil
+		}
+		rel = releases[0]
+	default:
+		return toJSONString(map[string]any{
+			"status":  "not_configured",
+			"message": "No release repository configured. Run 'relicta plan' first.",
+		}), nil
+	}
+
+	// The execution plan is computed when the release is approved
+	if rel.State() != release.StateApproved && rel.State() != release.StatePublishing {
+		return "", fmt.Errorf("%w: the execution plan is computed on approval (state %s); approve with relicta.approve first", release.ErrNotApproved, rel.State())
+	}
+
+	return toJSONString(executionPlanReport(rel)), nil
+}
+
+func (s *Server) handleTranscript(_ context.Context, input TranscriptToolInput) (string, error) {
+	if s.transcript == nil {
+		return toJSONString(map[string]any{
+			"status":  "not_configured",
+			"message": "Transcript recording is not available. Run 'relicta mcp serve' with a loaded configuration.",
+		}), nil
+	}
+
+	switch input.Action {
+	case "start":
+		s.transcript.SetEnabled(true)
+	case "stop":
+		s.transcript.SetEnabled(false)
+	case "", "status":
+	default:
+		return "", fmt.Errorf("invalid action %q: must be start, stop or status", input.Action)
+	}
+
+	status := s.transcript.Status()
+	return toJSONString(map[string]any{
+		"recording": status.Recording,
+		"file":      status.File,
+		"bytes":     status.Bytes,
+		"max_bytes": status.MaxBytes,
+		"truncated": status.Truncated,
+	}), nil
+}
+
 // Resource handlers
 
 func (s *Server) handleResourceState(ctx context.Context, uri string, params map[string]string) (*mcp.ResourceContent, error) {
@@ -1465,8 +2029,9 @@ func (s *Server) handleResourceConfig(ctx context.Context, uri string, params ma
   "product_name": %q,
   "ai_enabled": %t,
   "ai_provider": %q,
-  "versioning_strategy": %q
-}`, productName, s.config.AI.Enabled, s.config.AI.Provider, s.config.Versioning.Strategy)
+  "versioning_strategy": %q,
+  "profile": %q
+}`, productName, s.config.AI.Enabled, s.config.AI.Provider, s.config.Versioning.Strategy, s.config.Profile)
 
 	return &mcp.ResourceContent{
 		URI:      uri,
@@ -1712,6 +2277,252 @@ func (s *Server) handleResourceRiskReport(ctx context.Context, uri string, param
 	}, nil
 }
 
+func (s *Server) handleResourceApprovals(ctx context.Context, uri string, params map[string]string) (*mcp.ResourceContent, error) {
+	if s.releaseRepo == nil {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "no release repository configured"}`,
+		}, nil
+	}
+
+	releases, err := s.releaseRepo.FindActive(ctx)
+	if err != nil || len(releases) == 0 {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "no active release"}`,
+		}, nil
+	}
+
+	jsonBytes, err := json.MarshalIndent(approvalsReport(releases[0]), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode approvals: %w", err)
+	}
+
+	return &mcp.ResourceContent{
+		URI:      uri,
+		MimeType: "application/json",
+		Text:     string(jsonBytes),
+	}, nil
+}
+
+func (s *Server) handleResourceExecutionPlan(ctx context.Context, uri string, params map[string]string) (*mcp.ResourceContent, error) {
+	if s.releaseRepo == nil {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "no release repository configured"}`,
+		}, nil
+	}
+
+	releases, err := s.releaseRepo.FindActive(ctx)
+	if err != nil || len(releases) == 0 {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "no active release"}`,
+		}, nil
+	}
+
+	jsonBytes, err := json.MarshalIndent(executionPlanReport(releases[0]), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode execution plan: %w", err)
+	}
+
+	return &mcp.ResourceContent{
+		URI:      uri,
+		MimeType: "application/json",
+		Text:     string(jsonBytes),
+	}, nil
+}
+
+// maxMetricsHistory bounds the release records summarized by relicta://metrics.
+const maxMetricsHistory = 1000
+
+func (s *Server) handleResourceMetrics(ctx context.Context, uri string, params map[string]string) (*mcp.ResourceContent, error) {
+	if s.memoryStore == nil {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "release memory disabled", "hint": "set governance.memory_enabled to track release history"}`,
+		}, nil
+	}
+
+	records, err := s.memoryStore.GetReleaseHistory(ctx, s.memoryRepository, maxMetricsHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release history: %w", err)
+	}
+	if len(records) == 0 {
+		return &mcp.ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     `{"status": "no release history", "total_releases": 0}`,
+		}, nil
+	}
+
+	metrics := memory.SummarizeReleases(records, memory.DefaultMetricsWindow)
+	result := map[string]any{
+		"status":             "ok",
+		"repository":         s.memoryRepository,
+		"total_releases":     metrics.TotalReleases,
+		"releases_by_bump":   metrics.ReleasesByBump,
+		"auto_approved":      metrics.AutoApproved,
+		"manually_approved":  metrics.ManuallyApproved,
+		"auto_approve_ratio": metrics.AutoApproveRatio,
+		"window":             metrics.Window,
+		"average_risk_score": metrics.AverageRiskScore,
+		"failure_rate":       metrics.FailureRate,
+	}
+
+	// Put the active release in context of the recent ones
+	if s.releaseRepo != nil {
+		if releases, err := s.releaseRepo.FindActive(ctx); err == nil && len(releases) > 0 {
+			current := releases[0].RiskScore()
+			result["current_risk_score"] = current
+			result["risk_vs_average"] = current - metrics.AverageRiskScore
+		}
+	}
+
+	jsonBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode metrics: %w", err)
+	}
+
+	return &mcp.ResourceContent{
+		URI:      uri,
+		MimeType: "application/json",
+		Text:     string(jsonBytes),
+	}, nil
+}
+
+// executionPlanReport describes the publish steps of a release run in
+// execution order. The plan is computed on approval, so it is empty before.
+func executionPlanReport(rel *release.ReleaseRun) map[string]any {
+	steps := make([]map[string]any, 0, len(rel.Steps()))
+	plugins := []string{}
+	seen := make(map[string]bool)
+	for i, step := range rel.Steps() {
+		entry := map[string]any{
+			"order":  i + 1,
+			"name":   step.Name,
+			"type":   string(step.Type),
+			"unsafe": step.Unsafe,
+		}
+		if step.PluginName != "" {
+			entry["plugin"] = step.PluginName
+		}
+		if step.Hook != "" {
+			entry["hook"] = step.Hook
+		}
+		if step.Step != "" {
+			entry["step"] = step.Step
+		}
+		if step.Package != "" {
+			entry["package"] = step.Package
+		}
+		if step.TagName != "" {
+			entry["tag_name"] = step.TagName
+		}
+
+		state := release.StepPending
+		if status := rel.StepStatus(step.Name); status != nil {
+			state = status.State
+			entry["attempts"] = status.Attempts
+			if status.LastError != "" {
+				entry["last_error"] = status.LastError
+			}
+		}
+		willRun := state == release.StepPending || state == release.StepFailed
+		entry["status"] = string(state)
+		entry["will_run"] = willRun
+		steps = append(steps, entry)
+
+		if willRun && step.PluginName != "" && !seen[step.PluginName] {
+			seen[step.PluginName] = true
+			plugins = append(plugins, step.PluginName)
+		}
+	}
+
+	version := ""
+	if !rel.VersionNext().IsZero() {
+		version = rel.VersionNext().String()
+	}
+
+	return map[string]any{
+		"status":     "ok",
+		"release_id": string(rel.ID()),
+		"state":      rel.State().String(),
+		"version":    version,
+		"steps":      steps,
+		"plugins":    plugins,
+	}
+}
+
+// approvalsReport describes the approval state of a release run. Runs with a
+// multi-level approval policy report each level; other runs report the
+// single approval and whether the risk score allows auto-approval.
+func approvalsReport(rel *release.ReleaseRun) map[string]any {
+	status := rel.ApprovalStatus()
+	result := map[string]any{
+		"status":           "ok",
+		"release_id":       string(rel.ID()),
+		"state":            rel.State().String(),
+		"approved":         rel.IsApproved(),
+		"can_approve":      status.CanApprove,
+		"reason":           status.Reason,
+		"risk_score":       rel.RiskScore(),
+		"can_auto_approve": rel.CanAutoApprove(),
+	}
+
+	ml := rel.MultiLevelApprovalStatus()
+	if ml == nil {
+		result["mode"] = "single"
+		if approval := rel.Approval(); approval != nil {
+			result["approved_by"] = approval.ApprovedBy
+			result["approved_at"] = approval.ApprovedAt.Format(time.RFC3339)
+			result["auto_approved"] = approval.AutoApproved
+		}
+		return result
+	}
+
+	requirements := make([]map[string]any, 0, len(ml.Policy.Requirements))
+	for _, req := range ml.Policy.Requirements {
+		entry := map[string]any{
+			"level":       string(req.Level),
+			"description": req.Description,
+			"required":    req.Required,
+			"granted":     false,
+		}
+		if len(req.AllowedBy) > 0 {
+			entry["allowed_by"] = req.AllowedBy
+		}
+		if approval := ml.GetApproval(req.Level); approval != nil {
+			entry["granted"] = true
+			entry["approved_by"] = approval.ApprovedBy
+			entry["approved_at"] = approval.ApprovedAt.Format(time.RFC3339)
+			if approval.Justification != "" {
+				entry["justification"] = approval.Justification
+			}
+		}
+		requirements = append(requirements, entry)
+	}
+	granted, pending := approvalLevels(ml)
+
+	result["mode"] = "multi_level"
+	result["sequential"] = ml.Policy.Sequential
+	result["fully_approved"] = ml.IsFullyApproved()
+	result["requirements"] = requirements
+	result["granted_levels"] = granted
+	result["pending_levels"] = pending
+	if ml.Policy.Sequential {
+		if next := ml.NextRequiredLevel(); next != nil {
+			result["next_required_level"] = string(next.Level)
+		}
+	}
+	return result
+}
+
 // Prompt handlers
 
 func (s *Server) handlePromptReleaseSummary(ctx context.Context, args map[string]string) (*mcp.PromptResult, error) {
@@ -1927,6 +2738,47 @@ Include code examples for all significant changes.`
 	}, nil
 }
 
+func (s *Server) handlePromptDeprecationTimeline(ctx context.Context, args map[string]string) (*mcp.PromptResult, error) {
+	horizon := "the next major version"
+	// Without an explicit horizon, removals wait for a major version; a
+	// given horizon (e.g. "2 minor versions") is followed as is.
+	majorRule := "- Never schedule a removal outside a major version unless the project is below 1.0.0.\n"
+	if v, ok := args["horizon"]; ok && strings.TrimSpace(v) != "" {
+		horizon = strings.TrimSpace(v)
+		majorRule = ""
+	}
+
+	content := fmt.Sprintf(`You are a release manager scheduling the deprecation and removal of APIs.
+
+Using the commit history of this release and earlier releases, identify deprecated APIs from:
+- Commits that mention deprecation (e.g. "deprecate", "@deprecated", "Deprecated:")
+- Breaking-change markers ("!" after the commit type or a BREAKING CHANGE footer) that remove or replace APIs
+- Commits that introduce replacements for existing APIs
+
+Produce a Markdown table with these columns:
+
+| API | Deprecated In | Planned Removal | Replacement | Notes |
+
+- **API**: the function, type, endpoint, flag or configuration key
+- **Deprecated In**: the version in which it was first deprecated
+- **Planned Removal**: the version in which it will be removed, %s after the deprecation version
+- **Replacement**: the recommended alternative, or "none" if it is removed without replacement
+- **Notes**: migration hints or links to the relevant commits
+
+Rules:
+%s- APIs already removed by a breaking change in this release should be listed with their removal version and marked as removed.
+- If no deprecations are found, say so and suggest candidates based on the breaking changes.
+
+After the table, add a short summary of upcoming removals ordered by planned removal version.`, horizon, majorRule)
+
+	return &mcp.PromptResult{
+		Description: "Deprecation timeline prompt",
+		Messages: []mcp.PromptMessage{
+			{Role: "user", Content: mcp.TextContent{Type: "text", Text: content}},
+		},
+	}, nil
+}
+
 func (s *Server) handlePromptReleaseAnnouncement(ctx context.Context, args map[string]string) (*mcp.PromptResult, error) {
 	channel := "github"
 	if v, ok := args["channel"]; ok && v != "" {
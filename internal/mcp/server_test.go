@@ -3,18 +3,30 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/felixgeelhaar/mcp-go"
+	"github.com/felixgeelhaar/mcp-go/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/relicta-tech/relicta/internal/cgp/memory"
 	"github.com/relicta-tech/relicta/internal/cgp/risk"
 	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/changes"
 	domainrelease "github.com/relicta-tech/relicta/internal/domain/release"
+	releaseadapters "github.com/relicta-tech/relicta/internal/domain/release/adapters"
+	releaseapp "github.com/relicta-tech/relicta/internal/domain/release/app"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
+	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
 	"github.com/relicta-tech/relicta/internal/domain/version"
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+	servicerelease "github.com/relicta-tech/relicta/internal/service/release"
 )
 
 // parseJSONResult parses a JSON string result into a map for test assertions
@@ -107,6 +119,24 @@ func TestNewServer(t *testing.T) {
 	})
 }
 
+func TestToolSchemasInlineRepository(t *testing.T) {
+	server, err := NewServer("1.0.0")
+	require.NoError(t, err)
+
+	tools := server.server.Tools()
+	require.NotEmpty(t, tools)
+	for _, tool := range tools {
+		inputSchema, ok := tool.InputSchema.(*schema.Schema)
+		require.True(t, ok, tool.Name)
+		assert.NotContains(t, inputSchema.Properties, "RepositoryInput", tool.Name)
+		if tool.Name == "relicta.status" || tool.Name == "relicta.plan" {
+			require.Contains(t, inputSchema.Properties, "repository", tool.Name)
+			assert.Equal(t, "string", inputSchema.Properties["repository"].Type)
+			assert.Contains(t, inputSchema.Properties["repository"].Description, "Path to the target repository")
+		}
+	}
+}
+
 func TestServerOptions(t *testing.T) {
 	t.Run("WithGitService", func(t *testing.T) {
 		server, err := NewServer("1.0.0", WithGitService(nil))
@@ -347,6 +377,32 @@ func TestHandleEvaluate(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "risk calculator not configured")
 	})
+
+	t.Run("marks advisory evaluations", func(t *testing.T) {
+		strictCfg := config.DefaultConfig()
+		strictCfg.Governance.StrictMode = true
+		server, err := NewServer("1.0.0", WithConfig(strictCfg))
+		require.NoError(t, err)
+
+		resultStr, err := server.handleEvaluate(ctx, EvaluateToolInput{})
+		require.NoError(t, err)
+		result := parseJSONResult(t, resultStr)
+		assert.Equal(t, false, result["advisory"])
+		assert.NotContains(t, result, "advisory_reason")
+
+		resultStr, err = server.handleEvaluate(ctx, EvaluateToolInput{Advisory: true})
+		require.NoError(t, err)
+		result = parseJSONResult(t, resultStr)
+		assert.Equal(t, true, result["advisory"])
+		assert.Contains(t, result["advisory_reason"], "requested for this evaluation")
+
+		strictCfg.Governance.StrictMode = false
+		resultStr, err = server.handleEvaluate(ctx, EvaluateToolInput{})
+		require.NoError(t, err)
+		result = parseJSONResult(t, resultStr)
+		assert.Equal(t, true, result["advisory"])
+		assert.Contains(t, result["advisory_reason"], "strict mode is disabled")
+	})
 }
 
 func TestResourceHandlers(t *testing.T) {
@@ -550,6 +606,30 @@ func TestPromptHandlers(t *testing.T) {
 		assert.Len(t, result.Messages, 1)
 	})
 
+	t.Run("deprecation-timeline with default horizon", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		result, err := server.handlePromptDeprecationTimeline(ctx, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, "Deprecation timeline prompt", result.Description)
+		text := result.Messages[0].Content.(mcp.TextContent).Text
+		assert.Contains(t, text, "| API | Deprecated In | Planned Removal | Replacement | Notes |")
+		assert.Contains(t, text, "the next major version after the deprecation version")
+		assert.Contains(t, text, "Never schedule a removal outside a major version")
+	})
+
+	t.Run("deprecation-timeline with horizon", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		result, err := server.handlePromptDeprecationTimeline(ctx, map[string]string{"horizon": "2 minor versions"})
+		require.NoError(t, err)
+		text := result.Messages[0].Content.(mcp.TextContent).Text
+		assert.Contains(t, text, "2 minor versions after the deprecation version")
+		assert.NotContains(t, text, "Never schedule a removal outside a major version")
+	})
+
 	t.Run("approval-decision prompt", func(t *testing.T) {
 		server, err := NewServer("1.0.0")
 		require.NoError(t, err)
@@ -673,6 +753,20 @@ func TestHandleNotesWithAdapter(t *testing.T) {
 		result := parseJSONResult(t, resultStr)
 		assert.Equal(t, false, result["use_ai"])
 	})
+
+	t.Run("rejects notes_text combined with ai or regenerate", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithAdapter(NewAdapter()))
+		require.NoError(t, err)
+
+		for _, input := range []NotesToolInput{
+			{NotesText: "## Notes", AI: true},
+			{NotesText: "## Notes", Regenerate: true},
+		} {
+			_, err := server.handleNotes(ctx, input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "notes_text cannot be combined")
+		}
+	})
 }
 
 func TestHandleApproveWithAdapter(t *testing.T) {
@@ -690,6 +784,308 @@ func TestHandleApproveWithAdapter(t *testing.T) {
 	})
 }
 
+func TestHandleApproveLevel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires a level", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithAdapter(NewAdapter()))
+		require.NoError(t, err)
+
+		_, err = server.handleApproveLevel(ctx, ApproveLevelToolInput{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "level is required")
+	})
+
+	t.Run("returns status with adapter but no release services", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithAdapter(NewAdapter()))
+		require.NoError(t, err)
+
+		resultStr, err := server.handleApproveLevel(ctx, ApproveLevelToolInput{Level: "security"})
+		require.NoError(t, err)
+		result := parseJSONResult(t, resultStr)
+		assert.Equal(t, "security", result["level"])
+		assert.Contains(t, result, "status")
+	})
+
+	t.Run("records the approver", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := releaseadapters.NewFileReleaseRunRepository()
+
+		rel := domainrelease.NewReleaseRunForTest("approve-level-run", "main", dir)
+		current, _ := version.Parse("1.0.0")
+		next, _ := version.Parse("1.1.0")
+		require.NoError(t, domainrelease.SetPlan(rel, domainrelease.NewReleasePlan(current, next, changes.ReleaseTypeMinor, nil, false)))
+		require.NoError(t, rel.SetVersion(next, "v1.1.0"))
+		require.NoError(t, rel.Bump("system"))
+		require.NoError(t, rel.GenerateNotes(&domainrelease.ReleaseNotes{Text: "## Changes"}, "inputs-hash", "system"))
+		require.NoError(t, repo.Save(ctx, rel))
+		require.NoError(t, repo.SetLatest(ctx, dir, rel.ID()))
+
+		services := &domainrelease.Services{
+			ApproveRelease: releaseapp.NewApproveReleaseUseCase(repo, nil, nil, nil),
+			GetStatus:      releaseapp.NewGetStatusUseCase(repo, noHeadInspector{}),
+			Repository:     repo,
+		}
+		policy := domainrelease.ApprovalPolicy{Requirements: []domainrelease.ApprovalRequirement{
+			{Level: domainrelease.ApprovalLevelTechnical, Required: true},
+			{Level: domainrelease.ApprovalLevelRelease, Required: true},
+		}}
+		cfg := config.DefaultConfig()
+		cfg.MCP.Actor = "release-bot"
+		server, err := NewServer("1.0.0",
+			WithConfig(cfg),
+			WithRepositoryRoot(dir),
+			WithAdapter(NewAdapter(WithReleaseServices(services), WithRepoRoot(dir), WithApprovalPolicy(policy))),
+		)
+		require.NoError(t, err)
+
+		resultStr, err := server.handleApproveLevel(ctx, ApproveLevelToolInput{Level: "technical", Approver: "review-agent"})
+		require.NoError(t, err)
+		assert.Equal(t, "review-agent", parseJSONResult(t, resultStr)["approved_by"])
+
+		// The same approver cannot grant another level of the run
+		_, err = server.handleApproveLevel(ctx, ApproveLevelToolInput{Level: "release", Approver: "review-agent"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), domainrelease.ErrApproverAlreadyApproved.Error())
+
+		// Without an approver, the configured MCP actor approves
+		resultStr, err = server.handleApproveLevel(ctx, ApproveLevelToolInput{Level: "release"})
+		require.NoError(t, err)
+		result := parseJSONResult(t, resultStr)
+		assert.Equal(t, "release-bot", result["approved_by"])
+		assert.Equal(t, true, result["approved"])
+
+		saved, err := repo.LoadLatest(ctx, dir)
+		require.NoError(t, err)
+		approval := saved.MultiLevelApprovalStatus().GetApproval(domainrelease.ApprovalLevelTechnical)
+		require.NotNil(t, approval)
+		assert.Equal(t, "review-agent", approval.ApprovedBy)
+		assert.Equal(t, domainrelease.ActorAgent, approval.ApproverType)
+	})
+}
+
+// noHeadInspector is a repository inspector that cannot resolve HEAD.
+type noHeadInspector struct {
+	ports.RepoInspector
+}
+
+func (noHeadInspector) HeadSHA(context.Context) (domainrelease.CommitSHA, error) {
+	return "", errors.New("no HEAD")
+}
+
+func TestHandleResourceApprovals(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without repo", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		result, err := server.handleResourceApprovals(ctx, "relicta://approvals", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, "no release repository configured")
+	})
+
+	t.Run("without active release", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithReleaseRepository(&mockReleaseRepository{}))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceApprovals(ctx, "relicta://approvals", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, "no active release")
+	})
+
+	t.Run("single-level release", func(t *testing.T) {
+		repo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{createTestReleaseRun()}}
+		server, err := NewServer("1.0.0", WithReleaseRepository(repo))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceApprovals(ctx, "relicta://approvals", nil)
+		require.NoError(t, err)
+		data := parseJSONResult(t, result.Text)
+		assert.Equal(t, "single", data["mode"])
+		assert.Equal(t, false, data["approved"])
+		assert.Contains(t, data, "can_auto_approve")
+		assert.NotContains(t, data, "requirements")
+	})
+
+	t.Run("multi-level release", func(t *testing.T) {
+		rel := createTestReleaseRunWithVersion()
+		require.NoError(t, rel.Bump("system"))
+		require.NoError(t, rel.GenerateNotes(&domainrelease.ReleaseNotes{Text: "notes"}, "inputs-hash", "system"))
+		rel.SetApprovalPolicy(domainrelease.HighRiskApprovalPolicy())
+		require.NoError(t, rel.ApproveAtLevel(domainrelease.ApprovalLevelTechnical, "alice", domainrelease.ActorHuman, "reviewed"))
+
+		repo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{rel}}
+		server, err := NewServer("1.0.0", WithReleaseRepository(repo))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceApprovals(ctx, "relicta://approvals", nil)
+		require.NoError(t, err)
+		data := parseJSONResult(t, result.Text)
+		assert.Equal(t, "multi_level", data["mode"])
+		assert.Equal(t, true, data["sequential"])
+		assert.Equal(t, false, data["fully_approved"])
+		assert.Equal(t, []any{"technical"}, data["granted_levels"])
+		assert.Equal(t, []any{"security", "release"}, data["pending_levels"])
+		assert.Equal(t, "security", data["next_required_level"])
+
+		requirements, ok := data["requirements"].([]any)
+		require.True(t, ok)
+		require.Len(t, requirements, 3)
+		first := requirements[0].(map[string]any)
+		assert.Equal(t, true, first["granted"])
+		assert.Equal(t, "alice", first["approved_by"])
+		assert.Equal(t, "reviewed", first["justification"])
+		assert.NotEmpty(t, first["approved_at"])
+	})
+}
+
+// createApprovedReleaseRun returns an approved release whose execution plan
+// tags the release and runs the publish step of the npm plugin.
+func createApprovedReleaseRun(t *testing.T) *domainrelease.ReleaseRun {
+	t.Helper()
+	rel := createTestReleaseRunWithVersion()
+	require.NoError(t, rel.Bump("system"))
+	require.NoError(t, rel.GenerateNotes(&domainrelease.ReleaseNotes{Text: "notes"}, "inputs-hash", "system"))
+	rel.SetExecutionPlan([]domainrelease.StepPlan{
+		{Name: "create-tag", Type: domainrelease.StepTypeTag},
+		{Name: "npm:publish", Type: domainrelease.StepTypePlugin, PluginName: "npm", Step: "publish"},
+	})
+	require.NoError(t, rel.Approve("alice", false))
+	return rel
+}
+
+func TestHandleResourceExecutionPlan(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without repo", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		result, err := server.handleResourceExecutionPlan(ctx, "relicta://plan", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, "no release repository configured")
+	})
+
+	t.Run("approved release", func(t *testing.T) {
+		repo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{createApprovedReleaseRun(t)}}
+		server, err := NewServer("1.0.0", WithReleaseRepository(repo))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceExecutionPlan(ctx, "relicta://plan", nil)
+		require.NoError(t, err)
+		data := parseJSONResult(t, result.Text)
+		assert.Equal(t, []any{"npm"}, data["plugins"])
+
+		steps, ok := data["steps"].([]any)
+		require.True(t, ok)
+		require.Len(t, steps, 2)
+		tag := steps[0].(map[string]any)
+		assert.Equal(t, "create-tag", tag["name"])
+		assert.Equal(t, "tag", tag["type"])
+		assert.Equal(t, "pending", tag["status"])
+		assert.Equal(t, true, tag["will_run"])
+		npm := steps[1].(map[string]any)
+		assert.Equal(t, "npm:publish", npm["name"])
+		assert.Equal(t, "npm", npm["plugin"])
+		assert.Equal(t, false, npm["unsafe"])
+	})
+}
+
+func TestHandleDryRunPlan(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not configured", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		resultStr, err := server.handleDryRunPlan(ctx, DryRunPlanToolInput{})
+		require.NoError(t, err)
+		assert.Equal(t, "not_configured", parseJSONResult(t, resultStr)["status"])
+	})
+
+	t.Run("release not approved", func(t *testing.T) {
+		repo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{createTestReleaseRun()}}
+		server, err := NewServer("1.0.0", WithReleaseRepository(repo))
+		require.NoError(t, err)
+
+		_, err = server.handleDryRunPlan(ctx, DryRunPlanToolInput{})
+		require.ErrorIs(t, err, domainrelease.ErrNotApproved)
+	})
+
+	t.Run("approved release", func(t *testing.T) {
+		rel := createApprovedReleaseRun(t)
+		repo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{rel}}
+		server, err := NewServer("1.0.0", WithReleaseRepository(repo))
+		require.NoError(t, err)
+
+		resultStr, err := server.handleDryRunPlan(ctx, DryRunPlanToolInput{})
+		require.NoError(t, err)
+		data := parseJSONResult(t, resultStr)
+		assert.Equal(t, string(rel.ID()), data["release_id"])
+		assert.Equal(t, []any{"npm"}, data["plugins"])
+
+		// Previewing does not execute any step
+		assert.Equal(t, domainrelease.StepPending, rel.StepStatus("npm:publish").State)
+		assert.Equal(t, domainrelease.StateApproved, rel.State())
+	})
+}
+
+func TestHandleResourceMetrics(t *testing.T) {
+	ctx := context.Background()
+	const repo = "https://github.com/test/repo"
+
+	t.Run("memory disabled", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		result, err := server.handleResourceMetrics(ctx, "relicta://metrics", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, "release memory disabled")
+	})
+
+	t.Run("empty memory", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithMemoryStore(memory.NewInMemoryStore(), repo))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceMetrics(ctx, "relicta://metrics", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, "no release history")
+	})
+
+	t.Run("with history", func(t *testing.T) {
+		store := memory.NewInMemoryStore()
+		records := []*memory.ReleaseRecord{
+			{ID: "r1", RiskScore: 0.2, Outcome: memory.OutcomeSuccess,
+				Metadata: map[string]string{memory.MetadataBumpKind: "minor", memory.MetadataAutoApproved: "true"}},
+			{ID: "r2", RiskScore: 0.6, Outcome: memory.OutcomeFailed,
+				Metadata: map[string]string{memory.MetadataBumpKind: "major", memory.MetadataAutoApproved: "false"}},
+		}
+		for i, r := range records {
+			r.Repository = repo
+			r.Version = "1.0.0"
+			r.ReleasedAt = time.Now().Add(time.Duration(i) * time.Hour)
+			require.NoError(t, store.RecordRelease(ctx, r))
+		}
+
+		releaseRepo := &mockReleaseRepository{releases: []*domainrelease.ReleaseRun{createTestReleaseRun()}}
+		server, err := NewServer("1.0.0", WithMemoryStore(store, repo), WithReleaseRepository(releaseRepo))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceMetrics(ctx, "relicta://metrics", nil)
+		require.NoError(t, err)
+		data := parseJSONResult(t, result.Text)
+		assert.Equal(t, "ok", data["status"])
+		assert.Equal(t, float64(2), data["total_releases"])
+		assert.Equal(t, map[string]any{"minor": float64(1), "major": float64(1)}, data["releases_by_bump"])
+		assert.InDelta(t, 0.5, data["auto_approve_ratio"], 0.001)
+		assert.InDelta(t, 0.4, data["average_risk_score"], 0.001)
+		assert.InDelta(t, 0.5, data["failure_rate"], 0.001)
+		assert.Contains(t, data, "current_risk_score")
+		assert.Contains(t, data, "risk_vs_average")
+	})
+}
+
 func TestHandlePublishWithAdapter(t *testing.T) {
 	ctx := context.Background()
 
@@ -789,6 +1185,47 @@ func TestHandlePlanWithFromRef(t *testing.T) {
 	})
 }
 
+func TestHandlePlanWithSince(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects invalid since", func(t *testing.T) {
+		adapter := NewAdapter(WithReleaseAnalyzer(&servicerelease.Analyzer{}))
+		server, err := NewServer("1.0.0", WithAdapter(adapter))
+		require.NoError(t, err)
+
+		_, err = server.handlePlan(ctx, PlanToolInput{Since: "last week"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid since value")
+	})
+}
+
+func TestHandlePlanWithNonConventional(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects unknown policy", func(t *testing.T) {
+		adapter := NewAdapter(WithReleaseAnalyzer(&servicerelease.Analyzer{}))
+		server, err := NewServer("1.0.0", WithAdapter(adapter))
+		require.NoError(t, err)
+
+		_, err = server.handlePlan(ctx, PlanToolInput{NonConventional: "drop"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "drop")
+	})
+}
+
+func TestNonConventionalResult(t *testing.T) {
+	result := nonConventionalResult(servicerelease.NonConventionalSummary{
+		Policy:  servicerelease.NonConventionalPatch,
+		Commits: []sourcecontrol.CommitHash{"abc123", "def456"},
+	})
+	assert.Equal(t, 2, result["count"])
+	assert.Equal(t, "patch", result["policy"])
+
+	result = nonConventionalResult(servicerelease.NonConventionalSummary{})
+	assert.Equal(t, 0, result["count"])
+	assert.Equal(t, "infer", result["policy"])
+}
+
 func TestResourceStateWithCache(t *testing.T) {
 	ctx := context.Background()
 
@@ -1237,6 +1674,18 @@ func TestResourceConfigWithDifferentSettings(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, result.Text, "Relicta")
 	})
+
+	t.Run("includes active profile", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Profile = "staging"
+
+		server, err := NewServer("1.0.0", WithConfig(cfg))
+		require.NoError(t, err)
+
+		result, err := server.handleResourceConfig(ctx, "relicta://config", nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Text, `"profile": "staging"`)
+	})
 }
 
 func TestResourceChangelogWithVersionedRelease(t *testing.T) {
@@ -1742,114 +2191,163 @@ func TestHandleEvaluateWithAdapterAndGovernance(t *testing.T) {
 }
 
 // Test for issue #35: MCP server release state not persisted between tool calls
-// The fix ensures consistent repository path handling across all MCP tool calls.
+// The fix resolves the repository root per tool call and carries it in the
+// request context instead of relying on the process working directory.
+
+// newTestRepo creates a directory that looks like a git repository root.
+func newTestRepo(t *testing.T) string {
+	t.Helper()
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(root, ".git"), 0o755))
+	return root
+}
 
 func TestEnsureRepoPath(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("defaults to current dir when git service unavailable", func(t *testing.T) {
+	t.Run("defaults to current dir when no repository is known", func(t *testing.T) {
 		adapter := NewAdapter()
 		server, err := NewServer("1.0.0", WithAdapter(adapter))
 		require.NoError(t, err)
 
-		repoPath := server.ensureRepoPath(ctx)
-
+		reqCtx, repoPath, err := server.ensureRepoPath(ctx, "")
+		require.NoError(t, err)
 		assert.Equal(t, ".", repoPath)
-		assert.Equal(t, ".", adapter.GetRepoRoot())
+		assert.Equal(t, ".", adapter.repoRootFor(reqCtx))
+		assert.Equal(t, "", adapter.GetRepoRoot(), "shared adapter state must not change")
 	})
 
 	t.Run("handles nil adapter gracefully", func(t *testing.T) {
 		server, err := NewServer("1.0.0")
 		require.NoError(t, err)
 
-		// Should not panic with nil adapter
-		repoPath := server.ensureRepoPath(ctx)
+		_, repoPath, err := server.ensureRepoPath(ctx, "")
+		require.NoError(t, err)
 		assert.Equal(t, ".", repoPath)
 	})
 
-	t.Run("updates adapter repoRoot when called", func(t *testing.T) {
-		adapter := NewAdapter()
-		server, err := NewServer("1.0.0", WithAdapter(adapter))
+	t.Run("uses the bound repository root", func(t *testing.T) {
+		repo := newTestRepo(t)
+		server, err := NewServer("1.0.0", WithRepositoryRoot(repo))
 		require.NoError(t, err)
 
-		// Initially adapter has empty repoRoot
-		assert.Equal(t, "", adapter.GetRepoRoot())
+		reqCtx, repoPath, err := server.ensureRepoPath(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, repo, repoPath)
+		assert.Equal(t, repo, repoRootFromContext(reqCtx))
+	})
 
-		// ensureRepoPath should set it
-		_ = server.ensureRepoPath(ctx)
+	t.Run("resolves a subdirectory argument to the repository root", func(t *testing.T) {
+		repo := newTestRepo(t)
+		sub := filepath.Join(repo, "packages", "core")
+		require.NoError(t, os.MkdirAll(sub, 0o755))
+		server, err := NewServer("1.0.0", WithRepositoryRoot(repo))
+		require.NoError(t, err)
+
+		_, repoPath, err := server.ensureRepoPath(ctx, sub)
+		require.NoError(t, err)
+		assert.Equal(t, repo, repoPath)
+	})
 
-		// Now adapter has repoRoot set
-		assert.NotEqual(t, "", adapter.GetRepoRoot())
+	t.Run("rejects a repository the server does not serve", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithRepositoryRoot(newTestRepo(t)))
+		require.NoError(t, err)
+
+		_, _, err = server.ensureRepoPath(ctx, newTestRepo(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not served by this MCP server")
+	})
+
+	t.Run("rejects a path outside any repository", func(t *testing.T) {
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		_, _, err = server.ensureRepoPath(ctx, t.TempDir())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not inside a git repository")
 	})
 }
 
 func TestConsistentRepoPathAcrossToolCalls(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("all tool handlers set adapter repoRoot consistently", func(t *testing.T) {
-		adapter := NewAdapter()
-		server, err := NewServer("1.0.0", WithAdapter(adapter))
+	t.Run("tools run from a different working directory use the repository", func(t *testing.T) {
+		repo := newTestRepo(t)
+		t.Chdir(t.TempDir())
+
+		_, err := persistence.NewFreezeStore(repo).Freeze("incident INC-42", "alice")
+		require.NoError(t, err)
+
+		adapter := NewAdapter(WithRepoRoot(repo))
+		server, err := NewServer("1.0.0", WithAdapter(adapter), WithRepositoryRoot(repo))
+		require.NoError(t, err)
+
+		resultStr, err := server.handleValidateRelease(ctx, ValidateReleaseToolInput{})
+		require.NoError(t, err)
+		assert.Contains(t, resultStr, "incident INC-42", "freeze in the repository must be found from another CWD")
+
+		resultStr, err = server.handleInit(ctx, InitToolInput{})
+		require.NoError(t, err)
+		assert.Equal(t, "created", parseJSONResult(t, resultStr)["status"])
+		assert.FileExists(t, filepath.Join(repo, ".relicta.yaml"))
+		assert.NoFileExists(t, ".relicta.yaml", "config must not be written to the process CWD")
+	})
+
+	t.Run("repository argument targets the repository without a bound root", func(t *testing.T) {
+		repo := newTestRepo(t)
+		t.Chdir(t.TempDir())
+
+		server, err := NewServer("1.0.0")
+		require.NoError(t, err)
+
+		resultStr, err := server.handleInit(ctx, InitToolInput{RepositoryInput: RepositoryInput{Repository: repo}, Format: "json"})
+		require.NoError(t, err)
+		assert.Equal(t, "created", parseJSONResult(t, resultStr)["status"])
+		assert.FileExists(t, filepath.Join(repo, ".relicta.json"))
+	})
+
+	t.Run("repository argument for another repository is rejected", func(t *testing.T) {
+		server, err := NewServer("1.0.0", WithAdapter(NewAdapter()), WithRepositoryRoot(newTestRepo(t)))
 		require.NoError(t, err)
 
-		// Call various handlers and verify adapter repoRoot is set
-		// Each handler should call ensureRepoPath which sets adapter.repoRoot
-
-		_, _ = server.handleStatus(ctx, StatusInput{})
-		statusPath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", statusPath, "handleStatus should set repoRoot")
-
-		adapter.SetRepoRoot("") // Reset
-		_, _ = server.handlePlan(ctx, PlanToolInput{})
-		planPath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", planPath, "handlePlan should set repoRoot")
-		assert.Equal(t, statusPath, planPath, "paths should be consistent")
-
-		adapter.SetRepoRoot("") // Reset
-		_, _ = server.handleBump(ctx, BumpToolInput{})
-		bumpPath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", bumpPath, "handleBump should set repoRoot")
-		assert.Equal(t, statusPath, bumpPath, "paths should be consistent")
-
-		adapter.SetRepoRoot("") // Reset
-		_, _ = server.handleNotes(ctx, NotesToolInput{})
-		notesPath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", notesPath, "handleNotes should set repoRoot")
-		assert.Equal(t, statusPath, notesPath, "paths should be consistent")
-
-		adapter.SetRepoRoot("") // Reset
-		_, _ = server.handleApprove(ctx, ApproveToolInput{})
-		approvePath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", approvePath, "handleApprove should set repoRoot")
-		assert.Equal(t, statusPath, approvePath, "paths should be consistent")
-
-		adapter.SetRepoRoot("") // Reset
-		_, _ = server.handlePublish(ctx, PublishToolInput{})
-		publishPath := adapter.GetRepoRoot()
-		assert.NotEqual(t, "", publishPath, "handlePublish should set repoRoot")
-		assert.Equal(t, statusPath, publishPath, "paths should be consistent")
-	})
-
-	t.Run("issue 35: state persists across plan and notes calls", func(t *testing.T) {
-		// This test verifies the fix for issue #35:
-		// When plan is called, it sets repoRoot on the adapter.
-		// When notes is called, it should use the same repoRoot to find the release.
+		_, err = server.handlePlan(ctx, PlanToolInput{RepositoryInput: RepositoryInput{Repository: newTestRepo(t)}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not served by this MCP server")
+	})
+
+	t.Run("concurrent requests do not share repository state", func(t *testing.T) {
 		adapter := NewAdapter()
 		server, err := NewServer("1.0.0", WithAdapter(adapter))
 		require.NoError(t, err)
 
-		// Simulate the workflow that was failing
-		_, _ = server.handlePlan(ctx, PlanToolInput{})
-		pathAfterPlan := adapter.GetRepoRoot()
-
-		// Notes should use the same path
-		_, _ = server.handleNotes(ctx, NotesToolInput{})
-		pathAfterNotes := adapter.GetRepoRoot()
+		repoA, repoB := newTestRepo(t), newTestRepo(t)
+		ctxA, _, err := server.ensureRepoPath(ctx, repoA)
+		require.NoError(t, err)
+		ctxB, _, err := server.ensureRepoPath(ctx, repoB)
+		require.NoError(t, err)
 
-		// The key fix: paths should be the same
-		assert.Equal(t, pathAfterPlan, pathAfterNotes,
-			"plan and notes should use the same repository path")
+		assert.Equal(t, repoA, adapter.repoRootFor(ctxA))
+		assert.Equal(t, repoB, adapter.repoRootFor(ctxB))
 	})
 }
 
 // Suppress unused variable warnings
 var _ = time.Now
+
+func TestLockState(t *testing.T) {
+	repo := t.TempDir()
+
+	release, err := lockState(repo, "relicta.bump")
+	require.NoError(t, err)
+
+	_, err = lockState(repo, "relicta.publish")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "another relicta operation is in progress")
+	assert.Contains(t, err.Error(), "mcp relicta.bump")
+
+	release()
+	release, err = lockState(repo, "relicta.publish")
+	require.NoError(t, err)
+	release()
+}
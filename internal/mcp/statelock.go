@@ -0,0 +1,16 @@
+package mcp
+
+import (
+	"github.com/relicta-tech/relicta/internal/infrastructure/persistence"
+)
+
+// lockState takes the state lock of the repository at repoPath for a tool
+// call that mutates the release state. The caller must invoke the returned
+// release function when the call completes.
+func lockState(repoPath, tool string) (release func(), err error) {
+	release, err = persistence.NewStateLock(repoPath, 0).Acquire("mcp " + tool)
+	if err != nil {
+		return nil, userError(err)
+	}
+	return release, nil
+}
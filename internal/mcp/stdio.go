@@ -0,0 +1,351 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/felixgeelhaar/mcp-go/protocol"
+)
+
+// maxMessageSize bounds a single JSON-RPC line (including batches) read from
+// the client.
+const maxMessageSize = 10 * 1024 * 1024
+
+// batchIDPrefix marks the request IDs the framer assigns to batch elements.
+const batchIDPrefix = "relicta-batch-"
+
+// innerServeFunc serves single JSON-RPC messages read from in and writes the
+// responses to out, one message per line.
+type innerServeFunc func(ctx context.Context, in io.Reader, out io.Writer) error
+
+// stdioFramer adds JSON-RPC batch support in front of the mcp-go stdio
+// transport, which only understands one message per line. Single messages
+// are passed through unchanged and their responses written as they arrive.
+// The elements of a batch are forwarded in order under framer-assigned IDs,
+// and the batch response is written once every request in it is answered.
+// The inner transport handles messages one at a time, so batch elements
+// run in the order given.
+type stdioFramer struct {
+	out   io.Writer
+	inner io.Writer
+
+	// recorder, if set, records client and server lines.
+	recorder *TranscriptRecorder
+
+	mu      sync.Mutex // guards out, closed and written
+	written *sync.Cond // signaled when a line is written or the inner transport stops
+	closed  bool       // the inner transport stopped producing output
+
+	batchMu sync.Mutex           // guards pending and nextID
+	pending map[string]batchSlot // keyed by framer-assigned request ID
+	nextID  int64
+}
+
+// pendingBatch collects the responses of a batch.
+type pendingBatch struct {
+	responses []json.RawMessage // in element order; nil while awaited
+	waiting   int
+}
+
+// batchSlot locates the response of a batch element.
+type batchSlot struct {
+	batch *pendingBatch
+	index int
+	id    json.RawMessage // the client's request ID
+}
+
+// serveFramed reads client messages from in, serves them through serve and
+// writes the responses to out. It returns when in reaches EOF, ctx is
+// canceled or the inner transport fails.
+func serveFramed(ctx context.Context, in io.Reader, out io.Writer, serve innerServeFunc, recorder *TranscriptRecorder) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	f, stop, err := startFramer(ctx, out, serve, recorder)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		_ = f.recorder.Record(TranscriptClient, line)
+		if err := f.handleLine(line); err != nil {
+			_ = stop()
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		_ = stop()
+		return err
+	}
+
+	// Client closed stdin; let the inner transport finish.
+	return stop()
+}
+
+// startFramer runs serve on a pair of pipes and returns a framer writing
+// to out. stop closes the inner transport's input and waits for it to exit.
+func startFramer(ctx context.Context, out io.Writer, serve innerServeFunc, recorder *TranscriptRecorder) (*stdioFramer, func() error, error) {
+	innerIn, toInner, err := os.Pipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create transport pipe: %w", err)
+	}
+	fromInner, innerOut, err := os.Pipe()
+	if err != nil {
+		_ = innerIn.Close()
+		_ = toInner.Close()
+		return nil, nil, fmt.Errorf("failed to create transport pipe: %w", err)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		err := serve(ctx, innerIn, innerOut)
+		_ = innerOut.Close()
+		serveErr <- err
+	}()
+
+	f := &stdioFramer{
+		out:      out,
+		inner:    toInner,
+		recorder: recorder,
+		pending:  make(map[string]batchSlot),
+	}
+	f.written = sync.NewCond(&f.mu)
+	pumped := make(chan struct{})
+	go func() {
+		defer close(pumped)
+		f.pump(fromInner)
+	}()
+
+	stop := func() error {
+		defer fromInner.Close()
+		_ = toInner.Close()
+
+		select {
+		case err := <-serveErr:
+			<-pumped
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return f, stop, nil
+}
+
+// pump routes messages written by the inner transport. Responses to batch
+// elements are collected into their batch; everything else goes straight to
+// the client.
+func (f *stdioFramer) pump(r io.Reader) {
+	defer func() {
+		f.mu.Lock()
+		f.closed = true
+		f.written.Broadcast()
+		f.mu.Unlock()
+	}()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	for scanner.Scan() {
+		line := append(json.RawMessage(nil), scanner.Bytes()...)
+		if !f.collect(line) {
+			f.write(line)
+		}
+	}
+}
+
+// collect stores line in its batch if it answers a batch element, writing
+// the batch response once it is complete. It reports whether line belonged
+// to a batch.
+func (f *stdioFramer) collect(line json.RawMessage) bool {
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(line, &msg); err != nil || msg["method"] != nil {
+		return false
+	}
+	var id string
+	if err := json.Unmarshal(msg["id"], &id); err != nil {
+		return false
+	}
+
+	f.batchMu.Lock()
+	slot, ok := f.pending[id]
+	if !ok {
+		f.batchMu.Unlock()
+		return false
+	}
+	delete(f.pending, id)
+	msg["id"] = slot.id
+	resp, err := json.Marshal(msg)
+	if err != nil {
+		resp = line
+	}
+	slot.batch.responses[slot.index] = resp
+	slot.batch.waiting--
+	done := slot.batch.waiting == 0
+	f.batchMu.Unlock()
+
+	if done {
+		f.writeBatch(slot.batch.responses)
+	}
+	return true
+}
+
+// handleLine handles a single message or a batch from the client. Single
+// messages are forwarded unchanged; the framer does not wait for their
+// responses.
+func (f *stdioFramer) handleLine(line []byte) error {
+	if line[0] != '[' {
+		return f.forward(line)
+	}
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(line, &elems); err != nil {
+		f.writeError(protocol.NewParseError(err.Error()))
+		return nil
+	}
+	if len(elems) == 0 {
+		f.writeError(protocol.NewInvalidRequest("empty batch"))
+		return nil
+	}
+
+	batch := &pendingBatch{responses: make([]json.RawMessage, len(elems))}
+	forwards := make([][]byte, 0, len(elems))
+	for i, elem := range elems {
+		msg, req, ok := parseBatchElement(elem)
+		if !ok {
+			batch.responses[i] = errorResponse(protocol.NewInvalidRequest("batch element must be an object"))
+			continue
+		}
+		if req.IsNotification() {
+			forwards = append(forwards, msg)
+			continue
+		}
+
+		// Requests are renamed so that their responses can be told apart
+		// from those of other messages in flight.
+		f.batchMu.Lock()
+		f.nextID++
+		id := batchIDPrefix + strconv.FormatInt(f.nextID, 10)
+		f.pending[id] = batchSlot{batch: batch, index: i, id: req.ID}
+		batch.waiting++
+		f.batchMu.Unlock()
+
+		renamed, err := withID(msg, id)
+		if err != nil {
+			return err
+		}
+		forwards = append(forwards, renamed)
+	}
+
+	// A batch of notifications gets no response at all.
+	if batch.waiting == 0 && len(forwards) < len(elems) {
+		f.writeBatch(batch.responses)
+	}
+
+	for _, msg := range forwards {
+		if err := f.forward(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseBatchElement compacts a batch element and parses it the way the
+// inner transport will. Elements that are not request or notification
+// objects are rejected.
+func parseBatchElement(elem json.RawMessage) ([]byte, *protocol.Request, bool) {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, elem); err != nil || compact.Bytes()[0] != '{' {
+		return nil, nil, false
+	}
+	var req protocol.Request
+	if err := json.Unmarshal(compact.Bytes(), &req); err != nil {
+		return nil, nil, false
+	}
+	return compact.Bytes(), &req, true
+}
+
+// withID returns msg with its ID replaced by id.
+func withID(msg []byte, id string) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return nil, fmt.Errorf("failed to rename batch element: %w", err)
+	}
+	encoded, err := json.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+	fields["id"] = encoded
+	return json.Marshal(fields)
+}
+
+// forward sends one message to the inner transport.
+func (f *stdioFramer) forward(msg []byte) error {
+	line := make([]byte, 0, len(msg)+1)
+	line = append(append(line, msg...), '\n')
+	if _, err := f.inner.Write(line); err != nil {
+		return fmt.Errorf("failed to forward message: %w", err)
+	}
+	return nil
+}
+
+// expectsResponse reports whether the inner transport answers msg. It
+// answers every request and every message it cannot parse, but not
+// notifications.
+func expectsResponse(msg []byte) bool {
+	var req protocol.Request
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return true
+	}
+	return !req.IsNotification()
+}
+
+// errorResponse encodes an error response with a null ID, as JSON-RPC
+// requires when the request ID could not be determined.
+func errorResponse(e *protocol.Error) json.RawMessage {
+	data, _ := json.Marshal(protocol.NewErrorResponse(json.RawMessage("null"), e))
+	return data
+}
+
+func (f *stdioFramer) writeError(e *protocol.Error) {
+	f.write(errorResponse(e))
+}
+
+// writeBatch writes the responses of a batch. Notifications leave nil
+// slots, which are dropped.
+func (f *stdioFramer) writeBatch(responses []json.RawMessage) {
+	filled := make([]json.RawMessage, 0, len(responses))
+	for _, resp := range responses {
+		if resp != nil {
+			filled = append(filled, resp)
+		}
+	}
+	data, err := json.Marshal(filled)
+	if err != nil {
+		f.writeError(protocol.NewInternalError("failed to encode batch response"))
+		return
+	}
+	f.write(data)
+}
+
+func (f *stdioFramer) write(msg []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_ = f.recorder.Record(TranscriptServer, msg)
+	_, _ = f.out.Write(msg)
+	_, _ = f.out.Write([]byte("\n"))
+	f.written.Broadcast()
+}
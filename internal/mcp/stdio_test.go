@@ -0,0 +1,164 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServe is a stand-in for the mcp-go stdio transport. It answers each
+// request with its method, ignores notifications and reports parse errors,
+// sending a server notification before every tools/call response. Requests
+// for the "drop" method are never answered.
+func echoServe(_ context.Context, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			_, _ = io.WriteString(out, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`+"\n")
+			continue
+		}
+		if len(req.ID) == 0 || req.Method == "drop" {
+			continue
+		}
+		if req.Method == "tools/call" {
+			_, _ = io.WriteString(out, `{"jsonrpc":"2.0","method":"notifications/progress"}`+"\n")
+		}
+		resp, _ := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]string{"method": req.Method},
+		})
+		_, _ = out.Write(append(resp, '\n'))
+	}
+	return scanner.Err()
+}
+
+// serveLines runs serveFramed over the given client lines and returns the
+// lines written back to the client.
+func serveLines(t *testing.T, lines ...string) []string {
+	t.Helper()
+
+	var out bytes.Buffer
+	in := strings.NewReader(strings.Join(lines, "\n") + "\n")
+	require.NoError(t, serveFramed(context.Background(), in, &out, echoServe, nil))
+
+	output := strings.TrimSpace(out.String())
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
+
+func TestServeFramed_SingleMessage(t *testing.T) {
+	out := serveLines(t,
+		`{"jsonrpc":"2.0","id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+	)
+
+	require.Len(t, out, 1)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"method":"ping"}}`, out[0])
+}
+
+func TestServeFramed_UnansweredRequestDoesNotBlock(t *testing.T) {
+	out := serveLines(t,
+		`{"jsonrpc":"2.0","id":1,"method":"drop"}`,
+		`{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":1}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+	)
+
+	require.Len(t, out, 1)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":2,"result":{"method":"ping"}}`, out[0])
+}
+
+func TestServeFramed_MixedBatch(t *testing.T) {
+	out := serveLines(t, `[`+
+		`{"jsonrpc":"2.0","id":1,"method":"tools/list"},`+
+		`{"jsonrpc":"2.0","method":"notifications/initialized"},`+
+		`{"jsonrpc":"2.0","id":"two","method":"tools/call"},`+
+		`{"jsonrpc":"2.0","method":"notifications/cancelled"},`+
+		`{"jsonrpc":"2.0","id":3,"method":"resources/list"}`+
+		`]`)
+
+	// The server notification is passed through before the batch response.
+	require.Len(t, out, 2)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"notifications/progress"}`, out[0])
+
+	var responses []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out[1]), &responses))
+	require.Len(t, responses, 3, "notifications must not produce responses")
+	assert.Equal(t, float64(1), responses[0]["id"])
+	assert.Equal(t, "two", responses[1]["id"])
+	assert.Equal(t, float64(3), responses[2]["id"])
+	assert.Equal(t, map[string]any{"method": "tools/call"}, responses[1]["result"])
+}
+
+func TestServeFramed_NotificationOnlyBatch(t *testing.T) {
+	out := serveLines(t, `[{"jsonrpc":"2.0","method":"notifications/initialized"},{"jsonrpc":"2.0","method":"notifications/cancelled"}]`)
+	assert.Empty(t, out)
+}
+
+func TestServeFramed_InvalidElementDoesNotAbortBatch(t *testing.T) {
+	out := serveLines(t, `[{"jsonrpc":"2.0","id":1,"method":"ping"},42,{"jsonrpc":"2.0","id":2,"method":"tools/list"}]`)
+
+	require.Len(t, out, 1)
+	var responses []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out[0]), &responses))
+	require.Len(t, responses, 3)
+	assert.Equal(t, float64(1), responses[0]["id"])
+	assert.Contains(t, responses[1], "id")
+	assert.Nil(t, responses[1]["id"])
+	assert.NotNil(t, responses[1]["error"])
+	assert.Equal(t, float64(2), responses[2]["id"])
+	assert.NotNil(t, responses[2]["result"])
+}
+
+func TestServeFramed_InvalidBatch(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		code float64
+	}{
+		{name: "empty batch", line: `[]`, code: -32600},
+		{name: "malformed batch", line: `[{"jsonrpc":"2.0",`, code: -32700},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := serveLines(t, tt.line)
+
+			require.Len(t, out, 1)
+			var resp map[string]any
+			require.NoError(t, json.Unmarshal([]byte(out[0]), &resp))
+			errObj, ok := resp["error"].(map[string]any)
+			require.True(t, ok, "expected error response, got %s", out[0])
+			assert.Equal(t, tt.code, errObj["code"])
+		})
+	}
+}
+
+func TestServeFramed_Server(t *testing.T) {
+	server, err := NewServer("1.0.0")
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	in := strings.NewReader(`[{"jsonrpc":"2.0","id":1,"method":"ping"},{"jsonrpc":"2.0","id":2,"method":"tools/list"}]` + "\n")
+	require.NoError(t, serveFramed(context.Background(), in, &out, server.serveInner, nil))
+
+	var responses []map[string]any
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(out.Bytes()), &responses))
+	require.Len(t, responses, 2)
+	assert.Equal(t, float64(1), responses[0]["id"])
+	assert.Equal(t, float64(2), responses[1]["id"])
+	assert.NotNil(t, responses[1]["result"])
+}
@@ -0,0 +1,241 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	relictaerrors "github.com/relicta-tech/relicta/internal/errors"
+)
+
+// DefaultTranscriptMaxBytes is the default transcript size limit.
+const DefaultTranscriptMaxBytes = 10 * 1024 * 1024
+
+// Transcript directions.
+const (
+	// TranscriptClient marks a line sent by the client.
+	TranscriptClient = "client"
+	// TranscriptServer marks a line sent by the server.
+	TranscriptServer = "server"
+)
+
+// redactedValue replaces the values of secret-looking fields.
+const redactedValue = "[REDACTED]"
+
+// TranscriptEntry is one line of an MCP session transcript.
+type TranscriptEntry struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Message   json.RawMessage `json:"message"`
+}
+
+// TranscriptRecorder records MCP traffic to a JSONL file with secrets
+// redacted. The file is created on the first recorded message and
+// truncated, so each server session starts a new transcript. Recording
+// stops once the file reaches its size limit.
+type TranscriptRecorder struct {
+	mu        sync.Mutex
+	path      string
+	maxBytes  int64
+	enabled   bool
+	file      *os.File
+	written   int64
+	truncated bool
+}
+
+// TranscriptStatus describes the state of a TranscriptRecorder.
+type TranscriptStatus struct {
+	Recording bool   `json:"recording"`
+	File      string `json:"file"`
+	Bytes     int64  `json:"bytes"`
+	MaxBytes  int64  `json:"max_bytes"`
+	Truncated bool   `json:"truncated"`
+}
+
+// NewTranscriptRecorder creates a recorder writing to path. A maxBytes of
+// zero or less uses DefaultTranscriptMaxBytes.
+func NewTranscriptRecorder(path string, maxBytes int64, enabled bool) *TranscriptRecorder {
+	if maxBytes <= 0 {
+		maxBytes = DefaultTranscriptMaxBytes
+	}
+	return &TranscriptRecorder{
+		path:     path,
+		maxBytes: maxBytes,
+		enabled:  enabled,
+	}
+}
+
+// SetEnabled starts or stops recording.
+func (r *TranscriptRecorder) SetEnabled(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.enabled = enabled
+}
+
+// Status returns the current recorder state.
+func (r *TranscriptRecorder) Status() TranscriptStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return TranscriptStatus{
+		Recording: r.enabled && !r.truncated,
+		File:      r.path,
+		Bytes:     r.written,
+		MaxBytes:  r.maxBytes,
+		Truncated: r.truncated,
+	}
+}
+
+// Record appends a message to the transcript if recording is enabled.
+// Failures are returned but never affect the MCP session.
+func (r *TranscriptRecorder) Record(direction string, msg []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.enabled || r.truncated {
+		return nil
+	}
+
+	line, err := json.Marshal(TranscriptEntry{
+		Time:      time.Now().UTC(),
+		Direction: direction,
+		Message:   redactMessage(msg),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode transcript entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	if r.written+int64(len(line)) > r.maxBytes {
+		r.truncated = true
+		return nil
+	}
+
+	if r.file == nil {
+		if err := os.MkdirAll(filepath.Dir(r.path), 0o700); err != nil {
+			return fmt.Errorf("failed to create transcript directory: %w", err)
+		}
+		file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open transcript: %w", err)
+		}
+		r.file = file
+	}
+
+	n, err := r.file.Write(line)
+	r.written += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write transcript: %w", err)
+	}
+	return nil
+}
+
+// Close closes the transcript file.
+func (r *TranscriptRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// ReadTranscript reads the entries of a JSONL transcript.
+func ReadTranscript(rd io.Reader) ([]TranscriptEntry, error) {
+	var entries []TranscriptEntry
+
+	scanner := bufio.NewScanner(rd)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry TranscriptEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("invalid transcript entry on line %d: %w", lineNo, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read transcript: %w", err)
+	}
+
+	return entries, nil
+}
+
+// redactMessage removes secrets from a JSON-RPC message. Values of
+// secret-looking fields are replaced and known token formats are redacted
+// from all strings. Lines that are not valid JSON are stored as a redacted
+// JSON string.
+func redactMessage(msg []byte) json.RawMessage {
+	dec := json.NewDecoder(bytes.NewReader(msg))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		data, _ := json.Marshal(relictaerrors.RedactSensitive(string(msg)))
+		return data
+	}
+
+	data, err := json.Marshal(redactValue(v))
+	if err != nil {
+		data, _ = json.Marshal(relictaerrors.RedactSensitive(string(msg)))
+	}
+	return data
+}
+
+// redactValue redacts a decoded JSON value.
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if isSecretField(k) {
+				val[k] = redactedValue
+				continue
+			}
+			val[k] = redactValue(item)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = redactValue(item)
+		}
+		return val
+	case string:
+		return relictaerrors.RedactSensitive(val)
+	default:
+		return v
+	}
+}
+
+// isSecretField reports whether a field name suggests a secret value.
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if name == "progresstoken" {
+		return false
+	}
+	for _, s := range []string{"token", "secret", "password", "api_key", "apikey", "authorization", "credential"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
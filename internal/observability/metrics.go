@@ -35,6 +35,9 @@ type Metrics struct {
 	commandLatencyCount map[string]*atomic.Int64
 	commandLatencySum   map[string]*atomic.Int64
 
+	// Release state machine metrics
+	release releaseMetrics
+
 	// Info
 	version   string
 	startTime time.Time
@@ -236,6 +239,11 @@ func (m *Metrics) Handler() http.Handler {
 		}
 		m.mu.RUnlock()
 
+		sb.WriteString("\n")
+
+		// Release phases and outcomes
+		m.writeReleaseMetrics(&sb)
+
 		_, _ = w.Write([]byte(sb.String()))
 	})
 }
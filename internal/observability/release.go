@@ -0,0 +1,162 @@
+package observability
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+// phaseBuckets are the histogram upper bounds, in seconds, for the time a
+// release run spends in a state. Phases range from seconds (automated
+// pipelines) to days (waiting for approval).
+var phaseBuckets = []float64{1, 10, 60, 300, 900, 3600, 4 * 3600, 24 * 3600, 7 * 24 * 3600}
+
+// histogram is a Prometheus-style cumulative histogram.
+type histogram struct {
+	buckets []int64 // counts per bucket in phaseBuckets, non-cumulative
+	count   int64
+	sum     float64
+}
+
+func (h *histogram) observe(seconds float64) {
+	if h.buckets == nil {
+		h.buckets = make([]int64, len(phaseBuckets))
+	}
+	for i, le := range phaseBuckets {
+		if seconds <= le {
+			h.buckets[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// releaseMetrics holds release state machine metrics.
+type releaseMetrics struct {
+	mu     sync.Mutex
+	phases map[string]*histogram // keyed by state
+	runs   map[runKey]int64
+	active map[release.RunID]struct{} // runs counted in the active gauge
+}
+
+type runKey struct {
+	repo    string
+	outcome string
+}
+
+// RecordPhaseDuration records the time a release run spent in a state.
+func (m *Metrics) RecordPhaseDuration(state string, d time.Duration) {
+	m.release.mu.Lock()
+	defer m.release.mu.Unlock()
+
+	if m.release.phases == nil {
+		m.release.phases = make(map[string]*histogram)
+	}
+	h := m.release.phases[state]
+	if h == nil {
+		h = &histogram{}
+		m.release.phases[state] = h
+	}
+	h.observe(d.Seconds())
+}
+
+// RecordRunOutcome records a release run finishing
+// (published, failed or canceled) for a repository.
+func (m *Metrics) RecordRunOutcome(repo, outcome string) {
+	m.release.mu.Lock()
+	defer m.release.mu.Unlock()
+
+	if m.release.runs == nil {
+		m.release.runs = make(map[runKey]int64)
+	}
+	m.release.runs[runKey{repo: repo, outcome: outcome}]++
+}
+
+// ObserveTransition implements release.TransitionObserver. It records the
+// time spent in the previous state, counts finished runs,
+// and tracks the number of active runs.
+func (m *Metrics) ObserveTransition(repoID string, runID release.RunID, record release.TransitionRecord, inState time.Duration) {
+	if record.From == record.To {
+		return
+	}
+
+	m.RecordPhaseDuration(string(record.From), inState)
+	m.trackActiveRun(runID, record.To.IsActive())
+
+	if record.To.IsFinished() {
+		m.RecordRunOutcome(repoID, string(record.To))
+	}
+}
+
+// trackActiveRun updates the active releases gauge for a run. A run is
+// counted once while active and uncounted only if it was counted, so runs
+// that became active before observation started never drive it negative.
+func (m *Metrics) trackActiveRun(runID release.RunID, active bool) {
+	m.release.mu.Lock()
+	defer m.release.mu.Unlock()
+
+	_, counted := m.release.active[runID]
+	switch {
+	case active && !counted:
+		if m.release.active == nil {
+			m.release.active = make(map[release.RunID]struct{})
+		}
+		m.release.active[runID] = struct{}{}
+		m.IncrementActiveReleases()
+	case !active && counted:
+		delete(m.release.active, runID)
+		m.DecrementActiveReleases()
+	}
+}
+
+// writeReleaseMetrics writes the release state machine metrics in
+// Prometheus text format.
+func (m *Metrics) writeReleaseMetrics(sb *strings.Builder) {
+	m.release.mu.Lock()
+	defer m.release.mu.Unlock()
+
+	sb.WriteString("# HELP relicta_release_phase_duration_seconds Time a release run spent in a state\n")
+	sb.WriteString("# TYPE relicta_release_phase_duration_seconds histogram\n")
+	states := make([]string, 0, len(m.release.phases))
+	for state := range m.release.phases {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	for _, state := range states {
+		h := m.release.phases[state]
+		var cumulative int64
+		for i, le := range phaseBuckets {
+			cumulative += h.buckets[i]
+			sb.WriteString(fmt.Sprintf("relicta_release_phase_duration_seconds_bucket{state=%q,le=\"%g\"} %d\n", state, le, cumulative))
+		}
+		sb.WriteString(fmt.Sprintf("relicta_release_phase_duration_seconds_bucket{state=%q,le=\"+Inf\"} %d\n", state, h.count))
+		sb.WriteString(fmt.Sprintf("relicta_release_phase_duration_seconds_sum{state=%q} %g\n", state, h.sum))
+		sb.WriteString(fmt.Sprintf("relicta_release_phase_duration_seconds_count{state=%q} %d\n", state, h.count))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("# HELP relicta_release_runs_total Release runs reaching a finished state\n")
+	sb.WriteString("# TYPE relicta_release_runs_total counter\n")
+	keys := make([]runKey, 0, len(m.release.runs))
+	for k := range m.release.runs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].repo != keys[j].repo {
+			return keys[i].repo < keys[j].repo
+		}
+		return keys[i].outcome < keys[j].outcome
+	})
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf("relicta_release_runs_total{repo=%q,outcome=%q} %d\n", k.repo, k.outcome, m.release.runs[k]))
+	}
+	sb.WriteString("\n")
+}
+
+// Ensure Metrics implements release.TransitionObserver.
+var _ release.TransitionObserver = (*Metrics)(nil)
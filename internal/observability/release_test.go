@@ -0,0 +1,92 @@
+package observability
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/domain/release"
+)
+
+func TestMetrics_ObserveTransition(t *testing.T) {
+	m := NewMetrics("1.0.0")
+	repo := "github.com/test/repo"
+
+	transitions := []struct {
+		from, to release.RunState
+		inState  time.Duration
+	}{
+		{release.StateDraft, release.StatePlanned, 2 * time.Second},
+		{release.StatePlanned, release.StateVersioned, 30 * time.Second},
+		{release.StateVersioned, release.StateNotesReady, 5 * time.Second},
+		{release.StateNotesReady, release.StateApproved, 2 * time.Hour},
+		{release.StateApproved, release.StatePublishing, time.Second},
+		{release.StatePublishing, release.StatePublished, 90 * time.Second},
+	}
+
+	for i, tr := range transitions {
+		m.ObserveTransition(repo, "run-1", release.TransitionRecord{From: tr.from, To: tr.to}, tr.inState)
+		if i == 0 && m.Snapshot().ActiveReleases != 1 {
+			t.Errorf("ActiveReleases after plan = %d, want 1", m.Snapshot().ActiveReleases)
+		}
+	}
+	if got := m.Snapshot().ActiveReleases; got != 0 {
+		t.Errorf("ActiveReleases after publish = %d, want 0", got)
+	}
+
+	// A failed run on another repository
+	m.ObserveTransition("github.com/test/other", "run-2", release.TransitionRecord{From: release.StateDraft, To: release.StatePlanned}, time.Second)
+	m.ObserveTransition("github.com/test/other", "run-2", release.TransitionRecord{From: release.StatePlanned, To: release.StateFailed}, time.Second)
+
+	// Same-state records (e.g. re-approval) are not phase transitions
+	m.ObserveTransition(repo, "run-1", release.TransitionRecord{From: release.StateApproved, To: release.StateApproved}, time.Hour)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, req)
+	body := w.Body.String()
+
+	want := []string{
+		"# TYPE relicta_release_phase_duration_seconds histogram",
+		`relicta_release_phase_duration_seconds_bucket{state="notes_ready",le="3600"} 0`,
+		`relicta_release_phase_duration_seconds_bucket{state="notes_ready",le="14400"} 1`,
+		`relicta_release_phase_duration_seconds_bucket{state="planned",le="+Inf"} 2`,
+		`relicta_release_phase_duration_seconds_count{state="draft"} 2`,
+		`relicta_release_phase_duration_seconds_sum{state="publishing"} 90`,
+		`relicta_release_runs_total{repo="github.com/test/repo",outcome="published"} 1`,
+		`relicta_release_runs_total{repo="github.com/test/other",outcome="failed"} 1`,
+	}
+	for _, s := range want {
+		if !strings.Contains(body, s) {
+			t.Errorf("metrics output missing %q", s)
+		}
+	}
+	if strings.Contains(body, `state="approved",le="+Inf"} 2`) {
+		t.Error("same-state transition should not be recorded as a phase")
+	}
+}
+
+func TestMetrics_ObserveTransition_ActiveReleasesPaired(t *testing.T) {
+	m := NewMetrics("1.0.0")
+	repo := "github.com/test/repo"
+
+	// A run planned before observation started finishes: it was never
+	// counted, so the gauge must not go negative.
+	m.ObserveTransition(repo, "run-old", release.TransitionRecord{From: release.StatePublishing, To: release.StatePublished}, time.Second)
+	if got := m.Snapshot().ActiveReleases; got != 0 {
+		t.Fatalf("ActiveReleases = %d, want 0", got)
+	}
+
+	// A run first observed mid-flight is counted once and uncounted when done.
+	m.ObserveTransition(repo, "run-mid", release.TransitionRecord{From: release.StatePlanned, To: release.StateVersioned}, time.Second)
+	m.ObserveTransition(repo, "run-mid", release.TransitionRecord{From: release.StateVersioned, To: release.StateNotesReady}, time.Second)
+	if got := m.Snapshot().ActiveReleases; got != 1 {
+		t.Fatalf("ActiveReleases = %d, want 1", got)
+	}
+	m.ObserveTransition(repo, "run-mid", release.TransitionRecord{From: release.StateNotesReady, To: release.StateCanceled}, time.Second)
+	if got := m.Snapshot().ActiveReleases; got != 0 {
+		t.Fatalf("ActiveReleases = %d, want 0", got)
+	}
+}
@@ -94,6 +94,15 @@ type SpanContext struct {
 	SpanID  string
 }
 
+// TraceParent returns the span context as a W3C traceparent header value
+// (e.g. "00-<trace-id>-<span-id>-01"), or "" if the context is not valid.
+func (sc SpanContext) TraceParent() string {
+	if len(sc.TraceID) != 32 || len(sc.SpanID) != 16 {
+		return ""
+	}
+	return fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID)
+}
+
 // Tracer creates spans for tracing operations.
 type Tracer interface {
 	// Start creates a new span and returns it along with a new context.
@@ -414,6 +423,9 @@ const (
 	AttrRepositoryName  = "repository.name"
 	AttrPluginName      = "plugin.name"
 	AttrPluginHook      = "plugin.hook"
+	AttrPluginSuccess   = "plugin.success"
+	AttrPluginDuration  = "plugin.duration_ms"
+	AttrPluginTimeout   = "plugin.timeout"
 	AttrCommandName     = "command.name"
 	AttrGitBranch       = "git.branch"
 	AttrGitCommit       = "git.commit"
@@ -40,10 +40,37 @@ func (a *ExecutorAdapter) ExecuteHook(ctx context.Context, hook integration.Hook
 }
 
 // ExecutePlugin executes a specific plugin.
-// Note: Individual plugin execution by ID is not currently supported.
-// Use ExecuteHook to execute all plugins for a given hook instead.
+// Note: Individual plugin execution by ID is only supported for contributed
+// steps (req.Step set). Use ExecuteHook to execute all plugins for a given
+// hook instead.
 func (a *ExecutorAdapter) ExecutePlugin(ctx context.Context, id integration.PluginID, req integration.ExecuteRequest) (*integration.ExecuteResponse, error) {
-	return nil, fmt.Errorf("individual plugin execution by ID is not supported; use ExecuteHook instead")
+	if req.Step == "" {
+		return nil, fmt.Errorf("individual plugin execution by ID is not supported; use ExecuteHook instead")
+	}
+
+	resp, err := a.manager.ExecuteStep(ctx, string(id), req.Step, req.IdempotencyKey, toPluginReleaseContext(req.Context))
+	if err != nil {
+		return nil, err
+	}
+	return toIntegrationResponse(resp), nil
+}
+
+// PluginSteps returns the publish steps contributed by the managed plugins.
+func (a *ExecutorAdapter) PluginSteps(ctx context.Context) ([]integration.PluginStep, error) {
+	contributed, err := a.manager.PluginSteps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]integration.PluginStep, len(contributed))
+	for i, c := range contributed {
+		steps[i] = integration.PluginStep{
+			PluginID:    integration.PluginID(c.Plugin),
+			Name:        c.Name,
+			Description: c.Description,
+		}
+	}
+	return steps, nil
 }
 
 // toPluginReleaseContext converts domain ReleaseContext to plugin ReleaseContext.
@@ -56,8 +83,11 @@ func toPluginReleaseContext(ctx integration.ReleaseContext) plugin.ReleaseContex
 		RepositoryName:  ctx.RepositoryName,
 		Branch:          ctx.Branch,
 		TagName:         ctx.TagName,
+		CommitSHA:       ctx.CommitSHA,
 		Changelog:       ctx.Changelog,
 		ReleaseNotes:    ctx.ReleaseNotes,
+		Highlights:      ctx.Highlights,
+		IsPrerelease:    ctx.Version.IsPrerelease(),
 	}
 
 	// Convert changes if present
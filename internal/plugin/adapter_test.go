@@ -37,6 +37,7 @@ func TestToPluginReleaseContext(t *testing.T) {
 		RepositoryName:  "repo",
 		Branch:          "main",
 		TagName:         "v1.1.0",
+		CommitSHA:       "abc123def456",
 		Changelog:       "## Changes\n- Feature 1",
 		ReleaseNotes:    "New release",
 	}
@@ -64,6 +65,9 @@ func TestToPluginReleaseContext(t *testing.T) {
 	if result.TagName != "v1.1.0" {
 		t.Errorf("TagName = %v, want v1.1.0", result.TagName)
 	}
+	if result.CommitSHA != "abc123def456" {
+		t.Errorf("CommitSHA = %v, want abc123def456", result.CommitSHA)
+	}
 	if result.Changelog != "## Changes\n- Feature 1" {
 		t.Errorf("Changelog = %v, want ## Changes\\n- Feature 1", result.Changelog)
 	}
@@ -75,6 +79,36 @@ func TestToPluginReleaseContext(t *testing.T) {
 	}
 }
 
+func TestToPluginReleaseContext_Prerelease(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"1.2.3", false},
+		{"1.2.3+build.5", false},
+		{"1.2.3-rc.1", true},
+		{"2.0.0-alpha", true},
+		{"0.1.0-beta.2+sha.abc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			ctx := integration.ReleaseContext{Version: version.MustParse(tt.version)}
+
+			result := toPluginReleaseContext(ctx)
+
+			if result.IsPrerelease != tt.want {
+				t.Errorf("IsPrerelease = %v, want %v", result.IsPrerelease, tt.want)
+			}
+			// Explicit plugin config still overrides the detected value.
+			parser := plugin.NewConfigParser(map[string]any{"prerelease": !tt.want})
+			if got := parser.GetBoolDefault("prerelease", result.IsPrerelease); got == tt.want {
+				t.Errorf("explicit prerelease config should override detected value %v", tt.want)
+			}
+		})
+	}
+}
+
 func TestToPluginReleaseContext_WithChanges(t *testing.T) {
 	currentVer := version.MustParse("1.0.0")
 	nextVer := version.MustParse("2.0.0")
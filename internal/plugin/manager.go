@@ -2,13 +2,16 @@
 package plugin
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +24,8 @@ import (
 
 	"github.com/relicta-tech/relicta/internal/config"
 	"github.com/relicta-tech/relicta/internal/errors"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+	"github.com/relicta-tech/relicta/internal/observability"
 	"github.com/relicta-tech/relicta/internal/plugin/audit"
 	pmgr "github.com/relicta-tech/relicta/internal/plugin/manager"
 	"github.com/relicta-tech/relicta/internal/plugin/sandbox"
@@ -50,17 +55,23 @@ type Manager struct {
 	pendingPlugins map[string]*config.PluginConfig // Registered but not yet loaded
 	loadOnce       map[string]*sync.Once           // Ensures each plugin loads only once
 	loadErrors     map[string]error                // Stores load errors for lazy-loaded plugins
+
+	// secrets resolves secret references in plugin configurations when
+	// they are sent to a plugin; stored configurations keep the references.
+	secrets *secrets.Resolver
 }
 
 // loadedPlugin represents a loaded and running plugin.
 type loadedPlugin struct {
-	name    string
-	client  *goplugin.Client
-	plugin  plugin.Plugin
-	info    plugin.Info
-	config  map[string]any
-	timeout time.Duration
-	sandbox *sandbox.Sandbox
+	name     string
+	client   *goplugin.Client
+	plugin   plugin.Plugin
+	info     plugin.Info
+	config   map[string]any
+	timeout  time.Duration
+	priority int
+	runAfter []string
+	sandbox  *sandbox.Sandbox
 }
 
 // NewManager creates a new plugin manager.
@@ -85,6 +96,7 @@ func NewManager(cfg *config.Config) *Manager {
 		pendingPlugins:   make(map[string]*config.PluginConfig, pluginCount),
 		loadOnce:         make(map[string]*sync.Once, pluginCount),
 		loadErrors:       make(map[string]error, pluginCount),
+		secrets:          secrets.NewDefaultResolver(),
 	}
 }
 
@@ -92,6 +104,10 @@ func NewManager(cfg *config.Config) *Manager {
 func (m *Manager) LoadPlugins(ctx context.Context) error {
 	const op = "plugin.LoadPlugins"
 
+	if err := config.CheckPluginDependencies(m.cfg.Plugins); err != nil {
+		return errors.PluginWrap(err, op, "invalid plugin run_after configuration")
+	}
+
 	for _, pluginCfg := range m.cfg.Plugins {
 		if !pluginCfg.IsEnabled() {
 			m.logger.Debug("plugin disabled", "name", pluginCfg.Name)
@@ -113,7 +129,12 @@ func (m *Manager) LoadPlugins(ctx context.Context) error {
 // RegisterPlugins registers all configured plugins for lazy loading.
 // Plugins are not actually loaded until they are needed (when a hook is executed).
 // This improves startup time for commands that don't use plugins.
-func (m *Manager) RegisterPlugins() {
+// It returns an error if the plugins' run_after declarations form a cycle.
+func (m *Manager) RegisterPlugins() error {
+	if err := config.CheckPluginDependencies(m.cfg.Plugins); err != nil {
+		return errors.PluginWrap(err, "plugin.RegisterPlugins", "invalid plugin run_after configuration")
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -128,6 +149,8 @@ func (m *Manager) RegisterPlugins() {
 		m.pendingPlugins[pluginCfg.Name] = pluginCfg
 		m.loadOnce[pluginCfg.Name] = &sync.Once{}
 	}
+
+	return nil
 }
 
 // ensurePluginLoaded ensures a plugin is loaded, loading it lazily if needed.
@@ -182,11 +205,58 @@ func (m *Manager) ensurePluginLoaded(ctx context.Context, name string) (*loadedP
 
 // loadPlugin loads a single plugin.
 func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) error {
+	const op = "plugin.Load"
+
+	lp, err := m.startPlugin(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Validate configuration
+	if cfg.Config != nil {
+		pluginConfig, err := m.secrets.ResolveMap(ctx, cfg.Config)
+		if err != nil {
+			lp.client.Kill()
+			return errors.PluginWrap(err, op, "failed to resolve plugin secrets")
+		}
+		resp, err := lp.plugin.Validate(ctx, pluginConfig)
+		if err != nil {
+			lp.client.Kill()
+			return errors.PluginWrap(err, op, "failed to validate plugin config")
+		}
+		if !resp.Valid {
+			lp.client.Kill()
+			var errMsgs []string
+			for _, e := range resp.Errors {
+				errMsgs = append(errMsgs, fmt.Sprintf("%s: %s", e.Field, e.Message))
+			}
+			return errors.Validation(op, fmt.Sprintf("invalid plugin configuration: %s", joinErrors(errMsgs)))
+		}
+	}
+
+	// Store loaded plugin
+	m.mu.Lock()
+	m.plugins[cfg.Name] = lp
+	m.mu.Unlock()
+
+	m.logger.Info("plugin loaded", "name", cfg.Name, "version", lp.info.Version, "hooks", lp.info.Hooks)
+
+	// Log successful load
+	_ = audit.LogLoad(ctx, cfg.Name, true, "")
+
+	return nil
+}
+
+// startPlugin starts a plugin process and connects to it without validating
+// its configuration. The caller owns the returned plugin's client.
+func (m *Manager) startPlugin(ctx context.Context, cfg *config.PluginConfig) (*loadedPlugin, error) {
+	const op = "plugin.Load"
+
 	// Find plugin binary
 	pluginPath, err := m.findPluginBinary(cfg)
 	if err != nil {
 		_ = audit.LogLoad(ctx, cfg.Name, false, err.Error())
-		return err
+		return nil, err
 	}
 
 	m.logger.Debug("loading plugin", "name", cfg.Name, "path", pluginPath)
@@ -196,7 +266,7 @@ func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) erro
 	if err := m.verifyPluginBinary(cfg.Name, pluginPath); err != nil {
 		m.logger.Warn("plugin integrity verification failed", "plugin", cfg.Name, "error", err)
 		_ = audit.LogLoad(ctx, cfg.Name, false, "integrity verification failed: "+err.Error())
-		return errors.Plugin("plugin.Load", fmt.Sprintf("integrity verification failed for %s: %v", cfg.Name, err))
+		return nil, errors.Plugin(op, fmt.Sprintf("integrity verification failed for %s: %v", cfg.Name, err))
 	}
 
 	// Create sandbox with capabilities from config
@@ -220,13 +290,11 @@ func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) erro
 		Logger:           m.logger.Named(cfg.Name),
 	})
 
-	const op = "plugin.Load"
-
 	// Connect to the plugin (this starts the process)
 	rpcClient, err := client.Client()
 	if err != nil {
 		client.Kill()
-		return errors.PluginWrap(err, op, "failed to connect to plugin")
+		return nil, errors.PluginWrap(err, op, "failed to connect to plugin")
 	}
 
 	// Apply resource limits to the running plugin process (Linux only)
@@ -245,33 +313,13 @@ func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) erro
 	raw, err := rpcClient.Dispense(plugin.PluginName)
 	if err != nil {
 		client.Kill()
-		return errors.PluginWrap(err, op, "failed to dispense plugin")
+		return nil, errors.PluginWrap(err, op, "failed to dispense plugin")
 	}
 
 	p, ok := raw.(plugin.Plugin)
 	if !ok {
 		client.Kill()
-		return errors.Plugin(op, "plugin does not implement Plugin interface")
-	}
-
-	// Get plugin info
-	info := p.GetInfo()
-
-	// Validate configuration
-	if cfg.Config != nil {
-		resp, err := p.Validate(ctx, cfg.Config)
-		if err != nil {
-			client.Kill()
-			return errors.PluginWrap(err, op, "failed to validate plugin config")
-		}
-		if !resp.Valid {
-			client.Kill()
-			var errMsgs []string
-			for _, e := range resp.Errors {
-				errMsgs = append(errMsgs, fmt.Sprintf("%s: %s", e.Field, e.Message))
-			}
-			return errors.Validation(op, fmt.Sprintf("invalid plugin configuration: %s", joinErrors(errMsgs)))
-		}
+		return nil, errors.Plugin(op, "plugin does not implement Plugin interface")
 	}
 
 	// Set timeout
@@ -280,25 +328,88 @@ func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) erro
 		timeout = 30 * time.Second
 	}
 
-	// Store loaded plugin
-	m.mu.Lock()
-	m.plugins[cfg.Name] = &loadedPlugin{
-		name:    cfg.Name,
-		client:  client,
-		plugin:  p,
-		info:    info,
-		config:  cfg.Config,
-		timeout: timeout,
-		sandbox: sb,
+	return &loadedPlugin{
+		name:     cfg.Name,
+		client:   client,
+		plugin:   p,
+		info:     p.GetInfo(),
+		config:   cfg.Config,
+		timeout:  timeout,
+		priority: cfg.Priority,
+		runAfter: cfg.RunAfter,
+		sandbox:  sb,
+	}, nil
+}
+
+// PluginValidation is the result of validating a plugin's configuration.
+type PluginValidation struct {
+	Name   string                   `json:"name"`
+	Valid  bool                     `json:"valid"`
+	Errors []plugin.ValidationError `json:"errors,omitempty"`
+	// Error is set when the plugin could not be started or validated.
+	Error string `json:"error,omitempty"`
+}
+
+// ValidatePlugins calls Validate on every enabled plugin with its effective
+// configuration and reports the result per plugin. Unlike loading, it does
+// not stop at the first invalid plugin and also validates plugins without
+// configuration. Plugins started only for validation are stopped afterwards.
+func (m *Manager) ValidatePlugins(ctx context.Context) []PluginValidation {
+	results := make([]PluginValidation, 0, len(m.cfg.Plugins))
+	for i := range m.cfg.Plugins {
+		pluginCfg := &m.cfg.Plugins[i]
+		if !pluginCfg.IsEnabled() {
+			continue
+		}
+		results = append(results, m.validatePlugin(ctx, pluginCfg))
 	}
-	m.mu.Unlock()
+	return results
+}
 
-	m.logger.Info("plugin loaded", "name", cfg.Name, "version", info.Version, "hooks", info.Hooks)
+// validatePlugin validates the configuration of a single plugin, starting
+// the plugin if it is not loaded.
+func (m *Manager) validatePlugin(ctx context.Context, cfg *config.PluginConfig) PluginValidation {
+	result := PluginValidation{Name: cfg.Name}
 
-	// Log successful load
-	_ = audit.LogLoad(ctx, cfg.Name, true, "")
+	m.mu.RLock()
+	lp, loaded := m.plugins[cfg.Name]
+	m.mu.RUnlock()
 
-	return nil
+	if !loaded {
+		started, err := m.startPlugin(ctx, cfg)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		defer started.client.Kill()
+		lp = started
+	}
+
+	validateCtx, cancel := context.WithTimeout(ctx, lp.timeout)
+	defer cancel()
+
+	pluginConfig, err := m.secrets.ResolveMap(validateCtx, lp.config)
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to resolve plugin secrets: %v", err)
+		return result
+	}
+	if pluginConfig == nil {
+		pluginConfig = map[string]any{}
+	}
+
+	resp, err := lp.plugin.Validate(validateCtx, pluginConfig)
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to validate plugin config: %v", err)
+		return result
+	}
+	if resp == nil {
+		result.Error = "plugin returned no validation response"
+		return result
+	}
+
+	result.Valid = resp.Valid
+	result.Errors = resp.Errors
+	return result
 }
 
 // allowedPluginDirs returns the list of allowed directories for plugin binaries.
@@ -320,23 +431,6 @@ func (m *Manager) allowedPluginDirs() []string {
 	}
 }
 
-// validatePluginName checks if the plugin name contains only allowed characters.
-func validatePluginName(name string) error {
-	// Only allow alphanumeric characters, hyphens, and underscores
-	for _, r := range name {
-		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
-			return fmt.Errorf("plugin name contains invalid character: %q", r)
-		}
-	}
-	if name == "" {
-		return fmt.Errorf("plugin name cannot be empty")
-	}
-	if len(name) > 64 {
-		return fmt.Errorf("plugin name too long (max 64 characters)")
-	}
-	return nil
-}
-
 // isPathInAllowedDir checks if the resolved path is within an allowed directory.
 // Uses filepath.Rel for robust directory containment checking to prevent bypass attacks.
 func (m *Manager) isPathInAllowedDir(resolvedPath string) bool {
@@ -407,7 +501,7 @@ func (m *Manager) validatePluginBinary(path string) error {
 // findPluginBinary finds the plugin binary with security validation.
 func (m *Manager) findPluginBinary(cfg *config.PluginConfig) (string, error) {
 	// Validate plugin name first to prevent path injection
-	if err := validatePluginName(cfg.Name); err != nil {
+	if err := config.ValidatePluginName(cfg.Name); err != nil {
 		return "", fmt.Errorf("invalid plugin name: %w", err)
 	}
 
@@ -458,10 +552,12 @@ func (m *Manager) findPluginBinary(cfg *config.PluginConfig) (string, error) {
 // pluginExecInfo contains the information needed to execute a plugin.
 // This allows us to release the lock before making expensive RPC calls.
 type pluginExecInfo struct {
-	name    string
-	plugin  plugin.Plugin
-	config  map[string]any
-	timeout time.Duration
+	name     string
+	plugin   plugin.Plugin
+	config   map[string]any
+	timeout  time.Duration
+	priority int
+	runAfter []string
 }
 
 // pluginResult holds the result of a parallel plugin execution.
@@ -470,9 +566,12 @@ type pluginResult struct {
 	response plugin.ExecuteResponse
 }
 
-// ExecuteHook executes all plugins for a given hook in parallel.
-// Plugins are executed concurrently for improved performance.
-// Results are returned in a stable order (same order as plugin registration).
+// ExecuteHook executes all plugins for a given hook in priority order.
+// Plugins with a higher priority run first; plugins sharing a priority are
+// executed concurrently for improved performance. A plugin never starts
+// before the plugins it declares in run_after, and the outputs of plugins
+// that already completed are passed on via ReleaseContext.PluginOutputs.
+// Results are returned in execution order.
 // A global timeout is applied to prevent runaway execution.
 func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx plugin.ReleaseContext) ([]plugin.ExecuteResponse, error) {
 	// Collect plugins to execute while holding the lock briefly
@@ -482,6 +581,9 @@ func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx
 		return nil, nil
 	}
 
+	// Order into stages honoring run_after dependencies and priority
+	toExecute, stages := executionStages(toExecute)
+
 	// Apply global timeout for all plugin executions
 	// This prevents the entire hook execution from taking too long
 	globalCtx, globalCancel := context.WithTimeout(ctx, MaxGlobalHookTimeout)
@@ -493,104 +595,146 @@ func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx
 	// Channel for collecting results from parallel execution
 	// Buffered to prevent goroutine leaks on context cancellation
 	resultsChan := make(chan pluginResult, len(toExecute))
+	indexedResults := make([]pluginResult, 0, len(toExecute))
 
-	// Use errgroup for coordinated parallel execution
-	g, gCtx := errgroup.WithContext(globalCtx)
-
-	// Execute plugins in parallel with rate limiting
-	for i, exec := range toExecute {
-		i, exec := i, exec // Capture loop variables
-		g.Go(func() error {
-			// Check for context cancellation before acquiring semaphore
-			select {
-			case <-gCtx.Done():
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   fmt.Sprintf("execution canceled: %v", gCtx.Err()),
-					},
-				}
-				return nil
-			default:
-			}
+	// Outputs of completed plugins, exposed to later stages
+	outputs := maps.Clone(releaseCtx.PluginOutputs)
+	if outputs == nil {
+		outputs = make(map[string]map[string]any)
+	}
 
-			// Acquire execution slot from semaphore (rate limiting)
-			if err := m.executionLimiter.Acquire(gCtx, 1); err != nil {
-				m.logger.Error("failed to acquire execution slot", "plugin", exec.name, "error", err)
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   fmt.Sprintf("failed to acquire execution slot: %v", err),
-					},
+	// Execute stages in order; plugins within a stage run in parallel
+	for _, group := range stages {
+		stageCtx := releaseCtx
+		if len(outputs) > 0 {
+			stageCtx.PluginOutputs = maps.Clone(outputs)
+		}
+
+		// Use errgroup for coordinated parallel execution
+		g, gCtx := errgroup.WithContext(globalCtx)
+
+		// Execute plugins in parallel with rate limiting
+		for _, i := range group {
+			i, exec := i, toExecute[i] // Capture loop variables
+			g.Go(func() error {
+				// Check for context cancellation before acquiring semaphore
+				select {
+				case <-gCtx.Done():
+					resultsChan <- pluginResult{
+						index: i,
+						response: plugin.ExecuteResponse{
+							Success: false,
+							Error:   fmt.Sprintf("execution canceled: %v", gCtx.Err()),
+						},
+					}
+					return nil
+				default:
 				}
-				return nil
-			}
-			defer m.executionLimiter.Release(1)
 
-			m.logger.Debug("executing hook", "plugin", exec.name, "hook", hook)
+				// Acquire execution slot from semaphore (rate limiting)
+				if err := m.executionLimiter.Acquire(gCtx, 1); err != nil {
+					m.logger.Error("failed to acquire execution slot", "plugin", exec.name, "error", err)
+					resultsChan <- pluginResult{
+						index: i,
+						response: plugin.ExecuteResponse{
+							Success: false,
+							Error:   fmt.Sprintf("failed to acquire execution slot: %v", err),
+						},
+					}
+					return nil
+				}
+				defer m.executionLimiter.Release(1)
+
+				m.logger.Debug("executing hook", "plugin", exec.name, "hook", hook)
+
+				// Execute with per-plugin timeout (capped by global context)
+				execCtx, cancel := context.WithTimeout(gCtx, exec.timeout)
+				defer cancel()
+
+				// Trace the execution; plugins continue the trace via TraceContext
+				spanCtx, span := observability.StartSpan(execCtx, fmt.Sprintf("plugin.%s.%s", exec.name, hook),
+					observability.WithSpanKind(observability.SpanKindClient),
+					observability.WithAttributes(map[string]any{
+						observability.AttrPluginName: exec.name,
+						observability.AttrPluginHook: string(hook),
+					}))
+				pluginCtx := stageCtx
+				pluginCtx.TraceContext = span.SpanContext().TraceParent()
+
+				// Track execution time for audit logging
+				startTime := time.Now()
+
+				// Secret references are resolved only for this request
+				var resp *plugin.ExecuteResponse
+				pluginConfig, err := m.secrets.ResolveMap(spanCtx, exec.config)
+				if err != nil {
+					err = fmt.Errorf("failed to resolve plugin secrets: %w", err)
+				} else {
+					resp, err = exec.plugin.Execute(spanCtx, plugin.ExecuteRequest{
+						Hook:    hook,
+						Config:  pluginConfig,
+						Context: pluginCtx,
+						DryRun:  dryRun,
+					})
+				}
 
-			// Execute with per-plugin timeout (capped by global context)
-			execCtx, cancel := context.WithTimeout(gCtx, exec.timeout)
-			defer cancel()
+				duration := time.Since(startTime)
+				endPluginSpan(span, resp, err, execCtx.Err(), duration)
+
+				if err != nil {
+					m.logger.Error("plugin execution failed", "plugin", exec.name, "hook", hook, "error", err)
+					_ = audit.LogExecution(gCtx, exec.name, string(hook), false, duration, err.Error())
+					resultsChan <- pluginResult{
+						index: i,
+						response: plugin.ExecuteResponse{
+							Success: false,
+							Error:   err.Error(),
+						},
+					}
+					// Don't return error - allow other plugins to continue
+					return nil
+				}
 
-			// Track execution time for audit logging
-			startTime := time.Now()
+				if resp != nil {
+					if resp.Success {
+						m.logger.Info("plugin executed successfully", "plugin", exec.name, "hook", hook)
+						_ = audit.LogExecution(gCtx, exec.name, string(hook), true, duration, "")
+					} else {
+						m.logger.Warn("plugin execution returned error", "plugin", exec.name, "hook", hook, "error", resp.Error)
+						_ = audit.LogExecution(gCtx, exec.name, string(hook), false, duration, resp.Error)
+					}
+					resultsChan <- pluginResult{
+						index:    i,
+						response: *resp,
+					}
+				} else {
+					// Ensure we always send a result to prevent deadlock
+					_ = audit.LogExecution(gCtx, exec.name, string(hook), true, duration, "")
+					resultsChan <- pluginResult{
+						index: i,
+						response: plugin.ExecuteResponse{
+							Success: true,
+							Message: "plugin returned no response",
+						},
+					}
+				}
 
-			resp, err := exec.plugin.Execute(execCtx, plugin.ExecuteRequest{
-				Hook:    hook,
-				Config:  exec.config,
-				Context: releaseCtx,
-				DryRun:  dryRun,
+				return nil
 			})
+		}
 
-			duration := time.Since(startTime)
+		// Wait for the stage to complete before starting the next one
+		_ = g.Wait() // Errors are handled per-plugin, not propagated
 
-			if err != nil {
-				m.logger.Error("plugin execution failed", "plugin", exec.name, "hook", hook, "error", err)
-				_ = audit.LogExecution(gCtx, exec.name, string(hook), false, duration, err.Error())
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   err.Error(),
-					},
-				}
-				// Don't return error - allow other plugins to continue
-				return nil
+		// Every plugin in the stage sends exactly one result
+		for range group {
+			result := <-resultsChan
+			indexedResults = append(indexedResults, result)
+			if result.response.Success && len(result.response.Outputs) > 0 {
+				outputs[toExecute[result.index].name] = result.response.Outputs
 			}
-
-			if resp != nil {
-				if resp.Success {
-					m.logger.Info("plugin executed successfully", "plugin", exec.name, "hook", hook)
-					_ = audit.LogExecution(gCtx, exec.name, string(hook), true, duration, "")
-				} else {
-					m.logger.Warn("plugin execution returned error", "plugin", exec.name, "hook", hook, "error", resp.Error)
-					_ = audit.LogExecution(gCtx, exec.name, string(hook), false, duration, resp.Error)
-				}
-				resultsChan <- pluginResult{
-					index:    i,
-					response: *resp,
-				}
-			} else {
-				// Ensure we always send a result to prevent deadlock
-				_ = audit.LogExecution(gCtx, exec.name, string(hook), true, duration, "")
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: true,
-						Message: "plugin returned no response",
-					},
-				}
-			}
-
-			return nil
-		})
+		}
 	}
-
-	// Wait for all goroutines to complete
-	_ = g.Wait() // Errors are handled per-plugin, not propagated
 	close(resultsChan)
 
 	// Check if we timed out globally
@@ -598,12 +742,6 @@ func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx
 		m.logger.Warn("global hook timeout reached", "hook", hook, "timeout", MaxGlobalHookTimeout)
 	}
 
-	// Collect results and sort by original index for stable ordering
-	indexedResults := make([]pluginResult, 0, len(toExecute))
-	for result := range resultsChan {
-		indexedResults = append(indexedResults, result)
-	}
-
 	// Sort by index to maintain stable order
 	results := make([]plugin.ExecuteResponse, len(toExecute))
 	for _, r := range indexedResults {
@@ -624,6 +762,28 @@ func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx
 	return filteredResults, nil
 }
 
+// endPluginSpan records the outcome of a plugin execution on its span and
+// ends it. ctxErr is the execution context error, used to flag timeouts.
+func endPluginSpan(span observability.Span, resp *plugin.ExecuteResponse, err, ctxErr error, duration time.Duration) {
+	success := err == nil && (resp == nil || resp.Success)
+	span.SetAttributes(map[string]any{
+		observability.AttrPluginSuccess:  success,
+		observability.AttrPluginDuration: duration.Milliseconds(),
+		observability.AttrPluginTimeout:  ctxErr == context.DeadlineExceeded,
+	})
+
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(observability.SpanStatusError, err.Error())
+	case !success:
+		span.SetStatus(observability.SpanStatusError, resp.Error)
+	default:
+		span.SetStatus(observability.SpanStatusOK, "")
+	}
+	span.End()
+}
+
 // collectPluginsForHook collects plugins that support the given hook.
 // Supports both eagerly-loaded plugins and lazy-loaded plugins.
 func (m *Manager) collectPluginsForHook(hook plugin.Hook) []pluginExecInfo {
@@ -640,10 +800,12 @@ func (m *Manager) collectPluginsForHook(hook plugin.Hook) []pluginExecInfo {
 		}
 
 		toExecute = append(toExecute, pluginExecInfo{
-			name:    lp.name,
-			plugin:  lp.plugin,
-			config:  lp.config,
-			timeout: lp.timeout,
+			name:     lp.name,
+			plugin:   lp.plugin,
+			config:   lp.config,
+			timeout:  lp.timeout,
+			priority: lp.priority,
+			runAfter: lp.runAfter,
 		})
 	}
 
@@ -683,16 +845,82 @@ func (m *Manager) collectPluginsForHook(hook plugin.Hook) []pluginExecInfo {
 		}
 
 		toExecute = append(toExecute, pluginExecInfo{
-			name:    lp.name,
-			plugin:  lp.plugin,
-			config:  lp.config,
-			timeout: lp.timeout,
+			name:     lp.name,
+			plugin:   lp.plugin,
+			config:   lp.config,
+			timeout:  lp.timeout,
+			priority: lp.priority,
+			runAfter: lp.runAfter,
 		})
 	}
 
+	// Order by priority (highest first), then by name for determinism
+	slices.SortStableFunc(toExecute, func(a, b pluginExecInfo) int {
+		if a.priority != b.priority {
+			return cmp.Compare(b.priority, a.priority)
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
 	return toExecute
 }
 
+// executionStages orders plugins sorted by priority into sequential stages.
+// Each stage holds the highest-priority plugins whose run_after dependencies
+// (among the given plugins) have already completed. It returns the plugins
+// reordered by stage along with the indices of each stage into that slice.
+// Dependency cycles are rejected when plugins are registered; should one
+// slip through, the remaining plugins are run as a final stage.
+func executionStages(plugins []pluginExecInfo) ([]pluginExecInfo, [][]int) {
+	present := make(map[string]bool, len(plugins))
+	for _, p := range plugins {
+		present[p.name] = true
+	}
+
+	done := make(map[string]bool, len(plugins))
+	ordered := make([]pluginExecInfo, 0, len(plugins))
+	var stages [][]int
+
+	for len(ordered) < len(plugins) {
+		var ready []pluginExecInfo
+		for _, p := range plugins {
+			if done[p.name] {
+				continue
+			}
+			if !slices.ContainsFunc(p.runAfter, func(dep string) bool { return present[dep] && !done[dep] && dep != p.name }) {
+				ready = append(ready, p)
+			}
+		}
+
+		var stage []pluginExecInfo
+		if len(ready) == 0 {
+			for _, p := range plugins {
+				if !done[p.name] {
+					stage = append(stage, p)
+				}
+			}
+		} else {
+			for _, p := range ready {
+				if p.priority == ready[0].priority {
+					stage = append(stage, p)
+				}
+			}
+		}
+
+		indices := make([]int, 0, len(stage))
+		for _, p := range stage {
+			indices = append(indices, len(ordered))
+			ordered = append(ordered, p)
+		}
+		for _, p := range stage {
+			done[p.name] = true
+		}
+		stages = append(stages, indices)
+	}
+
+	return ordered, stages
+}
+
 // configHasHook checks if the plugin config specifies support for a hook.
 func (m *Manager) configHasHook(cfg *config.PluginConfig, hook plugin.Hook) bool {
 	hookStr := string(hook)
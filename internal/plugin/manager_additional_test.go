@@ -28,7 +28,9 @@ func TestManager_RegisterPlugins_TracksEnabled(t *testing.T) {
 	}
 
 	m := NewManager(cfg)
-	m.RegisterPlugins()
+	if err := m.RegisterPlugins(); err != nil {
+		t.Fatalf("RegisterPlugins() error = %v", err)
+	}
 
 	if _, ok := m.pendingPlugins["enabled"]; !ok {
 		t.Fatal("enabled plugin missing from pendingPlugins")
@@ -36,25 +36,25 @@ func BenchmarkValidatePluginName(b *testing.B) {
 
 	b.Run("valid_short", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_ = validatePluginName("github")
+			_ = config.ValidatePluginName("github")
 		}
 	})
 
 	b.Run("valid_long", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_ = validatePluginName("my-custom-plugin-with-long-name")
+			_ = config.ValidatePluginName("my-custom-plugin-with-long-name")
 		}
 	})
 
 	b.Run("valid_with_numbers", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_ = validatePluginName("plugin-v2-beta1")
+			_ = config.ValidatePluginName("plugin-v2-beta1")
 		}
 	})
 
 	b.Run("invalid", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_ = validatePluginName("../evil")
+			_ = config.ValidatePluginName("../evil")
 		}
 	})
 }
@@ -3,7 +3,9 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +14,8 @@ import (
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+	"github.com/relicta-tech/relicta/internal/observability"
 	"github.com/relicta-tech/relicta/pkg/plugin"
 )
 
@@ -68,9 +72,9 @@ func TestValidatePluginName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePluginName(tt.input)
+			err := config.ValidatePluginName(tt.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("validatePluginName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				t.Errorf("config.ValidatePluginName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 			}
 		})
 	}
@@ -783,9 +787,9 @@ func TestValidatePluginName_EdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePluginName(tt.input)
+			err := config.ValidatePluginName(tt.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("validatePluginName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				t.Errorf("config.ValidatePluginName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 			}
 		})
 	}
@@ -1186,9 +1190,9 @@ func TestValidatePluginName_AllValidChars(t *testing.T) {
 
 	for _, name := range validNames {
 		t.Run(name, func(t *testing.T) {
-			err := validatePluginName(name)
+			err := config.ValidatePluginName(name)
 			if err != nil {
-				t.Errorf("validatePluginName(%q) error = %v, want nil", name, err)
+				t.Errorf("config.ValidatePluginName(%q) error = %v, want nil", name, err)
 			}
 		})
 	}
@@ -1539,6 +1543,69 @@ func TestExecuteHook_PluginReturnsNilResponse(t *testing.T) {
 	}
 }
 
+// mapSecretBackend resolves secrets from a map keyed by reference name.
+type mapSecretBackend map[string]string
+
+func (b mapSecretBackend) Resolve(_ context.Context, ref secrets.Reference) (string, error) {
+	if secret, ok := b[ref.Name]; ok {
+		return secret, nil
+	}
+	return "", fmt.Errorf("secret %s not found", ref.Name)
+}
+
+func TestExecuteHook_ResolvesSecretReferences(t *testing.T) {
+	m := NewManager(&config.Config{})
+	m.secrets = secrets.NewResolver(secrets.WithBackend("mock", mapSecretBackend{"github": "ghp-test"}))
+
+	var gotToken any
+	pluginConfig := map[string]any{"token": "secret://mock/github", "draft": true}
+	addPlugin := func(name string, cfg map[string]any) {
+		m.plugins[name] = &loadedPlugin{
+			name:    name,
+			timeout: 30 * time.Second,
+			config:  cfg,
+			plugin: &mockPlugin{
+				executeFunc: func(_ context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+					gotToken = req.Config["token"]
+					return &plugin.ExecuteResponse{Success: true}, nil
+				},
+			},
+			info: plugin.Info{Name: name, Hooks: []plugin.Hook{plugin.HookPostPublish}},
+		}
+	}
+	addPlugin("github", pluginConfig)
+
+	responses, err := m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{})
+	if err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+	if len(responses) != 1 || !responses[0].Success {
+		t.Fatalf("ExecuteHook() responses = %+v", responses)
+	}
+	if gotToken != "ghp-test" {
+		t.Errorf("plugin received token %v, want resolved secret", gotToken)
+	}
+	if pluginConfig["token"] != "secret://mock/github" {
+		t.Errorf("stored config token = %v, want the reference kept", pluginConfig["token"])
+	}
+
+	// An unresolvable reference fails the plugin without executing it.
+	gotToken = nil
+	delete(m.plugins, "github")
+	addPlugin("gitlab", map[string]any{"token": "secret://mock/gitlab"})
+
+	responses, err = m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{})
+	if err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+	if len(responses) != 1 || responses[0].Success || !strings.Contains(responses[0].Error, "failed to resolve plugin secrets") {
+		t.Errorf("ExecuteHook() responses = %+v, want secret resolution failure", responses)
+	}
+	if gotToken != nil {
+		t.Error("plugin executed despite an unresolvable secret")
+	}
+}
+
 func TestExecuteHook_PluginReturnsError(t *testing.T) {
 	cfg := &config.Config{
 		Workflow: config.WorkflowConfig{
@@ -1703,3 +1770,363 @@ func TestExecuteHook_PluginFailureWithMessage(t *testing.T) {
 		t.Errorf("Expected error to contain 'permission denied', got %q", responses[0].Error)
 	}
 }
+
+func TestExecuteHook_PriorityOrder(t *testing.T) {
+	m := NewManager(&config.Config{})
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	addPlugin := func(name string, priority int) {
+		p := &mockPlugin{
+			executeFunc: func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+				return &plugin.ExecuteResponse{Success: true, Message: name}, nil
+			},
+		}
+		m.plugins[name] = &loadedPlugin{
+			name:     name,
+			plugin:   p,
+			timeout:  30 * time.Second,
+			priority: priority,
+			info:     plugin.Info{Name: name, Hooks: []plugin.Hook{plugin.HookPostPublish}},
+		}
+	}
+	addPlugin("slack", 0)
+	addPlugin("jira", 0)
+	addPlugin("github", 10)
+	addPlugin("audit", -5)
+
+	responses, err := m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{})
+	if err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+
+	// Results follow priority, then name
+	var got []string
+	for _, r := range responses {
+		got = append(got, r.Message)
+	}
+	if want := []string{"github", "jira", "slack", "audit"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("response order = %v, want %v", got, want)
+	}
+
+	// Higher priorities finish before lower priorities start
+	if len(order) != 4 || order[0] != "github" || order[3] != "audit" {
+		t.Errorf("execution order = %v, want github first and audit last", order)
+	}
+}
+
+func TestCollectPluginsForHook_SortedByPriorityThenName(t *testing.T) {
+	m := NewManager(&config.Config{})
+	for name, priority := range map[string]int{"b": 1, "a": 1, "c": 5, "d": 0} {
+		m.plugins[name] = &loadedPlugin{
+			name:     name,
+			priority: priority,
+			info:     plugin.Info{Name: name, Hooks: []plugin.Hook{plugin.HookPrePublish}},
+		}
+	}
+
+	var got []string
+	for _, p := range m.collectPluginsForHook(plugin.HookPrePublish) {
+		got = append(got, p.name)
+	}
+	if want := "c,a,b,d"; strings.Join(got, ",") != want {
+		t.Errorf("collectPluginsForHook() = %v, want %s", got, want)
+	}
+}
+
+func TestExecuteHook_RunAfterPassesOutputs(t *testing.T) {
+	m := NewManager(&config.Config{})
+
+	var consumed string
+	// slack has the higher priority but must wait for github
+	m.plugins["slack"] = &loadedPlugin{
+		name:     "slack",
+		timeout:  30 * time.Second,
+		priority: 10,
+		runAfter: []string{"github"},
+		info:     plugin.Info{Name: "slack", Hooks: []plugin.Hook{plugin.HookPostPublish}},
+		plugin: &mockPlugin{
+			executeFunc: func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+				consumed, _ = req.Context.PluginOutputs["github"]["release_url"].(string)
+				return &plugin.ExecuteResponse{Success: true, Message: "slack"}, nil
+			},
+		},
+	}
+	m.plugins["github"] = &loadedPlugin{
+		name:    "github",
+		timeout: 30 * time.Second,
+		info:    plugin.Info{Name: "github", Hooks: []plugin.Hook{plugin.HookPostPublish}},
+		plugin: &mockPlugin{
+			executeFunc: func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+				if len(req.Context.PluginOutputs) != 0 {
+					t.Errorf("github saw outputs %v, want none", req.Context.PluginOutputs)
+				}
+				return &plugin.ExecuteResponse{
+					Success: true,
+					Message: "github",
+					Outputs: map[string]any{"release_url": "https://example.com/releases/v1.0.0"},
+				}, nil
+			},
+		},
+	}
+
+	responses, err := m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{Version: "1.0.0"})
+	if err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+	if len(responses) != 2 || responses[0].Message != "github" || responses[1].Message != "slack" {
+		t.Errorf("responses = %+v, want github then slack", responses)
+	}
+	if consumed != "https://example.com/releases/v1.0.0" {
+		t.Errorf("slack consumed release_url = %q", consumed)
+	}
+}
+
+func TestExecutionStages(t *testing.T) {
+	plugins := []pluginExecInfo{
+		{name: "slack", priority: 10, runAfter: []string{"github"}},
+		{name: "jira", priority: 5},
+		{name: "github", priority: 0},
+		{name: "audit", priority: 0, runAfter: []string{"missing"}},
+	}
+
+	ordered, stages := executionStages(plugins)
+
+	var got []string
+	for _, stage := range stages {
+		var names []string
+		for _, i := range stage {
+			names = append(names, ordered[i].name)
+		}
+		got = append(got, strings.Join(names, "+"))
+	}
+	if want := "jira,github+audit,slack"; strings.Join(got, ",") != want {
+		t.Errorf("stages = %v, want %s", got, want)
+	}
+}
+
+func TestRegisterPlugins_RunAfterCycle(t *testing.T) {
+	m := NewManager(&config.Config{
+		Plugins: []config.PluginConfig{
+			{Name: "a", RunAfter: []string{"b"}},
+			{Name: "b", RunAfter: []string{"a"}},
+		},
+	})
+
+	err := m.RegisterPlugins()
+	if err == nil || !strings.Contains(err.Error(), "dependency cycle") {
+		t.Fatalf("RegisterPlugins() error = %v, want dependency cycle", err)
+	}
+	if err := m.LoadPlugins(context.Background()); err == nil {
+		t.Error("LoadPlugins() should reject dependency cycles")
+	}
+}
+
+// recordingTracer captures spans for assertions.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...observability.SpanOption) (context.Context, observability.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	span := &recordingSpan{
+		name:  name,
+		attrs: map[string]any{},
+		sc: observability.SpanContext{
+			TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			SpanID:  fmt.Sprintf("%016x", len(t.spans)+1),
+		},
+	}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func (t *recordingTracer) Shutdown(ctx context.Context) error { return nil }
+
+func (t *recordingTracer) span(name string) *recordingSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, s := range t.spans {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+type recordingSpan struct {
+	mu     sync.Mutex
+	name   string
+	attrs  map[string]any
+	status observability.SpanStatus
+	err    error
+	ended  bool
+	sc     observability.SpanContext
+}
+
+func (s *recordingSpan) End() { s.mu.Lock(); s.ended = true; s.mu.Unlock() }
+func (s *recordingSpan) SetStatus(status observability.SpanStatus, _ string) {
+	s.mu.Lock()
+	s.status = status
+	s.mu.Unlock()
+}
+func (s *recordingSpan) SetAttribute(key string, value any) {
+	s.mu.Lock()
+	s.attrs[key] = value
+	s.mu.Unlock()
+}
+func (s *recordingSpan) SetAttributes(attrs map[string]any) {
+	s.mu.Lock()
+	maps.Copy(s.attrs, attrs)
+	s.mu.Unlock()
+}
+func (s *recordingSpan) RecordError(err error)                      { s.mu.Lock(); s.err = err; s.mu.Unlock() }
+func (s *recordingSpan) AddEvent(name string, attrs map[string]any) {}
+func (s *recordingSpan) SpanContext() observability.SpanContext     { return s.sc }
+
+func TestExecuteHook_TracesPlugins(t *testing.T) {
+	tracer := &recordingTracer{}
+	origTracer := observability.GetTracer()
+	observability.SetTracer(tracer)
+	t.Cleanup(func() { observability.SetTracer(origTracer) })
+
+	m := NewManager(&config.Config{})
+	traceContexts := make(map[string]string)
+	var mu sync.Mutex
+	add := func(name string, timeout time.Duration, fn func(ctx context.Context) (*plugin.ExecuteResponse, error)) {
+		m.plugins[name] = &loadedPlugin{
+			name:    name,
+			timeout: timeout,
+			info:    plugin.Info{Name: name, Hooks: []plugin.Hook{plugin.HookPostPublish}},
+			plugin: &mockPlugin{
+				executeFunc: func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+					mu.Lock()
+					traceContexts[name] = req.Context.TraceContext
+					mu.Unlock()
+					return fn(ctx)
+				},
+			},
+		}
+	}
+	add("github", 30*time.Second, func(context.Context) (*plugin.ExecuteResponse, error) {
+		return &plugin.ExecuteResponse{Success: true}, nil
+	})
+	add("slack", 30*time.Second, func(context.Context) (*plugin.ExecuteResponse, error) {
+		return nil, errors.New("webhook rejected")
+	})
+	add("jira", 10*time.Millisecond, func(ctx context.Context) (*plugin.ExecuteResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	if _, err := m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{Version: "1.0.0"}); err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+
+	tests := []struct {
+		plugin      string
+		wantSuccess bool
+		wantTimeout bool
+		wantStatus  observability.SpanStatus
+	}{
+		{"github", true, false, observability.SpanStatusOK},
+		{"slack", false, false, observability.SpanStatusError},
+		{"jira", false, true, observability.SpanStatusError},
+	}
+	for _, tt := range tests {
+		name := "plugin." + tt.plugin + ".post-publish"
+		span := tracer.span(name)
+		if span == nil {
+			t.Errorf("no span %q", name)
+			continue
+		}
+		if !span.ended {
+			t.Errorf("%s: span not ended", name)
+		}
+		if span.status != tt.wantStatus {
+			t.Errorf("%s: status = %v, want %v", name, span.status, tt.wantStatus)
+		}
+		if span.attrs[observability.AttrPluginSuccess] != tt.wantSuccess {
+			t.Errorf("%s: success = %v, want %v", name, span.attrs[observability.AttrPluginSuccess], tt.wantSuccess)
+		}
+		if span.attrs[observability.AttrPluginTimeout] != tt.wantTimeout {
+			t.Errorf("%s: timeout = %v, want %v", name, span.attrs[observability.AttrPluginTimeout], tt.wantTimeout)
+		}
+		if _, ok := span.attrs[observability.AttrPluginDuration]; !ok {
+			t.Errorf("%s: missing duration attribute", name)
+		}
+		if got, want := traceContexts[tt.plugin], span.sc.TraceParent(); got != want || got == "" {
+			t.Errorf("%s: TraceContext = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestValidatePlugins(t *testing.T) {
+	disabled := false
+	cfg := &config.Config{
+		Plugins: []config.PluginConfig{
+			{Name: "github"},
+			{Name: "slack", Config: map[string]any{"webhook": "https://hooks.example.com/x"}},
+			{Name: "disabled", Enabled: &disabled},
+			{Name: "missing-binary-plugin"},
+		},
+	}
+	m := NewManager(cfg)
+
+	// Reject configurations without a token, as the GitHub plugin does.
+	requireToken := &mockPlugin{
+		validateFunc: func(ctx context.Context, config map[string]any) (*plugin.ValidateResponse, error) {
+			if config == nil {
+				t.Error("Validate should receive an empty config, not nil")
+			}
+			if _, ok := config["token"]; !ok {
+				return &plugin.ValidateResponse{
+					Valid:  false,
+					Errors: []plugin.ValidationError{{Field: "token", Message: "token is required"}},
+				}, nil
+			}
+			return &plugin.ValidateResponse{Valid: true}, nil
+		},
+	}
+
+	m.mu.Lock()
+	m.plugins["github"] = &loadedPlugin{name: "github", timeout: time.Second, plugin: requireToken}
+	m.plugins["slack"] = &loadedPlugin{
+		name:    "slack",
+		timeout: time.Second,
+		plugin:  &mockPlugin{},
+		config:  cfg.Plugins[1].Config,
+	}
+	m.mu.Unlock()
+
+	results := m.ValidatePlugins(context.Background())
+	if len(results) != 3 {
+		t.Fatalf("expected results for 3 enabled plugins, got %+v", results)
+	}
+
+	byName := make(map[string]PluginValidation)
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+
+	github := byName["github"]
+	if github.Valid || len(github.Errors) != 1 || github.Errors[0].Field != "token" {
+		t.Errorf("expected github to fail validation on token, got %+v", github)
+	}
+	if slack := byName["slack"]; !slack.Valid || slack.Error != "" {
+		t.Errorf("expected slack to be valid, got %+v", slack)
+	}
+	if missing := byName["missing-binary-plugin"]; missing.Valid || missing.Error == "" {
+		t.Errorf("expected an error for a plugin that cannot be started, got %+v", missing)
+	}
+	if _, ok := byName["disabled"]; ok {
+		t.Error("disabled plugins should not be validated")
+	}
+}
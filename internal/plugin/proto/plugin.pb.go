@@ -158,7 +158,9 @@ type PluginInfo struct {
 	// hooks lists the hooks this plugin supports.
 	Hooks []string `protobuf:"bytes,5,rep,name=hooks,proto3" json:"hooks,omitempty"`
 	// config_schema is a JSON schema for the plugin configuration.
-	ConfigSchema  string `protobuf:"bytes,6,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	ConfigSchema string `protobuf:"bytes,6,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	// steps lists the publish steps contributed by the plugin as JSON.
+	Steps         string `protobuf:"bytes,7,opt,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -235,6 +237,13 @@ func (x *PluginInfo) GetConfigSchema() string {
 	return ""
 }
 
+func (x *PluginInfo) GetSteps() string {
+	if x != nil {
+		return x.Steps
+	}
+	return ""
+}
+
 // ExecuteRequest is the request for executing a plugin hook.
 type ExecuteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -245,9 +254,13 @@ type ExecuteRequest struct {
 	// context contains the release context.
 	Context *ReleaseContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 	// dry_run indicates if this is a dry run.
-	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// step names the contributed publish step to run, if any.
+	Step string `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`
+	// idempotency_key is stable across retries of the same step.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ExecuteRequest) Reset() {
@@ -308,6 +321,20 @@ func (x *ExecuteRequest) GetDryRun() bool {
 	return false
 }
 
+func (x *ExecuteRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *ExecuteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // ExecuteResponse is the response from plugin execution.
 type ExecuteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -418,7 +445,15 @@ type ReleaseContext struct {
 	// changes contains the categorized changes.
 	Changes *CategorizedChanges `protobuf:"bytes,12,opt,name=changes,proto3" json:"changes,omitempty"`
 	// environment contains environment variables (filtered for security).
-	Environment   map[string]string `protobuf:"bytes,13,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Environment map[string]string `protobuf:"bytes,13,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// is_prerelease is true when the version has a prerelease component (e.g., "1.2.3-rc.1").
+	IsPrerelease bool `protobuf:"varint,14,opt,name=is_prerelease,json=isPrerelease,proto3" json:"is_prerelease,omitempty"`
+	// plugin_outputs contains outputs of plugins that ran earlier in the same hook as JSON.
+	PluginOutputs string `protobuf:"bytes,15,opt,name=plugin_outputs,json=pluginOutputs,proto3" json:"plugin_outputs,omitempty"`
+	// trace_context is the W3C traceparent of the span tracing this execution.
+	TraceContext string `protobuf:"bytes,16,opt,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty"`
+	// highlights are the most significant changes, breaking changes first.
+	Highlights    []string `protobuf:"bytes,17,rep,name=highlights,proto3" json:"highlights,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -544,6 +579,34 @@ func (x *ReleaseContext) GetEnvironment() map[string]string {
 	return nil
 }
 
+func (x *ReleaseContext) GetIsPrerelease() bool {
+	if x != nil {
+		return x.IsPrerelease
+	}
+	return false
+}
+
+func (x *ReleaseContext) GetPluginOutputs() string {
+	if x != nil {
+		return x.PluginOutputs
+	}
+	return ""
+}
+
+func (x *ReleaseContext) GetTraceContext() string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return ""
+}
+
+func (x *ReleaseContext) GetHighlights() []string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 // CategorizedChanges contains commits grouped by category.
 type CategorizedChanges struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1023,7 +1086,7 @@ var File_internal_plugin_proto_plugin_proto protoreflect.FileDescriptor
 const file_internal_plugin_proto_plugin_proto_rawDesc = "" +
 	"\n" +
 	"\"internal/plugin/proto/plugin.proto\x12\arelicta\"\a\n" +
-	"\x05Empty\"\xaf\x01\n" +
+	"\x05Empty\"\xc5\x01\n" +
 	"\n" +
 	"PluginInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
@@ -1031,18 +1094,21 @@ const file_internal_plugin_proto_plugin_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
 	"\x06author\x18\x04 \x01(\tR\x06author\x12\x14\n" +
 	"\x05hooks\x18\x05 \x03(\tR\x05hooks\x12#\n" +
-	"\rconfig_schema\x18\x06 \x01(\tR\fconfigSchema\"\x97\x01\n" +
+	"\rconfig_schema\x18\x06 \x01(\tR\fconfigSchema\x12\x14\n" +
+	"\x05steps\x18\a \x01(\tR\x05steps\"\xd4\x01\n" +
 	"\x0eExecuteRequest\x12!\n" +
 	"\x04hook\x18\x01 \x01(\x0e2\r.relicta.HookR\x04hook\x12\x16\n" +
 	"\x06config\x18\x02 \x01(\tR\x06config\x121\n" +
 	"\acontext\x18\x03 \x01(\v2\x17.relicta.ReleaseContextR\acontext\x12\x17\n" +
-	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xa6\x01\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\xa6\x01\n" +
 	"\x0fExecuteResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
 	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
 	"\aoutputs\x18\x04 \x01(\tR\aoutputs\x12/\n" +
-	"\tartifacts\x18\x05 \x03(\v2\x11.relicta.ArtifactR\tartifacts\"\xcb\x04\n" +
+	"\tartifacts\x18\x05 \x03(\v2\x11.relicta.ArtifactR\tartifacts\"\xdc\x05\n" +
 	"\x0eReleaseContext\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
 	"\x10previous_version\x18\x02 \x01(\tR\x0fpreviousVersion\x12\x19\n" +
@@ -1058,7 +1124,13 @@ const file_internal_plugin_proto_plugin_proto_rawDesc = "" +
 	" \x01(\tR\tchangelog\x12#\n" +
 	"\rrelease_notes\x18\v \x01(\tR\freleaseNotes\x125\n" +
 	"\achanges\x18\f \x01(\v2\x1b.relicta.CategorizedChangesR\achanges\x12J\n" +
-	"\venvironment\x18\r \x03(\v2(.relicta.ReleaseContext.EnvironmentEntryR\venvironment\x1a>\n" +
+	"\venvironment\x18\r \x03(\v2(.relicta.ReleaseContext.EnvironmentEntryR\venvironment\x12#\n" +
+	"\ris_prerelease\x18\x0e \x01(\bR\fisPrerelease\x12%\n" +
+	"\x0eplugin_outputs\x18\x0f \x01(\tR\rpluginOutputs\x12#\n" +
+	"\rtrace_context\x18\x10 \x01(\tR\ftraceContext\x12\x1e\n" +
+	"\n" +
+	"highlights\x18\x11 \x03(\tR\n" +
+	"highlights\x1a>\n" +
 	"\x10EnvironmentEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x03\n" +
@@ -0,0 +1,133 @@
+// Package plugin provides plugin management for Relicta.
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/relicta-tech/relicta/internal/observability"
+	"github.com/relicta-tech/relicta/internal/plugin/audit"
+	"github.com/relicta-tech/relicta/pkg/plugin"
+)
+
+// ContributedStep is a publish step declared by a plugin in its Info.
+type ContributedStep struct {
+	Plugin string
+	plugin.StepDefinition
+}
+
+// PluginSteps returns the publish steps contributed by the enabled plugins,
+// in configuration order and, within a plugin, in declaration order.
+// Plugins are loaded as needed to read their declared steps; plugins that
+// fail to load are skipped, as they are when executing hooks.
+func (m *Manager) PluginSteps(ctx context.Context) ([]ContributedStep, error) {
+	var steps []ContributedStep
+	for i := range m.cfg.Plugins {
+		pluginCfg := &m.cfg.Plugins[i]
+		if !pluginCfg.IsEnabled() {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		lp, err := m.ensurePluginLoaded(ctx, pluginCfg.Name)
+		if err != nil {
+			m.logger.Warn("failed to load plugin for step planning", "name", pluginCfg.Name, "error", err)
+			continue
+		}
+
+		seen := make(map[string]bool, len(lp.info.Steps))
+		for _, def := range lp.info.Steps {
+			if def.Name == "" {
+				return nil, fmt.Errorf("plugin %s declares a step without a name", lp.name)
+			}
+			if seen[def.Name] {
+				return nil, fmt.Errorf("plugin %s declares step %q more than once", lp.name, def.Name)
+			}
+			seen[def.Name] = true
+			steps = append(steps, ContributedStep{Plugin: lp.name, StepDefinition: def})
+		}
+	}
+	return steps, nil
+}
+
+// ExecuteStep runs a step contributed by the named plugin. The plugin is
+// invoked for the post-publish hook with ExecuteRequest.Step set, using its
+// configuration and timeout. A step the plugin does not declare is rejected.
+func (m *Manager) ExecuteStep(ctx context.Context, name, step, idempotencyKey string, releaseCtx plugin.ReleaseContext) (*plugin.ExecuteResponse, error) {
+	lp, err := m.ensurePluginLoaded(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plugin %s: %w", name, err)
+	}
+	if !pluginDeclaresStep(lp, step) {
+		return nil, fmt.Errorf("plugin %s does not declare step %q", name, step)
+	}
+
+	if err := m.executionLimiter.Acquire(ctx, 1); err != nil {
+		return nil, fmt.Errorf("failed to acquire execution slot: %w", err)
+	}
+	defer m.executionLimiter.Release(1)
+
+	label := "step:" + step
+	m.logger.Debug("executing step", "plugin", name, "step", step)
+
+	execCtx, cancel := context.WithTimeout(ctx, lp.timeout)
+	defer cancel()
+
+	spanCtx, span := observability.StartSpan(execCtx, fmt.Sprintf("plugin.%s.%s", name, label),
+		observability.WithSpanKind(observability.SpanKindClient),
+		observability.WithAttributes(map[string]any{
+			observability.AttrPluginName: name,
+			observability.AttrPluginHook: label,
+		}))
+	releaseCtx.TraceContext = span.SpanContext().TraceParent()
+
+	startTime := time.Now()
+
+	// Secret references are resolved only for this request
+	var resp *plugin.ExecuteResponse
+	pluginConfig, err := m.secrets.ResolveMap(spanCtx, lp.config)
+	if err != nil {
+		err = fmt.Errorf("failed to resolve plugin secrets: %w", err)
+	} else {
+		resp, err = lp.plugin.Execute(spanCtx, plugin.ExecuteRequest{
+			Hook:           plugin.HookPostPublish,
+			Config:         pluginConfig,
+			Context:        releaseCtx,
+			DryRun:         m.cfg.Workflow.DryRunByDefault,
+			Step:           step,
+			IdempotencyKey: idempotencyKey,
+		})
+	}
+	duration := time.Since(startTime)
+	endPluginSpan(span, resp, err, execCtx.Err(), duration)
+
+	switch {
+	case err != nil:
+		m.logger.Error("plugin step failed", "plugin", name, "step", step, "error", err)
+		_ = audit.LogExecution(ctx, name, label, false, duration, err.Error())
+		return nil, err
+	case resp == nil:
+		_ = audit.LogExecution(ctx, name, label, true, duration, "")
+		return &plugin.ExecuteResponse{Success: true, Message: "plugin returned no response"}, nil
+	case !resp.Success:
+		m.logger.Warn("plugin step returned error", "plugin", name, "step", step, "error", resp.Error)
+		_ = audit.LogExecution(ctx, name, label, false, duration, resp.Error)
+	default:
+		m.logger.Info("plugin step executed successfully", "plugin", name, "step", step)
+		_ = audit.LogExecution(ctx, name, label, true, duration, "")
+	}
+	return resp, nil
+}
+
+// pluginDeclaresStep reports whether a plugin declares the given step.
+func pluginDeclaresStep(lp *loadedPlugin, step string) bool {
+	for _, def := range lp.info.Steps {
+		if def.Name == step {
+			return true
+		}
+	}
+	return false
+}
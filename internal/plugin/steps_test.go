@@ -0,0 +1,170 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/relicta/internal/config"
+	"github.com/relicta-tech/relicta/internal/domain/integration"
+	"github.com/relicta-tech/relicta/internal/infrastructure/secrets"
+	"github.com/relicta-tech/relicta/pkg/plugin"
+)
+
+// stepRecordingPlugin records the requests it receives.
+type stepRecordingPlugin struct {
+	stubPlugin
+	requests []plugin.ExecuteRequest
+	response *plugin.ExecuteResponse
+}
+
+func (p *stepRecordingPlugin) Execute(_ context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+	p.requests = append(p.requests, req)
+	return p.response, nil
+}
+
+func newStepManager(t *testing.T, impl plugin.Plugin) *Manager {
+	t.Helper()
+
+	cfg := &config.Config{
+		Plugins: []config.PluginConfig{
+			{Name: "assets", Enabled: boolPtr(true)},
+			{Name: "disabled", Enabled: boolPtr(false)},
+			{Name: "notify", Enabled: boolPtr(true)},
+		},
+	}
+	m := NewManager(cfg)
+	m.plugins["assets"] = &loadedPlugin{
+		name:   "assets",
+		plugin: impl,
+		info: plugin.Info{
+			Name: "assets",
+			Steps: []plugin.StepDefinition{
+				{Name: "upload", Description: "Upload assets"},
+				{Name: "verify"},
+			},
+		},
+		config:  map[string]any{"bucket": "releases"},
+		timeout: DefaultPerPluginTimeout,
+	}
+	m.plugins["notify"] = &loadedPlugin{
+		name:    "notify",
+		plugin:  stubPlugin{},
+		info:    plugin.Info{Name: "notify", Steps: []plugin.StepDefinition{{Name: "announce"}}},
+		timeout: DefaultPerPluginTimeout,
+	}
+	return m
+}
+
+func TestManager_PluginSteps(t *testing.T) {
+	m := newStepManager(t, stubPlugin{})
+
+	steps, err := m.PluginSteps(context.Background())
+	if err != nil {
+		t.Fatalf("PluginSteps() error = %v", err)
+	}
+
+	want := []string{"assets/upload", "assets/verify", "notify/announce"}
+	if len(steps) != len(want) {
+		t.Fatalf("PluginSteps() len = %d, want %d", len(steps), len(want))
+	}
+	for i, w := range want {
+		if got := steps[i].Plugin + "/" + steps[i].Name; got != w {
+			t.Errorf("steps[%d] = %s, want %s", i, got, w)
+		}
+	}
+	if steps[0].Description != "Upload assets" {
+		t.Errorf("steps[0].Description = %q", steps[0].Description)
+	}
+}
+
+func TestManager_PluginSteps_DuplicateName(t *testing.T) {
+	m := newStepManager(t, stubPlugin{})
+	m.plugins["notify"].info.Steps = append(m.plugins["notify"].info.Steps, plugin.StepDefinition{Name: "announce"})
+
+	if _, err := m.PluginSteps(context.Background()); err == nil || !strings.Contains(err.Error(), "more than once") {
+		t.Errorf("PluginSteps() error = %v, want duplicate step error", err)
+	}
+}
+
+func TestManager_ExecuteStep(t *testing.T) {
+	impl := &stepRecordingPlugin{response: &plugin.ExecuteResponse{Success: true, Message: "uploaded"}}
+	m := newStepManager(t, impl)
+
+	resp, err := m.ExecuteStep(context.Background(), "assets", "upload", "key-1", plugin.ReleaseContext{Version: "1.2.0"})
+	if err != nil {
+		t.Fatalf("ExecuteStep() error = %v", err)
+	}
+	if !resp.Success || resp.Message != "uploaded" {
+		t.Errorf("ExecuteStep() = %+v", resp)
+	}
+
+	if len(impl.requests) != 1 {
+		t.Fatalf("requests = %d, want 1", len(impl.requests))
+	}
+	req := impl.requests[0]
+	if req.Hook != plugin.HookPostPublish || req.Step != "upload" || req.IdempotencyKey != "key-1" {
+		t.Errorf("request = hook %s step %q key %q", req.Hook, req.Step, req.IdempotencyKey)
+	}
+	if req.Config["bucket"] != "releases" || req.Context.Version != "1.2.0" {
+		t.Errorf("request config = %v, version = %q", req.Config, req.Context.Version)
+	}
+}
+
+func TestManager_ExecuteStep_ResolvesSecrets(t *testing.T) {
+	impl := &stepRecordingPlugin{response: &plugin.ExecuteResponse{Success: true}}
+	m := newStepManager(t, impl)
+	m.secrets = secrets.NewResolver(secrets.WithBackend("mock", mapSecretBackend{"bucket": "private-releases"}))
+	m.plugins["assets"].config = map[string]any{"bucket": "secret://mock/bucket"}
+
+	if _, err := m.ExecuteStep(context.Background(), "assets", "upload", "", plugin.ReleaseContext{}); err != nil {
+		t.Fatalf("ExecuteStep() error = %v", err)
+	}
+	if len(impl.requests) != 1 || impl.requests[0].Config["bucket"] != "private-releases" {
+		t.Errorf("requests = %+v, want resolved bucket", impl.requests)
+	}
+	if m.plugins["assets"].config["bucket"] != "secret://mock/bucket" {
+		t.Error("ExecuteStep() wrote the resolved secret back to the plugin config")
+	}
+
+	m.plugins["assets"].config = map[string]any{"bucket": "secret://missing/bucket"}
+	if _, err := m.ExecuteStep(context.Background(), "assets", "upload", "", plugin.ReleaseContext{}); err == nil {
+		t.Error("ExecuteStep() expected error for unresolvable secret")
+	}
+	if len(impl.requests) != 1 {
+		t.Errorf("plugin executed %d times, want 1", len(impl.requests))
+	}
+}
+
+func TestManager_ExecuteStep_UndeclaredStep(t *testing.T) {
+	impl := &stepRecordingPlugin{}
+	m := newStepManager(t, impl)
+
+	if _, err := m.ExecuteStep(context.Background(), "assets", "publish", "", plugin.ReleaseContext{}); err == nil {
+		t.Error("ExecuteStep() expected error for undeclared step")
+	}
+	if len(impl.requests) != 0 {
+		t.Errorf("plugin executed %d times for undeclared step", len(impl.requests))
+	}
+}
+
+func TestExecutorAdapter_ExecutePlugin_Step(t *testing.T) {
+	impl := &stepRecordingPlugin{response: &plugin.ExecuteResponse{Success: false, Error: "quota exceeded"}}
+	adapter := NewExecutorAdapter(newStepManager(t, impl))
+
+	resp, err := adapter.ExecutePlugin(context.Background(), "assets", integration.ExecuteRequest{Step: "verify"})
+	if err != nil {
+		t.Fatalf("ExecutePlugin() error = %v", err)
+	}
+	if resp.Success || resp.Error != "quota exceeded" {
+		t.Errorf("ExecutePlugin() = %+v, want failure", resp)
+	}
+
+	steps, err := adapter.PluginSteps(context.Background())
+	if err != nil {
+		t.Fatalf("PluginSteps() error = %v", err)
+	}
+	if len(steps) != 3 || steps[0].PluginID != "assets" || steps[0].Name != "upload" {
+		t.Errorf("PluginSteps() = %+v", steps)
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/analysis"
 	analysisfactory "github.com/relicta-tech/relicta/internal/analysis/factory"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
 	"github.com/relicta-tech/relicta/internal/domain/version"
 )
@@ -34,6 +37,53 @@ func DefaultConfig() AnalyzerConfig {
 	}
 }
 
+// NonConventionalPolicy controls how commits that do not parse as
+// conventional commits affect the release.
+type NonConventionalPolicy string
+
+// Non-conventional commit policies.
+const (
+	// NonConventionalInfer classifies the commits with heuristics or AI and
+	// uses the inferred type. This is the default.
+	NonConventionalInfer NonConventionalPolicy = "infer"
+	// NonConventionalIgnore keeps the commits as chores that do not affect
+	// the version.
+	NonConventionalIgnore NonConventionalPolicy = "ignore"
+	// NonConventionalPatch treats each commit as a patch-level fix.
+	NonConventionalPatch NonConventionalPolicy = "patch"
+	// NonConventionalError fails the analysis, listing the commits.
+	NonConventionalError NonConventionalPolicy = "error"
+)
+
+// ParseNonConventionalPolicy parses a policy name. An empty name is the
+// default policy, NonConventionalInfer.
+func ParseNonConventionalPolicy(name string) (NonConventionalPolicy, error) {
+	switch policy := NonConventionalPolicy(strings.ToLower(strings.TrimSpace(name))); policy {
+	case "":
+		return NonConventionalInfer, nil
+	case NonConventionalInfer, NonConventionalIgnore, NonConventionalPatch, NonConventionalError:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid non-conventional commit policy %q (use infer, ignore, patch or error)", name)
+	}
+}
+
+// NonConventionalCommitsError is returned by Analyze under
+// NonConventionalError when the range contains non-conventional commits.
+type NonConventionalCommitsError struct {
+	Commits []*sourcecontrol.Commit
+}
+
+// Error lists the offending commits.
+func (e *NonConventionalCommitsError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d commit(s) do not follow the conventional commit format:", len(e.Commits))
+	for _, c := range e.Commits {
+		fmt.Fprintf(&sb, "\n  %s %s", c.ShortHash(), c.Subject())
+	}
+	return sb.String()
+}
+
 // AnalyzeInput contains input parameters for release analysis.
 type AnalyzeInput struct {
 	RepositoryPath string
@@ -47,6 +97,46 @@ type AnalyzeInput struct {
 
 	// CommitClassifications allows manual overrides keyed by commit hash.
 	CommitClassifications map[sourcecontrol.CommitHash]*analysis.CommitClassification
+
+	// ExcludeCommits lists commit SHAs (full or abbreviated) to drop from the
+	// analyzed range. Each must match exactly one commit in the range.
+	ExcludeCommits []string
+
+	// Prerelease, when set, makes the next version a prerelease with this
+	// identifier, incrementing an existing prerelease series.
+	Prerelease version.Prerelease
+
+	// ContinuePrerelease applies like Prerelease, but only when the current
+	// version is itself a prerelease, so that a configured suffix continues
+	// an existing prerelease series without turning stable releases into
+	// prereleases. Ignored when Prerelease is set.
+	ContinuePrerelease version.Prerelease
+
+	// BaseTag, when set, plans a hotfix release of an older release line:
+	// commits are collected from the tag to ToRef and the next version is a
+	// patch bump of the tag's version. No newer version tag may be reachable
+	// from ToRef. It cannot be combined with FromRef.
+	BaseTag string
+
+	// Since, when non-zero, drops commits authored before this time. With
+	// FromRef or BaseTag it further restricts that range; otherwise commits
+	// are collected from ToRef back to Since regardless of tags, while the
+	// current version still comes from the latest tag.
+	Since time.Time
+
+	// CurrentVersion, when set, is used as the current version instead of
+	// the one derived from tags, e.g. when it is read from a version file.
+	// Commits are still collected from FromRef or the latest tag. Ignored
+	// with BaseTag.
+	CurrentVersion *version.SemanticVersion
+
+	// NonConventionalPolicy controls how non-conventional commits are
+	// handled. Empty means NonConventionalInfer.
+	NonConventionalPolicy NonConventionalPolicy
+
+	// CommitParser parses commit messages into the changeset. Nil means
+	// Conventional Commits. Commits it cannot parse are non-conventional.
+	CommitParser changes.CommitParser
 }
 
 // Validate validates the input parameters.
@@ -80,6 +170,14 @@ func (i *AnalyzeInput) Validate() error {
 	}
 
 	invalidRefChars := ":?*[\\ "
+	if i.BaseTag != "" {
+		if i.FromRef != "" {
+			return fmt.Errorf("base tag and from reference cannot be combined")
+		}
+		if strings.ContainsAny(i.BaseTag, "~^"+invalidRefChars) {
+			return fmt.Errorf("invalid base tag: %s", i.BaseTag)
+		}
+	}
 	if i.FromRef != "" {
 		if strings.ContainsAny(i.FromRef, invalidRefChars) {
 			return fmt.Errorf("invalid from reference: %s", i.FromRef)
@@ -104,10 +202,147 @@ type AnalyzeOutput struct {
 	Branch         string
 	Commits        []*sourcecontrol.Commit
 
+	// ExcludedCommits contains the full hashes of commits removed via ExcludeCommits.
+	ExcludedCommits []sourcecontrol.CommitHash
+
+	// BaseTag is the tag a hotfix release is based on; empty otherwise.
+	BaseTag string
+
+	// Window describes the time span of the analyzed commits.
+	Window CommitWindow
+
+	// NonConventional reports the commits that did not parse as
+	// conventional commits and how they were handled.
+	NonConventional NonConventionalSummary
+
 	// Analysis contains detailed classification results.
 	Analysis *analysis.AnalysisResult
 }
 
+// ChangelogPreview renders the changelog entry publish would write for the
+// analyzed release. The entry is produced by generator with the configured
+// notes options (template, exclude_scopes, ...) for an in-memory release run
+// that is never saved; AI is not used. It is empty when there is no
+// ReleaseCommits returns the hashes of the analyzed commits: the range a
+// release run of this analysis covers.
+func (o *AnalyzeOutput) ReleaseCommits() []releasedomain.CommitSHA {
+	commits := []releasedomain.CommitSHA{}
+	if o.ChangeSet == nil {
+		return commits
+	}
+	for _, c := range o.ChangeSet.Commits() {
+		commits = append(commits, releasedomain.CommitSHA(c.Hash()))
+	}
+	return commits
+}
+
+// changeset.
+func (o *AnalyzeOutput) ChangelogPreview(ctx context.Context, generator ports.NotesGenerator, options ports.NotesOptions) (string, error) {
+	if o.ChangeSet == nil {
+		return "", nil
+	}
+
+	run := releasedomain.NewReleaseRun(o.RepositoryName, "", o.ChangeSet.FromRef(), "", nil, "", "")
+	run.SetChangeSet(o.ChangeSet)
+	if err := run.SetVersionProposal(o.CurrentVersion, o.NextVersion, releasedomain.BumpKind(o.ReleaseType), 1.0); err != nil {
+		return "", err
+	}
+
+	options.UseAI = false
+	notes, err := generator.Generate(ctx, run, options)
+	if err != nil {
+		return "", fmt.Errorf("failed to render changelog preview: %w", err)
+	}
+	return notes.Text, nil
+}
+
+// NonConventionalSummary reports the non-conventional commits of a release.
+// Merge commits are not counted.
+type NonConventionalSummary struct {
+	Policy  NonConventionalPolicy
+	Commits []sourcecontrol.CommitHash
+}
+
+// CommitWindow describes the effective time span of an analyzed commit range.
+type CommitWindow struct {
+	// Since is the requested lower bound; zero when the range was not time-bounded.
+	Since time.Time
+	// Oldest and Newest are the dates of the oldest and newest analyzed commits.
+	Oldest time.Time
+	Newest time.Time
+}
+
+// Fields returns the window as output fields: RFC 3339 UTC timestamps,
+// with since only when the range was time-bounded.
+func (w CommitWindow) Fields() map[string]any {
+	fields := map[string]any{
+		"oldest": w.Oldest.UTC().Format(time.RFC3339),
+		"newest": w.Newest.UTC().Format(time.RFC3339),
+	}
+	if !w.Since.IsZero() {
+		fields["since"] = w.Since.UTC().Format(time.RFC3339)
+	}
+	return fields
+}
+
+// newCommitWindow computes the window spanned by commits.
+func newCommitWindow(since time.Time, commits []*sourcecontrol.Commit) CommitWindow {
+	w := CommitWindow{Since: since}
+	for _, c := range commits {
+		d := c.Date()
+		if w.Oldest.IsZero() || d.Before(w.Oldest) {
+			w.Oldest = d
+		}
+		if d.After(w.Newest) {
+			w.Newest = d
+		}
+	}
+	return w
+}
+
+// ParseSince parses a --since value relative to now. It accepts a duration
+// ("36h", "2w", "7d"), a date ("2006-01-02") or an RFC 3339 timestamp.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	if n := len(value); n >= 2 {
+		if days, err := strconv.Atoi(value[:n-1]); err == nil && days > 0 {
+			switch value[n-1] {
+			case 'd', 'D':
+				return now.AddDate(0, 0, -days), nil
+			case 'w', 'W':
+				return now.AddDate(0, 0, -7*days), nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid since value %q: use a duration (e.g. 72h, 7d, 2w), a date (YYYY-MM-DD) or an RFC 3339 timestamp", value)
+}
+
+// filterSince keeps only commits dated at or after since.
+func filterSince(commits []*sourcecontrol.Commit, since time.Time) []*sourcecontrol.Commit {
+	if since.IsZero() {
+		return commits
+	}
+	kept := commits[:0:0]
+	for _, c := range commits {
+		if !c.Date().Before(since) {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
 // Analyzer orchestrates commit collection, classification, and version calculation.
 type Analyzer struct {
 	gitRepo         sourcecontrol.GitRepository
@@ -135,19 +370,33 @@ func (a *Analyzer) Analyze(ctx context.Context, input AnalyzeInput) (*AnalyzeOut
 	if err := input.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
 	}
+	policy, err := ParseNonConventionalPolicy(string(input.NonConventionalPolicy))
+	if err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
 
 	repoInfo, currentVersion, fromRef, commits, err := a.collectCommits(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
+	commits, excluded, err := excludeCommits(commits, input.ExcludeCommits)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build changeset from commits
 	changeSetID := changes.ChangeSetID(fmt.Sprintf("cs-%d", time.Now().UnixNano()))
 	changeSet := changes.NewChangeSet(changeSetID, fromRef, input.ToRef)
 
-	analysisResult, classifications, err := a.prepareCommitClassifications(ctx, commits, input)
-	if err != nil {
-		return nil, err
+	// Only the default policy classifies non-conventional commits.
+	var analysisResult *analysis.AnalysisResult
+	var classifications map[sourcecontrol.CommitHash]*analysis.CommitClassification
+	if policy == NonConventionalInfer {
+		analysisResult, classifications, err = a.prepareCommitClassifications(ctx, commits, input)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	minConfidence := analysis.DefaultConfig().MinConfidence
@@ -155,8 +404,10 @@ func (a *Analyzer) Analyze(ctx context.Context, input AnalyzeInput) (*AnalyzeOut
 		minConfidence = input.AnalysisConfig.MinConfidence
 	}
 
+	var nonConventional []*sourcecontrol.Commit
 	for _, commit := range commits {
-		conventionalCommit := changes.ParseConventionalCommit(
+		conventionalCommit := changes.ParseCommit(
+			input.CommitParser,
 			string(commit.Hash()),
 			commit.Message(),
 			changes.WithAuthor(commit.Author().Name, commit.Author().Email),
@@ -168,6 +419,17 @@ func (a *Analyzer) Analyze(ctx context.Context, input AnalyzeInput) (*AnalyzeOut
 			continue
 		}
 
+		isMerge := commit.IsMergeCommit()
+		if !isMerge {
+			nonConventional = append(nonConventional, commit)
+		}
+		if policy != NonConventionalInfer {
+			if !isMerge && policy != NonConventionalError {
+				changeSet.AddCommit(nonConventionalCommit(commit, policy))
+			}
+			continue
+		}
+
 		classification := classifications[commit.Hash()]
 		if classification == nil || classification.ShouldSkip {
 			continue
@@ -184,13 +446,33 @@ func (a *Analyzer) Analyze(ctx context.Context, input AnalyzeInput) (*AnalyzeOut
 		changeSet.AddCommit(inferred)
 	}
 
+	if policy == NonConventionalError && len(nonConventional) > 0 {
+		return nil, &NonConventionalCommitsError{Commits: nonConventional}
+	}
+
 	if changeSet.IsEmpty() {
 		return nil, changes.ErrEmptyChangeSet
 	}
 
+	summary := NonConventionalSummary{Policy: policy}
+	for _, c := range nonConventional {
+		summary.Commits = append(summary.Commits, c.Hash())
+	}
+
 	// Calculate version
 	releaseType := changeSet.ReleaseType()
+	if input.BaseTag != "" {
+		// Hotfixes of an older release line are always patch releases.
+		releaseType = changes.ReleaseTypePatch
+	}
 	nextVersion := a.versionCalc.CalculateNextVersion(currentVersion, releaseType.ToBumpType())
+	pre := input.Prerelease
+	if pre == "" && currentVersion.IsPrerelease() {
+		pre = input.ContinuePrerelease
+	}
+	if pre != "" {
+		nextVersion = version.NextPrerelease(currentVersion, nextVersion, pre)
+	}
 
 	branch := input.Branch
 	if branch == "" {
@@ -204,14 +486,18 @@ func (a *Analyzer) Analyze(ctx context.Context, input AnalyzeInput) (*AnalyzeOut
 	}
 
 	return &AnalyzeOutput{
-		CurrentVersion: currentVersion,
-		NextVersion:    nextVersion,
-		ReleaseType:    releaseType,
-		ChangeSet:      changeSet,
-		RepositoryName: repoName,
-		Branch:         branch,
-		Commits:        commits,
-		Analysis:       analysisResult,
+		CurrentVersion:  currentVersion,
+		NextVersion:     nextVersion,
+		ReleaseType:     releaseType,
+		ChangeSet:       changeSet,
+		RepositoryName:  repoName,
+		Branch:          branch,
+		Commits:         commits,
+		ExcludedCommits: excluded,
+		BaseTag:         input.BaseTag,
+		Window:          newCommitWindow(input.Since, commits),
+		NonConventional: summary,
+		Analysis:        analysisResult,
 	}, nil
 }
 
@@ -227,6 +513,11 @@ func (a *Analyzer) AnalyzeCommits(ctx context.Context, input AnalyzeInput) (*ana
 		return nil, nil, err
 	}
 
+	commits, _, err = excludeCommits(commits, input.ExcludeCommits)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	commitInfos := make([]analysis.CommitInfo, 0, len(commits))
 	for _, c := range commits {
 		// Get file list via diff stats if available
@@ -283,7 +574,14 @@ func (a *Analyzer) collectCommits(ctx context.Context, input AnalyzeInput) (*sou
 	var currentVersion version.SemanticVersion
 	fromRef := input.FromRef
 
-	if fromRef == "" {
+	switch {
+	case input.BaseTag != "":
+		currentVersion, err = hotfixBaseVersion(tags, input.BaseTag, input.TagPrefix)
+		if err != nil {
+			return nil, version.SemanticVersion{}, "", nil, err
+		}
+		fromRef = input.BaseTag
+	case fromRef == "":
 		versionTags := tags.FilterByPrefix(input.TagPrefix).VersionTags()
 		if latestTag := versionTags.Latest(); latestTag != nil {
 			fromRef = latestTag.Name()
@@ -291,29 +589,147 @@ func (a *Analyzer) collectCommits(ctx context.Context, input AnalyzeInput) (*sou
 				currentVersion = *v
 			}
 		}
-	} else {
+	default:
 		if v, err := version.Parse(strings.TrimPrefix(fromRef, input.TagPrefix)); err == nil {
 			currentVersion = v
 		}
 	}
+	if input.CurrentVersion != nil && input.BaseTag == "" {
+		currentVersion = *input.CurrentVersion
+	}
 
 	toRef := input.ToRef
 	if toRef == "" {
 		toRef = "HEAD"
 	}
 
-	commits, err := a.gitRepo.GetCommitsBetween(ctx, fromRef, toRef)
+	// A time-bounded range without an explicit start reaches past the
+	// latest tag: the walk covers all history and Since bounds it.
+	walkFrom := fromRef
+	if !input.Since.IsZero() && input.FromRef == "" && input.BaseTag == "" {
+		walkFrom = ""
+	}
+
+	commits, err := a.gitRepo.GetCommitsBetween(ctx, walkFrom, toRef)
 	if err != nil {
 		return nil, version.SemanticVersion{}, "", nil, fmt.Errorf("failed to get commits: %w", err)
 	}
 
+	// Check ancestry before time filtering drops the root commit.
+	rangeCommits := commits
+	commits = filterSince(commits, input.Since)
+
 	if len(commits) == 0 {
 		return nil, version.SemanticVersion{}, "", nil, sourcecontrol.ErrNoCommits
 	}
 
+	// The commit walk stops at the base tag; reaching a root commit means
+	// the tag is not an ancestor of ToRef.
+	if input.BaseTag != "" {
+		for _, c := range rangeCommits {
+			if len(c.Parents()) == 0 {
+				return nil, version.SemanticVersion{}, "", nil, fmt.Errorf("base tag %s is not an ancestor of %s; check out a branch created from the tag", input.BaseTag, toRef)
+			}
+		}
+		if newer := newerReleaseInRange(tags, rangeCommits, currentVersion, input.TagPrefix); newer != nil {
+			return nil, version.SemanticVersion{}, "", nil, fmt.Errorf("%s is reachable from %s, so it is not a maintenance branch of %s; check out a branch created from the base tag", newer.Name(), toRef, input.BaseTag)
+		}
+	}
+
 	return repoInfo, currentVersion, fromRef, commits, nil
 }
 
+// hotfixBaseVersion validates the base tag of a hotfix release and returns
+// its version. The tag must be a stable version tag and the latest patch
+// release of its major.minor line, so the hotfix version is not taken.
+func hotfixBaseVersion(tags sourcecontrol.TagList, baseTag, prefix string) (version.SemanticVersion, error) {
+	var base *sourcecontrol.Tag
+	for _, t := range tags {
+		if t.Name() == baseTag {
+			base = t
+			break
+		}
+	}
+	if base == nil {
+		return version.SemanticVersion{}, fmt.Errorf("base tag %s does not exist", baseTag)
+	}
+
+	v, err := version.Parse(base.WithoutPrefix(prefix))
+	if err != nil || v.IsPrerelease() {
+		return version.SemanticVersion{}, fmt.Errorf("base tag %s is not a stable version tag", baseTag)
+	}
+
+	for _, t := range tags.FilterByPrefix(prefix) {
+		tv, err := version.Parse(t.WithoutPrefix(prefix))
+		if err != nil || tv.IsPrerelease() {
+			continue
+		}
+		if tv.Major() == v.Major() && tv.Minor() == v.Minor() && tv.GreaterThan(v) {
+			return version.SemanticVersion{}, fmt.Errorf("%s is a newer release of the %d.%d line; base the hotfix on it instead", t.Name(), v.Major(), v.Minor())
+		}
+	}
+
+	return v, nil
+}
+
+// newerReleaseInRange returns a version tag newer than base that points at
+// one of commits, or nil. A hotfix range containing such a tag would release
+// the newer line's changes as a patch of the old one.
+func newerReleaseInRange(tags sourcecontrol.TagList, commits []*sourcecontrol.Commit, base version.SemanticVersion, prefix string) *sourcecontrol.Tag {
+	inRange := make(map[sourcecontrol.CommitHash]bool, len(commits))
+	for _, c := range commits {
+		inRange[c.Hash()] = true
+	}
+	for _, t := range tags.FilterByPrefix(prefix) {
+		tv, err := version.Parse(t.WithoutPrefix(prefix))
+		if err != nil || !tv.GreaterThan(base) {
+			continue
+		}
+		if inRange[t.Hash()] {
+			return t
+		}
+	}
+	return nil
+}
+
+// excludeCommits removes the commits matching the given SHAs from commits and
+// returns the remaining commits along with the full hashes of those removed.
+func excludeCommits(commits []*sourcecontrol.Commit, shas []string) ([]*sourcecontrol.Commit, []sourcecontrol.CommitHash, error) {
+	if len(shas) == 0 {
+		return commits, nil, nil
+	}
+
+	inRange := make([]releasedomain.CommitSHA, len(commits))
+	for i, c := range commits {
+		inRange[i] = releasedomain.CommitSHA(c.Hash())
+	}
+
+	_, excludedSHAs, err := releasedomain.ExcludeCommits(inRange, shas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	excludedSet := make(map[sourcecontrol.CommitHash]bool, len(excludedSHAs))
+	excluded := make([]sourcecontrol.CommitHash, len(excludedSHAs))
+	for i, sha := range excludedSHAs {
+		excluded[i] = sourcecontrol.CommitHash(sha)
+		excludedSet[excluded[i]] = true
+	}
+
+	remaining := make([]*sourcecontrol.Commit, 0, len(commits)-len(excluded))
+	for _, c := range commits {
+		if !excludedSet[c.Hash()] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil, nil, sourcecontrol.ErrNoCommits
+	}
+
+	return remaining, excluded, nil
+}
+
 func (a *Analyzer) prepareCommitClassifications(ctx context.Context, commits []*sourcecontrol.Commit, input AnalyzeInput) (*analysis.AnalysisResult, map[sourcecontrol.CommitHash]*analysis.CommitClassification, error) {
 	// If manual classifications provided, use them
 	if len(input.CommitClassifications) > 0 {
@@ -371,6 +787,23 @@ func getSubject(message string) string {
 	return message
 }
 
+// nonConventionalCommit converts a non-conventional commit under the ignore
+// or patch policy: a chore, or a fix that bumps the patch version.
+func nonConventionalCommit(commit *sourcecontrol.Commit, policy NonConventionalPolicy) *changes.ConventionalCommit {
+	commitType := changes.CommitTypeChore
+	if policy == NonConventionalPatch {
+		commitType = changes.CommitTypeFix
+	}
+	return changes.NewConventionalCommit(
+		string(commit.Hash()),
+		commitType,
+		getSubject(commit.Message()),
+		changes.WithAuthor(commit.Author().Name, commit.Author().Email),
+		changes.WithDate(commit.Date()),
+		changes.WithRawMessage(commit.Message()),
+	)
+}
+
 func classificationToCommit(commit *sourcecontrol.Commit, classification *analysis.CommitClassification) *changes.ConventionalCommit {
 	commitType := classification.Type
 	if commitType == "" {
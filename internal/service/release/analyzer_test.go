@@ -3,12 +3,16 @@ package release
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/relicta-tech/relicta/internal/analysis"
 	analysisfactory "github.com/relicta-tech/relicta/internal/analysis/factory"
 	"github.com/relicta-tech/relicta/internal/domain/changes"
+	releasedomain "github.com/relicta-tech/relicta/internal/domain/release/domain"
+	"github.com/relicta-tech/relicta/internal/domain/release/ports"
 	"github.com/relicta-tech/relicta/internal/domain/sourcecontrol"
 	"github.com/relicta-tech/relicta/internal/domain/version"
 )
@@ -19,6 +23,8 @@ type mockGitRepo struct {
 	tags    sourcecontrol.TagList
 	commits []*sourcecontrol.Commit
 	err     error
+
+	from string // from reference of the last GetCommitsBetween call
 }
 
 func (m *mockGitRepo) GetInfo(ctx context.Context) (*sourcecontrol.RepositoryInfo, error) {
@@ -36,6 +42,7 @@ func (m *mockGitRepo) GetTags(ctx context.Context) (sourcecontrol.TagList, error
 }
 
 func (m *mockGitRepo) GetCommitsBetween(ctx context.Context, from, to string) ([]*sourcecontrol.Commit, error) {
+	m.from = from
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -313,6 +320,509 @@ func TestAnalyzer_Analyze_Success(t *testing.T) {
 	}
 }
 
+// previewNotesGenerator records the run and options it generates notes for.
+type previewNotesGenerator struct {
+	run     *releasedomain.ReleaseRun
+	options ports.NotesOptions
+}
+
+func (g *previewNotesGenerator) Generate(_ context.Context, run *releasedomain.ReleaseRun, options ports.NotesOptions) (*releasedomain.ReleaseNotes, error) {
+	g.run, g.options = run, options
+	return &releasedomain.ReleaseNotes{Text: "notes for " + run.VersionNext().String()}, nil
+}
+
+func (g *previewNotesGenerator) ComputeInputsHash(*releasedomain.ReleaseRun, ports.NotesOptions) string {
+	return ""
+}
+
+func TestAnalyzeOutput_ChangelogPreview(t *testing.T) {
+	v1, _ := version.Parse("1.1.0")
+
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			newTestCommit("abc123", "feat: add export"),
+			newTestCommit("def456", "fix(api): handle timeouts"),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{RepositoryPath: "/test/repo", TagPrefix: "v"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	generator := &previewNotesGenerator{}
+	options := ports.NotesOptions{UseAI: true, ExcludeScopes: []string{"deps"}, Template: "CHANGELOG.tmpl"}
+	preview, err := output.ChangelogPreview(context.Background(), generator, options)
+	if err != nil {
+		t.Fatalf("ChangelogPreview() error = %v", err)
+	}
+	if preview != "notes for 1.1.0" {
+		t.Errorf("ChangelogPreview() = %q, want the generated notes", preview)
+	}
+	if generator.run.ChangeSet() != output.ChangeSet {
+		t.Error("ChangelogPreview() should generate notes for the analyzed changeset")
+	}
+	if generator.options.UseAI || generator.options.Template != "CHANGELOG.tmpl" || len(generator.options.ExcludeScopes) != 1 {
+		t.Errorf("ChangelogPreview() options = %+v, want the configured options without AI", generator.options)
+	}
+
+	preview, err = (&AnalyzeOutput{}).ChangelogPreview(context.Background(), generator, options)
+	if err != nil || preview != "" {
+		t.Errorf("ChangelogPreview() without changeset = %q, %v, want empty", preview, err)
+	}
+}
+
+func TestAnalyzer_Analyze_Prerelease(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			newTestCommit("abc123", "feat: add new feature"),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{Prerelease: version.PrereleaseBeta})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.NextVersion.String() != "1.0.0-beta.1" {
+		t.Errorf("expected NextVersion 1.0.0-beta.1, got %s", output.NextVersion.String())
+	}
+}
+
+func TestAnalyzer_Analyze_CurrentVersion(t *testing.T) {
+	next := version.MustParse("2.5.0")
+	v2 := version.MustParse("2.4.0")
+
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			newTestCommit("abc123", "feat: add new feature"),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: next}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{CurrentVersion: &v2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.CurrentVersion.Equal(v2) {
+		t.Errorf("expected CurrentVersion %s, got %s", v2, output.CurrentVersion)
+	}
+}
+
+func TestAnalyzer_Analyze_ContinuePrerelease(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+	commits := []*sourcecontrol.Commit{newTestCommit("abc123", "fix: handle nil")}
+
+	tests := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{name: "stable current version", tag: "v0.9.0", want: "1.0.0"},
+		{name: "prerelease current version", tag: "v1.0.0-beta.1", want: "1.0.0-beta.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := &mockGitRepo{
+				info:    &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+				tags:    sourcecontrol.TagList{sourcecontrol.NewTag(tt.tag, "aaa111")},
+				commits: commits,
+			}
+			analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+			output, err := analyzer.Analyze(context.Background(), AnalyzeInput{TagPrefix: "v", ContinuePrerelease: version.PrereleaseBeta})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.NextVersion.String() != tt.want {
+				t.Errorf("expected NextVersion %s, got %s", tt.want, output.NextVersion.String())
+			}
+		})
+	}
+}
+
+func TestAnalyzer_Analyze_ExcludeCommits(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			newTestCommit("abc123", "feat!: breaking change"),
+			newTestCommit("def456", "fix: fix bug"),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{ExcludeCommits: []string{"abc"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ChangeSet.CommitCount() != 1 {
+		t.Errorf("expected 1 commit after exclusion, got %d", output.ChangeSet.CommitCount())
+	}
+	if output.ReleaseType != changes.ReleaseTypePatch {
+		t.Errorf("expected patch release after excluding breaking commit, got %s", output.ReleaseType)
+	}
+	if len(output.ExcludedCommits) != 1 || output.ExcludedCommits[0] != "abc123" {
+		t.Errorf("expected ExcludedCommits [abc123], got %v", output.ExcludedCommits)
+	}
+
+	if _, err := analyzer.Analyze(context.Background(), AnalyzeInput{ExcludeCommits: []string{"999"}}); err == nil {
+		t.Error("expected error for commit outside the range")
+	}
+}
+
+func TestAnalyzer_Analyze_Since(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+	now := time.Now()
+	author := sourcecontrol.Author{Name: "Test User", Email: "test@example.com"}
+
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			sourcecontrol.NewCommit("abc123", "fix: recent fix", author, now.Add(-time.Hour)),
+			sourcecontrol.NewCommit("def456", "feat!: old breaking change", author, now.Add(-72*time.Hour)),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	since := now.Add(-24 * time.Hour)
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{Since: since})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ChangeSet.CommitCount() != 1 {
+		t.Errorf("expected 1 commit within window, got %d", output.ChangeSet.CommitCount())
+	}
+	if output.ReleaseType != changes.ReleaseTypePatch {
+		t.Errorf("expected patch release, got %s", output.ReleaseType)
+	}
+	if !output.Window.Since.Equal(since) {
+		t.Errorf("expected window since %v, got %v", since, output.Window.Since)
+	}
+	if !output.Window.Oldest.Equal(now.Add(-time.Hour)) || !output.Window.Newest.Equal(now.Add(-time.Hour)) {
+		t.Errorf("unexpected window bounds: %+v", output.Window)
+	}
+
+	if _, err := analyzer.Analyze(context.Background(), AnalyzeInput{Since: now.Add(time.Minute)}); err != sourcecontrol.ErrNoCommits {
+		t.Errorf("expected ErrNoCommits for empty window, got %v", err)
+	}
+}
+
+func TestAnalyzer_Analyze_SinceReachesPastLatestTag(t *testing.T) {
+	v2, _ := version.Parse("2.0.0")
+	now := time.Now()
+	author := sourcecontrol.Author{Name: "Test User", Email: "test@example.com"}
+
+	tag := sourcecontrol.NewTag("v1.0.0", "tagged")
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{tag},
+		commits: []*sourcecontrol.Commit{
+			sourcecontrol.NewCommit("abc123", "fix: recent fix", author, now.Add(-time.Hour)),
+			sourcecontrol.NewCommit("tagged", "feat: before the tag", author, now.Add(-48*time.Hour)),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, &testVersionCalc{nextVersion: v2}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{Since: now.Add(-72 * time.Hour)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gitRepo.from != "" {
+		t.Errorf("expected the walk to start from ToRef only, got from %q", gitRepo.from)
+	}
+	if output.ChangeSet.CommitCount() != 2 {
+		t.Errorf("expected commits before the tag within the window, got %d", output.ChangeSet.CommitCount())
+	}
+	if output.CurrentVersion.String() != "1.0.0" {
+		t.Errorf("expected current version from the latest tag, got %s", output.CurrentVersion)
+	}
+
+	if _, err := analyzer.Analyze(context.Background(), AnalyzeInput{FromRef: "v1.0.0", Since: now.Add(-72 * time.Hour)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gitRepo.from != "v1.0.0" {
+		t.Errorf("expected --from to bound the walk, got from %q", gitRepo.from)
+	}
+}
+
+func TestCommitWindow_Fields(t *testing.T) {
+	window := CommitWindow{
+		Since:  time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		Oldest: time.Date(2024, 6, 2, 9, 0, 0, 0, time.UTC),
+		Newest: time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC),
+	}
+	fields := window.Fields()
+	if fields["since"] != "2024-06-01T00:00:00Z" || fields["oldest"] != "2024-06-02T09:00:00Z" || fields["newest"] != "2024-06-03T09:00:00Z" {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+	if _, ok := (CommitWindow{}).Fields()["since"]; ok {
+		t.Error("since should be omitted when the window is not time-bounded")
+	}
+}
+
+func TestAnalyzer_Analyze_NonConventionalPolicy(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+	commits := []*sourcecontrol.Commit{
+		newTestCommit("abc123", "docs: update readme"),
+		newTestCommit("def456", "tweak the parser"),
+		newTestCommit("fed789", "more tweaks"),
+	}
+	analyzer := NewAnalyzer(&mockGitRepo{
+		info:    &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags:    sourcecontrol.TagList{},
+		commits: commits,
+	}, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	tests := []struct {
+		policy          NonConventionalPolicy
+		wantReleaseType changes.ReleaseType
+	}{
+		{policy: NonConventionalIgnore, wantReleaseType: changes.ReleaseTypeNone},
+		{policy: NonConventionalPatch, wantReleaseType: changes.ReleaseTypePatch},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			output, err := analyzer.Analyze(context.Background(), AnalyzeInput{NonConventionalPolicy: tt.policy})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			if output.ReleaseType != tt.wantReleaseType {
+				t.Errorf("ReleaseType = %s, want %s", output.ReleaseType, tt.wantReleaseType)
+			}
+			if output.ChangeSet.CommitCount() != 3 {
+				t.Errorf("CommitCount() = %d, want 3", output.ChangeSet.CommitCount())
+			}
+			if output.NonConventional.Policy != tt.policy || len(output.NonConventional.Commits) != 2 {
+				t.Errorf("NonConventional = %+v, want 2 commits under %s", output.NonConventional, tt.policy)
+			}
+			if output.Analysis != nil {
+				t.Error("Analysis is set; non-conventional commits should not be classified")
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		_, err := analyzer.Analyze(context.Background(), AnalyzeInput{NonConventionalPolicy: NonConventionalError})
+		var ncErr *NonConventionalCommitsError
+		if !errors.As(err, &ncErr) {
+			t.Fatalf("Analyze() error = %v, want NonConventionalCommitsError", err)
+		}
+		if len(ncErr.Commits) != 2 || !strings.Contains(err.Error(), "tweak the parser") {
+			t.Errorf("error = %v, want both offenders listed", err)
+		}
+	})
+
+	t.Run("default infers", func(t *testing.T) {
+		output, err := analyzer.Analyze(context.Background(), AnalyzeInput{})
+		if err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+		if output.NonConventional.Policy != NonConventionalInfer || len(output.NonConventional.Commits) != 2 {
+			t.Errorf("NonConventional = %+v, want 2 commits under infer", output.NonConventional)
+		}
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := analyzer.Analyze(context.Background(), AnalyzeInput{NonConventionalPolicy: "strict"})
+		if err == nil || !strings.Contains(err.Error(), "non-conventional commit policy") {
+			t.Errorf("Analyze() error = %v", err)
+		}
+	})
+}
+
+func TestAnalyzer_Analyze_CommitParser(t *testing.T) {
+	v1, _ := version.Parse("1.0.0")
+	analyzer := NewAnalyzer(&mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		tags: sourcecontrol.TagList{},
+		commits: []*sourcecontrol.Commit{
+			newTestCommit("abc123", ":sparkles: Add CSV export"),
+			newTestCommit("def456", "🐛 (parser) Handle empty input"),
+			newTestCommit("fed789", "feat: conventional commit"),
+		},
+	}, &testVersionCalc{nextVersion: v1}, analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{
+		NonConventionalPolicy: NonConventionalIgnore,
+		CommitParser:          changes.GitmojiParser{},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if output.ReleaseType != changes.ReleaseTypeMinor {
+		t.Errorf("ReleaseType = %s, want minor from :sparkles:", output.ReleaseType)
+	}
+	cats := output.ChangeSet.Categories()
+	if len(cats.Features) != 1 || cats.Features[0].Subject() != "Add CSV export" {
+		t.Errorf("Features = %v, want the gitmoji feature", cats.Features)
+	}
+	if len(cats.Fixes) != 1 || cats.Fixes[0].Scope() != "parser" {
+		t.Errorf("Fixes = %v, want the scoped gitmoji fix", cats.Fixes)
+	}
+	if len(output.NonConventional.Commits) != 1 || output.NonConventional.Commits[0] != "fed789" {
+		t.Errorf("NonConventional = %+v, want the conventional commit unparsed", output.NonConventional)
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "36h", want: now.Add(-36 * time.Hour)},
+		{value: "7d", want: now.AddDate(0, 0, -7)},
+		{value: "2w", want: now.AddDate(0, 0, -14)},
+		{value: "2024-06-01", want: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-06-01T08:30:00Z", want: time.Date(2024, 6, 1, 8, 30, 0, 0, time.UTC)},
+		{value: "-2h", wantErr: true},
+		{value: "0d", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSince(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseSince(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// newHotfixCommit creates a test commit with a parent, so the commit walk
+// does not look like it reached the root of the history.
+func newHotfixCommit(hash, message string) *sourcecontrol.Commit {
+	c := newTestCommit(hash, message)
+	c.SetParents([]sourcecontrol.CommitHash{"parent"})
+	return c
+}
+
+func TestAnalyzer_Analyze_Hotfix(t *testing.T) {
+	tags := sourcecontrol.TagList{
+		sourcecontrol.NewTag("v1.1.0", "aaa111"),
+		sourcecontrol.NewTag("v1.2.0", "bbb222"),
+	}
+	gitRepo := &mockGitRepo{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "hotfix/1.1"},
+		tags: tags,
+		commits: []*sourcecontrol.Commit{
+			newHotfixCommit("abc123", "feat: backport option"),
+			newHotfixCommit("def456", "fix: patch security issue"),
+		},
+	}
+	analyzer := NewAnalyzer(gitRepo, version.NewDefaultVersionCalculator(), analysisfactory.NewFactory(nil))
+
+	output, err := analyzer.Analyze(context.Background(), AnalyzeInput{TagPrefix: "v", BaseTag: "v1.1.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.CurrentVersion.String() != "1.1.0" {
+		t.Errorf("expected CurrentVersion 1.1.0, got %s", output.CurrentVersion.String())
+	}
+	if output.NextVersion.String() != "1.1.1" {
+		t.Errorf("expected NextVersion 1.1.1, got %s", output.NextVersion.String())
+	}
+	if output.ReleaseType != changes.ReleaseTypePatch {
+		t.Errorf("expected patch release, got %s", output.ReleaseType)
+	}
+	if output.ChangeSet.FromRef() != "v1.1.0" {
+		t.Errorf("expected changeset to start at v1.1.0, got %s", output.ChangeSet.FromRef())
+	}
+	if output.BaseTag != "v1.1.0" {
+		t.Errorf("expected BaseTag v1.1.0, got %q", output.BaseTag)
+	}
+}
+
+func TestAnalyzer_Analyze_HotfixErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    sourcecontrol.TagList
+		commits []*sourcecontrol.Commit
+		input   AnalyzeInput
+		wantErr string
+	}{
+		{
+			name:    "missing base tag",
+			tags:    sourcecontrol.TagList{sourcecontrol.NewTag("v1.2.0", "bbb222")},
+			commits: []*sourcecontrol.Commit{newHotfixCommit("abc123", "fix: bug")},
+			input:   AnalyzeInput{TagPrefix: "v", BaseTag: "v1.1.0"},
+			wantErr: "does not exist",
+		},
+		{
+			name:    "newer patch release exists",
+			tags:    sourcecontrol.TagList{sourcecontrol.NewTag("v1.1.0", "aaa111"), sourcecontrol.NewTag("v1.1.1", "ccc333")},
+			commits: []*sourcecontrol.Commit{newHotfixCommit("abc123", "fix: bug")},
+			input:   AnalyzeInput{TagPrefix: "v", BaseTag: "v1.1.0"},
+			wantErr: "v1.1.1 is a newer release",
+		},
+		{
+			name: "newer release reachable from head",
+			tags: sourcecontrol.TagList{sourcecontrol.NewTag("v1.1.0", "aaa111"), sourcecontrol.NewTag("v1.2.0", "bbb222")},
+			commits: []*sourcecontrol.Commit{
+				newHotfixCommit("abc123", "fix: bug"),
+				newHotfixCommit("bbb222", "feat: new line"),
+			},
+			input:   AnalyzeInput{TagPrefix: "v", BaseTag: "v1.1.0"},
+			wantErr: "v1.2.0 is reachable from HEAD",
+		},
+		{
+			name:    "base tag is not an ancestor",
+			tags:    sourcecontrol.TagList{sourcecontrol.NewTag("v1.1.0", "aaa111")},
+			commits: []*sourcecontrol.Commit{newTestCommit("abc123", "fix: bug")},
+			input:   AnalyzeInput{TagPrefix: "v", BaseTag: "v1.1.0"},
+			wantErr: "not an ancestor",
+		},
+		{
+			name:    "combined with from reference",
+			input:   AnalyzeInput{BaseTag: "v1.1.0", FromRef: "v1.0.0"},
+			wantErr: "cannot be combined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := &mockGitRepo{
+				info:    &sourcecontrol.RepositoryInfo{Name: "test-repo"},
+				tags:    tt.tags,
+				commits: tt.commits,
+			}
+			analyzer := NewAnalyzer(gitRepo, version.NewDefaultVersionCalculator(), analysisfactory.NewFactory(nil))
+
+			_, err := analyzer.Analyze(context.Background(), tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestAnalyzer_Analyze_InvalidInput(t *testing.T) {
 	gitRepo := &mockGitRepo{}
 	versionCalc := newTestVersionCalc()
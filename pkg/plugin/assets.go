@@ -0,0 +1,142 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// AssetNameData is the data available to an asset_name_template.
+type AssetNameData struct {
+	// Version is the release version without a "v" prefix (e.g. "1.2.0").
+	Version string
+	// OS is the operating system found in the local file name (e.g. "linux"), if any.
+	OS string
+	// Arch is the architecture found in the local file name (e.g. "amd64"), if any.
+	Arch string
+	// Base is the local file name without its extension and without the
+	// OS and architecture parts (e.g. "myapp" for "myapp-linux-amd64.tar.gz").
+	Base string
+	// Ext is the file extension, including compound archive extensions (e.g. ".tar.gz").
+	Ext string
+}
+
+// knownOS are operating system names recognized in asset file names.
+var knownOS = map[string]string{
+	"linux": "linux", "darwin": "darwin", "macos": "darwin", "windows": "windows",
+	"freebsd": "freebsd", "openbsd": "openbsd", "netbsd": "netbsd", "android": "android",
+	"illumos": "illumos", "solaris": "solaris", "aix": "aix",
+}
+
+// knownArch are architecture names recognized in asset file names.
+var knownArch = map[string]string{
+	"amd64": "amd64", "arm64": "arm64", "aarch64": "arm64",
+	"386": "386", "i386": "386", "arm": "arm", "armv6": "armv6", "armv7": "armv7",
+	"ppc64le": "ppc64le", "s390x": "s390x", "riscv64": "riscv64", "universal": "universal",
+}
+
+// compoundExts are multi-part extensions kept together.
+var compoundExts = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst"}
+
+// NewAssetNameData derives the template data for a local asset path.
+func NewAssetNameData(assetPath, version string) AssetNameData {
+	name := filepath.Base(assetPath)
+
+	ext := filepath.Ext(name)
+	for _, ce := range compoundExts {
+		if strings.HasSuffix(strings.ToLower(name), ce) {
+			ext = name[len(name)-len(ce):]
+			break
+		}
+	}
+	stem := strings.TrimSuffix(name, ext)
+
+	data := AssetNameData{Version: strings.TrimPrefix(version, "v"), Ext: ext}
+	// "x86_64" contains a separator; treat it as a single part.
+	parts := strings.NewReplacer("x86_64", "amd64", "X86_64", "amd64").Replace(stem)
+
+	var base []string
+	for _, part := range strings.FieldsFunc(parts, func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+		lower := strings.ToLower(part)
+		if goos, ok := knownOS[lower]; ok && data.OS == "" {
+			data.OS = goos
+			continue
+		}
+		if arch, ok := knownArch[lower]; ok && data.Arch == "" {
+			data.Arch = arch
+			continue
+		}
+		base = append(base, part)
+	}
+	data.Base = strings.Join(base, "-")
+	if data.Base == "" {
+		data.Base = stem
+	}
+	return data
+}
+
+// ParseAssetNameTemplate parses and checks an asset_name_template. The
+// template must only reference AssetNameData fields and must render a
+// plain file name.
+func ParseAssetNameTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("asset_name_template").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset_name_template: %w", err)
+	}
+	sample := AssetNameData{Version: "1.0.0", OS: "linux", Arch: "amd64", Base: "app", Ext: ".tar.gz"}
+	if _, err := renderAssetName(tmpl, sample); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// RenderAssetNames returns the upload name of each asset, in order. An
+// empty template keeps the local file names. Two assets mapping to the
+// same name are an error. Plugins that upload release assets call it to
+// honor their asset_name_template option; relicta does not rename assets
+// on their behalf.
+func RenderAssetNames(text, version string, assetPaths []string) ([]string, error) {
+	var tmpl *template.Template
+	if text != "" {
+		var err error
+		if tmpl, err = ParseAssetNameTemplate(text); err != nil {
+			return nil, err
+		}
+	}
+
+	names := make([]string, len(assetPaths))
+	seen := make(map[string]string, len(assetPaths))
+	for i, assetPath := range assetPaths {
+		name := filepath.Base(assetPath)
+		if tmpl != nil {
+			var err error
+			if name, err = renderAssetName(tmpl, NewAssetNameData(assetPath, version)); err != nil {
+				return nil, fmt.Errorf("asset %s: %w", assetPath, err)
+			}
+		}
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("assets %s and %s both map to %q", other, assetPath, name)
+		}
+		seen[name] = assetPath
+		names[i] = name
+	}
+	return names, nil
+}
+
+// renderAssetName executes the template and checks the result is a file name.
+func renderAssetName(tmpl *template.Template, data AssetNameData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid asset_name_template: %w", err)
+	}
+	name := strings.TrimSpace(buf.String())
+	switch {
+	case name == "":
+		return "", fmt.Errorf("asset_name_template renders an empty name")
+	case strings.ContainsAny(name, `/\`) || name == "." || name == "..":
+		return "", fmt.Errorf("asset_name_template must render a file name, got %q", name)
+	}
+	return name, nil
+}
@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewAssetNameData(t *testing.T) {
+	tests := []struct {
+		path string
+		want AssetNameData
+	}{
+		{"dist/myapp-linux-amd64.tar.gz", AssetNameData{Version: "1.2.0", OS: "linux", Arch: "amd64", Base: "myapp", Ext: ".tar.gz"}},
+		{"dist/myapp_Darwin_x86_64.zip", AssetNameData{Version: "1.2.0", OS: "darwin", Arch: "amd64", Base: "myapp", Ext: ".zip"}},
+		{"build/my-cli-windows-arm64.exe", AssetNameData{Version: "1.2.0", OS: "windows", Arch: "arm64", Base: "my-cli", Ext: ".exe"}},
+		{"checksums.txt", AssetNameData{Version: "1.2.0", Base: "checksums", Ext: ".txt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := NewAssetNameData(tt.path, "v1.2.0"); got != tt.want {
+				t.Errorf("NewAssetNameData() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderAssetNames(t *testing.T) {
+	paths := []string{"dist/myapp-linux-amd64.tar.gz", "dist/myapp-darwin-arm64.tar.gz"}
+
+	names, err := RenderAssetNames("{{.Base}}_{{.Version}}_{{.OS}}_{{.Arch}}{{.Ext}}", "1.2.0", paths)
+	if err != nil {
+		t.Fatalf("RenderAssetNames() error = %v", err)
+	}
+	want := []string{"myapp_1.2.0_linux_amd64.tar.gz", "myapp_1.2.0_darwin_arm64.tar.gz"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("names[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	names, err = RenderAssetNames("", "1.2.0", paths)
+	if err != nil || names[0] != "myapp-linux-amd64.tar.gz" {
+		t.Errorf("RenderAssetNames() without template = %v, %v", names, err)
+	}
+}
+
+func TestRenderAssetNames_Collision(t *testing.T) {
+	paths := []string{"dist/myapp-linux-amd64.tar.gz", "dist/myapp-darwin-amd64.tar.gz"}
+
+	_, err := RenderAssetNames("myapp_{{.Version}}_{{.Arch}}.tar.gz", "1.2.0", paths)
+	if err == nil || !strings.Contains(err.Error(), `both map to "myapp_1.2.0_amd64.tar.gz"`) {
+		t.Errorf("RenderAssetNames() error = %v, want collision error", err)
+	}
+
+	_, err = RenderAssetNames("", "1.2.0", []string{"a/app.zip", "b/app.zip"})
+	if err == nil {
+		t.Error("RenderAssetNames() expected collision error for identical file names")
+	}
+}
+
+func TestParseAssetNameTemplate_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"syntax":        "{{.Version",
+		"unknown field": "{{.Platform}}.zip",
+		"empty":         "{{if false}}x{{end}}",
+		"path":          "{{.OS}}/{{.Base}}{{.Ext}}",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseAssetNameTemplate(text); err == nil {
+				t.Errorf("ParseAssetNameTemplate(%q) expected error", text)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package plugin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultForgeMaxWait is the longest a ForgeClient waits for a rate limit
+// to reset before failing a request.
+const DefaultForgeMaxWait = time.Minute
+
+// forgeThrottleFraction is the share of the rate limit below which requests
+// are spread over the time left until the limit resets.
+const forgeThrottleFraction = 10 // 1/10 = 10%
+
+// ErrRateLimited is returned when a forge API rate limit is exhausted and
+// does not reset within the client's maximum wait.
+var ErrRateLimited = errors.New("forge API rate limit exceeded")
+
+// RateLimit is the API rate limit state reported by a forge.
+type RateLimit struct {
+	// Limit is the number of requests allowed per window.
+	Limit int `json:"limit"`
+	// Remaining is the number of requests left in the current window.
+	Remaining int `json:"remaining"`
+	// Reset is when the current window ends.
+	Reset time.Time `json:"reset"`
+}
+
+// Known reports whether the forge has reported a rate limit.
+func (r RateLimit) Known() bool {
+	return r.Limit > 0
+}
+
+// ForgeClient is an HTTP client for a forge API (GitHub, GitLab, Gitea)
+// that authenticates requests and tracks the API rate limit from response
+// headers. When fewer than 10% of the requests remain it spreads requests
+// over the rest of the window, and when the limit is exhausted it waits for
+// the reset or fails with ErrRateLimited.
+//
+// A ForgeClient is safe for concurrent use. Use SharedForgeClient so that
+// features calling the same forge with the same token share one client and
+// one view of the rate limit.
+type ForgeClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	logger     *slog.Logger
+	maxWait    time.Duration
+	now        func() time.Time
+	sleep      func(ctx context.Context, d time.Duration) error
+
+	mu   sync.Mutex
+	rate RateLimit
+}
+
+// ForgeOption configures a ForgeClient.
+type ForgeOption func(*ForgeClient)
+
+// WithForgeHTTPClient sets the HTTP client used for API calls.
+func WithForgeHTTPClient(client *http.Client) ForgeOption {
+	return func(c *ForgeClient) {
+		c.httpClient = client
+	}
+}
+
+// WithForgeLogger sets the logger used to report the rate limit at debug
+// level.
+func WithForgeLogger(logger *slog.Logger) ForgeOption {
+	return func(c *ForgeClient) {
+		c.logger = logger
+	}
+}
+
+// WithForgeMaxWait sets the longest the client waits for a rate limit to
+// reset before failing with ErrRateLimited.
+func WithForgeMaxWait(d time.Duration) ForgeOption {
+	return func(c *ForgeClient) {
+		c.maxWait = d
+	}
+}
+
+// NewForgeClient creates a client for the forge API at baseURL (e.g.
+// "https://api.github.com"), authenticating with token if it is not empty.
+func NewForgeClient(baseURL, token string, opts ...ForgeOption) *ForgeClient {
+	c := &ForgeClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+		},
+		maxWait: DefaultForgeMaxWait,
+		now:     time.Now,
+		sleep:   sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+var (
+	forgeClientsMu sync.Mutex
+	forgeClients   = make(map[string]*ForgeClient)
+)
+
+// SharedForgeClient returns the client for baseURL and token, creating it
+// on first use. Clients are shared per set of options, so callers passing a
+// different HTTP client, logger or maximum wait get a client of their own.
+func SharedForgeClient(baseURL, token string, opts ...ForgeOption) *ForgeClient {
+	key := forgeClientKey(baseURL, token, opts)
+
+	forgeClientsMu.Lock()
+	defer forgeClientsMu.Unlock()
+
+	if c, ok := forgeClients[key]; ok {
+		return c
+	}
+	c := NewForgeClient(baseURL, token, opts...)
+	forgeClients[key] = c
+	return c
+}
+
+// forgeClientKey identifies a shared client by API URL, token and the
+// settings of opts without keeping the token itself as a map key.
+func forgeClientKey(baseURL, token string, opts []ForgeOption) string {
+	var settings ForgeClient
+	for _, opt := range opts {
+		opt(&settings)
+	}
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%s|%s|%p|%p|%d",
+		strings.ToLower(strings.TrimSuffix(baseURL, "/")), hex.EncodeToString(sum[:]),
+		settings.httpClient, settings.logger, settings.maxWait)
+}
+
+// BaseURL returns the API base URL.
+func (c *ForgeClient) BaseURL() string {
+	return c.baseURL
+}
+
+// RateLimit returns the last rate limit reported by the forge.
+func (c *ForgeClient) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rate
+}
+
+// NewRequest creates a request for path relative to the API base URL.
+func (c *ForgeClient) NewRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, method, c.baseURL+"/"+strings.TrimPrefix(path, "/"), body)
+}
+
+// Do sends an authenticated request. It throttles before sending when the
+// rate limit is nearly exhausted and retries once after the forge rejects
+// the request for exceeding it. The caller must close the response body.
+func (c *ForgeClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if c.token != "" && req.Header.Get("Authorization") == "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", "relicta")
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.throttle(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		c.updateRateLimit(resp.Header)
+
+		if !isRateLimited(resp) {
+			return resp, nil
+		}
+		wait := c.retryAfter(resp.Header)
+		_ = resp.Body.Close()
+		if attempt > 0 || wait > c.maxWait {
+			return nil, c.rateLimitError()
+		}
+
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return nil, c.rateLimitError()
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		c.log("forge rate limit exceeded, retrying", "wait", wait.String())
+		if err := c.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// throttle delays a request when the rate limit is nearly exhausted.
+func (c *ForgeClient) throttle(ctx context.Context) error {
+	rate := c.RateLimit()
+	if !rate.Known() || rate.Remaining > rate.Limit/forgeThrottleFraction {
+		return nil
+	}
+	untilReset := rate.Reset.Sub(c.now())
+	if untilReset <= 0 {
+		return nil
+	}
+
+	wait := untilReset / time.Duration(rate.Remaining+1)
+	if rate.Remaining == 0 {
+		wait = untilReset
+		if wait > c.maxWait {
+			return c.rateLimitError()
+		}
+	}
+	if wait > c.maxWait {
+		wait = c.maxWait
+	}
+
+	c.log("throttling forge requests", "remaining", rate.Remaining, "limit", rate.Limit, "wait", wait.String())
+	return c.sleep(ctx, wait)
+}
+
+// updateRateLimit records the rate limit headers of a response. GitHub and
+// Gitea send X-RateLimit-*, GitLab sends RateLimit-*.
+func (c *ForgeClient) updateRateLimit(h http.Header) {
+	limit, okLimit := headerInt(h, "X-RateLimit-Limit", "RateLimit-Limit")
+	remaining, okRemaining := headerInt(h, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if !okLimit || !okRemaining {
+		return
+	}
+	rate := RateLimit{Limit: limit, Remaining: remaining}
+	if reset, ok := headerInt(h, "X-RateLimit-Reset", "RateLimit-Reset"); ok {
+		rate.Reset = time.Unix(int64(reset), 0)
+	}
+
+	c.mu.Lock()
+	c.rate = rate
+	c.mu.Unlock()
+
+	c.log("forge rate limit", "remaining", rate.Remaining, "limit", rate.Limit, "reset", rate.Reset.Format(time.RFC3339))
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited
+// request, from Retry-After or the rate limit reset time.
+func (c *ForgeClient) retryAfter(h http.Header) time.Duration {
+	if seconds, ok := headerInt(h, "Retry-After"); ok {
+		return time.Duration(seconds) * time.Second
+	}
+	if rate := c.RateLimit(); !rate.Reset.IsZero() {
+		if wait := rate.Reset.Sub(c.now()); wait > 0 {
+			return wait
+		}
+	}
+	return time.Second
+}
+
+// rateLimitError describes an exhausted rate limit.
+func (c *ForgeClient) rateLimitError() error {
+	host := c.baseURL
+	if u, err := url.Parse(c.baseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	rate := c.RateLimit()
+	if rate.Reset.IsZero() {
+		return fmt.Errorf("%w for %s", ErrRateLimited, host)
+	}
+	return fmt.Errorf("%w for %s (resets at %s)", ErrRateLimited, host, rate.Reset.Format(time.RFC3339))
+}
+
+func (c *ForgeClient) log(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Debug(msg, append([]any{"api", c.baseURL}, args...)...)
+	}
+}
+
+// isRateLimited reports whether the forge rejected a request for exceeding
+// its rate limit: 429, or 403 with no requests remaining or a Retry-After.
+func isRateLimited(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		if resp.Header.Get("Retry-After") != "" {
+			return true
+		}
+		remaining, ok := headerInt(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+		return ok && remaining == 0
+	}
+	return false
+}
+
+// headerInt returns the first of the named headers holding an integer.
+func headerInt(h http.Header, names ...string) (int, bool) {
+	for _, name := range names {
+		if v := h.Get(name); v != "" {
+			if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
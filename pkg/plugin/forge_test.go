@@ -0,0 +1,229 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a controllable clock; sleeping advances it.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func (f *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	f.sleeps = append(f.sleeps, d)
+	f.now = f.now.Add(d)
+	return nil
+}
+
+// newTestForgeClient creates a client for server using clock.
+func newTestForgeClient(server *httptest.Server, clock *fakeClock, opts ...ForgeOption) *ForgeClient {
+	c := NewForgeClient(server.URL, "test-token", append([]ForgeOption{WithForgeHTTPClient(server.Client())}, opts...)...)
+	c.now = clock.Now
+	c.sleep = clock.Sleep
+	return c
+}
+
+// rateLimitServer answers with the given remaining count and a reset one
+// minute after start.
+func rateLimitServer(t *testing.T, start time.Time, remaining *atomic.Int64, status func() int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(remaining.Load(), 10))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(start.Add(time.Minute).Unix(), 10))
+		w.WriteHeader(status())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doGet(t *testing.T, c *ForgeClient) (*http.Response, error) {
+	t.Helper()
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "/rate_limit", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := c.Do(req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestForgeClient_TracksRateLimit(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	clock := &fakeClock{now: start}
+	var remaining atomic.Int64
+	remaining.Store(90)
+	server := rateLimitServer(t, start, &remaining, func() int { return http.StatusOK })
+	c := newTestForgeClient(server, clock)
+
+	if c.RateLimit().Known() {
+		t.Error("RateLimit().Known() = true before any request")
+	}
+	if _, err := doGet(t, c); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	rate := c.RateLimit()
+	if rate.Limit != 100 || rate.Remaining != 90 || !rate.Reset.Equal(start.Add(time.Minute)) {
+		t.Errorf("RateLimit() = %+v", rate)
+	}
+	if len(clock.sleeps) != 0 {
+		t.Errorf("client slept %v with plenty of requests left", clock.sleeps)
+	}
+}
+
+func TestForgeClient_ThrottlesWhenApproachingLimit(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	clock := &fakeClock{now: start}
+	var remaining atomic.Int64
+	remaining.Store(5)
+	server := rateLimitServer(t, start, &remaining, func() int { return http.StatusOK })
+	c := newTestForgeClient(server, clock)
+
+	// The first request learns the limit; the second is spread over the
+	// minute left for the 5 remaining requests.
+	for i := 0; i < 2; i++ {
+		if _, err := doGet(t, c); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 10*time.Second {
+		t.Errorf("sleeps = %v, want [10s]", clock.sleeps)
+	}
+}
+
+func TestForgeClient_WaitsForResetWhenExhausted(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	clock := &fakeClock{now: start}
+	var remaining atomic.Int64
+	server := rateLimitServer(t, start, &remaining, func() int { return http.StatusOK })
+
+	t.Run("waits when the reset is within the maximum wait", func(t *testing.T) {
+		clock.now, clock.sleeps = start, nil
+		c := newTestForgeClient(server, clock)
+		c.rate = RateLimit{Limit: 100, Remaining: 0, Reset: start.Add(30 * time.Second)}
+
+		if _, err := doGet(t, c); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		if len(clock.sleeps) != 1 || clock.sleeps[0] != 30*time.Second {
+			t.Errorf("sleeps = %v, want [30s]", clock.sleeps)
+		}
+	})
+
+	t.Run("fails when the reset is too far away", func(t *testing.T) {
+		clock.now, clock.sleeps = start, nil
+		c := newTestForgeClient(server, clock, WithForgeMaxWait(10*time.Second))
+		c.rate = RateLimit{Limit: 100, Remaining: 0, Reset: start.Add(30 * time.Second)}
+
+		_, err := doGet(t, c)
+		if !errors.Is(err, ErrRateLimited) {
+			t.Fatalf("Do() error = %v, want ErrRateLimited", err)
+		}
+		if !strings.Contains(err.Error(), "resets at") {
+			t.Errorf("Do() error = %v, want reset time", err)
+		}
+	})
+}
+
+func TestForgeClient_RetriesAfterRateLimitResponse(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	clock := &fakeClock{now: start}
+	c := newTestForgeClient(server, clock)
+
+	req, err := c.NewRequest(context.Background(), http.MethodPost, "repos/o/r/releases", strings.NewReader(`{"tag_name":"v1.0.0"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || requests.Load() != 2 {
+		t.Errorf("status = %d after %d requests, want 200 after 2", resp.StatusCode, requests.Load())
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 5*time.Second {
+		t.Errorf("sleeps = %v, want [5s]", clock.sleeps)
+	}
+}
+
+func TestForgeClient_ExceededLimitFails(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	var remaining atomic.Int64
+	server := rateLimitServer(t, start, &remaining, func() int { return http.StatusForbidden })
+
+	clock := &fakeClock{now: start}
+	c := newTestForgeClient(server, clock, WithForgeMaxWait(10*time.Second))
+
+	_, err := doGet(t, c)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Do() error = %v, want ErrRateLimited", err)
+	}
+	if len(clock.sleeps) != 0 {
+		t.Errorf("sleeps = %v, want no wait beyond the maximum", clock.sleeps)
+	}
+}
+
+func TestSharedForgeClient(t *testing.T) {
+	a := SharedForgeClient("https://api.example.com/", "token-a")
+	if b := SharedForgeClient("https://API.example.com", "token-a"); a != b {
+		t.Error("SharedForgeClient() returned different clients for the same host and token")
+	}
+	if c := SharedForgeClient("https://api.example.com", "token-b"); a == c {
+		t.Error("SharedForgeClient() shared a client between tokens")
+	}
+	if d := SharedForgeClient("https://gitlab.example.com/api/v4", "token-a"); a == d {
+		t.Error("SharedForgeClient() shared a client between hosts")
+	}
+}
+
+func TestSharedForgeClient_Options(t *testing.T) {
+	httpClient := &http.Client{}
+	a := SharedForgeClient("https://api.options.example.com", "token", WithForgeHTTPClient(httpClient))
+	if a.httpClient != httpClient {
+		t.Error("SharedForgeClient() ignored the HTTP client option")
+	}
+	if b := SharedForgeClient("https://api.options.example.com", "token", WithForgeHTTPClient(httpClient)); a != b {
+		t.Error("SharedForgeClient() returned different clients for the same options")
+	}
+
+	other := &http.Client{}
+	c := SharedForgeClient("https://api.options.example.com", "token", WithForgeHTTPClient(other))
+	if c == a || c.httpClient != other {
+		t.Error("SharedForgeClient() ignored a different HTTP client option")
+	}
+	d := SharedForgeClient("https://api.options.example.com", "token", WithForgeHTTPClient(httpClient), WithForgeMaxWait(time.Second))
+	if d == a || d.maxWait != time.Second {
+		t.Error("SharedForgeClient() ignored a different maximum wait")
+	}
+}
@@ -48,6 +48,13 @@ func (s *GRPCServer) GetInfo(ctx context.Context, req *proto.Empty) (*proto.Plug
 		hooks[i] = string(h)
 	}
 
+	// Convert contributed steps to JSON
+	var stepsJSON string
+	if len(info.Steps) > 0 {
+		data, _ := json.Marshal(info.Steps)
+		stepsJSON = string(data)
+	}
+
 	return &proto.PluginInfo{
 		Name:         info.Name,
 		Version:      info.Version,
@@ -55,6 +62,7 @@ func (s *GRPCServer) GetInfo(ctx context.Context, req *proto.Empty) (*proto.Plug
 		Author:       info.Author,
 		Hooks:        hooks,
 		ConfigSchema: info.ConfigSchema,
+		Steps:        stepsJSON,
 	}, nil
 }
 
@@ -85,18 +93,26 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 		Changelog:       req.Context.Changelog,
 		ReleaseNotes:    req.Context.ReleaseNotes,
 		Environment:     req.Context.Environment,
+		IsPrerelease:    req.Context.IsPrerelease,
+		TraceContext:    req.Context.TraceContext,
+		Highlights:      req.Context.Highlights,
 	}
 
 	if req.Context.Changes != nil {
 		releaseCtx.Changes = convertProtoChanges(req.Context.Changes)
 	}
+	if req.Context.PluginOutputs != "" {
+		_ = json.Unmarshal([]byte(req.Context.PluginOutputs), &releaseCtx.PluginOutputs) // Ignore unmarshal error for optional field
+	}
 
 	// Execute
 	resp, err := s.Impl.Execute(ctx, ExecuteRequest{
-		Hook:    protoHookToHook(req.Hook),
-		Config:  config,
-		Context: releaseCtx,
-		DryRun:  req.DryRun,
+		Hook:           protoHookToHook(req.Hook),
+		Config:         config,
+		Context:        releaseCtx,
+		DryRun:         req.DryRun,
+		Step:           req.Step,
+		IdempotencyKey: req.IdempotencyKey,
 	})
 	if err != nil {
 		return &proto.ExecuteResponse{
@@ -195,6 +211,11 @@ func (c *GRPCClient) GetInfo() Info {
 		hooks[i] = Hook(h)
 	}
 
+	var steps []StepDefinition
+	if resp.Steps != "" {
+		_ = json.Unmarshal([]byte(resp.Steps), &steps) // Ignore unmarshal error for optional field
+	}
+
 	return Info{
 		Name:         resp.Name,
 		Version:      resp.Version,
@@ -202,6 +223,7 @@ func (c *GRPCClient) GetInfo() Info {
 		Author:       resp.Author,
 		Hooks:        hooks,
 		ConfigSchema: resp.ConfigSchema,
+		Steps:        steps,
 	}
 }
 
@@ -210,9 +232,11 @@ func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteR
 	configJSON, _ := json.Marshal(req.Config)
 
 	protoReq := &proto.ExecuteRequest{
-		Hook:   hookToProtoHook(req.Hook),
-		Config: string(configJSON),
-		DryRun: req.DryRun,
+		Hook:           hookToProtoHook(req.Hook),
+		Config:         string(configJSON),
+		DryRun:         req.DryRun,
+		Step:           req.Step,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 
 	if req.Context.Version != "" {
@@ -229,11 +253,18 @@ func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteR
 			Changelog:       req.Context.Changelog,
 			ReleaseNotes:    req.Context.ReleaseNotes,
 			Environment:     req.Context.Environment,
+			IsPrerelease:    req.Context.IsPrerelease,
+			TraceContext:    req.Context.TraceContext,
+			Highlights:      req.Context.Highlights,
 		}
 
 		if req.Context.Changes != nil {
 			protoReq.Context.Changes = convertChangesToProto(req.Context.Changes)
 		}
+		if len(req.Context.PluginOutputs) > 0 {
+			data, _ := json.Marshal(req.Context.PluginOutputs)
+			protoReq.Context.PluginOutputs = string(data)
+		}
 	}
 
 	resp, err := c.client.Execute(ctx, protoReq)
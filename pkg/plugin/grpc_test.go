@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
 
 	"github.com/relicta-tech/relicta/internal/plugin/proto"
 )
@@ -305,6 +306,8 @@ func TestGRPCClient_GetInfo_Timeout(t *testing.T) {
 type mockPluginClient struct {
 	proto.UnimplementedPluginServer
 	hangOnGetInfo bool
+	info          *proto.PluginInfo
+	lastExecute   *proto.ExecuteRequest
 }
 
 func (m *mockPluginClient) GetInfo(ctx context.Context, req *proto.Empty, opts ...grpc.CallOption) (*proto.PluginInfo, error) {
@@ -313,6 +316,9 @@ func (m *mockPluginClient) GetInfo(ctx context.Context, req *proto.Empty, opts .
 		<-ctx.Done()
 		return nil, ctx.Err()
 	}
+	if m.info != nil {
+		return m.info, nil
+	}
 	return &proto.PluginInfo{
 		Name:    "test",
 		Version: "1.0.0",
@@ -320,6 +326,7 @@ func (m *mockPluginClient) GetInfo(ctx context.Context, req *proto.Empty, opts .
 }
 
 func (m *mockPluginClient) Execute(ctx context.Context, req *proto.ExecuteRequest, opts ...grpc.CallOption) (*proto.ExecuteResponse, error) {
+	m.lastExecute = req
 	return &proto.ExecuteResponse{Success: true}, nil
 }
 
@@ -364,6 +371,191 @@ func TestGRPCClient_Execute(t *testing.T) {
 	}
 }
 
+// capturingPlugin records the last execute request it received.
+type capturingPlugin struct {
+	mockPlugin
+	lastReq ExecuteRequest
+}
+
+func (c *capturingPlugin) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResponse, error) {
+	c.lastReq = req
+	return &ExecuteResponse{Success: true}, nil
+}
+
+func TestGRPC_Execute_PluginOutputsRoundTrip(t *testing.T) {
+	mockClient := &mockPluginClient{}
+	client := &GRPCClient{client: mockClient}
+
+	_, err := client.Execute(context.Background(), ExecuteRequest{
+		Hook: HookPostPublish,
+		Context: ReleaseContext{
+			Version: "1.0.0",
+			PluginOutputs: map[string]map[string]any{
+				"github": {"release_url": "https://example.com/releases/v1.0.0"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if mockClient.lastExecute.Context.PluginOutputs == "" {
+		t.Fatal("plugin outputs not sent over gRPC")
+	}
+
+	impl := &capturingPlugin{}
+	server := &GRPCServer{Impl: impl}
+	if _, err := server.Execute(context.Background(), mockClient.lastExecute); err != nil {
+		t.Fatalf("server Execute() error = %v", err)
+	}
+	if got := impl.lastReq.Context.PluginOutputs["github"]["release_url"]; got != "https://example.com/releases/v1.0.0" {
+		t.Errorf("PluginOutputs[github][release_url] = %v", got)
+	}
+}
+
+func TestGRPC_Execute_TraceContextRoundTrip(t *testing.T) {
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	mockClient := &mockPluginClient{}
+	client := &GRPCClient{client: mockClient}
+
+	_, err := client.Execute(context.Background(), ExecuteRequest{
+		Hook:    HookPostPublish,
+		Context: ReleaseContext{Version: "1.0.0", TraceContext: traceParent},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Round-trip through the wire format to exercise the proto descriptor
+	data, err := protobuf.Marshal(mockClient.lastExecute)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var wireReq proto.ExecuteRequest
+	if err := protobuf.Unmarshal(data, &wireReq); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	impl := &capturingPlugin{}
+	server := &GRPCServer{Impl: impl}
+	if _, err := server.Execute(context.Background(), &wireReq); err != nil {
+		t.Fatalf("server Execute() error = %v", err)
+	}
+	if impl.lastReq.Context.TraceContext != traceParent {
+		t.Errorf("TraceContext = %q, want %q", impl.lastReq.Context.TraceContext, traceParent)
+	}
+}
+
+func TestGRPC_Execute_StepRoundTrip(t *testing.T) {
+	mockClient := &mockPluginClient{}
+	client := &GRPCClient{client: mockClient}
+
+	_, err := client.Execute(context.Background(), ExecuteRequest{
+		Hook:           HookPostPublish,
+		Context:        ReleaseContext{Version: "1.0.0"},
+		Step:           "upload-assets",
+		IdempotencyKey: "run-1:upload-assets:abc",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	data, err := protobuf.Marshal(mockClient.lastExecute)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var wireReq proto.ExecuteRequest
+	if err := protobuf.Unmarshal(data, &wireReq); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	impl := &capturingPlugin{}
+	server := &GRPCServer{Impl: impl}
+	if _, err := server.Execute(context.Background(), &wireReq); err != nil {
+		t.Fatalf("server Execute() error = %v", err)
+	}
+	if impl.lastReq.Step != "upload-assets" {
+		t.Errorf("Step = %q, want upload-assets", impl.lastReq.Step)
+	}
+	if impl.lastReq.IdempotencyKey != "run-1:upload-assets:abc" {
+		t.Errorf("IdempotencyKey = %q, want run-1:upload-assets:abc", impl.lastReq.IdempotencyKey)
+	}
+}
+
+func TestGRPC_GetInfo_StepsRoundTrip(t *testing.T) {
+	server := &GRPCServer{Impl: &stepPlugin{}}
+	resp, err := server.GetInfo(context.Background(), &proto.Empty{})
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	data, err := protobuf.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var wireInfo proto.PluginInfo
+	if err := protobuf.Unmarshal(data, &wireInfo); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	client := &GRPCClient{client: &mockPluginClient{info: &wireInfo}}
+	info := client.GetInfo()
+	if len(info.Steps) != 2 {
+		t.Fatalf("Steps len = %d, want 2", len(info.Steps))
+	}
+	if info.Steps[0].Name != "upload-assets" || info.Steps[1].Name != "announce" {
+		t.Errorf("Steps = %+v, want upload-assets then announce", info.Steps)
+	}
+	if info.Steps[0].Description != "Upload release assets" {
+		t.Errorf("Steps[0].Description = %q", info.Steps[0].Description)
+	}
+}
+
+// stepPlugin contributes publish steps.
+type stepPlugin struct {
+	mockPlugin
+}
+
+func (p *stepPlugin) GetInfo() Info {
+	info := p.mockPlugin.GetInfo()
+	info.Steps = []StepDefinition{
+		{Name: "upload-assets", Description: "Upload release assets"},
+		{Name: "announce"},
+	}
+	return info
+}
+
+func TestGRPC_Execute_HighlightsRoundTrip(t *testing.T) {
+	highlights := []string{"api: drop v1 endpoints", "cli: add --template flag"}
+	mockClient := &mockPluginClient{}
+	client := &GRPCClient{client: mockClient}
+
+	_, err := client.Execute(context.Background(), ExecuteRequest{
+		Hook:    HookPostPublish,
+		Context: ReleaseContext{Version: "1.0.0", Highlights: highlights},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	data, err := protobuf.Marshal(mockClient.lastExecute)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var wireReq proto.ExecuteRequest
+	if err := protobuf.Unmarshal(data, &wireReq); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	impl := &capturingPlugin{}
+	server := &GRPCServer{Impl: impl}
+	if _, err := server.Execute(context.Background(), &wireReq); err != nil {
+		t.Fatalf("server Execute() error = %v", err)
+	}
+	if got := impl.lastReq.Context.Highlights; len(got) != 2 || got[0] != highlights[0] || got[1] != highlights[1] {
+		t.Errorf("Highlights = %v, want %v", got, highlights)
+	}
+}
+
 func TestGRPCClient_Validate(t *testing.T) {
 	client := &GRPCClient{
 		client: &mockPluginClient{},
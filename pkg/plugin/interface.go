@@ -80,6 +80,22 @@ type Info struct {
 	Hooks []Hook `json:"hooks"`
 	// ConfigSchema is a JSON schema for the plugin configuration.
 	ConfigSchema string `json:"config_schema,omitempty"`
+	// Steps lists the steps the plugin contributes to the publish
+	// execution plan, in the order they should run.
+	Steps []StepDefinition `json:"steps,omitempty"`
+}
+
+// StepDefinition declares a step a plugin contributes to the publish
+// execution plan. Contributed steps are planned on approval after the tag
+// step and are tracked, retried and resumed like any other publish step.
+// The plugin is invoked for HookPostPublish with ExecuteRequest.Step set to
+// the step name. A failed step is run again on retry, so steps should be
+// safe to repeat; ExecuteRequest.IdempotencyKey helps detect earlier runs.
+type StepDefinition struct {
+	// Name identifies the step within the plugin (e.g., "upload-assets").
+	Name string `json:"name"`
+	// Description is a short description of what the step does.
+	Description string `json:"description,omitempty"`
 }
 
 // ExecuteRequest contains the context for plugin execution.
@@ -92,6 +108,12 @@ type ExecuteRequest struct {
 	Context ReleaseContext `json:"context"`
 	// DryRun indicates if this is a dry run.
 	DryRun bool `json:"dry_run"`
+	// Step names the contributed step to run (see Info.Steps). It is empty
+	// for regular hook executions.
+	Step string `json:"step,omitempty"`
+	// IdempotencyKey is stable across retries of the same step of the same
+	// release. It is only set when Step is set.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 // ExecuteResponse contains the result of plugin execution.
@@ -126,16 +148,43 @@ type ReleaseContext struct {
 	RepositoryName string `json:"repository_name,omitempty"`
 	// Branch is the branch being released from.
 	Branch string `json:"branch"`
-	// CommitSHA is the HEAD commit SHA.
+	// CommitSHA is the commit the release was planned against, pinned when
+	// the release was planned. Plugins that create a release whose tag may
+	// not exist yet should target it rather than a branch, whose HEAD may
+	// have moved on:
+	//
+	//	target := parser.GetStringDefault("target_commitish", req.Context.CommitSHA)
 	CommitSHA string `json:"commit_sha"`
 	// Changelog is the generated changelog content.
 	Changelog string `json:"changelog,omitempty"`
 	// ReleaseNotes is the generated release notes.
 	ReleaseNotes string `json:"release_notes,omitempty"`
+	// Highlights are the most significant changes of the release, breaking
+	// changes first, suitable for short summaries and notifications.
+	Highlights []string `json:"highlights,omitempty"`
 	// Changes contains the categorized changes.
 	Changes *CategorizedChanges `json:"changes,omitempty"`
 	// Environment contains filtered environment variables.
 	Environment map[string]string `json:"environment,omitempty"`
+	// IsPrerelease is true when Version has a SemVer prerelease component
+	// (e.g., "1.2.3-rc.1"). Plugins that publish releases should use it as the
+	// default for their prerelease flag, letting explicit config override it:
+	//
+	//	prerelease := parser.GetBoolDefault("prerelease", req.Context.IsPrerelease)
+	IsPrerelease bool `json:"is_prerelease,omitempty"`
+	// PluginOutputs holds the outputs of plugins that already ran in the
+	// current hook, keyed by plugin name. Declare the producing plugin in
+	// run_after to guarantee its outputs are present:
+	//
+	//	url, _ := req.Context.PluginOutputs["github"]["release_url"].(string)
+	PluginOutputs map[string]map[string]any `json:"plugin_outputs,omitempty"`
+	// TraceContext is the W3C traceparent of the span tracing this plugin
+	// execution, empty when tracing is disabled. Plugins can continue the
+	// trace, e.g. with OpenTelemetry:
+	//
+	//	carrier := propagation.MapCarrier{"traceparent": req.Context.TraceContext}
+	//	ctx = propagation.TraceContext{}.Extract(ctx, carrier)
+	TraceContext string `json:"trace_context,omitempty"`
 }
 
 // CategorizedChanges contains commits grouped by category.
@@ -75,6 +75,10 @@ type ReleaseContextProto struct {
 	ReleaseNotes    string
 	Changes         *CategorizedChangesProto
 	Environment     map[string]string
+	IsPrerelease    bool
+	PluginOutputs   string
+	TraceContext    string
+	Highlights      []string
 }
 
 // CategorizedChangesProto is the protobuf categorized changes.
@@ -0,0 +1,149 @@
+// Package webhook provides helpers for services that receive Relicta webhooks.
+//
+// When a webhook has a secret configured, Relicta sends the Unix time of the
+// delivery in the X-Relicta-Timestamp header and a "v1=" signature, an
+// HMAC-SHA256 of the timestamp and the request body, in the
+// X-Relicta-Webhook-Signature header. Receivers verify both with
+// VerifySignature.
+//
+// For existing receivers, Relicta also still sends the original "sha256="
+// signature of the body alone in the X-Relicta-Signature header. It does not
+// protect against replayed deliveries and is deprecated; verify it with
+// VerifyLegacySignature only until the receiver is migrated.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the v1 signature of a delivery.
+	SignatureHeader = "X-Relicta-Webhook-Signature"
+	// TimestampHeader carries the Unix time, in seconds, of a delivery.
+	TimestampHeader = "X-Relicta-Timestamp"
+	// LegacySignatureHeader carries the deprecated signature of the body
+	// alone.
+	LegacySignatureHeader = "X-Relicta-Signature"
+
+	// DefaultTolerance is how far the timestamp of a delivery may be from the
+	// current time before VerifySignature rejects it as a replay.
+	DefaultTolerance = 5 * time.Minute
+
+	// v1Prefix prefixes the hex-encoded v1 signature.
+	v1Prefix = "v1="
+	// legacyPrefix prefixes the hex-encoded legacy signature.
+	legacyPrefix = "sha256="
+)
+
+var (
+	// ErrMissingSignature is returned when the signature header is empty.
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	// ErrUnsupportedVersion is returned when the signature does not have the
+	// prefix of the expected signature version.
+	ErrUnsupportedVersion = errors.New("webhook: unsupported signature version")
+	// ErrInvalidSignature is returned when the signature does not match.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	// ErrInvalidTimestamp is returned when the timestamp is not Unix seconds.
+	ErrInvalidTimestamp = errors.New("webhook: invalid timestamp")
+	// ErrTimestampOutOfTolerance is returned when the timestamp is too old or
+	// too far in the future.
+	ErrTimestampOutOfTolerance = errors.New("webhook: timestamp outside tolerance")
+)
+
+// now returns the current time. Tests replace it.
+var now = time.Now
+
+// Sign returns the SignatureHeader value of a delivery of body at
+// timestamp, in the form "v1=<hex>".
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return v1Prefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignLegacy returns the LegacySignatureHeader value of a delivery of body,
+// in the form "sha256=<hex>".
+func SignLegacy(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return legacyPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that header is the signature Relicta computed for
+// body at timestamp with secret, and that timestamp is within
+// DefaultTolerance of the current time. The comparison is constant-time.
+//
+//	func handle(w http.ResponseWriter, r *http.Request) {
+//		body, err := io.ReadAll(r.Body)
+//		if err != nil {
+//			http.Error(w, "bad request", http.StatusBadRequest)
+//			return
+//		}
+//		err = webhook.VerifySignature(secret,
+//			r.Header.Get(webhook.TimestampHeader), string(body),
+//			r.Header.Get(webhook.SignatureHeader))
+//		if err != nil {
+//			http.Error(w, "invalid signature", http.StatusUnauthorized)
+//			return
+//		}
+//		// handle the event
+//	}
+func VerifySignature(secret, timestamp, body, header string) error {
+	return VerifySignatureWithTolerance(secret, timestamp, body, header, DefaultTolerance)
+}
+
+// VerifySignatureWithTolerance is like VerifySignature but accepts
+// timestamps within tolerance of the current time. A zero tolerance disables
+// the timestamp check.
+func VerifySignatureWithTolerance(secret, timestamp, body, header string, tolerance time.Duration) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+	if !strings.HasPrefix(header, v1Prefix) {
+		return ErrUnsupportedVersion
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidTimestamp, timestamp)
+	}
+	if tolerance > 0 {
+		age := now().Sub(time.Unix(seconds, 0))
+		if age > tolerance || age < -tolerance {
+			return fmt.Errorf("%w: delivered %s ago", ErrTimestampOutOfTolerance, age.Truncate(time.Second))
+		}
+	}
+
+	expected := Sign(secret, timestamp, []byte(body))
+	if !hmac.Equal([]byte(expected), []byte(header)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyLegacySignature checks that header is the deprecated signature
+// Relicta computed for body with secret. It cannot detect replayed
+// deliveries; use VerifySignature instead.
+func VerifyLegacySignature(secret, body, header string) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+	if !strings.HasPrefix(header, legacyPrefix) {
+		return ErrUnsupportedVersion
+	}
+
+	expected := SignLegacy(secret, []byte(body))
+	if !hmac.Equal([]byte(expected), []byte(header)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "test-secret"
+	const body = `{"event":"release.published","release_id":"test"}`
+
+	sentAt := time.Unix(1760000000, 0)
+	now = func() time.Time { return sentAt.Add(time.Minute) }
+	t.Cleanup(func() { now = time.Now })
+
+	timestamp := strconv.FormatInt(sentAt.Unix(), 10)
+	signature := Sign(secret, timestamp, []byte(body))
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+		header    string
+		wantErr   error
+	}{
+		{name: "valid", secret: secret, timestamp: timestamp, body: body, header: signature},
+		{name: "wrong secret", secret: "wrong-secret", timestamp: timestamp, body: body, header: signature, wantErr: ErrInvalidSignature},
+		{name: "different body", secret: secret, timestamp: timestamp, body: "different payload", header: signature, wantErr: ErrInvalidSignature},
+		{name: "different timestamp", secret: secret, timestamp: strconv.FormatInt(sentAt.Unix()+1, 10), body: body, header: signature, wantErr: ErrInvalidSignature},
+		{name: "missing signature", secret: secret, timestamp: timestamp, body: body, wantErr: ErrMissingSignature},
+		{name: "unsupported version", secret: secret, timestamp: timestamp, body: body, header: "v2=abc", wantErr: ErrUnsupportedVersion},
+		{name: "legacy signature", secret: secret, timestamp: timestamp, body: body, header: SignLegacy(secret, []byte(body)), wantErr: ErrUnsupportedVersion},
+		{name: "invalid timestamp", secret: secret, timestamp: "yesterday", body: body, header: signature, wantErr: ErrInvalidTimestamp},
+		{name: "expired timestamp", secret: secret, timestamp: strconv.FormatInt(sentAt.Add(-time.Hour).Unix(), 10), body: body, header: signature, wantErr: ErrTimestampOutOfTolerance},
+		{name: "future timestamp", secret: secret, timestamp: strconv.FormatInt(sentAt.Add(time.Hour).Unix(), 10), body: body, header: signature, wantErr: ErrTimestampOutOfTolerance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.secret, tt.timestamp, tt.body, tt.header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifySignature() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifySignatureWithTolerance(t *testing.T) {
+	const secret = "test-secret"
+	const body = `{"event":"release.published"}`
+
+	sentAt := time.Unix(1760000000, 0)
+	now = func() time.Time { return sentAt.Add(time.Hour) }
+	t.Cleanup(func() { now = time.Now })
+
+	timestamp := strconv.FormatInt(sentAt.Unix(), 10)
+	signature := Sign(secret, timestamp, []byte(body))
+
+	if err := VerifySignatureWithTolerance(secret, timestamp, body, signature, 2*time.Hour); err != nil {
+		t.Errorf("VerifySignatureWithTolerance() error = %v, want nil within tolerance", err)
+	}
+	if err := VerifySignatureWithTolerance(secret, timestamp, body, signature, 0); err != nil {
+		t.Errorf("VerifySignatureWithTolerance() error = %v, want nil with zero tolerance", err)
+	}
+	if err := VerifySignatureWithTolerance(secret, timestamp, body, signature, time.Minute); !errors.Is(err, ErrTimestampOutOfTolerance) {
+		t.Errorf("VerifySignatureWithTolerance() error = %v, want ErrTimestampOutOfTolerance", err)
+	}
+}
+
+func TestVerifyLegacySignature(t *testing.T) {
+	const secret = "test-secret"
+	const body = `{"event":"release.published","release_id":"test"}`
+
+	signature := SignLegacy(secret, []byte(body))
+
+	tests := []struct {
+		name    string
+		secret  string
+		body    string
+		header  string
+		wantErr error
+	}{
+		{name: "valid", secret: secret, body: body, header: signature},
+		{name: "wrong secret", secret: "wrong-secret", body: body, header: signature, wantErr: ErrInvalidSignature},
+		{name: "different body", secret: secret, body: "different payload", header: signature, wantErr: ErrInvalidSignature},
+		{name: "missing signature", secret: secret, body: body, wantErr: ErrMissingSignature},
+		{name: "v1 signature", secret: secret, body: body, header: Sign(secret, "1760000000", []byte(body)), wantErr: ErrUnsupportedVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyLegacySignature(tt.secret, tt.body, tt.header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyLegacySignature() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
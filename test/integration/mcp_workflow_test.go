@@ -39,7 +39,7 @@ func (m *mockNotesGenerator) ComputeInputsHash(run *domain.ReleaseRun, _ ports.N
 
 // setupMCPAdapter creates a fully configured MCP adapter for testing.
 // This mirrors the production setup used by the CLI.
-func setupMCPAdapter(t *testing.T, repoDir string) *mcp.Adapter {
+func setupMCPAdapter(t *testing.T, repoDir string, opts ...mcp.AdapterOption) *mcp.Adapter {
 	t.Helper()
 
 	// Create git service and adapter (same as CLI)
@@ -66,11 +66,11 @@ func setupMCPAdapter(t *testing.T, repoDir string) *mcp.Adapter {
 	require.NoError(t, err)
 
 	// Create MCP adapter with full infrastructure (ADR-007 compliant)
-	return mcp.NewAdapter(
+	return mcp.NewAdapter(append([]mcp.AdapterOption{
 		mcp.WithReleaseAnalyzer(releaseAnalyzer),
 		mcp.WithReleaseServices(services),
 		mcp.WithRepoRoot(repoDir),
-	)
+	}, opts...)...)
 }
 
 // TestMCPWorkflowE2E tests the full MCP release workflow matches CLI behavior.
@@ -164,6 +164,123 @@ func TestMCPWorkflowE2E(t *testing.T) {
 	})
 }
 
+// TestMCPBumpExplicitVersion verifies that an explicit version passed to the
+// MCP bump must be greater than the current version.
+func TestMCPBumpExplicitVersion(t *testing.T) {
+	RequireGitVersion(t, "2.0.0")
+	ctx := context.Background()
+
+	repo := NewTestRepo(t)
+	repo.WriteFile("README.md", "# Test Project\n")
+	repo.Commit("feat: initial project setup")
+	repo.Tag("v1.2.0")
+
+	repo.WriteFile("main.go", "package main\n")
+	repo.Commit("fix: add main entry point")
+
+	adapter := setupMCPAdapter(t, repo.Dir)
+
+	_, err := adapter.Plan(ctx, mcp.PlanInput{FromRef: "v1.2.0"})
+	require.NoError(t, err)
+
+	_, err = adapter.Bump(ctx, mcp.BumpInput{Version: "1.1.0"})
+	require.ErrorIs(t, err, app.ErrVersionNotIncreasing)
+	assert.Contains(t, err.Error(), "1.1.0 is not greater than 1.2.0")
+
+	status, err := adapter.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "planned", status.State)
+
+	bumpOutput, err := adapter.Bump(ctx, mcp.BumpInput{Version: "2.0.0-rc.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0-rc.1", bumpOutput.NextVersion)
+	assert.Equal(t, "v2.0.0-rc.1", bumpOutput.TagName)
+}
+
+// TestMCPNotesPresets verifies that the MCP notes presets are validated and
+// that the notes record the presets they were generated with.
+func TestMCPNotesPresets(t *testing.T) {
+	RequireGitVersion(t, "2.0.0")
+	ctx := context.Background()
+
+	repo := NewTestRepo(t)
+	repo.WriteFile("README.md", "# Test Project\n")
+	repo.Commit("feat: initial project setup")
+	repo.Tag("v1.0.0")
+
+	repo.WriteFile("main.go", "package main\n")
+	repo.Commit("feat: add main entry point")
+
+	adapter := setupMCPAdapter(t, repo.Dir)
+
+	_, err := adapter.Plan(ctx, mcp.PlanInput{FromRef: "v1.0.0"})
+	require.NoError(t, err)
+	_, err = adapter.Bump(ctx, mcp.BumpInput{BumpType: "auto"})
+	require.NoError(t, err)
+
+	_, err = adapter.Notes(ctx, mcp.NotesInput{Tone: "sarcastic"})
+	require.ErrorIs(t, err, domain.ErrUnknownNotesPreset)
+	assert.Contains(t, err.Error(), "technical, friendly, professional, excited")
+
+	notesOutput, err := adapter.Notes(ctx, mcp.NotesInput{Audience: "user", Tone: "marketing"})
+	require.NoError(t, err)
+	assert.Equal(t, "users", notesOutput.Audience)
+	assert.Equal(t, "excited", notesOutput.Tone)
+}
+
+// TestMCPStatusApprovalProgress verifies that the MCP status reports the
+// approval progress: granted and pending levels under a multi-level policy,
+// and whether auto-approval is possible otherwise.
+func TestMCPStatusApprovalProgress(t *testing.T) {
+	RequireGitVersion(t, "2.0.0")
+	ctx := context.Background()
+
+	repo := NewTestRepo(t)
+	repo.WriteFile("README.md", "# Test Project\n")
+	repo.Commit("feat: initial project setup")
+	repo.Tag("v1.0.0")
+
+	repo.WriteFile("main.go", "package main\n")
+	repo.Commit("feat: add main entry point")
+
+	adapter := setupMCPAdapter(t, repo.Dir)
+	_, err := adapter.Plan(ctx, mcp.PlanInput{FromRef: "v1.0.0"})
+	require.NoError(t, err)
+	_, err = adapter.Bump(ctx, mcp.BumpInput{BumpType: "auto"})
+	require.NoError(t, err)
+	_, err = adapter.Notes(ctx, mcp.NotesInput{})
+	require.NoError(t, err)
+
+	status, err := adapter.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "single", status.ApprovalMode)
+	assert.Equal(t, status.RiskScore <= status.AutoApproveThreshold, status.CanAutoApprove)
+	assert.Empty(t, status.PendingApprovalLevels)
+
+	multiLevel := setupMCPAdapter(t, repo.Dir, mcp.WithApprovalPolicy(domain.HighRiskApprovalPolicy()))
+	status, err = multiLevel.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "multi_level", status.ApprovalMode)
+	assert.Empty(t, status.GrantedApprovalLevels)
+	assert.Equal(t, []string{"technical", "security", "release"}, status.PendingApprovalLevels)
+	assert.Equal(t, "technical", status.NextApprovalLevel)
+
+	_, err = multiLevel.ApproveLevel(ctx, mcp.ApproveLevelInput{Level: "technical", ApprovedBy: "alice"})
+	require.NoError(t, err)
+
+	status, err = multiLevel.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "multi_level", status.ApprovalMode)
+	assert.Equal(t, []string{"technical"}, status.GrantedApprovalLevels)
+	assert.Equal(t, []string{"security", "release"}, status.PendingApprovalLevels)
+	assert.Equal(t, "security", status.NextApprovalLevel)
+
+	// The policy recorded on the run is reported without the option.
+	status, err = adapter.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"technical"}, status.GrantedApprovalLevels)
+}
+
 // TestMCPAndCLIStateConsistency verifies that operations via MCP and CLI
 // produce consistent state when reading from the same repository.
 func TestMCPAndCLIStateConsistency(t *testing.T) {